@@ -20,10 +20,17 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/DePINNetwork/depin-sdk/util/execpool"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
 )
 
+// voteVerificationBuckets range from 50µs to about 100ms
+var voteVerificationBuckets = metrics.ExponentialBuckets(0.00005, 2, 12)
+
+var voteVerificationSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_agreement_vote_verification_seconds", Description: "Time spent verifying a single vote"}, voteVerificationBuckets)
+
 type asyncVerifyVoteRequest struct {
 	ctx     context.Context
 	l       LedgerReader
@@ -103,7 +110,9 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
+		start := time.Now()
 		v, err := req.uv.verify(req.l)
+		voteVerificationSeconds.ObserveSince(start, nil)
 		req.message.Vote = v
 
 		var e *LedgerDroppedRoundError
@@ -46,6 +46,7 @@ var errPseudonodeNoProposals = errors.New("no valid participation keys to genera
 
 var pseudonodeBacklogFullByType = metrics.NewTagCounter("algod_agreement_pseudonode_tasks_dropped_{TAG}", "Number of pseudonode {TAG} tasks dropped", "proposal", "vote")
 var pseudonodeResultTimeoutsByType = metrics.NewTagCounter("algod_agreement_pseudonode_tasks_timeouts_{TAG}", "Number of pseudonode {TAG} task result timeouts", "vote", "pvote", "ppayload")
+var blockAssemblySeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_agreement_block_assembly_seconds", Description: "Time spent assembling a block for a proposal"}, metrics.DefaultHistogramBuckets)
 
 // A pseudonode creates proposals and votes with a KeyManager which holds participation keys.
 //
@@ -288,7 +289,9 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 	for i := range accounts {
 		addresses[i] = accounts[i].Account
 	}
+	assemblyStart := time.Now()
 	ve, err := n.factory.AssembleBlock(round, addresses)
+	blockAssemblySeconds.ObserveSince(assemblyStart, nil)
 	if err != nil {
 		if err != ErrAssembleBlockRoundStale {
 			n.log.Errorf("pseudonode.makeProposals: could not generate a proposal for round %d: %v", round, err)
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
+	"github.com/DePINNetwork/depin-sdk/rpcs"
+	"github.com/DePINNetwork/depin-sdk/util/s3"
+)
+
+// blockArchiveBundleCacheSize is the number of bundles the block archive peer keeps in memory, so that the
+// parallel block fetches of the catchup service share the download of the bundle they fall into.
+const blockArchiveBundleCacheSize = 4
+
+// blockArchiveBundleFetchTimeout bounds the download of a single bundle
+const blockArchiveBundleFetchTimeout = 2 * time.Minute
+
+// blockArchiveManifestRefreshInterval limits how often the manifest is re-read when asking for rounds past its end
+const blockArchiveManifestRefreshInterval = 10 * time.Second
+
+// blockArchiveToleranceFactor is the number of failures after which the catchup service stops fetching from the
+// block archive and turns to network peers instead; this typically happens once it reaches the end of the archive.
+const blockArchiveToleranceFactor = 3
+
+// blockArchiveBundle is a bundle downloaded, or being downloaded, from the block archive
+type blockArchiveBundle struct {
+	first   basics.Round
+	done    chan struct{}
+	entries [][]byte
+	err     error
+}
+
+// blockArchivePeer is the pseudo-peer standing for a block archive in the catchup peer selection.
+// The universal fetcher fetches blocks from it the same way it does from http and ws peers.
+type blockArchivePeer struct {
+	store     rpcs.BlockArchiveStore
+	genesisID string
+	location  string
+	log       logging.Logger
+
+	mu               deadlock.Mutex
+	manifest         rpcs.BlockArchiveManifest
+	manifestLoadedAt time.Time
+	// bundles lists the cached bundles, the most recently used last
+	bundles []*blockArchiveBundle
+}
+
+func makeBlockArchivePeer(log logging.Logger, store rpcs.BlockArchiveStore, genesisID string, location string) *blockArchivePeer {
+	return &blockArchivePeer{
+		store:     store,
+		genesisID: genesisID,
+		location:  location,
+		log:       log,
+	}
+}
+
+// makeS3BlockArchivePeer returns a block archive peer for the bucket given in the config, or nil if it cannot be set up
+func makeS3BlockArchivePeer(log logging.Logger, cfg config.Local, ledger Ledger) *blockArchivePeer {
+	hdr, err := ledger.BlockHdr(ledger.LastRound())
+	if err != nil {
+		log.Warnf("unable to catch up from the block archive: %v", err)
+		return nil
+	}
+	helper, err := s3.MakeS3SessionWithEndpoint(cfg.BlockArchiveS3Bucket, cfg.BlockArchiveS3Endpoint)
+	if err != nil {
+		log.Warnf("unable to catch up from the block archive: %v", err)
+		return nil
+	}
+	return makeBlockArchivePeer(log, &helper, hdr.GenesisID, fmt.Sprintf("[s3] (%s)", cfg.BlockArchiveS3Bucket))
+}
+
+func (ap *blockArchivePeer) address() string {
+	return ap.location
+}
+
+// getBlockBytes returns the encoded block and certificate of the given round, in the format served by the block service
+func (ap *blockArchivePeer) getBlockBytes(ctx context.Context, r basics.Round) ([]byte, error) {
+	manifest, err := ap.getManifest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if r > manifest.LastRound {
+		return nil, noBlockForRoundError{round: r, latest: manifest.LastRound}
+	}
+
+	bundle := ap.getBundle(manifest, r)
+	select {
+	case <-bundle.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if bundle.err != nil {
+		return nil, bundle.err
+	}
+	idx := int(r - bundle.first)
+	if idx >= len(bundle.entries) {
+		return nil, fmt.Errorf("blockArchivePeer: bundle starting at round %d has no entry for round %d", bundle.first, r)
+	}
+	return bundle.entries[idx], nil
+}
+
+// getManifest returns the cached manifest, re-reading it if it does not cover round r and was not read recently
+func (ap *blockArchivePeer) getManifest(ctx context.Context, r basics.Round) (rpcs.BlockArchiveManifest, error) {
+	ap.mu.Lock()
+	manifest, loadedAt := ap.manifest, ap.manifestLoadedAt
+	ap.mu.Unlock()
+	if manifest.BundleRounds != 0 && (r <= manifest.LastRound || time.Since(loadedAt) < blockArchiveManifestRefreshInterval) {
+		return manifest, nil
+	}
+
+	manifest, err := rpcs.GetBlockArchiveManifest(ctx, ap.store, ap.genesisID)
+	if errors.Is(err, s3.ErrObjectNotFound) {
+		// nothing was exported yet
+		return rpcs.BlockArchiveManifest{}, noBlockForRoundError{round: r}
+	}
+	if err != nil {
+		return rpcs.BlockArchiveManifest{}, err
+	}
+
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	ap.manifest, ap.manifestLoadedAt = manifest, time.Now()
+	return manifest, nil
+}
+
+// getBundle returns the bundle holding round r, starting its download unless it is already cached
+func (ap *blockArchivePeer) getBundle(manifest rpcs.BlockArchiveManifest, r basics.Round) *blockArchiveBundle {
+	first, last := rpcs.BlockArchiveBundleRange(r, manifest.BundleRounds)
+
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	for i, bundle := range ap.bundles {
+		if bundle.first == first {
+			ap.bundles = append(append(ap.bundles[:i], ap.bundles[i+1:]...), bundle)
+			return bundle
+		}
+	}
+
+	bundle := &blockArchiveBundle{first: first, done: make(chan struct{})}
+	if len(ap.bundles) >= blockArchiveBundleCacheSize {
+		ap.bundles = ap.bundles[1:]
+	}
+	ap.bundles = append(ap.bundles, bundle)
+	go ap.fetchBundle(bundle, last)
+	return bundle
+}
+
+// fetchBundle downloads and splits the given bundle. The download is not tied to the context of the fetch that
+// started it, as other fetches may be waiting for the same bundle.
+func (ap *blockArchivePeer) fetchBundle(bundle *blockArchiveBundle, last basics.Round) {
+	defer close(bundle.done)
+	ctx, cancel := context.WithTimeout(context.Background(), blockArchiveBundleFetchTimeout)
+	defer cancel()
+
+	data, err := ap.store.GetObject(ctx, rpcs.BlockArchiveBundleKey(ap.genesisID, bundle.first, last))
+	if err == nil {
+		bundle.entries, err = rpcs.DecodeBlockArchiveBundle(data)
+	}
+	if err != nil {
+		bundle.err = fmt.Errorf("blockArchivePeer: unable to fetch bundle %d-%d: %w", bundle.first, last, err)
+		ap.log.Info(bundle.err)
+		ap.dropBundle(bundle)
+	}
+}
+
+// dropBundle removes a bundle from the cache, so that the next fetch of its rounds downloads it again
+func (ap *blockArchivePeer) dropBundle(bundle *blockArchiveBundle) {
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	for i, cached := range ap.bundles {
+		if cached == bundle {
+			ap.bundles = append(ap.bundles[:i], ap.bundles[i+1:]...)
+			return
+		}
+	}
+}
+
+// blockArchivePeerSelector is the peerSelector offering the block archive peer, ahead of the network peers
+type blockArchivePeerSelector struct {
+	mu   deadlock.Mutex
+	peer *peerSelectorPeer
+	rank int
+}
+
+func makeBlockArchivePeerSelector(peer *blockArchivePeer) *wrappedPeerSelector {
+	return &wrappedPeerSelector{
+		peerClass: network.PeersPhonebookArchivalNodes,
+		peerSelector: &blockArchivePeerSelector{
+			peer: &peerSelectorPeer{Peer: peer, peerClass: network.PeersPhonebookArchivalNodes},
+			rank: peerRankInitialFirstPriority,
+		},
+		toleranceFactor: blockArchiveToleranceFactor,
+	}
+}
+
+func (ps *blockArchivePeerSelector) rankPeer(psp *peerSelectorPeer, rank int) (int, int) {
+	if psp == nil || psp.Peer != ps.peer.Peer {
+		return -1, -1
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	oldRank := ps.rank
+	ps.rank = rank
+	return oldRank, rank
+}
+
+func (ps *blockArchivePeerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockDownloadDuration time.Duration) (rank int) {
+	if psp == nil || psp.Peer != ps.peer.Peer {
+		return peerRankInvalidDownload
+	}
+	return downloadDurationToRank(blockDownloadDuration, lowBlockDownloadThreshold, highBlockDownloadThreshold, peerRank0LowBlockTime, peerRank0HighBlockTime)
+}
+
+func (ps *blockArchivePeerSelector) getNextPeer() (psp *peerSelectorPeer, err error) {
+	return ps.peer, nil
+}
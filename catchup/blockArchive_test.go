@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/data"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/rpcs"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/s3"
+)
+
+// memoryBlockArchiveStore is an in-memory rpcs.BlockArchiveStore counting the objects read from it
+type memoryBlockArchiveStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	gets    map[string]int
+}
+
+func (ms *memoryBlockArchiveStore) PutObject(ctx context.Context, key string, data []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.objects == nil {
+		ms.objects = make(map[string][]byte)
+	}
+	ms.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (ms *memoryBlockArchiveStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.gets == nil {
+		ms.gets = make(map[string]int)
+	}
+	ms.gets[key]++
+	data, ok := ms.objects[key]
+	if !ok {
+		return nil, s3.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+func (ms *memoryBlockArchiveStore) getCount(key string) int {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.gets[key]
+}
+
+// exportTestBlockArchive builds a ledger with numBlocks blocks past genesis and exports it to a block archive
+// with the given bundle size, returning the ledger and the archive store.
+func exportTestBlockArchive(t *testing.T, numBlocks int, bundleRounds uint64) (*data.Ledger, *memoryBlockArchiveStore) {
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, numBlocks)
+
+	cfg := config.GetDefaultLocal()
+	cfg.BlockArchiveBundleRounds = bundleRounds
+	store := &memoryBlockArchiveStore{}
+	exporter := rpcs.MakeBlockArchiveExporter(logging.TestingLog(t), cfg, remote, store, "test genesisID")
+	exporter.Start()
+	defer exporter.Stop()
+
+	_, expectedLast := rpcs.BlockArchiveBundleRange(basics.Round(numBlocks+1), bundleRounds)
+	expectedLast -= basics.Round(bundleRounds)
+	require.Eventually(t, func() bool {
+		manifest, err := rpcs.GetBlockArchiveManifest(context.Background(), store, "test genesisID")
+		return err == nil && manifest.LastRound == expectedLast
+	}, 10*time.Second, 10*time.Millisecond)
+	return remote, store
+}
+
+func TestBlockArchivePeerFetchBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	remote, store := exportTestBlockArchive(t, 25, 10)
+	defer remote.Close()
+	archive := makeBlockArchivePeer(logging.TestingLog(t), store, "test genesisID", "[test] archive")
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), &httpTestPeerSource{}, defaultConfig)
+
+	// concurrent fetches of the rounds of a bundle share its download
+	var wg sync.WaitGroup
+	for rnd := basics.Round(10); rnd <= 19; rnd++ {
+		wg.Add(1)
+		go func(rnd basics.Round) {
+			defer wg.Done()
+			blk, cert, _, err := fetcher.fetchBlock(context.Background(), rnd, archive)
+			require.NoError(t, err)
+			require.Equal(t, rnd, cert.Round)
+			expected, err := remote.Block(rnd)
+			require.NoError(t, err)
+			require.Equal(t, expected, *blk)
+		}(rnd)
+	}
+	wg.Wait()
+	require.Equal(t, 1, store.getCount(rpcs.BlockArchiveBundleKey("test genesisID", 10, 19)))
+
+	blk, _, _, err := fetcher.fetchBlock(context.Background(), 1, archive)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(1), blk.Round())
+
+	// rounds 20-25 are not part of a complete bundle yet
+	_, _, _, err = fetcher.fetchBlock(context.Background(), 22, archive)
+	var nbfe noBlockForRoundError
+	require.True(t, errors.As(err, &nbfe))
+	require.Equal(t, basics.Round(19), nbfe.latest)
+
+	// a missing bundle is reported, and downloaded again by the next fetch
+	key := rpcs.BlockArchiveBundleKey("test genesisID", 1, 9)
+	store.mu.Lock()
+	bundle := store.objects[key]
+	delete(store.objects, key)
+	store.mu.Unlock()
+	archive = makeBlockArchivePeer(logging.TestingLog(t), store, "test genesisID", "[test] archive")
+	_, _, _, err = fetcher.fetchBlock(context.Background(), 3, archive)
+	require.ErrorIs(t, err, s3.ErrObjectNotFound)
+	require.NoError(t, store.PutObject(context.Background(), key, bundle))
+	blk, _, _, err = fetcher.fetchBlock(context.Background(), 3, archive)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(3), blk.Round())
+}
+
+func TestServiceFetchBlocksFromBlockArchive(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	remote, store := exportTestBlockArchive(t, 25, 10)
+	defer remote.Close()
+
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	// no network peers: all the blocks come from the archive, up to its last bundle
+	net := &httpTestPeerSource{}
+	s := MakeService(logging.TestingLog(t), defaultConfig, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	s.blockArchive = makeBlockArchivePeer(logging.TestingLog(t), store, "test genesisID", "[test] archive")
+	s.testStart()
+	s.sync()
+
+	require.Equal(t, basics.Round(19), local.LastRound())
+	for rnd := basics.Round(1); rnd <= 19; rnd++ {
+		expected, err := remote.Block(rnd)
+		require.NoError(t, err)
+		localBlock, err := local.Block(rnd)
+		require.NoError(t, err)
+		require.Equal(t, expected, localBlock)
+	}
+}
+
+func TestCreatePeerSelectorWithBlockArchive(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	archive := makeBlockArchivePeer(logging.TestingLog(t), &memoryBlockArchiveStore{}, "test genesisID", "[test] archive")
+	ps := createPeerSelector(&httpTestPeerSource{}, archive)
+	cps, ok := ps.(*classBasedPeerSelector)
+	require.True(t, ok)
+	require.Len(t, cps.peerSelectors, 5)
+	require.Equal(t, blockArchiveToleranceFactor, cps.peerSelectors[0].toleranceFactor)
+
+	psp, err := ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, archive, psp.Peer)
+	require.Equal(t, "[test] archive", peerAddress(psp.Peer))
+
+	// once the archive runs out of blocks, the selector moves on to the network peers
+	for i := 0; i <= blockArchiveToleranceFactor; i++ {
+		ps.rankPeer(psp, peerRankNoBlockForRound)
+	}
+	_, err = ps.getNextPeer()
+	require.ErrorIs(t, err, errPeerSelectorNoPeerPoolsAvailable)
+}
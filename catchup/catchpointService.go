@@ -20,6 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,6 +34,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
 	"github.com/DePINNetwork/depin-sdk/ledger"
 	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
+	"github.com/DePINNetwork/depin-sdk/ledger/store/trackerdb"
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/stateproof"
@@ -100,6 +104,12 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector peerSelector
+	// catchpointFile is the path of a local catchpoint file the ledger is loaded from, rather than downloaded from peers.
+	// It's persisted along with the label, so that a service resumed after a restart keeps loading the same file.
+	catchpointFile string
+	// resumedDownload holds the catchpoint file entries staged before the node restarted, allowing the ledger download
+	// of a resumed service to carry on from the first entry that was not staged yet.
+	resumedDownload *catchpointDownload
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -129,8 +139,10 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 	return service, nil
 }
 
-// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode
-func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
+// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode.
+// When catchpointFile isn't empty, the ledger is loaded from that local catchpoint file rather than downloaded from peers;
+// catchpointFile may also be a directory holding catchpoint files, as resolved by ResolveCatchpointFile.
+func MakeNewCatchpointCatchupService(catchpoint string, catchpointFile string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
 	if catchpoint == "" {
 		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: catchpoint is invalid")
 	}
@@ -147,6 +159,7 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		net:            net,
 		ledger:         accessor.Ledger(),
 		config:         cfg,
+		catchpointFile: catchpointFile,
 	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
@@ -161,7 +174,12 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 func (cs *CatchpointCatchupService) Start(ctx context.Context) error {
 	// Only check catchpoint ledger validity if we're starting new
 	if cs.stage == ledger.CatchpointCatchupStateInactive {
-		err := cs.checkLedgerDownload()
+		var err error
+		if cs.catchpointFile != "" {
+			err = cs.checkLedgerFile()
+		} else {
+			err = cs.checkLedgerDownload()
+		}
 		if err != nil {
 			return fmt.Errorf("aborting catchup Start(): %s", err)
 		}
@@ -242,7 +260,7 @@ func (cs *CatchpointCatchupService) run() {
 	}
 }
 
-// loadStateVariables loads the current stage and catchpoint label from disk. It's used only in the case of catchpoint catchup recovery.
+// loadStateVariables loads the current stage, catchpoint label and catchpoint file from disk. It's used only in the case of catchpoint catchup recovery.
 // ( i.e. the node never completed the catchup, and the node was shutdown )
 func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err error) {
 	var label string
@@ -254,13 +272,45 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	cs.stats.CatchpointLabel = label
 	cs.statsMu.Unlock()
 
+	cs.catchpointFile, err = cs.ledgerAccessor.GetCatchpointFile(ctx)
+	if err != nil {
+		return err
+	}
+
 	cs.stage, err = cs.ledgerAccessor.GetState(ctx)
 	if err != nil {
 		return err
 	}
+
+	if cs.stage == ledger.CatchpointCatchupStateLedgerDownload {
+		dl := &catchpointDownload{}
+		dl.staged, dl.progress, err = cs.ledgerAccessor.GetStagingProgress(ctx)
+		if err != nil {
+			return err
+		}
+		if dl.staged > 0 {
+			cs.resumedDownload = dl
+		}
+	}
 	return nil
 }
 
+// startLedgerDownload returns the download state to stage the catchpoint file entries with. A service resumed after a
+// restart carries on with the entries staged before the restart; otherwise, the staging balances are reset.
+func (cs *CatchpointCatchupService) startLedgerDownload() (*catchpointDownload, error) {
+	if dl := cs.resumedDownload; dl != nil {
+		cs.resumedDownload = nil
+		cs.log.Infof("resuming catchpoint catchup ledger download from entry %d", dl.staged)
+		cs.updateLedgerFetcherProgress(&dl.progress)
+		return dl, nil
+	}
+	err := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &catchpointDownload{}, nil
+}
+
 // processStageInactive is the first catchpoint stage. It stores the desired label for catching up, so that if the catchpoint catchup is interrupted
 // it could be resumed from that point.
 func (cs *CatchpointCatchupService) processStageInactive() (err error) {
@@ -271,6 +321,10 @@ func (cs *CatchpointCatchupService) processStageInactive() (err error) {
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint label : %v", err))
 	}
+	err = cs.ledgerAccessor.SetCatchpointFile(cs.ctx, cs.catchpointFile)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageInactive failed to set the catchpoint file : %v", err))
+	}
 
 	err = cs.updateStage(ledger.CatchpointCatchupStateLedgerDownload)
 	if err != nil {
@@ -294,42 +348,82 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to parse label : %v", err))
 	}
 
+	if cs.catchpointFile != "" {
+		return cs.processStageLedgerLoad(label)
+	}
+
 	// download balances file.
 	lf := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	attemptsCount := 0
+	// the download state survives a failing peer, so that the next attempt resumes where the previous one stopped.
+	var dl *catchpointDownload
+	// peers serving only entire catchpoint files are not used for parallel downloads.
+	wholeFileOnly := make(map[string]bool)
 
 	for {
 		attemptsCount++
 
-		err0 := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
-		if err0 != nil {
-			if cs.ctx.Err() != nil {
-				return cs.stopOrAbort()
+		if dl == nil || dl.corrupted {
+			var err0 error
+			dl, err0 = cs.startLedgerDownload()
+			if err0 != nil {
+				if cs.ctx.Err() != nil {
+					return cs.stopOrAbort()
+				}
+				return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
 			}
-			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
 		}
-		psp, err0 := cs.blocksDownloadPeerSelector.getNextPeer()
+		psps, err0 := cs.getLedgerDownloadPeers(wholeFileOnly)
 		if err0 != nil {
 			err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
 			return cs.abort(err0)
 		}
-		peer := psp.Peer
 		start := time.Now()
-		err0 = lf.downloadLedger(cs.ctx, peer, round)
+		if len(psps) > 1 {
+			peers := make([]network.HTTPPeer, len(psps))
+			for i, psp := range psps {
+				peers[i] = psp.Peer.(network.HTTPPeer)
+			}
+			err0 = lf.downloadLedgerEntries(cs.ctx, peers, round, dl)
+		} else {
+			err0 = lf.downloadLedger(cs.ctx, psps[0].Peer, round, dl)
+		}
 		if err0 == nil {
-			cs.log.Infof("ledger downloaded from %s in %d seconds", peerAddress(peer), time.Since(start)/time.Second)
+			cs.log.Infof("ledger downloaded from %d peer(s) in %d seconds", len(psps), time.Since(start)/time.Second)
 			start = time.Now()
 			err0 = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
 			if err0 == nil {
 				cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
 				break
 			}
-			// failed to build the merkle trie for the above catchpoint file.
-			cs.log.Infof("failed to build merkle trie for catchpoint file from %s: %v", peerAddress(peer), err0)
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			// failed to build the merkle trie for the above catchpoint file; we can't tell which of the peers
+			// served the bad entries, so we start over.
+			for _, psp := range psps {
+				cs.log.Infof("failed to build merkle trie for catchpoint file from %s: %v", peerAddress(psp.Peer), err0)
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			}
+			dl.corrupted = true
 		} else {
-			cs.log.Infof("failed to download catchpoint ledger from peer %s: %v", peerAddress(peer), err0)
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			psp := psps[0]
+			var peerErr *ledgerPeerError
+			if errors.As(err0, &peerErr) {
+				for _, candidate := range psps {
+					if candidate.Peer == network.Peer(peerErr.peer) {
+						psp = candidate
+					}
+				}
+			}
+			switch {
+			case errors.Is(err0, errLedgerEntriesUnsupported):
+				cs.log.Infof("peer %s does not support partial catchpoint downloads", peerAddress(psp.Peer))
+				wholeFileOnly[peerAddress(psp.Peer)] = true
+			case errors.Is(err0, errInvalidCatchpointEntry) || dl.corrupted:
+				cs.log.Infof("received an invalid catchpoint ledger from peer %s: %v", peerAddress(psp.Peer), err0)
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			default:
+				cs.log.Infof("failed to download catchpoint ledger from peer %s: %v", peerAddress(psp.Peer), err0)
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			}
 		}
 
 		// instead of testing for err == cs.ctx.Err() , we'll check on the context itself.
@@ -343,7 +437,11 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 			err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup exceeded number of attempts to retrieve ledger")
 			return cs.abort(err0)
 		}
-		cs.log.Warnf("unable to download ledger : %v", err0)
+		if dl.corrupted {
+			cs.log.Warnf("unable to download ledger, restarting download : %v", err0)
+		} else {
+			cs.log.Warnf("unable to download ledger, resuming download from entry %d : %v", dl.staged, err0)
+		}
 	}
 
 	err = cs.updateStage(ledger.CatchpointCatchupStateLatestBlockDownload)
@@ -353,6 +451,45 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 	return nil
 }
 
+// processStageLedgerLoad is the ledger download stage of a catchpoint catchup loading the ledger from a local catchpoint file.
+// The catchpoint file is never substituted by a download from peers : if it can't be loaded, the catchup is aborted.
+func (cs *CatchpointCatchupService) processStageLedgerLoad(label string) error {
+	dl, err := cs.startLedgerDownload()
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to reset staging balances : %v", err))
+	}
+
+	lf := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	start := time.Now()
+	err = lf.loadLedgerFile(cs.ctx, cs.catchpointFile, label, dl)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to load catchpoint file %s : %v", cs.catchpointFile, err))
+	}
+	cs.log.Infof("ledger loaded from %s in %d seconds", cs.catchpointFile, time.Since(start)/time.Second)
+
+	start = time.Now()
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to build merkle trie for catchpoint file %s : %v", cs.catchpointFile, err))
+	}
+	cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+
+	err = cs.updateStage(ledger.CatchpointCatchupStateLatestBlockDownload)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageLedgerLoad failed to update stage to CatchpointCatchupStateLatestBlockDownload : %v", err))
+	}
+	return nil
+}
+
 // updateVerifiedCounts update the user's statistics for the given verified hashes
 func (cs *CatchpointCatchupService) updateVerifiedCounts(accountCount, kvCount uint64) {
 	cs.statsMu.Lock()
@@ -807,6 +944,35 @@ func (cs *CatchpointCatchupService) updateBlockRetrievalStatistics(acquiredBlock
 	cs.stats.VerifiedBlocks = uint64(int64(cs.stats.VerifiedBlocks) + verifiedBlocksDelta)
 }
 
+// getLedgerDownloadPeers selects up to CatchupLedgerDownloadParallelism distinct peers to download the catchpoint file from.
+// When more than a single peer is returned, all of them are HTTP peers supporting partial catchpoint file downloads.
+func (cs *CatchpointCatchupService) getLedgerDownloadPeers(wholeFileOnly map[string]bool) ([]*peerSelectorPeer, error) {
+	psp, err := cs.blocksDownloadPeerSelector.getNextPeer()
+	if err != nil {
+		return nil, err
+	}
+	psps := []*peerSelectorPeer{psp}
+	if _, ok := psp.Peer.(network.HTTPPeer); !ok || wholeFileOnly[peerAddress(psp.Peer)] {
+		return psps, nil
+	}
+	selected := map[string]bool{peerAddress(psp.Peer): true}
+	// the peer selector picks peers at random out of the best ranked ones, so a few more picks are
+	// made to gather distinct peers.
+	for picks := 0; picks < 2*cs.config.CatchupLedgerDownloadParallelism && len(psps) < cs.config.CatchupLedgerDownloadParallelism; picks++ {
+		psp, err = cs.blocksDownloadPeerSelector.getNextPeer()
+		if err != nil {
+			break
+		}
+		address := peerAddress(psp.Peer)
+		if _, ok := psp.Peer.(network.HTTPPeer); !ok || selected[address] || wholeFileOnly[address] {
+			continue
+		}
+		selected[address] = true
+		psps = append(psps, psp)
+	}
+	return psps, nil
+}
+
 func (cs *CatchpointCatchupService) initDownloadPeerSelector() {
 	cs.blocksDownloadPeerSelector = makeCatchpointPeerSelector(cs.net)
 }
@@ -836,3 +1002,46 @@ func (cs *CatchpointCatchupService) checkLedgerDownload() error {
 	}
 	return fmt.Errorf("checkLedgerDownload(): catchpoint '%s' unavailable from peers: %s", cs.stats.CatchpointLabel, err)
 }
+
+// checkLedgerFile resolves the local catchpoint file, and validates it is readable before actually starting the catchup process.
+func (cs *CatchpointCatchupService) checkLedgerFile() error {
+	path, err := ResolveCatchpointFile(cs.catchpointFile, cs.stats.CatchpointLabel)
+	if err != nil {
+		return fmt.Errorf("checkLedgerFile(): %w", err)
+	}
+	cs.catchpointFile = path
+	return nil
+}
+
+// ResolveCatchpointFile returns the path of the local catchpoint file for the given catchpoint label. The given path is
+// either the one of the catchpoint file itself, or the one of a directory holding catchpoint files, in which case the
+// catchpoint file is picked by the round of the label : it is either named after the round, as in 1000000.catchpoint,
+// or laid out as in the catchpoints directory of a node generating catchpoint files.
+func ResolveCatchpointFile(path string, label string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.Mode().IsRegular() {
+		return path, nil
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("catchpoint file %s is neither a regular file nor a directory", path)
+	}
+
+	round, _, err := ledgercore.ParseCatchpointLabel(label)
+	if err != nil {
+		return "", err
+	}
+	candidates := []string{
+		filepath.Join(path, strconv.FormatUint(uint64(round), 10)+".catchpoint"),
+		filepath.Join(path, trackerdb.MakeCatchpointFilePath(round)),
+	}
+	for _, candidate := range candidates {
+		info, err = os.Stat(candidate)
+		if err == nil && info.Mode().IsRegular() {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no catchpoint file for round %d in directory %s", round, path)
+}
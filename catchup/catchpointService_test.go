@@ -18,17 +18,21 @@ package catchup
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/DePINNetwork/depin-sdk/agreement"
 	"github.com/DePINNetwork/depin-sdk/components/mocks"
+	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/crypto"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
 	"github.com/DePINNetwork/depin-sdk/ledger"
 	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
+	"github.com/DePINNetwork/depin-sdk/ledger/store/trackerdb"
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
@@ -159,3 +163,195 @@ func TestProcessStageBlocksDownloadNilCert(t *testing.T) {
 	err = cs.processStageBlocksDownload()
 	require.NoError(t, err)
 }
+
+func TestResolveCatchpointFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	label := "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+
+	// a catchpoint file is used as is, whatever its name
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catchpoint.tar")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+	resolved, err := ResolveCatchpointFile(path, label)
+	require.NoError(t, err)
+	require.Equal(t, path, resolved)
+
+	// in a directory, the catchpoint file is picked by the round of the label
+	_, err = ResolveCatchpointFile(dir, label)
+	require.ErrorContains(t, err, "no catchpoint file for round 1000")
+	path = filepath.Join(dir, "1000.catchpoint")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+	resolved, err = ResolveCatchpointFile(dir, label)
+	require.NoError(t, err)
+	require.Equal(t, path, resolved)
+
+	// including the catchpoints directory of a node
+	dir = t.TempDir()
+	path = filepath.Join(dir, trackerdb.MakeCatchpointFilePath(1000))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+	resolved, err = ResolveCatchpointFile(dir, label)
+	require.NoError(t, err)
+	require.Equal(t, path, resolved)
+
+	_, err = ResolveCatchpointFile(dir, "bad catchpoint")
+	require.Error(t, err)
+	_, err = ResolveCatchpointFile(filepath.Join(dir, "missing"), label)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+type catchpointFileAccessorMock struct {
+	catchpointCatchupAccessorMock
+	label string
+	file  string
+}
+
+func (m *catchpointFileAccessorMock) GetLabel(ctx context.Context) (string, error) {
+	return m.label, nil
+}
+
+func (m *catchpointFileAccessorMock) SetLabel(ctx context.Context, label string) error {
+	m.label = label
+	return nil
+}
+
+func (m *catchpointFileAccessorMock) GetCatchpointFile(ctx context.Context) (string, error) {
+	return m.file, nil
+}
+
+func (m *catchpointFileAccessorMock) SetCatchpointFile(ctx context.Context, path string) error {
+	m.file = path
+	return nil
+}
+
+type catchpointCatchupNodeMock struct{}
+
+func (n *catchpointCatchupNodeMock) SetCatchpointCatchupMode(bool) <-chan context.Context {
+	ch := make(chan context.Context)
+	close(ch)
+	return ch
+}
+
+// TestCatchpointServiceResumedFile checks the local catchpoint file survives a restart, and that the resumed catchup
+// is aborted rather than falling back to peers when the catchpoint file is gone.
+func TestCatchpointServiceResumedFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	label := "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	dir := t.TempDir()
+	path := filepath.Join(dir, "1000.catchpoint")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+
+	l := catchpointCatchupLedger{}
+	a := catchpointFileAccessorMock{catchpointCatchupAccessorMock: catchpointCatchupAccessorMock{l: &l}}
+	cs, err := MakeNewCatchpointCatchupService(label, dir, &catchpointCatchupNodeMock{}, logging.TestingLog(t), nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.NoError(t, cs.checkLedgerFile())
+	cs.ctx, cs.cancelCtxFunc = context.WithCancel(context.Background())
+	defer cs.cancelCtxFunc()
+	require.NoError(t, cs.processStageInactive())
+	require.Equal(t, label, a.label)
+	require.Equal(t, path, a.file)
+
+	resumed, err := MakeResumedCatchpointCatchupService(context.Background(), &catchpointCatchupNodeMock{}, logging.TestingLog(t), nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.Equal(t, path, resumed.catchpointFile)
+
+	require.NoError(t, os.Remove(path))
+	resumed.ctx, resumed.cancelCtxFunc = context.WithCancel(context.Background())
+	defer resumed.cancelCtxFunc()
+	err = resumed.processStageLedgerDownload()
+	require.ErrorContains(t, err, "processStageLedgerLoad failed to load catchpoint file "+path)
+}
+
+// catchpointStagingAccessorMock keeps the catchup stage and the staging progress across restarts, the way the ledger does
+type catchpointStagingAccessorMock struct {
+	catchpointFileAccessorMock
+	state    ledger.CatchpointCatchupState
+	staged   uint64
+	progress ledger.CatchpointCatchupAccessorProgress
+	resets   int
+	entries  []string
+}
+
+func (m *catchpointStagingAccessorMock) GetState(ctx context.Context) (ledger.CatchpointCatchupState, error) {
+	return m.state, nil
+}
+
+func (m *catchpointStagingAccessorMock) SetState(ctx context.Context, state ledger.CatchpointCatchupState) error {
+	m.state = state
+	return nil
+}
+
+func (m *catchpointStagingAccessorMock) ResetStagingBalances(ctx context.Context, newCatchup bool) error {
+	m.resets++
+	m.staged = 0
+	m.progress = ledger.CatchpointCatchupAccessorProgress{}
+	m.entries = nil
+	return nil
+}
+
+func (m *catchpointStagingAccessorMock) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	m.entries = append(m.entries, sectionName)
+	progress.ProcessedBytes += uint64(len(bytes))
+	return nil
+}
+
+func (m *catchpointStagingAccessorMock) GetStagingProgress(ctx context.Context) (uint64, ledger.CatchpointCatchupAccessorProgress, error) {
+	return m.staged, m.progress, nil
+}
+
+func (m *catchpointStagingAccessorMock) SetStagingProgress(ctx context.Context, staged uint64, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	m.staged = staged
+	m.progress = *progress
+	return nil
+}
+
+// TestCatchpointServiceResumedStaging checks a catchup resumed after a restart carries on staging from the first
+// catchpoint file entry that was not staged before the restart, rather than starting over.
+func TestCatchpointServiceResumedStaging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	label := "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	entries := makeTestCatchpointEntries(5)
+	entries[0].data = protocol.Encode(&ledger.CatchpointFileHeader{Version: ledger.CatchpointFileVersionV8, Catchpoint: label})
+	path := writeTestCatchpointFile(t, entries, ledger.CatchpointFileEncodingZstd)
+
+	l := catchpointCatchupLedger{}
+	a := catchpointStagingAccessorMock{catchpointFileAccessorMock: catchpointFileAccessorMock{catchpointCatchupAccessorMock: catchpointCatchupAccessorMock{l: &l}}}
+	cs, err := MakeNewCatchpointCatchupService(label, path, &catchpointCatchupNodeMock{}, logging.TestingLog(t), nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	cs.ctx, cs.cancelCtxFunc = context.WithCancel(context.Background())
+	defer cs.cancelCtxFunc()
+	require.NoError(t, cs.processStageInactive())
+	require.Equal(t, ledger.CatchpointCatchupState(ledger.CatchpointCatchupStateLedgerDownload), a.state)
+
+	// the node restarts once the first three entries are staged
+	a.entries = catchpointEntryNames(entries[:3])
+	a.staged = 3
+	a.progress = ledger.CatchpointCatchupAccessorProgress{SeenHeader: true, ProcessedBytes: 100}
+
+	resumed, err := MakeResumedCatchpointCatchupService(context.Background(), &catchpointCatchupNodeMock{}, logging.TestingLog(t), nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	require.NotNil(t, resumed.resumedDownload)
+	require.Equal(t, uint64(3), resumed.resumedDownload.staged)
+	resumed.ctx, resumed.cancelCtxFunc = context.WithCancel(context.Background())
+	defer resumed.cancelCtxFunc()
+	require.NoError(t, resumed.processStageLedgerDownload())
+
+	require.Zero(t, a.resets)
+	require.Equal(t, catchpointEntryNames(entries), a.entries)
+	require.Equal(t, uint64(len(entries)), a.staged)
+	require.Greater(t, a.progress.ProcessedBytes, uint64(100))
+	require.Equal(t, ledger.CatchpointCatchupState(ledger.CatchpointCatchupStateLatestBlockDownload), a.state)
+
+	// a new catchup starts over
+	cs, err = MakeNewCatchpointCatchupService(label, path, &catchpointCatchupNodeMock{}, logging.TestingLog(t), nil, &a, config.GetDefaultLocal())
+	require.NoError(t, err)
+	cs.ctx, cs.cancelCtxFunc = context.WithCancel(context.Background())
+	defer cs.cancelCtxFunc()
+	require.NoError(t, cs.processStageLedgerDownload())
+	require.Equal(t, 1, a.resets)
+	require.Equal(t, catchpointEntryNames(entries), a.entries)
+}
@@ -18,20 +18,29 @@ package catchup
 
 import (
 	"archive/tar"
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/DePINNetwork/msgp/msgp"
+
 	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/ledger"
 	"github.com/DePINNetwork/depin-sdk/ledger/encoded"
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/network"
+	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/rpcs"
 	"github.com/DePINNetwork/depin-sdk/util"
 )
@@ -45,14 +54,63 @@ const (
 	defaultMinCatchpointFileDownloadBytesPerSecond = 20 * 1024
 	// catchpointFileStreamReadSize defines the number of bytes we would attempt to read at each iteration from the incoming http data stream
 	catchpointFileStreamReadSize = 4096
+	// catchpointEntriesPerRequest is the number of catchpoint file entries requested from a peer at once when downloading
+	// the catchpoint file from multiple peers in parallel
+	catchpointEntriesPerRequest = 16
 )
 
 var errNonHTTPPeer = fmt.Errorf("downloadLedger : non-HTTPPeer encountered")
 
+// errInvalidCatchpointEntry is returned when a peer serves a malformed catchpoint file entry
+var errInvalidCatchpointEntry = errors.New("invalid catchpoint file entry")
+
+// errLedgerEntriesUnsupported is returned when a peer serves the entire catchpoint file rather than the requested entries
+var errLedgerEntriesUnsupported = errors.New("peer does not support partial catchpoint file downloads")
+
 type ledgerFetcherReporter interface {
 	updateLedgerFetcherProgress(*ledger.CatchpointCatchupAccessorProgress)
 }
 
+// catchpointDownload tracks the catchpoint file entries staged so far, allowing an interrupted
+// download to resume from the first entry that was not staged yet.
+type catchpointDownload struct {
+	progress ledger.CatchpointCatchupAccessorProgress
+	// staged is the number of catchpoint file entries staged so far
+	staged uint64
+	// corrupted is set once staging an entry fails, leaving the staging tables in an unknown state;
+	// the download has to start over in that case.
+	corrupted     bool
+	writeDuration time.Duration
+}
+
+// catchpointEntry is a single entry of the catchpoint file tar archive
+type catchpointEntry struct {
+	name string
+	data []byte
+}
+
+// catchpointEntriesBatch is a batch of consecutive catchpoint file entries downloaded from a single peer
+type catchpointEntriesBatch struct {
+	index   uint64
+	peer    network.HTTPPeer
+	entries []catchpointEntry
+	err     error
+}
+
+// ledgerPeerError is returned when downloading a part of the catchpoint file from one of several peers fails
+type ledgerPeerError struct {
+	peer network.HTTPPeer
+	err  error
+}
+
+func (e *ledgerPeerError) Error() string {
+	return fmt.Sprintf("peer %s : %v", e.peer.GetAddress(), e.err)
+}
+
+func (e *ledgerPeerError) Unwrap() error {
+	return e.err
+}
+
 type ledgerFetcher struct {
 	net      network.GossipNode
 	accessor ledger.CatchpointCatchupAccessor
@@ -72,8 +130,11 @@ func makeLedgerFetcher(net network.GossipNode, accessor ledger.CatchpointCatchup
 	}
 }
 
-func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, method string) (*http.Response, error) {
+func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, method string, query url.Values) (*http.Response, error) {
 	ledgerURL := network.SubstituteGenesisID(lf.net, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36))
+	if len(query) > 0 {
+		ledgerURL += "?" + query.Encode()
+	}
 	lf.log.Debugf("ledger %s %#v peer %#v %T", method, ledgerURL, peer, peer)
 	request, err := http.NewRequestWithContext(ctx, method, ledgerURL, nil)
 	if err != nil {
@@ -81,6 +142,7 @@ func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPee
 	}
 
 	network.SetUserAgentHeader(request.Header)
+	request.Header.Set("Accept-Encoding", ledger.CatchpointFileEncodingZstd+", "+ledger.CatchpointFileEncodingGzip)
 	httpClient := peer.GetHTTPClient()
 	if httpClient == nil {
 		return nil, fmt.Errorf("requestLedger: HTTPPeer %s has no http client", peer.GetAddress())
@@ -95,7 +157,7 @@ func (lf *ledgerFetcher) headLedger(ctx context.Context, peer network.Peer, roun
 	}
 	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
 	defer timeoutContextCancel()
-	response, err := lf.requestLedger(timeoutContext, httpPeer, round, http.MethodHead)
+	response, err := lf.requestLedger(timeoutContext, httpPeer, round, http.MethodHead, nil)
 	if err != nil {
 		lf.log.Debugf("getPeerLedger HEAD : %s", err)
 		return err
@@ -113,46 +175,260 @@ func (lf *ledgerFetcher) headLedger(ctx context.Context, peer network.Peer, roun
 	}
 }
 
-func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer, round basics.Round) error {
+// downloadLedger downloads the catchpoint file entries not staged yet by dl from a single peer.
+func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer, round basics.Round, dl *catchpointDownload) error {
 	httpPeer, ok := peer.(network.HTTPPeer)
 	if !ok {
 		return errNonHTTPPeer
 	}
-	return lf.getPeerLedger(ctx, httpPeer, round)
+	return lf.getPeerLedger(ctx, httpPeer, round, dl)
 }
 
-func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, dl *catchpointDownload) error {
 	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
 	defer timeoutContextCancel()
-	response, err := lf.requestLedger(timeoutContext, peer, round, http.MethodGet)
+
+	// when resuming an interrupted download, ask the peer to skip the entries we've already staged.
+	var query url.Values
+	if dl.staged > 0 {
+		query = url.Values{}
+		query.Set(rpcs.LedgerEntriesStartParam, strconv.FormatUint(dl.staged, 10))
+	}
+	response, err := lf.requestLedger(timeoutContext, peer, round, http.MethodGet, query)
 	if err != nil {
 		lf.log.Debugf("getPeerLedger GET : %s", err)
 		return err
 	}
 	defer response.Body.Close()
 
+	err = checkLedgerResponse("getPeerLedger", response)
+	if err != nil {
+		return err
+	}
+	body, err := decodeLedgerResponse(response)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// peers that don't support partial downloads send the entire catchpoint file, in which case
+	// we skip the entries we've already staged ourselves.
+	first := uint64(0)
+	if startHeader := response.Header.Get(rpcs.LedgerEntriesStartHeader); startHeader != "" {
+		if startHeader != strconv.FormatUint(dl.staged, 10) {
+			return fmt.Errorf("getPeerLedger : %w: requested entries starting at %d, received entries starting at %s", errInvalidCatchpointEntry, dl.staged, startHeader)
+		}
+		first = dl.staged
+	}
+
+	err = lf.readLedgerEntries(body, first, func(index uint64, name string, data []byte) (bool, error) {
+		if index < dl.staged {
+			return true, nil
+		}
+		return true, lf.stageEntry(ctx, dl, name, data)
+	})
+	if err != nil {
+		return err
+	}
+	lf.logWriteDurations(dl)
+	return nil
+}
+
+// downloadLedgerEntries downloads the catchpoint file entries not staged yet by dl from the given peers in parallel,
+// each peer serving batches of catchpointEntriesPerRequest consecutive entries. The entries are staged in order as
+// they arrive; when a peer fails, the entries staged so far are kept so that the download can be resumed.
+func (lf *ledgerFetcher) downloadLedgerEntries(ctx context.Context, peers []network.HTTPPeer, round basics.Round, dl *catchpointDownload) error {
+	ctx, cancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
+
+	// the number of batches downloaded but not staged yet is limited, bounding the memory the download takes
+	window := 2 * len(peers)
+	slots := make(chan struct{}, window)
+	results := make(chan catchpointEntriesBatch, window)
+	base := dl.staged
+	var nextBatch atomic.Uint64
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for _, peer := range peers {
+		wg.Add(1)
+		go func(peer network.HTTPPeer) {
+			defer wg.Done()
+			for {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				batch := catchpointEntriesBatch{index: nextBatch.Add(1) - 1, peer: peer}
+				batch.entries, batch.err = lf.getPeerLedgerEntries(ctx, peer, round, base+batch.index*catchpointEntriesPerRequest, catchpointEntriesPerRequest)
+				select {
+				case results <- batch:
+				case <-ctx.Done():
+					return
+				}
+				// a short batch marks the end of the catchpoint file.
+				if batch.err != nil || len(batch.entries) < catchpointEntriesPerRequest {
+					return
+				}
+			}
+		}(peer)
+	}
+
+	pending := make(map[uint64]catchpointEntriesBatch, window)
+	for expected := uint64(0); ; expected++ {
+		batch, has := pending[expected]
+		for !has {
+			select {
+			case received := <-results:
+				pending[received.index] = received
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			batch, has = pending[expected]
+		}
+		delete(pending, expected)
+		if batch.err != nil {
+			return &ledgerPeerError{peer: batch.peer, err: batch.err}
+		}
+		for _, entry := range batch.entries {
+			err := lf.stageEntry(ctx, dl, entry.name, entry.data)
+			if err != nil {
+				return &ledgerPeerError{peer: batch.peer, err: err}
+			}
+		}
+		<-slots
+		if len(batch.entries) < catchpointEntriesPerRequest {
+			lf.logWriteDurations(dl)
+			return nil
+		}
+	}
+}
+
+// getPeerLedgerEntries downloads up to count catchpoint file entries from the peer, starting at the entry with index start.
+func (lf *ledgerFetcher) getPeerLedgerEntries(ctx context.Context, peer network.HTTPPeer, round basics.Round, start, count uint64) ([]catchpointEntry, error) {
+	query := url.Values{}
+	query.Set(rpcs.LedgerEntriesStartParam, strconv.FormatUint(start, 10))
+	query.Set(rpcs.LedgerEntriesCountParam, strconv.FormatUint(count, 10))
+	response, err := lf.requestLedger(ctx, peer, round, http.MethodGet, query)
+	if err != nil {
+		lf.log.Debugf("getPeerLedgerEntries GET : %s", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	err = checkLedgerResponse("getPeerLedgerEntries", response)
+	if err != nil {
+		return nil, err
+	}
+	body, err := decodeLedgerResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	switch startHeader := response.Header.Get(rpcs.LedgerEntriesStartHeader); startHeader {
+	case strconv.FormatUint(start, 10):
+	case "":
+		return nil, errLedgerEntriesUnsupported
+	default:
+		return nil, fmt.Errorf("getPeerLedgerEntries : %w: requested entries starting at %d, received entries starting at %s", errInvalidCatchpointEntry, start, startHeader)
+	}
+
+	entries := make([]catchpointEntry, 0, count)
+	err = lf.readLedgerEntries(body, start, func(index uint64, name string, data []byte) (bool, error) {
+		entries = append(entries, catchpointEntry{name: name, data: data})
+		return uint64(len(entries)) < count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// loadLedgerFile stages the catchpoint file entries not staged yet by dl off a local catchpoint file, either compressed
+// or not, the way `catchpointdump file` reads it. The file has to hold the catchpoint with the given label.
+func (lf *ledgerFetcher) loadLedgerFile(ctx context.Context, path string, label string, dl *catchpointDownload) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stream, err := ledger.MakeCatchpointFileReader(bufio.NewReader(file))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	err = lf.readLedgerEntries(stream, 0, func(index uint64, name string, data []byte) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if name == ledger.CatchpointContentFileName {
+			// check the label upfront, rather than finding out once the whole file was loaded.
+			var fileHeader ledger.CatchpointFileHeader
+			err := protocol.Decode(data, &fileHeader)
+			if err != nil {
+				return false, fmt.Errorf("loadLedgerFile : %w: unable to decode the catchpoint file header : %v", errInvalidCatchpointEntry, err)
+			}
+			if fileHeader.Catchpoint != label {
+				return false, fmt.Errorf("loadLedgerFile : catchpoint file %s holds catchpoint '%s' rather than '%s'", path, fileHeader.Catchpoint, label)
+			}
+		}
+		if index < dl.staged {
+			return true, nil
+		}
+		return true, lf.stageEntry(ctx, dl, name, data)
+	})
+	if err != nil {
+		return err
+	}
+	if dl.staged == 0 {
+		return fmt.Errorf("loadLedgerFile : catchpoint file %s is empty", path)
+	}
+	lf.logWriteDurations(dl)
+	return nil
+}
+
+// checkLedgerResponse checks the response to a catchpoint file download request is successful and holds a catchpoint file.
+func checkLedgerResponse(caller string, response *http.Response) error {
 	// check to see that we had no errors.
 	switch response.StatusCode {
 	case http.StatusOK:
 	case http.StatusNotFound: // server could not find a block with that round numbers.
 		return errNoLedgerForRound
 	default:
-		return fmt.Errorf("getPeerLedger error response status code %d", response.StatusCode)
+		return fmt.Errorf("%s error response status code %d", caller, response.StatusCode)
 	}
 
 	// at this point, we've already received the response headers. ensure that the
 	// response content type is what we'd like it to be.
 	contentTypes := response.Header["Content-Type"]
 	if len(contentTypes) != 1 {
-		err = fmt.Errorf("getPeerLedger : http ledger fetcher invalid content type count %d", len(contentTypes))
-		return err
+		return fmt.Errorf("%s : http ledger fetcher invalid content type count %d", caller, len(contentTypes))
 	}
 
 	if contentTypes[0] != rpcs.LedgerResponseContentType {
-		err = fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0])
-		return err
+		return fmt.Errorf("%s : http ledger fetcher response has an invalid content type : %s", caller, contentTypes[0])
 	}
+	return nil
+}
 
+// decodeLedgerResponse returns a reader of the catchpoint file response body, decompressing it according to its content encoding.
+func decodeLedgerResponse(response *http.Response) (io.ReadCloser, error) {
+	switch encoding := response.Header.Get("Content-Encoding"); encoding {
+	case "", ledger.CatchpointFileEncodingGzip, ledger.CatchpointFileEncodingZstd:
+		return ledger.NewCatchpointFileDecoder(response.Body, encoding)
+	default:
+		return nil, fmt.Errorf("http ledger fetcher response has an unsupported content encoding : %s", encoding)
+	}
+}
+
+// readLedgerEntries reads the catchpoint file entries off the tar stream, the first of which has the given index,
+// verifying each of them and passing it to process, until the stream ends or process returns false.
+func (lf *ledgerFetcher) readLedgerEntries(body io.Reader, first uint64, process func(index uint64, name string, data []byte) (bool, error)) error {
 	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
 	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
 	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
@@ -161,61 +437,101 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
 	}
 
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
+	watchdogReader := util.MakeWatchdogStreamReader(body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
 	defer watchdogReader.Close()
 	tarReader := tar.NewReader(watchdogReader)
-	var downloadProgress ledger.CatchpointCatchupAccessorProgress
-	var writeDuration time.Duration
-
-	printLogsFunc := func() {
-		lf.log.Infof(
-			"writing balances to disk took %d seconds, "+
-				"writing creatables to disk took %d seconds, "+
-				"writing hashes to disk took %d seconds, "+
-				"writing kv pairs to disk took %d seconds, "+
-				"total duration is %d seconds",
-			downloadProgress.BalancesWriteDuration/time.Second,
-			downloadProgress.CreatablesWriteDuration/time.Second,
-			downloadProgress.HashesWriteDuration/time.Second,
-			downloadProgress.KVWriteDuration/time.Second,
-			writeDuration/time.Second)
-	}
-
-	for {
+
+	for index := first; ; index++ {
 		header, err := tarReader.Next()
 		if err != nil {
 			if err == io.EOF {
-				printLogsFunc()
 				return nil
 			}
 			return err
 		}
 		if header.Size > maxCatchpointFileChunkSize || header.Size < 1 {
-			return fmt.Errorf("getPeerLedger received a tar header with data size of %d", header.Size)
+			return fmt.Errorf("%w: received a tar header with data size of %d", errInvalidCatchpointEntry, header.Size)
 		}
-		balancesBlockBytes := make([]byte, header.Size)
-		_, err = io.ReadFull(tarReader, balancesBlockBytes)
+		data := make([]byte, header.Size)
+		_, err = io.ReadFull(tarReader, data)
 		if err != nil {
 			return err
 		}
-		start := time.Now()
-		err = lf.processBalancesBlock(ctx, header.Name, balancesBlockBytes, &downloadProgress)
+		err = verifyCatchpointEntry(index, header.Name, data)
 		if err != nil {
 			return err
 		}
-		writeDuration += time.Since(start)
-		if lf.reporter != nil {
-			lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
+		more, err := process(index, header.Name, data)
+		if err != nil || !more {
+			return err
 		}
 		if err = watchdogReader.Reset(); err != nil {
 			if err == io.EOF {
-				printLogsFunc()
 				return nil
 			}
-			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
-			return err
+			return fmt.Errorf("readLedgerEntries received the following error while reading the catchpoint file : %v", err)
+		}
+	}
+}
+
+// verifyCatchpointEntry checks a downloaded catchpoint file entry is well-formed before it gets staged:
+// the content header comes first and only first, and every msgpack entry holds exactly one encoded object.
+func verifyCatchpointEntry(index uint64, name string, data []byte) error {
+	if (index == 0) != (name == ledger.CatchpointContentFileName) {
+		return fmt.Errorf("%w: unexpected entry '%s' at index %d", errInvalidCatchpointEntry, name, index)
+	}
+	if !strings.HasSuffix(name, ".msgpack") {
+		// unknown sections are passed through, to support backward compatibility.
+		return nil
+	}
+	rest, err := msgp.Skip(data)
+	if err != nil {
+		return fmt.Errorf("%w: entry '%s' could not be decoded : %v", errInvalidCatchpointEntry, name, err)
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("%w: entry '%s' has %d trailing bytes", errInvalidCatchpointEntry, name, len(rest))
+	}
+	return nil
+}
+
+// stageEntry stages the next catchpoint file entry of the download.
+func (lf *ledgerFetcher) stageEntry(ctx context.Context, dl *catchpointDownload, name string, data []byte) error {
+	start := time.Now()
+	err := lf.processBalancesBlock(ctx, name, data, &dl.progress)
+	if err != nil {
+		if errors.Is(err, ledger.ErrCatchpointChunkHashMismatch) {
+			// the chunk got rejected before being staged, so it can be downloaded again from another peer.
+			return fmt.Errorf("%w: %w", errInvalidCatchpointEntry, err)
 		}
+		dl.corrupted = true
+		return err
+	}
+	dl.writeDuration += time.Since(start)
+	dl.staged++
+	// should storing the progress fail, a restarted node would stage some of the entries again, finding the staging
+	// tables corrupted and starting over; the download itself can go on.
+	err = lf.accessor.SetStagingProgress(ctx, dl.staged, &dl.progress)
+	if err != nil {
+		lf.log.Warnf("stageEntry: unable to store the staging progress : %v", err)
 	}
+	if lf.reporter != nil {
+		lf.reporter.updateLedgerFetcherProgress(&dl.progress)
+	}
+	return nil
+}
+
+func (lf *ledgerFetcher) logWriteDurations(dl *catchpointDownload) {
+	lf.log.Infof(
+		"writing balances to disk took %d seconds, "+
+			"writing creatables to disk took %d seconds, "+
+			"writing hashes to disk took %d seconds, "+
+			"writing kv pairs to disk took %d seconds, "+
+			"total duration is %d seconds",
+		dl.progress.BalancesWriteDuration/time.Second,
+		dl.progress.CreatablesWriteDuration/time.Second,
+		dl.progress.HashesWriteDuration/time.Second,
+		dl.progress.KVWriteDuration/time.Second,
+		dl.writeDuration/time.Second)
 }
 
 func (lf *ledgerFetcher) processBalancesBlock(ctx context.Context, sectionName string, bytes []byte, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
@@ -18,12 +18,22 @@ package catchup
 
 import (
 	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
+	"github.com/DataDog/zstd"
+	"github.com/DePINNetwork/go-deadlock"
+	"github.com/DePINNetwork/msgp/msgp"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
 
 	"github.com/DePINNetwork/depin-sdk/components/mocks"
@@ -31,7 +41,9 @@ import (
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/ledger"
 	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
 	p2ptesting "github.com/DePINNetwork/depin-sdk/network/p2p/testing"
+	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/rpcs"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
 )
@@ -47,7 +59,7 @@ func TestNoPeersAvailable(t *testing.T) {
 
 	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 	peer := &lf // The peer is an opaque interface.. we can add anything as a Peer.
-	err := lf.downloadLedger(context.Background(), peer, basics.Round(0))
+	err := lf.downloadLedger(context.Background(), peer, basics.Round(0), &catchpointDownload{})
 	require.Equal(t, errNonHTTPPeer, err)
 }
 
@@ -56,7 +68,7 @@ func TestNonParsableAddress(t *testing.T) {
 
 	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 	peer := testHTTPPeer(":def")
-	err := lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+	err := lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &catchpointDownload{})
 	require.Error(t, err)
 }
 
@@ -121,7 +133,7 @@ func TestLedgerFetcherErrorResponseHandling(t *testing.T) {
 			})
 			lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
 			peer := testHTTPPeer(listener.Addr().String())
-			err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
+			err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0), &catchpointDownload{})
 			require.Equal(t, tc.err, err)
 		})
 	}
@@ -175,7 +187,7 @@ func TestLedgerFetcher(t *testing.T) {
 	require.NoError(t, err)
 
 	httpServerResponse = http.StatusOK
-	err = lf.downloadLedger(context.Background(), &successPeer, basics.Round(0))
+	err = lf.downloadLedger(context.Background(), &successPeer, basics.Round(0), &catchpointDownload{})
 	require.NoError(t, err)
 
 	// headLedger 500 response
@@ -214,6 +226,383 @@ func TestLedgerFetcherP2P(t *testing.T) {
 	require.NoError(t, err)
 
 	httpServerResponse = http.StatusOK
-	err = lf.downloadLedger(context.Background(), successPeer, basics.Round(0))
+	err = lf.downloadLedger(context.Background(), successPeer, basics.Round(0), &catchpointDownload{})
 	require.NoError(t, err)
 }
+
+// recordingCatchupAccessor records the names of the catchpoint file entries it stages
+type recordingCatchupAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	staged []string
+	// reject optionally fails staging an entry, leaving it unstaged
+	reject func(sectionName string) error
+}
+
+func (a *recordingCatchupAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	if a.reject != nil {
+		if err := a.reject(sectionName); err != nil {
+			return err
+		}
+	}
+	a.staged = append(a.staged, sectionName)
+	return nil
+}
+
+// writeTestCatchpointTar writes the catchpoint file entries as a tar archive compressed with the given encoding
+func writeTestCatchpointTar(w io.Writer, entries []catchpointEntry, encoding string) error {
+	compressor := io.WriteCloser(nopWriteCloser{w})
+	switch encoding {
+	case ledger.CatchpointFileEncodingGzip:
+		compressor = gzip.NewWriter(w)
+	case ledger.CatchpointFileEncodingZstd:
+		compressor = zstd.NewWriter(w)
+	}
+	wtar := tar.NewWriter(compressor)
+	for _, entry := range entries {
+		err := wtar.WriteHeader(&tar.Header{Name: entry.name, Mode: 0600, Size: int64(len(entry.data))})
+		if err != nil {
+			return err
+		}
+		_, err = wtar.Write(entry.data)
+		if err != nil {
+			return err
+		}
+	}
+	err := wtar.Close()
+	if err != nil {
+		return err
+	}
+	return compressor.Close()
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+type testCatchpointLedger struct {
+	entries []catchpointEntry
+	// encoding is the content encoding of the catchpoint file, gzip unless set
+	encoding string
+}
+
+func (l *testCatchpointLedger) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	encoding := l.encoding
+	if encoding == "" {
+		encoding = ledger.CatchpointFileEncodingGzip
+	}
+	buf := bytes.NewBuffer(nil)
+	err := writeTestCatchpointTar(buf, l.entries, encoding)
+	if err != nil {
+		return nil, err
+	}
+	return testCatchpointStream{bytes.NewReader(buf.Bytes())}, nil
+}
+
+type testCatchpointStream struct {
+	*bytes.Reader
+}
+
+func (s testCatchpointStream) Size() (int64, error) {
+	return s.Reader.Size(), nil
+}
+
+func (s testCatchpointStream) Close() error {
+	return nil
+}
+
+type testLedgerServiceRouter struct {
+	*mux.Router
+}
+
+func (r testLedgerServiceRouter) RegisterHTTPHandler(path string, handler http.Handler) {
+	r.Handle(path, handler)
+}
+
+func makeTestCatchpointEntries(numChunks int) []catchpointEntry {
+	entries := []catchpointEntry{{name: ledger.CatchpointContentFileName, data: msgp.AppendString(nil, ledger.CatchpointContentFileName)}}
+	for i := 1; i <= numChunks; i++ {
+		name := fmt.Sprintf("balances.%d.msgpack", i)
+		entries = append(entries, catchpointEntry{name: name, data: msgp.AppendString(nil, name)})
+	}
+	return entries
+}
+
+func catchpointEntryNames(entries []catchpointEntry) (names []string) {
+	for _, entry := range entries {
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+// startTestCatchpointServer serves the catchpoint file entries through a ledger service, passing requests through
+// the optional filter first. A filter returning false fails the request.
+func startTestCatchpointServer(t *testing.T, entries []catchpointEntry, filter func(*http.Request) bool) testHTTPPeer {
+	return startTestLedgerServer(t, &testCatchpointLedger{entries: entries}, filter)
+}
+
+func startTestLedgerServer(t *testing.T, l *testCatchpointLedger, filter func(*http.Request) bool) testHTTPPeer {
+	router := testLedgerServiceRouter{mux.NewRouter()}
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	ledgerService := rpcs.MakeLedgerService(cfg, l, router, "test")
+	ledgerService.Start()
+
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	s := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if filter != nil && !filter(req) {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			router.ServeHTTP(w, req)
+		}),
+	}
+	go s.Serve(listener)
+	t.Cleanup(func() {
+		s.Close()
+		ledgerService.Stop()
+	})
+	return testHTTPPeer(listener.Addr().String())
+}
+
+func TestLedgerFetcherEntries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	entries := makeTestCatchpointEntries(3*catchpointEntriesPerRequest + 5)
+	peerA := startTestCatchpointServer(t, entries, nil)
+	peerB := startTestCatchpointServer(t, entries, nil)
+
+	accessor := &recordingCatchupAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	dl := &catchpointDownload{}
+	err := lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peerA, &peerB}, basics.Round(1), dl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(entries)), dl.staged)
+	require.Equal(t, catchpointEntryNames(entries), accessor.staged)
+
+	// a catchpoint file made of whole batches ends with an empty batch
+	entries = makeTestCatchpointEntries(2*catchpointEntriesPerRequest - 1)
+	peerA = startTestCatchpointServer(t, entries, nil)
+	accessor.staged = nil
+	dl = &catchpointDownload{}
+	err = lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peerA}, basics.Round(1), dl)
+	require.NoError(t, err)
+	require.Equal(t, catchpointEntryNames(entries), accessor.staged)
+}
+
+func TestLedgerFetcherResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	entries := makeTestCatchpointEntries(4 * catchpointEntriesPerRequest)
+	peerA := startTestCatchpointServer(t, entries, nil)
+	// peer B fails serving anything past the second batch
+	failFrom := 2 * catchpointEntriesPerRequest
+	peerB := startTestCatchpointServer(t, entries, func(req *http.Request) bool {
+		start, _ := strconv.Atoi(req.URL.Query().Get(rpcs.LedgerEntriesStartParam))
+		return start < failFrom
+	})
+
+	accessor := &recordingCatchupAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	dl := &catchpointDownload{}
+	err := lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peerB}, basics.Round(1), dl)
+	var peerErr *ledgerPeerError
+	require.ErrorAs(t, err, &peerErr)
+	require.Equal(t, network.HTTPPeer(&peerB), peerErr.peer)
+	require.False(t, dl.corrupted)
+	require.Equal(t, uint64(failFrom), dl.staged)
+
+	// resume from a single peer, which skips the entries already staged
+	err = lf.downloadLedger(context.Background(), &peerA, basics.Round(1), dl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(entries)), dl.staged)
+	require.Equal(t, catchpointEntryNames(entries), accessor.staged)
+}
+
+func TestLedgerFetcherWholeFilePeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	entries := makeTestCatchpointEntries(catchpointEntriesPerRequest + 3)
+	// the peer ignores the entries range, serving the entire catchpoint file
+	peer := startTestCatchpointServer(t, entries, func(req *http.Request) bool {
+		req.URL.RawQuery = ""
+		return true
+	})
+
+	accessor := &recordingCatchupAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	_, err := lf.getPeerLedgerEntries(context.Background(), &peer, basics.Round(1), 0, catchpointEntriesPerRequest)
+	require.ErrorIs(t, err, errLedgerEntriesUnsupported)
+
+	// resuming a download from such a peer skips the entries already staged
+	dl := &catchpointDownload{staged: 5}
+	err = lf.downloadLedger(context.Background(), &peer, basics.Round(1), dl)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(entries)), dl.staged)
+	require.Equal(t, catchpointEntryNames(entries[5:]), accessor.staged)
+}
+
+func TestLedgerFetcherInvalidEntries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		corrupt func([]catchpointEntry) []catchpointEntry
+	}{
+		{"missing header", func(entries []catchpointEntry) []catchpointEntry { return entries[1:] }},
+		{"repeated header", func(entries []catchpointEntry) []catchpointEntry { return append(entries[:2], entries...) }},
+		{"truncated entry", func(entries []catchpointEntry) []catchpointEntry {
+			entries[2].data = entries[2].data[:len(entries[2].data)-1]
+			return entries
+		}},
+		{"trailing bytes", func(entries []catchpointEntry) []catchpointEntry {
+			entries[2].data = append(entries[2].data, 0)
+			return entries
+		}},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			entries := tc.corrupt(makeTestCatchpointEntries(4))
+			peer := startTestCatchpointServer(t, entries, nil)
+			accessor := &recordingCatchupAccessor{}
+			lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+
+			dl := &catchpointDownload{}
+			err := lf.downloadLedger(context.Background(), &peer, basics.Round(1), dl)
+			require.ErrorIs(t, err, errInvalidCatchpointEntry)
+			require.False(t, dl.corrupted)
+
+			accessor.staged = nil
+			dl = &catchpointDownload{}
+			err = lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peer}, basics.Round(1), dl)
+			require.ErrorIs(t, err, errInvalidCatchpointEntry)
+			require.False(t, dl.corrupted)
+			require.Equal(t, dl.staged, uint64(len(accessor.staged)))
+		})
+	}
+}
+
+func TestLedgerFetcherEncodings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	entries := makeTestCatchpointEntries(catchpointEntriesPerRequest + 3)
+	for _, encoding := range []string{ledger.CatchpointFileEncodingGzip, ledger.CatchpointFileEncodingZstd} {
+		var served []string
+		var mu deadlock.Mutex
+		peer := startTestLedgerServer(t, &testCatchpointLedger{entries: entries, encoding: encoding}, func(req *http.Request) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			served = append(served, req.Header.Get("Accept-Encoding"))
+			return true
+		})
+
+		accessor := &recordingCatchupAccessor{}
+		lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+		err := lf.downloadLedger(context.Background(), &peer, basics.Round(1), &catchpointDownload{})
+		require.NoError(t, err, encoding)
+		require.Equal(t, catchpointEntryNames(entries), accessor.staged, encoding)
+
+		accessor.staged = nil
+		err = lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peer}, basics.Round(1), &catchpointDownload{})
+		require.NoError(t, err, encoding)
+		require.Equal(t, catchpointEntryNames(entries), accessor.staged, encoding)
+
+		mu.Lock()
+		for _, acceptEncoding := range served {
+			require.Equal(t, "zstd, gzip", acceptEncoding)
+		}
+		mu.Unlock()
+	}
+}
+
+func TestLedgerFetcherChunkHashMismatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	entries := makeTestCatchpointEntries(catchpointEntriesPerRequest + 3)
+	peer := startTestCatchpointServer(t, entries, nil)
+
+	// the accessor rejects the fifth entry once, as if it didn't match its hash in the catchpoint file header
+	rejected := false
+	accessor := &recordingCatchupAccessor{reject: func(sectionName string) error {
+		if sectionName == entries[5].name && !rejected {
+			rejected = true
+			return fmt.Errorf("%w: chunk '%s'", ledger.ErrCatchpointChunkHashMismatch, sectionName)
+		}
+		return nil
+	}}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{GenesisID: "test"}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+
+	dl := &catchpointDownload{}
+	err := lf.downloadLedgerEntries(context.Background(), []network.HTTPPeer{&peer}, basics.Round(1), dl)
+	require.ErrorIs(t, err, errInvalidCatchpointEntry)
+	require.ErrorIs(t, err, ledger.ErrCatchpointChunkHashMismatch)
+	var peerErr *ledgerPeerError
+	require.ErrorAs(t, err, &peerErr)
+	// the entries staged so far are kept, so the download resumes from the rejected entry
+	require.False(t, dl.corrupted)
+	require.Equal(t, uint64(5), dl.staged)
+
+	err = lf.downloadLedger(context.Background(), &peer, basics.Round(1), dl)
+	require.NoError(t, err)
+	require.Equal(t, catchpointEntryNames(entries), accessor.staged)
+}
+
+func writeTestCatchpointFile(t *testing.T, entries []catchpointEntry, encoding string) string {
+	path := filepath.Join(t.TempDir(), "test.catchpoint")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, writeTestCatchpointTar(file, entries, encoding))
+	return path
+}
+
+func TestLedgerFetcherLoadFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const label = "1000#ABCDEF"
+	entries := makeTestCatchpointEntries(5)
+	entries[0].data = protocol.Encode(&ledger.CatchpointFileHeader{Version: ledger.CatchpointFileVersionV8, Catchpoint: label})
+
+	for _, encoding := range []string{"", ledger.CatchpointFileEncodingGzip, ledger.CatchpointFileEncodingZstd} {
+		path := writeTestCatchpointFile(t, entries, encoding)
+		accessor := &recordingCatchupAccessor{}
+		lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+
+		dl := &catchpointDownload{}
+		err := lf.loadLedgerFile(context.Background(), path, label, dl)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(entries)), dl.staged)
+		require.Equal(t, catchpointEntryNames(entries), accessor.staged)
+
+		// resuming skips the entries already staged
+		accessor.staged = nil
+		dl = &catchpointDownload{staged: 2}
+		err = lf.loadLedgerFile(context.Background(), path, label, dl)
+		require.NoError(t, err)
+		require.Equal(t, catchpointEntryNames(entries[2:]), accessor.staged)
+
+		// a file holding another catchpoint is rejected before anything is staged
+		accessor.staged = nil
+		err = lf.loadLedgerFile(context.Background(), path, "1000#OTHER", &catchpointDownload{})
+		require.ErrorContains(t, err, "rather than '1000#OTHER'")
+		require.Empty(t, accessor.staged)
+	}
+
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &recordingCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	err := lf.loadLedgerFile(context.Background(), filepath.Join(t.TempDir(), "missing"), label, &catchpointDownload{})
+	require.ErrorIs(t, err, os.ErrNotExist)
+	err = lf.loadLedgerFile(context.Background(), writeTestCatchpointFile(t, nil, ledger.CatchpointFileEncodingGzip), label, &catchpointDownload{})
+	require.ErrorContains(t, err, "is empty")
+}
@@ -448,6 +448,8 @@ func peerAddress(peer network.Peer) string {
 		return httpPeer.GetAddress()
 	} else if unicastPeer, ok := peer.(network.UnicastPeer); ok {
 		return unicastPeer.GetAddress()
+	} else if archivePeer, ok := peer.(*blockArchivePeer); ok {
+		return archivePeer.address()
 	}
 	return ""
 }
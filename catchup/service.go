@@ -25,6 +25,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/DePINNetwork/go-deadlock"
+
 	"github.com/DePINNetwork/depin-sdk/agreement"
 	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/crypto"
@@ -36,6 +38,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/util/execpool"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
 )
 
 // uncapParallelDownloadRate is a simple threshold to detect whether the node is caught up.
@@ -48,6 +51,19 @@ const catchupRetryLimit = 500
 
 const followLatestBackoff = 100 * time.Millisecond
 
+var catchupBlocksFetchedTotal = metrics.MakeCounter(metrics.CatchupBlocksFetchedTotal)
+var catchupBlocksWrittenTotal = metrics.MakeCounter(metrics.CatchupBlocksWrittenTotal)
+var catchupTransactionsWrittenTotal = metrics.MakeCounter(metrics.CatchupTransactionsWrittenTotal)
+var catchupBlocksPreverifiedTotal = metrics.MakeCounter(metrics.CatchupBlocksPreverifiedTotal)
+var catchupBlocksPreverifySkippedTotal = metrics.MakeCounter(metrics.CatchupBlocksPreverifySkippedTotal)
+var catchupPreverificationSeconds = metrics.MakeHistogram(metrics.CatchupPreverificationSeconds, metrics.DefaultHistogramBuckets)
+var catchupBlockWriteSeconds = metrics.MakeHistogram(metrics.CatchupBlockWriteSeconds, metrics.DefaultHistogramBuckets)
+var catchupBlocksPerSecond = metrics.MakeGauge(metrics.CatchupBlocksPerSecond)
+var catchupTransactionsPerSecond = metrics.MakeGauge(metrics.CatchupTransactionsPerSecond)
+
+// catchupThroughputWindow is the duration over which the catchup throughput gauges are computed
+const catchupThroughputWindow = 10 * time.Second
+
 // ErrSyncRoundInvalid is returned when the sync round requested is behind the current ledger round
 var ErrSyncRoundInvalid = errors.New("requested sync round cannot be less than the latest round")
 
@@ -70,6 +86,7 @@ type Ledger interface {
 	IsBehindCommittingDeltas() bool
 	Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error)
 	AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error
+	PreverifyBlock(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) error
 	WaitMem(r basics.Round) chan struct{}
 }
 
@@ -117,6 +134,24 @@ type Service struct {
 	// unsupportedRoundMonitor goroutine, after detecting
 	// an unsupported block.
 	onceUnsupportedRound sync.Once
+
+	// blockArchive, when set, is the block archive pseudo-peer that blocks are fetched from before turning to network peers.
+	blockArchive *blockArchivePeer
+
+	// preverifySlots bounds the number of blocks whose signatures are verified ahead of their evaluation at once;
+	// it is nil when the signatures are only verified on evaluation.
+	preverifySlots chan struct{}
+
+	// throughput accounts the blocks and transactions written over the current throughput window
+	throughputMu deadlock.Mutex
+	throughput   catchupThroughput
+}
+
+// catchupThroughput accounts the blocks and transactions written since start
+type catchupThroughput struct {
+	start        time.Time
+	blocks       uint64
+	transactions uint64
 }
 
 // A BlockAuthenticator authenticates blocks given a certificate.
@@ -146,6 +181,12 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.roundTimeEstimate = agreement.DefaultDeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
 	s.syncNow = make(chan struct{}, 1)
+	if config.CatchupSignatureVerificationLookahead > 0 && config.CatchupVerifyTransactionSignatures() {
+		s.preverifySlots = make(chan struct{}, config.CatchupSignatureVerificationLookahead)
+	}
+	if config.EnableBlockArchiveCatchup {
+		s.blockArchive = makeS3BlockArchivePeer(s.log, config, ledger)
+	}
 
 	return s
 }
@@ -180,8 +221,9 @@ func (s *Service) IsSynchronizing() (synchronizing bool, initialSync bool) {
 	return
 }
 
-// triggerSync attempts to wake up the sync loop.
-func (s *Service) triggerSync() {
+// TriggerSync attempts to wake up the sync loop, so that it syncs right away rather than once the ledger stopped
+// advancing for a while.
+func (s *Service) TriggerSync() {
 	// Prevents deadlock if periodic sync isn't running
 	// when catchup is setting the sync round.
 	select {
@@ -197,14 +239,14 @@ func (s *Service) SetDisableSyncRound(rnd uint64) error {
 		return ErrSyncRoundInvalid
 	}
 	s.disableSyncRound.Store(rnd)
-	s.triggerSync()
+	s.TriggerSync()
 	return nil
 }
 
 // UnsetDisableSyncRound removes any previously set disabled sync round
 func (s *Service) UnsetDisableSyncRound() {
 	s.disableSyncRound.Store(0)
-	s.triggerSync()
+	s.TriggerSync()
 }
 
 // GetDisableSyncRound returns the disabled sync round
@@ -276,6 +318,9 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 	// repeated requests for a block that most likely does not exist yet
 	peerErrors := map[network.Peer]int{}
 
+	// fetch the block, retrying with other peers until it's authenticated
+	var block *bookkeeping.Block
+	var cert *agreement.Certificate
 	i := 0
 	for {
 		i++
@@ -316,7 +361,9 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 		s.log.Debugf("fetchAndWrite(%d): got %s peer: %s", r, psp.peerClass, peerAddress(peer))
 
 		// Try to fetch, timing out after retryInterval
-		block, cert, blockDownloadDuration, err := s.innerFetch(ctx, r, peer)
+		var blockDownloadDuration time.Duration
+		var err error
+		block, cert, blockDownloadDuration, err = s.innerFetch(ctx, r, peer)
 
 		if err != nil {
 			if errors.Is(err, errLedgerAlreadyHasBlock) {
@@ -363,6 +410,7 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 			return false
 		}
 		s.log.Debugf("fetchAndWrite(%d): Got block and cert contents: %v %v", r, block, cert)
+		catchupBlocksFetchedTotal.Inc(nil)
 
 		// Check that the block's contents match the block header (necessary with an untrusted block because b.Hash() only hashes the header)
 		if s.cfg.CatchupVerifyPaysetHash() {
@@ -400,80 +448,179 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 		r1, r2 := peerSelector.rankPeer(psp, peerRank)
 		s.log.Debugf("fetchAndWrite(%d): ranked peer %s with %d from %d to %d", r, peerAddress(psp.Peer), peerRank, r1, r2)
 
-		// Write to ledger, noting that ledger writes must be in order
+		break
+	}
+
+	// verify the transaction signatures of the block while the preceding blocks are evaluated, and make sure that
+	// the verification is no longer running once we're done with this block.
+	preverification := s.preverifyBlock(ctx, block)
+	defer preverification.stop()
+
+	// Write to ledger, noting that ledger writes must be in order
+	select {
+	case <-ctx.Done():
+		s.log.Debugf("fetchAndWrite(%d): Aborted while waiting to write to ledger", r)
+		return false
+	case <-prevFetchCompleteChan:
+		// make sure the ledger wrote enough of the account data to disk, since we don't want the ledger to hold a large amount of data in memory.
+		proto, err := s.ledger.ConsensusParams(r.SubSaturate(1))
+		if err != nil {
+			s.log.Errorf("fetchAndWrite(%d): Unable to determine consensus params for round %d: %v", r, r-1, err)
+			return false
+		}
+		ledgerBacklogRound := r.SubSaturate(basics.Round(proto.MaxBalLookback))
 		select {
-		case <-ctx.Done():
-			s.log.Debugf("fetchAndWrite(%d): Aborted while waiting to write to ledger", r)
+		case <-s.ledger.Wait(ledgerBacklogRound):
+			// i.e. round r-320 is no longer in the blockqueue, so it's account data is either being currently written, or it was already written.
+		case <-s.ctx.Done():
+			s.log.Debugf("fetchAndWrite(%d): Aborted while waiting for ledger to complete writing up to round %d", r, ledgerBacklogRound)
 			return false
-		case <-prevFetchCompleteChan:
-			// make sure the ledger wrote enough of the account data to disk, since we don't want the ledger to hold a large amount of data in memory.
-			proto, err := s.ledger.ConsensusParams(r.SubSaturate(1))
-			if err != nil {
-				s.log.Errorf("fetchAndWrite(%d): Unable to determine consensus params for round %d: %v", r, r-1, err)
-				return false
-			}
-			ledgerBacklogRound := r.SubSaturate(basics.Round(proto.MaxBalLookback))
-			select {
-			case <-s.ledger.Wait(ledgerBacklogRound):
-				// i.e. round r-320 is no longer in the blockqueue, so it's account data is either being currently written, or it was already written.
-			case <-s.ctx.Done():
-				s.log.Debugf("fetchAndWrite(%d): Aborted while waiting for ledger to complete writing up to round %d", r, ledgerBacklogRound)
-				return false
-			}
+		}
 
-			if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
-				var vb *ledgercore.ValidatedBlock
-				vb, err = s.ledger.Validate(s.ctx, *block, s.blockValidationPool)
-				if err != nil {
-					if s.ctx.Err() != nil {
-						// if the context expired, just exit.
-						return false
-					}
-					var errNSBE ledgercore.ErrNonSequentialBlockEval
-					if errors.As(err, &errNSBE) && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
-						// the block was added to the ledger from elsewhere after fetching it here
-						// only the agreement could have added this block into the ledger, catchup is complete
-						s.log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
-						return false
-					}
-					s.log.Warnf("fetchAndWrite(%d): failed to validate block : %v", r, err)
-					return false
-				}
-				err = s.ledger.AddValidatedBlock(*vb, *cert)
-			} else {
-				err = s.ledger.AddBlock(*block, *cert)
-			}
+		// don't wait for the signatures of this block to be verified ahead unless that's already underway: the validation
+		// below finds the transaction groups verified ahead in the verified transactions cache, and verifies the others.
+		preverification.stop()
 
+		writeStart := time.Now()
+		if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
+			var vb *ledgercore.ValidatedBlock
+			vb, err = s.ledger.Validate(s.ctx, *block, s.blockValidationPool)
 			if err != nil {
-				var errNonSequentialBlockEval ledgercore.ErrNonSequentialBlockEval
-				var blockInLedgerError ledgercore.BlockInLedgerError
-				var protocolErr protocol.Error
-				switch {
-				case errors.As(err, &errNonSequentialBlockEval):
-					s.log.Infof("fetchAndWrite(%d): no need to re-evaluate historical block", r)
-					return true
-				case errors.As(err, &blockInLedgerError):
+				if s.ctx.Err() != nil {
+					// if the context expired, just exit.
+					return false
+				}
+				var errNSBE ledgercore.ErrNonSequentialBlockEval
+				if errors.As(err, &errNSBE) && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
 					// the block was added to the ledger from elsewhere after fetching it here
 					// only the agreement could have added this block into the ledger, catchup is complete
 					s.log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
 					return false
-				case errors.As(err, &protocolErr):
-					if !s.protocolErrorLogged {
-						logging.Base().Errorf("fetchAndWrite(%d): unrecoverable protocol error detected: %v", r, err)
-						s.protocolErrorLogged = true
-					}
-				default:
-					s.log.Errorf("fetchAndWrite(%d): ledger write failed: %v", r, err)
 				}
+				s.log.Warnf("fetchAndWrite(%d): failed to validate block : %v", r, err)
+				return false
+			}
+			err = s.ledger.AddValidatedBlock(*vb, *cert)
+		} else {
+			err = s.ledger.AddBlock(*block, *cert)
+		}
 
+		if err != nil {
+			var errNonSequentialBlockEval ledgercore.ErrNonSequentialBlockEval
+			var blockInLedgerError ledgercore.BlockInLedgerError
+			var protocolErr protocol.Error
+			switch {
+			case errors.As(err, &errNonSequentialBlockEval):
+				s.log.Infof("fetchAndWrite(%d): no need to re-evaluate historical block", r)
+				return true
+			case errors.As(err, &blockInLedgerError):
+				// the block was added to the ledger from elsewhere after fetching it here
+				// only the agreement could have added this block into the ledger, catchup is complete
+				s.log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
 				return false
+			case errors.As(err, &protocolErr):
+				if !s.protocolErrorLogged {
+					logging.Base().Errorf("fetchAndWrite(%d): unrecoverable protocol error detected: %v", r, err)
+					s.protocolErrorLogged = true
+				}
+			default:
+				s.log.Errorf("fetchAndWrite(%d): ledger write failed: %v", r, err)
 			}
-			s.log.Debugf("fetchAndWrite(%d): Wrote block to ledger", r)
-			return true
+
+			return false
 		}
+		s.log.Debugf("fetchAndWrite(%d): Wrote block to ledger", r)
+		catchupBlockWriteSeconds.ObserveSince(writeStart, nil)
+		catchupBlocksWrittenTotal.Inc(nil)
+		catchupTransactionsWrittenTotal.AddUint64(uint64(len(block.Payset)), nil)
+		s.updateThroughput(uint64(len(block.Payset)), time.Now())
+		return true
 	}
 }
 
+// blockPreverification is the verification of the transaction signatures of a block ahead of its evaluation
+type blockPreverification struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// stop drops the verification if it has not started yet, or waits for it to be over otherwise, since the evaluation
+// would only verify the same signatures again. It may be called more than once.
+func (p blockPreverification) stop() {
+	p.cancel()
+	<-p.done
+}
+
+// preverifyBlock verifies the transaction signatures of the given block on the block validation pool once the block
+// is within CatchupSignatureVerificationLookahead rounds of the ledger, so that the verification of the upcoming blocks
+// runs ahead of their evaluation. The verified transaction groups are kept in the ledger's verified transactions cache,
+// which the evaluation of the block then relies on. At most CatchupSignatureVerificationLookahead blocks are verified
+// ahead at once; a block still waiting for its turn once it's evaluated only gets its signatures verified on evaluation.
+// Since any failure would be caught when the block is validated, it is only logged here.
+func (s *Service) preverifyBlock(ctx context.Context, block *bookkeeping.Block) blockPreverification {
+	waitCtx, cancel := context.WithCancel(ctx)
+	p := blockPreverification{cancel: cancel, done: make(chan struct{})}
+	if s.preverifySlots == nil || len(block.Payset) == 0 {
+		close(p.done)
+		return p
+	}
+
+	go func() {
+		defer close(p.done)
+		r := block.Round()
+		skipped := func() {
+			// the block is about to be evaluated, and other blocks are still being verified ahead
+			if ctx.Err() == nil {
+				catchupBlocksPreverifySkippedTotal.Inc(nil)
+			}
+		}
+		// the ledger has round r-lookahead once block r is within the verification lookahead
+		select {
+		case <-s.ledger.WaitMem(r.SubSaturate(basics.Round(cap(s.preverifySlots)))):
+		case <-waitCtx.Done():
+			skipped()
+			return
+		}
+		select {
+		case s.preverifySlots <- struct{}{}:
+			defer func() { <-s.preverifySlots }()
+		case <-waitCtx.Done():
+			skipped()
+			return
+		}
+		start := time.Now()
+		err := s.ledger.PreverifyBlock(ctx, *block, s.blockValidationPool)
+		if err != nil {
+			s.log.Debugf("preverifyBlock(%d): failed to verify the block signatures ahead of its evaluation: %v", r, err)
+			return
+		}
+		catchupPreverificationSeconds.ObserveSince(start, nil)
+		catchupBlocksPreverifiedTotal.Inc(nil)
+	}()
+	return p
+}
+
+// updateThroughput accounts a block of the given number of transactions written at now, and updates the throughput
+// gauges once the throughput window is over.
+func (s *Service) updateThroughput(transactions uint64, now time.Time) {
+	s.throughputMu.Lock()
+	defer s.throughputMu.Unlock()
+	if s.throughput.start.IsZero() {
+		// the first block written starts the window
+		s.throughput.start = now
+		return
+	}
+	s.throughput.blocks++
+	s.throughput.transactions += transactions
+	elapsed := now.Sub(s.throughput.start)
+	if elapsed < catchupThroughputWindow {
+		return
+	}
+	catchupBlocksPerSecond.Set(uint64(float64(s.throughput.blocks) / elapsed.Seconds()))
+	catchupTransactionsPerSecond.Set(uint64(float64(s.throughput.transactions) / elapsed.Seconds()))
+	s.throughput = catchupThroughput{start: now}
+}
+
 // TODO the following code does not handle the following case: seedLookback upgrades during fetch
 func (s *Service) pipelinedFetch(seedLookback uint64) {
 	maxParallelRequests := s.parallelBlocks
@@ -497,7 +644,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 		}
 	}()
 
-	ps := createPeerSelector(s.net)
+	ps := createPeerSelector(s.net, s.blockArchive)
 	if _, err := ps.getNextPeer(); err != nil {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from: %v", err)
 		return
@@ -759,7 +906,8 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 	peerErrors := map[network.Peer]int{}
 
 	blockHash := bookkeeping.BlockHash(cert.Proposal.BlockDigest) // semantic digest (i.e., hash of the block header), not byte-for-byte digest
-	ps := createPeerSelector(s.net)
+	// the block archive trails the tip of the chain, so fetchRound does not try it
+	ps := createPeerSelector(s.net, nil)
 	for s.ledger.LastRound() < cert.Round {
 		psp, getPeerErr := ps.getNextPeer()
 		if getPeerErr != nil {
@@ -803,7 +951,7 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 						// - peer selector punishes one of the peers more than the other
 						// - the punished peer gets the block, and the less punished peer stuck.
 						// It this case reset the peer selector to let it re-learn priorities.
-						ps = createPeerSelector(s.net)
+						ps = createPeerSelector(s.net, nil)
 					}
 				}
 				peerErrors[peer]++
@@ -881,8 +1029,14 @@ func (s *Service) roundIsNotSupported(nextRound basics.Round) bool {
 	return true
 }
 
-func createPeerSelector(net peersRetriever) peerSelector {
-	wrappedPeerSelectors := []*wrappedPeerSelector{
+// createPeerSelector returns the peer selector for fetching blocks. If blockArchive is not nil, it is
+// tried ahead of the network peers.
+func createPeerSelector(net peersRetriever, blockArchive *blockArchivePeer) peerSelector {
+	var wrappedPeerSelectors []*wrappedPeerSelector
+	if blockArchive != nil {
+		wrappedPeerSelectors = append(wrappedPeerSelectors, makeBlockArchivePeerSelector(blockArchive))
+	}
+	wrappedPeerSelectors = append(wrappedPeerSelectors, []*wrappedPeerSelector{
 		{
 			peerClass: network.PeersConnectedOut,
 			peerSelector: makeRankPooledPeerSelector(net,
@@ -907,7 +1061,7 @@ func createPeerSelector(net peersRetriever) peerSelector {
 				[]peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersConnectedIn}}),
 			toleranceFactor: 3,
 		},
-	}
+	}...)
 
 	return makeClassBasedPeerSelector(wrappedPeerSelectors)
 }
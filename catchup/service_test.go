@@ -19,6 +19,7 @@ package catchup
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
@@ -44,6 +45,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/rpcs"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
 	"github.com/DePINNetwork/depin-sdk/util/execpool"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
 )
 
 var defaultConfig = config.GetDefaultLocal()
@@ -778,6 +780,10 @@ func (m *mockedLedger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agre
 	return nil
 }
 
+func (m *mockedLedger) PreverifyBlock(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) error {
+	return nil
+}
+
 func (m *mockedLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -863,6 +869,140 @@ func (m *mockedBehindDeltasLedger) IsBehindCommittingDeltas() bool {
 	return true
 }
 
+// mockedValidatingLedger is a mockedLedger that validates blocks and keeps track of the blocks whose signatures were
+// verified ahead of their evaluation
+type mockedValidatingLedger struct {
+	mockedLedger
+
+	preverifiedMu deadlock.Mutex
+	// preverified maps the preverified rounds to the last round in the ledger at the time of their verification
+	preverified map[basics.Round]basics.Round
+	// inFlight and maxInFlight count the concurrent calls to PreverifyBlock
+	inFlight    int
+	maxInFlight int
+}
+
+func (m *mockedValidatingLedger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
+	vb := ledgercore.MakeValidatedBlock(blk, ledgercore.StateDelta{})
+	return &vb, nil
+}
+
+func (m *mockedValidatingLedger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
+	return m.AddBlock(vb.Block(), cert)
+}
+
+func (m *mockedValidatingLedger) PreverifyBlock(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) error {
+	lastRound := m.LastRound()
+	m.preverifiedMu.Lock()
+	m.inFlight++
+	if m.inFlight > m.maxInFlight {
+		m.maxInFlight = m.inFlight
+	}
+	m.preverifiedMu.Unlock()
+
+	// give other verifications a chance to run concurrently
+	time.Sleep(time.Millisecond)
+
+	m.preverifiedMu.Lock()
+	defer m.preverifiedMu.Unlock()
+	m.inFlight--
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if m.preverified == nil {
+		m.preverified = make(map[basics.Round]basics.Round)
+	}
+	m.preverified[blk.Round()] = lastRound
+	return nil
+}
+
+func TestServiceFetchBlocksPreverify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numberOfBlocks := basics.Round(40)
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, int(numberOfBlocks)-1)
+
+	// Create a network and block service
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), config.GetDefaultLocal(), remote, net, "test genesisID")
+
+	nodeA := basicRPCNode{}
+	ls.RegisterHandlers(&nodeA)
+	nodeA.start()
+	defer nodeA.stop()
+	net.addPeer(nodeA.rootURL())
+
+	for _, lookahead := range []uint64{0, 1, 4} {
+		t.Run(fmt.Sprintf("lookahead=%d", lookahead), func(t *testing.T) {
+			local := new(mockedValidatingLedger)
+			local.blocks = append(local.blocks, bookkeeping.Block{})
+
+			cfg := defaultConfig
+			cfg.CatchupBlockValidateMode = 4 // verify the transaction signatures
+			cfg.CatchupSignatureVerificationLookahead = lookahead
+			syncer := MakeService(logging.Base(), cfg, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+			syncer.testStart()
+			syncer.sync()
+			require.Equal(t, numberOfBlocks, local.LastRound())
+
+			local.preverifiedMu.Lock()
+			defer local.preverifiedMu.Unlock()
+			if lookahead == 0 {
+				require.Empty(t, local.preverified)
+				return
+			}
+			// blocks still waiting to be verified once they're evaluated are skipped, so only some are preverified
+			require.NotEmpty(t, local.preverified)
+			require.LessOrEqual(t, local.maxInFlight, int(lookahead))
+			for r, lastRound := range local.preverified {
+				// the block was verified within the lookahead, and before it was written to the ledger
+				require.GreaterOrEqual(t, lastRound+basics.Round(lookahead), r, "round %d", r)
+				require.Less(t, lastRound, r, "round %d", r)
+			}
+		})
+	}
+}
+
+// gaugeValue returns the value of a gauge without labels
+func gaugeValue(gauge *metrics.Gauge) float64 {
+	values := make(map[string]float64)
+	gauge.AddMetric(values)
+	for _, v := range values {
+		return v
+	}
+	return 0
+}
+
+func TestServiceUpdateThroughput(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	s := MakeService(logging.Base(), defaultConfig, &httpTestPeerSource{}, new(mockedLedger), &mockedAuthenticator{errorRound: -1}, nil, nil)
+	start := time.Now()
+	s.updateThroughput(100, start)
+	catchupBlocksPerSecond.Set(0)
+	catchupTransactionsPerSecond.Set(0)
+
+	// the gauges are only updated once the window is over
+	for i := 1; i < 10; i++ {
+		s.updateThroughput(100, start.Add(time.Duration(i)*time.Second))
+	}
+	require.Zero(t, gaugeValue(catchupBlocksPerSecond))
+	require.Zero(t, gaugeValue(catchupTransactionsPerSecond))
+
+	s.updateThroughput(100, start.Add(catchupThroughputWindow))
+	require.Equal(t, float64(1), gaugeValue(catchupBlocksPerSecond))
+	require.Equal(t, float64(100), gaugeValue(catchupTransactionsPerSecond))
+
+	// the next window starts with the last block written
+	for i := 1; i <= 40; i++ {
+		s.updateThroughput(50, start.Add(catchupThroughputWindow+time.Duration(i)*time.Second/4))
+	}
+	require.Equal(t, float64(4), gaugeValue(catchupBlocksPerSecond))
+	require.Equal(t, float64(200), gaugeValue(catchupTransactionsPerSecond))
+}
+
 func testingenvWithUpgrade(
 	t testing.TB,
 	numBlocks,
@@ -959,7 +1099,7 @@ func TestCreatePeerSelector(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
 	s := MakeService(logging.Base(), defaultConfig, &httpTestPeerSource{}, new(mockedLedger), &mockedAuthenticator{errorRound: int(0 + 1)}, nil, nil)
-	ps := createPeerSelector(s.net)
+	ps := createPeerSelector(s.net, nil)
 
 	cps, ok := ps.(*classBasedPeerSelector)
 	require.True(t, ok)
@@ -51,7 +51,7 @@ func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, confi
 		log:    log}
 }
 
-// fetchBlock returns a block from the peer. The peer can be either an http or ws peer.
+// fetchBlock returns a block from the peer. The peer can be either an http or ws peer, or the block archive.
 func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Round, peer network.Peer) (blk *bookkeeping.Block,
 	cert *agreement.Certificate, downloadDuration time.Duration, err error) {
 
@@ -85,8 +85,14 @@ func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Ro
 			return nil, nil, time.Duration(0), err
 		}
 		address = fetcherClient.address()
+	} else if archivePeer, validArchivePeer := peer.(*blockArchivePeer); validArchivePeer {
+		fetchedBuf, err = archivePeer.getBlockBytes(ctx, round)
+		if err != nil {
+			return nil, nil, time.Duration(0), err
+		}
+		address = archivePeer.address()
 	} else {
-		return nil, nil, time.Duration(0), fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
+		return nil, nil, time.Duration(0), fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer, or the block archive")
 	}
 	downloadDuration = time.Since(blockDownloadStartTime)
 	block, cert, err := processBlockBytes(fetchedBuf, round, address)
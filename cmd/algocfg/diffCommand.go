@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DePINNetwork/depin-sdk/cmd/util/datadir"
+	"github.com/DePINNetwork/depin-sdk/config"
+)
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the parameters of config.json which differ from the defaults of its config version",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		anyError := false
+		datadir.OnDataDirs(func(dataDir string) {
+			file := filepath.Join(dataDir, config.ConfigFilename)
+			data, err := os.ReadFile(file)
+			if os.IsNotExist(err) {
+				reportInfof("%s: no config file, the defaults are used", file)
+				return
+			}
+			if err != nil {
+				reportWarnf("Error reading config file '%s' - %s", file, err)
+				anyError = true
+				return
+			}
+
+			version, diffs, err := config.DiffConfigFile(data)
+			if err != nil {
+				reportWarnf("Error parsing config file '%s' - %s", file, err)
+				anyError = true
+				return
+			}
+			reportInfof("%s: config version %d", file, version)
+			for _, diff := range diffs {
+				reportInfof("%s: %s -> %s", diff.Field, formatConfigValue(diff.Default), formatConfigValue(diff.Value))
+			}
+		})
+		if anyError {
+			os.Exit(1)
+		}
+	},
+}
+
+func formatConfigValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DePINNetwork/depin-sdk/cmd/util/datadir"
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/util/db"
+)
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check config.json for unknown keys, invalid values, deprecated fields and unsupported combinations of settings",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		anyError := false
+		datadir.OnDataDirs(func(dataDir string) {
+			file := filepath.Join(dataDir, config.ConfigFilename)
+			data, err := os.ReadFile(file)
+			if os.IsNotExist(err) {
+				reportInfof("%s: no config file, the defaults are used", file)
+				return
+			}
+			if err != nil {
+				reportWarnf("Error reading config file '%s' - %s", file, err)
+				anyError = true
+				return
+			}
+
+			issues, err := config.ValidateConfigFile(data)
+			if err != nil {
+				reportWarnf("Error parsing config file '%s' - %s", file, err)
+				anyError = true
+				return
+			}
+			issues = append(issues, validateDataDir(dataDir)...)
+
+			for _, issue := range issues {
+				reportInfof("%s: %s", file, issue)
+				if !issue.Warning {
+					anyError = true
+				}
+			}
+			if len(issues) == 0 {
+				reportInfof("%s: ok", file)
+			}
+		})
+		if anyError {
+			os.Exit(1)
+		}
+	},
+}
+
+// validateDataDir checks the configuration of the data directory against its contents
+func validateDataDir(dataDir string) []config.ValidationIssue {
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil || !cfg.EnableFollowMode {
+		return nil
+	}
+	registries, _ := filepath.Glob(filepath.Join(dataDir, "*", config.ParticipationRegistryFilename))
+	if cfg.HotDataDir != "" {
+		hotRegistries, _ := filepath.Glob(filepath.Join(cfg.HotDataDir, "*", config.ParticipationRegistryFilename))
+		registries = append(registries, hotRegistries...)
+	}
+	for _, registry := range registries {
+		keys, err := countParticipationKeys(registry)
+		if err != nil {
+			return []config.ValidationIssue{{Field: "EnableFollowMode", Message: fmt.Sprintf("unable to read participation keys from %s: %v", registry, err), Warning: true}}
+		}
+		if keys > 0 {
+			return []config.ValidationIssue{{Field: "EnableFollowMode", Message: fmt.Sprintf("follower nodes do not participate, but %s has %d participation keys", registry, keys)}}
+		}
+	}
+	return nil
+}
+
+// countParticipationKeys returns the number of keys in a participation registry database
+func countParticipationKeys(registry string) (count int, err error) {
+	accessor, err := db.MakeAccessor(registry, true, false)
+	if err != nil {
+		return 0, err
+	}
+	defer accessor.Close()
+	err = accessor.Handle.QueryRow("SELECT COUNT(*) FROM Keysets").Scan(&count)
+	return count, err
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/db"
+)
+
+func TestProfilesValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for name := range profileNames {
+		cfg, err := getConfigForArg(name)
+		require.NoError(t, err)
+		for _, issue := range cfg.Validate() {
+			require.True(t, issue.Warning, "profile %s: %s", name, issue)
+		}
+	}
+}
+
+func TestValidateDataDir(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	genesisDir := filepath.Join(dataDir, "testnet-v1.0")
+	require.NoError(t, os.Mkdir(genesisDir, 0700))
+	accessor, err := db.MakeAccessor(filepath.Join(genesisDir, config.ParticipationRegistryFilename), false, false)
+	require.NoError(t, err)
+	_, err = accessor.Handle.Exec("CREATE TABLE Keysets (pk INTEGER PRIMARY KEY)")
+	require.NoError(t, err)
+	accessor.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	require.NoError(t, cfg.SaveToDisk(dataDir))
+	require.Empty(t, validateDataDir(dataDir))
+
+	accessor, err = db.MakeAccessor(filepath.Join(genesisDir, config.ParticipationRegistryFilename), false, false)
+	require.NoError(t, err)
+	_, err = accessor.Handle.Exec("INSERT INTO Keysets (pk) VALUES (1)")
+	require.NoError(t, err)
+	accessor.Close()
+
+	issues := validateDataDir(dataDir)
+	require.Len(t, issues, 1)
+	require.Equal(t, "EnableFollowMode", issues[0].Field)
+	require.False(t, issues[0].Warning)
+
+	// participation keys are fine on nodes which are not followers
+	cfg.EnableFollowMode = false
+	require.NoError(t, cfg.SaveToDisk(dataDir))
+	require.Empty(t, validateDataDir(dataDir))
+}
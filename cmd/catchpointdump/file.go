@@ -19,7 +19,6 @@ package main
 import (
 	"archive/tar"
 	"bufio"
-	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/base64"
@@ -164,33 +163,13 @@ func printLoadCatchpointProgressLine(progress int, barLength int, dld int64) {
 	fmt.Printf(escapeCursorUp+escapeDeleteLine+outString+" %s\n", formatSize(dld))
 }
 
-func isGzipCompressed(catchpointReader *bufio.Reader, catchpointFileSize int64) bool {
-	const gzipPrefixSize = 2
-	const gzipPrefix = "\x1F\x8B"
-
-	if catchpointFileSize < gzipPrefixSize {
-		return false
-	}
-
-	prefixBytes, err := catchpointReader.Peek(gzipPrefixSize)
-
+func getCatchpointTarReader(catchpointReader *bufio.Reader) (*tar.Reader, bool, error) {
+	isCompressed := ledger.CatchpointFileEncoding(catchpointReader) != ""
+	tarStream, err := ledger.MakeCatchpointFileReader(catchpointReader)
 	if err != nil {
-		return false
-	}
-
-	return prefixBytes[0] == gzipPrefix[0] && prefixBytes[1] == gzipPrefix[1]
-}
-
-func getCatchpointTarReader(catchpointReader *bufio.Reader, catchpointFileSize int64) (*tar.Reader, bool, error) {
-	if isGzipCompressed(catchpointReader, catchpointFileSize) {
-		gzipReader, err := gzip.NewReader(catchpointReader)
-		if err != nil {
-			return nil, false, err
-		}
-		return tar.NewReader(gzipReader), true, nil
+		return nil, false, err
 	}
-
-	return tar.NewReader(catchpointReader), false, nil
+	return tar.NewReader(tarStream), isCompressed, nil
 }
 
 func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.CatchpointCatchupAccessor, catchpointFile io.Reader, catchpointFileSize int64) (fileHeader ledger.CatchpointFileHeader, err error) {
@@ -202,12 +181,12 @@ func loadCatchpointIntoDatabase(ctx context.Context, catchupAccessor ledger.Catc
 	defer printLoadCatchpointProgressLine(0, 0, 0)
 
 	catchpointReader := bufio.NewReader(catchpointFile)
-	tarReader, isCompressed, err := getCatchpointTarReader(catchpointReader, catchpointFileSize)
+	tarReader, isCompressed, err := getCatchpointTarReader(catchpointReader)
 	if err != nil {
 		return fileHeader, err
 	}
 	if isCompressed {
-		// gzip'ed file is about 3-6 times smaller than tar
+		// compressed file is about 3-6 times smaller than tar
 		// modify catchpointFileSize to make the progress bar more-less reflecting the state
 		catchpointFileSize = 4 * catchpointFileSize
 	}
@@ -329,6 +308,10 @@ func printAccountsDatabase(databaseName string, stagingTables bool, fileHeader l
 			fileHeader.TotalOnlineRoundParams,
 			fileHeader.TotalChunks,
 		}
+		if len(fileHeader.ChunkHashes) > 0 {
+			headerFields = append(headerFields, "Chunk Hashes: %d")
+			headerValues = append(headerValues, len(fileHeader.ChunkHashes))
+		}
 		// safety check
 		if len(headerFields) != len(headerValues) {
 			return fmt.Errorf("printing failed: header formatting mismatch")
@@ -38,7 +38,7 @@ import (
 var infoFile string
 
 func init() {
-	infoCmd.Flags().StringVarP(&infoFile, "tar", "t", "", "Specify the catchpoint file (.tar or .tar.gz) to read")
+	infoCmd.Flags().StringVarP(&infoFile, "tar", "t", "", "Specify the catchpoint file (.tar, .tar.gz or .tar.zst) to read")
 	infoCmd.Flags().StringVarP(&networkName, "net", "n", "", "Specify the network name (e.g. mainnet.algorand.network)")
 	infoCmd.Flags().IntVarP(&round, "round", "r", 0, "Specify the round number (e.g. 7700000). Only used if --relay/-p is given.")
 	infoCmd.Flags().StringVarP(&relayAddress, "relay", "p", "", "Relay address to download from (e.g. r-ru.algorand-mainnet.network:4160). If specified, fetch instead of reading local --tar.")
@@ -93,7 +93,7 @@ var infoCmd = &cobra.Command{
 		defer f.Close()
 
 		// Extract just the file header
-		fileHeader, err := loadCatchpointFileHeader(f, fi.Size())
+		fileHeader, err := loadCatchpointFileHeader(f)
 		if err != nil {
 			reportErrorf("Error reading CatchpointFileHeader from '%s': %v", infoFile, err)
 		}
@@ -119,6 +119,9 @@ func printHeaderFields(fileHeader ledger.CatchpointFileHeader) {
 	fmt.Printf("Total Online Accounts: %d\n", fileHeader.TotalOnlineAccounts)
 	fmt.Printf("Total Online Round Params: %d\n", fileHeader.TotalOnlineRoundParams)
 	fmt.Printf("Total Chunks: %d\n", fileHeader.TotalChunks)
+	if len(fileHeader.ChunkHashes) > 0 {
+		fmt.Printf("Chunk Hashes: %d\n", len(fileHeader.ChunkHashes))
+	}
 
 	totals := fileHeader.Totals
 	fmt.Printf("AccountTotals - Online Money: %d\n", totals.Online.Money.Raw)
@@ -132,12 +135,12 @@ func printHeaderFields(fileHeader ledger.CatchpointFileHeader) {
 
 // loadCatchpointFileHeader reads only enough of the tar (or tar.gz) to
 // decode the ledger.CatchpointFileHeader from the "content.json" chunk.
-func loadCatchpointFileHeader(catchpointFile io.Reader, catchpointFileSize int64) (ledger.CatchpointFileHeader, error) {
+func loadCatchpointFileHeader(catchpointFile io.Reader) (ledger.CatchpointFileHeader, error) {
 	var fileHeader ledger.CatchpointFileHeader
 	fmt.Printf("Scanning for CatchpointFileHeader in tar...\n\n")
 
 	catchpointReader := bufio.NewReader(catchpointFile)
-	tarReader, _, err := getCatchpointTarReader(catchpointReader, catchpointFileSize)
+	tarReader, _, err := getCatchpointTarReader(catchpointReader)
 	if err != nil {
 		return fileHeader, err
 	}
@@ -193,7 +196,7 @@ func loadCatchpointFileHeader(catchpointFile io.Reader, catchpointFileSize int64
 }
 
 // loadCatchpointFileHeaderFromRelay opens a streaming HTTP connection to the
-// given relay for the given round, then scans the (possibly gzip) tar stream
+// given relay for the given round, then scans the (possibly compressed) tar stream
 // until it finds `content.json`, decodes the ledger.CatchpointFileHeader, and
 // immediately closes the network connection (so we don't download the entire file).
 func loadCatchpointFileHeaderFromRelay(relay string, netName string, round int) (ledger.CatchpointFileHeader, error) {
@@ -228,11 +231,9 @@ func loadCatchpointFileHeaderFromRelay(relay string, netName string, round int)
 	wdReader := util.MakeWatchdogStreamReader(resp.Body, 4096, 4096, 5*time.Second)
 	defer wdReader.Close()
 
-	// Use isGzip logic from file.go
-	// We have to peek the first 2 bytes to see if it's gz
+	// We have to peek the first bytes to see whether it's compressed
 	peekReader := bufio.NewReader(wdReader)
-	// We'll fake a size of "unknown" since we don't truly know the length
-	tarReader, _, err := getCatchpointTarReader(peekReader, -1 /* unknown size */)
+	tarReader, _, err := getCatchpointTarReader(peekReader)
 	if err != nil {
 		return fileHeader, err
 	}
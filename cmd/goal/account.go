@@ -80,6 +80,7 @@ func init() {
 	accountCmd.AddCommand(newCmd)
 	accountCmd.AddCommand(deleteCmd)
 	accountCmd.AddCommand(listCmd)
+	accountCmd.AddCommand(listDerivedCmd)
 	accountCmd.AddCommand(renameCmd)
 	accountCmd.AddCommand(infoCmd)
 	accountCmd.AddCommand(assetDetailsCmd)
@@ -549,6 +550,36 @@ var listCmd = &cobra.Command{
 	},
 }
 
+var listDerivedCmd = &cobra.Command{
+	Use:   "derived",
+	Short: "Show the accounts of an HD wallet along with their derivation paths",
+	Long:  `Show the accounts generated by a hierarchical deterministic wallet (created with goal wallet new --hd), along with the BIP-44 derivation path each was derived at. Imported accounts are not shown.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+
+		// Get a wallet handle to the specified wallet
+		wh := ensureWalletHandle(dataDir, walletName)
+
+		// List the derived addresses in the wallet
+		client := ensureKmdClient(dataDir)
+		keys, err := client.ListDerivedAddresses(wh)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		// Special response if there are no addresses
+		if len(keys) == 0 {
+			reportInfoln(infoNoAccounts)
+			os.Exit(0)
+		}
+
+		for _, key := range keys {
+			fmt.Printf("%s\t%s\n", key.Path, key.Address)
+		}
+	},
+}
+
 var assetDetailsCmd = &cobra.Command{
 	Use:   "assetdetails",
 	Short: "Retrieve information about the assets belonging to the specified account inclusive of asset metadata",
@@ -83,6 +83,17 @@ const (
 	errorCloningNode                        = "Error cloning the node: %s"
 	infoNodeCloned                          = "Node cloned successfully to: %s"
 	infoNodeWroteToken                      = "Successfully wrote new API token: %s"
+	infoNodeWroteScopedToken                = "Successfully created API token '%s': %s"
+	infoNodeRevokedScopedToken              = "Revoked API token '%s'"
+	infoNodeNoScopedTokens                  = "No scoped API tokens"
+	errorNodeScopedToken                    = "Cannot manage scoped API tokens: %s"
+	infoNodeNoPeers                         = "No connected peers"
+	infoNodeNoPinnedPeers                   = "No pinned peers"
+	infoNodeConnectedPeer                   = "Connecting to peer %s"
+	infoNodeDisconnectedPeer                = "Disconnected peer %s"
+	infoNodePinnedPeer                      = "Pinned peer %s"
+	infoNodeUnpinnedPeer                    = "Unpinned peer %s"
+	errorNodePeers                          = "Cannot manage peers: %s"
 	infoNodePendingTxnsDescription          = "Pending Transactions (Truncated max=%d, Total in pool=%d): "
 	infoNodeNoPendingTxnsDescription        = "None"
 	infoDataDir                             = "[Data Directory: %s]"
@@ -92,6 +103,8 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorCatchpointFileNeedsLabel           = "A catchpoint argument is needed when catching up from a catchpoint file"
+	errorCatchpointFilePath                 = "Unable to resolve the catchpoint file path '%s': %v"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -192,6 +205,16 @@ const (
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
 	errorCouldntRenameWallet     = "Couldn't rename wallet: %s"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+	errorBackupFileExists        = "Backup file %s already exists"
+
+	infoChooseBackupPasswordPrompt   = "Please choose a password for the backup: "
+	infoBackupPasswordPrompt         = "Please enter the password for the backup: "
+	infoChooseRestoredPasswordPrompt = "Please choose a password for the restored wallet: "
+	infoWroteWalletBackup            = "Wrote wallet backup to %s"
+	infoRestoringWallet              = "Restoring wallet..."
+	infoRestoredWallet               = "Restored wallet"
 
 	// Commands
 	infoPasswordPrompt       = "Please enter the password for wallet '%s': "
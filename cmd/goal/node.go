@@ -62,6 +62,7 @@ var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
 var minCatchupRounds uint64
+var catchpointFile string
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -80,6 +81,8 @@ func init() {
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 	nodeCmd.AddCommand(p2pID)
+	nodeCmd.AddCommand(tokenCmd)
+	nodeCmd.AddCommand(peersCmd)
 
 	startCmd.Flags().StringVarP(&peerDial, "peer", "p", "", "Peer address to dial for initial connection")
 	startCmd.Flags().StringVarP(&listenIP, "listen", "l", "", "Endpoint / REST address to listen on")
@@ -119,6 +122,7 @@ func init() {
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
 	catchupCmd.Flags().Uint64VarP(&minCatchupRounds, "min", "m", 0, "Catchup only if the catchpoint would advance the node by the specified minimum number of rounds")
+	catchupCmd.Flags().StringVar(&catchpointFile, "file", "", "Load the ledger from the given local catchpoint file, or from the file matching the catchpoint round in the given directory, rather than downloading it from peers; the trailing blocks are still downloaded from peers")
 
 }
 
@@ -161,7 +165,7 @@ var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
 	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --file 6500000.catchpoint\tStart catching up to round 6500000 using a local catchpoint file\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		var catchpoint string
@@ -180,6 +184,25 @@ var catchupCmd = &cobra.Command{
 				return
 			}
 
+			if catchpointFile != "" {
+				if catchpoint == "" {
+					reportErrorf(errorCatchpointFileNeedsLabel)
+				}
+				// the node resolves the path on its own host, which isn't necessarily running in our working directory.
+				path, err := filepath.Abs(catchpointFile)
+				if err != nil {
+					reportErrorf(errorCatchpointFilePath, catchpointFile, err)
+				}
+				resp, err := client.CatchupFromFile(catchpoint, minCatchupRounds, path)
+				if err != nil {
+					reportErrorf(errorNodeStatus, err)
+				}
+				if resp.CatchupMessage != catchpoint {
+					reportInfof("node response: %s", resp.CatchupMessage)
+				}
+				return
+			}
+
 			// lookup missing catchpoint
 			if catchpoint == "" {
 				vers, err := client.AlgodVersions()
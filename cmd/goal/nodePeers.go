@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DePINNetwork/depin-sdk/cmd/util/datadir"
+)
+
+var peerPinDuration time.Duration
+
+func init() {
+	peersCmd.AddCommand(listPeersCmd)
+	peersCmd.AddCommand(connectPeerCmd)
+	peersCmd.AddCommand(disconnectPeerCmd)
+	peersCmd.AddCommand(pinPeerCmd)
+	peersCmd.AddCommand(unpinPeerCmd)
+	peersCmd.AddCommand(listPinnedPeersCmd)
+	peersCmd.AddCommand(peerTrafficCmd)
+
+	pinPeerCmd.Flags().DurationVar(&peerPinDuration, "duration", 0, "Time after which the pin lifts, e.g. 24h (0 keeps the peer pinned until it is unpinned)")
+}
+
+var peersCmd = &cobra.Command{
+	Use:   "peers",
+	Short: "Inspect and manage the peers of a running node",
+	Long: `Inspect and manage the peers of a running node without restarting it.
+Pinned peers are connected to and reconnected to by the node. Over websocket connections they are also never disconnected to make room for other peers and are prioritized like the PriorityPeers; over p2p connections they are only reconnected to. Pins are not persisted across node restarts.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		listPeersCmd.Run(cmd, args)
+	},
+}
+
+var listPeersCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the peers the node is connected to",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.Peers()
+			if err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			if len(response.Peers) == 0 {
+				reportInfoln(infoNodeNoPeers)
+				return
+			}
+			for _, p := range response.Peers {
+				var flags []string
+				if p.Outgoing {
+					flags = append(flags, "outgoing")
+				} else {
+					flags = append(flags, "incoming")
+				}
+				if p.Pinned {
+					flags = append(flags, "pinned")
+				}
+				line := fmt.Sprintf("%s\t%s\t%s\t%s\tconnected=%s", p.Address, p.Network, p.Role, strings.Join(flags, ","),
+					time.Unix(int64(p.ConnectedSince), 0).UTC().Format(time.RFC3339))
+				if p.PeerId != nil {
+					line += "\tpeer-id=" + *p.PeerId
+				}
+				if p.MessageDelay != nil {
+					line += "\tdelay=" + time.Duration(*p.MessageDelay).String()
+				}
+				var received, sent uint64
+				for _, t := range p.Traffic {
+					received += t.BytesReceived
+					sent += t.BytesSent
+				}
+				line += fmt.Sprintf("\treceived=%d\tsent=%d", received, sent)
+				fmt.Println(line)
+			}
+		})
+	},
+}
+
+var connectPeerCmd = &cobra.Command{
+	Use:   "connect <address>",
+	Short: "Connect the node to a relay address or a libp2p multiaddr",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			if err := client.ConnectPeer(args[0]); err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			reportInfof(infoNodeConnectedPeer, args[0])
+		})
+	},
+}
+
+var disconnectPeerCmd = &cobra.Command{
+	Use:   "disconnect <address>",
+	Short: "Close the connection with a peer, given by its address or libp2p peer ID",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			if err := client.DisconnectPeer(args[0]); err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			reportInfof(infoNodeDisconnectedPeer, args[0])
+		})
+	},
+}
+
+var pinPeerCmd = &cobra.Command{
+	Use:   "pin <address>",
+	Short: "Pin a peer so the node stays connected to it",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if peerPinDuration < 0 {
+			reportErrorf(errorNodePeers, fmt.Errorf("negative pin duration %v", peerPinDuration))
+		}
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			if err := client.PinPeer(args[0], uint64(peerPinDuration/time.Second)); err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			reportInfof(infoNodePinnedPeer, args[0])
+		})
+	},
+}
+
+var unpinPeerCmd = &cobra.Command{
+	Use:   "unpin <address>",
+	Short: "Lift the pin of a peer",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			if err := client.UnpinPeer(args[0]); err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			reportInfof(infoNodeUnpinnedPeer, args[0])
+		})
+	},
+}
+
+var listPinnedPeersCmd = &cobra.Command{
+	Use:   "pins",
+	Short: "List the pinned peers",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.PinnedPeers()
+			if err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			if len(response.Pins) == 0 {
+				reportInfoln(infoNodeNoPinnedPeers)
+				return
+			}
+			for _, pin := range response.Pins {
+				expires := "never"
+				if pin.Expires != nil {
+					expires = time.Unix(int64(*pin.Expires), 0).UTC().Format(time.RFC3339)
+				}
+				fmt.Printf("%s\texpires=%s\n", pin.Address, expires)
+			}
+		})
+	},
+}
+
+var peerTrafficCmd = &cobra.Command{
+	Use:   "traffic [address]",
+	Short: "Show the bytes and messages exchanged with the peers by message tag",
+	Long:  "Show the bytes and messages exchanged with the peers by message tag, since they connected and over sliding windows. The output can be limited to a peer given by its address or libp2p peer ID.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.Peers()
+			if err != nil {
+				reportErrorf(errorNodePeers, err)
+			}
+			found := false
+			for _, p := range response.Peers {
+				if len(args) > 0 && args[0] != p.Address && (p.PeerId == nil || args[0] != *p.PeerId) {
+					continue
+				}
+				found = true
+				fmt.Println(p.Address)
+				for _, t := range p.Traffic {
+					line := fmt.Sprintf("\t%s\treceived=%dB/%d\tsent=%dB/%d", t.Tag, t.BytesReceived, t.MessagesReceived, t.BytesSent, t.MessagesSent)
+					for _, w := range t.Windows {
+						line += fmt.Sprintf("\t%s: received=%dB/%d sent=%dB/%d", time.Duration(w.Seconds)*time.Second, w.BytesReceived, w.MessagesReceived, w.BytesSent, w.MessagesSent)
+					}
+					fmt.Println(line)
+				}
+			}
+			if !found {
+				reportInfoln(infoNodeNoPeers)
+			}
+		})
+	},
+}
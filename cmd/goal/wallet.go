@@ -29,19 +29,27 @@ import (
 	"github.com/DePINNetwork/depin-sdk/crypto"
 	"github.com/DePINNetwork/depin-sdk/crypto/passphrase"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/lib/kmdapi"
+	"github.com/DePINNetwork/depin-sdk/libgoal"
+	"github.com/DePINNetwork/depin-sdk/util"
 )
 
 var (
 	recoverWallet           bool
 	createUnencryptedWallet bool
 	noDisplaySeed           bool
+	createHDWallet          bool
 	defaultWalletName       string
+	backupWalletName        string
+	exportWalletName        string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
 	walletCmd.AddCommand(renameWalletCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
+	walletCmd.AddCommand(exportWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
@@ -50,6 +58,11 @@ func init() {
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
 	newWalletCmd.Flags().BoolVar(&createUnencryptedWallet, "unencrypted", false, "Create a new wallet without a password.")
 	newWalletCmd.Flags().BoolVar(&noDisplaySeed, "no-display-seed", false, "Create a new wallet without displaying the seed phrase.")
+	newWalletCmd.Flags().BoolVar(&createHDWallet, "hd", false, "Create a hierarchical deterministic wallet, backed up by a 24-word BIP-39 mnemonic. Accounts are derived along BIP-44 paths (m/44'/283'/n'/0'/0'), and --recover expects a BIP-39 mnemonic of 12, 15, 18, 21 or 24 words")
+
+	backupWalletCmd.Flags().StringVarP(&backupWalletName, "wallet", "w", "", "Set the wallet to back up")
+
+	exportWalletCmd.Flags().StringVarP(&exportWalletName, "wallet", "w", "", "Set the wallet whose mnemonic to export")
 }
 
 var walletCmd = &cobra.Command{
@@ -99,6 +112,7 @@ var newWalletCmd = &cobra.Command{
 
 		// Check if we should recover the wallet from a mnemonic
 		var mdk crypto.MasterDerivationKey
+		var bip39Mnemonic string
 		if recoverWallet {
 			fmt.Println(infoRecoveryPrompt)
 			resp, err := reader.ReadString('\n')
@@ -106,15 +120,24 @@ var newWalletCmd = &cobra.Command{
 			if err != nil {
 				reportErrorf(errorFailedToReadResponse, err)
 			}
-			var key []byte
-			key, err = passphrase.MnemonicToKey(resp)
-			if err != nil {
-				reportErrorf(errorBadMnemonic, err)
-			}
-			// Copy the recovered key into the mdk
-			n := copy(mdk[:], key)
-			if n != len(mdk) {
-				reportErrorln(errorBadRecoveredKey)
+			if createHDWallet {
+				// BIP-39 mnemonics of any standard length are handed to kmd
+				// as is, since shorter ones don't fit a master derivation key
+				_, err = passphrase.BIP39MnemonicToEntropy(resp)
+				if err != nil {
+					reportErrorf(errorBadMnemonic, err)
+				}
+				bip39Mnemonic = resp
+			} else {
+				key, err := passphrase.MnemonicToKey(resp)
+				if err != nil {
+					reportErrorf(errorBadMnemonic, err)
+				}
+				// Copy the recovered key into the mdk
+				n := copy(mdk[:], key)
+				if n != len(mdk) {
+					reportErrorln(errorBadRecoveredKey)
+				}
 			}
 		}
 
@@ -139,7 +162,14 @@ var newWalletCmd = &cobra.Command{
 
 		// Create the wallet
 		reportInfoln(infoCreatingWallet)
-		walletID, err := client.CreateWallet(walletName, walletPassword, mdk)
+		var walletID []byte
+		if bip39Mnemonic != "" {
+			walletID, err = client.CreateHDWalletFromMnemonic(walletName, walletPassword, bip39Mnemonic)
+		} else if createHDWallet {
+			walletID, err = client.CreateHDWallet(walletName, walletPassword, mdk)
+		} else {
+			walletID, err = client.CreateWallet(walletName, walletPassword, mdk)
+		}
 		if err != nil {
 			reportErrorf(errorCouldntCreateWallet, err)
 		}
@@ -164,17 +194,7 @@ var newWalletCmd = &cobra.Command{
 				// Invalidate the handle when we're done with it
 				defer client.ReleaseWalletHandle(token)
 
-				// Export the master derivation key
-				mdk, err := client.ExportMasterDerivationKey(token, walletPassword)
-				if err != nil {
-					reportErrorf(errorCouldntExportMDK, err)
-				}
-
-				// Convert the key to a mnemonic
-				mnemonic, err := passphrase.KeyToMnemonic(mdk[:])
-				if err != nil {
-					reportErrorf(errorCouldntMakeMnemonic, err)
-				}
+				mnemonic := exportWalletMnemonic(client, token, walletPassword)
 
 				// Display the mnemonic to the user
 				reportInfoln(infoPrintedBackupPhrase)
@@ -279,3 +299,138 @@ func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	}
 	fmt.Println(strings.Repeat("#", 50))
 }
+
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup [output file]",
+	Short: "Write an encrypted backup of a wallet to a file",
+	Long:  "Write an encrypted backup of a wallet to a file. The backup holds the wallet's master derivation key, generated and imported keys, and multisig addresses, and is protected by a separate backup password. Restore it with `goal wallet restore`.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		outFile := args[0]
+
+		// Refuse to overwrite an existing file before asking for passwords
+		if util.FileExists(outFile) {
+			reportErrorf(errorBackupFileExists, outFile)
+		}
+
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, backupWalletName, true)
+
+		// Fetch a password for the backup
+		fmt.Print(infoChooseBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+		fmt.Print(infoPasswordConfirmation)
+		if !bytes.Equal(backupPassword, ensurePassword()) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		archive, err := client.BackupWallet(wh, pw, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+
+		f, err := os.OpenFile(outFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, outFile, err)
+		}
+		_, err = f.Write(archive)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			reportErrorf(fileWriteError, outFile, err)
+		}
+
+		reportInfof(infoWroteWalletBackup, outFile)
+	},
+}
+
+var exportWalletCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Display the backup mnemonic of a wallet",
+	Long:  "Display the backup mnemonic of a wallet: the BIP-39 mnemonic of a hierarchical deterministic wallet, or the 25-word mnemonic of its master derivation key otherwise. Recover the wallet with `goal wallet new --recover`. Keys imported into the wallet are not covered by the mnemonic.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, exportWalletName, true)
+
+		mnemonic := exportWalletMnemonic(client, wh, pw)
+		reportInfoln(infoPrintedBackupPhrase)
+		reportInfof(infoBackupPhrase, mnemonic)
+	},
+}
+
+// exportWalletMnemonic returns the mnemonic a wallet can be recovered from:
+// its BIP-39 mnemonic if it is hierarchical deterministic, or the 25-word
+// mnemonic of its master derivation key otherwise
+func exportWalletMnemonic(client libgoal.Client, wh []byte, pw []byte) string {
+	mnemonic, err := client.ExportBIP39Mnemonic(wh, pw)
+	if err != nil {
+		reportErrorf(errorCouldntExportMDK, err)
+	}
+	if mnemonic != "" {
+		return mnemonic
+	}
+
+	mdk, err := client.ExportMasterDerivationKey(wh, pw)
+	if err != nil {
+		reportErrorf(errorCouldntExportMDK, err)
+	}
+	mnemonic, err = passphrase.KeyToMnemonic(mdk[:])
+	if err != nil {
+		reportErrorf(errorCouldntMakeMnemonic, err)
+	}
+	return mnemonic
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [backup file] [wallet name]",
+	Short: "Create a wallet from an encrypted backup",
+	Long:  "Create a wallet from a backup written by `goal wallet backup`. The backup is checked for tampering before anything is restored. The wallet keeps its backed up name unless a new one is given.",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		archive, err := os.ReadFile(args[0])
+		if err != nil {
+			reportErrorf(fileReadError, args[0], err)
+		}
+		var walletName []byte
+		if len(args) > 1 {
+			walletName = []byte(args[1])
+		}
+
+		fmt.Print(infoBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+
+		// Fetch a password for the restored wallet
+		fmt.Print(infoChooseRestoredPasswordPrompt)
+		walletPassword := ensurePassword()
+		fmt.Print(infoPasswordConfirmation)
+		if !bytes.Equal(walletPassword, ensurePassword()) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		reportInfoln(infoRestoringWallet)
+		walletID, err := client.RestoreWallet(walletName, walletPassword, archive, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		reportInfoln(infoRestoredWallet)
+
+		// Check if we're the only wallet
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+
+		// We are the only wallet -- make us the default
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
@@ -50,6 +50,16 @@ func (m *MockCatchpointCatchupAccessor) SetLabel(ctx context.Context, label stri
 	return nil
 }
 
+// GetCatchpointFile returns the path of the local catchpoint file the catchpoint catchup loads the ledger from, if any
+func (m *MockCatchpointCatchupAccessor) GetCatchpointFile(ctx context.Context) (path string, err error) {
+	return "", nil
+}
+
+// SetCatchpointFile set the path of the local catchpoint file the catchpoint catchup loads the ledger from
+func (m *MockCatchpointCatchupAccessor) SetCatchpointFile(ctx context.Context, path string) (err error) {
+	return nil
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (m *MockCatchpointCatchupAccessor) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	return nil
@@ -60,6 +70,16 @@ func (m *MockCatchpointCatchupAccessor) ProcessStagingBalances(ctx context.Conte
 	return nil
 }
 
+// GetStagingProgress returns the number of catchpoint file entries staged so far and the staging progress
+func (m *MockCatchpointCatchupAccessor) GetStagingProgress(ctx context.Context) (staged uint64, progress ledger.CatchpointCatchupAccessorProgress, err error) {
+	return 0, ledger.CatchpointCatchupAccessorProgress{}, nil
+}
+
+// SetStagingProgress stores the number of catchpoint file entries staged so far and the staging progress
+func (m *MockCatchpointCatchupAccessor) SetStagingProgress(ctx context.Context, staged uint64, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	return nil
+}
+
 // BuildMerkleTrie inserts the account hashes into the merkle trie
 func (m *MockCatchpointCatchupAccessor) BuildMerkleTrie(ctx context.Context, progressUpdates func(uint64, uint64)) (err error) {
 	return nil
@@ -296,6 +296,11 @@ type Local struct {
 	// EnableIncomingMessageFilter enable the filtering of incoming messages.
 	EnableIncomingMessageFilter bool `version[0]:"false"`
 
+	// CompressedMessageTags is a comma-separated list of message tags (for example "AV,VB,TX") this node offers
+	// to exchange zstd-compressed with a shared dictionary. Compression for a tag is used on a connection only
+	// when both peers offer it with the same dictionary. An empty value disables per-tag compression.
+	CompressedMessageTags string `version[35]:""`
+
 	// DeadlockDetection controls enabling or disabling deadlock detection.
 	// negative (-1) to disable, positive (1) to enable, 0 for default.
 	DeadlockDetection int `version[1]:"0"`
@@ -370,6 +375,18 @@ type Local struct {
 	// EnableNetDevMetrics exposes network interface total bytes sent/received metrics in /metrics
 	EnableNetDevMetrics bool `version[34]:"false"`
 
+	// EnableTracing enables OpenTelemetry tracing of the transaction lifecycle: REST submission or gossip,
+	// the transaction handler backlog, verification, transaction pool admission, broadcast, block assembly and commit.
+	// Spans are exported to the OTLP collector at TracingOTLPEndpoint.
+	EnableTracing bool `version[35]:"false"`
+
+	// TracingOTLPEndpoint is the URL of the OTLP/HTTP collector traces are exported to when EnableTracing is set.
+	TracingOTLPEndpoint string `version[35]:"http://localhost:4318"`
+
+	// TracingSamplePercent is the percentage of transaction groups that are traced when EnableTracing is set.
+	// Transactions submitted with a sampled W3C traceparent header are always traced.
+	TracingSamplePercent uint64 `version[35]:"1"`
+
 	// TelemetryToLog configures whether to record messages to node.log that are normally only sent to remote event monitoring.
 	TelemetryToLog bool `version[5]:"true"`
 
@@ -401,6 +418,11 @@ type Local struct {
 	// 0 means don't store any, -1 mean unlimited and positive number suggest the maximum number of most recent catchpoint files to store.
 	CatchpointFileHistoryLength int `version[7]:"365"`
 
+	// EnableCatchpointChunkHashes makes the node generate catchpoint files of version 9, which are compressed with zstd
+	// rather than gzip and list a hash of every chunk in their header, allowing a corrupted chunk to be detected as soon as it is downloaded.
+	// Nodes that predate this catchpoint file version are unable to catch up off these files, so it is disabled by default.
+	EnableCatchpointChunkHashes bool `version[35]:"false"`
+
 	// EnableGossipService enables the gossip network HTTP websockets endpoint. The functionality of this depends on NetAddress, which must also be provided.
 	// This functionality is required for serving gossip traffic.
 	EnableGossipService bool `version[33]:"true"`
@@ -426,6 +448,10 @@ type Local struct {
 	// CatchupLedgerDownloadRetryAttempts controls the number of attempt the ledger fetching would be attempted before giving up catching up to the provided catchpoint.
 	CatchupLedgerDownloadRetryAttempts int `version[9]:"50"`
 
+	// CatchupLedgerDownloadParallelism is the maximal number of peers the catchpoint file is downloaded from in parallel, each of them
+	// serving a different part of it. Setting it to 1 downloads the catchpoint file from a single peer at a time.
+	CatchupLedgerDownloadParallelism int `version[35]:"4"`
+
 	// CatchupBlockDownloadRetryAttempts controls the number of attempts the block fetcher would make before giving up on a provided catchpoint.
 	CatchupBlockDownloadRetryAttempts int `version[9]:"1000"`
 
@@ -484,6 +510,26 @@ type Local struct {
 	// StatusNotFound (404)
 	BlockServiceCustomFallbackEndpoints string `version[16]:""`
 
+	// BlockArchiveS3Bucket is the name of the S3-compatible bucket holding the block archive, which is written when
+	// EnableBlockArchiveExport is set and read when EnableBlockArchiveCatchup is set.
+	BlockArchiveS3Bucket string `version[35]:""`
+
+	// BlockArchiveS3Endpoint is the URL of the S3-compatible service holding BlockArchiveS3Bucket, such as a MinIO
+	// deployment. If empty, the AWS S3 endpoint of the region given by the S3_REGION environment variable is used.
+	BlockArchiveS3Endpoint string `version[35]:""`
+
+	// EnableBlockArchiveExport makes an archival node upload its committed blocks and certificates to BlockArchiveS3Bucket,
+	// in bundles of BlockArchiveBundleRounds rounds each.
+	EnableBlockArchiveExport bool `version[35]:"false"`
+
+	// BlockArchiveBundleRounds is the number of rounds stored in every bundle uploaded to the block archive.
+	// It only applies to a bucket the node starts exporting to; an existing archive keeps the bundle size it was created with.
+	BlockArchiveBundleRounds uint64 `version[35]:"1000"`
+
+	// EnableBlockArchiveCatchup makes the catchup service download blocks from the block archive in BlockArchiveS3Bucket
+	// before turning to relays and archival nodes for the rounds the archive does not have.
+	EnableBlockArchiveCatchup bool `version[35]:"false"`
+
 	// CatchupBlockValidateMode is a development and testing configuration used by the catchup service.
 	// It can be used to omit certain validations to speed up the catchup process, or to apply extra validations which are redundant in normal operation.
 	// This field is a bit-field with:
@@ -498,6 +544,12 @@ type Local struct {
 	//      previously used executabled, and would not provide any additional security guarantees.
 	CatchupBlockValidateMode int `version[16]:"0"`
 
+	// CatchupSignatureVerificationLookahead is the number of rounds ahead of the ledger for which the catchup service
+	// verifies the transaction signatures and logicsigs of the fetched blocks, while the preceding blocks are evaluated.
+	// It only applies when CatchupBlockValidateMode enables the transaction signatures verification. Setting it to 0
+	// verifies the signatures of every block only once the block is evaluated.
+	CatchupSignatureVerificationLookahead uint64 `version[35]:"4"`
+
 	// EnableAccountUpdatesStats specifies whether or not to emit the AccountUpdates telemetry event.
 	EnableAccountUpdatesStats bool `version[16]:"false"`
 
@@ -545,6 +597,16 @@ type Local struct {
 	// RestConnectionsHardLimit is the maximum number of active connections the API server will accept before closing requests with no response.
 	RestConnectionsHardLimit uint64 `version[20]:"2048"`
 
+	// RestClientRateLimit is the number of request cost units per second each REST API client may use.
+	// Clients using a scoped API token are identified by the token, and other clients by their IP address (see
+	// UseXForwardedForAddressField). Most requests cost one unit while expensive ones such as simulate cost more.
+	// Requests above the limit are answered with 429 Too Many Requests and a Retry-After header. 0 disables the limit.
+	RestClientRateLimit uint64 `version[35]:"0"`
+
+	// RestClientRateLimitBurst is the maximum number of request cost units a REST API client may use at once
+	// when RestClientRateLimit is enabled.
+	RestClientRateLimitBurst uint64 `version[35]:"200"`
+
 	// MaxAPIResourcesPerAccount sets the maximum total number of resources (created assets, created apps,
 	// asset holdings, and application local state) per account that will be allowed in AccountInformation
 	// REST API responses before returning a 400 Bad Request. Set zero for no limit.
@@ -567,6 +629,20 @@ type Local struct {
 	// i.e. the block DB can return transaction IDs for questions for the range Latest-MaxBlockHistoryLookback...Latest
 	MaxBlockHistoryLookback uint64 `version[31]:"0"`
 
+	// BlockRetentionWindowSeconds makes a non-archival node keep, in addition to the blocks kept because of
+	// MaxBlockHistoryLookback, all the blocks created within this many seconds of the latest block.
+	// The default of 0 disables retention by age.
+	BlockRetentionWindowSeconds uint64 `version[35]:"0"`
+
+	// BlockRetentionSparseInterval makes a non-archival node keep every block whose round is a multiple of this
+	// interval once it falls out of the range of recent blocks the node retains. The default of 0 keeps none of them.
+	BlockRetentionSparseInterval uint64 `version[35]:"0"`
+
+	// BlockRetentionKeepStateProofRounds makes a non-archival node keep every block whose round is a multiple of the
+	// state proof interval once it falls out of the range of recent blocks the node retains, as these blocks hold the
+	// state proof commitments light clients verify transactions against.
+	BlockRetentionKeepStateProofRounds bool `version[35]:"false"`
+
 	// EnableUsageLog enables 10Hz log of CPU and RAM usage.
 	// Also adds 'algod_ram_usage` (number of bytes in use) to /metrics
 	EnableUsageLog bool `version[24]:"false"`
@@ -628,6 +704,26 @@ type Local struct {
 	// EnableDHT will turn on the hash table for use with capabilities advertisement
 	EnableDHTProviders bool `version[34]:"false"`
 
+	// EnableP2PMDNSDiscovery enables discovery of peers of the same genesis on the local network segment via multicast DNS.
+	// It is intended for private networks on a LAN or in containers. The node must listen (NetAddress is set) to be discoverable.
+	// This is only used when EnableP2P or EnableP2PHybridMode is true.
+	EnableP2PMDNSDiscovery bool `version[35]:"false"`
+
+	// EnableP2PQUICTransport enables the QUIC transport in addition to TCP for the p2p network.
+	// When NetAddress is set the node also listens for QUIC connections on the same port over UDP.
+	// This is only used when EnableP2P or EnableP2PHybridMode is true.
+	EnableP2PQUICTransport bool `version[35]:"false"`
+
+	// EnableP2PWebTransport enables the WebTransport transport (over QUIC) for the p2p network.
+	// When NetAddress is set the node also listens for WebTransport sessions on the same port over UDP.
+	// This is only used when EnableP2P or EnableP2PHybridMode is true.
+	EnableP2PWebTransport bool `version[35]:"false"`
+
+	// P2PPreferQUIC controls which transport is dialed first when a peer advertises both TCP and QUIC addresses.
+	// When true, QUIC addresses are dialed ahead of TCP ones; when false, TCP is dialed first and QUIC is used as a fallback.
+	// This is only used when EnableP2PQUICTransport or EnableP2PWebTransport is true.
+	P2PPreferQUIC bool `version[35]:"true"`
+
 	// P2PPersistPeerID will write the private key used for the node's PeerID to the P2PPrivateKeyLocation.
 	// This is only used when P2PEnable is true. If P2PPrivateKey is not specified, it uses the default location.
 	P2PPersistPeerID bool `version[29]:"false"`
@@ -643,6 +739,20 @@ type Local struct {
 	// GoMemLimit provides the Go runtime with a soft memory limit. The default behavior is no limit,
 	// unless the GOMEMLIMIT environment variable is set.
 	GoMemLimit uint64 `version[34]:"0"`
+
+	// EnablePeerScoring turns on accumulating misbehavior penalties, such as invalid transactions and votes or
+	// oversized messages, against gossip peers. Peers whose score reaches PeerBanThreshold are banned by IP address
+	// and libp2p peer ID for PeerBanDurationSeconds. Bans added through the admin API apply regardless of this setting.
+	EnablePeerScoring bool `version[35]:"false"`
+
+	// PeerBanThreshold is the misbehavior score at which a peer gets banned when EnablePeerScoring is set.
+	PeerBanThreshold uint64 `version[35]:"100"`
+
+	// PeerScoreHalfLifeSeconds is the time it takes for a peer's accumulated misbehavior score to decay by half.
+	PeerScoreHalfLifeSeconds uint64 `version[35]:"600"`
+
+	// PeerBanDurationSeconds is how long a peer stays banned after its misbehavior score reaches PeerBanThreshold.
+	PeerBanDurationSeconds uint64 `version[35]:"3600"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -29,7 +29,13 @@ var defaultLocal = Local{
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
+	BlockArchiveBundleRounds:                   1000,
+	BlockArchiveS3Bucket:                       "",
+	BlockArchiveS3Endpoint:                     "",
 	BlockDBDir:                                 "",
+	BlockRetentionKeepStateProofRounds:         false,
+	BlockRetentionSparseInterval:               0,
+	BlockRetentionWindowSeconds:                0,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BlockServiceMemCap:                         500000000,
 	BroadcastConnectionsLimit:                  -1,
@@ -44,9 +50,12 @@ var defaultLocal = Local{
 	CatchupFailurePeerRefreshRate:              10,
 	CatchupGossipBlockFetchTimeoutSec:          4,
 	CatchupHTTPBlockFetchTimeoutSec:            4,
+	CatchupLedgerDownloadParallelism:           4,
 	CatchupLedgerDownloadRetryAttempts:         50,
 	CatchupParallelBlocks:                      16,
+	CatchupSignatureVerificationLookahead:      4,
 	ColdDataDir:                                "",
+	CompressedMessageTags:                      "",
 	ConnectionsRateLimitingCount:               60,
 	ConnectionsRateLimitingWindowSeconds:       1,
 	CrashDBDir:                                 "",
@@ -63,7 +72,10 @@ var defaultLocal = Local{
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
 	EnableAssembleStats:                        false,
+	EnableBlockArchiveCatchup:                  false,
+	EnableBlockArchiveExport:                   false,
 	EnableBlockService:                         false,
+	EnableCatchpointChunkHashes:                false,
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
@@ -77,6 +89,10 @@ var defaultLocal = Local{
 	EnableOutgoingNetworkMessageFiltering:      true,
 	EnableP2P:                                  false,
 	EnableP2PHybridMode:                        false,
+	EnableP2PMDNSDiscovery:                     false,
+	EnableP2PQUICTransport:                     false,
+	EnableP2PWebTransport:                      false,
+	EnablePeerScoring:                          false,
 	EnablePingHandler:                          true,
 	EnablePrivateNetworkAccessHeader:           false,
 	EnableProcessBlockStats:                    false,
@@ -84,6 +100,7 @@ var defaultLocal = Local{
 	EnableRequestLogger:                        false,
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
+	EnableTracing:                              false,
 	EnableTxBacklogAppRateLimiting:             true,
 	EnableTxBacklogRateLimiting:                true,
 	EnableTxnEvalTracer:                        false,
@@ -124,15 +141,21 @@ var defaultLocal = Local{
 	P2PHybridIncomingConnectionsLimit:          1200,
 	P2PHybridNetAddress:                        "",
 	P2PPersistPeerID:                           false,
+	P2PPreferQUIC:                              true,
 	P2PPrivateKeyLocation:                      "",
 	ParticipationKeysRefreshInterval:           60000000000,
+	PeerBanDurationSeconds:                     3600,
+	PeerBanThreshold:                           100,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
+	PeerScoreHalfLifeSeconds:                   600,
 	PriorityPeers:                              map[string]bool{},
 	ProposalAssemblyTime:                       500000000,
 	PublicAddress:                              "",
 	ReconnectTime:                              60000000000,
 	ReservedFDs:                                256,
+	RestClientRateLimit:                        0,
+	RestClientRateLimitBurst:                   200,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
 	RestReadTimeoutSeconds:                     15,
@@ -145,6 +168,8 @@ var defaultLocal = Local{
 	TLSCertFile:                                "",
 	TLSKeyFile:                                 "",
 	TelemetryToLog:                             true,
+	TracingOTLPEndpoint:                        "http://localhost:4318",
+	TracingSamplePercent:                       1,
 	TrackerDBDir:                               "",
 	TransactionSyncDataExchangeRate:            0,
 	TransactionSyncSignificantMessageThreshold: 0,
@@ -29,6 +29,22 @@ import (
 // it's implemented in ./config/defaults_gen.go, and should be the only "consumer" of this exported variable
 var AutogenLocal = GetVersionedDefaultLocalConfig(getLatestConfigVersion())
 
+// deprecatedFields are the fields of Local which are kept so that older configuration files still load,
+// but which the node no longer uses.
+var deprecatedFields = map[string]bool{
+	"ReconnectTime":                    true,
+	"PeerPingPeriodSeconds":            true,
+	"EnableTopAccountsReporting":       true,
+	"SuggestedFeeBlockHistory":         true,
+	"SuggestedFeeSlidingWindowSize":    true,
+	"ParticipationKeysRefreshInterval": true,
+}
+
+// IsDeprecated returns true if the named field of Local is no longer used by the node.
+func IsDeprecated(field string) bool {
+	return deprecatedFields[field]
+}
+
 func migrate(cfg Local) (newCfg Local, err error) {
 	newCfg = cfg
 	latestConfigVersion := getLatestConfigVersion()
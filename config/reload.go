@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// liveReloadableFields are the fields a running node applies when its configuration is reloaded.
+// A change of any other field only takes effect after a restart.
+var liveReloadableFields = map[string]bool{
+	"BaseLoggerDebugLevel":               true,
+	"TxPoolSize":                         true,
+	"TxBacklogRateLimitingCongestionPct": true,
+	"MaxConnectionsPerIP":                true,
+	"EnableRequestLogger":                true,
+	"RestClientRateLimit":                true,
+	"RestClientRateLimitBurst":           true,
+}
+
+// NetworkReloadableFields are the live reloadable fields applied by the websocket network. A node running the
+// P2P network alone only applies them after a restart.
+var NetworkReloadableFields = []string{"MaxConnectionsPerIP", "EnableRequestLogger"}
+
+// IsLiveReloadable returns true if a change of the named field is applied by a running node when its
+// configuration is reloaded.
+func IsLiveReloadable(field string) bool {
+	return liveReloadableFields[field]
+}
+
+// ChangedFields returns the names of the fields whose values differ between cfg and other.
+func (cfg Local) ChangedFields(other Local) []string {
+	var changed []string
+	v1 := reflect.ValueOf(cfg)
+	v2 := reflect.ValueOf(other)
+	for i := 0; i < v1.NumField(); i++ {
+		if !reflect.DeepEqual(v1.Field(i).Interface(), v2.Field(i).Interface()) {
+			changed = append(changed, v1.Type().Field(i).Name)
+		}
+	}
+	return changed
+}
+
+// ApplyReloaded updates cfg, the configuration of a running node, with the live reloadable fields that changed
+// between previous and reloaded, two versions of the configuration file. The notApplied fields are live reloadable
+// fields that the running node does not apply, which are handled like the other fields needing a restart.
+// It returns the names of the fields that were applied and of the changed fields that only take effect after
+// a restart. Nothing is applied if a reloaded value is invalid.
+func (cfg *Local) ApplyReloaded(previous, reloaded Local, notApplied ...string) (applied []string, restartRequired []string, err error) {
+	changed := previous.ChangedFields(reloaded)
+	for _, field := range changed {
+		if IsLiveReloadable(field) && !slices.Contains(notApplied, field) {
+			applied = append(applied, field)
+		} else {
+			restartRequired = append(restartRequired, field)
+		}
+	}
+
+	for _, issue := range reloaded.Validate() {
+		if !issue.Warning && IsLiveReloadable(issue.Field) && !slices.Contains(notApplied, issue.Field) {
+			return nil, nil, fmt.Errorf("invalid value for %s: %s", issue.Field, issue.Message)
+		}
+	}
+
+	dst := reflect.ValueOf(cfg).Elem()
+	src := reflect.ValueOf(reloaded)
+	for _, field := range applied {
+		dst.FieldByName(field).Set(src.FieldByName(field))
+	}
+	return applied, restartRequired, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestLocalChangedFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := GetDefaultLocal()
+	other := cfg
+	require.Empty(t, cfg.ChangedFields(other))
+
+	other.TxPoolSize++
+	other.NetAddress = ":4160"
+	other.DNSBootstrapID = ""
+	require.ElementsMatch(t, []string{"DNSBootstrapID", "NetAddress", "TxPoolSize"}, cfg.ChangedFields(other))
+}
+
+func TestLocalApplyReloaded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	previous := GetDefaultLocal()
+	// the running configuration may differ from the file, e.g. because of command line overrides
+	running := previous
+	running.NetAddress = "127.0.0.1:4160"
+	running.GossipFanout = 1
+
+	reloaded := previous
+	reloaded.TxPoolSize = 100
+	reloaded.BaseLoggerDebugLevel = 5
+	reloaded.EnableRequestLogger = true
+	reloaded.IncomingConnectionsLimit = 10
+
+	applied, restartRequired, err := running.ApplyReloaded(previous, reloaded)
+	a.NoError(err)
+	a.ElementsMatch([]string{"TxPoolSize", "BaseLoggerDebugLevel", "EnableRequestLogger"}, applied)
+	a.Equal([]string{"IncomingConnectionsLimit"}, restartRequired)
+	a.Equal(100, running.TxPoolSize)
+	a.Equal(uint32(5), running.BaseLoggerDebugLevel)
+	a.True(running.EnableRequestLogger)
+	a.Equal(previous.IncomingConnectionsLimit, running.IncomingConnectionsLimit)
+	a.Equal("127.0.0.1:4160", running.NetAddress)
+	a.Equal(1, running.GossipFanout)
+
+	// the live reloadable fields that the node does not apply need a restart
+	networkReloaded := previous
+	networkReloaded.MaxConnectionsPerIP++
+	networkReloaded.EnableRequestLogger = true
+	p2pRunning := previous
+	applied, restartRequired, err = p2pRunning.ApplyReloaded(previous, networkReloaded, NetworkReloadableFields...)
+	a.NoError(err)
+	a.Empty(applied)
+	a.ElementsMatch(NetworkReloadableFields, restartRequired)
+	a.Equal(previous, p2pRunning)
+
+	invalid := reloaded
+	invalid.TxBacklogRateLimitingCongestionPct = 120
+	before := running
+	_, _, err = running.ApplyReloaded(reloaded, invalid)
+	a.ErrorContains(err, "TxBacklogRateLimitingCongestionPct")
+	a.Equal(before, running)
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ValidationIssue is a problem found in a configuration file
+type ValidationIssue struct {
+	// Field is the configuration field, or the unknown key of the file, the issue is about
+	Field string
+	// Message describes the issue
+	Message string
+	// Warning is set for issues which do not prevent the node from running as configured
+	Warning bool
+}
+
+func (i ValidationIssue) String() string {
+	severity := "error"
+	if i.Warning {
+		severity = "warning"
+	}
+	return fmt.Sprintf("%s: %s: %s", severity, i.Field, i.Message)
+}
+
+// FieldDiff is a configuration field set to a value other than its default
+type FieldDiff struct {
+	Field   string
+	Default interface{}
+	Value   interface{}
+}
+
+// ValidateConfigFile checks the contents of a configuration file for unknown keys, values of the wrong type and
+// deprecated fields, then loads it the way the node does and checks the rules across fields with Validate.
+func ValidateConfigFile(data []byte) ([]ValidationIssue, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+
+	localType := reflect.TypeOf(Local{})
+	fieldsByLowerName := make(map[string]reflect.StructField, localType.NumField())
+	for i := 0; i < localType.NumField(); i++ {
+		field := localType.Field(i)
+		fieldsByLowerName[strings.ToLower(field.Name)] = field
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []ValidationIssue
+	for _, name := range names {
+		field, ok := localType.FieldByName(name)
+		if !ok {
+			// the decoder matches keys to fields ignoring case, so such a key is still used
+			field, ok = fieldsByLowerName[strings.ToLower(name)]
+			if !ok {
+				issues = append(issues, ValidationIssue{Field: name, Message: "unknown key"})
+				continue
+			}
+			issues = append(issues, ValidationIssue{Field: name, Message: fmt.Sprintf("key is loaded as %s, use the exact field name", field.Name), Warning: true})
+		}
+		if err := json.Unmarshal(keys[name], reflect.New(field.Type).Interface()); err != nil {
+			issues = append(issues, ValidationIssue{Field: field.Name, Message: fmt.Sprintf("invalid value %s for type %s", keys[name], field.Type)})
+			continue
+		}
+		if IsDeprecated(field.Name) {
+			issues = append(issues, ValidationIssue{Field: field.Name, Message: "field is deprecated and unused", Warning: true})
+		}
+	}
+	if len(issues) > 0 && !onlyWarnings(issues) {
+		// the file would not load
+		return issues, nil
+	}
+
+	cfg := defaultLocal
+	cfg.Version = 0
+	if err := loadConfig(bytes.NewReader(data), &cfg); err != nil {
+		return append(issues, ValidationIssue{Field: "Version", Message: err.Error()}), nil
+	}
+	cfg, err := enrichNetworkingConfig(cfg)
+	if err != nil {
+		// only hybrid mode without a public address is rejected
+		return append(issues, ValidationIssue{Field: "PublicAddress", Message: err.Error()}), nil
+	}
+	cfg, err = migrate(cfg)
+	if err != nil {
+		return append(issues, ValidationIssue{Field: "Version", Message: err.Error()}), nil
+	}
+	return append(issues, cfg.Validate()...), nil
+}
+
+func onlyWarnings(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if !issue.Warning {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the values of the configuration and the combinations of fields which the node does not support
+// or which do not behave as they might be expected to.
+func (cfg Local) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+	if cfg.BaseLoggerDebugLevel > 5 {
+		issues = append(issues, ValidationIssue{Field: "BaseLoggerDebugLevel", Message: fmt.Sprintf("invalid value %d, the highest level is 5", cfg.BaseLoggerDebugLevel)})
+	}
+	if cfg.TxPoolSize < 1 {
+		issues = append(issues, ValidationIssue{Field: "TxPoolSize", Message: fmt.Sprintf("invalid value %d, the pool needs room for at least one transaction", cfg.TxPoolSize)})
+	}
+	if cfg.TxBacklogRateLimitingCongestionPct > 100 || cfg.TxBacklogRateLimitingCongestionPct < 0 {
+		issues = append(issues, ValidationIssue{Field: "TxBacklogRateLimitingCongestionPct", Message: fmt.Sprintf("invalid value %d, it must be between 0 and 100", cfg.TxBacklogRateLimitingCongestionPct)})
+	}
+	if err := cfg.ValidateP2PHybridConfig(); err != nil {
+		issues = append(issues, ValidationIssue{Field: "P2PHybridNetAddress", Message: err.Error()})
+	}
+	switch cfg.CatchpointTracking {
+	case CatchpointTrackingModeUntracked, CatchpointTrackingModeAutomatic, CatchpointTrackingModeTracked, CatchpointTrackingModeStored:
+	default:
+		issues = append(issues, ValidationIssue{Field: "CatchpointTracking", Message: fmt.Sprintf("unknown value %d is treated as automatic", cfg.CatchpointTracking), Warning: true})
+	}
+	if cfg.Archival && cfg.MaxBlockHistoryLookback > 0 {
+		issues = append(issues, ValidationIssue{Field: "MaxBlockHistoryLookback", Message: "has no effect on archival nodes, which keep all blocks", Warning: true})
+	}
+	if !cfg.Archival && cfg.StoresCatchpoints() && cfg.MaxBlockHistoryLookback < cfg.CatchpointInterval {
+		issues = append(issues, ValidationIssue{Field: "MaxBlockHistoryLookback", Message: fmt.Sprintf("nodes storing catchpoints should keep at least CatchpointInterval (%d) blocks to serve catchpoint catchup", cfg.CatchpointInterval), Warning: true})
+	}
+	if cfg.EnableFollowMode && (cfg.EnableP2P || cfg.EnableP2PHybridMode) {
+		issues = append(issues, ValidationIssue{Field: "EnableFollowMode", Message: "follower nodes only use the websocket network, EnableP2P and EnableP2PHybridMode are ignored", Warning: true})
+	}
+	return issues
+}
+
+// DiffConfigFile decodes a configuration file over the defaults of the version it declares, without migrating it,
+// and returns that version and the fields set to values other than its defaults.
+func DiffConfigFile(data []byte) (version uint32, diffs []FieldDiff, err error) {
+	var versioned struct{ Version uint32 }
+	if err = json.Unmarshal(data, &versioned); err != nil {
+		return 0, nil, err
+	}
+	version = versioned.Version
+	if version > getLatestConfigVersion() {
+		return 0, nil, fmt.Errorf("unexpected config version: %d", version)
+	}
+
+	defaults := GetVersionedDefaultLocalConfig(version)
+	cfg := defaults
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return 0, nil, err
+	}
+
+	v := reflect.ValueOf(cfg)
+	vDefault := reflect.ValueOf(defaults)
+	for i := 0; i < v.NumField(); i++ {
+		if !reflect.DeepEqual(v.Field(i).Interface(), vDefault.Field(i).Interface()) {
+			diffs = append(diffs, FieldDiff{Field: v.Type().Field(i).Name, Default: vDefault.Field(i).Interface(), Value: v.Field(i).Interface()})
+		}
+	}
+	return version, diffs, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestValidateConfigFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	issues, err := ValidateConfigFile([]byte(`{"Version": 35, "Archival": true}`))
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	_, err = ValidateConfigFile([]byte(`{"Version": 35,`))
+	require.Error(t, err)
+
+	issues, err = ValidateConfigFile([]byte(`{"Version": 35, "Archivall": true, "GossipFanout": "4", "archival": true, "ReconnectTime": 1}`))
+	require.NoError(t, err)
+	require.Equal(t, []ValidationIssue{
+		{Field: "Archivall", Message: "unknown key"},
+		{Field: "GossipFanout", Message: `invalid value "4" for type int`},
+		{Field: "ReconnectTime", Message: "field is deprecated and unused", Warning: true},
+		{Field: "archival", Message: "key is loaded as Archival, use the exact field name", Warning: true},
+	}, issues)
+
+	issues, err = ValidateConfigFile([]byte(`{"Version": 1000}`))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "Version", issues[0].Field)
+	require.False(t, issues[0].Warning)
+
+	issues, err = ValidateConfigFile([]byte(`{"Version": 35, "NetAddress": ":4160", "EnableP2PHybridMode": true}`))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "PublicAddress", issues[0].Field)
+
+	issues, err = ValidateConfigFile([]byte(`{"Version": 35, "Archival": true, "MaxBlockHistoryLookback": 100, "TxPoolSize": 0}`))
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	require.Equal(t, "TxPoolSize", issues[0].Field)
+	require.False(t, issues[0].Warning)
+	require.Equal(t, "MaxBlockHistoryLookback", issues[1].Field)
+	require.True(t, issues[1].Warning)
+}
+
+func TestLocalValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Empty(t, GetDefaultLocal().Validate())
+
+	fields := func(cfg Local) (errors, warnings []string) {
+		for _, issue := range cfg.Validate() {
+			if issue.Warning {
+				warnings = append(warnings, issue.Field)
+			} else {
+				errors = append(errors, issue.Field)
+			}
+		}
+		return
+	}
+
+	cfg := GetDefaultLocal()
+	cfg.BaseLoggerDebugLevel = 6
+	cfg.TxBacklogRateLimitingCongestionPct = 101
+	cfg.EnableP2PHybridMode = true
+	cfg.NetAddress = ":4160"
+	errors, warnings := fields(cfg)
+	require.Equal(t, []string{"BaseLoggerDebugLevel", "TxBacklogRateLimitingCongestionPct", "P2PHybridNetAddress"}, errors)
+	require.Empty(t, warnings)
+
+	cfg = GetDefaultLocal()
+	cfg.CatchpointTracking = 5
+	cfg.EnableFollowMode = true
+	cfg.EnableP2P = true
+	errors, warnings = fields(cfg)
+	require.Empty(t, errors)
+	require.Equal(t, []string{"CatchpointTracking", "EnableFollowMode"}, warnings)
+
+	// non archival nodes storing catchpoints need the blocks since the previous catchpoint
+	cfg = GetDefaultLocal()
+	cfg.CatchpointTracking = CatchpointTrackingModeStored
+	errors, warnings = fields(cfg)
+	require.Empty(t, errors)
+	require.Equal(t, []string{"MaxBlockHistoryLookback"}, warnings)
+	cfg.MaxBlockHistoryLookback = 22000
+	require.Empty(t, cfg.Validate())
+}
+
+func TestDiffConfigFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	version, diffs, err := DiffConfigFile([]byte(`{"Version": 27, "MaxConnectionsPerIP": 15, "GossipFanout": 8}`))
+	require.NoError(t, err)
+	require.Equal(t, uint32(27), version)
+	// MaxConnectionsPerIP is the default of version 27, even though the latest default is lower
+	require.Equal(t, []FieldDiff{{Field: "GossipFanout", Default: 4, Value: 8}}, diffs)
+
+	version, diffs, err = DiffConfigFile([]byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), version)
+	require.Empty(t, diffs)
+
+	_, _, err = DiffConfigFile([]byte(`{"Version": 1000}`))
+	require.Error(t, err)
+}
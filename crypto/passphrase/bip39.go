@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	bip39MinEntropyBytes = 16
+	bip39MaxEntropyBytes = 32
+	bip39EntropyStep     = 4
+	bip39SeedIterations  = 2048
+	bip39SeedLenBytes    = 64
+	bip39SaltPrefix      = "mnemonic"
+)
+
+// The 25-word mnemonic uses the BIP-39 English wordlist, so the same list
+// serves both formats
+var bip39WordIndex map[string]int
+
+func init() {
+	bip39WordIndex = make(map[string]int, len(wordlist))
+	for i, w := range wordlist {
+		bip39WordIndex[w] = i
+	}
+}
+
+// EntropyToBIP39Mnemonic encodes 16 to 32 bytes of entropy (in steps of 4)
+// as a BIP-39 mnemonic of 12 to 24 words. Unlike KeyToMnemonic, the words
+// follow the BIP-39 bit order and checksum, so the result can be used with
+// other BIP-39 wallets.
+func EntropyToBIP39Mnemonic(entropy []byte) (string, error) {
+	if len(entropy) < bip39MinEntropyBytes || len(entropy) > bip39MaxEntropyBytes || len(entropy)%bip39EntropyStep != 0 {
+		return "", errWrongBIP39EntropyLen
+	}
+
+	// The checksum is the first len(entropy)*8/32 bits of its SHA-256
+	checksumBits := len(entropy) / bip39EntropyStep
+	hash := sha256.Sum256(entropy)
+	bits := append(append([]byte{}, entropy...), hash[0])
+
+	numWords := (len(entropy)*8 + checksumBits) / bitsPerWord
+	words := make([]string, numWords)
+	for i := range words {
+		words[i] = wordlist[readBits(bits, i*bitsPerWord, bitsPerWord)]
+	}
+	return strings.Join(words, sepStr), nil
+}
+
+// BIP39MnemonicToEntropy decodes a BIP-39 mnemonic back into the entropy
+// used to create it, verifying its checksum
+func BIP39MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+
+	numWords := len(words)
+	totalBits := numWords * bitsPerWord
+	checksumBits := totalBits / 33
+	entropyLen := (totalBits - checksumBits) / 8
+	if numWords%3 != 0 || entropyLen < bip39MinEntropyBytes || entropyLen > bip39MaxEntropyBytes {
+		return nil, errWrongBIP39MnemonicLen
+	}
+
+	bits := make([]byte, entropyLen+1)
+	for i, w := range words {
+		idx, ok := bip39WordIndex[w]
+		if !ok {
+			return nil, errNotInWordlist(w)
+		}
+		writeBits(bits, i*bitsPerWord, bitsPerWord, uint32(idx))
+	}
+
+	entropy := bits[:entropyLen]
+	hash := sha256.Sum256(entropy)
+	mask := byte(0xff) << (8 - checksumBits)
+	if bits[entropyLen]&mask != hash[0]&mask {
+		return nil, errWrongChecksum
+	}
+	return entropy, nil
+}
+
+// BIP39MnemonicToSeed validates a BIP-39 mnemonic and stretches it, along
+// with an optional passphrase, into the 64-byte seed that BIP-32 style key
+// derivation starts from
+func BIP39MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	_, err := BIP39MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), sepStr))
+	salt := norm.NFKD.String(bip39SaltPrefix + passphrase)
+	return pbkdf2.Key([]byte(normalized), []byte(salt), bip39SeedIterations, bip39SeedLenBytes, sha512.New), nil
+}
+
+// readBits returns the n bits of buf starting at bit offset off, reading
+// each byte from its most significant bit
+func readBits(buf []byte, off int, n int) uint32 {
+	var v uint32
+	for i := off; i < off+n; i++ {
+		v = v<<1 | uint32(buf[i/8]>>(7-i%8))&1
+	}
+	return v
+}
+
+// writeBits stores the low n bits of v into buf at bit offset off, the
+// inverse of readBits
+func writeBits(buf []byte, off int, n int, v uint32) {
+	for i := 0; i < n; i++ {
+		if v&(1<<(n-1-i)) != 0 {
+			pos := off + i
+			buf[pos/8] |= 1 << (7 - pos%8)
+		}
+	}
+}
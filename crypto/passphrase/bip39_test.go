@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+// Test vectors from the reference BIP-39 implementation, using the
+// passphrase "TREZOR"
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+}
+
+func TestBIP39Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, v := range bip39Vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		require.NoError(t, err)
+
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.mnemonic, m)
+
+		recovered, err := BIP39MnemonicToEntropy(m)
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+
+		seed, err := BIP39MnemonicToSeed(m, "TREZOR")
+		require.NoError(t, err)
+		require.Equal(t, v.seed, hex.EncodeToString(seed))
+	}
+}
+
+func TestBIP39GenerateAndRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, n := range []int{16, 20, 24, 28, 32} {
+		entropy := make([]byte, n)
+		_, err := rand.Read(entropy)
+		require.NoError(t, err)
+
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(m), n*3/4)
+
+		recovered, err := BIP39MnemonicToEntropy(m)
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+	}
+}
+
+func TestBIP39Errors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := EntropyToBIP39Mnemonic(make([]byte, 15))
+	require.Error(t, err)
+
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon")
+	require.Error(t, err)
+
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	require.Equal(t, errWrongChecksum, err)
+
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon zzzz")
+	require.Error(t, err)
+
+	// A 25-word Algorand mnemonic is not a BIP-39 mnemonic
+	_, err = BIP39MnemonicToSeed(strings.Repeat("abandon ", 24)+"invest", "")
+	require.Error(t, err)
+}
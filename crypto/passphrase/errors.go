@@ -23,3 +23,9 @@ import (
 var errWrongKeyLen = fmt.Errorf("key length must be %d bytes", keyLenBytes)
 var errWrongMnemonicLen = fmt.Errorf("mnemonic must be %d words", mnemonicLenWords)
 var errWrongChecksum = fmt.Errorf("checksum failed to validate")
+var errWrongBIP39EntropyLen = fmt.Errorf("bip39 entropy must be between %d and %d bytes, in steps of %d", bip39MinEntropyBytes, bip39MaxEntropyBytes, bip39EntropyStep)
+var errWrongBIP39MnemonicLen = fmt.Errorf("bip39 mnemonic must be 12, 15, 18, 21 or 24 words")
+
+func errNotInWordlist(w string) error {
+	return fmt.Errorf("%s is not in the words list", w)
+}
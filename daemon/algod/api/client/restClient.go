@@ -444,7 +444,8 @@ type pageParams struct {
 }
 
 type catchupParams struct {
-	Min uint64 `url:"min"`
+	Min  uint64 `url:"min"`
+	File string `url:"file,omitempty"`
 }
 
 // PendingTransactionsByAddr returns all the pending transactions for an addr.
@@ -668,6 +669,13 @@ func (client RestClient) Catchup(catchpointLabel string, minRounds uint64) (resp
 	return
 }
 
+// CatchupFromFile start catching up to the give catchpoint label, loading the ledger from the given catchpoint file, or directory of
+// catchpoint files, on the node's host
+func (client RestClient) CatchupFromFile(catchpointLabel string, minRounds uint64, catchpointFile string) (response model.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), catchupParams{Min: minRounds, File: catchpointFile}, nil, "POST", false, true, false)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -843,6 +851,51 @@ func (client RestClient) GetSyncRound() (response model.GetSyncRoundResponse, er
 	return
 }
 
+type peerAddressParams struct {
+	Address string `url:"address"`
+}
+
+type pinPeerParams struct {
+	Address  string `url:"address"`
+	Duration uint64 `url:"duration,omitempty"`
+}
+
+// Peers lists the peers the node is connected to
+func (client RestClient) Peers() (response model.PeersResponse, err error) {
+	err = client.get(&response, "/v2/peers", nil)
+	return
+}
+
+// ConnectPeer connects the node to the peer at address
+func (client RestClient) ConnectPeer(address string) (err error) {
+	err = client.post(nil, "/v2/peers/connect", peerAddressParams{Address: address}, nil, true)
+	return
+}
+
+// DisconnectPeer closes the connection of the node with the peer at address
+func (client RestClient) DisconnectPeer(address string) (err error) {
+	err = client.post(nil, "/v2/peers/disconnect", peerAddressParams{Address: address}, nil, true)
+	return
+}
+
+// PinnedPeers lists the peers pinned on the node
+func (client RestClient) PinnedPeers() (response model.PeerPinsResponse, err error) {
+	err = client.get(&response, "/v2/peers/pins", nil)
+	return
+}
+
+// PinPeer pins the peer at address for duration seconds, or until it is unpinned if duration is zero
+func (client RestClient) PinPeer(address string, duration uint64) (err error) {
+	err = client.post(nil, "/v2/peers/pins", pinPeerParams{Address: address, Duration: duration}, nil, true)
+	return
+}
+
+// UnpinPeer lifts the pin of the peer at address
+func (client RestClient) UnpinPeer(address string) (err error) {
+	err = client.delete(nil, "/v2/peers/pins", peerAddressParams{Address: address}, true)
+	return
+}
+
 // GetLedgerStateDelta retrieves the ledger state delta for the round
 func (client RestClient) GetLedgerStateDelta(round uint64) (response ledgercore.StateDelta, err error) {
 	// Note: this endpoint gets the StateDelta as JSON, meaning some string fields with non-UTF-8 data will lose
@@ -41,14 +41,21 @@ func Metrics(ctx lib.ReqContext, context echo.Context) {
 	//       404:
 	//         description: metrics were compiled out
 	w := context.Response().Writer
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(http.StatusOK)
-
 	var buf strings.Builder
-	metrics.DefaultRegistry().WriteMetrics(&buf, "")
+	// scrapers asking for OpenMetrics get it, everyone else gets the Prometheus text format
+	if strings.Contains(context.Request().Header.Get("Accept"), "application/openmetrics-text") {
+		w.Header().Set("Content-Type", openMetricsContentType)
+		metrics.DefaultRegistry().WriteOpenMetrics(&buf, "")
+	} else {
+		w.Header().Set("Content-Type", "text/plain")
+		metrics.DefaultRegistry().WriteMetrics(&buf, "")
+	}
+	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(buf.String()))
 }
 
+const openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
 func init() {
 	Routes = append(Routes,
 		lib.Route{
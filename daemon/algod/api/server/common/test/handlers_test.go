@@ -30,6 +30,8 @@ import (
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/node"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/depin-sdk/util/metrics/metricstest"
 )
 
 func mockNodeStatusInRangeHelper(
@@ -86,3 +88,30 @@ func TestReadyEndpoint(t *testing.T) {
 	mockNodeInstance.catchupStatus = StoppedAtUnsupported
 	readyEndpointTestHelper(t, mockNodeInstance, http.StatusInternalServerError)
 }
+
+func TestMetricsEndpointOpenMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// register the gatherers that the node registers on startup, next to the metrics of the imported packages
+	for _, m := range []metrics.Metric{metrics.NewRuntimeMetrics(), &metrics.PrometheusDefaultMetrics, &metrics.OpencensusDefaultMetrics, metrics.NetDevMetrics} {
+		metrics.DefaultRegistry().Register(m)
+		defer metrics.DefaultRegistry().Deregister(m)
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0,text/plain;q=0.5")
+	rec := httptest.NewRecorder()
+	common.Metrics(lib.ReqContext{Log: logging.NewLogger()}, e.NewContext(req, rec))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "application/openmetrics-text")
+
+	families, err := metricstest.ParseOpenMetrics(rec.Body.String())
+	require.NoError(t, err, rec.Body.String())
+	types := make(map[string]string, len(families))
+	for _, f := range families {
+		types[f.Name] = f.Type
+	}
+	require.Equal(t, "counter", types["algod_go_gc_cycles_total_gc_cycles"])
+	require.Equal(t, "counter", types["algod_network_sent_bytes"])
+}
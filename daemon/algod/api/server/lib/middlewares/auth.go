@@ -18,11 +18,14 @@ package middlewares
 
 import (
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/DePINNetwork/depin-sdk/util/tokens"
 )
 
 // TokenPathParam is the name of the path parameter used by URLAuthPrefix
@@ -35,6 +38,23 @@ const urlAuthFormatter = "/urlAuth/%s"
 // InvalidTokenMessage is the message set when an invalid / missing token is found.
 const InvalidTokenMessage = "Invalid API Token"
 
+// ForbiddenScopeMessage is the message set when a scoped token does not grant access to the route.
+const ForbiddenScopeMessage = "API Token not allowed for this route"
+
+// RateLimitedTokenMessage is the message set when a scoped token exceeded its request rate limit.
+const RateLimitedTokenMessage = "API Token rate limit exceeded"
+
+// ScopedTokenNameKey is the request context key holding the name of the scoped token the request was authorized with.
+const ScopedTokenNameKey = "scopedTokenName"
+
+// ScopedTokenAuthorizer checks named tokens carrying route scopes.
+type ScopedTokenAuthorizer interface {
+	Authorize(token string, scope tokens.Scope) (string, error)
+}
+
+// RouteScopeFunc returns the scope a token needs to access the route of the request.
+type RouteScopeFunc func(ctx echo.Context) tokens.Scope
+
 // AuthMiddleware provides some data to the handler.
 type AuthMiddleware struct {
 	// Header is the token header which needs to be provided. For example 'X-Algod-API-Token'.
@@ -42,6 +62,10 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// scopedTokens optionally authorizes named tokens having the scope returned by routeScope.
+	scopedTokens ScopedTokenAuthorizer
+	routeScope   RouteScopeFunc
 }
 
 // MakeAuth constructs the auth middleware function
@@ -59,6 +83,37 @@ func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
 	return auth.handler
 }
 
+// MakeScopedAuth constructs the auth middleware function accepting either one of the static tokens
+// or a scoped token from the store that grants the scope required by the route.
+func MakeScopedAuth(header string, apiTokens []string, scopedTokens ScopedTokenAuthorizer, routeScope RouteScopeFunc) echo.MiddlewareFunc {
+	apiTokenBytes := make([][]byte, 0)
+	for _, token := range apiTokens {
+		apiTokenBytes = append(apiTokenBytes, []byte(token))
+	}
+
+	auth := AuthMiddleware{
+		header:       header,
+		tokens:       apiTokenBytes,
+		scopedTokens: scopedTokens,
+		routeScope:   routeScope,
+	}
+
+	return auth.handler
+}
+
+// headerToken grabs the apiToken from the HTTP header, or as a bearer token
+func headerToken(req *http.Request, header string) string {
+	providedToken := req.Header.Get(header)
+	if len(providedToken) == 0 {
+		// Accept tokens provided in a bearer token format.
+		bearer, token, found := strings.Cut(req.Header.Get("Authorization"), " ")
+		if found && strings.EqualFold("Bearer", bearer) {
+			providedToken = token
+		}
+	}
+	return providedToken
+}
+
 // Auth takes a logger and an array of api token and return a middleware function
 // that ensures one of the api tokens was provided.
 func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
@@ -68,15 +123,7 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			return next(ctx)
 		}
 
-		// Grab the apiToken from the HTTP header, or as a bearer token
-		providedToken := []byte(ctx.Request().Header.Get(auth.header))
-		if len(providedToken) == 0 {
-			// Accept tokens provided in a bearer token format.
-			bearer, token, found := strings.Cut(ctx.Request().Header.Get("Authorization"), " ")
-			if found && strings.EqualFold("Bearer", bearer) {
-				providedToken = []byte(token)
-			}
-		}
+		providedToken := []byte(headerToken(ctx.Request(), auth.header))
 
 		// Handle debug routes with /urlAuth/:token prefix.
 		if ctx.Param(TokenPathParam) != "" {
@@ -101,6 +148,19 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		if auth.scopedTokens != nil && len(providedToken) != 0 {
+			name, err := auth.scopedTokens.Authorize(string(providedToken), auth.routeScope(ctx))
+			switch {
+			case err == nil:
+				ctx.Set(ScopedTokenNameKey, name)
+				return next(ctx)
+			case errors.Is(err, tokens.ErrScopeNotAllowed):
+				return echo.NewHTTPError(http.StatusForbidden, ForbiddenScopeMessage)
+			case errors.Is(err, tokens.ErrRateLimited):
+				return echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedTokenMessage)
+			}
+		}
+
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
@@ -20,8 +20,10 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/tokens"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 )
@@ -189,3 +191,53 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dataDir := t.TempDir()
+	readToken, err := tokens.CreateScopedToken(dataDir, "reader", []tokens.Scope{tokens.ScopeRead}, time.Time{}, 0)
+	require.NoError(t, err)
+	submitToken, err := tokens.CreateScopedToken(dataDir, "submitter", []tokens.Scope{tokens.ScopeRead, tokens.ScopeSubmit}, time.Time{}, 1)
+	require.NoError(t, err)
+	expiredToken, err := tokens.CreateScopedToken(dataDir, "expired", []tokens.Scope{tokens.ScopeAdmin}, time.Now().Add(-time.Minute), 0)
+	require.NoError(t, err)
+	store, err := tokens.MakeScopedTokenStore(dataDir)
+	require.NoError(t, err)
+	defer store.Close()
+
+	routeScope := func(ctx echo.Context) tokens.Scope {
+		if ctx.Request().Method == http.MethodPost {
+			return tokens.ScopeSubmit
+		}
+		return tokens.ScopeRead
+	}
+	handler := MakeScopedAuth(testAPIHeader, []string{"static"}, store, routeScope)(success)
+
+	call := func(method, token string) error {
+		req, _ := http.NewRequest(method, "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		return handler(e.NewContext(req, nil))
+	}
+
+	require.Equal(t, errSuccess, call("GET", "static"))
+	require.Equal(t, errSuccess, call("POST", "static"))
+	require.Equal(t, errSuccess, call("GET", readToken))
+	require.Equal(t, echo.NewHTTPError(http.StatusForbidden, ForbiddenScopeMessage), call("POST", readToken))
+	require.Equal(t, invalidTokenError, call("GET", expiredToken))
+	require.Equal(t, invalidTokenError, call("GET", "unknown"))
+	require.Equal(t, invalidTokenError, call("GET", ""))
+
+	// the submit token is limited to one request per second
+	require.Equal(t, errSuccess, call("POST", submitToken))
+	require.Equal(t, echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedTokenMessage), call("POST", submitToken))
+
+	// the request context holds the name of the scoped token, but nothing for the static token
+	for token, name := range map[string]interface{}{readToken: "reader", "static": nil} {
+		req, _ := http.NewRequest("GET", "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		ctx := e.NewContext(req, nil)
+		require.Equal(t, errSuccess, handler(ctx))
+		require.Equal(t, name, ctx.Get(ScopedTokenNameKey))
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/go-deadlock"
+)
+
+// RateLimitedMessage is the message set when a client exceeded its request rate limit.
+const RateLimitedMessage = "Request rate limit exceeded"
+
+// rateLimiterMaxClients is the number of tracked clients above which clients with a full bucket are forgotten
+const rateLimiterMaxClients = 10000
+
+var restRateLimitedRequests = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_rate_limited_requests", Description: "Number of REST API requests rejected by the per-client rate limiter"})
+var restRateLimitedCost = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_rate_limited_cost", Description: "Cost units of REST API requests rejected by the per-client rate limiter"})
+var restRateLimiterClients = metrics.MakeGauge(metrics.MetricName{Name: "algod_rest_rate_limiter_clients", Description: "Number of REST API clients tracked by the per-client rate limiter"})
+
+// RouteCostFunc returns the number of cost units a request to the route uses.
+type RouteCostFunc func(ctx echo.Context) uint64
+
+// RateLimiterMiddleware limits the request rate of each REST API client with a token bucket.
+type RateLimiterMiddleware struct {
+	// forwardedForHeader optionally names the header carrying the client address set by a reverse proxy.
+	forwardedForHeader string
+	routeCost          RouteCostFunc
+
+	mu      deadlock.Mutex
+	limit   rate.Limit
+	burst   int
+	clients map[string]*rate.Limiter
+}
+
+// MakeRateLimiterMiddleware constructs the rate limiter middleware, whose limits can be changed with SetLimits.
+// Requests are not limited while the limit is zero. Clients authorized with a scoped token are keyed by the token
+// name, and other clients by their address, since the static API token is shared by all of them.
+func MakeRateLimiterMiddleware(limit uint64, burst uint64, forwardedForHeader string, routeCost RouteCostFunc) *RateLimiterMiddleware {
+	return makeRateLimiter(limit, burst, forwardedForHeader, routeCost)
+}
+
+func makeRateLimiter(limit uint64, burst uint64, forwardedForHeader string, routeCost RouteCostFunc) *RateLimiterMiddleware {
+	rl := &RateLimiterMiddleware{
+		forwardedForHeader: forwardedForHeader,
+		routeCost:          routeCost,
+		clients:            make(map[string]*rate.Limiter),
+	}
+	rl.SetLimits(limit, burst)
+	return rl
+}
+
+// SetLimits changes the request rate and the bucket size of all the clients.
+func (rl *RateLimiterMiddleware) SetLimits(limit uint64, burst uint64) {
+	if burst < limit {
+		burst = limit
+	}
+	if burst > math.MaxInt32 {
+		burst = math.MaxInt32
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.limit = rate.Limit(limit)
+	rl.burst = int(burst)
+	now := time.Now()
+	for _, l := range rl.clients {
+		l.SetLimitAt(now, rl.limit)
+		l.SetBurstAt(now, rl.burst)
+	}
+}
+
+// clientKey identifies the client of the request by its scoped token name or address
+func (rl *RateLimiterMiddleware) clientKey(ctx echo.Context) string {
+	if name, ok := ctx.Get(ScopedTokenNameKey).(string); ok {
+		return "token:" + name
+	}
+	return "addr:" + clientAddress(ctx.Request(), rl.forwardedForHeader)
+}
+
+// clientAddress returns the host part of the remote address, or the address set by the reverse proxy
+// in forwardedForHeader. As in the network request tracker, the last X-Forwarded-For value is used.
+func clientAddress(req *http.Request, forwardedForHeader string) string {
+	if forwardedForHeader != "" {
+		var forwardedFor string
+		if textproto.CanonicalMIMEHeaderKey(forwardedForHeader) == "X-Forwarded-For" {
+			if values := req.Header.Values(forwardedForHeader); len(values) != 0 {
+				ips := strings.Split(values[len(values)-1], ",")
+				forwardedFor = strings.TrimSpace(ips[len(ips)-1])
+			}
+		} else {
+			forwardedFor = req.Header.Get(forwardedForHeader)
+		}
+		if ip := net.ParseIP(forwardedFor); ip != nil {
+			return ip.String()
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// reserve takes cost units from the client bucket and returns how long the client needs to wait
+// if the bucket does not hold enough of them, in which case nothing is taken. A request costing more
+// than the bucket holds is charged the whole bucket.
+func (rl *RateLimiterMiddleware) reserve(key string, cost uint64, now time.Time) time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.limit == 0 {
+		return 0
+	}
+	if cost > uint64(rl.burst) {
+		cost = uint64(rl.burst)
+	}
+
+	l, ok := rl.clients[key]
+	if !ok {
+		if len(rl.clients) >= rateLimiterMaxClients {
+			rl.evictIdle(now)
+		}
+		l = rate.NewLimiter(rl.limit, rl.burst)
+		rl.clients[key] = l
+		restRateLimiterClients.Set(uint64(len(rl.clients)))
+	}
+
+	r := l.ReserveN(now, int(cost))
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// evictIdle forgets clients whose bucket is full since they are indistinguishable from new clients
+func (rl *RateLimiterMiddleware) evictIdle(now time.Time) {
+	for key, l := range rl.clients {
+		if l.TokensAt(now) >= float64(rl.burst) {
+			delete(rl.clients, key)
+		}
+	}
+}
+
+// Handler is the echo middleware function of the rate limiter
+func (rl *RateLimiterMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		// OPTIONS responses are never limited
+		if ctx.Request().Method == "OPTIONS" {
+			return next(ctx)
+		}
+
+		cost := uint64(1)
+		if rl.routeCost != nil {
+			cost = rl.routeCost(ctx)
+		}
+
+		delay := rl.reserve(rl.clientKey(ctx), cost, time.Now())
+		if delay == 0 {
+			return next(ctx)
+		}
+
+		restRateLimitedRequests.Inc(nil)
+		restRateLimitedCost.AddUint64(cost, nil)
+		retryAfter := int64(math.Ceil(delay.Seconds()))
+		ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(retryAfter, 10))
+		return echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage)
+	}
+}
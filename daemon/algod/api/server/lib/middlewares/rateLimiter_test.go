@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestRateLimiterCostAndRetryAfter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	routeCost := func(ctx echo.Context) uint64 {
+		if ctx.Request().URL.Path == "/expensive" {
+			return 20
+		}
+		return 1
+	}
+	handler := makeRateLimiter(1, 30, "", routeCost).Handler(success)
+
+	call := func(path, scopedToken, remoteAddr string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(testAPIHeader, "static")
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		if scopedToken != "" {
+			ctx.Set(ScopedTokenNameKey, scopedToken)
+		}
+		return rec, handler(ctx)
+	}
+
+	// 30 units: one expensive request and ten cheap ones, then the bucket is empty
+	_, err := call("/expensive", "a", "1.1.1.1:1")
+	require.Equal(t, errSuccess, err)
+	for i := 0; i < 10; i++ {
+		_, err = call("/cheap", "a", "1.1.1.1:1")
+		require.Equal(t, errSuccess, err)
+	}
+	rec, err := call("/cheap", "a", "1.1.1.1:1")
+	require.Equal(t, echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage), err)
+	require.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+	rec, err = call("/expensive", "a", "1.1.1.1:1")
+	require.Equal(t, echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage), err)
+	require.Equal(t, "20", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// another scoped token from the same address has its own bucket
+	_, err = call("/expensive", "b", "1.1.1.1:1")
+	require.Equal(t, errSuccess, err)
+	// without a scoped token the client is keyed by its address, whatever the static token
+	_, err = call("/expensive", "", "1.1.1.1:1")
+	require.Equal(t, errSuccess, err)
+	_, err = call("/expensive", "", "1.1.1.1:2")
+	require.Error(t, err)
+}
+
+func TestRateLimiterClientKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:4160"
+	req.Header.Set(testAPIHeader, "token")
+	req.Header.Add("X-Forwarded-For", "1.2.3.4")
+	req.Header.Add("X-Forwarded-For", "5.6.7.8, 9.9.9.9")
+	req.Header.Set("CF-Connecting-IP", "8.8.8.8")
+
+	ctx := e.NewContext(req, httptest.NewRecorder())
+
+	// the static API token is shared by all the clients, which are told apart by their address
+	require.Equal(t, "addr:10.0.0.1", makeRateLimiter(1, 1, "", nil).clientKey(ctx))
+	require.Equal(t, "addr:9.9.9.9", makeRateLimiter(1, 1, "X-Forwarded-For", nil).clientKey(ctx))
+	require.Equal(t, "addr:8.8.8.8", makeRateLimiter(1, 1, "CF-Connecting-IP", nil).clientKey(ctx))
+	// invalid forwarded addresses fall back to the connection address
+	require.Equal(t, "addr:10.0.0.1", makeRateLimiter(1, 1, "X-Real-IP", nil).clientKey(ctx))
+
+	ctx.Set(ScopedTokenNameKey, "indexer")
+	require.Equal(t, "token:indexer", makeRateLimiter(1, 1, "X-Forwarded-For", nil).clientKey(ctx))
+}
+
+func TestRateLimiterEvictIdle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rl := makeRateLimiter(10, 10, "", nil)
+	now := time.Now()
+	require.Zero(t, rl.reserve("busy", 10, now))
+	require.Zero(t, rl.reserve("idle", 1, now))
+	require.Len(t, rl.clients, 2)
+
+	// after a while the idle client bucket is full again while the busy one is not
+	rl.evictIdle(now.Add(200 * time.Millisecond))
+	require.Len(t, rl.clients, 1)
+	require.Contains(t, rl.clients, "busy")
+	require.NotZero(t, rl.reserve("busy", 10, now.Add(200*time.Millisecond)))
+}
+
+func TestRateLimiterSetLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// requests are not limited while the limit is zero
+	rl := MakeRateLimiterMiddleware(0, 0, "", nil)
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		require.Zero(t, rl.reserve("client", 1, now))
+	}
+
+	rl.SetLimits(1, 2)
+	require.Zero(t, rl.reserve("client", 2, now))
+	require.NotZero(t, rl.reserve("client", 1, now))
+
+	// the tracked clients get the new limits
+	rl.SetLimits(100, 100)
+	require.Zero(t, rl.reserve("client", 50, now.Add(time.Second)))
+}
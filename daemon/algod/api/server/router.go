@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"golang.org/x/sync/semaphore"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/common"
 	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/lib"
 	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/lib/middlewares"
@@ -73,14 +75,71 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 	}
 }
 
+// publicRouteScope returns the scoped token scope required by the public routes
+func publicRouteScope(ctx echo.Context) tokens.Scope {
+	if ctx.Request().Method == http.MethodPost {
+		switch ctx.Path() {
+		case "/v2/transactions", "/v2/transactions/async", apiV1Tag + "/transactions":
+			return tokens.ScopeSubmit
+		case "/v2/transactions/simulate", "/v2/teal/dryrun":
+			return tokens.ScopeSimulate
+		case "/v2/devmode/blocks/offset/:offset", "/v2/ledger/sync/:round":
+			return tokens.ScopeAdmin
+		}
+	}
+	if ctx.Request().Method == http.MethodDelete && ctx.Path() == "/v2/ledger/sync" {
+		return tokens.ScopeAdmin
+	}
+	return tokens.ScopeRead
+}
+
+// publicRouteCost returns the rate limiter cost units used by a request to the public routes.
+// Routes doing substantially more work than a lookup cost more.
+func publicRouteCost(ctx echo.Context) uint64 {
+	switch ctx.Path() {
+	case "/v2/transactions/simulate", "/v2/teal/dryrun":
+		return 20
+	case "/v2/applications/:application-id/boxes", "/v2/accounts/:address/assets", "/v2/blocks/:round/logs",
+		"/v2/deltas/:round", "/v2/deltas/txn/group/:id", "/v2/deltas/:round/txn/group":
+		return 10
+	case "/v2/teal/compile", "/v2/teal/disassemble":
+		return 5
+	}
+	return 1
+}
+
+// privateRouteScope returns a function giving the scoped token scope required by the private routes,
+// which is the debug scope for the debug routes, including those authenticated by a token in the path,
+// and defaultScope otherwise
+func privateRouteScope(defaultScope tokens.Scope) middlewares.RouteScopeFunc {
+	return func(ctx echo.Context) tokens.Scope {
+		path := strings.TrimPrefix(ctx.Path(), middlewares.URLAuthPrefix)
+		if strings.HasPrefix(path, "/debug/") {
+			return tokens.ScopeDebug
+		}
+		return defaultScope
+	}
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+// scopedTokens is optional and enables the named tokens carrying route scopes in addition to the static tokens.
+func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokens middlewares.ScopedTokenAuthorizer, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+	makeAuth := func(apiTokens []string, routeScope middlewares.RouteScopeFunc) echo.MiddlewareFunc {
+		if scopedTokens == nil {
+			return middlewares.MakeAuth(TokenHeader, apiTokens)
+		}
+		return middlewares.MakeScopedAuth(TokenHeader, apiTokens, scopedTokens, routeScope)
+	}
+
 	// check admin token and init admin middleware
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
 	adminMiddleware := []echo.MiddlewareFunc{
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken}),
+		makeAuth([]string{adminAPIToken}, privateRouteScope(tokens.ScopeAdmin)),
+	}
+	participationMiddleware := []echo.MiddlewareFunc{
+		makeAuth([]string{adminAPIToken}, privateRouteScope(tokens.ScopeParticipation)),
 	}
 
 	// check public api tokens and init public middleware
@@ -93,10 +152,21 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		if err := tokens.ValidateAPIToken(apiToken); err != nil {
 			logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 		}
-		publicMiddleware = append(publicMiddleware, middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken}))
+		publicMiddleware = append(publicMiddleware, makeAuth([]string{adminAPIToken, apiToken}, publicRouteScope))
 
 	}
 
+	// per-client rate limiting runs after authentication so that clients cannot evade it with made up tokens.
+	// The limiter is always in place, passing requests through while the limit is zero, so that the limit
+	// can be turned on by a config reload.
+	cfg := node.Config()
+	rateLimiter := middlewares.MakeRateLimiterMiddleware(
+		cfg.RestClientRateLimit, cfg.RestClientRateLimitBurst, cfg.UseXForwardedForAddressField, publicRouteCost)
+	publicMiddleware = append(publicMiddleware, rateLimiter.Handler)
+	node.OnConfigReload(func(cfg config.Local) {
+		rateLimiter.SetLimits(cfg.RestClientRateLimit, cfg.RestClientRateLimitBurst)
+	})
+
 	e := echo.New()
 
 	e.Listener = listener
@@ -143,7 +213,7 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 	nppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
 	npprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
 	ppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
-	pprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
+	pprivate.RegisterHandlers(e, &v2Handler, participationMiddleware...)
 
 	if node.Config().EnableFollowMode {
 		data.RegisterHandlers(e, &v2Handler, publicMiddleware...)
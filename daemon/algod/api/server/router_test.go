@@ -24,9 +24,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/lib"
+	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/lib/middlewares"
 	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/v1/routes"
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/tokens"
 )
 
 func setupRouter() *echo.Echo {
@@ -76,3 +78,59 @@ func TestGetTransactionV1Sunset(t *testing.T) {
 		assert.Equal(t, http.StatusGone, rec.Code)
 	}
 }
+
+func TestRouteScopes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	scopeOf := func(scopeFn func(echo.Context) tokens.Scope, method, path string) tokens.Scope {
+		ctx := e.NewContext(httptest.NewRequest(method, path, nil), nil)
+		ctx.SetPath(path)
+		return scopeFn(ctx)
+	}
+
+	testCases := []struct {
+		method string
+		path   string
+		scope  tokens.Scope
+	}{
+		{http.MethodGet, "/v2/status", tokens.ScopeRead},
+		{http.MethodGet, "/v2/transactions/pending", tokens.ScopeRead},
+		{http.MethodPost, "/v2/transactions", tokens.ScopeSubmit},
+		{http.MethodPost, "/v2/transactions/async", tokens.ScopeSubmit},
+		{http.MethodPost, "/v1/transactions", tokens.ScopeSubmit},
+		{http.MethodPost, "/v2/transactions/simulate", tokens.ScopeSimulate},
+		{http.MethodPost, "/v2/teal/dryrun", tokens.ScopeSimulate},
+		{http.MethodPost, "/v2/teal/compile", tokens.ScopeRead},
+		{http.MethodGet, "/v2/ledger/sync", tokens.ScopeRead},
+		{http.MethodDelete, "/v2/ledger/sync", tokens.ScopeAdmin},
+		{http.MethodPost, "/v2/ledger/sync/:round", tokens.ScopeAdmin},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.scope, scopeOf(publicRouteScope, tc.method, tc.path), "%s %s", tc.method, tc.path)
+	}
+
+	participation := privateRouteScope(tokens.ScopeParticipation)
+	assert.Equal(t, tokens.ScopeParticipation, scopeOf(participation, http.MethodPost, "/v2/participation"))
+	assert.Equal(t, tokens.ScopeDebug, scopeOf(participation, http.MethodGet, "/debug/settings/pprof"))
+	admin := privateRouteScope(tokens.ScopeAdmin)
+	assert.Equal(t, tokens.ScopeAdmin, scopeOf(admin, http.MethodPost, "/v2/shutdown"))
+	assert.Equal(t, tokens.ScopeDebug, scopeOf(admin, http.MethodGet, "/debug/pprof/*"))
+	assert.Equal(t, tokens.ScopeDebug, scopeOf(admin, http.MethodGet, middlewares.URLAuthPrefix+"/debug/pprof/*"))
+	assert.Equal(t, tokens.ScopeAdmin, scopeOf(admin, http.MethodGet, middlewares.URLAuthPrefix+"/v2/shutdown"))
+}
+
+func TestPublicRouteCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	costOf := func(path string) uint64 {
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, path, nil), nil)
+		ctx.SetPath(path)
+		return publicRouteCost(ctx)
+	}
+	assert.Equal(t, uint64(1), costOf("/v2/status"))
+	assert.Equal(t, uint64(20), costOf("/v2/transactions/simulate"))
+	assert.Equal(t, uint64(10), costOf("/v2/applications/:application-id/boxes"))
+	assert.Equal(t, uint64(5), costOf("/v2/teal/compile"))
+}
@@ -45,8 +45,18 @@ var (
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errCatchpointWouldNotInitialize            = "the node has already been initialized"
+	errCatchpointFileNotAbsolute               = "catchpoint file path must be absolute"
+	errFailedToOpenCatchpointFile              = "failed to open catchpoint file : %v"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errFailedToBanPeer                         = "failed to ban peer : %v"
+	errFailedToUnbanPeer                       = "failed to unban peer : %v"
+	errFailedToListPeers                       = "failed to list peers : %v"
+	errFailedToConnectPeer                     = "failed to connect peer : %v"
+	errFailedToDisconnectPeer                  = "failed to disconnect peer : %v"
+	errFailedToPinPeer                         = "failed to pin peer : %v"
+	errFailedToUnpinPeer                       = "failed to unpin peer : %v"
+	errFailedToReloadConfig                    = "failed to reload config : %v"
 )
@@ -114,224 +114,239 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbNrIo/lVQOqfKsX/ijF/J2fhXW+dO7CQ7N07i8jjZe47tm0BkS8IOBXABcEaK",
-	"r7/7LXQDJEiCEjUzcXZv7V/2iHg0Go1Gv9D9YZarTaUkSGtmzz7MKq75Bixo/IvnuaqlzUTh/irA5FpU",
-	"Vig5exa+MWO1kKvZfCbcrxW369l8JvkG2jau/3ym4e+10FDMnlldw3xm8jVsuBvY7irXuhlpm61U5oc4",
-	"oyHOX8w+7vnAi0KDMUMof5TljgmZl3UBzGouDc/dJ8OuhV0zuxaG+c5MSKYkMLVkdt1pzJYCysKchEX+",
-	"vQa9i1bpJx9f0scWxEyrEoZwPlebhZAQoIIGqGZDmFWsgCU2WnPL3AwO1tDQKmaA63zNlkofAJWAiOEF",
-	"WW9mz97ODMgCNO5WDuIK/7vUAL9BZrlegZ29n6cWt7SgMys2iaWde+xrMHVpDcO2uMaVuALJXK8T9n1t",
-	"LFsA45K9/uY5e/LkyZduIRtuLRSeyEZX1c4er4m6z57NCm4hfB7SGi9XSnNZZE371988x/kv/AKntuLG",
-	"QPqwnLkv7PzF2AJCxwQJCWlhhfvQoX7XI3Eo2p8XsFQaJu4JNb7TTYnn/0N3Jec2X1dKSJvYF4ZfGX1O",
-	"8rCo+z4e1gDQaV85TGk36NuH2ZfvPzyaP3r48d/enmX/7f/8/MnHict/3ox7AAPJhnmtNch8l600cDwt",
-	"ay6H+Hjt6cGsVV0WbM2vcPP5Blm978tcX2KdV7ysHZ2IXKuzcqUM456MCljyurQsTMxqWTo25Ubz1M6E",
-	"YZVWV6KAYu647/Va5GuWc0NDYDt2LcrS0WBtoBijtfTq9hymjzFKHFw3wgcu6B8XGe26DmACtsgNsrxU",
-	"BjKrDlxP4cbhsmDxhdLeVea4y4q9WQPDyd0HumwRd9LRdFnumMV9LRg3jLNwNc2ZWLKdqtk1bk4pLrG/",
-	"X43D2oY5pOHmdO5Rd3jH0DdARgJ5C6VK4BKRF87dEGVyKVa1BsOu12DX/s7TYColDTC1+Bvk1m37/7z4",
-	"8QemNPsejOEreMXzSwYyVwUUJ+x8yaSyEWl4WkIcup5j6/BwpS75vxnlaGJjVhXPL9M3eik2IrGq7/lW",
-	"bOoNk/VmAdptabhCrGIabK3lGEA04gFS3PDtcNI3upY57n87bUeWc9QmTFXyHSJsw7d/fjj34BjGy5JV",
-	"IAshV8xu5agc5+Y+DF6mVS2LCWKOdXsaXaymglwsBRSsGWUPJH6aQ/AIeRw8rfAVgRMGGQWnmeUAOBK2",
-	"CZpxp9t9YRVfQUQyJ+wnz9zwq1WXIBtCZ4sdfqo0XAlVm6bTCIw49X4JXCoLWaVhKRI0duHR4RgMtfEc",
-	"eONloFxJy4WEwjFnBFpZIGY1ClM04X59Z3iLL7iBL56O3fHt14m7v1T9Xd+745N2GxtldCQTV6f76g9s",
-	"WrLq9J+gH8ZzG7HK6OfBRorVG3fbLEWJN9Hf3P4FNNQGmUAHEeFuMmIlua01PHsnH7i/WMYuLJcF14X7",
-	"ZUM/fV+XVlyIlfuppJ9eqpXIL8RqBJkNrEmFC7tt6B83Xpod221Sr3ip1GVdxQvKO4rrYsfOX4xtMo15",
-	"LGGeNdpurHi82QZl5Ngedtts5AiQo7iruGt4CTsNDlqeL/Gf7RLpiS/1b+6fqipdb1stU6h1dOyvZDQf",
-	"eLPCWVWVIucOia/9Z/fVMQEgRYK3LU7xQn32IQKx0qoCbQUNyqsqK1XOy8xYbnGkf9ewnD2b/dtpa385",
-	"pe7mNJr8pet1gZ2cyEpiUMar6ogxXjnRx+xhFo5B4ydkE8T2UGgSkjbRkZJwLLiEKy7tSauydPhBc4Df",
-	"+plafJO0Q/juqWCjCGfUcAGGJGBqeM+wCPUM0coQrSiQrkq1aH747KyqWgzi97OqInyg9AgCBTPYCmPN",
-	"fVw+b09SPM/5ixP2bTw2iuJKljt3OZCo4e6Gpb+1/C3W2Jb8GtoR7xmG26n0iduagAYn5t8FxaFasVal",
-	"k3oO0opr/BffNiYz9/ukzv8cJBbjdpy4UNHymCMdB3+JlJvPepQzJBxv7jlhZ/2+NyMbN8oegjHnLRbv",
-	"mnjwF2FhYw5SQgRRRE1+e7jWfDfzQmKGwt6QTH4yQBRS8ZWQCO3cqU+Sbfgl7YdCvDtCANPoRURLJEE2",
-	"JlQvc3rUnwzsLP8E1Jra2CCJOkm1FMaiXo2N2RpKFJy5DAQdk8qNKGPChu9ZRAPzteYV0bL/QmKXkKjP",
-	"UyOC9ZYX78Q7MQlzxO6jjUaobsyWD7LOJCTINXowfFWq/PIv3Kzv4IQvwlhD2sdp2Bp4AZqtuVknDk6P",
-	"ttvRptC3a4g0yxbRVCfNEl+qlbmDJZbqGNZVVc95Wbqphyyrt1oceNJBLkvmGjPYCDSYe8WRLOykf7Gv",
-	"eb52YgHLeVnOW1ORqrISrqB0SruQEvSc2TW37eHHkYNeg+fIgGN2Fli0Gm9mQhObbmwRGtiG4w20cdpM",
-	"VXb7NBzU8A30pCC8EVWNVoRI0Th/EVYHVyCRJzVDI/jNGtFaEw9+4ub2n3BmqWhxZAG0wX3X4K/hFx2g",
-	"Xev2PpXtFEoXZLO27jehWa40DUE3vJ/c/Qe4bjsTdX5Wacj8EJpfgTa8dKvrLep+Q753dToPnMyCWx6d",
-	"TE+FaQWMOAf2Q/EOdMJK8yP+h5fMfXZSjKOklnoECiMqcqcWdDE7VNFMrgHaWxXbkCmTVTy/PArK5+3k",
-	"aTYz6eR9TdZTv4V+Ec0OvdmKwtzVNuFgY3vVPSFkuwrsaCCL7GU60VxTEPBGVYzYRw8E4hQ4GiFEbe/8",
-	"WvtKbVMwfaW2gytNbeFOdsKNM5nZI3z/kks9YSHq5kfIp7hpeIHL+G5wYLeux7OF0jcTmHp3qGStQ5Vx",
-	"N2okL857dIBN6yrz7CfhlKEGvYHaGJb9ck5/+BS2Oli4sPx3wIJxo94FFroD3TUW1KYSJdzB6V4n5dQF",
-	"N/DkMbv4y9nnjx7/8vjzLxxJVlqtNN+wxc6CYZ95yyMzdlfC/eRBQwEqPfoXT4Mbrjtuahyjap3DhlfD",
-	"oci9Rwo+NWOu3RBrXTTjqhsAJzF9cLc3oZ2R59qB9gIW9eoCrHXK/CutlnfO8AczpKDDRq8q7WQn03WF",
-	"eoHwtHBNTmFrNT+tsCXIgkIp3DqEcWruZnEnRDW28UU7S8E8Rgs4eCiO3aZ2ml28VXqn67uw4IDWSiel",
-	"jEorq3JVZk6UFSpx173yLZhvEbar6v9O0LJrbpibGx20tSxGrjS7ldOvaBr6zVa2uNkrHtF6E6vz807Z",
-	"ly7yW0WrAp3ZrWRInZ2bdqnVhnFWYEcUp74FSyKm2MCF5Zvqx+Xybgy6CgdKiARiA8bNxKiFE/AM5EpS",
-	"vOKB29+POgU9fcQER5odB8Bj5GInc/QG3sWxHReMNkJiaILZyTySkhyMJRSrDlne3ko3hg6a6p5JgOPQ",
-	"8RI/ozviBZSWf6P0m1ZC/1arurpz9tyfc+pyuF+Md3gUrm+wdAu5KrsxsisH+0lqjX/Igp43dhJaA0KP",
-	"FPlSrNY2UolfafU73InJWVKA4geyh5Wuz9Aq9oMqHDOxtbkDUbIdrOVwjm5jvsYXqraMM6kKwM2vTVrI",
-	"HImqxHAujEKzsdyKJhhh2AIcdeW8dqutK4YxVoP7ou2Y8ZxOaIaoMSMRJk1oELWi6Shir9TAix1bAEim",
-	"Fj6MwweY4CI5BojZIKZ5ETfBLzpwVVrlYAwUmbe2HwQttKOrw+7BEwKOADezMKPYkutbA3t5dRDOS9hl",
-	"GM5o2Gff/Wzu/wHwWmV5eQCx2CaF3r7JcAj1tOn3EVx/8pjsyBhJVOvEW8cgSrAwhsKjcDK6f32IBrt4",
-	"e7Rcgcaomd+V4sMktyOgBtTfmd5vC21djQTpezXdSXhuwySXKghWqcFKbmx2iC27Rh1bgltBxAlTnBgH",
-	"HhG8XnJjKdJLyALNtnSd4DwkhLkpxgEeVUPcyD8HDWQ4du7uQWlq06gjpq4qpS0UqTWgcW90rh9g28yl",
-	"ltHYjc5jFasNHBp5DEvR+B5ZXgPGP7htTHneODhcHIYNuHt+l0RlB4gWEfsAuQitIuzGgcojgAjTIpoI",
-	"R5ge5TTR0fOZsaqqHLewWS2bfmNouqDWZ/antu2QuMiPQ/d2ocCgj8i395BfE2YpRH3NDfNwBGstmnMo",
-	"JG0IszuMmREyh2wf5aOK51rFR+DgIa2rleYFZAWUfJewM9NnRp/3DYA73qq7ykJGscbpTW8pOYR27hla",
-	"4XgmJTwy/MJydwSdKtASiO99YOQCcOwUc/J0dK8ZCudKblEYD5dNW50YEW/DK2Xdjnt6QJA9R58C8Age",
-	"mqFvjgrsnLW6Z3+K/wLjJ2jkiOMn2YEZW0I7/lELGLEF+2dc0XnpsfceB06yzVE2doCPjB3ZEcP0K66t",
-	"yEWFus53sLtz1a8/QTI2gBVguSihYNEHUgOruD+jKNn+mDdTBSfZ3obgD4xvieWESKQu8JewQ537FT2/",
-	"iEwdd6HLJkZ19xOXDAENQd1OBI+bwJbnttw5Qc2uYceuQQMz9YKiNIb+FKuqLB4g6Z/ZM6N3QCfdv3s9",
-	"4hc4VLS8lNuSdIL98L3pKQYddHhdoFKqnGAhGyAjCcGk8BhWKbfrwr/wCm98AiV1gPRMG6MPmuv/numg",
-	"GVfA/kvVLOcSVa7aQiPTKI2CAgqQbgYngjVz+vjLFkNQwgZIk8QvDx70F/7ggd9zYdgSrsOzSNewj44H",
-	"D9CO80oZ2zlcd2APdcftPHF9oOPKXXxeC+nzlMNBXX7kKTv5qjd44+1yZ8oYT7hu+bdmAL2TuZ2y9phG",
-	"pgW04biTfDndEKjBunHfL8SmLrm9C68VXPEyU1egtSjgICf3Ewslv77i5Y9NN3zyCbmj0RyyHB8qThwL",
-	"3rg+9LbRjSOkcAeY3jVMBQjOqdcFdTqgYrZBD2KzgUJwC+WOVRpyoCd9TnI0zVJPGAX752suV6gwaFWv",
-	"fJwEjYMMvzZkmtG1HAyRFKrsVmZo5E5dAD4SL7zqdOIUcKfS9S3kpMBc82Y+/5B3ys0c7UHfY5B0ks1n",
-	"oxqvQ+pVq/EScrpPUydcBh15L8JPO/FEVwqizsk+Q3zF2+IOk9vc38dk3w6dgnI4cRTU3H4ci2t26na5",
-	"uwOhhwZiGioNBq+o2Exl6Ktaxs/QQzTkzljYDC351PWXkeP3elRfVLIUErKNkrBLZl4REr7Hj8njhNfk",
-	"SGcUWMb69nWQDvw9sLrzTKHG2+IXd7t/QvseK/ON0nflEqUBJ4v3EzyQB93tfsqb+kl5WSZci/6Rap8B",
-	"mHkTOSc048aoXKDMdl6YuQ98Jm+kf9HaRf+r5unNHZy9/rg9H1qc/wBtxFBWjLO8FGhBVtJYXef2neRo",
-	"o4qWmgjiCsr4uNXyeWiSNpMmrJh+qHeSYwBfY7lKBmwsIWGm+QYgGC9NvVqBsT1dZwnwTvpWQrJaCotz",
-	"bdxxyei8VKAxkuqEWm74ji0dTVjFfgOt2KK2Xekf32AbK8rSO/TcNEwt30luWQncWPa9kG+2OFxw+ocj",
-	"K8FeK33ZYCF9u69AghEmSwebfUtf8emCX/7aP2PAiH76HOJq26QQM7fMTh6Y//3Zfz57e5b9N89+e5h9",
-	"+f+dvv/w9OP9B4MfH3/885//T/enJx//fP8//z21UwH21AthD/n5C68Zn79A9Sd6jdCH/ZPZ/zdCZkki",
-	"i6M5erTFPsNsGJ6A7neNY3YN76TdSkdIV7wUheMtNyGH/g0zOIt0OnpU09mInjEsrPVIpeIWXIYlmEyP",
-	"Nd5YihrGZ6bf4qNT0j+vx/OyrCVtZZC+6alpiC9Ty3mTb4FSsT1j+Bh/zUOQp//z8edfzObtI/rm+2w+",
-	"81/fJyhZFNtUqoQCtildMX4Hcs+wiu8M2DT3QNiToXQU2xEPu4HNArRZi+rTcwpjxSLN4cKrLG9z2spz",
-	"SW8Y3PlBF+fOe07U8tPDbTVAAZVdp1I0dQQ1bNXuJkAv7KTS6grknIkTOOnbfAqnL/qgvhL4MgSmaqWm",
-	"aEPNOSBCC1QRYT1eyCTDSop+ei84/OVv7lwd8gOn4OrPmYrovfft12/YqWeY5h5l7aChozwLCVXavw/t",
-	"BCQ5bhY/m3sn38kXsETrg5LP3smCW3664Ebk5rQ2oL/iJZc5nKwUexaenL7glr+TA0lrNHdk9C6cVfWi",
-	"FDm7jBWSljwpH9hwhHfv3vJypd69ez+IzRiqD36qJH+hCTInCKvaZj6bUabhmuuU78s02WxwZEpXtm9W",
-	"ErJVTQbSkC3Jj5/mebyqTD+rxXD5VVW65UdkaHzOBrdlzFjVPLlzAop/tez29wflLwbNr4NdpTZg2K8b",
-	"Xr0V0r5n2bv64cMn+HixTfPwq7/yHU3uKphsXRnNutE3quDCSa3EWPWs4quUi+3du7cWeIW7j/LyBm0c",
-	"ZcmwW+dhZXhggEO1C2hecY9uAMFx9PtnXNwF9QqZK9NLwE+4hd035rfaryhFwI2360CaAV7bdebOdnJV",
-	"xpF42Jkmod3KCVkhGsOIFWqrPvffAli+hvzSJ2WDTWV38073EPDjBc3AOoShdH30iBITRqGDYgGsrgru",
-	"RXEud/3MPYZeVOCgr+ESdm9Um2/qmFQ93cwxZuygIqVG0qUj1vjY+jH6m++jysJbWp+ABd+nBrJ41tBF",
-	"6DN+kEnkvYNDnCKKTmaTMURwnUAEEf8ICm6wUDferUg/tTwhc5BWXEEGpViJRSrT8F+H/rAAq6NKn1zR",
-	"RyE3Axomlsyp8gu6WL16r7lcgbue3ZWqDC8pcWwyaAP1oTVwbRfA7V47v4zfNgboUKW8xsflaOGbuyXA",
-	"1u23sGixk3DttAo0FFEbH718Mh5/RoBDcUN4QvdWUzgZ1XU96hJJFcOt3GC3UWt9aF5MZwgXfd8AZmVV",
-	"125fHBTKJxSlvDXR/VIbvoIR3SX23k1M+dHx+OEghySSpAyiln1RYyAJJEGmxplbc/IMg/viDjGqmb2A",
-	"zDATOYi9zwjzhHuELUoUYJvIVdp7rjteVEp8PAZamrWAlq0oGMDoYiQ+jmtuwnHElLCBy06Szn7HF8T7",
-	"su+dR7GEUd7XJrdeuA37HHSg9/scfCHxXsi2Fyv9EzLnOd0Lny+ktkNJFE0LKGFFC6fGgVDanFDtBjk4",
-	"flwukbdkqbDEyEAdCQB+DnCaywPGyDfCJo+QIuMIbAx8wIHZDyo+m3J1DJDS57TiYWy8IqK/If2wjwL1",
-	"nTCqKne5ihF/Yx44gM+20UoWvYhqHIYJOWeOzV3x0rE5r4u3gwySwKFC0Uv55kNv7o8pGntcU3TlH7Um",
-	"EhJusppYmg1Ap0XtPRAv1DajF8pJXWSxXTh6T75dwPfSqYNJ6fbuGbZQWwznwquFYuUPwDIORwAjsr1s",
-	"hUF6xX5jchYBs2/a/XJuigoNkow3tDbkMiboTZl6RLYcI5fPogx6NwKgZ4Zqy1F4s8RB80FXPBle5u2t",
-	"Nm8zw4ZnYanjP3aEkrs0gr+hfayb8+4vbW7D8fxp4UR9kmR/Q8vSbZIwUueKEisek4OxTw4dIPZg9VVf",
-	"DkyitRvr1cVrhLUUK3HMd+iUHKLNQAmoBGcd0TS7TEUKOF0e8B6/CN0iYx3uHpe7+1EAoYaVMBZap1GI",
-	"C/ojzPEcM0QrtRxfna300q3vtVLN5U9uc+zYWeYnXwFG4C+FNjZDj1tyCa7RNwaNSN+4pmkJtBuiSPUU",
-	"RJHmuDjtJeyyQpR1ml79vN+9cNP+0Fw0pl7gLSYkBWgtsP5HMnB5z9QU2753wS9pwS/5na132mlwTd3E",
-	"2pFLd45/knPRY2D72EGCAFPEMdy1UZTuYZDRg/Mhd4yk0Sim5WSft2FwmIow9sEotfDsfezmp5GSa4ky",
-	"HaZfCKrVCoqQwS34w2SUJ69UchUVqqqqfWkBTxhl58Pkenvy8vkwfBgLwo/E/UzIArZp6GOtACFvX9Zh",
-	"TkGcZAWS0pWkzUJJ1MQh/tgistV9Yl9o/wFAMgj6Tc+Z3UYn0y4124kbUAIvvE5iIKxv/7EcbohH3Xws",
-	"fLqT3HX/EcIBkaaEjWq3DNMQjDBgXlWi2PYcTzTqqBGMH2VdHpG2kLX4wQ5goBsEnSS4TrZwH2rtDeyn",
-	"qPOeOq2MYq99YLGjb577B/hFrdGD0YlsHqamb3S1iWv/7ucLqzRfgfdCZQTSrYbA5RyDhijxu2FWUDhJ",
-	"IZZLiL0v5iaegw5wAxt7MYF0E0SWdtHUQtovnqbI6AD1tDAeRlmaYhK0MOaTfzP0cgWZPjIlNVdCtDU3",
-	"cFUln+t/B7vsZ17WTskQ2rThud7t1L18j9j1q813sMORD0a9OsAO7Apanl4D0mDK0t98MlGO7numU8UA",
-	"1cvOFh6xU2fpXbqjrfF1J8aJv71lOnUZuku5zcFogyQcLFN24yIdm+BOD3QR3yflQ5sgisMySCTvx1MJ",
-	"E6p0Dq+iJhfFIdp9A7wMxIvLmX2cz24XCZC6zfyIB3D9qrlAk3jGSFPyDHcCe45EOa8qra54mfl4ibHL",
-	"X6srf/lj8xBe8Yk1mTRlv/n67OUrD/7H+SwvgeussQSMrgrbVf80q6JKFfuvEkpo7g2dZCmKNr9JOh3H",
-	"WFxj8vKesWlQ96WNn4mOoo+5WKYD3g/yPh/qQ0vcE/IDVRPx0/o8KeCnG+TDr7gog7MxQDsSnI6Lm1Y8",
-	"KMkV4gFuHSwUxXxld8puBqc7fTpa6jrAk3CuHzE1ZVrjkD5xJbIiH/zD71x6+kbpDvP3LxOTwUO/n1jl",
-	"hGzC40isdijR2RemThgJXr+ufnWn8cGD+Kg9eDBnv5b+QwQg/r7wv6N+8eBB0nuYNGM5JoFWKsk3cL95",
-	"ZTG6EZ9WAZdwPe2CPrvaNJKlGifDhkIpCiig+9pj71oLj8/C/1JACe6nkylKerzphO4YmCkn6GLsJWIT",
-	"ZLqhqqCGKdmPqcZHsI60kNn7qhPkjB0eIVlv0IGZmVLk6dAOuTCOvUoKpnSNGTYesda6EWsxEpsraxGN",
-	"5ZpNyZnaAzKaI4lMk0zb2uJuofzxrqX4ew1MFE6rWQrQeK/1rrqgHOCoA4E0bRfzA5Ofqh3+NnaQPf6m",
-	"YAvaZwTZ67970fiUwkJTdY2OjACPZxww7j3R254+PDXTa7Z1NwRzmh4zpTp8YHTeWTcyR7LauzDZUqvf",
-	"IO0IQf9RIhFGcHwKNPP+BjIVuddnKY1TuS1a385+aLun68ZjG39rXTgsuimsdpPLNH2qj9vImyi9Jp2u",
-	"2SN5TAmLIwy6TwNGWAserygYFktdhOgjLuk8URaIzguz9KmM33Ke0vjtqfQwD96/lvx6wVNlcJwu5GCK",
-	"trcTJ2UVC53DBpgmxwHNzqII7qatoExyFejWBzHMSntDvYamnazRtAoMUlSsuswpTKE0KjFMLa+5pELp",
-	"rh/xK9/bALngXa9rpTEPpEmHdBWQi03SHPvu3dsiH4bvFGIlqAZ4bSAqMu0HYpRsEqnIF+puMnd41Jwv",
-	"2cN5VOne70YhroQRixKwxSNqseAGr8vGHd50ccsDadcGmz+e0Hxdy0JDYdeGEGsUa3RPFPKawMQF2GsA",
-	"yR5iu0dfss8wJNOIK7jvsOiFoNmzR19iQA398TB1y/oa7vtYdoE8OwRrp+kYY1JpDMck/ajp6OulBvgN",
-	"xm+HPaeJuk45S9jSXyiHz9KGS76C9PuMzQGYqC/uJrrze3iR5A0AY7XaMWHT84Pljj+NvPl27I/AYLna",
-	"bITd+MA9ozaOntoK0jRpGA5rrYWSWAGu8BHjX6sQ/tezdX1iNYZvRt5sYZTyD+ijjdE6Z5ySf5aijUwP",
-	"JUnZecgtjDXCmtJghBs3l1s6ypIYqL5klRbSov2jtsvsT04t1jx37O9kDNxs8cXTRK2tbq0WeRzgnxzv",
-	"GgzoqzTq9QjZB5nF92WfSSWzjeMoxf02x0J0KkcDddMhmWNxofuHnir5ulGyUXKrO+TGI059K8KTewa8",
-	"JSk26zmKHo9e2SenzFqnyYPXbod+ev3SSxkbpVMFA9rj7iUODVYLuMIXc+lNcmPeci90OWkXbgP9Hxv/",
-	"FETOSCwLZzmpCEQezX2P5Z0U//P3beZzdKzSS8SeDVDphLXT2+0+cbThcVa3vv+WAsbw2wjmJqMNRxli",
-	"ZST6nsLrmz5/RLxQHyTa847B8dGvTDsdHOX4Bw8Q6AcP5l4M/vVx9zOx9wcP0gmIkyY392uLhdtoxNg3",
-	"tYdfqYQB7Cu1JS4cAop8foSEATJ5SbmbceHHmLNuabhPLz7czcOudJhpmvzD+vFzHwF/MHfEHdt3qrHC",
-	"6SSjE65xUNcy6YQ+GAURbYAbdQGlcqpTXOomtlInya53gwUK/GPx7RbvAU5iuxZl8XObsazHHjWX+ToZ",
-	"+7pwHX8hybNzsRADSFbPWHMpoUwORxrbL0GzS+ief1NT59kIObFtv7YqLbe3uBbwLpgBqDChQ6+wpZsg",
-	"xmo3GVSTbKBcqYLhPG2phvbkD2swpwpDJl7t4rCb2vpoTHzh7NPoLEWJwYVpbyi2zDS3I/wEC5WHqjlu",
-	"HKwbbkh5ptFBMy42eN0YvqlKwJN5Bdpp/mqJL0W73TExGI4c1WFgpnKfsCWmYVDM1loytVxGywBphYZy",
-	"N2cVN4YGeeiWBVuce/bs0cOHSWMOYmfCSgmLYZk/tkt5dIpN6IsvHUQJ7o8C9jCsH1uKOmZjh4TjKyVi",
-	"qeMUT6UayGjvQN+fu5KoSmJT0fOEfYv5fBwRdxK4oxEupMbtpomsq1LxYo4pe998ffaS0azUh2q/U5XG",
-	"FdqguuSfdBpMT5sZ8hWN5IOZPs7+BBVu1cZmTVHFVMY916It+yh6kSRonYqxc8JekGGwqbxPkzBM/Kw3",
-	"UEQ1HEk1ReJw/7GW52u0uHWu+XFeOb28aGBnrT8ielPX1PRBhu3g9hVGqcDonGG17WthAN+ZwxV0k/w1",
-	"GS+9xTck/esuT9dSEqUcU4S7qeBzLNoDcCSmBVd5ErIe4o+0t1CV4WOrrV5gr/QLg17p1p4vO6SMC4mj",
-	"2ffeZJ5zqaTIMcF/SlzEhGTTnG8TaiGkvWZm5k9o4nAlC8Y2L1w9FkdLyAZG6BE3dGRHX92mEnXQnxa2",
-	"vpDYCqzxnA2Keajf7N08QhrwNZocEcV8UulEqE4yvL8JCziSjDDX0Ijd7hv37Qdv1cVUD5dCov3Go80r",
-	"H+SIKY1Af6tkwrKVAuPX032jYt66PieYe7CA7fuTl2ol8guxwjEoOMwtmyIhh0OdhbhIH4fo2j53bX1G",
-	"+ObnTpATTXpWVX7S8ereSUHSbuUoglPROCE8IkJuM3482h5y2xvQjPepIzS4wlgsqPAeHhBGUyG6O8rX",
-	"TpEiisIWjN4JJtPCCpkA46WQwTGYviDy5JWAG4PndaSfyTW3pDtM4mlvgJcjYf347pY8y7cdqp8P36EE",
-	"1xjmGN/Gtrj1CONoGrQSP5c7Fg6Fo+5ImHjOyyYgOFGqGqUqL0QV+GSmV7w6xTgc4w5l/rsXwMFHaU13",
-	"rDFx7E00lnlvURcrsBkvilTCpq/wK8Ov4ekTbCGvm9JKzZu3bubtIbX5iXIlTb3ZM1docMvpomrwCWqI",
-	"K9KHHcb8MYsd/puqKzS+Mz4U+Oi3piHutzgu3fzw7WxK6nU0nRmxyqZjAu+U26OjnfpmhN72v1NKD49Q",
-	"/yHemPa4XLxHKf72tbs44nS0g6hrulqabLEY4azwe0jj0+Q57HIlvMoG1bPQl4+bl9iyHvChYRLwK16O",
-	"vO+OPQB0v5JVfOyVdz6alIBbn3TKcraXBY0m8qEI2J5PYegYG4t6paDXu7PF+7XuRei4R+q7jv+JIp9a",
-	"ZjHqd7qZa6jd4GN9Q4OS80PBhywVbRPW1HabVOutwyCnFOlI1YPwYkKn6P2Bkv0DDL+YcjMMS/DPZ+fF",
-	"UbwzVVNkRqMkdyBZUH885XqbZh2Fn0oZ0ZZYTFXanxhN/QaL5Ucp44djhSi7K8gt1tVso4c0wDEJ5N1k",
-	"wf7/r9Tr45pVE3TuM67vS7M+LKZ5gN0PMsNE2Y2oEOHJ9KTiZ02MKD1xueamzUfRexQ6+Wnacgk5pn3d",
-	"m4nnr04Bb7O8zIOKjrAso8Q8onmogYmLjzdAtQDtS5SzF56ogMitwRl7qHsJu3uGdaghWRmxeaV0k8yo",
-	"iAHyhoQkuWM2RR8WI0xDGYiFEPPoc8222f9Hk9pGeaVuOFcgSXdxtLmm9kyZruo8aS7X9ai8dvjmYCxZ",
-	"z7Ao7Lgo+gJr8BofAcSbzKqxwsbOh5VBrn1mVsyb1JjRQ45WMOG3kCSNZinFJcRl39Fpcc11EVrcSdYb",
-	"uptEGuhlM7NoI9SH/u5Ernl87JGXyokR2diLmW5QeBNRdc9Q6FuboQThWoLWUDTW8VIZyKwKEe374NiH",
-	"CorvuxESzGh9FwJuNLfv6zZ5Mda54pjLl/uwvniBTMOGO+h0lGJ4fM59yH5O38Mr41Dn6KCxoaHXwwU3",
-	"w9sEYQZIjKl+yfxtefj18k3sDkJK0FlwQvTzDctuyilMLFjUOV3Q8cFobDOTk4PsYSVJlT0frrKnI0Sv",
-	"gC9hd0qKT6hUGnYwBpokJwI9yqjY2+Q7tcSYFNyrOwHvj02UVSlVZiN27/NhkuQ+xV+K/BIwyVkTwztS",
-	"hJp9hubWxrF5vd6FpMBVBRKK+yeMnUl6NRF8nN36ab3J5T27b/4tzlrUlLfc21dO3sl0+DlmFNe35GZh",
-	"mP08zIBjdbecigY5kIJ3K8eiL64TJdlPpmrlQ69jv0x2S1QERUomuSDnxXM86KnqwfjGO0pGgD4tzrzT",
-	"g5lSpYIVb/IO3Q2VxlQ8GQJkQU55Dt1A4QdPIiBZ+DlxCim3l8/qpZZMQ+tPvGl6s2GN6pRG35+5maXL",
-	"75ZKQ6fatOtNqQybyP5QFp7rhbCa691NkpANamQPrCejWD4YmdME5bQLaQNzhjgsS3WdIbPKmkT+KdXW",
-	"tTPdyzhUlWr7uVO9gCjEhxsvqO3YmhcsV1pDHvdIP2gjqDZKQ1YqjPhJOSOX1sndG3zFIlmpVkxVuSqA",
-	"CmKkKWhsrlpKjmITRAEWSRQQ7eBzSOoT0fHEKe+qQDulraFFZ+TWGgleBePT1HgMUeMhvHuKm6d581Js",
-	"kW5Ap478klldw5z5Fv0iwP7gcw0Ma+ojKA0tXYuyxJexYhs54Rofdhq1I2LvOUbYXQkMw+i+kiZpOC45",
-	"D0dWnPdw7is6z34yNUbK4BMZN8VTtlHGek2TRmqX3EYffZYrabUqy65RikT0lXdUfM+3Z3luXyp1ueD5",
-	"5X3Ua6WyzUqLeXhA2o8Ta2fSvdxJE6vj93ORUjuMmvJEe3QJfM/Jjq5cHYH5/jAHPWxzP0tV+O+uq8tM",
-	"02rMmWTcqo3I02fqnyvwajRcKsWikkmZqHgcPaPHZnjY48uq8bMjixyiGSRPVr86Y54ReH8jshv3X5TA",
-	"++OyJXhGM3JRDpmLl6KyfFTW6wGAkNLbTltrqjgXS2INV1EreguO3tI+oBNvFQxKuR1sboQ7B8rCrYAa",
-	"BMI1AH5Gxoc5Jc+ioLqF2obv99vsWjcC/uN+Ku8wj7Fon4uWtDTF+4RMHCMcIZ3Dd29ozBt817uYGiDT",
-	"VAedeMNHAIyHzHRgmBQ4cywYSy5KKLJUcbnzxkY1jzRt/0qnX/NZGM/Jc16H2m5u7FqDzwxBIr7u+r8q",
-	"7khJNc2HlmRZwBYoxJ8K3XNDfo/gf4GSarr1jAGqykq4gk4kkU9XUaOoKa4g9DVNZ1YAVOiN7NvIUiEy",
-	"8V3eM5z4tWdRkMUU7CYtKYRY2il2wEySNOpsZUbHxEw9Sg6iK1HUvIM/c6zI0TUDuqOcQNVAR8iCHjl1",
-	"mp9ohNdhgLPQPyXKBEy8n8aHjmZBadTtY0AHQ+ZqM3bqZTpiLs7F0jhYcLaiccQSibd8w1T8Wo4bJIck",
-	"36pbE/dJKBkh9ust5CjVeH0HCq/xjDgpfFoHpHYJUJBW4LokrO1rkEyqqIbeNTeNqtImiQs/0MTYSEiv",
-	"Td/AqdwGtt1+ZxkOxkwvW9SoIqEbOr25ef4POYl7D+LoeCkaMeBfgu2xfwXq9moHNsBaxdLtp5P9sQqd",
-	"v8U8F5+zRR0GKkt1TUXxYj30BQQ/KFFfcAF5sVw013II4Jv7/IV9U4eIQpc3fMeUxn+c1vn3mpdiuUM+",
-	"Q+CHbsysuSMh73iliAAfEOgm3i9ezQNgwdqiwlS0bjF1zGi4nRslAtpd5KF6iWIbfgnxNmCwA/HP3DrG",
-	"aeoFWi7cld3bziEW/OJDDooNL2JNHzPhdetEh9yorvf/3z6LiqcKCayqkuehBKKvwdLlM1jmNBCXXcNm",
-	"/7u5IV8LJNCUTm2JVoeH1sUNTKZHsq5UMPpYfYkO2IOSkoPSGrdaxjHV59s363teHE5ayl3vwtSomwHQ",
-	"cSG6Q+DHdfk+Df6TSSrHljEF/H8UvI9U4ozhpaKbnwDLnWQMCVjJWr1Q20zD0hwKMCFztVPndZvGIZhY",
-	"hcw1cEMRN+c/esWzzcEopFOEKSa08Wk2oxSwFLJllkJWtU3oMZiKUe4ihMVGf0TriAttTEpwwuQVL3+8",
-	"Aq1FMbZx7nRQzbo4B35wdPi+CRNGc6cOBxCm1eHwqV5rRo+buQucquxQuKaxXBZcF3FzIVkO2t377Jrv",
-	"zM09So1z4JBPiUfSTPcBeeRdQtImQMqddwrf0t/TAMjv0PEzwWGDccEJZw2Zdqwa8c8MYfincNhs+DYr",
-	"1QoflI0cCJ98Ez18pAIqiWZwks+mrTvMY8RvsH8azDvuGZFVOOuUKfaf+x9xK1GN/EkKu/fkk42y/8KP",
-	"4m7pYAakylUb/E/EMjyPqUeZPg9H/DAzCJvhIXugPYg2EUb8Q127+MguYhiEf9EbG8Gn13PqRlqknn6S",
-	"ZSBDi4HZE94Ppg1l57kPzxqa0gamBkLK3D+cPdLSRvb5cC+NgEfFt/1Z707bhMy4cY4pgrX/qWxWqSrL",
-	"p8R8UmmCwrsJPKRdGEfoI3ICjKy7CY8xTbGOTgqcTtWOY+uAjVYNOeTtqvJ9Sv+YmWiEo3ddEGqJvIxK",
-	"U6N1C1/yNMaUeVCvg0+6awZrmATjTENeazQTX/Pd4bpKIylxL/5y9vmjx788/vwL5hqwQqzAtGmVe3WJ",
-	"2rhAIft2n08bCThYnk1vQniITogL/sfwqKrZFH/WiNuaNmfioCrTMfblxAWQOI6Jejg32iscpw3t/8fa",
-	"rtQi73zHUij4/fdMq7JMp7Vv5KqEAyW1W5ELxWkgFWgjjHWMsOsBFbaNiDZrNA9ictMrSiyiZA7Bfuyp",
-	"QNiRkKvUQsYCapGf4TNf7zVisK1Kz6vI07NvXV5PIwsdCo0YFbMAVqnKi/ZiyVIQ4QsiXUNjGfeGT7SI",
-	"RzGyDbOlaNkUIfrI8zTpxRWB93P7brVKm+b0bhMT4kU4lDcgzTH/xPgT9ptwkta0/w/DPxJv8u+MazTL",
-	"/T14RVI/uFnV8UmgDd9nJ8gDARh5bdt5Jxk9FIsyrWryEqA/ITiQ++LH961j+eCzEIQkdDgAXvx8tm3X",
-	"vGTw4PzBGUy/b5ASLeX9GCV0ln/oRW5gvc1FEm2RN5pYC4bYkhqKhdFza/O8ecU8opUMHjtrpSxzmmlZ",
-	"Jh5Jkx0Hz1RMOE4l0Fe8/PRc4xuhjT1DfEDxevxpVPxSNkYyodLcLGXbSz5p7uhV7N1NLV/hw+y/gtuj",
-	"5D3nh/JO+MFthsYdLMm9CrcCvfVm1zgmBVk9+oItfDWBSkMuTN+5fx2Ek+ZhKGix9AGtsLUHXqIeWufP",
-	"yt6CjJchEof9ELm3Gp+9h7A9on8wUxk5uUkqT1HfgCwS+EvxqLj66IHr4paZ52+WASTK5XVkBpBhXdWp",
-	"y8N14KVTGxiuc/Jt3cFt4qJu1zY1fc3kBPbv3r21iylZZ9LJ5l13THtzJ1nnj8o5/zskvCEc+TH8vCmK",
-	"+XksBSql+RxJ09zbj1qUBwNWOkm3P85nK5BghMG00r/44hif9i4NEFDmheFRJVhvky6GEJNYa2fyaKoo",
-	"nfaETNq+WyL9Mb5qzGst7A4LowYDmvglWcr22ya3h88N0/jS/N1n1SU0xanbTCC1Cbfrt4qXeB+Ri0+6",
-	"W0iVJ+xrSvbsD8qf7y3+A5786Wnx8Mmj/1j86eHnD3N4+vmXDx/yL5/yR18+eQSP//T504fwaPnFl4vH",
-	"xeOnjxdPHz/94vMv8ydPHy2efvHlf9xzfMiBTICGLO/PZv8rOytXKjt7dZ69ccC2OOGV+A7c3qCuvFRY",
-	"uM8hNceTCBsuytmz8NP/CCfsJFebdvjw68wXoJmtra3Ms9PT6+vrk7jL6Qqf/mdW1fn6NMyD5dQ68sqr",
-	"8yZGn+JwcEdb6zFuqieFM/z2+uuLN+zs1flJSzCzZ7OHJw9PHvnavZJXYvZs9gR/wtOzxn0/xVSLp8Zn",
-	"UT9t3mp9nA++VRXlWHefPI36v9bAS0yw4/7YgNUiD5808GLn/2+u+WoF+gRfb9BPV49PgzRy+sFnTvi4",
-	"79tpHBly+qGTYKI40DNEPhxqcvoh1AbdP2CnLqSPOYs6TAR0X7PTBdYDmdoU4tWNLwXVGHP6AQXx0d9P",
-	"vTUl/REVIjpppyFRy0hLepKf/thB4Qe7dQvZP5xrE42Xc5uv6+r0A/4HD020Ikr2eGq38hQdyKcfOojw",
-	"nweI6P7edo9bXG1UAQE4tVxSQdV9n08/0L/RRLCtQAsnjWJWHf8rZT87xbpau+HPO+ndnSWkctb8JA2Q",
-	"thySz+9k3j59a/jIeREaX+xkHsTmEBOJ3OHxw4c0/VP8z8zXnelldjn153k2rZh+N70i8t6eva6Blx74",
-	"gT2ZIQyPPh0M55LiIB0zpkvj43z2+afEwrl08g0vGbak6Z98wk0AfSVyYG9gUynNtSh37CfZhHJGVUBT",
-	"FHgp1bUMkDuJo95suN6hJL9RV2CYLzAaESfT4GQnCvfAEICWhvHK446PvJ1V9aIU+WxOyTTfo7RmU4JL",
-	"MCINZwoGtHbw7qn49uCZmL4LXXl4T8qaSXAeSGZAww+F+eH+hr3vu2BpqnupDZr9ixH8ixHcISOwtZaj",
-	"RzS6vzDvGlT+iWvO8zXs4wfD2zK64GeVSiWWuNjDLHyhizFecdHlFW2o4ezZ22lFzrzXgwzaBRh3mE+C",
-	"MuMk9VbX0A1HCmcefa7RXu8r3Pzx/T/E/f6cy3CeOztObk2uSwG6oQIuh7VH/sUF/p/hAlREidO+zpmF",
-	"sjTx2bcKzz55gHw6TUmeuYl8oJP9tBWmOz+fBrtFSgfttvzQ+bOrV5l1bQt1Hc2CFn9yVw21DPexNv2/",
-	"T6+5sNlSaZ90E4vRDztb4OWpL7bS+7XNbz74gknbox/j56TJX0+5VzdS35DXjXUc6MOpr17lG2kUoqDD",
-	"59bqFluxkM829qu37x2XwyrTngW3Rplnp6f4LGatjD2dfZx/6Bls4o/vG8IKVTVnlRZXmO7+/Xy2zZQW",
-	"KyF5mXmrRlsxavb45OHs4/8NAAD//4/RRj2aBgEA",
+	"H4sIAAAAAAAC/+x9f5MbN67gV2HpvSr/OGnGv5K3mautd7N2kp2Lk7g8k+y9i30J1Q1J3GmRvSR7RorP",
+	"3/0KINnN7mZLrRnF2b3KX/aoSRAEQRAEQODDJFPrUkmQ1kzOPkxKrvkaLGj6i2eZqqSdiRz/ysFkWpRW",
+	"KDk5C9+YsVrI5WQ6Efhrye1qMp1IvobJWdx/OtHwj0poyCdnVlcwnZhsBWuOgO22xNY1pM1sqWYexLkD",
+	"cfFq8nHHB57nGozpY/m9LLZMyKyocmBWc2l4hp8MuxV2xexKGOY7MyGZksDUgtlVqzFbCChycxIm+Y8K",
+	"9DaapR98eEofGxRnWhXQx/OlWs+FhIAV1EjVC8KsYjksqNGKW4YjIK6hoVXMANfZii2U3oOqQyLGF2S1",
+	"npz9NDEgc9C0WhmIG/rvQgP8CjPL9RLs5P00NbmFBT2zYp2Y2oWnvgZTFdYwaktzXIobkAx7nbBvK2PZ",
+	"HBiX7O1XL9nz58+/wImsubWQeyYbnFUzejwn131yNsm5hfC5z2u8WCrNZT6r27/96iWNf+knOLYVNwbS",
+	"m+Ucv7CLV0MTCB0TLCSkhSWtQ4v7sUdiUzQ/z2GhNIxcE9f4qIsSj/+7rkrGbbYqlZA2sS6MvjL3OSnD",
+	"ou67ZFiNQKt9iZTSCPSnJ7Mv3n94On365OO//XQ++9/+z8+efxw5/Zc13D0USDbMKq1BZtvZUgOn3bLi",
+	"sk+Pt54fzEpVRc5W/IYWn69J1Pu+DPs60XnDiwr5RGRanRdLZRj3bJTDgleFZWFgVskCjCFontuZMKzU",
+	"6kbkkE+ZkOx2JbIVy7hxIKgduxVFgTxYGciHeC09ux2b6WNMEsTrTvSgCf3zEqOZ1x5KwIakwSwrlIGZ",
+	"VXuOp3DicJmz+EBpzipz2GHFrlbAaHD84A5bop1Eni6KLbO0rjnjhnEWjqYpEwu2VRW7pcUpxDX197NB",
+	"qq0ZEo0Wp3WO4uYdIl+PGAnizZUqgEsiXth3fZLJhVhWGgy7XYFd+TNPgymVNMDU/O+QWVz2/3n5/XdM",
+	"afYtGMOX8IZn1wxkpnLIT9jFgkllI9bwvEQ0xJ5D8/B4pQ75vxuFPLE2y5Jn1+kTvRBrkZjVt3wj1tWa",
+	"yWo9B41LGo4Qq5gGW2k5hJCDuIcV13zTH/RKVzKj9W+GbelyyG3ClAXfEsHWfPPnJ1OPjmG8KFgJMhdy",
+	"yexGDupxOPZ+9GZaVTIfoeZYXNPoYDUlZGIhIGc1lB2Y+GH24SPkYfg0yleEjpB70BFyHDoSNgmewd2N",
+	"X1jJlxCxzAn7wQs3+mrVNcia0dl8S59KDTdCVabuNIAjDb1bA5fKwqzUsBAJHrv05DCMM9fGS+C114Ey",
+	"JS0XEnImpENaWXDCahCnaMDd953+KT7nBj5/Mfm47+vI1V+o7qrvXPFRq02NZm5LJo5O/Oo3bFqzavUf",
+	"cT+MxzZiOXM/9xZSLK/wtFmIgk6iv+P6BTJUhoRAixDhbDJiKbmtNJy9k4/xLzZjl5bLnOscf1m7n76t",
+	"CisuxRJ/KtxPr9VSZJdiOUDMGtfkhYu6rd0/CC8tju0mea94rdR1VcYTyloX1/mWXbwaWmQH81DGPK9v",
+	"u/HF42oTLiOH9rCbeiEHkBykXcmx4TVsNSC2PFvQP5sF8RNf6F/xn7IssLctFynSIh/7I5nMB96scF6W",
+	"hcg4EvGt/4xfUQiAu0jwpsUpHahnHyIUS61K0FY4oLwsZ4XKeDEzlluC9O8aFpOzyb+dNvaXU9fdnEaD",
+	"v8Zel9QJVVanBs14WR4A4w2qPmaHsEABTZ9ITDixR0qTkG4RkZUEiuACbri0J5Npak82G/gnP1JDb6ft",
+	"OHp3rmCDBGeu4RyM04BdwweGRaRnRFZGZCWFdFmoef3Dw/OybChI38/L0tGDtEcQpJjBRhhrHtH0ebOT",
+	"4nEuXp2wr2PYpIorNC/NwasaeDYs/KnlT7HatuTn0EB8YBgtJxprPk5rMhgD9hgcR9eKlSpQ69nLK9j4",
+	"r75tzGb4+6jO/xosFtN2mLmwFfOUc3cc+iW63DzscE6fcby554Sdd/vejW0Qyg6GMRcNFY/NPPSLsLA2",
+	"ezkhwijiJr88XGu+nXglcUbKXp9NfjDgOKTkSyEJ2ylenyRb82u3HorojowApr4XOV4ioI0J1eucnvQn",
+	"PTvLvwC3phY2aKKGcVYIY+leTY3ZCgpSnLkMDB2zyp04Y8SC75hEjfOt5qXjZf/FqV1C0n3eNXK43vPg",
+	"HXkmJnFuPscLTVjdWSzvFZ1JTPBDF4e/FCq7/is3qyPs8HmA1ed9GoatgOeg2YqbVWLjdHi7gTaGv7Eh",
+	"8SybR0Od1FN8rZbmCFMs1CGiqyxf8qLAofsiqzNbAjxqIxcFw8YM1sLa5uLoLOzu/sW+5NkK1QKW8aKY",
+	"NqYiVc4KuIGCKc2ElGjtsitum81PkMO9hvaRARR2Flg0G29mIhObrm0RGtia0wm0xttMWbT71BLU8DV0",
+	"tCA6EVVFVoToonHxKswObkCSTKpBE/r1HMlaEwM/Yef1JxpZKjc5ZwG0wX1X06+WFy2ksXVznspmCKVz",
+	"Z7O2+JvQLFPagXAnvB8c/wNcN50ddz4sNcw8CM1vQBte4Ow6k3pUs++xdueenZlzy6Od6bkwfQFzkoP6",
+	"kXoHOmGl+Z7+wwuGn1GLQU5quEeQMqIid2ruDmYklRsJG5C9VbG1M2UytC8ehOXLZvC0mBm187501lO/",
+	"hH4S9QpdbURujrVMBGxordo7xNmugjjq6SI7hU401hgCXKmSOfHRQcFJCoLmCKI2Rz/W/qI2KZz+oja9",
+	"I01t4CgroTbuP6OEPeH3h17qGYtINz1AP6VFowNcxmcDot24Hs/nSt9NYeqcoZI1DlXGEWqkL047fEBN",
+	"q3LmxU/CKeMadAA1MSy79Zwu+BS1WlS4tPw3oIKxPEL+HlRoAzo2FdS6FAUcYXevknoqmsCfP2OXfz3/",
+	"7Omzn5999jmyZKnVUvM1m28tGPbQWx6ZsdsCHiU3GilQaeifvwhuuDbcFByjKp3Bmpd9UM695y74rhnD",
+	"dn2qtclMs64RHCX0AU9vR3bmPNe0KckR+RYKxfPj2DILAQPCKVtxuYScGbBWyKU31UEedD5dSYniUqoc",
+	"DjkNiQ7IrbOGRKPGdzKS1BbLr4HBYgGZ9W4wzjzUexzMgRwJDEetWYR05j3GToyHKSAGr2BeLS/9D2+0",
+	"Whz9zO6NkEKWGr0pNaq/pu3N9ut7mmOTU9hYzU9LagkyJ7FF8xCGGwPr+VHkwtDezZtRcuY3RQ575dqh",
+	"O60ZZhvttld6q6tjGOFAa6WT/FhqZVWmihneRoRKqCtvfAvmW4TlKru/O2zZLTcMxyYfeyXzAa0Eneej",
+	"tSwH+mojG9rs3EhuvonZ+XHHrEub+M1ducSQoI1kxJ0tZWmh1ZpxllNH0oi/ButuCWINl5avy+8Xi+PY",
+	"5BUBSggusQaDIzHXggnc/ZmSLuR0jwLnoY4hT5cwwRdqhxHwFLncyowcusfYtsO67VpIii4xW5lFii7i",
+	"WEC+BD2CHuMV2SFyuKEemAQ6SI7X9Jk8Sq+gsPwrpa+aS9bXWlXl0cVzd8yx0+F+Mt5nlWPf4KwQclm0",
+	"w5yXiPtJao6/y4Re1qYuNwfCnjjytViubGTVeKPVb3AmJkdJIUofnEmzwD59w+Z3KkdhYitzhNtAA6yR",
+	"cMi3sVzjc1VZxknRosWvTPqeMBAYS3oJBRLa+OpBVjRh2ByQuzJe4WyrklGYXO+8aDrOeOZ26IxIY9ID",
+	"NtFdrpUbzgVdFhp4jiZLkEzNfSSOjxGiSXKK8bNB0/a3lIS8aOFVapWBMejsdH6JvaiFdu7osDvoRIgT",
+	"wvUozCi24PreyF7f7MXzGrYzikg17OE3P5pHvwO+Vlle7CEstUmRt2v17WM9bvhdDNcdPGY7Z092XMus",
+	"ootVARaGSHgQTQbXr4tRbxXvT5Yb0BT49JtyfBjkfgxUo/ob8/t9sa3KgXcW3tKCGh4umORSBcUqBazg",
+	"xs72iWVsFM/F4AwiSZiSxAR4QPF6zY11wXpC5mR5d8cJjUN9aIhhhAevIQj5x3AD6cPOlDQgTWXq64ip",
+	"ylJpC3kzWDMHss8OjvUdbOqx1CKCXd95rGKVgX2Qh6gUwffE8jdg+oPb2hrr7bv9yVHkB57z2yQpW0g0",
+	"hNiFyGVoFVE3jjUfQESYhtCOcYTpcE4d4D6dGKvKEqWFnVWy7jdEpkvX+tz+0LTtM5dzxdGYLFdgyM3n",
+	"23vMbx1l3SuDFTfM4xEM7mSRc1GFfZxxM86MkBnMdnE+XfGwVbwF9m7SqlxqnsMsh4JvE64C95m5z7sA",
+	"0Io3111lYebCxdOL3nByiM7dAVoRvITQ/E4x+sIy3IJ4FWgYxPfeAzkHgp0STp6PHtSgaKzkEgV4NG23",
+	"1AmIdBreKIsr7ho5lL1EH4PwAB1q0HcnBXXeYZD8LzB+gNDmDoNswQxNoYF/0AQGzPn+JV60XzrivSOB",
+	"k2JzUIztkSNDW3bAt/CGaysyUdJd5xvYHv3q1x0gGd7BcrBcoJEx+uCugWXcn7lA5y7Mu10FR9ne+uj3",
+	"jG+J6YRgsjby17ClO/cbAP0XLo/iruUH2BH9uPs95HykkRB1qBJAszmXNOcwuzfiKLMrxYGzeyP2z64U",
+	"h8xOSLwd4yTrpTvKzAD0YVPD8MX9cyOwYyeXKSkhs+35UShPZIU7hpklAZUJ92YTpxGejODtMG4CG57Z",
+	"Yss46YdbdgsamKnmLgas763FSK8YQNL7u2NEH96SDC7ZGW9zSaCi6aXcbu66uhu/q86dtUUOf00tlSpG",
+	"GG97xEhiMCr4jpUKV13496PhBWEQci0kvT5RbAO6XouJyUwzYP+lKpZxSdaAykKtbitNOiz2pRGEicb0",
+	"0d0NhaCANTgjB315/Lg78ceP/ZoLwxZwGx5dP37cJ8fjx2RifKOMbcn9Y2x4ru1FQrMhtzjqZP6C3D3u",
+	"9oeMeshjVvJNB3gYlPaUMZ5xcfr3FgCdnbkZM/eYR8aFy9rNyJlftQMse/Omdb8U66rg9hgOVbjhxUzd",
+	"gNYih73y3Q8slPzyhhff193oQTlkyKMZzJxTeyQsuMI+LmAB4QgprAivpsYiBBeu16XrtMf60YRUifUa",
+	"csEtFFtWasggdw4hYZipp3rCCKz32aOI16pa+igsB4cEPj7QpyfRleyBSOr7diNn5H9JHQA+zje8GUdN",
+	"HzhaG7rOG3e3vuX1eJC3zoWRa9B1ZiX9t9PJoDEGiXrTGGMccdoP30ccBq2rSESfZuCRXj4iHarlfXrF",
+	"y4KbCRf3t/EmNaBTWPYHjp5MNB+HXk2gJajYHkHpcYCYhlKDoSMqtqAa91Ut4iQXIdZ6ayys+04m1/Xn",
+	"ge33dtCUoWQhJMzWSsI2mddJSPiWPqZ6u2NyoDMpLEN9u9fjFv4dtNrjjOHG+9KXVru7Q7vOVPOV0sfy",
+	"1juAo5X+Ec7xvfcBP+RdXfgYy9/3evsn8F0BYKZ1XK7QjBujMkE620Vupm6jeUe5fy/fJv+b+mHfEfZe",
+	"F27HvRtnVyH3BRQl4ywrBDk3lDRWV5l9JzmZT6OpJkJEg51o2KD+MjRJW/ATBnYP6p3kFB5cG1WTsUQL",
+	"SFgQvwIIdnVTLZdgbOeuswB4J30rIVklhaWx1rhdZm6/lHi131o4cS3xocsCecIq9itoxeaVbWv/lOHB",
+	"WDTPO18zDsPU4p3klhXAjWXfCoxkQnAhHiVsWQn2Vunrmgrp030JEowws3Qo69fuKz2M8tNf+UdS+H/f",
+	"OUTtNylnJjjNVpap//PwP88wuxSf/fpk9sV/O33/4cXHR497Pz77+Oc//9/2T88//vnRf/57aqUC7iIf",
+	"xPzilb8ZX7yi60/01qmL+ydzTWHSkiSTxYFGHd5iDynXjmegR227rV3BO4lRZFZhqieRc3s3duieML29",
+	"6HZHh2taC9Gx04a5HnipuIeUYQkh0xGNd9ai+tHf6UwfuJAheQe2YotKuqUM2rd7yB5CH9ViWmdzcYke",
+	"zxil+ljxEELu/3z22eeTaZOio/4+mU781/cJThb5JpWIJYdN6q4YvzJ7YFjJtwZsWnoQ7skoTxd2FINd",
+	"AxoZzEqUn15SGCvmaQkX3nx6m9NGXkj3Qgr3D3nft96ppxafHm+rAXIo7SqVAK6lqFGrZjUBOhFR+OwH",
+	"5JSJEzjp2nxyvC/6eNMC+KKOiVdqzG2o3geO0QJXRFSPJzLKsJLin877MH/4m6NfhzzgFF7dMVPB5g++",
+	"/vKKnXqBaR4QtTzoKItL4irtPrRj5SzjrUe57+Q7+QoWZH1Q8uydzLnlp3NuRGZOK4P+iILLDE6Wip2F",
+	"B+2vuOXvZE/TGsxMG2WdYGU1L0SGrpYUe7psg30I7979hFbdd+/e98KG+tcHP1RSvrgBZqgIq8rOfK60",
+	"mYZbrlNuWVPnyiLI1HvnqE7JVpUzkHr4zMNPyzxelqabM6c//bIscPoRGxqfEQaXjBmr6ge9wtQ5EXB9",
+	"v1P+YND8NthVKgOG/bLm5U9C2vds9q568uQ5sFYSmV/8kY88uS1htHVlMKdP16hCE3fXSnpGMSv5MuX9",
+	"fffuJwu8pNUnfXmNS4CKLnWLaVI/XyJQzQQCPYYXwOFxcHYFmtyl6xXy4qanQJ9oCdsZLO61XlECkjsv",
+	"154kJryyqxnu7eSsDLJ4WJk6XeaSC2lCoJARS7qt+syiczQpQnbtUz7CurTbaau7WrQUzSA6hHHJQN0T",
+	"bUpHRw4KTBJa5tyr4lxuu3nB/PsiAvoWrmF7pZpsdockAmvnpTJDG5U4NdIukVnjbethdBffBzyGl/o+",
+	"vRO9fg9scVbzRegzvJGdynuETZxiilbepCFCcJ0gBHUYIsEdJorw7sX6qekJmYG04gZmUIilmKfymP+t",
+	"7w8LuCJX+tStPkC+BmjQRSasYXN3sPrrveZyCYxT5FOpDC9cWupkPBHdh1bAtZ0Dtzvt/DJ+OR2ww/7s",
+	"FneWs/BNcQqwwfUWlix2Em4h94Yi18YH1p8Mh0Y6xCG/Iz6he3NTOBm863rSJVK2hlO5pm59rfVRozGf",
+	"Xa3q72ugnM/qFtcFsVA+XbHLihWdL5XhSxi4u8Teu5EJhVoePwKyTyNJ6iAYytJWNXqaQBJl13iGc07u",
+	"YcAvuInpmtmJFQ4jOQex9xlRFQJPsHlBCmwdVO3WnuuWF1Uud6GWFi2gZaMKBjTaFIm344qbsB3zaSRl",
+	"R2lnv2F+gl25PS+iMNcoq3SduTOchl0J2rv3+wyfIa1nyOUZX/pH5OWcTpwASC6HkqSa5lDA0k3cNQ6M",
+	"0mScaxYI8fh+sSDZMktFzEYG6kgB8GMA3lweM+Z8I2w0hBQbR2hT4AMBZt+peG/K5SFISp8xjwfYdERE",
+	"f0P6zal7Q4LKqCrxcBUD/sYsSACfy6fRLDrB/gSGCTllKOZueAHShrt4A6SXYpIuFJ2Ekj705tHQRWOH",
+	"a8od+QfNiXrcaTaxNhuQTqvaOzCeq83M5T9I3kXmmznye/JZDfZKbkyXzPOBYXO1oUhDOlrcM449uAzj",
+	"EdBoEKAsjTh36jekZzlkdg27W89NcaFhD2uts2GXIUVvzNADuuUQuzyM8nPeCYFuvoM6ma83S+w1H7TV",
+	"k/5h3pxq0ybvdHixmNr+Q1souUoD9Ovbx9oZNf/aZE4dzs7oG32aVKJ9y9J9Ury6zoSIOSjDa5cdWkjs",
+	"oOqbrh6YJGurVYeuEdVSooQJmXBK9slmoAC6BM9aqunsGrbpuzzQOX4ZukXGOlo9LrePogBCDUthLDRO",
+	"oxAX9HuY4znln1dqMTw7W+oFzu+tUvXhTx2dMb41zU8+A3ocshAaXyGgxy05BWz0lSEj0lfYNK2Bthab",
+	"uWotIk9LXBoW3xPmoqjS/OrH/eYVDvtdfdCYak6nmJAuQGtO1YWSMfU7hnbPLnZO+LWb8Gt+tPmO2w3Y",
+	"FAfWyC7tMf5F9kVHgO0SBwkGTDFHf9UGSbpDQEa5EPrSMdJGo5iWk13eht5mygPsvVFqISPD0MnvICXn",
+	"EuVRTT9eVcslPuJzucOCP0xGWTgLJZdRGbyy3JV09ARrLxifunNH1k8fhg9DQfiRuj8T6LFNYx81c5g3",
+	"jz4pYykNsgTpMumkzUJquSfEn1pEtrpP7AvtPgBIBkFfdZzZTXSyW6V6OWkBCuAhA5iBML/d27K/IJ50",
+	"06Hw6Vbq6N1biAASTwkbVYbqZ8gYEMC8LEW+6TieHNRBIxg/yLo8oG2RaPHA9lCgHQSdZLhWLQIfau0N",
+	"7Kd05z3FW5mLvfaBxcjfPPO5IfJKkwejFdncL3xR39VGzv2bHy+t0nwJ3gs1cyjdCwRN5xAyRGUlDLM+",
+	"g10uFguIvS/mLp6DFnI9G3s+gnUTTJZ20VRC2s9fpNhoD/c0OO4nWZpjErww5JO/6nu5fNvYlFQfCdHS",
+	"3MFVlcwk8Q1sZz+i0YGVXGjThOd6t1P78D1g1W/W38CWIO+NekXE9qwKWZ7eAvFgytJffzJRBYAHJqaY",
+	"u162lvCAlTpPr9KRlsZXtRlm/uaUiWfUmcp9NkYTJIG4jFmNy3RsAu4eaBO+y8r7FkHk+3WQSN+PhxIm",
+	"1ADuH0V1mpR9vIs5DgPz0nQmH6eT+0UCpE4zD3EPrd/UB2iSzhRp6jzDrcCeA0nOS4zf4sXMx0sMHf5a",
+	"3fjDn5qH8IpPfJNJc/bVl+ev33j00SVdANez2hIwOCtqV/7LzMrVwdl9lLhyCd7Q6SxF0eLXKe3jGItb",
+	"Ko3QMTb1qko18TMNvBBzsUgHvO+VfT7Ux01xR8gPlHXET+PzpM6dIB9+w0URnI0B24HgdJrcuNJkSakQ",
+	"A7h3sFAU8zU7qrjp7e707mi4a49MorG+p6yp6RuH9DlVSRT54B9+dO3pK6Vbwt+/TEwGD/12ahUq2Y6O",
+	"A7HaoQBwV5k6YU7x+mX5C+7Gx4/jrfb48ZT9UvgPEYL0+9z/TveLx4/7SLvTLi0kyEol+Roe1a8sBhfi",
+	"017AJdyOO6DPb9a1ZqmG2bDmUBcFFMh966l3q4WnZ+5/QXcs/nQy5pIeL7ojd4zMmB10OfQSsQ4yXbua",
+	"w4Yp2Y2ppkewyFok7H1NG+eM7W8hWa3JgTkzhcjSoR1yblC8ShdMiY0ZNR6w1iLESgzE5spKRLCw2Zh0",
+	"vh0kozGSxDTJjMIN7ebKb+9Kin9UwEQO0uInTeda56gLlwOC2lNI03YxD5j6RODvYwfZ4W8KtqBdRpCd",
+	"/rtXtU8pTDRVNe3ACPB4xJ7g3hG97fnDc7N7zbZqh2COu8cEh17SfOA9iEHQeWfdwBhNgVbq51IXCTNb",
+	"aPUrpB0h5D9KJMLwA9F1hHqnIve6IqV2Kof5xKPvW+7xd+Ohhb/3XThMui7beJfDNL2rD1vIu1x6TTqT",
+	"+HQSb8k0Xu4jaz8NGBAttL2iYFgqpBOij7h0+8llgWi9MEvvyqiFOXXwm13pce6ualbw2znPrtN3IcQp",
+	"Wt5WnJRVLHQOC2DqHAdudBZFcNdthUtyWIJufBD9hMl3vNe4YUffaJoLDHZsXV2mLkyhMCoBppK3XFoI",
+	"YQxOXvneBpwLHnvdKk0pSk06pCuHTKyT5th3737Ks374Ti6WOJJL4BmVsPeAmMuDSlyUC1MWfFtn7vCk",
+	"uViwJ9NmT4bVyMWNMBjITC2euhZzbui4rN3hdRecHki7MtT82Yjmq0rmGnK7Mo6wRrH67klKXh2YOAd7",
+	"CyDZE2r39Av2kEIyjbiBR0hFrwRNzp5+QQE17o8nqVM2hwWvCrtLZOcks0OwdpqPKSbVwUAh6aGmo68X",
+	"GuBXGD4dduwm13XMXqKW/kDZv5fWXPIlpN9nrPfg5PrSapI7v0MXSY1yMFarLRM2PT5YjvJp4M03ij+H",
+	"BsvUei3s2gfuGbVGfmrq07tBA7gT2htOptd4hY8U/1qG8L+OresTX2P4Os0PnKKUvyMfbUzWKeMuL20h",
+	"QnAP1AWP2UVIe02lfOrCg442OBZOnXRJXEKqBCWkJftHZRezP+G1WPMMxd/JELqz+ecvEpX82pWg5GGI",
+	"f3K6azCgb9Kk1wNsH3QW3xdfwcvZWqCof9TkWIh25WCgbnJYOxQXuhv0WM0XocwG2a1qsRuPJPW9GE/u",
+	"AHhPVqzncxA/HjyzT86ZlU6zB69whX54+9prGWulU7Usmu3uNQ4NVgu4gXxwkRDmPddCF6NW4T7Y/77x",
+	"T0HljNSysJeTF4HIo7nrsTxq8T9+2yTlJ8eqe4nYsQEqnbB2ervdJ442PMzq1vXfuoAx+jZAudFkIyh9",
+	"qgxE39PPTZ/fI16oi5Jb85bB8ekvTOMdnPT4x48JabQ7uqa/PGt/duL98eN0buykyQ1/bahwnxsx9U2t",
+	"IVaO7YsCtXFSOAQU+fwI/fVLH1J4Ms49jClrF5789OrDcR52pcNM0+wf5k+fuwT4naUjrdiuXU31k0cZ",
+	"nWiOvaq5SSf03iiIaAEQ6hwwaNK0qjBFdE+zXecECxz4+9IbJ+8RTlK7EkX+Y5OxrCMeNZfZKhn7OseO",
+	"PzvNs3WwOAGQohr60SQUSXDuxvZzuNkl7p5/V2PHWQs5sm034bmbbmdyDeJtNANSYUAkr7AFDhBTtZ0M",
+	"qk42UCxVzmicpopIs/P7Fd5TNUv7LOjArivrozHphbNPo7MQBf5vwBtKLWea2wF5oul13qKBCDeA/he6",
+	"hjjooBkXazpuDMfSTrQzb0DjzV8t6KVouzslBiPIUYkQZkr8RC0pDYNittISKylG0wBphYZiO2UlN8YB",
+	"eYLTgg2NPTl7+uRJ0phD1BkxU0fFMM3vm6k8PaUm7ouvauVqLxyE7H5cPzYcdcjC9hnHF/GkQuopmUof",
+	"3HtM7ExHkivgWRebPWFfUz4fZOJWAnfEpk6N204TWZWF4vmUUvZivAlzo7o+GohQVEB0ifh32D/pNBif",
+	"NjPkKxrIBzMezu4EFThrY2d1vc9Uxj1s0VQkFZ1IErJOxdQ5Ya+cYdAEs5MbxFUz1mvIo/Ki7mpKzIH/",
+	"sZZnK2ygWsf8sKwcX/k2iLPGHxG9qbsJH0lgI96++K2rfTtlVMv/VmAS3hW3cAPtJH8BjWDxDUn/2tML",
+	"ta6FPKTEf11c6lCyB+QIbu0qT2LWIfyB9hZXw/zQQsCX1Cv9wqBTL6Pjyw4p40LiaPatN5lnXCopMkrw",
+	"n1IXKSHZOOfbiFoIaa+ZmfgdmthcyVrG9QtXT8XB6sbTSYtwfUd29BUX1XGH+9PCxte4W4I1XrJBPg3V",
+	"4b2bR0gDvnwYMlEsJ5VOhOokw/vrsIAD2YhyDQ3Y7b7Cb995qy5uQXYtJNlvPNn85cM5YgojyN8qmbBs",
+	"qcD4+bTfqJifsM8J5R7MYfP+5LVaiuxSLAmGCw7DabtIyD6o8xAX6eMQse1LbOszwtc/t4Kc3KDnZekH",
+	"Tb7TrFc4VXF7kMCpaJwQHhERt4YfQ9vBbjsDmuk8RUbDUgHMWCjpHO4xRl28vA0FCwVUjqOoBXPvBFNE",
+	"KYRMoPFayOAYTB8QWfJIoIWh/TrQz2Sa22zVEkP7wiAHwvrp3W12fQxQnQUmktAcwxjDy9jUXR8QHHWD",
+	"RuPncsvCpkDujpQJfNRXB5j2q6iTVuWVqJyezHTqqqcEBwruWXgI2CLX3kdpdXeqMXHoSTSUeW9e5Uuw",
+	"mNUtlbDpL/SV0dfw9AnrXFR11a/6zVs783af2/xAmZKmWu8YKzS453C5MNwYWM+LRDDkq/oj5PUKI6eh",
+	"vwD/TdUVGl4ZHwp88FvTEPebH5Zuvv92NqX1Ik/PMKvQeErQmXJ/cjRD343Rm/5H5fTwCPWf4o1pR8rF",
+	"a5SSb1/iwRGno+1FXbujpc4WSxHOir6HND51nsO2VMJv/epZ5MunxUssWQf50DCJ+A0vBt53xx4Ad746",
+	"q/jQK+9sMCkBtz7plOVspwgaTOTjImA7PoW+Y2wo6tUFvR7PFu/nupOgwx6pb1r+Jxf51AiLQb/T3VxD",
+	"zQIf6hvqVs1IKD7UIsLd34Z6BpSB+01LQI4p0pGqB+HVhGA2cVzm89W4Ihm9+ho9Cr8aczL06PFxOrnI",
+	"D5KdqZoiEwcluQJiubKUkvyvwHPQb/akXG/SrJPyUyoj6oOZFQjM57hcEbiTsdHUaNITccr4PqwQZXcD",
+	"maWSr030kAY4JIE8Dhbs/3+kXh++WdVB5z7j+q406/06r3vEfS8zTJTdyBUiPBmfVPy8jhF1T1ywElid",
+	"j6LzKHT007TFAjJK+7ozE8/f8ALeZHmZhis64bKIEvOI+qEGJS4+3ADVIFTwO+JT8OOhM/RQ9xq2Dwxr",
+	"cUOyMmL9SukumVGJAs4bEpLkDtkUfViMMDVnEBVCzKPrDk32/8GktlFeqTuOFViS8TjX1I4h0wXHR42F",
+	"XQ/Ka0dvDoaS9YRqxP2dV9cUlpA7WeMr1XoT8x12M3nGHcCLN81bPs0KMS+flW7EAZ6CTSl0SsX7QYpN",
+	"Y5KnGlfelTVtIqdcaeQFuZJcjC2d8xhxyyX5u7gceBO15rJKMWEINg7gcaHcZbqpoQjs/M3FlGnuW3JJ",
+	"w66FmcOK3wil0+HHGrhJKcR/W219xQHQNKCj5oiXbTU3+OkM8QIVOB5ihrDkLggtlC226kAW4JFQr2cS",
+	"w2PcUrrqOGJf2KiJcOmK0m8OQitXff2ODBN4XbmFxbbzQpgVDKQc8xclvO/z5AlZcJTwtRezBB2u0Iz6",
+	"1KVnwWlEkUt2ytbATRVSjanKLhVuwluYG/TS2gjbASb2fpP0ejROlTB8vBxYP3XKbmmfls/KJM0DSrt3",
+	"CbHOmufQoXB6DyAms6GzqC0wpn4zg+4i7ub0rAxzTGLvyovvxj3QxbUd2LUqlUFe0+ouVAPHNNSIsK0s",
+	"s2oaClO127v4mJj/T9LKJ18sRDaY3go/Mti4F9XRU2kcZIoaWuBIy5ejc8Kg1LjiyysHfr+fqZZEgSc9",
+	"4SIuqpekv5ubSQ5JsDdi+DRzYGNd1HtKlT5QhkUl6YOI+g2OrVKkjy0hTV2BhqM1dbAQ7wD1h4gXLeQh",
+	"TMRrsYVY18nwXI1avmS0diPkaiI8dBZyuiesyvi9zvnudJTAzjusRDMD0g5BM1G66WE4YYY7kPs2EOEQ",
+	"/Gq4aRRrmOOwtHyZXsVom9M5+8N339QCp168kGGmktdS3cogE3ocfitkrm7Nbn6pZbEpBD3N8L3q2J14",
+	"T06ZWeGPxrp71mGiyI34N4K/VxohiaZdRmvxSWqxuwvVEGFwX7WwOubWIsryDl3/2EvH3kteNqeXLgjg",
+	"oMO5RYhE+gjJHAa4PzemeZBylEdW0WGPwyuwXBTGP/TgdQGN2C/HLtxMo99IniNSlB63jpYKpTjAhN9C",
+	"Lmw3SiGufR0suvy62DRMnx5aHCW5KTVD73wK6UU9smgeIvfDmvtM4d70Z4UymP99KDFC++1v/XDmgXEv",
+	"nJpElITXArSGvA6CKpSBmVUJHaOHxy5SGHrGdScimMEyng65wRIub5saNVTOmFPJFu5fb8UTZBrWHLHT",
+	"USWZ4TF3Eful+x6SSYVTba9PuebX2d4XA+EJujA9IsZcv2DeKLo/SdVd3MtCStCzEGvWLSsj25mFKX98",
+	"XmXunI03Ru2CP+CQHRQlSc9s1p9lxxUUJXu6hu2p82+5Q9DUKxgj7QzkDvUocX5nkY/qcDcpvJdHQe/3",
+	"zYdcKlXMBsKbLvq1cLocfy2ya397DU81UYV70N4bOAh7SFE1dfzq7Wobar+UJUjIH50wdi7d4/gQytou",
+	"k90ZXD6wu8bf0Kh55cpTeTf6yTuZfmVMh6m+pzQLYHbLMAMyv/dQDsjugexGDgXZ31KRqXY1+pOxztd+",
+	"cGlHmYmYymGR0kkuXYzaS9roqSs7pfKKcs5R6CJnPraNmUKl3qTdJd0YgkpTKh6MELIw5p7dYOGBJwng",
+	"4/b3pLb2n0PyZrVgGpqw0btmsfaJoZ1oNkOO2+7I9ShtebdQGuIR6VmKy1gfdiUJHArW1nNhNdfbu+Sa",
+	"bpMqdbsbpPLeBxj124tmIs37iz4Ni0LdzkhYzep6bSkPJrYz7cM4mG6afrir5xC95ODGK2pbtuI5y5TW",
+	"kMU90iZIh9VaaZhhZYKkSfY1GpRYIdbCGkblwJZMleg1d3UP0xw0NFYlJSe1CaI4+iQJHO/gTH2fiI9H",
+	"Dolnqoscm5GqtbdMUFj8K+zjMjA12UndpGcuenHgjSIYn43UU8g17uNLjOPS93VDRtKyeSE2xDegU1t+",
+	"wazGx6O+BUFvsRBtfK6BrYUxDpWal25FUVACJLFp5AHUocpDxuuk2ntBD6luBEXbt5NhUQ9UcjOoM4TF",
+	"MuAyTt8ZOcVCoZQaz+DZ1JX3e8ZQfjAVPYigTAg4xAu2Vsb6m6aD1Ey5eWTyMFPSalUUHXuv4xsfj/Yt",
+	"35xnmX2t1DUmtXpE91qpbD3TfBryBHWfAzUj6U6K3PYBPCMeMPtLTrh2OEqQAqMFZEfE9WKf9lrBGjTf",
+	"75eg+0OrzvsT686rLUzT15hzybhVa5Gl99S/1vuawVcxKRGVIoXr4Ta+Y2La7PFhVYdTk4jskxkkTxY5",
+	"PmdeEPiwUudmLK3TwLtw2QK47Y0dHZR94eK1qFk2qOt1ECBMXQofW2lXWDzWxGqpopbOR0e20i6iI08V",
+	"entwP9wQwtGRsnAvpHrvnWoEHzrjw9TlSHZvp/C9vP/+qPEM3gn5j7u5vCU8hh51XDaspalJnXBxQCKk",
+	"S7XsfAFxRemb5mPfQZhghB95wkcIDL+MaOEw6n3EoWgsOD6Qm3E7cLiTjWoa3bR9MoYIeigDS6OwjFeh",
+	"hDfCrjT4BIBOxdftMMeS21U4OrF535KMVkkwpMz8Clq52tzTKMwOCle6u2MMUOWsgBtoPRhxvGwqUjUx",
+	"3sL3NXVnlgOU5FDp2shSLyHis7xjOPFzn0Wx9GOom7SkOMK6lWJ7zCRJo85Gztw2MWO3EmJ0I/KKt+hn",
+	"DlU52mZA3MoJUvXuCLNwjxw7zA8OwtsA4Dz0T6kygRLvx8mhg0VQmnS7BNDel1GVGdr1Mv0wKk65WTtY",
+	"aLS8jrd1LN7IDVPyWzlskOyzfHPdGrlOQsmIsF9uICOtxt93KIgD7zMDTgqfvY+4XQLk7laAXRLW9hVI",
+	"JlVz7SFrZLiqNLnAww9uYGokpL9N3yF2uHm/dP+VZQSMmU5S4MGLhK759O7m+d9lJ+7ciIPwUjxiwCf8",
+	"2GH/Ctztrx3UQFVFziSuJ+r+VGzcn2Jeik/ZvAqA0FrhYgzje+grCH5QJWMXkJtRyKZLNmBHbneC9U0d",
+	"InqhioHaStM/Uln2j4oXYuGCxRz6oRszK44s5B2vLvDbv/vCgXerV9OAWLC2qDCUm7cYCzMCt0UoEdJ4",
+	"kIcilYqt+TXEy0Ax7U5+ZhYFp6nmZLnAI7uznH0q+MmHVIMUQtjcvynh+bYlHUIJDOz935vsF/FQIU9x",
+	"WfAM8lapzbacQWWoZi67gvXu9Ch9uRZYILSKmFaHfFr5HUymB4qu1JvjoTKCLbSja0S7iuBxpjHS8tup",
+	"FbcjscyoqRx7FcY+rughHdcb34d+XH7909A/WYtgaBpj0P9noXtdxHMYX2ryKajcyrmXwNVZq+dqM9Ow",
+	"MPsCTKg1It8gbGoTq5CZBm5cxM3F9/7i2aTaFxIvwu7pX+3TrKHksBCyEZZClpVN3GMo477cRgSLjf5E",
+	"1gEX2pCWgMrkDS++vwGtRT60cLg71CIuDICYBEeH75swYdRnah+AMM0djjKyNGb0uBke4K6YqotMM5bL",
+	"nOs8bi4ky0BbLtB3vTV39yjVzoF9PiUeaTPtPGGRd4lY2yFSbL1T+J7+nhpBfkTHzwiHzdUKPPe3nTX+",
+	"VY4a8M/0cfiXcNis+QZ9fJQ3ZGBD+BoL5OGjZkxJMoM7/WzcvMM4RvwKu4eh8lJeEFlFo44ZYve+/56W",
+	"kq6RP0hhd+58Z6PsJnJxzyvdxgxElcvmjbdjlv5+LAdi4Mt2/p2gbIZ8ZYH3IFrEoVdDbbv4wCpSGIRP",
+	"3BQbwceX7W1HWiROGG8ZmJHFwOx4xQ2mebHMMx+e1Tel9UwNjihTnx/pQEubs8+Hc2kAPSQ0hBd47WHr",
+	"kBmEc0it490ZkWalKmfZmJhPV4EudwgETNs4DvBH5AQYmHcdHmPqmowxN7aLMx5a7nmwOOQ+b1eZ7br0",
+	"D5mJBiR62wWhFiTLaAs745jSsTFlGq7XwSfdNoPVQoJxpiGrNJmJb/l2f/ncgconl389/+zps5+fffY5",
+	"wwYsF0swTfWcTvnZJi5QyK7d59NGAvamZ9OLEPKN0efa/xhyZ9SL4veak7amSY3fK757iH05cQAktmOi",
+	"7Omd1orgNC+4/7mWKzXJo69YigS//ZphmEa6elmtVyUcKKnVilwoeAMpQRthLEjb8YAK20REmxWZB6mG",
+	"xY3LH6nCc7WGC4QdCLlKTWQooJbkGX6qn2DBpiy8rLr1j7uH5+Xvac5CR0ojRcWgFUuVXrUXC5bCiJH9",
+	"vILaMu4Nn2QRj2Jka2HromVTjOgjz9OshzEbdBNWC7Zb2jeOwiCoE5IeFzGhXoRNeQfWHPJPDGcqu4sk",
+	"aUz7/zTyI5F67WhSo57ubyErkveDHamlzntxD3XasVGo9dNwJdiDEBhIqtRKhxPlA4kKamjnJSB/QnAg",
+	"d9WPbxvH8t5nIYRJ6LAHvThLUtOu+wD0dy5U8W1NlGgq74c4oTX9fYmX6tev4SCJlsgbTawF48RSIrdF",
+	"lFXLvKyTVQ3cSno5rbRSlimJtpFELixnx6E9FTOOkBb0DS8+vdT4Smhjz4kekL8dfhoVJ0SKiexIae6W",
+	"mfs1HzV2wX+DoeUbyr/1N8A1Sp5zHpR3wvdOMzLu8MKFVy9qbzRIdkswaaXZ08/Z3BeNKzVkwnSd+7dB",
+	"Oanz/4BG7xgNARu7J+HQvnn+qOw92HgRInHYd5F7q/bZewybLfo7C5WBnZvk8hT39dgiQb+UjMKUyOOq",
+	"jN23wNjdEj1GKZsPTPQYz4xSao+eHs2DDp3KQH+eo0/rFm0TB3Uzt7FZSkfXKcNSkPMxyUXTNcWwO2U3",
+	"PUpxsYNKi/0GeU0djTwMP26KY34cqnThqjkMVOPprAcW7tnrVYtrK+GDW5BghKHqQT/7Goif9iwNGLik",
+	"Rv2t6nC9T1ZQR5jEXFuDR0NFVZNGFEzy3RJVblyGhEoLu71E+gcDmvj5OpUw8us6haNPAVr70vzZZ9U1",
+	"yBDv0SR8rEw4Xb9WvKDzyLn4JJ5CqjhhX7qaPn6j/PnB/D/g+Z9e5E+eP/2P+Z+efPYkgxefffHkCf/i",
+	"BX/6xfOn8OxPn714Ak8Xn38xf5Y/e/Fs/uLZi88/+yJ7/uLp/MXnX/zHg8l0IhBlh2go5nU2+V+z82Kp",
+	"ZudvLmZXiGxDE14KzJL58SPdlV06NSJqRjsRn7oXk7Pw0/8IO+wkU+sGfPh14uuMTlbWlubs9PT29vYk",
+	"7nK6pKf/M6uqbHUaxvk47VD8/M1FHaPv4nBoRRvr8cmkYYVz+vb2y8srzF930jDM5Gzy5OTJyVOEr0qQ",
+	"vBSTs8lz+ol2z4rW/ZQy6p8aXyzrtH6r9XHa+4YGwoX/5HnU/7UCXtiV/2MNVossfNLA863/v7nlyyXo",
+	"k7+7jHn4082z06CNnH7wmRM+7vp2GkeGnH5oJZjI9/QMkQ/7mpx+8Gka9gBslf/3MWdRh5GI7mp2Oleb",
+	"A5pCPLvhqdA1xpx+IEV88PdTb01Jf6QLkdtppyEf50BL9yQ//bFFwg92gxPZDQ7bRPAydJdV5ekH+g9t",
+	"mmhGjp1PNRSK583PLtX/qd3IU/Irn35o0cd/7tGn/XvTPW5xs1Y5BJzVYmHA7vl8+sH9Gw0EmxK0QCWV",
+	"F82vLvf1KVVV3vZ/3krvBS3AJnMpGnCXaNeBYYfmRVwtXi7y0PhyK7OgTYdQSRIaz548ccO/oP9MfNXR",
+	"TsKXU7/NJ+6Y32vLaSXXJ5HcMePV+Lp3f2BPJoTD00+Hw4V04ZEoo91Z8nE6+exTUuFCWtCSF4xauuGf",
+	"f8JFAH0jMmBXsC6V5loUW/aDrCM83WlGrzBTHOjSk3nMP04nplqvud6Sgr9WN2DYWkgKUGiYk2lAlcpF",
+	"gdTJpBwP00nIUbz8NCmreSGyydSVUnhPSpxN6TPBttQfKdjVGuDtXfH13j0xfhXaavKOTDaj8NyT48CB",
+	"7+v4/fUNa9/1zLqhHqQWaPKHIPhDEBxRENhKy8EtGp1flHUbSv/yNcOygrvkQf+0jA74SalS+SYudwgL",
+	"X+ZwSFZctmVFE4E4OftpXIlr7wxxdu4cDG7mk3DHQQW+uYLoWiKFPU+u2Git/QQmZ6nqqe//Kc73l1yG",
+	"/dxaceft5LoQoGsu4LJfefIPKfD/jRRwJXS5W9cps4ARkdHet4r2vnMMOZ4Q0jnsRsqBVu2LRplu/Xwa",
+	"zBmpq2m75YfWn+3rFiWG7vx5Oucy+VtyKPrq8/F2f86FGfhSingIs6psrm6juZI7wvnS+ncd/FiZ7t+n",
+	"t1xYNDD6wg98YUH3O1vgxakv+Nn5tamx1ftChcOiH+O3rslfT7m/9KS+kcQd6ti7rKe++vvoQKMQoh0+",
+	"NybB2MRG0r42rv30HmWtAX0TDoLGYnR2ekpvdlbK2NPJx+mHjjUp/vi+Zu8P4QgotbhBbPDbZqa0WAqJ",
+	"OaOcyaWpWjx5dvJk8vH/DQCXzNUufBkBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
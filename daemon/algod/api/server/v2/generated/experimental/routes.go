@@ -130,229 +130,244 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9f5PbtpLgV0Fpt8qxT5yxHSf74qtXexM7yZuLnbg8TvZ2bd8LRLYkvKEAPgCckeLz",
-	"d79CN0CCJChRMxP7var9yx4RPxqNRqPRPz/McrWplARpzezph1nFNd+ABY1/8TxXtbSZKNxfBZhci8oK",
-	"JWdPwzdmrBZyNZvPhPu14nY9m88k30DbxvWfzzT8vRYaitlTq2uYz0y+hg13A9td5Vo3I22zlcr8EGc0",
-	"xPnz2cc9H3hRaDBmCOXPstwxIfOyLoBZzaXhuftk2LWwa2bXwjDfmQnJlASmlsyuO43ZUkBZmJOwyL/X",
-	"oHfRKv3k40v62IKYaVXCEM5narMQEgJU0ADVbAizihWwxEZrbpmbwcEaGlrFDHCdr9lS6QOgEhAxvCDr",
-	"zezp25kBWYDG3cpBXOF/lxrgd8gs1yuws/fz1OKWFnRmxSaxtHOPfQ2mLq1h2BbXuBJXIJnrdcJe1say",
-	"BTAu2evvn7Evv/zyG7eQDbcWCk9ko6tqZ4/XRN1nT2cFtxA+D2mNlyuluSyypv3r75/h/Bd+gVNbcWMg",
-	"fVjO3Bd2/nxsAaFjgoSEtLDCfehQv+uROBTtzwtYKg0T94Qa3+mmxPN/1l3Juc3XlRLSJvaF4VdGn5M8",
-	"LOq+j4c1AHTaVw5T2g369mH2zfsPj+aPHn78l7dn2X/5P7/68uPE5T9rxj2AgWTDvNYaZL7LVho4npY1",
-	"l0N8vPb0YNaqLgu25le4+XyDrN73Za4vsc4rXtaOTkSu1Vm5UoZxT0YFLHldWhYmZrUsHZtyo3lqZ8Kw",
-	"SqsrUUAxd9z3ei3yNcu5oSGwHbsWZelosDZQjNFaenV7DtPHGCUOrhvhAxf0j4uMdl0HMAFb5AZZXioD",
-	"mVUHrqdw43BZsPhCae8qc9xlxd6sgeHk7gNdtog76Wi6LHfM4r4WjBvGWbia5kws2U7V7Bo3pxSX2N+v",
-	"xmFtwxzScHM696g7vGPoGyAjgbyFUiVwicgL526IMrkUq1qDYddrsGt/52kwlZIGmFr8DXLrtv1/X/z8",
-	"E1OavQRj+Ape8fySgcxVAcUJO18yqWxEGp6WEIeu59g6PFypS/5vRjma2JhVxfPL9I1eio1IrOol34pN",
-	"vWGy3ixAuy0NV4hVTIOttRwDiEY8QIobvh1O+kbXMsf9b6ftyHKO2oSpSr5DhG349s8P5x4cw3hZsgpk",
-	"IeSK2a0clePc3IfBy7SqZTFBzLFuT6OL1VSQi6WAgjWj7IHET3MIHiGPg6cVviJwwiCj4DSzHABHwjZB",
-	"M+50uy+s4iuISOaE/eKZG3616hJkQ+hsscNPlYYroWrTdBqBEafeL4FLZSGrNCxFgsYuPDocg6E2ngNv",
-	"vAyUK2m5kFA45oxAKwvErEZhiibc/94Z3uILbuDrJ2N3fPt14u4vVX/X9+74pN3GRhkdycTV6b76A5uW",
-	"rDr9J7wP47mNWGX082AjxeqNu22WosSb6G9u/wIaaoNMoIOIcDcZsZLc1hqevpMP3F8sYxeWy4Lrwv2y",
-	"oZ9e1qUVF2LlfirppxdqJfILsRpBZgNr8sGF3Tb0jxsvzY7tNvmueKHUZV3FC8o7D9fFjp0/H9tkGvNY",
-	"wjxrXrvxw+PNNjxGju1ht81GjgA5iruKu4aXsNPgoOX5Ev/ZLpGe+FL/7v6pqtL1ttUyhVpHx/5KRvWB",
-	"VyucVVUpcu6Q+Np/dl8dEwB6SPC2xSleqE8/RCBWWlWgraBBeVVlpcp5mRnLLY70rxqWs6ezfzlt9S+n",
-	"1N2cRpO/cL0usJMTWUkMynhVHTHGKyf6mD3MwjFo/IRsgtgeCk1C0iY6UhKOBZdwxaU9aZ8sHX7QHOC3",
-	"fqYW3yTtEL57T7BRhDNquABDEjA1vGdYhHqGaGWIVhRIV6VaND98cVZVLQbx+1lVET5QegSBghlshbHm",
-	"Pi6ftycpnuf8+Qn7IR4bRXEly527HEjUcHfD0t9a/hZrdEt+De2I9wzD7VT6xG1NQIMT8++C4vBZsVal",
-	"k3oO0opr/BffNiYz9/ukzv8cJBbjdpy48KHlMUdvHPwletx80aOcIeF4dc8JO+v3vRnZuFH2EIw5b7F4",
-	"18SDvwgLG3OQEiKIImry28O15ruZFxIzFPaGZPKLAaKQiq+ERGjn7vkk2YZf0n4oxLsjBDDNu4hoiSTI",
-	"RoXqZU6P+pOBnuWfgFpTGxskUSeplsJYfFdjY7aGEgVnLgNBx6RyI8qYsOF7FtHAfK15RbTsv5DYJSS+",
-	"56kRwXrLi3finZiEOWL30UYjVDdmywdZZxIS5Bo9GL4tVX75F27Wd3DCF2GsIe3jNGwNvADN1tysEwen",
-	"R9vtaFPo2zVEmmWLaKqTZokv1MrcwRJLdQzrqqpnvCzd1EOW1VstDjzpIJclc40ZbAQqzP3DkTTs9P5i",
-	"3/F87cQClvOynLeqIlVlJVxB6R7tQkrQc2bX3LaHH0cO7xo8RwYcs7PAotV4NROq2HSji9DANhxvoI17",
-	"zVRlt0/DQQ3fQE8KwhtR1ahFiB4a58/D6uAKJPKkZmgEv1kjamviwU/c3P4TziwVLY40gDaY7xr8Nfyi",
-	"A7Rr3d6nsp1C6YJ01tb9JjTLlaYh6Ib3k7v/ANdtZ6LOLyoNmR9C8yvQhpdudb1F3W/I965O54GTWXDL",
-	"o5PpqTD9ACPOgf1QvAOd0NL8jP/hJXOfnRTjKKmlHoHCiIrMqQVdzA5VNJNrgPpWxTakymQVzy+PgvJZ",
-	"O3mazUw6ed+R9tRvoV9Es0NvtqIwd7VNONjYXnVPCOmuAjsayCJ7mU401xQEvFEVI/bRA4E4BY5GCFHb",
-	"O7/WvlXbFEzfqu3gSlNbuJOdcONMZvYI33/LpZ6wEHXzI+RT3DS8wGV8NziwW9Pj2ULpmwlMvTtUstag",
-	"yrgbNZIX5z06wKZ1lXn2kzDKUIPeQK0Py345pz98ClsdLFxY/gdgwbhR7wIL3YHuGgtqU4kS7uB0r5Ny",
-	"6oIb+PIxu/jL2VePHv/18VdfO5KstFppvmGLnQXDvvCaR2bsroT7yYOGAlR69K+fBDNcd9zUOEbVOocN",
-	"r4ZDkXmPHvjUjLl2Q6x10YyrbgCcxPTB3d6EdkaWawfac1jUqwuw1j3mX2m1vHOGP5ghBR02elVpJzuZ",
-	"rinUC4SnhWtyClur+WmFLUEW5Erh1iGMe+ZuFndCVGMbX7SzFMxjtICDh+LYbWqn2cVbpXe6vgsNDmit",
-	"dFLKqLSyKldl5kRZoRJ33SvfgvkWYbuq/u8ELbvmhrm50UBby2LkSrNbOf2KpqHfbGWLm73iEa03sTo/",
-	"75R96SK/fWhVoDO7lQyps3PTLrXaMM4K7Iji1A9gScQUG7iwfFP9vFzejUJX4UAJkUBswLiZGLVwAp6B",
-	"XEnyVzxw+/tRp6Cnj5hgSLPjAHiMXOxkjtbAuzi244LRRkh0TTA7mUdSkoOxhGLVIcvba+nG0EFT3TMJ",
-	"cBw6XuBnNEc8h9Ly75V+00roP2hVV3fOnvtzTl0O94vxBo/C9Q2abiFXZddHduVgP0mt8bMs6FmjJ6E1",
-	"IPRIkS/Eam2jJ/Errf6AOzE5SwpQ/ED6sNL1GWrFflKFYya2NncgSraDtRzO0W3M1/hC1ZZxJlUBuPm1",
-	"SQuZI16V6M6FXmg2lltRBSMMW4CjrpzXbrV1xdDHanBftB0zntMJzRA1ZsTDpHENolY0HXnslRp4sWML",
-	"AMnUwrtxeAcTXCRHBzEbxDQv4ib4RQeuSqscjIEi89r2g6CFdnR12D14QsAR4GYWZhRbcn1rYC+vDsJ5",
-	"CbsM3RkN++LHX839zwCvVZaXBxCLbVLo7asMh1BPm34fwfUnj8mOlJFEtU68dQyiBAtjKDwKJ6P714do",
-	"sIu3R8sVaPSa+UMpPkxyOwJqQP2D6f220NbViJO+f6Y7Cc9tmORSBcEqNVjJjc0OsWXXqKNLcCuIOGGK",
-	"E+PAI4LXC24seXoJWaDalq4TnIeEMDfFOMCjzxA38q/hBTIcO3f3oDS1aZ4jpq4qpS0UqTWgcm90rp9g",
-	"28ylltHYzZvHKlYbODTyGJai8T2y/AsY/+C2UeV55eBwceg24O75XRKVHSBaROwD5CK0irAbOyqPACJM",
-	"i2giHGF6lNN4R89nxqqqctzCZrVs+o2h6YJan9lf2rZD4iI7Dt3bhQKDNiLf3kN+TZglF/U1N8zDEbS1",
-	"qM4hl7QhzO4wZkbIHLJ9lI9PPNcqPgIHD2ldrTQvICug5LuEnpk+M/q8bwDc8fa5qyxk5Guc3vSWkoNr",
-	"556hFY5nUsIjwy8sd0fQPQVaAvG9D4xcAI6dYk6eju41Q+FcyS0K4+GyaasTI+JteKWs23FPDwiy5+hT",
-	"AB7BQzP0zVGBnbP27dmf4j/B+AkaOeL4SXZgxpbQjn/UAkZ0wT6MKzovPfbe48BJtjnKxg7wkbEjO6KY",
-	"fsW1Fbmo8K3zI+zu/OnXnyDpG8AKsFyUULDoAz0Dq7g/Iy/Z/pg3ewpO0r0NwR8o3xLLCZ5IXeAvYYdv",
-	"7lcUfhGpOu7iLZsY1d1PXDIENDh1OxE8bgJbntty5wQ1u4YduwYNzNQL8tIY2lOsqrJ4gKR9Zs+M3gCd",
-	"NP/utYhf4FDR8lJmS3oT7IfvTe9h0EGHfwtUSpUTNGQDZCQhmOQewyrldl34CK8Q4xMoqQOkZ9rofdBc",
-	"//dMB824AvafqmY5l/jkqi00Mo3SKCigAOlmcCJYM6f3v2wxBCVsgF6S+OXBg/7CHzzwey4MW8J1CIt0",
-	"DfvoePAA9TivlLGdw3UH+lB33M4T1wcartzF518hfZ5y2KnLjzxlJ1/1Bm+sXe5MGeMJ1y3/1gygdzK3",
-	"U9Ye08g0hzYcd5Itp+sCNVg37vuF2NQlt3dhtYIrXmbqCrQWBRzk5H5ioeR3V7z8uemGIZ+QOxrNIcsx",
-	"UHHiWPDG9aHYRjeOkMIdYIprmAoQnFOvC+p04InZOj2IzQYKwS2UO1ZpyIFC+pzkaJqlnjBy9s/XXK7w",
-	"waBVvfJ+EjQOMvzakGpG13IwRFKosluZoZI7dQF4T7wQ1enEKeDuSdfXkNMD5po38/lA3ik3c7QHfYtB",
-	"0kg2n42+eB1Sr9oXLyGnG5o64TLoyHsRftqJJ5pSEHVO9hniK94Wd5jc5v4xKvt26BSUw4kjp+b245hf",
-	"s3tul7s7EHpoIKah0mDwiorVVIa+qmUchh68IXfGwmaoyaeufx05fq9H34tKlkJCtlESdsnMK0LCS/yY",
-	"PE54TY50RoFlrG//DdKBvwdWd54p1Hhb/OJu909o32Jlvlf6rkyiNOBk8X6CBfKgud1PeVM7KS/LhGnR",
-	"B6n2GYCZN55zQjNujMoFymznhZl7x2eyRvqI1i76XzWhN3dw9vrj9mxocf4D1BFDWTHO8lKgBllJY3Wd",
-	"23eSo44qWmrCiSs8xse1ls9Ck7SaNKHF9EO9kxwd+BrNVdJhYwkJNc33AEF5aerVCoztvXWWAO+kbyUk",
-	"q6WwONfGHZeMzksFGj2pTqjlhu/Y0tGEVex30IotatuV/jEG21hRlt6g56ZhavlOcstK4Mayl0K+2eJw",
-	"wegfjqwEe630ZYOF9O2+AglGmCztbPYDfcXQBb/8tQ9jQI9++hz8atukEDO3zE4emP/7xb8/fXuW/RfP",
-	"fn+YffM/Tt9/ePLx/oPBj48//vnP/6/705cf/3z/3/81tVMB9lSEsIf8/Ll/GZ8/x+dPFI3Qh/2T6f83",
-	"QmZJIou9OXq0xb7AbBiegO53lWN2De+k3UpHSFe8FIXjLTchh/4NMziLdDp6VNPZiJ4yLKz1yEfFLbgM",
-	"SzCZHmu8sRQ19M9Mx+KjUdKH1+N5WdaStjJI3xRqGvzL1HLe5FugVGxPGQbjr3lw8vR/Pv7q69m8DaJv",
-	"vs/mM//1fYKSRbFNpUooYJt6K8ZxIPcMq/jOgE1zD4Q96UpHvh3xsBvYLECbtag+PacwVizSHC5EZXmd",
-	"01aeS4phcOcHTZw7bzlRy08Pt9UABVR2nUrR1BHUsFW7mwA9t5NKqyuQcyZO4KSv8ynce9E79ZXAl8Ex",
-	"VSs15TXUnAMitEAVEdbjhUxSrKTopxfB4S9/c+fPIT9wCq7+nCmP3ns/fPeGnXqGae5R1g4aOsqzkHhK",
-	"+/jQjkOS42Zx2Nw7+U4+hyVqH5R8+k4W3PLTBTciN6e1Af0tL7nM4WSl2NMQcvqcW/5ODiSt0dyRUVw4",
-	"q+pFKXJ2GT9IWvKkfGDDEd69e8vLlXr37v3AN2P4fPBTJfkLTZA5QVjVNvPZjDIN11ynbF+myWaDI1O6",
-	"sn2zkpCtalKQhmxJfvw0z+NVZfpZLYbLr6rSLT8iQ+NzNrgtY8aqJuTOCSg+atnt70/KXwyaXwe9Sm3A",
-	"sN82vHorpH3Psnf1w4dfYvBim+bhN3/lO5rcVTBZuzKadaOvVMGF07MSfdWziq9SJrZ3795a4BXuPsrL",
-	"G9RxlCXDbp3AyhBggEO1C2iiuEc3gOA4Ov4ZF3dBvULmyvQS8BNuYTfG/Fb7FaUIuPF2HUgzwGu7ztzZ",
-	"Tq7KOBIPO9MktFs5ISt4Yxixwteqz/23AJavIb/0SdlgU9ndvNM9OPx4QTOwDmEoXR8FUWLCKDRQLIDV",
-	"VcG9KM7lrp+5x1BEBQ76Gi5h90a1+aaOSdXTzRxjxg4qUmokXTpijY+tH6O/+d6rLMTS+gQsGJ8ayOJp",
-	"Qxehz/hBJpH3Dg5xiig6mU3GEMF1AhFE/CMouMFC3Xi3Iv3U8oTMQVpxBRmUYiUWqUzD/zG0hwVYHVX6",
-	"5IreC7kZ0DCxZO4pv6CL1T/vNZcrcNezu1KV4SUljk06beB7aA1c2wVwu1fPL+PYxgAdPimvMbgcNXxz",
-	"twTYuv0WFjV2Eq7dqwIVRdTGey+fjPufEeBQ3BCe0L19KZyMvnU96hJJFcOt3GC3edZ617yYzhAu+r4B",
-	"zMqqrt2+OCiUTyhKeWui+6U2fAUjb5fYejcx5UfH4oeDHJJIkjKIWvZFjYEkkASZGmduzckzDO6LO8T4",
-	"zOw5ZIaZyEDsbUaYJ9wjbFGiANt4rtLec92xolLi4zHQ0qwFtGxFwQBGFyPxcVxzE44jpoQNXHaSdPYH",
-	"RhDvy753HvkSRnlfm9x64Tbsc9DBu9/n4AuJ90K2vfjRPyFznnt7YfhCajuURNG0gBJWtHBqHAilzQnV",
-	"bpCD4+flEnlLlnJLjBTUkQDg5wD3cnnAGNlG2OQRUmQcgY2ODzgw+0nFZ1OujgFS+pxWPIyNV0T0N6QD",
-	"+8hR3wmjqnKXqxixN+aBA/hsG61k0fOoxmGYkHPm2NwVLx2b82/xdpBBEjh8UPRSvnnXm/tjD409pim6",
-	"8o9aEwkJN1lNLM0GoNOi9h6IF2qbUYRy8i2y2C4cvSdjFzBeOnUwKd3ePcMWaovuXHi1kK/8AVjG4Qhg",
-	"RLqXrTBIr9hvTM4iYPZNu1/OTVGhQZLxitaGXMYEvSlTj8iWY+TyRZRB70YA9NRQbTkKr5Y4qD7oiifD",
-	"y7y91eZtZtgQFpY6/mNHKLlLI/gb6se6Oe/+0uY2HM+fFk7UJ0n2N9Qs3SYJI3WuKLHiMTkY++TQAWIP",
-	"Vl/15cAkWru+Xl28RlhLsRLHfIdGySHaDJSAj+CsI5pmlylPAfeWB7zHL0K3SFmHu8fl7n7kQKhhJYyF",
-	"1mgU/II+hzqeY4ZopZbjq7OVXrr1vVaqufzJbI4dO8v85CtAD/yl0MZmaHFLLsE1+t6gEul71zQtgXZd",
-	"FKmegijSHBenvYRdVoiyTtOrn/fH527an5qLxtQLvMWEJAetBdb/SDou75mafNv3LvgFLfgFv7P1TjsN",
-	"rqmbWDty6c7xT3IuegxsHztIEGCKOIa7NorSPQwyCjgfcsdIGo18Wk72WRsGh6kIYx/0Ugth72M3P42U",
-	"XEuU6TAdIahWKyhCBrdgD5NRnrxSyVVUqKqq9qUFPGGUnQ+T6+3Jy+fd8GHMCT8S9zMhC9imoY9fBQh5",
-	"G1mHOQVxkhVISleSVgslURO7+GOLSFf3iW2h/QCApBP0m54xu/VOpl1qthM3oARe+DeJgbC+/cdyuCEe",
-	"dfMx9+lOctf9RwgHRJoSNqrdMkxDMMKAeVWJYtszPNGoo0owfpR2eUTaQtbiBzuAga4TdJLgOtnCvau1",
-	"V7Cf4pv31L3KyPfaOxY7+ua5D8Avao0WjI5n8zA1ffNWm7j2H3+9sErzFXgrVEYg3WoIXM4xaIgSvxtm",
-	"BbmTFGK5hNj6Ym5iOegAN9CxFxNIN0FkaRNNLaT9+kmKjA5QTwvjYZSlKSZBC2M2+TdDK1eQ6SNVUnMl",
-	"RFtzA1NVMlz/R9hlv/Kydo8MoU3rnuvNTt3L94hdv9r8CDsc+aDXqwPswK6g5uk1IA2mNP3NJxPl6L5n",
-	"OlUM8HnZ2cIjduosvUt3tDW+7sQ48be3TKcuQ3cptzkYrZOEg2XKblykfRPc6YEu4vukfGgTRHFYBonk",
-	"/XgqYUKVzuFV1OSiOES7b4CXgXhxObOP89ntPAFSt5kf8QCuXzUXaBLP6GlKluGOY8+RKOdVpdUVLzPv",
-	"LzF2+Wt15S9/bB7cKz7xSyZN2W++O3vxyoP/cT7LS+A6azQBo6vCdtU/zaqoUsX+q4QSmntFJ2mKos1v",
-	"kk7HPhbXmLy8p2wa1H1p/Weio+h9LpZph/eDvM+7+tAS97j8QNV4/LQ2T3L46Tr58CsuymBsDNCOOKfj",
-	"4qYVD0pyhXiAWzsLRT5f2Z2ym8HpTp+OlroO8CSc62dMTZl+cUifuBJZkXf+4XcuPX2vdIf5+8jEpPPQ",
-	"HydWOSGb8Djiqx1KdPaFqRNGgtdvq9/caXzwID5qDx7M2W+l/xABiL8v/O/4vnjwIGk9TKqxHJNALZXk",
-	"G7jfRFmMbsSnfYBLuJ52QZ9dbRrJUo2TYUOh5AUU0H3tsXethcdn4X8poAT308mUR3q86YTuGJgpJ+hi",
-	"LBKxcTLdUFVQw5Ts+1RjEKwjLWT2vuoEGWOHR0jWGzRgZqYUedq1Qy6MY6+SnCldY4aNR7S1bsRajPjm",
-	"ylpEY7lmU3Km9oCM5kgi0yTTtra4Wyh/vGsp/l4DE4V71SwFaLzXelddeBzgqAOBNK0X8wOTnaod/jZ6",
-	"kD32pqAL2qcE2Wu/e97YlMJCU3WNjvQAj2ccMO493tuePjw1UzTbuuuCOe0dM6U6fGB03lg3Mkey2rsw",
-	"2VKr3yFtCEH7USIRRjB8ClTz/g4y5bnXZymNUbktWt/Ofmi7p7+Nxzb+1m/hsOimsNpNLtP0qT5uI2/y",
-	"6DXpdM0eyWOPsNjDoBsaMMJa8HhFzrBY6iJ4H3FJ54myQHQizNKnMo7lPKXx21PpYR7Ev5b8esFTZXDc",
-	"W8jBFG1vx0/KKhY6hw0wTY4Dmp1FHtxNW0GZ5CrQrQ1imJX2hu8amnbyi6Z9wCBFxU+XObkplEYlhqnl",
-	"NZdUKN31I37lexsgE7zrda005oE0aZeuAnKxSapj3717W+RD951CrATVAK8NREWm/UCMkk0iFflC3U3m",
-	"Do+a8yV7OI8q3fvdKMSVMGJRArZ4RC0W3OB12ZjDmy5ueSDt2mDzxxOar2tZaCjs2hBijWLN2xOFvMYx",
-	"cQH2GkCyh9ju0TfsC3TJNOIK7jsseiFo9vTRN+hQQ388TN2yvob7PpZdIM8OztppOkafVBrDMUk/atr7",
-	"eqkBfofx22HPaaKuU84StvQXyuGztOGSryAdn7E5ABP1xd1Ec34PL5KsAWCsVjsmbHp+sNzxp5GYb8f+",
-	"CAyWq81G2I133DNq4+iprSBNk4bhsNZaKIkV4Aof0f+1Cu5/PV3XJ37G8M1IzBZ6Kf+ENtoYrXPGKfln",
-	"KVrP9FCSlJ2H3MJYI6wpDUa4cXO5paMsiY7qS1ZpIS3qP2q7zP7knsWa5479nYyBmy2+fpKotdWt1SKP",
-	"A/yT412DAX2VRr0eIfsgs/i+7AupZLZxHKW43+ZYiE7lqKNu2iVzzC90/9BTJV83SjZKbnWH3HjEqW9F",
-	"eHLPgLckxWY9R9Hj0Sv75JRZ6zR58Nrt0C+vX3gpY6N0qmBAe9y9xKHBagFXGDGX3iQ35i33QpeTduE2",
-	"0H9e/6cgckZiWTjLyYdAZNHcFyzvpPhfX7aZz9GwSpGIPR2g0gltp9fbfWJvw+O0bn37LTmM4bcRzE1G",
-	"G44yxMqI9z251zd9Poe/UB8k2vOOwvHRb0y7NzjK8Q8eINAPHsy9GPzb4+5nYu8PHqQTECdVbu7XFgu3",
-	"eRFj39QefqsSCrBv1Za4cHAo8vkREgrI5CXlbsaFH2POuqXhPr34cDeBXWk30zT5h/Xj5z4CPjN3xB3b",
-	"d6qxwukkpROucVDXMmmEPugFEW2AG3UBpXJPp7jUTaylTpJd7wYLFPh58e0W7wFOYrsWZfFrm7Gsxx41",
-	"l/k66fu6cB3/SpJn52IhBpCsnrHmUkKZHI5ebH8NL7vE2/Nvauo8GyEntu3XVqXl9hbXAt4FMwAVJnTo",
-	"FbZ0E8RY7SaDapINlCtVMJynLdXQnvxhDeZUYchE1C4Ou6mt98bECGefRmcpSnQuTFtDsWWmuR3hJ1io",
-	"PFTNceNg3XBDj2caHTTjYoPXjeGbqgQ8mVeg3ctfLTFStNsdE4PhyFEdBmYq9wlbYhoGxWytJVPLZbQM",
-	"kFZoKHdzVnFjaJCHblmwxblnTx89fJhU5iB2JqyUsBiW+XO7lEen2IS++NJBlOD+KGAPw/qxpahjNnZI",
-	"OL5SIpY6TvFUqoGM+g60/bkriaokNhU9T9gPmM/HEXEngTsq4UJq3G6ayLoqFS/mmLL3zXdnLxjNSn2o",
-	"9jtVaVyhDqpL/kmjwfS0mSFf0Ug+mOnj7E9Q4VZtbNYUVUxl3HMt2rKPoudJgtqpGDsn7DkpBpvK+zQJ",
-	"w8TPegNFVMORnqZIHO4/1vJ8jRq3zjU/ziunlxcN7Ky1R0QxdU1NH2TYDm5fYZQKjM4ZVtu+FgYwzhyu",
-	"oJvkr8l46TW+Ielfd3m6lpIo5Zgi3E0Fn2PRHoAjMS2YypOQ9RB/pL6FqgwfW231AnulIwx6pVt7tuyQ",
-	"Mi4kjmYvvco851JJkWOC/5S4iAnJphnfJtRCSFvNzMyf0MThShaMbSJcPRZHS8gGRugRNzRkR1/dphJ1",
-	"0J8Wtr6Q2Aqs8ZwNinmo3+zNPEIa8DWaHBHFfFLphKtO0r2/cQs4koww19CI3u579+0nr9XFVA+XQqL+",
-	"xqPNPz7IEFMagfZWyYRlKwXGr6cbo2Leuj4nmHuwgO37kxdqJfILscIxyDnMLZs8IYdDnQW/SO+H6No+",
-	"c219Rvjm546TE016VlV+0vHq3klB0m7lKIJT3jjBPSJCbjN+PNoectvr0Iz3qSM0uEJfLKjwHh4QRlMh",
-	"ujvKd+4hRRSFLRjFCSbTwgqZAOOFkMEwmL4g8uSVgBuD53Wkn8k1t/R2mMTT3gAvR9z6Me6WLMu3Haqf",
-	"D9+hBNcY5hjfxra49QjjaBq0Ej+XOxYOhaPuSJh4xsvGIThRqhqlKi9EFRgy0ytenWIcjnGHMv/dC+Bg",
-	"UFrTHWtMHHsTjWXeW9TFCmzGiyKVsOlb/Mrwawh9gi3kdVNaqYl562beHlKbnyhX0tSbPXOFBrecLqoG",
-	"n6CGuCJ92GHMH7PY4b+pukLjO+NdgY+ONQ1+v8Vx6eaHsbMpqdfRdGbEKpuOCbxTbo+OduqbEXrb/04p",
-	"PQSh/kPEmPa4XLxHKf72nbs44nS0A69rulqabLHo4azwe0jj0+Q57HIlvMoG1bPQlo+bl9iyHvChYRLw",
-	"K16OxHfHFgC6X0krPhblnY8mJeDWJ52ynO1lQaOJfMgDtmdTGBrGxrxeyen17nTxfq17ETpukfqxY38i",
-	"z6eWWYzanW5mGmo3+Fjb0KDk/FDwIU1F24Q1td0m1XrrMMgpRTpS9SC8mNApen+gZP8Aw8+n3AzDEvzz",
-	"2XlxFO9M1RSZ0SjJHUgW1B9Pud6mWUfhp1JGtCUWU5X2J3pTv8Fi+VHK+OFYwcvuCnKLdTVb7yENcEwC",
-	"eTdZ0P//d+r18ZdV43TuM67vS7M+LKZ5gN0PMsNE2Y2oEOHJ9KTiZ42PKIW4XHPT5qPoBYVODk1bLiHH",
-	"tK97M/H8h3uAt1le5uGJjrAso8Q8ognUwMTFxyugWoD2JcrZC09UQOTW4IwF6l7C7p5hHWpIVkZsopRu",
-	"khkVMUDWkJAkd0yn6N1ihGkoA7EQfB59rtk2+/9oUtsor9QN5wok6S6ONtfUninTVZ0nzeW6HpXXDmMO",
-	"xpL1DIvCjouiz7EGr/EeQLzJrBo/2Nj5sDLItc/MinmTGjV6yNEKJvwWkqTRLKW4hLjsOxotrrkuQos7",
-	"yXpDd5NIA71sZhath/rQ3p3INY/BHnmpnBiRjUXMdJ3CG4+qe4Zc39oMJQjXErSGotGOl8pAZlXwaN8H",
-	"xz5UkH/fjZBgRuu7EHCjuX1ft8mLsc4Vx1y+3Lv1xQtkGjbcQaejFMPjc+5D9jP6HqKMQ52jg8qGhl4P",
-	"F9wMsQnCDJAYU/2S+dvycPTyTfQOQkrQWTBC9PMNy27KKUwsWNQ5XdDxwWh0M5OTg+xhJcknez5cZe+N",
-	"EEUBX8LulB4+oVJp2MEYaJKcCPQoo2Jvk+9UE2NScK/uBLzPmyirUqrMRvTe58MkyX2KvxT5JWCSs8aH",
-	"d6QINfsC1a2NYfN6vQtJgasKJBT3Txg7kxQ1EWyc3fppvcnlPbtv/i3OWtSUt9zrV07eybT7OWYU17fk",
-	"ZmGY/TzMgGN1t5yKBjmQgncrx7wvrhMl2U+mvsqHVsd+meyWqAiKlExyQcaLZ3jQU9WDMcY7SkaANi3O",
-	"vNGDmVKlnBVvEofuhkpjKp4MAbIgp4RDN1D4wZMISBZ+TpxCyu3ls3qpJdPQ2hNvmt5sWKM69aLvz9zM",
-	"0uV3S6WhU23a9aZUho1nfygLz/VCWM317iZJyAY1sgfak1EsH/TMaZxy2oW0jjlDHJalus6QWWVNIv/U",
-	"09a1M93LOFSVavu5U72AyMWHGy+o7diaFyxXWkMe90gHtBFUG6UhKxV6/KSMkUvr5O4NRrFIVqoVU1Wu",
-	"CqCCGGkKGpurlpKj2ASRg0USBUQ7GA5JfSI6njjlXRVop7Q1tOiMzFojzqtgfJoajyFqPIR3T3HzNG9e",
-	"ii3SDejUkV8yq2uYM9+iXwTYH3yugWFNfQSloaVrUZYYGSu2kRGusWGnUTsi9p6jh92VQDeMbpQ0ScNx",
-	"yXk4suK8h3Nf0Xn2i6nRUwZDZNwUT9hGGetfmjRSu+TW++iLXEmrVVl2lVIkoq+8oeIl357luX2h1OWC",
-	"55f38V0rlW1WWsxDAGnfT6ydSfdyJ02sjt/PRUrt0GvKE+3RJfA9Jzu6cnUE5vvDHPSwzv0sVeG/u64u",
-	"M00/Y84k41ZtRJ4+U/9cjlej7lIpFpVMykTF4yiMHpvhYY8vq8bOjixyiGaQPFn96ox5RuDtjchu3H9R",
-	"Au+Py5bgGc3IRTlkLl6KyvJRWa8HAEJKsZ221lRxLpbEGq6iVhQLjtbSPqATbxV0SrkdbG6EOwfKwq2A",
-	"GjjCNQB+QcqHOSXPIqe6hdqG7/fb7Fo3Av7jfirvMI8xb5+LlrQ0+fuETBwjHCGdw3eva8wbjOtdTHWQ",
-	"aaqDTrzhIwDGXWY6MExynDkWjCUXJRRZqrjceaOjmkcvbR+l06/5LIzn5DmvQ203N3atwWeGIBFfd+1f",
-	"FXekpJrmQ02yLGAL5OJPhe65IbtHsL9ASTXdesoAVWUlXEHHk8inq6hR1BRXEPqapjMrACq0RvZ1ZCkX",
-	"mfgu7ylO/NqzyMliCnaTmhRCLO0UO6AmSSp1tjKjY2KmHiUH0ZUoat7BnzlW5OiqAd1RTqBq8EbIwjty",
-	"6jS/0AivwwBnoX9KlAmYeD+NDx3NgtKo28eADrrM1Wbs1Mu0x1yci6UxsOBsRWOIJRJv+Yap+LUcV0gO",
-	"Sb59bk3cJ6FkhNjvtpCjVOPfO1D4F8+IkcKndUBqlwAFvQpcl4S2fQ2SSRXV0LvmpnmqtEniwg80MTYS",
-	"0r+mb2BUbh3bbr+zDAdjppctavQhoRs6vbl6/rOcxL0HcXS8FI0Y8JFge/Rfgbr9swMbYK1i6fbTyf5Y",
-	"hc7fYp6Lz9miDgOVpbqmonjxO/Q5BDsoUV8wAXmxXDTXcnDgm/v8hX1Vh4hclzd8x5TGf9yr8+81L8Vy",
-	"h3yGwA/dmFlzR0Le8EoeAd4h0E28X7yaB8CCtkWFqWjdYuqY0XA7N0oEtLvIQ/USxTb8EuJtQGcH4p+5",
-	"dYzT1AvUXLgru7edQyz4xYccFBtexC99zITXrRMdcqO63v+zDYuKpwoJrKqS56EEoq/B0uUzWOY0EJdd",
-	"w2Z/3NyQrwUSaEqntkSrQ6B1cQOV6ZGsK+WMPlZfogP2oKTkoLTGrZZxTPX5NmZ9T8ThpKXc9S5M9boZ",
-	"AB0XojsEflyX79PgP5mkcmwZU8D/R8H7SCXOGF4quvkJsNxJxpCAlbTVC7XNNCzNIQcTUle757xu0zgE",
-	"FauQuQZuyOPm/Gf/8GxzMArpHsLkE9rYNJtRClgK2TJLIavaJt4xmIpR7iKExUp/ROuICW1MSnDC5BUv",
-	"f74CrUUxtnHudFDNujgHfjB0+L4JFUZzpw4HEKZ9w2GoXqtGj5u5C5yq7JC7prFcFlwXcXMhWQ7a3fvs",
-	"mu/MzS1KjXHgkE2JR9JMN4A8si4haRMg5c4bhW9p72kA5Hdo+JlgsEG/4ISxhlQ7Vo3YZ4Yw/FMYbDZ8",
-	"m5VqhQFlIwfCJ99ECx89AZVENTjJZ9PWHeYx4nfYPw3mHfeMyCqcdcoU+8/9z7iV+Iz8RQq79+STjrIf",
-	"4Ud+t3QwA1LlqnX+J2IZnsdUUKbPwxEHZgZhMwSyB9qDaBNhxD7U1YuP7CK6QfiI3lgJPr2eU9fTIhX6",
-	"SZqBDDUGZo97P5jWlZ3n3j1rqEobqBoIKXMfOHukpo308+FeGgGPim/7s96dtnGZceMcUwRrf6hsVqkq",
-	"y6f4fFJpgsKbCTykXRhH6CMyAoysu3GPMU2xjk4KnE7VjmPrgI1WDTlk7aryfY/+MTXRCEfvmiDUEnkZ",
-	"laZG7RZG8jTKlHl4XgebdFcN1jAJxpmGvNaoJr7mu8N1lUZS4l785eyrR4//+virr5lrwAqxAtOmVe7V",
-	"JWr9AoXs630+rSfgYHk2vQkhEJ0QF+yPIaiq2RR/1ojbmjZn4qAq0zH65cQFkDiOiXo4N9orHKd17f/H",
-	"2q7UIu98x1Io+OP3TKuyTKe1b+SqhAEltVuRCcW9QCrQRhjrGGHXAips6xFt1qgexOSmV5RYRMkcgv7Y",
-	"U4GwIy5XqYWMOdQiP8MwX281YrCtSs+ryNKzb13+nUYaOhQa0StmAaxSlRftxZKlIMIIIl1Doxn3ik/U",
-	"iEc+sg2zJW/ZFCF6z/M06cUVgfdz+261Spvm9G4TE+JFOJQ3IM0x+8R4CPtNOEmr2v+H4R+JmPw74xrN",
-	"cv8IXpF8H9ys6vgk0Ibx2QnyQABGom07cZJRoFiUaVWTlQDtCcGA3Bc/XraG5YNhIQhJ6HAAvDh8tm3X",
-	"RDJ4cD5zBtOXDVKipbwfo4TO8g9F5AbW21wk0RZ5pYm1YIgtqaFYGIVbm2dNFPPIq2QQ7KyVssy9TMsy",
-	"ESRNehw8UzHhuCeBvuLlp+ca3wtt7BniA4rX46FRcaRsjGRCpblZyrYXfNLcUVTs3U0tX2Fg9n+A26Pk",
-	"PeeH8kb4wW2Gyh0syb0KtwLFerNrHJOcrB59zRa+mkClIRemb9y/DsJJExgKWiy9Qyts7YFI1EPr/FXZ",
-	"W5DxMnjisJ8i81Zjs/cQtkf0MzOVkZObpPIU9Q3IIoG/FI+Kq48euC5umXn+ZhlAolxeR2YAGdZVnbo8",
-	"XAdeOrWB4Ton39Yd3CYu6nZtU9PXTE5g/+7dW7uYknUmnWzedce0N3eSdf6onPN/QMIbwpEfw8+bophf",
-	"x1KgUprPkTTNvf2oRXnQYaWTdPvjfLYCCUYYTCv9V18c49PepQECyrwwPKoE623SxRBiEmvtTB5NFaXT",
-	"npBJ23dLpD/GqMa81sLusDBqUKCJvyZL2f7Q5PbwuWEaW5q/+6y6hKY4dZsJpDbhdv1B8RLvIzLxSXcL",
-	"qfKEfUfJnv1B+fO9xb/Bl396Ujz88tG/Lf708KuHOTz56puHD/k3T/ijb758BI//9NWTh/Bo+fU3i8fF",
-	"4yePF08eP/n6q2/yL588Wjz5+pt/u+f4kAOZAA1Z3p/O/k92Vq5UdvbqPHvjgG1xwivxI7i9wbfyUmHh",
-	"PofUHE8ibLgoZ0/DT/8rnLCTXG3a4cOvM1+AZra2tjJPT0+vr69P4i6nKwz9z6yq8/VpmAfLqXXklVfn",
-	"jY8++eHgjrbaY9xUTwpn+O31dxdv2Nmr85OWYGZPZw9PHp488rV7Ja/E7OnsS/wJT88a9/0UUy2eGp9F",
-	"/bSJ1fo4H3yrKsqx7j55GvV/rYGXmGDH/bEBq0UePmngxc7/31zz1Qr0CUZv0E9Xj0+DNHL6wWdO+Ljv",
-	"22nsGXL6oZNgojjQs/F8SNokXyh1iSbxIB/dMz0/jpO49PB54dBPLdH5wpy3jDDUj0Wb8+zp25TuxftQ",
-	"VvWiFDmj6xvp121ORF5N2pCWfaCibdbWLm+ZoWNwD7Nv3n/46k8fU0JWH5CX3iDYWkC8Sy5GeWGAwkmA",
-	"6+816F0LGFrrZzEYQ3Nh0tjiBM3K58D3s52wX7ynA34lntJ4hPqgsErDlVC1aTqNAOaGSMHVYOE9FjFD",
-	"1z8kh8cPH4aT7+XqiKxOPbXG6O7aHgZ+QcekM+hU9k0IRW4xGeJjSLG/GEq55LApJCevenS33fBLsrqg",
-	"Qx3TPm7WY9T76CKSm/gRvy2Buf+BpVsmBGXTTEOh5OOQW46cwOBKGyvGSkFqP+/elCrO+3E+e3IkNexV",
-	"UHVSSSbAf8lLBzIUIW0MQfDo00FwLsnj0107dD1+nM+++pQ4OJeOefGSYcuovmiC4uWlVNcytHSyTL3Z",
-	"cL1DScVO2WOf5QhtiaEd0T1drNyd4bczYstYk6ICLdyDkZez9x8PXS+nH0Jd6f2XUaemsPdXjjpMvOT2",
-	"NTtdYC2pqU3BRI3Hl4IqMHP6AU/o6O+nXhOf/ojKNJLSTkOSr5GWlM4l/bGDwg926xayfzjXJhov5zZf",
-	"19XpB/wPClzRiihR8KndylN0Pjr90EGE/zxARPf3tnvc4mqjCgjAqeWSinHv+3z6gf6NJuoQZivUdAWU",
-	"76JGz9aQX87Sd18vi3rUi5E8yhclFMScnkzoIJWNO93oQL9G8cOwn39kYsmgP4UwYYYjzi0lFj3FkpW7",
-	"Fpfh553Mkz8Ot7mTVHHk59PwHEqJtt2WHzp/do+cWde2UNfRLKhIJC34EDL3sTb9v0+vubDZUmmfyw9r",
-	"XA87W+Dlqa/h0Pu1TZs8+IK5oKMf4yi15K+n3KN6VimTINvX/Dqy/p1hY5IQwNhvFb4oxm6nbbYQEiko",
-	"vqFa/QF9HMrGg3sJy8btbGuCGebhwWQgWvEi5wZrK/tyKANp/WPy2H1qaeNbXrCQQyVjrexx5l+pnaX9",
-	"Y0giSXbzHK6gdBTDlGaHeM9nlmW+evjlp5v+AvSVyIG9gU2lNNei3LFfZBOAc2NW/D2St+b5Jcr4DcmT",
-	"d6bm192YHp3OKtGtFxSSjACzW7bmsih9HL6qsRCao000uqrI7cddYaFeVqU0AkDZJ6EgRwhzwi4aNxF0",
-	"uqjDM6kgskGrCOZUpkk4upCQGXHCVTKfbTPHD1YgM8+RsoUqdr7SzEzza7ul2PoB2yM5c4QnDqTA1Fcv",
-	"6Iw0Cn7j4XOrp4z1fqiQaDR+b9+7BzHW5fa6ilaN9fT0FAOJ1srY05l7z3dVXPHH9w3mQh3SWaXFFRYI",
-	"QKQpLdwztcy8HqitsTV7fPJw9vH/BwAA//+ayO17zAcBAA==",
+	"H4sIAAAAAAAC/+y9cXPcNrIg/lVQ816VY/+Gkuw4eRv/auudNk6yujiJy3Ky9y72JRiyZwYrDsAFQGkm",
+	"Pn33q24AJEiCMxxJcXar3l+2hkCj0Wg0Gt2N7g+zXG0qJUFaM3vxYVZxzTdgQdNfPM9VLW0mCvyrAJNr",
+	"UVmh5OxF+MaM1UKuZvOZwF8rbtez+UzyDcxexP3nMw3/qIWGYvbC6hrmM5OvYcMRsN1V2LqBtM1WKvMg",
+	"zh2Ii5ez2z0feFFoMGaI5Q+y3DEh87IugFnNpeE5fjLsRtg1s2thmO/MhGRKAlNLZtedxmwpoCzMSZjk",
+	"P2rQu2iWfvDxKd22KGZalTDE80u1WQgJAStokGoWhFnFClhSozW3DEdAXENDq5gBrvM1Wyp9AFWHRIwv",
+	"yHoze/HzzIAsQNNq5SCu6b9LDfAbZJbrFdjZ+3lqcksLOrNik5jahae+BlOX1jBqS3NciWuQDHudsO9q",
+	"Y9kCGJfszddfsk8//fQLnMiGWwuFZ7LRWbWjx3Ny3WcvZgW3ED4PeY2XK6W5LLKm/Zuvv6TxL/0Ep7bi",
+	"xkB6s5zjF3bxcmwCoWOChYS0sKJ16HA/9khsivbnBSyVholr4ho/6KLE4/+hq5Jzm68rJaRNrAujr8x9",
+	"TsqwqPs+GdYg0GlfIaU0Av35LPvi/Yen86dnt//283n2v/2fn316O3H6XzZwD1Ag2TCvtQaZ77KVBk67",
+	"Zc3lkB5vPD+YtarLgq35NS0+35Co930Z9nWi85qXNfKJyLU6L1fKMO7ZqIAlr0vLwsCsliUYQ9A8tzNh",
+	"WKXVtSigmDMh2c1a5GuWc+NAUDt2I8oSebA2UIzxWnp2ezbTbUwSxOtO9KAJ/fMSo53XAUrAlqRBlpfK",
+	"QGbVgeMpnDhcFiw+UNqzyhx3WLG3a2A0OH5why3RTiJPl+WOWVrXgnHDOAtH05yJJdupmt3Q4pTiivr7",
+	"2SDVNgyJRovTOUdx846Rb0CMBPEWSpXAJREv7LshyeRSrGoNht2swa79mafBVEoaYGrxd8gtLvv/vPzh",
+	"e6Y0+w6M4St4zfMrBjJXBRQn7GLJpLIRa3heIhpiz7F5eLxSh/zfjUKe2JhVxfOr9Ileio1IzOo7vhWb",
+	"esNkvVmAxiUNR4hVTIOttRxDyEE8wIobvh0O+lbXMqf1b4ft6HLIbcJUJd8RwTZ8++ezuUfHMF6WrAJZ",
+	"CLliditH9Tgc+zB6mVa1LCaoORbXNDpYTQW5WAooWANlDyZ+mEP4CHkcPq3yFaEj5AF0hJyGjoRtgmdw",
+	"d+MXVvEVRCxzwn70wo2+WnUFsmF0ttjRp0rDtVC1aTqN4EhD79fApbKQVRqWIsFjl54chnHm2ngJvPE6",
+	"UK6k5UJCwYR0SCsLTliN4hQNuP++MzzFF9zA589nt4e+Tlz9peqv+t4Vn7Ta1ChzWzJxdOJXv2HTmlWn",
+	"/4T7YTy2EavM/TxYSLF6i6fNUpR0Ev0d1y+QoTYkBDqECGeTESvJba3hxTv5BP9iGbu0XBZcF/jLxv30",
+	"XV1acSlW+FPpfnqlViK/FKsRYja4Ji9c1G3j/kF4aXFst8l7xSulruoqnlDeubguduzi5dgiO5jHMuZ5",
+	"c9uNLx5vt+EycmwPu20WcgTJUdpVHBtewU4DYsvzJf2zXRI/8aX+Df+pqhJ722qZIi3ysT+SyXzgzQrn",
+	"VVWKnCMR3/jP+BWFALiLBG9bnNKB+uJDhGKlVQXaCgeUV1VWqpyXmbHcEqR/17CcvZj922lrfzl13c1p",
+	"NPgr7HVJnVBldWpQxqvqCBivUfUxe4QFCmj6RGLCiT1SmoR0i4isJFAEl3DNpT2ZzVN7st3AP/uRWno7",
+	"bcfRu3cFGyU4cw0XYJwG7Bo+MiwiPSOyMiIrKaSrUi2aHz45r6qWgvT9vKocPUh7BEGKGWyFseYxTZ+3",
+	"Oyke5+LlCfsmhk2quELz0gK8qoFnw9KfWv4Ua2xLfg4txEeG0XKiseZ23pDBGLAPwXF0rVirErWeg7yC",
+	"jf/q28Zshr9P6vyvwWIxbceZC1sxTzl3x6FfosvNJz3OGTKON/ecsPN+37uxDULZwzDmoqXiQzMP/SIs",
+	"bMxBTogwirjJLw/Xmu9mXknMSNkbssmPBhyHVHwlJGE7x+uTZBt+5dZDEd2REcA09yLHSwS0NaF6ndOT",
+	"/mRgZ/kX4NbUwgZN1DDOSmEs3aupMVtDSYozl4GhY1a5E2dMWPA9k2hwvtG8crzsvzi1S0i6z7tGDtd7",
+	"HrwTz8Qkzu3neKEJqzuL5YOiM4kJfujj8JdS5Vd/5Wb9ADt8EWANeZ+GYWvgBWi25mad2Dg93m6hTeFv",
+	"bEg8yxbRUCfNFF+plXmAKZbqGNFVVV/yssShhyKrN1sCPGkjlyXDxgw2wtr24ugs7O7+xb7i+RrVApbz",
+	"spy3piJVZSVcQ8mUZkJKtHbZNbft5ifI4V5D+8gACjsLLJqNNzORiU03tggNbMPpBNrgbaYqu30aCWr4",
+	"BnpaEJ2IqiYrQnTRuHgZZgfXIEkmNaAJ/WaOZK2JgZ+w8+YTjSyVm5yzANrgvmvo18iLDtLYuj1PZTuE",
+	"0oWzWVv8TWiWK+1AuBPeD47/Aa7bzo47P6k0ZB6E5tegDS9xdr1JPW7Y96F254GdWXDLo53puTB9AXOS",
+	"g/qRegc6YaX5gf7DS4afUYtBTmq5R5AyoiJ3auEOZiSVGwkbkL1VsY0zZTK0Lx6F5Zft4GkxM2nnfeWs",
+	"p34J/SSaFXq7FYV5qGUiYGNr1d0hznYVxNFAF9krdKKxphDgraqYEx89FJykIGiOIGr74MfaX9Q2hdNf",
+	"1HZwpKktPMhKqK37zyRhT/j9t17qGYtINz9CP6VFowNcxmcDot26Hs8XSt9NYeqdoZK1DlXGEWqkL857",
+	"fEBN6yrz4ifhlHENeoDaGJb9ek4ffIpaHSpcWv47UMFYHiF/Dyp0AT00FdSmEiU8wO5eJ/VUNIF/+oxd",
+	"/vX8s6fPfnn22efIkpVWK803bLGzYNgn3vLIjN2V8Di50UiBSkP//Hlww3XhpuAYVescNrwagnLuPXfB",
+	"d80YthtSrUtmmnWD4CShD3h6O7Iz57mmTUmOyDdQKl48jC2zFDAinPI1lysomAFrhVx5Ux0UQefTtZQo",
+	"LqUq4JjTkOiA3Jq1JJo0vpORpLZYfgUMlkvIrXeDceah3uNgDuRIYDhpzSKkc+8xdmI8TAExeAmLenXp",
+	"f3it1fLBz+zBCClkqdHrSqP6a7rebL++pwU2OYWt1fy0opYgCxJbNA9huDGwWTyIXBjbu0U7SsH8pijg",
+	"oFw7dqe1w+yi3fZS73T9EEY40FrpJD9WWlmVqzLD24hQCXXltW/BfIuwXFX/d4ctu+GG4djkY69lMaKV",
+	"oPN8spblQL/dypY2ezeSm29idn7cKevSJX57V64wJGgrGXFnR1laarVhnBXUkTTib8C6W4LYwKXlm+qH",
+	"5fJhbPKKACUEl9iAwZGYa8EE7v5cSRdyekCB81CnkKdPmOALteMIeIpc7mRODt2H2Lbjuu1GSIouMTuZ",
+	"R4ou4lhCsQI9gR7TFdkxcrihHpkEOkiOV/SZPEovobT8a6Xftpesb7SqqwcXz/0xp06H+8l4n1WBfYOz",
+	"QshV2Q1zXiHuJ6k5/iET+rIxdbk5EPbEka/Eam0jq8ZrrX6HMzE5SgpR+uBMmiX2GRo2v1cFChNbmwe4",
+	"DbTAWgmHfBvLNb5QtWWcFC1a/Nqk7wkjgbGkl1AgoY2vHmRFE4YtALkr5zXOtq4YhckNzou2Y8Zzt0Mz",
+	"Io1JD9hGd7lWbjgXdFlq4AWaLEEytfCROD5GiCbJKcbPBk3b31IS8qKDV6VVDsags9P5JQ6iFtq5o8Pu",
+	"oRMhTgg3ozCj2JLreyN7dX0QzyvYZRSRatgn3/5kHv8B+FpleXmAsNQmRd6+1XeI9bTh9zFcf/CY7Zw9",
+	"2XEts4ouViVYGCPhUTQZXb8+RoNVvD9ZrkFT4NPvyvFhkPsxUIPq78zv98W2rkbeWXhLC2p4uGCSSxUU",
+	"qxSwkhubHRLL2Ciei8EZRJIwJYkJ8Iji9Yob64L1hCzI8u6OExqH+tAQ4wiPXkMQ8k/hBjKEnStpQJra",
+	"NNcRU1eV0haKdrB2DmSfHR3re9g2Y6llBLu581jFagOHII9RKYLvieVvwPQHt4011tt3h5OjyA8853dJ",
+	"UnaQaAmxD5HL0CqibhxrPoKIMC2hHeMI0+OcJsB9PjNWVRVKC5vVsuk3RqZL1/rc/ti2HTKXc8XRmKxQ",
+	"YMjN59t7zG8cZd0rgzU3zOMRDO5kkXNRhUOccTNmRsgcsn2cT1c8bBVvgYObtK5WmheQFVDyXcJV4D4z",
+	"93kfAFrx9rqrLGQuXDy96C0nh+jcPaAVwUsIze8Voy8sxy2IV4GWQXzvA5ALINgp4eT56FEDisZKLlGA",
+	"R9N2S52ASKfhtbK44q6RQ9lL9CkIj9ChAX13UlDnPQbJ/wLjBwht7jDIDszYFFr4R01gxJzvX+JF+6Un",
+	"3nsSOCk2R8XYATkytmVHfAuvubYiFxXddb6F3YNf/foDJMM7WAGWCzQyRh/cNbCK+zMX6NyHeber4CTb",
+	"2xD9gfEtMZ0QTNZF/gp2dOd+DaD/wuWDuGv5EXZEP+5hDzmfaCREHaoC0GzBJc05zO61eJDZVeLI2b0W",
+	"h2dXiWNmJyTejnGSzdI9yMwA9HFTw/DFw3MjsFMnlyspIbfd+VEoT2SFewgzSwIqE+7NJk4jPBnB22Hc",
+	"BLY8t+WOcdIPd+wGNDBTL1wM2NBbi5FeMYCk93fPiD68JRlcsjfe5pJARdNLud3cdXU/fm97d9YOOfw1",
+	"tVKqnGC8HRAjicGk4DtWKVx14d+PhheEQch1kPT6RLkL6HotJiYzzYD9l6pZziVZA2oLjbqtNOmw2JdG",
+	"ECYa00d3txSCEjbgjBz05cmT/sSfPPFrLgxbwk14dP3kyZAcT56QifG1MrYj9x9iw3NtLxKaDbnFUSfz",
+	"F+T+cXc4ZNRDnrKSr3vAw6C0p4zxjIvTv7cA6O3M7ZS5xzwyLVzWbifO/G03wHIwb1r3S7GpS24fwqEK",
+	"17zM1DVoLQo4KN/9wELJr655+UPTjR6UQ448mkPmnNoTYcFb7OMCFhCOkMKK8GpqKkJw4Xpduk4HrB9t",
+	"SJXYbKAQ3EK5Y5WGHArnEBKGmWaqJ4zAep89init6pWPwnJwSODjA316El3LAYikvm+3MiP/S+oA8HG+",
+	"4c04avrA0drQd964u/UNb8aDonMuTFyDvjMr6b+dz0aNMUjU69YY44jTffg+4TDoXEUi+rQDT/TyEelQ",
+	"LR/SK14W3Ey4uL+PN6kFncJyOHD0ZKL9OPZqAi1B5e4BlB4HiGmoNBg6omILqnFf1TJOchFirXfGwmbo",
+	"ZHJdfxnZfm9GTRlKlkJCtlESdsm8TkLCd/Qx1dsdkyOdSWEZ69u/Hnfw76HVHWcKN96XvrTa/R3ad6aa",
+	"r5V+KG+9AzhZ6Z/gHD94H/BD3tWFj7H8Q6+3fwLfFwBm3sTlCs24MSoXpLNdFGbuNpp3lPv38l3yv24e",
+	"9j3A3uvD7bl34+wq5L6AsmKc5aUg54aSxuo6t+8kJ/NpNNVEiGiwE40b1L8MTdIW/ISB3YN6JzmFBzdG",
+	"1WQs0RISFsSvAYJd3dSrFRjbu+ssAd5J30pIVkthaawNbpfM7ZcKr/Y7CyeuJT50WSJPWMV+A63YorZd",
+	"7Z8yPBiL5nnna8ZhmFq+k9yyErix7DuBkUwILsSjhC0rwd4ofdVQIX26r0CCESZLh7J+477Swyg//bV/",
+	"JIX/951D1H6bcmaG0+xkmfo/n/znC8wuxbPfzrIv/r/T9x+e3z5+Mvjx2e2f//x/uz99evvnx//576mV",
+	"CriLYhTzi5f+Znzxkq4/0VunPu4fzTWFSUuSTBYHGvV4i31CuXY8Az3u2m3tGt5JjCKzClM9iYLbu7FD",
+	"/4QZ7EW3O3pc01mInp02zPXIS8U9pAxLCJmeaLyzFjWM/k5n+sCFDMk7sBVb1tItZdC+3UP2EPqolvMm",
+	"m4tL9PiCUaqPNQ8h5P7PZ599Ppu3KTqa77P5zH99n+BkUWxTiVgK2KbuivErs0eGVXxnwKalB+GejPJ0",
+	"YUcx2A2gkcGsRfXxJYWxYpGWcOHNp7c5beWFdC+kcP+Q933nnXpq+fHxthqggMquUwngOooatWpXE6AX",
+	"EYXPfkDOmTiBk77Np8D7oo83LYEvm5h4pabchpp94BgtcEVE9XgikwwrKf7pvQ/zh7958OuQB5zCqz9m",
+	"Ktj80TdfvWWnXmCaR0QtDzrK4pK4SrsP3Vg5y3jnUe47+U6+hCVZH5R88U4W3PLTBTciN6e1QX9EyWUO",
+	"JyvFXoQH7S+55e/kQNMazUwbZZ1gVb0oRY6ulhR7umyDQwjv3v2MVt13794PwoaG1wc/VFK+uAEyVIRV",
+	"bTOfKy3TcMN1yi1rmlxZBJl67x3VKdmqdgZSD595+GmZx6vK9HPmDKdfVSVOP2JD4zPC4JIxY1XzoFeY",
+	"JicCru/3yh8Mmt8Eu0ptwLBfN7z6WUj7nmXv6rOzT4F1ksj86o985MldBZOtK6M5ffpGFZq4u1bSM4qs",
+	"4quU9/fdu58t8IpWn/TlDS4BKrrULaZJ83yJQLUTCPQYXwCHx9HZFWhyl65XyIubngJ9oiXsZrC413pF",
+	"CUjuvFwHkpjw2q4z3NvJWRlk8bAyTbrMFRfShEAhI1Z0W/WZRRdoUoT8yqd8hE1ld/NOd7XsKJpBdAjj",
+	"koG6J9qUjo4cFJgktCq4V8W53PXzgvn3RQT0DVzB7q1qs9kdkwism5fKjG1U4tRIu0Rmjbeth9FffB/w",
+	"GF7q+/RO9Po9sMWLhi9Cn/GN7FTeB9jEKabo5E0aIwTXCUJQhzES3GGiCO9erJ+anpA5SCuuIYNSrMQi",
+	"lcf8b0N/WMAVudKnbvUB8g1Agy4yYQ1buIPVX+81lytgnCKfKmV46dJSJ+OJ6D60Bq7tArjda+eX8cvp",
+	"gB32Zze4s5yFb45TgC2ut7BksZNwA4U3FLk2PrD+ZDw00iEOxR3xCd3bm8LJ6F3Xky6RsjWcyg11m2ut",
+	"jxqN+eztuvm+Acr5rG5wXRAL5dMVu6xY0flSG76CkbtL7L2bmFCo4/EjIIc0kqQOgqEsXVVjoAkkUXaN",
+	"M5xzcg8DfsFNTNfMXqxwGMk5iL3PiKoQeIItSlJgm6Bqt/Zcd7yocrUPtbRoAS1bVTCg0aVIvB3X3ITt",
+	"WMwjKTtJO/sd8xPsy+15EYW5Rlmlm8yd4TTsS9DBvd9n+AxpPUMuz/jSPyEv53zmBEByOZQk1bSAElZu",
+	"4q5xYJQ241y7QIjHD8slyZYsFTEbGagjBcCPAXhzecKY842wyRBSbByhTYEPBJh9r+K9KVfHICl9xjwe",
+	"YNMREf0N6Ten7g0JKqOqwsNVjPgb8yABfC6fVrPoBfsTGCbknKGYu+YlSBvu4i2QQYpJulD0Ekr60JvH",
+	"YxeNPa4pd+QfNSfqcafZxNpsQDqtau/BeKG2mct/kLyLLLYL5PfksxrsldyYLpnnI8MWakuRhnS0uGcc",
+	"B3AZxyOg0SJAWRpx7tRvTM9yyOwbdr+em+JCwz5ptM6WXcYUvSlDj+iWY+zySZSf804I9PMdNMl8vVni",
+	"oPmgq54MD/P2VJu3eafDi8XU9h/bQslVGqHf0D7Wzaj51zZz6nh2Rt/o46QSHVqW7pPi1XUmRMxRGV77",
+	"7NBBYg9VX/f1wCRZO616dI2olhIlTMiEU3JINgMl0CU466im2RXs0nd5oHP8MnSLjHW0elzuHkcBhBpW",
+	"wlhonUYhLuiPMMdzyj+v1HJ8drbSS5zfG6Waw586OmN8Z5offQb0OGQpNL5CQI9bcgrY6GtDRqSvsWla",
+	"A+0sNnPVWkSRlrg0LL4nLERZp/nVj/vtSxz2++agMfWCTjEhXYDWgqoLJWPq9wztnl3snfArN+FX/MHm",
+	"O203YFMcWCO7dMf4F9kXPQG2TxwkGDDFHMNVGyXpHgEZ5UIYSsdIG41iWk72eRsGm6kIsA9GqYWMDGMn",
+	"v4OUnEuURzX9eFWtVviIz+UOC/4wGWXhLJVcRWXwqmpf0tETrL1gfOrOPVk/fRg+jAXhR+p+JtBjm8Y+",
+	"auYwbx99UsZSGmQF0mXSSZuF1OpAiD+1iGx1H9kX2n8AkAyCfttzZrfRyW6VmuWkBSiBhwxgBsL89m/L",
+	"4YJ40s3Hwqc7qaP3byECSDwlbFQZapghY0QA86oSxbbneHJQR41g/Cjr8oi2RaLFAztAgW4QdJLhOrUI",
+	"fKi1N7Cf0p33FG9lLvbaBxYjf/Pc54Yoak0ejE5k87DwRXNXmzj3b3+6tErzFXgvVOZQuhcIms4xZIjK",
+	"ShhmfQa7QiyXEHtfzF08Bx3kBjb2YgLrJpgs7aKphbSfP0+x0QHuaXE8TLI0xyR4Ycwn/3bo5fJtY1NS",
+	"cyRES3MHV1Uyk8S3sMt+QqMDq7jQpg3P9W6n7uF7xKpfb76FHUE+GPWKiB1YFbI8vQHiwZSlv/lkogoA",
+	"j0xMMXe97CzhESt1nl6lB1oaX9VmnPnbUyaeUW8q99kYbZAE4jJlNS7TsQm4e6BL+D4rH1oEURzWQSJ9",
+	"Px5KmFADeHgUNWlSDvEu5jgMzEvTmd3OZ/eLBEidZh7iAVq/bg7QJJ0p0tR5hjuBPUeSnFcYv8XLzMdL",
+	"jB3+Wl37w5+ah/CKj3yTSXP226/OX7326KNLugSus8YSMDoralf9y8zK1cHZf5S4cgne0OksRdHiNynt",
+	"4xiLGyqN0DM2DapKtfEzLbwQc7FMB7wflH0+1MdNcU/ID1RNxE/r86TOvSAffs1FGZyNAduR4HSa3LTS",
+	"ZEmpEAO4d7BQFPOVPai4Gezu9O5oueuATKKxfqCsqekbh/Q5VUkU+eAf/uDa09dKd4S/f5mYDB76/dQq",
+	"VLIdHUditUMB4L4ydcKc4vXr6lfcjU+exFvtyZM5+7X0HyIE6feF/53uF0+eDJF2p11aSJCVSvINPG5e",
+	"WYwuxMe9gEu4mXZAn19vGs1SjbNhw6EuCiiQ+8ZT70YLT8/C/4LuWPzpZMolPV50R+4YmSk76HLsJWIT",
+	"ZLpxNYcNU7IfU02PYJG1SNj7mjbOGTvcQrLekAMzM6XI06EdcmFQvEoXTImNGTUesdYixFqMxObKWkSw",
+	"sNmUdL49JKMxksQ0yYzCLe0Wym/vWop/1MBEAdLiJ03nWu+oC5cDgjpQSNN2MQ+Y+kTg72MH2eNvCrag",
+	"fUaQvf67l41PKUw0VTXtyAjweMSB4N4Tve35w3Oze8227oZgTrvHBIde0nzgPYhB0Hln3cgYbYFW6udS",
+	"FwmTLbX6DdKOEPIfJRJh+IHoOkK9U5F7fZHSOJXDfOLRDy339Lvx2MLf+y4cJt2UbbzLYZre1cct5F0u",
+	"vSadSXw+i7dkGi/3kXWfBoyIFtpeUTAsFdIJ0Udcuv3kskB0Xpild2XUwpw6+O2u9Dj3VzUv+c2C51fp",
+	"uxDiFC1vJ07KKhY6hwUwTY4DNzqLIribtsIlOaxAtz6IYcLkO95r3LCTbzTtBQY7dq4ucxemUBqVAFPL",
+	"Gy4thDAGJ698bwPOBY+9bpSmFKUmHdJVQC42SXPsu3c/F/kwfKcQKxzJJfCMSth7QMzlQSUuKoSpSr5r",
+	"Mnd40lws2dm83ZNhNQpxLQwGMlOLp67Fghs6Lht3eNMFpwfSrg01fzah+bqWhYbCro0jrFGsuXuSktcE",
+	"Ji7A3gBIdkbtnn7BPqGQTCOu4TFS0StBsxdPv6CAGvfHWeqULWDJ69LuE9kFyewQrJ3mY4pJdTBQSHqo",
+	"6ejrpQb4DcZPhz27yXWdspeopT9QDu+lDZd8Ben3GZsDOLm+tJrkzu/RRVKjAozVaseETY8PlqN8Gnnz",
+	"jeLPocFytdkIu/GBe0ZtkJ/a+vRu0ADuhPaGk+kNXuEjxb9WIfyvZ+v6yNcYvknzA6co5e/JRxuTdc64",
+	"y0tbihDcA03BY3YR0l5TKZ+m8KCjDY6FUyddEpeQKkEJacn+Udtl9ie8Fmueo/g7GUM3W3z+PFHJr1sJ",
+	"Sh6H+EenuwYD+jpNej3C9kFn8X3xFbzMNgJF/eM2x0K0K0cDdZPD2rG40P2gp2q+CCUbZbe6w248ktT3",
+	"Yjy5B+A9WbGZz1H8ePTMPjpn1jrNHrzGFfrxzSuvZWyUTtWyaLe71zg0WC3gGorRRUKY91wLXU5ahftg",
+	"/8fGPwWVM1LLwl5OXgQij+a+x/Koxf/0XZuUnxyr7iVizwaodMLa6e12Hzna8DirW99/6wLG6NsI5SaT",
+	"jaAMqTISfU8/t33+iHihPkpuzTsGx6e/Mo13cNLjnzwhpNHu6Jr++qz72Yn3J0/SubGTJjf8taXCfW7E",
+	"1De1hlg5digK1NZJ4RBQ5PMjDNcvfUjhybjwMOasW3jy46sPD/OwKx1mmmb/MH/63CfAHywdacX27Wqq",
+	"nzzJ6ERzHFTNTTqhD0ZBRAuAUBeAQZOmU4Uponua7XonWODAP5beOHmPcJLatSiLn9qMZT3xqLnM18nY",
+	"1wV2/MVpnp2DxQmAFNXQjyahTIJzN7Zfws0ucff8u5o6zkbIiW37Cc/ddHuTaxHvohmQCgMieYUtcYCY",
+	"qt1kUE2ygXKlCkbjtFVE2p0/rPCeqlk6ZEEHdlNbH41JL5x9Gp2lKPF/I95QaplpbkfkiabXecsWIlwD",
+	"+l/oGuKgg2ZcbOi4MRxLO9HOvAaNN3+1pJei3e6UGIwgRyVCmKnwE7WkNAyK2VpLrKQYTQOkFRrK3ZxV",
+	"3BgH5AynBVsae/bi6dlZ0phD1JkwU0fFMM0f2qk8PaUm7ouvauVqLxyF7GFcb1uOOmZhh4zji3hSIfWU",
+	"TKUP7j0mdqYjyRXwbIrNnrBvKJ8PMnEngTti06TG7aaJrKtS8WJOKXsx3oS5UV0fDUQoKiC6Qvx77J90",
+	"GkxPmxnyFY3kg5kOZ3+CCpy1sVlT7zOVcQ9btBVJRS+ShKxTMXVO2EtnGDTB7OQGcdWM9QaKqLyou5oS",
+	"c+B/rOX5GhuozjE/LiunV74N4qz1R0Rv6q7DRxLYiLcvfutq384Z1fK/EZiEd80tXEM3yV9AI1h8Q9K/",
+	"7vRCrWshjynx3xSXOpbsATmC27jKk5j1CH+kvcXVMD+2EPAl9Uq/MOjVy+j5skPKuJA4mn3nTeY5l0qK",
+	"nBL8p9RFSkg2zfk2oRZC2mtmZn6HJjZXspZx88LVU3G0uvF81iHc0JEdfcVFddzh/rSw9TXuVmCNl2xQ",
+	"zEN1eO/mEdKALx+GTBTLSaUToTrJ8P4mLOBINqJcQyN2u6/x2/feqotbkF0JSfYbTzZ/+XCOmNII8rdK",
+	"JixbKTB+Pt03KuZn7HNCuQcL2L4/eaVWIr8UK4LhgsNw2i4ScgjqPMRF+jhEbPsltvUZ4ZufO0FObtDz",
+	"qvKDJt9pNiucqrg9SuBUNE4Ij4iI28CPoe1ht70BzXSeIqNhqQBmLFR0Dg8Yoyle3oWChQJqx1HUgrl3",
+	"gimilEIm0HglZHAMpg+IPHkk0MLQfh3pZ3LNbb7uiKFDYZAjYf307ja/eghQvQUmktAcwxjjy9jWXR8R",
+	"HE2DVuPncsfCpkDujpQJfNTXBJgOq6iTVuWVqIKezPTqqqcEBwruLDwE7JDr4KO0pjvVmDj2JBrLvLeo",
+	"ixVYzOqWStj0F/rK6Gt4+oR1Luqm6lfz5q2beXvIbX6gXElTb/aMFRrcc7hCGG4MbBZlIhjyZfMRimaF",
+	"kdPQX4D/puoKja+MDwU++q1piPstjks3P3w7m9J6kaczzCo0nRJ0ptyfHO3Qd2P0tv+Dcnp4hPpP8ca0",
+	"J+XiNUrJt6/w4IjT0Q6irt3R0mSLpQhnRd9DGp8mz2FXKuG3YfUs8uXT4iWWrId8aJhE/JqXI++7Yw+A",
+	"O1+dVXzslXc+mpSAW590ynK2VwSNJvJxEbA9n8LQMTYW9eqCXh/OFu/nupeg4x6pbzv+Jxf51AqLUb/T",
+	"3VxD7QIf6xvqV81IKD7UIsLd34YGBpSR+01HQE4p0pGqB+HVhGA2cVzm89W4IhmD+hoDCr+ccjIM6HE7",
+	"n10UR8nOVE2RmYOSXAGxWltKSf5X4AXo1wdSrrdp1kn5qZQRzcHMSgTmc1yuCdzJ1GhqNOmJOGX8EFaI",
+	"sruG3FLJ1zZ6SAMck0AeBwv2//9OvT5+s2qCzn3G9X1p1od1Xg+I+0FmmCi7kStEeDI9qfh5EyPqnrhg",
+	"JbAmH0XvUejkp2nLJeSU9nVvJp6/4QW8zfIyD1d0wmUZJeYRzUMNSlx8vAGqRajkd8Sn5A+HzthD3SvY",
+	"PTKsww3JyojNK6W7ZEYlCjhvSEiSO2ZT9GExwjScQVQIMY+uO7TZ/0eT2kZ5pe44VmBJxuNcU3uGTBcc",
+	"nzQWdj0qrx29ORhL1hOqEQ93XlNTWELhZI2vVOtNzHfYzeQZdwAvXrdv+TQrxaJ6VrkRR3gKtpXQKRXv",
+	"Rym2rUmealx5V9a8jZxypZGX5EpyMbZ0zmPELZfk7+Jy5E3Uhss6xYQh2DiAx4Vyl+m2hiKw89cXc6a5",
+	"b8klDbsRZgFrfi2UTocfa+AmpRD/bb3zFQdA04COmhNetjXc4KczxgtU4HiMGcKSuyC0ULbYqiNZgEdC",
+	"vZlJDI9xS+mq44h9YaMmwqUrSr85CK1c9fU7MkzgdeUWFtsuSmHWMJJyzF+U8L7PkydkyVHCN17MCnS4",
+	"QjPq05SeBacRRS7ZOdsAN3VINaZqu1K4CW9gYdBLayNsR5jY+03S69E6VcLw8XJg/dQ5u6F9Wj2rkjQP",
+	"KO3fJcQ6G15Aj8LpPYCYZGNnUVdgzP1mBt1H3M3pWRXmmMTelRffj3ugi2s7smtVKoO8ptVdqhaOaakR",
+	"YVtbZtU8FKbqtnfxMTH/n6SVT75cinw0vRV+ZLB1L6qjp9I4yBw1tMCRlq8m54RBqfGWr9468If9TI0k",
+	"CjzpCRdxUbMkw93cTnJMgr0W46eZAxvrot5TqvSRMiwqSR9E1O9wbFUifWwJaZoKNBytqaOFeEeoP0a8",
+	"aCGPYSLeiC3EukmG52rU8hWjtZsgVxPhoVnI6Z6wKuP3Jue701ECO++xEmUGpB2DZqJ00+Nwwgz3IPdd",
+	"IMIx+DVw0yg2MKdhafkqvYrRNqdz9sfvv20ETrN4IcNMLa+kupFBJgw4/EbIQt2Y/fzSyGJTCnqa4Xs1",
+	"sTvxnpwzs8YfjXX3rONEkRvxbwT/oDRCEs37jNbhk9Ri9xeqJcLovupg9ZBbiyjLe3T977300HvJy+b0",
+	"0gUBHHQ4twiRSJ8gmcMA9+fGNA9SjvLIKjrucXgJlovS+IcevCmgEfvl2IWbafQbyXNEitLjNtFSoRQH",
+	"mPBbyIXtRinFla+DRZdfF5uG6dNDiwdJbkrN0DufQnrZjCzah8jDsOYhU7g3/XmpDOZ/H0uM0H372zyc",
+	"eWTcC6c2ESXhtQStoWiCoEplILMqoWMM8NhHCkPPuO5EBDNaxtMhN1rC5U1bo4bKGXMq2cL96614gkzD",
+	"hiN2OqokMz7mPmJ/6b6HZFLhVDvoU274NTv4YiA8QRdmQMSY65fMG0UPJ6m6i3tZSAk6C7Fm/bIysptZ",
+	"mPLHF3Xuztl4YzQu+CMO2VFRkvTM5sNZ9lxBUbKnK9idOv+WOwRNs4Ix0s5A7lCPEuf3FvlBHe4mhffq",
+	"QdD7Y/MhV0qV2Uh408WwFk6f469EfuVvr+GpJqpwj7p7Awdhn1BUTRO/erPehdovVQUSiscnjJ1L9zg+",
+	"hLJ2y2T3BpeP7L7xtzRqUbvyVN6NfvJOpl8Z02Gq7ynNApj9MsyALO49lAOyfyC7lWNB9jdUZKpbjf5k",
+	"qvN1GFzaU2YipnJYpHSSSxej9iVt9NSVnVJ5RTnnKHSRMx/bxkypUm/S7pJuDEGlKRUPRghZmHLPbrHw",
+	"wJME8HH7B1Jb+88hebNaMg1t2Ohds1j7xNBONJsxx21/5GaUrrxbKg3xiPQsxWWsD7uSBA4Fa+uFsJrr",
+	"3V1yTXdJlbrdjVL54AOM5u1FO5H2/cWQhmWpbjISVllTry3lwcR2pnsYB9NN2w939QKilxzceEVtx9a8",
+	"YLnSGvK4R9oE6bDaKA0ZViZImmRfoUGJlWIjrGFUDmzFVIVec1f3MM1BY2PVUnJSmyCKo0+SwPEOztT3",
+	"ifh44pB4prrIsYxUrYNlgsLiv8U+LgNTm53UTTpz0YsjbxTB+GyknkKu8RBfYhyXvq8fMpKWzUuxJb4B",
+	"ndryS2Y1Ph71LQh6h4Vo43MNbCOMcag0vHQjypISIIltKw+gCVUeM14n1d4Lekh1LSjavpsMi3qgkptD",
+	"kyEslgGXcfrOyCkWCqU0eAbPpq693zOG8qOp6UEEZULAIZ6zjTLW3zQdpHbK7SOTT3IlrVZl2bP3Or7x",
+	"8Wjf8e15nttXSl1hUqvHdK+VyjYzLeYhT1D/OVA7ku6lyO0ewBnxgDlccsK1w1GCFJgsIHsibhD7dNAK",
+	"1qL5/rAEPRxadT6cWH9eXWGavsacS8at2og8vaf+td7XjL6KSYmoFClcD7fxHRPTZo8PqyacmkTkkMwg",
+	"ebLI8TnzgsCHlTo3Y2WdBt6Hy5bA7WDs6KAcChevRWX5qK7XQ4AwdSl8bK1dYfFYE2ukilo5Hx3ZSvuI",
+	"TjxV6O3B/XBDCA+OlIV7ITV479Qg+IkzPsxdjmT3dgrfy/vvj1vP4J2Qv93P5R3hMfao47JlLU1NmoSL",
+	"IxIhXapl7wuIt5S+aTH1HYQJRviJJ3yEwPjLiA4Ok95HHIvGkuMDuYzbkcOdbFTz6KbtkzFE0EMZWBqF",
+	"5bwOJbwRdq3BJwB0Kr7uhjlW3K7D0YnNh5ZktEqCIWXmN9DK1eaeR2F2ULrS3T1jgKqyEq6h82DE8bKp",
+	"SdXEeAvf1zSdWQFQkUOlbyNLvYSIz/Ke4cTPPYti6adQN2lJcYR1K8UOmEmSRp2tzNw2MVO3EmJ0LYqa",
+	"d+hnjlU5umZA3MoJUg3uCFm4R04d5kcH4U0AcB76p1SZQIn30+TQ0SIoTbp9Aujgy6jajO16mX4YFafc",
+	"bBwsNFrRxNs6Fm/lhqn4jRw3SA5Zvr1uTVwnoWRE2K+2kJNW4+87FMSB95kRJ4XP3kfcLgEKdyvALglr",
+	"+xokk6q99pA1MlxV2lzg4Qc3MDUS0t+m7xA73L5fuv/KMgLGTC8p8OhFQjd8enfz/B+yE/duxFF4KR4x",
+	"4BN+7LF/Be721w5qoOqyYBLXE3V/KjbuTzEvxedsUQdAaK1wMYbxPfQlBD+okrELyM0oZNMlG7AjtzvB",
+	"hqYOEb1QxUBtpekfqSz7R81LsXTBYg790I2ZNUcW8o5XF/jt333hwPvVq3lALFhbVBjKzVtMhRmB2yGU",
+	"CGk8yEORSsU2/AriZaCYdic/c4uC09QLslzgkd1bziEV/ORDqkEKIWzv35TwfNeRDqEEBvb+/9vsF/FQ",
+	"IU9xVfIcik6pza6cQWWoYS67hs3+9ChDuRZYILSKmFaHfFrFHUymR4qu1JvjsTKCHbSja0S3iuDDTGOi",
+	"5bdXK25PYplJU3noVZj6uGKAdFxv/BD6cfn1j0P/ZC2CsWlMQf+fhe5NEc9xfKnJx6ByJ+deAldnrV6o",
+	"baZhaQ4FmFBrRL5F2DQmViFzDdy4iJuLH/zFs021LyRehN3Tv8an2UApYClkKyyFrGqbuMdQxn25iwgW",
+	"G/2JrCMutDEtAZXJa17+cA1ai2Js4XB3qGVcGAAxCY4O3zdhwmjO1CEAYdo7HGVkac3ocTM8wF0xVReZ",
+	"ZiyXBddF3FxIloO2XKDvemfu7lFqnAOHfEo80ma6ecIi7xKxtkOk3Hmn8D39PQ2C/AEdPxMcNm/X4Lm/",
+	"66zxr3LUiH9miMO/hMNmw7fo46O8ISMbwtdYIA8fNWNKkhnc6WfT5h3GMeI32D8MlZfygsgqGnXKEPv3",
+	"/Q+0lHSN/FEKu3fnOxtlP5GLe17pNmYgqly1b7wdswz3YzUSA1918+8EZTPkKwu8B9Eijr0a6trFR1aR",
+	"wiB84qbYCD69bG830iJxwnjLQEYWA7PnFTeY9sUyz3141tCUNjA1OKLMfX6kIy1tzj4fzqUR9JDQEF7g",
+	"dYdtQmYQzjG1jvdnRMoqVWX5lJhPV4GucAgETLs4jvBH5AQYmXcTHmOamowxN3aLMx5b7nm0OOQhb1eV",
+	"77v0j5mJRiR61wWhliTLaAs745jSsTFlHq7XwSfdNYM1QoJxpiGvNZmJb/jucPnckconl389/+zps1+e",
+	"ffY5wwasECswbfWcXvnZNi5QyL7d5+NGAg6mZ9OLEPKN0efG/xhyZzSL4veak7amTY0/KL57jH05cQAk",
+	"tmOi7Omd1orgtC+4/7mWKzXJB1+xFAl+/zXDMI109bJGr0o4UFKrFblQ8AZSgTbCWJC25wEVto2INmsy",
+	"D1INi2uXP1KF52otFwg7EnKVmshYQC3JM/zUPMGCbVV6WXXjH3ePz8vf05yFjpRGiopBK5aqvGovliyF",
+	"ESP7eQ2NZdwbPskiHsXINsLWRcumGNFHnqdZD2M26Caslmy/tG8dhUFQJyQ9LmJCvQib8g6sOeafGM9U",
+	"dhdJ0pr2/2nkRyL12oNJjWa6v4esSN4P9qSWOh/EPTRpxyahNkzDlWAPQmAkqVInHU6UDyQqqKGdl4D8",
+	"CcGB3Fc/vmsdywefhRAmocMB9OIsSW27/gPQP7hQxXcNUaKpvB/jhM70DyVeal6/hoMkWiJvNLEWjBNL",
+	"idwWUVYt82WTrGrkVjLIaaWVskxJtI0kcmE5Ow7tqZhxhLSgr3n58aXG10Ibe070gOLN+NOoOCFSTGRH",
+	"SnO3zNyv+KSxS/47DC1fU/6tvwGuUfKc86C8E35wmpFxh5cuvHrZeKNBshuCSSvNnn7OFr5oXKUhF6bv",
+	"3L8JykmT/wc0esdoCNjaAwmHDs3zJ2XvwcbLEInDvo/cW43P3mPYbtE/WKiM7Nwkl6e4b8AWCfqlZBSm",
+	"RJ5WZey+BcbulugxStl8ZKLHeGaUUnvy9GgedOjUBobznHxad2ibOKjbuU3NUjq5ThmWglxMSS6arimG",
+	"3Sm76YMUFzuqtNjvkNfU0cjD8OOmOOansUoXrprDSDWe3npg4Z6DXrW4thI+uAUJRhiqHvSLr4H4cc/S",
+	"gIFLajTcqg7X+2QFdYRJzLUzeDRUVDVpQsEk3y1R5cZlSKi1sLtLpH8woIlfrlIJI79pUjj6FKCNL82f",
+	"fVZdgQzxHm3Cx9qE0/UbxUs6j5yLT+IppMoT9pWr6eM3yp8fLf4DPv3T8+Ls06f/sfjT2WdnOTz/7Iuz",
+	"M/7Fc/70i0+fwrM/ffb8DJ4uP/9i8ax49vzZ4vmz559/9kX+6fOni+eff/Efj2bzmUCUHaKhmNeL2f/K",
+	"zsuVys5fX2RvEdmWJrwSmCXz9pbuyi6dGhE1p52IT93L2Yvw0/8IO+wkV5sWfPh15uuMztbWVubF6enN",
+	"zc1J3OV0RU//M6vqfH0axrmd9yh+/vqiidF3cTi0oq31+GTWssI5fXvz1eVbzF930jLM7MXs7OTs5CnC",
+	"VxVIXonZi9mn9BPtnjWt+yll1D81vljWafNW63Y++IYGwqX/5HnU/7UGXtq1/2MDVos8fNLAi53/v7nh",
+	"qxXok7+7jHn40/Wz06CNnH7wmRNu9307jSNDTj90EkwUB3o2kQ9JnyQ+LSKXeNCPHpleHAeSt1mGiwLJ",
+	"71pS8IW5aAUhkTj4nGcvfk7ZXlxXVtWLUuTMHd/Ev7g4EXs1Wbha8UGGtpkTnziRVhiigDvLvnj/4bM/",
+	"3aaUrEFuFe8QbD0gPiSXXnnRA4WTgNc/atC7FjHy1s9iNIbuwnQOu61llS915kfDx2PQqqFOpjQRof5R",
+	"WKXhWqjaNJ1GEEMQKbwaKryfz9yl3jjh9+zsLOx8r1dHbHXquTUmd9f3MIgLOiadQRy3k1KKcDIZ0SOR",
+	"mMyELGN8JSR3UfUUbrvhV87rQgF1TPt3s56iPkaXiNy8H/HLEoT771ihc8KjbDfSUCm5HUrLkR0YQmlj",
+	"w1gpnNnPhzet0TZLIYnt2/zb+ez5kdyw10DVqRiQQP87XiLKaAhv4/+enz39eBhcSBfxiceOOx5v57PP",
+	"PiYNLiQKL14yaukORHrImeB4n+HMt7ydz0y92XC9I03FTlljn+WIfImhneN7d7By3MM/z5xYptKDFWiB",
+	"F0bMxXp76Hg5/eBT/Bw4jGIj+amPV446TDzk9jU7XajtEU3BRI3Hp0ImMHP6gXbo6O+n3hKf/kjGNKel",
+	"nYZcziMtXTqX9McOCT/YLU5kPzhsE8HLMdSirk4/0H9I4Ypm5FShUw2l4kX7sysTc2q38pRikk4/dOjj",
+	"Pw/o0/297R63uN6oAgLOark0YA98Pv3g/o0G6vBrq+t09ZavokZfriGn1J6JI7FXQyvqxZyaimHdhZNZ",
+	"zyd0kMrGne60z9+QVmLYD9+iBw36QwgTRjhiO7uyEqemrqpy19Iy/LyTefLH4TJ3UuqP/Hwabkkpjbfb",
+	"8kPnz+5OpHyzvT9PF1wmf0sORV99ms/+z4UwI18qEQ9h1rUt1E00V7JyOhP9kD74sTb9v09vuLBot/D5",
+	"5PnSgh52tsDLU19HsPdrW7pn8IXqEUU/RlIj/esp9ws+q5RJbJ43/CZyTZ5TY6e+gLF/UcVuz9G5zRZC",
+	"Eh/Hx2dr3HAfh4r77TyhdFEUX/APDZMEUaYSrXiRc0N5E6M0vp2rxG1y839sVegvvGAhwUvGWsXo3F+h",
+	"O1P751CTkkLvJb50RY5hSrNDEvAPVrQ+O/v04w1/Cfpa5MDewqZSmmtR7tiPsnkddOcD4Wtib42hE3gB",
+	"aVjehY5iAq2Yc5ROxBWHIglNevW2LIDdsjWXRQm6CdyuQCNvInxKcBJikvAgDTWbK6UJAZcaEwoXpWFO",
+	"2GUTw0IRIXW4wxWObchlgyD8IJziW5yPc8KBhoZglAcrwCd9tJmyhSp2vtrpTPMbu3UP/wdizynBIzJx",
+	"oKKmvnotbKRRCGoPn1sjamyUJGtJY478+T3e1g3o62BIaW1sL05P6ZXTWhl7Orudf+jZ3+KP7xvKfQhm",
+	"gkqLa8TmloimtMA7dJl5I1Vb53n27ORsdvv/BgCMOTLFrhoBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
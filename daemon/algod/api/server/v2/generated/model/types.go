@@ -706,6 +706,99 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PeerBan A peer banned from connecting to the node.
+type PeerBan struct {
+	// Address The banned IP address or libp2p peer ID.
+	Address string `json:"address"`
+
+	// Expires Unix timestamp, in seconds, when the ban lifts. Omitted for permanent bans.
+	Expires *uint64 `json:"expires,omitempty"`
+
+	// Manual Whether the ban was added through the API, rather than for misbehavior.
+	Manual bool `json:"manual"`
+
+	// Reason Why the peer was banned.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PeerInfo A peer the node is connected to.
+type PeerInfo struct {
+	// Address The address the peer was connected to at, or the address it connected in from.
+	Address string `json:"address"`
+
+	// ConnectedSince Unix timestamp, in seconds, when the connection was established.
+	ConnectedSince uint64 `json:"connected-since"`
+
+	// MessageDelay Relative average per-message delay of the peer in nanoseconds, measured for outgoing websocket connections.
+	MessageDelay *uint64 `json:"message-delay,omitempty"`
+
+	// Network The network the peer is connected over, ws or p2p.
+	Network string `json:"network"`
+
+	// Outgoing Whether the node made the connection.
+	Outgoing bool `json:"outgoing"`
+
+	// PeerId The libp2p peer ID, for peers connected over the p2p network.
+	PeerId *string `json:"peer-id,omitempty"`
+
+	// Pinned Whether the peer is pinned.
+	Pinned bool `json:"pinned"`
+
+	// Role relay for the peers the node connected out to, client for the peers which connected in.
+	Role string `json:"role"`
+
+	// Traffic The traffic exchanged with the peer, by message tag.
+	Traffic []PeerTagTraffic `json:"traffic"`
+}
+
+// PeerPin A peer pinned by the node operator.
+type PeerPin struct {
+	// Address The pinned peer address.
+	Address string `json:"address"`
+
+	// Expires Unix timestamp, in seconds, when the pin lifts. Omitted for pins without a duration.
+	Expires *uint64 `json:"expires,omitempty"`
+}
+
+// PeerTagTraffic The traffic exchanged with a peer in the messages of a tag since the connection was established.
+type PeerTagTraffic struct {
+	// BytesReceived Bytes received from the peer.
+	BytesReceived uint64 `json:"bytes-received"`
+
+	// BytesSent Bytes sent to the peer.
+	BytesSent uint64 `json:"bytes-sent"`
+
+	// MessagesReceived Messages received from the peer.
+	MessagesReceived uint64 `json:"messages-received"`
+
+	// MessagesSent Messages sent to the peer.
+	MessagesSent uint64 `json:"messages-sent"`
+
+	// Tag The message tag, or UNK for the messages with an unknown tag.
+	Tag string `json:"tag"`
+
+	// Windows The traffic over the sliding windows reported by the node, shortest first.
+	Windows []PeerTrafficWindow `json:"windows"`
+}
+
+// PeerTrafficWindow The traffic exchanged with a peer in the messages of a tag over a sliding window.
+type PeerTrafficWindow struct {
+	// BytesReceived Bytes received from the peer.
+	BytesReceived uint64 `json:"bytes-received"`
+
+	// BytesSent Bytes sent to the peer.
+	BytesSent uint64 `json:"bytes-sent"`
+
+	// MessagesReceived Messages received from the peer.
+	MessagesReceived uint64 `json:"messages-received"`
+
+	// MessagesSent Messages sent to the peer.
+	MessagesSent uint64 `json:"messages-sent"`
+
+	// Seconds The duration of the window in seconds.
+	Seconds uint64 `json:"seconds"`
+}
+
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 type PendingTransactionResponse struct {
 	// ApplicationIndex The application index if the transaction was found and it created an application.
@@ -1198,6 +1291,15 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// ConfigReloadResponse defines model for ConfigReloadResponse.
+type ConfigReloadResponse struct {
+	// Applied The changed settings applied to the running node.
+	Applied []string `json:"applied"`
+
+	// RestartRequired The changed settings which only take effect after a restart.
+	RestartRequired []string `json:"restart-required"`
+}
+
 // DebugSettingsProfResponse algod mutex and blocking profiling state.
 type DebugSettingsProfResponse = DebugSettingsProf
 
@@ -1324,6 +1426,21 @@ type ParticipationKeyResponse = ParticipationKey
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// PeerBansResponse defines model for PeerBansResponse.
+type PeerBansResponse struct {
+	Bans []PeerBan `json:"bans"`
+}
+
+// PeerPinsResponse defines model for PeerPinsResponse.
+type PeerPinsResponse struct {
+	Pins []PeerPin `json:"pins"`
+}
+
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+	Peers []PeerInfo `json:"peers"`
+}
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1549,6 +1666,9 @@ type GetTransactionProofParamsFormat string
 type StartCatchupParams struct {
 	// Min Specify the minimum number of blocks which the ledger must be advanced by in order to start the catchup. This is useful for simplifying tools which support fast catchup, they can run the catchup unconditionally and the node will skip the catchup if it is not needed.
 	Min *uint64 `form:"min,omitempty" json:"min,omitempty"`
+
+	// File Absolute path, on the node's host, of a catchpoint file to load the ledger from, rather than downloading the catchpoint file from peers. It may also be a directory holding catchpoint files, such as the catchpoints directory of a node, in which the catchpoint file is picked by the round of the catchpoint label. The catchpoint label and merkle root are verified as usual, and the trailing blocks are downloaded from peers. A catchup interrupted by a restart resumes loading the same file, and is aborted if the file is no longer available.
+	File *string `form:"file,omitempty" json:"file,omitempty"`
 }
 
 // GetLedgerStateDeltaForTransactionGroupParams defines parameters for GetLedgerStateDeltaForTransactionGroup.
@@ -1590,6 +1710,42 @@ type GenerateParticipationKeysParams struct {
 	Last uint64 `form:"last" json:"last"`
 }
 
+// BanPeerParams defines parameters for BanPeer.
+type BanPeerParams struct {
+	// Duration Ban duration in seconds. When omitted or zero, the ban is permanent.
+	Duration *uint64 `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// Reason Reason for the ban.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ConnectPeerParams defines parameters for ConnectPeer.
+type ConnectPeerParams struct {
+	// Address A websocket relay address such as host:port, or a libp2p multiaddr ending with /p2p/<peer ID>.
+	Address string `form:"address" json:"address"`
+}
+
+// DisconnectPeerParams defines parameters for DisconnectPeer.
+type DisconnectPeerParams struct {
+	// Address The address of the peer, or a libp2p peer ID.
+	Address string `form:"address" json:"address"`
+}
+
+// UnpinPeerParams defines parameters for UnpinPeer.
+type UnpinPeerParams struct {
+	// Address The pinned peer address.
+	Address string `form:"address" json:"address"`
+}
+
+// PinPeerParams defines parameters for PinPeer.
+type PinPeerParams struct {
+	// Address A websocket relay address such as host:port, an IP address, or a libp2p multiaddr ending with /p2p/<peer ID>.
+	Address string `form:"address" json:"address"`
+
+	// Duration Pin duration in seconds. When omitted or zero, the peer stays pinned until it is unpinned.
+	Duration *uint64 `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
@@ -36,6 +36,36 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
+	// Reloads the node configuration.
+	// (POST /v2/config/reload)
+	ReloadConfig(ctx echo.Context) error
+	// Lists the connected peers.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
+	// Lists the banned peers.
+	// (GET /v2/peers/bans)
+	GetPeerBans(ctx echo.Context) error
+	// Unbans a peer.
+	// (DELETE /v2/peers/bans/{address})
+	UnbanPeer(ctx echo.Context, address string) error
+	// Bans a peer.
+	// (POST /v2/peers/bans/{address})
+	BanPeer(ctx echo.Context, address string, params BanPeerParams) error
+	// Connects to a peer.
+	// (POST /v2/peers/connect)
+	ConnectPeer(ctx echo.Context, params ConnectPeerParams) error
+	// Disconnects a peer.
+	// (POST /v2/peers/disconnect)
+	DisconnectPeer(ctx echo.Context, params DisconnectPeerParams) error
+	// Unpins a peer.
+	// (DELETE /v2/peers/pins)
+	UnpinPeer(ctx echo.Context, params UnpinPeerParams) error
+	// Lists the pinned peers.
+	// (GET /v2/peers/pins)
+	GetPinnedPeers(ctx echo.Context) error
+	// Pins a peer.
+	// (POST /v2/peers/pins)
+	PinPeer(ctx echo.Context, params PinPeerParams) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -119,11 +149,201 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min: %s", err))
 	}
 
+	// ------------- Optional query parameter "file" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file", ctx.QueryParams(), &params.File)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.StartCatchup(ctx, catchpoint, params)
 	return err
 }
 
+// ReloadConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) ReloadConfig(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReloadConfig(ctx)
+	return err
+}
+
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
+// GetPeerBans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerBans(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerBans(ctx)
+	return err
+}
+
+// UnbanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnbanPeer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnbanPeer(ctx, address)
+	return err
+}
+
+// BanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) BanPeer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BanPeerParams
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BanPeer(ctx, address, params)
+	return err
+}
+
+// ConnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) ConnectPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConnectPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ConnectPeer(ctx, params)
+	return err
+}
+
+// DisconnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DisconnectPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectPeer(ctx, params)
+	return err
+}
+
+// UnpinPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnpinPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnpinPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnpinPeer(ctx, params)
+	return err
+}
+
+// GetPinnedPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPinnedPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPinnedPeers(ctx)
+	return err
+}
+
+// PinPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) PinPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PinPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PinPeer(ctx, params)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -177,6 +397,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST(baseURL+"/v2/config/reload", wrapper.ReloadConfig, m...)
+	router.GET(baseURL+"/v2/peers", wrapper.GetPeers, m...)
+	router.GET(baseURL+"/v2/peers/bans", wrapper.GetPeerBans, m...)
+	router.DELETE(baseURL+"/v2/peers/bans/:address", wrapper.UnbanPeer, m...)
+	router.POST(baseURL+"/v2/peers/bans/:address", wrapper.BanPeer, m...)
+	router.POST(baseURL+"/v2/peers/connect", wrapper.ConnectPeer, m...)
+	router.POST(baseURL+"/v2/peers/disconnect", wrapper.DisconnectPeer, m...)
+	router.DELETE(baseURL+"/v2/peers/pins", wrapper.UnpinPeer, m...)
+	router.GET(baseURL+"/v2/peers/pins", wrapper.GetPinnedPeers, m...)
+	router.POST(baseURL+"/v2/peers/pins", wrapper.PinPeer, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -184,230 +414,262 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3MbN7Io/lVQPKfKj8OR5EeyG/1q6/wUO8nqxklclpK959i+CTjTJLEaArMAhiLj",
-	"6+9+C43HYGYw5FBinE2d/csWB49Go9Fo9PPDJBerSnDgWk3OP0wqKukKNEj8i+a5qLnOWGH+KkDlklWa",
-	"CT4599+I0pLxxWQ6YebXiurlZDrhdAVNG9N/OpHwj5pJKCbnWtYwnah8CStqBtbbyrQOI22yhcjcEBd2",
-	"iMuXk487PtCikKBUH8ofeLkljOdlXQDRknJFc/NJkVuml0QvmSKuM2GcCA5EzIlethqTOYOyUCd+kf+o",
-	"QW6jVbrJh5f0sQExk6KEPpwvxGrGOHioIAAVNoRoQQqYY6Ml1cTMYGD1DbUgCqjMl2Qu5B5QLRAxvMDr",
-	"1eT87UQBL0DibuXA1vjfuQT4FTJN5QL05P00tbi5Bplptkos7dJhX4KqS60ItsU1LtgaODG9Tsh3tdJk",
-	"BoRy8ubrF+TZs2dfmIWsqNZQOCIbXFUze7wm231yPimoBv+5T2u0XAhJeZGF9m++foHzX7kFjm1FlYL0",
-	"YbkwX8jly6EF+I4JEmJcwwL3oUX9pkfiUDQ/z2AuJIzcE9v4qJsSz/+77kpOdb6sBOM6sS8EvxL7OcnD",
-	"ou67eFgAoNW+MpiSZtC3Z9kX7z88mT45+/hvby+y/3Z/fvbs48jlvwjj7sFAsmFeSwk832YLCRRPy5Ly",
-	"Pj7eOHpQS1GXBVnSNW4+XSGrd32J6WtZ55qWtaETlktxUS6EItSRUQFzWpea+IlJzUvDpsxojtoJU6SS",
-	"Ys0KKKaG+94uWb4kOVV2CGxHbllZGhqsFRRDtJZe3Y7D9DFGiYHrTvjABf3zIqNZ1x5MwAa5QZaXQkGm",
-	"xZ7ryd84lBckvlCau0oddlmR6yUQnNx8sJct4o4bmi7LLdG4rwWhilDir6YpYXOyFTW5xc0p2Q32d6sx",
-	"WFsRgzTcnNY9ag7vEPp6yEggbyZECZQj8vy566OMz9milqDI7RL00t15ElQluAIiZn+HXJtt/19XP3xP",
-	"hCTfgVJ0Aa9pfkOA56KA4oRczgkXOiINR0uIQ9NzaB0OrtQl/3clDE2s1KKi+U36Ri/ZiiVW9R3dsFW9",
-	"IrxezUCaLfVXiBZEgq4lHwLIjriHFFd005/0WtY8x/1vpm3JcobamKpKukWErejmL2dTB44itCxJBbxg",
-	"fEH0hg/KcWbu/eBlUtS8GCHmaLOn0cWqKsjZnEFBwig7IHHT7IOH8cPgaYSvCBw/yCA4YZY94HDYJGjG",
-	"nG7zhVR0ARHJnJAfHXPDr1rcAA+ETmZb/FRJWDNRq9BpAEacercEzoWGrJIwZwkau3LoMAzGtnEceOVk",
-	"oFxwTRmHwjBnBFposMxqEKZowt3vnf4tPqMKPn8+dMc3X0fu/lx0d33njo/abWyU2SOZuDrNV3dg05JV",
-	"q/+I92E8t2KLzP7c20i2uDa3zZyVeBP93eyfR0OtkAm0EOHvJsUWnOpawvk7/tj8RTJypSkvqCzMLyv7",
-	"03d1qdkVW5ifSvvTK7Fg+RVbDCAzwJp8cGG3lf3HjJdmx3qTfFe8EuKmruIF5a2H62xLLl8ObbId81DC",
-	"vAiv3fjhcb3xj5FDe+hN2MgBIAdxV1HT8Aa2Egy0NJ/jP5s50hOdy1/NP1VVmt66mqdQa+jYXcmoPnBq",
-	"hYuqKllODRLfuM/mq2ECYB8StGlxihfq+YcIxEqKCqRmdlBaVVkpclpmSlONI/27hPnkfPJvp43+5dR2",
-	"V6fR5K9MryvsZERWKwZltKoOGOO1EX3UDmZhGDR+QjZh2R4KTYzbTTSkxAwLLmFNuT5pniwtfhAO8Fs3",
-	"U4NvK+1YfHeeYIMIJ7bhDJSVgG3DB4pEqCeIVoJoRYF0UYpZ+OHhRVU1GMTvF1Vl8YHSIzAUzGDDlFaP",
-	"cPm0OUnxPJcvT8g38dgoigtebs3lYEUNczfM3a3lbrGgW3JraEZ8oAhup5AnZms8GoyYfwyKw2fFUpRG",
-	"6tlLK6bxX13bmMzM76M6/zFILMbtMHHhQ8thzr5x8JfocfOwQzl9wnHqnhNy0e17N7Ixo+wgGHXZYPHY",
-	"xIO/MA0rtZcSIogianLbQ6Wk24kTEjMU9vpk8qMCSyEVXTCO0E7N84mTFb2x+yEQ74YQQIV3kaUlK0EG",
-	"FaqTOR3qT3p6lj8AtaY21kuiRlItmdL4rsbGZAklCs6Ue4KOSeVOlDFiw3csIsB8K2lladl9sWIX4/ie",
-	"t40srPe8eEfeiUmYI3YfbTRCdWe2vJd1JiFBrtGB4ctS5Dd/pWp5hBM+82P1aR+nIUugBUiypGqZODgd",
-	"2m5GG0PfpiHSLJlFU52EJb4SC3WEJZbiENZVVS9oWZqp+yyrs1oceNRBLktiGhNYMVSYu4ej1bDb9xf5",
-	"iuZLIxaQnJbltFEViSorYQ2lebQzzkFOiV5S3Rx+HNm/a/AcKTDMTgOJVuPUTKhik0EXIYGsKN5AK/Oa",
-	"qcp2n8BBFV1BRwrCG1HUqEWIHhqXL/3qYA0ceVIYGsEPa0RtTTz4iZnbfcKZubCLsxpA7c13AX+BX7SA",
-	"Nq2b+5Q3UwhZWJ21Nr8xSXIh7RD2hneTm/8AlU1nS50PKwmZG0LSNUhFS7O6zqIeBfI91uncczILqml0",
-	"Mh0Vph9glnNgPxTvQCa0ND/gf2hJzGcjxRhKaqiHoTAiInNqYS9mgyo7k2mA+lZBVlaVSSqa3xwE5Ytm",
-	"8jSbGXXyvrLaU7eFbhFhh643rFDH2iYcbGiv2ifE6q48O+rJIjuZTjTXGARci4pY9tEBwXIKHM0iRGyO",
-	"fq19KTYpmL4Um96VJjZwlJ0w44xm9gjfv+RSR1iIuukB8iluGl7gPL4bDNiN6fFiJuTdBKbOHcpJY1Al",
-	"1IwayYvTDh1g07rKHPtJGGVsg85AjQ/LbjmnO3wKWy0sXGn6G2BBmVGPgYX2QMfGglhVrIQjnO5lUk6d",
-	"UQXPnpKrv1589uTpz08/+9yQZCXFQtIVmW01KPLQaR6J0tsSHiUPGgpQ6dE/f+7NcO1xU+MoUcscVrTq",
-	"D2XNe/aBb5sR066PtTaacdUBwFFMH8ztbdFOrOXagPYSZvXiCrQ2j/nXUsyPzvB7M6Sgw0avK2lkJ9U2",
-	"hTqB8LQwTU5hoyU9rbAl8MK6Uph1MGWeuavZUYhqaOOLZpaCOIwWsPdQHLpNzTTbeKvkVtbH0OCAlEIm",
-	"pYxKCi1yUWZGlGUicde9di2Ia+G3q+r+bqElt1QRMzcaaGteDFxpesPHX9F26OsNb3CzUzyy602szs07",
-	"Zl/ayG8eWhXITG84Qeps3bRzKVaEkgI7ojj1DWgrYrIVXGm6qn6Yz4+j0BU4UEIkYCtQZiZiWxgBT0Eu",
-	"uPVX3HP7u1HHoKeLGG9I08MAOIxcbXmO1sBjHNthwWjFOLomqC3PIynJwFhCsWiR5f21dEPosFM9UAlw",
-	"DDpe4Wc0R7yEUtOvhbxuJPRvpKiro7Pn7pxjl0PdYpzBozB9vaab8UXZ9pFdGNhPUmv8XRb0IuhJ7BoQ",
-	"eqTIV2yx1NGT+LUUv8GdmJwlBSh+sPqw0vTpa8W+F4VhJrpWRxAlm8EaDmfoNuZrdCZqTSjhogDc/Fql",
-	"hcwBr0p050IvNB3LraiCYYrMwFBXTmuz2roi6GPVuy+ajhnN7QnNEDVqwMMkuAbZVnY667FXSqDFlswA",
-	"OBEz58bhHExwkRQdxLQX05yIm+AXLbgqKXJQCorMadv3gubb2atD78ATAo4Ah1mIEmRO5b2BvVnvhfMG",
-	"thm6Myry8Nuf1KPfAV4tNC33IBbbpNDbVRn2oR43/S6C604ek51VRlqqNeKtYRAlaBhC4UE4Gdy/LkS9",
-	"Xbw/WtYg0WvmN6V4P8n9CCiA+hvT+32hrasBJ333TDcSntkwTrnwglVqsJIqne1jy6ZRS5dgVhBxwhQn",
-	"xoEHBK9XVGnr6cV4gWpbe53gPFYIM1MMAzz4DDEj/+RfIP2xc3MPclWr8BxRdVUJqaFIrQGVe4NzfQ+b",
-	"MJeYR2OHN48WpFawb+QhLEXjO2S5FzD+QXVQ5TnlYH9x6DZg7vltEpUtIBpE7ALkyreKsBs7Kg8AwlSD",
-	"aEs4THUoJ3hHTydKi6oy3EJnNQ/9htB0ZVtf6B+btn3isnYce28XAhTaiFx7B/mtxax1UV9SRRwcXluL",
-	"6hzrktaH2RzGTDGeQ7aL8vGJZ1rFR2DvIa2rhaQFZAWUdJvQM9vPxH7eNQDuePPcFRoy62uc3vSGkr1r",
-	"546hBY6nUsIjwS8kN0fQPAUaAnG994xcAI6dYk6Ojh6EoXCu5Bb58XDZdqsTI+JtuBba7LijBwTZcfQx",
-	"AA/gIQx9d1Rg56x5e3an+C9QboIgRxw+yRbU0BKa8Q9awIAu2IVxReelw947HDjJNgfZ2B4+MnRkBxTT",
-	"r6nULGcVvnW+he3Rn37dCZK+AaQATVkJBYk+2GdgFfcn1ku2O+bdnoKjdG998HvKt8RyvCdSG/gb2OKb",
-	"+7UNv4hUHcd4yyZGNfcT5QQB9U7dRgSPm8CG5rrcGkFNL2FLbkECUfXMemn07SlaVFk8QNI+s2NGZ4BO",
-	"mn93WsSvcKhoeSmzpX0T7IbvuvMwaKHDvQUqIcoRGrIeMpIQjHKPIZUwu85chJeP8fGU1ALSMW30PgjX",
-	"/wPVQjOugPyXqElOOT65ag1BphESBQUUIM0MRgQLczr/ywZDUMIK7EsSvzx+3F3448duz5kic7j1YZGm",
-	"YRcdjx+jHue1ULp1uI6gDzXH7TJxfaDhylx87hXS5Sn7nbrcyGN28nVn8GDtMmdKKUe4Zvn3ZgCdk7kZ",
-	"s/aYRsY5tOG4o2w5bReo3rpx36/Yqi6pPobVCta0zMQapGQF7OXkbmIm+FdrWv4QumHIJ+SGRnPIcgxU",
-	"HDkWXJs+NrbRjMM4MwfYxjWMBQguba8r22nPE7NxemCrFRSMaii3pJKQgw3pM5KjCks9IdbZP19SvsAH",
-	"gxT1wvlJ2HGQ4dfKqmZkzXtDJIUqveEZKrlTF4DzxPNRnUacAmqedF0NuX3A3NIwnwvkHXMzR3vQtRgk",
-	"jWTTyeCL1yB13bx4LXLaoakjLoOWvBfhp5l4pCkFUWdknz6+4m0xh8ls7m+jsm+GTkHZnzhyam4+Dvk1",
-	"m+d2uT2C0GMHIhIqCQqvqFhNpexXMY/D0L035FZpWPU1+bbrzwPH783ge1HwknHIVoLDNpl5hXH4Dj8m",
-	"jxNekwOdUWAZ6tt9g7Tg74DVnmcMNd4Xv7jb3RPatVipr4U8lknUDjhavB9hgdxrbndT3tVOSssyYVp0",
-	"QapdBqCmwXOOSUKVEjlDme2yUFPn+GytkS6itY3+1yH05ghnrztux4YW5z9AHTGUFaEkLxlqkAVXWta5",
-	"fscp6qiipSacuPxjfFhr+cI3SatJE1pMN9Q7TtGBL2iukg4bc0ioab4G8MpLVS8WoHTnrTMHeMddK8ZJ",
-	"zZnGuVbmuGT2vFQg0ZPqxLZc0S2ZG5rQgvwKUpBZrdvSP8ZgK83K0hn0zDREzN9xqkkJVGnyHePXGxzO",
-	"G/39keWgb4W8CVhI3+4L4KCYytLOZt/Yrxi64Ja/dGEM6NFvP3u/2iYpxMQss5UH5v88/M/ztxfZf9Ps",
-	"17Psi/84ff/h+cdHj3s/Pv34l7/83/ZPzz7+5dF//ntqpzzsqQhhB/nlS/cyvnyJz58oGqEL+yfT/68Y",
-	"z5JEFntzdGiLPMRsGI6AHrWVY3oJ77jecENIa1qywvCWu5BD94bpnUV7OjpU09qIjjLMr/XAR8U9uAxJ",
-	"MJkOa7yzFNX3z0zH4qNR0oXX43mZ19xupZe+baip9y8T82nIt2BTsZ0TDMZfUu/k6f58+tnnk2kTRB++",
-	"T6YT9/V9gpJZsUmlSihgk3orxnEgDxSp6FaBTnMPhD3pSmd9O+JhV7CagVRLVn16TqE0m6U5nI/Kcjqn",
-	"Db/kNobBnB80cW6d5UTMPz3cWgIUUOllKkVTS1DDVs1uAnTcTiop1sCnhJ3ASVfnU5j3onPqK4HOvWOq",
-	"FGLMayicA0tonioirMcLGaVYSdFPJ4LDXf7q6M8hN3AKru6cKY/eB998dU1OHcNUD2zWDjt0lGch8ZR2",
-	"8aEthyTDzeKwuXf8HX8Jc9Q+CH7+jhdU09MZVSxXp7UC+SUtKc/hZCHIuQ85fUk1fcd7ktZg7sgoLpxU",
-	"9axkObmJHyQNedp8YP0R3r17S8uFePfufc83o/98cFMl+YudIDOCsKh15rIZZRJuqUzZvlTIZoMj23Rl",
-	"u2a1QraorYLUZ0ty46d5Hq0q1c1q0V9+VZVm+REZKpezwWwZUVqEkDsjoLioZbO/3wt3MUh66/UqtQJF",
-	"flnR6i3j+j3J3tVnZ88weLFJ8/CLu/INTW4rGK1dGcy60VWq4MLtsxJ91bOKLlImtnfv3mqgFe4+yssr",
-	"1HGUJcFurcBKH2CAQzULCFHcgxtg4Tg4/hkXd2V7+cyV6SXgJ9zCdoz5vfYrShFw5+3ak2aA1nqZmbOd",
-	"XJUyJO53JiS0Wxghy3tjKLbA16rL/TcDki8hv3FJ2WBV6e201d07/DhB07MOpmy6PhtEiQmj0EAxA1JX",
-	"BXWiOOXbbuYeZSMqcNA3cAPba9HkmzokVU87c4waOqhIqZF0aYg1PrZujO7mO68yH0vrErBgfKoni/NA",
-	"F77P8EG2Iu8RDnGKKFqZTYYQQWUCEZb4B1Bwh4Wa8e5F+qnlMZ4D12wNGZRswWapTMN/69vDPKyGKl1y",
-	"ReeFHAZUhM2JecrP7MXqnveS8gWY69lcqULR0iaOTTpt4HtoCVTqGVC9U8/P49hGDx0+KW8xuBw1fFOz",
-	"BNiY/WYaNXYcbs2rAhVFto3zXj4Z9j+zgENxR3h89+alcDL41nWoSyRV9LdywG541jrXvJjOEC77fQWY",
-	"lVXcmn0xUAiXUNTmrYnul1rRBQy8XWLr3ciUHy2LHw6yTyJJyiBi3hU1epJAEmTbODNrTp5hMF/MIcZn",
-	"Zsch089kDcTOZoR5wh3CZiUKsMFz1e49lS0rqk18PARamrWA5I0o6MFoYyQ+jkuq/HHElLCey46Szn7D",
-	"COJd2fcuI1/CKO9ryK3nb8MuB+29+10OPp94z2fbix/9IzLnmbcXhi+ktkNwFE0LKGFhF24be0JpckI1",
-	"G2Tg+GE+R96SpdwSIwV1JAC4OcC8XB4TYm0jZPQIKTKOwEbHBxyYfC/is8kXhwDJXU4r6sfGKyL6G9KB",
-	"fdZR3wijojKXKxuwN+aeA7hsG41k0fGoxmEI41Ni2NyalobNubd4M0gvCRw+KDop35zrzaOhh8YO05S9",
-	"8g9akxUS7rKaWJr1QKdF7R0Qz8QmsxHKybfIbDMz9J6MXcB46dTBtOn2HigyExt058KrxfrK74FlGA4P",
-	"RqR72TCF9Ir9huQsC8yuaXfLuSkqVEgyTtEayGVI0Bsz9YBsOUQuD6MMencCoKOGaspROLXEXvVBWzzp",
-	"X+bNrTZtMsP6sLDU8R86QsldGsBfXz/Wznn31ya34XD+NH+iPkmyv75m6T5JGG3nyiZWPCQHY5ccWkDs",
-	"wOrrrhyYRGvb16uN1whrKVZimG/fKNlHm4IS8BGctUTT7CblKWDe8oD3+JXvFinrcPco3z6KHAglLJjS",
-	"0BiNvF/Q76GOp5ghWoj58Op0JedmfW+ECJe/NZtjx9YyP/kK0AN/zqTSGVrckkswjb5WqET62jRNS6Bt",
-	"F0VbT4EVaY6L097ANitYWafp1c377Usz7ffholH1DG8xxq2D1gzrfyQdl3dMbX3bdy74lV3wK3q09Y47",
-	"DaapmVgacmnP8Qc5Fx0GtosdJAgwRRz9XRtE6Q4GGQWc97ljJI1GPi0nu6wNvcNU+LH3eqn5sPehm9+O",
-	"lFxLlOkwHSEoFgsofAY3bw/jUZ68UvBFVKiqqnalBTwhNjsfJtfbkZfPueHDkBN+JO5njBewSUMfvwoQ",
-	"8iayDnMK4iQL4DZdSVotlERN7OKPLSJd3Se2hXYDAJJO0NcdY3bjnWx3KWwnbkAJtHBvEgV+fbuPZX9D",
-	"HOqmQ+7TreSuu48QDog0xXRUu6WfhmCAAdOqYsWmY3iyow4qwehB2uUBaQtZixtsDwbaTtBJgmtlC3eu",
-	"1k7Bfopv3lPzKrO+186x2NA3zV0AflFLtGC0PJv7qenDW23k2r/96UoLSRfgrFCZBeleQ+ByDkFDlPhd",
-	"Ec2sO0nB5nOIrS/qLpaDFnA9HXsxgnQTRJY20dSM68+fp8hoD/U0MO5HWZpiErQwZJO/7lu5vEwfqZLC",
-	"lRBtzR1MVclw/W9hm/1Ey9o8MphUjXuuMzu1L98Ddn29+ha2OPJer1cD2J5dQc3TG0AaTGn6wycV5eh+",
-	"oFpVDPB52drCA3bqIr1LR9oaV3dimPibW6ZVl6G9lPscjMZJwsAyZjeu0r4J5vRAG/FdUt63CazYL4NE",
-	"8n48FVO+Smf/Kgq5KPbR7jXQ0hMvLmfycTq5nydA6jZzI+7B9etwgSbxjJ6m1jLccuw5EOW0qqRY0zJz",
-	"/hJDl78Ua3f5Y3PvXvGJXzJpyr7+6uLVawf+x+kkL4HKLGgCBleF7ao/zKpspYrdV4lNaO4UnVZTFG1+",
-	"SDod+1jcYvLyjrKpV/el8Z+JjqLzuZinHd738j7n6mOXuMPlB6rg8dPYPK3DT9vJh64pK72x0UM74JyO",
-	"ixtXPCjJFeIB7u0sFPl8ZUdlN73TnT4dDXXt4Uk41w+YmjL94uAucSWyIuf8Q48uPX0tZIv5u8jEpPPQ",
-	"bydWGSHb4nHAV9uX6OwKUyfECl6/LH4xp/Hx4/ioPX48Jb+U7kMEIP4+c7/j++Lx46T1MKnGMkwCtVSc",
-	"ruBRiLIY3IhP+wDncDvugr5Yr4JkKYbJMFCo9QLy6L512LuVzOGzcL8UUIL56WTMIz3edIvuGJgxJ+hq",
-	"KBIxOJmubFVQRQTv+lRjEKwhLWT2ruqENcb2jxCvV2jAzFTJ8rRrB58pw165daY0jQk2HtDWmhFrNuCb",
-	"y2sWjWWajcmZ2gEymiOJTJVM29rgbibc8a45+0cNhBXmVTNnIPFe61x1/nGAo/YE0rRezA1s7VTN8PfR",
-	"g+ywN3ld0C4lyE773ctgU/ILTdU1OtADPJ6xx7h3eG87+nDUbKPZlm0XzHHvmDHV4T2jc8a6gTmS1d6Z",
-	"yuZS/AppQwjajxKJMLzhk6Ga91fgKc+9LksJRuWmaH0z+77tHv82Htr4e7+F/aJDYbW7XKbpU33YRt7l",
-	"0avS6ZodkoceYbGHQTs0YIC14PGKnGGx1IX3PqLcniebBaIVYZY+lXEs56kdvzmVDuZe/GtJb2c0VQbH",
-	"vIUMTNH2tvyktCC+s98AFXIc2NlJ5MEd2jKbSa4C2dgg+llp7/iusdOOftE0DxikqPjpMrVuCqUSiWFq",
-	"fku5LZRu+ll+5XorsCZ40+tWSMwDqdIuXQXkbJVUx75797bI++47BVswWwO8VhAVmXYDEZtsEqnIFeoO",
-	"mTscai7n5GwaVbp3u1GwNVNsVgK2eGJbzKjC6zKYw0MXszzgeqmw+dMRzZc1LyQUeqksYpUg4e2JQl5w",
-	"TJyBvgXg5AzbPfmCPESXTMXW8Mhg0QlBk/MnX6BDjf3jLHXLuhruu1h2gTzbO2un6Rh9Uu0Yhkm6UdPe",
-	"13MJ8CsM3w47TpPtOuYsYUt3oew/SyvK6QLS8RmrPTDZvribaM7v4IVbawAoLcWWMJ2eHzQ1/Gkg5tuw",
-	"PwsGycVqxfTKOe4psTL01FSQtpP64bDWmi+J5eHyH9H/tfLufx1d1yd+xtDVQMwWeil/jzbaGK1TQm3y",
-	"z5I1num+JCm59LmFsUZYKA1mcWPmMktHWRId1eekkoxr1H/Uep792TyLJc0N+zsZAjebff48UWurXauF",
-	"Hwb4J8e7BAVynUa9HCB7L7O4vuQhFzxbGY5SPGpyLESnctBRN+2SOeQXunvosZKvGSUbJLe6RW404tT3",
-	"Ijy+Y8B7kmJYz0H0ePDKPjll1jJNHrQ2O/Tjm1dOylgJmSoY0Bx3J3FI0JLBGiPm0ptkxrznXshy1C7c",
-	"B/rf1//Ji5yRWObPcvIhEFk0dwXLGyn+p++azOdoWLWRiB0doJAJbafT231ib8PDtG5d+611GMNvA5gb",
-	"jTYcpY+VAe97614f+vwe/kJdkOyetxSOT34h0rzBUY5//BiBfvx46sTgX562P1v2/vhxOgFxUuVmfm2w",
-	"cJ8XMfZN7eGXIqEA+1JsLBf2DkUuP0JCAZm8pMzNOHNjTEm7NNynFx+OE9iVdjNNk79fP37uIuB35o64",
-	"Y7tONVY4HaV0wjX26lomjdB7vSCiDTCjzqAU5ukUl7qJtdRJsuvcYJ4Cf198m8U7gJPYrllZ/NRkLOuw",
-	"R0l5vkz6vs5Mx5+t5Nm6WCwDSFbPWFLOoUwOZ19sP/uXXeLt+Xcxdp4V4yPbdmur2uV2FtcA3gbTA+Un",
-	"NOhlujQTxFhtJ4MKyQbKhSgIztOUamhOfr8Gc6owZCJqF4dd1dp5Y2KEs0ujM2clOhemraHYMpNUD/AT",
-	"LFTuq+aYcbBuuLKPZzs6SELZCq8bRVdVCXgy1yDNy1/MMVK03R0Tg+HIUR0GoirzCVtiGgZBdC05EfN5",
-	"tAzgmkkot1NSUaXsIGdmWbDBuSfnT87OksocxM6IlVos+mX+0CzlySk2sV9c6SCb4P4gYPfD+rGhqEM2",
-	"tk84rlIiljpO8VRbAxn1HWj7M1eSrZIYKnqekG8wn48h4lYCd1TC+dS47TSRdVUKWkwxZe/1VxeviJ3V",
-	"9rG1322VxgXqoNrknzQajE+b6fMVDeSDGT/O7gQVZtVKZ6GoYirjnmnRlH1kHU8S1E7F2DkhL61iMFTe",
-	"t5MQTPwsV1BENRzt0xSJw/xHa5ovUePWuuaHeeX48qKenTX2iCimLtT0QYZt4HYVRm2B0SnBatu3TAHG",
-	"mcMa2kn+QsZLp/H1Sf/ay5M155ZSDinCHSr4HIp2D5wV07ypPAlZB/EH6ltsleFDq61eYa90hEGndGvH",
-	"lu1TxvnE0eQ7pzLPKRec5ZjgPyUuYkKycca3EbUQ0lYzNXEnNHG4kgVjQ4Srw+JgCVnPCB3i+obs6KvZ",
-	"VEsd9k8NG1dIbAFaOc4GxdTXb3ZmHsYVuBpNhohiPilkwlUn6d4f3AIOJCPMNTSgt/vafPveaXUx1cMN",
-	"46i/cWhzjw9riCkVQ3srJ0yThQDl1tOOUVFvTZ8TzD1YwOb9ySuxYPkVW+AY1jnMLNt6QvaHuvB+kc4P",
-	"0bR9Ydq6jPDh55aTk530oqrcpMPVvZOCpN7wQQSnvHG8e0SE3DB+PNoOctvp0Iz3qSE0WKMvFlR4D/cI",
-	"I1SIbo/ylXlIWYrCFsTGCSbTwjKeAOMV494wmL4g8uSVgBuD53Wgn8ol1fbtMIqnXQMtB9z6Me7WWpbv",
-	"O1Q3H75BCa7RzzG8jU1x6wHGERo0Ej/lW+IPhaHuSJh4QcvgEJwoVY1SlROiCgyZ6RSvTjEOw7h9mf/2",
-	"BbA3KC10xxoTh95EQ5n3ZnWxAJ3RokglbPoSvxL86kOfYAN5HUorhZi3dubtPrW5iXLBVb3aMZdvcM/p",
-	"omrwCWqIK9L7Hcb8MbMt/puqKzS8M84V+OBYU+/3WxyWbr4fO5uSeg1NZ4otsvGYwDvl/uhopr4boTf9",
-	"j0rpPgj1nyLGtMPl4j1K8bevzMURp6PteV3bqyVki0UPZ4HffRqfkOewzZXwKutVz0JbPm5eYss6wPuG",
-	"ScDXtByI744tAPZ+tVrxoSjvfDApAdUu6ZSmZCcLGkzkYz1gOzaFvmFsyOvVOr0eTxfv1roTocMWqW9b",
-	"9ifr+dQwi0G7091MQ80GH2ob6pWc7ws+VlPRNCGhttuoWm8tBjmmSEeqHoQTE1pF7/eU7O9h+OWYm6Ff",
-	"gn86uSwO4p2pmiITO0pyB5IF9YdTrjdp1lH4qYRiTYnFVKX9kd7U11gsP0oZ3x/Le9mtIddYV7PxHpIA",
-	"hySQN5N5/f+/Uq8Pv6yC07nLuL4rzXq/mOYedt/LDBNlN7KFCE/GJxW/CD6iNsTllqomH0UnKHR0aNp8",
-	"Djmmfd2Ziedv5gHeZHmZ+ic6wjKPEvOwEKiBiYsPV0A1AO1KlLMTnqiAyL3BGQrUvYHtA0Va1JCsjBii",
-	"lO6SGRUxYK0hPknukE7RucUwFSgDseB9Hl2u2Sb7/2BS2yiv1B3n8iRpLo4m19SOKdNVnUfNZboelNcO",
-	"Yw6GkvX0i8IOi6IvsQavch5ANGRWjR9s5LJfGeTWZWbFvElBje5ztILyv/kkaXaWkt1AXPYdjRa3VBa+",
-	"xVGy3ti7iaWBnoeZWeOh3rd3J3LNY7BHXgojRmRDETNtp/DgUfVAWde3JkMJwjUHKaEI2vFSKMi08B7t",
-	"u+DYhQrr33cnJKjB+i4WuMHcvm+a5MVY54piLl/q3PriBRIJK2qgk1GK4eE5dyH7hf3uo4x9naO9yoZA",
-	"r/sLbvrYBKZ6SIypfk7cbbk/evkuegfGOcjMGyG6+YZ5O+UUJhYs6txe0PHBCLqZ0clBdrCS5JM976+y",
-	"80aIooBvYHtqHz6+UqnfwRhoKzlZ0KOMip1NPqomRqXgXhwFvN83UVYlRJkN6L0v+0mSuxR/w/IbwCRn",
-	"wYd3oAg1eYjq1mDYvF1ufVLgqgIOxaMTQi64jZrwNs52/bTO5PyB3jX/Bmctapu33OlXTt7xtPs5ZhSX",
-	"9+RmfpjdPEyBYXX3nMoOsicF74YPeV/cJkqyn4x9lfetjt0y2Q1RWShSMsmVNV68wIOeqh6MMd5RMgK0",
-	"aVHijB5ElSLlrHiXOHQzVBpT8WQIkAY+Jhw6QOEGTyIgWfg5cQptbi+X1UvMiYTGnnjX9Gb9GtWpF313",
-	"5jBLm9/NhYRWtWnT26YyDJ79viw8lTOmJZXbuyQh69XI7mlPBrG81zMnOOU0C2kcc/o4LEtxmyGzykIi",
-	"/9TT1rRT7cvYV5Vq+plTPYPIxYcqJ6htyZIWJBdSQh73SAe0WahWQkJWCvT4SRkj59rI3SuMYuGkFAsi",
-	"qlwUYAtipCloaK6ac4piE0QOFkkUWNrBcEjbJ6LjkVMeq0C7TVtjF51Zs9aA8yool6bGYcg27sO7o7h5",
-	"mjfP2QbpBmTqyM+JljVMiWvRLQLsDj6VQLCmPoISaOmWlSVGxrJNZIQLNuw0agfE3kv0sFszdMNoR0lb",
-	"aTguOQ8HVpx3cO4qOk9+VDV6ymCIjJniOVkJpd1L047ULLnxPnqYC66lKMu2UsqK6AtnqPiObi7yXL8S",
-	"4mZG85tH+K7lQoeVFlMfQNr1E2tmkp3cSSOr43dzkdp26DXliPbgEviOkx1cuToC8/1+Drpf536RqvDf",
-	"XlebmaafMRecUC1WLE+fqT+W49Wgu1SKRSWTMtnicTaMHpvhYY8vq2BnRxbZRzNwmqx+dUEcI3D2RmQ3",
-	"5r8ogXfHJXNwjGbgouwzFydFZfmgrNcBACG1sZ26lrbiXCyJBa4iFjYWHK2lXUBH3irolHI/2MwIRwdK",
-	"w72A6jnCBQAfWuXD1CbPsk51M7Hx3x812bXuBPzH3VTeYh5D3j5XDWlJ6+/jM3EMcIR0Dt+drjHXGNc7",
-	"G+sgE6qDjrzhIwCGXWZaMIxynDkUjDllJRRZqrjcZdBRTaOXtovS6dZ8Zspx8pzWvrabGbuW4DJDWBFf",
-	"tu1fFTWkJELzviaZF7AB6+JvC91TZe0e3v4Cpa3p1lEGiCorYQ0tTyKXrqJGUZOtwfdVoTMpACq0RnZ1",
-	"ZCkXmfgu7yhO3NqzyMliDHaTmhSLWLtTZI+aJKnU2fDMHhM19igZiNasqGkLf+pQkaOtBjRHOYGq3hsh",
-	"8+/IsdP8aEd44we48P1ToozHxPtxfOhgFpRG3S4GtNdlrlZDp56nPebiXCzBwIKzFcEQa0m84Ruqord8",
-	"WCHZJ/nmuTVyn5jgEWK/2kCOUo1770DhXjwDRgqX1gGpnQMU9lVguiS07UvghIuoht4tVeGp0iSJ8z/Y",
-	"ibER4+41fQejcuPYdv+dJTgYUZ1sUYMPCRno9O7q+d/lJO48iIPjpWhEgYsE26H/8tTtnh3YAGsVc7Of",
-	"RvbHKnTuFnNcfEpmtR+oLMWtLYoXv0NfgreDWurzJiAnlrNwLXsHvqnLX9hVdbDIdXlFt0RI/Me8Ov9R",
-	"05LNt8hnLPi+G1FLakjIGV6tR4BzCDQT7xavph4wr20Rfiq7bjZ2zGi4rRklAtpc5L56iSAregPxNqCz",
-	"g+WfuTaMU9Uz1FyYK7uznX0suMX7HBQrWsQvfcyE164T7XOjmt7/XxMWFU/lE1hVJc19CURXg6XNZ7DM",
-	"qScuvYTV7ri5Pl/zJBBKpzZEK32gdXEHlemBrCvljD5UX6IFdq+kZK+0xr2WcUj1+SZmfUfE4ailHHsX",
-	"xnrd9ICOC9HtAz+uy/dp8J9MUjm0jDHg/7PgfaASZwyvLbr5CbDcSsaQgNVqq2dik0mYq30OJlZdbZ7z",
-	"sknj4FWsjOcSqLIeN5c/uIdnk4ORcfMQtj6hwaYZRilgznjDLBmvap14x2AqRr6NEBYr/RGtAya0ISnB",
-	"CJNrWv6wBilZMbRx5nTYmnVxDnxv6HB9EyqMcKf2B2CqecNhqF6jRo+bmQvcVtmx7ppKU15QWcTNGSc5",
-	"SHPvk1u6VXe3KAXjwD6bEo2kmXYAeWRdQtK2gJRbZxS+p70nAEiPaPgZYbBBv+CEscaqdrQYsM/0YfhD",
-	"GGxWdJOVYoEBZQMHwiXfRAuffQIKjmpwK5+NW7efR7FfYfc0mHfcMSItcNYxU+w+9z/gVuIz8kfO9M6T",
-	"b3WU3Qg/63drD6ZHKl80zv+WWPrnMRWU6fJwxIGZXtj0geye9iDaRBiwD7X14gO7iG4QLqI3VoKPr+fU",
-	"9rRIhX5azUCGGgO1w70fVOPKTnPnntVXpfVUDRYpUxc4e6Cmzern/b00AJ4tvu3Oenva4DJjxjmkCNbu",
-	"UNmsElWWj/H5tKUJCmcmcJC2YRygj8gIMLDu4B6jQrGOVgqcVtWOQ+uADVYN2WftqvJdj/4hNdEAR2+b",
-	"IMQceZktTY3aLYzkCcqUqX9ee5t0Ww0WmAShREJeS1QT39Lt/rpKAylxr/568dmTpz8//exzYhqQgi1A",
-	"NWmVO3WJGr9Axrt6n0/rCdhbnk5vgg9Et4jz9kcfVBU2xZ01y21VkzOxV5XpEP1y4gJIHMdEPZw77RWO",
-	"07j2/3NtV2qRR9+xFAp++z2ToizTae2DXJUwoKR2KzKhmBdIBVIxpQ0jbFtAmW48otUS1YOY3HRtE4sI",
-	"noPXHzsqYHrA5Sq1kCGHWuRnGObrrEYENlXpeJW19Oxal3unWQ0dCo3oFTMDUonKifZsTlIQYQSRrCFo",
-	"xp3iEzXikY9sYLbWWzZFiM7zPE16cUXg3dy+Xa1Spzm92cSEeOEP5R1Ic8g+MRzCfhdO0qj2/2n4RyIm",
-	"/2hcIyz3t+AVyffB3aqOjwKtH5+dIA8EYCDathUnGQWKRZlWpbUSoD3BG5C74sd3jWF5b1gIQuI77AEv",
-	"Dp9t2oVIBgfO75zB9LuAlGgp74coobX8fRG5nvWGiyTaIqc00RqUZUuiLxZG4dbqRYhiHniV9IKdpRCa",
-	"mJdpWSaCpK0eB89UTDjmSSDXtPz0XONrJpW+QHxA8WY4NCqOlI2RbFGp7pay7RUdNXcUFXu8qflrDMz+",
-	"G5g9St5zbihnhO/dZqjcwZLcC38r2FhvcotjWierJ5+TmasmUEnImeoa92+9cBICQ0GyuXNohY3eE4m6",
-	"b50/CX0PMp57TxzyfWTeCjZ7B2FzRH9npjJwcpNUnqK+Hlkk8JfiUXH10T3XxT0zz98tA0iUy+vADCD9",
-	"uqpjl4frwEunVtBf5+jbuoXbxEXdrG1s+prRCezfvXurZ2OyzqSTzZvumPbmKFnnD8o5/xskvLE4cmO4",
-	"eVMU89NQClSb5nMgTXNnP2pW7nVYaSXd/jidLICDYgrTSv/simN82rvUQ2AzL/SPqoX1PuliLGISa21N",
-	"Hk0VpdMekUnbdUukP8aoxryWTG+xMKpXoLGfk6Vsvwm5PVxumGBLc3efFjcQilM3mUBq5W/XbwQt8T6y",
-	"Jj5ubiFRnpCvbLJnd1D+8mD2J3j25+fF2bMnf5r9+eyzsxyef/bF2Rn94jl98sWzJ/D0z589P4Mn88+/",
-	"mD0tnj5/Onv+9Pnnn32RP3v+ZPb88y/+9MDwIQOyBdRneT+f/O/solyI7OL1ZXZtgG1wQiv2LZi9wbfy",
-	"XGDhPoPUHE8irCgrJ+f+p//fn7CTXKya4f2vE1eAZrLUulLnp6e3t7cncZfTBYb+Z1rU+fLUz4Pl1Fry",
-	"yuvL4KNv/XBwRxvtMW6qI4UL/Pbmq6trcvH68qQhmMn55Ozk7OSJq93LacUm55Nn+BOeniXu+ymmWjxV",
-	"Lov6aROrlbTbvUGXdS+cywUU5GGIuvmPYLlVj3zwzpyVeGX8XVliDKu4LJC4XBHGCZaVQmcsBOvp2Znf",
-	"CyfpRBfOKUZ/nH+YNMW7u8JED6nXDcBJyJqidv1F/8hvuLjlBPPC2QNUr1ZUbu0KWtiIBsdtoguFSnbJ",
-	"1lTD5L3p3cV5Vbnc9UMoxzI+7VPuOyOBhOTn5oTZnOguA71KobyfN/+e2N+ZJ7A3WWJ3sNFrA7NPnxNy",
-	"6zmDkMMZ2owtwsIZsWqHHqKnk6pOoPMrDKxRu3A2jfKxW2hEWQSM9zD6uv4fglFDuu5umpx/MH8tgZaY",
-	"WMv8sTKEmvtPEmixdf9Xt3SxAHni1ml+Wj899a+Q0w8uY8rHXd9OY4+w0w+txDLFnp7e42lfk9MPvibw",
-	"7gFb9WCdr2nUYSSgu5qdzrAO0NimEK9ueClI8+r0Az7AB38/dVrU9EdUhNgb9tQnaBpoaVNxpD+2UPhB",
-	"b8xCdg9n2kTj5VTny7o6/YD/QbL9aE97CalMTrZaAyVN8ylhmtCZkFhiVudLww18bUumopa9I39her2w",
-	"EPhS4eheNDl/24//woGIHwlFFHP/NhJEa6ZGSERzSsQUggjcat8Iwm/Psi/ef3gyfXL28d+MoOv+/OzZ",
-	"x5He8y/CuOQqSLEjG76/J8fr6WyaRdpNCgys/8hwtDAc3+O2qjMQCcjYU8CuM3z/rYQM+PkReXw7BW2C",
-	"v39JC+LTJODcTz7d3Jfc+ogbQdUK1B+nk88+5eovuSF5WnqR7I7C24U9/DFTIG6zU8LbdMIFj5Ip8oUV",
-	"M0QqVcUAv1Ga3oHfXJle/+I3rYY9Kx/G4Vltq6teHfn12MsklDUDn2HWxxbQYk157oOxmugI3C8reTvC",
-	"CA64tYJ5Xfo0JFXJ5rYouBCln0jVVWU4zpyqQFkuJMM8mG0WhTA0qXkuuHWdwugXbwDGbAhoRFY3rGp1",
-	"YXNDVa5ctY3EOvGb/o8a5LbZ9RUzL9/em6lx7vstWbjF4xFYeHugI7Pwpwey0T/+iv9nX1rPz/786SDw",
-	"yYuu2QpErf+ol+aVvcHudWk6Gd6WYjjVG36K7t2nH1rPFfe591xp/950j1usV6IA/4QQ87lC1cquz6cf",
-	"7L/RRLCpQLIVcFt33P1qb45TrHq97f+85Xnyx/46WnmZB34+9RrV1Cu53fJD68/2y08ta12IW1vSMimv",
-	"4PVJS7KinC5sEH9QQpp70A3QpIwmP1ThonKxu4RiJTZR60ZLbENZXEB/sOPjjRa8uRaM4wRokMVZ6Nx0",
-	"pdEF7ooh9nWIVw6y70UBfdkodRE6GFuXYTgKqbKD74+jnYwY78fDDgoajq3XQ5+MzMdadf8+vaVMGwnK",
-	"5W5GjPY7a6DlqavZ1fm1KZPR+4K1P6If46wEyV9PaftctDUoZsuGOvbUK6mvToMw0MgH0/jPjfEmNoYg",
-	"uQQzyNv3ZtcVyLWnpEa3f356itGVS6H0KUqibb1//PF92GhfnDlsuPm2yYRkC8ZpmTklWVN4cPL05Gzy",
-	"8f8FAAD//22BJVXhDAEA",
+	"H4sIAAAAAAAC/+y9f3cbN5Io+lVwuHuOYy8pyY6TnXjPnH2KnWT04iQ6lpN5+2LfGbAbJDFqAj0AWiLj",
+	"6+9+T1UB3ehuNNmUGCe5479ssfGjUCgUCvXz3STT61IroZydPHs3Kbnha+GEwb94lulKuZnM4a9c2MzI",
+	"0kmtJs/CN2adkWo5mU4k/Fpyt5pMJ4qvxeRZ3H86MeKflTQinzxzphLTic1WYs1hYLctoXU90ma21DM/",
+	"xDkNcfFi8n7HB57nRljbh/IHVWyZVFlR5YI5w5XlGXyy7Fa6FXMraZnvzKRiWgmmF8ytWo3ZQooitydh",
+	"kf+shNlGq/STDy/pfQPizOhC9OF8rtdzqUSAStRA1RvCnGa5WGCjFXcMZgBYQ0OnmRXcZCu20GYPqARE",
+	"DK9Q1Xry7OeJFSoXBncrE/IG/7swQvwiZo6bpXCTt9PU4hZOmJmT68TSLjz2jbBV4SzDtrjGpbwRikGv",
+	"E/ZdZR2bC8YVe/X1c/bpp59+AQtZc+dE7olscFXN7PGaqPvk2STnToTPfVrjxVIbrvJZ3f7V189x/iu/",
+	"wLGtuLUifVjO4Qu7eDG0gNAxQUJSObHEfWhRP/RIHIrm57lYaCNG7gk1PuqmxPP/pruScZetSi2VS+wL",
+	"w6+MPid5WNR9Fw+rAWi1LwFTBgb9+Wz2xdt3j6ePz97/28/ns//f//nZp+9HLv95Pe4eDCQbZpUxQmXb",
+	"2dIIjqdlxVUfH688PdiVroqcrfgNbj5fI6v3fRn0JdZ5w4sK6ERmRp8XS20Z92SUiwWvCsfCxKxShbAW",
+	"R/PUzqRlpdE3Mhf5lEnFblcyW7GMWxoC27FbWRRAg5UV+RCtpVe34zC9j1ECcN0JH7ig3y8ymnXtwYTY",
+	"IDeYZYW2Yub0nusp3Dhc5Sy+UJq7yh52WbHXK8FwcvhAly3iTgFNF8WWOdzXnHHLOAtX05TJBdvqit3i",
+	"5hTyGvv71QDW1gyQhpvTukfh8A6hr4eMBPLmWheCK0ReOHd9lKmFXFZGWHa7Em7l7zwjbKmVFUzP/yEy",
+	"B9v+/1798D3Thn0nrOVLccmzayZUpnORn7CLBVPaRaThaQlxCD2H1uHhSl3y/7AaaGJtlyXPrtM3eiHX",
+	"MrGq7/hGrqs1U9V6LgxsabhCnGZGuMqoIYBoxD2kuOab/qSvTaUy3P9m2pYsB9QmbVnwLSJszTd/Ppt6",
+	"cCzjRcFKoXKplsxt1KAcB3PvB29mdKXyEWKOgz2NLlZbikwupMhZPcoOSPw0++CR6jB4GuErAkeqPeBI",
+	"NQ4cJTYJmoHTDV9YyZciIpkT9qNnbvjV6WuhakJn8y1+Ko24kbqydacBGHHq3RK40k7MSiMWMkFjVx4d",
+	"lnFGbTwHXnsZKNPKcalEzqQioLUTxKwGYYom3P3e6d/ic27F508n7/d9Hbn7C93d9Z07Pmq3sdGMjmTi",
+	"6oSv/sCmJatW/xHvw3huK5cz+rm3kXL5Gm6bhSzwJvoH7F9AQ2WRCbQQEe4mK5eKu8qIZ2/UI/iLzdiV",
+	"4yrnJodf1vTTd1Xh5JVcwk8F/fRSL2V2JZcDyKxhTT64sNua/oHx0uzYbZLvipdaX1dlvKCs9XCdb9nF",
+	"i6FNpjEPJczz+rUbPzxeb8Jj5NAeblNv5ACQg7grOTS8FlsjAFqeLfCfzQLpiS/ML/BPWRbQ25WLFGqB",
+	"jv2VjOoDr1Y4L8tCZhyQ+Mp/hq/ABAQ9JHjT4hQv1GfvIhBLo0thnKRBeVnOCp3xYmYddzjSvxuxmDyb",
+	"/Ntpo385pe72NJr8JfS6wk4gspIYNONlecAYlyD62B3MAhg0fkI2QWwPhSapaBOBlCSw4ELccOVOJtPU",
+	"mWwO8M9+pgbfJO0QvjtPsEGEM2o4F5YkYGr4wLII9QzRyhCtKJAuCz2vf/jkvCwbDOL387IkfKD0KCQK",
+	"ZmIjrbMPcfm8OUnxPBcvTtg38dgoimtQL82FFzXgblj4W8vfYrVuya+hGfGBZbidoKx5P63RYK1wx6A4",
+	"fFasdAFSz15agcZ/8W1jMoPfR3X+Y5BYjNth4oJWzGOO3jj4S/S4+aRDOX3C8eqeE3be7Xs3soFRdhCM",
+	"vWiweGziwV+kE2u7lxIiiCJq8tvDjeHbiRcSZyjs9cnkRyuIQkq+lAqhncLzSbE1v6b90Ih3IARh63cR",
+	"0RIO2qhQvczpUX/S07P8Aag1tbFBErWMs0Jah+9qbMxWokDBmatA0DGp3IkyRmz4jkXUMN8aXhIt+y8k",
+	"dkmF73lqRLDe8+IdeScmYW4+xxuNUN2ZLe9lnUlI4EMXhi8LnV3/hdvVEU74PIzVp32chq0Ez4VhK25X",
+	"iYPToe1mtDH0DQ2RZtk8muqkXuJLvbRHWGKhD2FdZfmcFwVM3WdZndXiwKMOclEwaMzEWjrXPBxJw07v",
+	"L/YVz1YgFrCMF8W0URXpclaIG1EwbZhUCrRdbsVdc/hx5PCuwXNkBTA7J1i0Gq9mQhWbqXURRrA1xxto",
+	"Da+Zsmj3qTmo5WvRkYLwRtQVahGih8bFi7A6cSMU8qR6aAS/XiNqa+LBT9h5/QlnVpoWRxpAF8x3Nf5q",
+	"ftECGlo396lqptAmJ521g9+kYZk2NATd8H5y+I/gpulM1PlJacTMD2H4jTCWF7C6zqIe1uR7rNO552Tm",
+	"3PHoZHoqTD/AiHNgPxTvhEloaX7A//CCwWeQYoCSGuqRKIzoyJya08UMqKKZoAHqWzVbkyqTgX7xICif",
+	"N5On2cyok/cVaU/9FvpF1Dv0eiNze6xtwsGG9qp9Qkh3FdhRTxbZyXSiucYg4LUuGbGPDgjEKXA0Qoje",
+	"HP1a+1JvUjB9qTe9K01vxFF2Qm/oP6OYPcL3US71hIWomx4gn+Km4QWu4rsBwG5Mj+dzbe4mMHXuUMUa",
+	"gyrjMGokL047dIBNq3Lm2U/CKEMNOgM1Piy75Zzu8ClstbBw5fivgAXreAT8PbDQHujYWNDrUhbiCKd7",
+	"lZRTQQX+6RN29Zfzzx4/+duTzz4HkiyNXhq+ZvOtE5Z94jWPzLptIR4mDxoKUOnRP38azHDtcVPjWF2Z",
+	"TKx52R+KzHv0wKdmDNr1sdZGM666BnAU0xdwexPaGVmu8VCiIfKVKDTPj6PLLKQYYE7ZiqulyJkVzkm1",
+	"9Ko6kQeZz1RKAbtUOheH3IaIB6DWWYOiUfMTj0SxxfFrwcRiITLnzWCc+VHvcTEHdCQgHLVnEdCZtxgT",
+	"Gw9LAAheiHm1vPI/XBq9OPqd3ZshBSw2uiwNiL+2bc32+3uaQ5NTsXGGn5bYUqgc2RauQ1purVjPj8IX",
+	"hs5u3sySM38ocrGXrx160pppttFpe2G2pjqGEk4Yo02SHkujnc50MYPXiNQJceXSt2C+Rdiusvs7Qctu",
+	"uWUwN9rYK5UPSCVgPB8tZdHQrzeqwc3Og0TrTazOzztmX9rIb97KJbgEbRRD6mwJSwuj14yzHDuiRPyN",
+	"cPRKkGtx5fi6/GGxOI5OXuNACcYl18LCTIxaMAmnP9OKXE73CHB+1DHo6SIm2ELdMAAeI1dblaFB9xjH",
+	"dli2XUuF3iV2q7JI0AUYC5EvhRmBj/GC7BA6aKoHNgEOoOMlfkaL0gtROP61Nq+bR9Y3Rlfl0dlzd86x",
+	"y+F+Md5mlUPfYKyQalm03ZyXAPtJao2/yYKe16ouWgNCjxT5Ui5XLtJqXBr9K9yJyVlSgOIHUmkW0Kev",
+	"2Pxe58BMXGWP8BpoBms4HNBtzNf4XFeOcRS0cPMrm34nDDjGolyCjoQufnqgFk1aNhdAXRmvYLVVydBN",
+	"rndfNB1nPKMTOkPU2PSEjXcXtaLpyOmyMILnoLIUium598TxPkK4SI4+fi5I2v6VkuAXLbhKozNhLRg7",
+	"yS6xF7TQjq4OtwNPCDgCXM/CrGYLbu4N7PXNXjivxXaGHqmWffLtT/bhbwCv044XexCLbVLo7Wp9+1CP",
+	"m34XwXUnj8mO9MlEtcxpfFgVwokhFB6Ek8H960LU28X7o+VGGHR8+lUpPkxyPwKqQf2V6f2+0FblQJyF",
+	"17SAhAcbprjSQbBKDVZw62b72DI0itdiYQURJ0xxYhx4QPB6ya0jZz2pctS803WC82AfnGIY4MFnCIz8",
+	"U3iB9MfOtLJC2crWzxFblaU2TuTNZM0aUD87ONf3YlPPpRfR2PWbx2lWWbFv5CEsReN7ZPkXMP7BXa2N",
+	"9frd/uLQ8wPu+W0SlS0gGkTsAuQqtIqwG/uaDwAibYNoIhxpO5RTO7hPJ9bpsgRu4WaVqvsNoemKWp+7",
+	"H5u2feIiUxzOyXItLJr5fHsP+S1hlqIMVtwyD0dQuKNGjrwK+zDDYZxZqTIx20X5+MSDVvER2HtIq3Jp",
+	"eC5muSj4NmEqoM+MPu8aAHe8ee5qJ2bkLp7e9IaSg3fujqE1jpdgmt9rhl9YBkcQngINgfjee0bOBY6d",
+	"Yk6ejh7UQ+FcyS0K4+GyaasTI+JteKMd7Dg1IpA9Rx8D8AAe6qHvjgrsvEMh+T/C+glCmztMshV2aAnN",
+	"+ActYECd7yPxovPSYe8dDpxkm4NsbA8fGTqyA7aFS26czGSJb51vxfboT7/uBEn3DpYLxyUoGaMP9Aws",
+	"4/6MHJ27Y97tKThK99YHv6d8SywnOJO1gb8WW3xzXwphvuTqKOZafoAe0c+730LORyoJQYYqhTBszhWu",
+	"OazuUh5ldaU8cHWXcv/qSnnI6qSC1zEsst66o6xMCHPY0sB9cf/acNixi8u0UiJz7fWhK0+khTuGmiUx",
+	"KpMUswnLCCEj8DqMm4gNz1yxZRzlwy27FUYwW83JB6xvrQVPr3iApPV3x4zevSXpXLLT3+YKh4qWlzK7",
+	"0XN1N3yvO2/WFjr8M7XUuhihvO0hIwnBKOc7VmrYdenjR0MEYWByLSC9PFFsA7heionRjCtg/6MrlnGF",
+	"2oDKiVrc1gZlWOiLM0gbzem9uxsMiUKsBSk58MujR92FP3rk91xathC3Iej60aM+Oh49QhXjpbauxfeP",
+	"ceC5cRcJyQbN4iCT+Qdy97rb7zLqRx6zk5edwcOkeKas9YQLy783A+iczM2Ytcc0Ms5d1m1Grvx128Gy",
+	"t27c9yu5rgrujmFQFTe8mOkbYYzMxV7+7ieWWn11w4sf6m4YUC4yoNFMzMioPXIs8Rr6kMMCjCOVdDJE",
+	"TY0FSFxQryvqtEf70bhUyfVa5JI7UWxZaUQmcjIISctsvdQThsN6mz2weKOrpffConGQ4UOAPoZEV6o3",
+	"RFLedxs1Q/tL6gLwfr4hZhwkfcFB29A13tDb+pbX84m8dS+M3IOuMStpv51OBpUxgNSbRhlDyGkHvo+4",
+	"DFpPkQg/zcQjrXyIOhDL+/iKtwUOE2zur2NNaoZOQdmfOAqZaD4ORU2AJqjYHkHooYGYEaURFq+oWINq",
+	"6atexEkugq/11jqx7huZqOvfBo7fq0FVhlaFVGK21kpsk3mdpBLf4cdUb7omBzqjwDLUt/s8bsHfAas9",
+	"zxhqvC9+cbe7J7RrTLVfa3Msaz0NOFroH2Ec3/se8FPe1YQPvvx9q7cPge8yADut/XKlYdxanUmU2S5y",
+	"O6WD5g3lPl6+jf7LOrDvCGevO27HvBtnV0HzhShKxllWSDRuaGWdqTL3RnFUn0ZLTbiIBj3RsEL9eWiS",
+	"1uAnFOx+qDeKo3twrVRN+hItREKD+LUQQa9uq+VSWNd56yyEeKN8K6lYpaTDudZwXGZ0Xkp42m+dOKGW",
+	"EOiyAJpwmv0ijGbzyrWlf8zwYB2o58nWDNMwvXijuGOF4Nax7yR4MsFwwR8lHFkl3K021zUW0rf7Uihh",
+	"pZ2lXVm/oa8YGOWXv/JBUvB/3zl47TcpZyawzFaWqf/1yX8/g+xSfPbL2eyL/zh9++7p+4ePej8+ef/n",
+	"P//v9k+fvv/zw//+99ROBdhlPgj5xQv/Mr54gc+fKNapC/sHM01B0pIkkcWORh3aYp9grh1PQA/belu3",
+	"Em8UeJE5DameZM7d3cihe8P0ziKdjg7VtDaio6cNaz3wUXEPLsMSTKbDGu8sRfW9v9OZPmAjQ/IOaMUW",
+	"laKtDNI3BbIH10e9mNbZXCjR4zOGqT5WPLiQ+z+ffPb5ZNqk6Ki/T6YT//VtgpJlvkklYsnFJvVWjKPM",
+	"HlhW8q0VLs09EPaklye5HcXDrgUoGexKlh+eU1gn52kOF2I+vc5poy4URUjB+UHr+9Yb9fTiw8PtjBC5",
+	"KN0qlQCuJahhq2Y3heh4REHYj1BTJk/ESVfnk8N70fubFoIvap94rce8hupzQIQWqCLCeryQUYqVFP10",
+	"4sP85W+P/hzyA6fg6s6ZcjZ/8M1Xr9mpZ5j2AWLLDx1lcUk8pelD21fOMd4Kyn2j3qgXYoHaB62evVE5",
+	"d/x0zq3M7GllwR5RcJWJk6Vmz0JA+wvu+BvVk7QGM9NGWSdYWc0LmYGpJUWelG2wP8KbNz+DVvfNm7c9",
+	"t6H+88FPleQvNMEMBGFduZnPlTYz4pablFnW1rmycGTsvXNWErJ1RQpSPz7z46d5Hi9L282Z019+WRaw",
+	"/IgMrc8IA1vGrNN1QK+0dU4E2N/vtb8YDL8NepXKCsv+vublz1K5t2z2pjo7+1SwVhKZv/srH2hyW4rR",
+	"2pXBnD5dpQounJ6VGEYxK/kyZf198+ZnJ3iJu4/y8hq2AARd7BbjpA5fwqGaBQR8DG8AwXFwdgVc3BX1",
+	"Cnlx00vAT7iF7QwW99qvKAHJnbdrTxITXrnVDM52clUWSDzsTJ0uc8mlssFRyMolvlZ9ZtE5qBRFdu1T",
+	"Pop16bbTVne9aAmagXVIS8lAKUQb09GhgQKShJY596I4V9tuXjAfX4SDvhLXYvtaN9nsDkkE1s5LZYcO",
+	"KlJqJF0CscbH1o/R3Xzv8Bgi9X16J4x+D2TxrKaL0Gf4IJPIe4RDnCKKVt6kIURwk0AEdhhCwR0WCuPd",
+	"i/RTy5MqE8rJGzEThVzKeSqP+V/79rAAK1ClT93qHeTrAS2YyKSzbE4Xq3/eG66WgnH0fCq15QWlpU76",
+	"E+F7aCW4cXPB3U49v4ojpwN00J/dwskiDd8UliA2sN/SocZOiVuRe0URtfGO9SfDrpEEuMjvCE/o3rwU",
+	"Tgbfuh51iZSt4VausVs/a73XaExnr1f197XAnM/6FvYFoNA+XTFlxYrul8rypRh4u8TWu5EJhVoWPxxk",
+	"n0SSlEHAlaUtavQkgSTI1HgGa06eYQFf4BDjM7PjKxxmIgOxtxlhFQKPsHmBAmztVE17z03LiqqWu0BL",
+	"sxZhVCMKBjDaGImP44rbcBzzacRlR0lnv2J+gl25PS8iN9coq3SduTPchl0O2nv3+wyfIa1nyOUZP/pH",
+	"5OWcTogBJLdDKxRNc1GIJS2cGgdCaTLONRsEcPywWCBvmaU8ZiMFdSQA+DkEvFweMUa2ETZ6hBQZR2Cj",
+	"4wMOzL7X8dlUy0OAVD5jHg9j4xUR/S3SMacUQwLCqC7hcpUD9sYscACfy6eRLDrO/jgMk2rKgM3d8EIo",
+	"F97izSC9FJP4oOgklPSuNw+HHho7TFN05R+0Juxxp9XE0mwAOi1q74B4rjczyn+QfIvMN3Og92RYDfRK",
+	"HkxK5vnAsrneoKchXi0UxrEHlmE4AhgNAJilEdaO/YbkLAJm17S75dwUFVr2SS11NuQyJOiNmXpAthwi",
+	"l0+i/Jx3AqCb76BO5uvVEnvVB23xpH+ZN7fatMk7HSIWU8d/6Agld2kAf339WDuj5l+azKnD2Rl9ow+T",
+	"SrSvWbpPilfqjIDYgzK8dsmhBcQOrF525cAkWlutOniNsJZiJUyqhFGyjzYrCoGP4FlLNJ1di236LS/w",
+	"Hr8K3SJlHe4eV9uHkQOhEUtpnWiMRsEv6LdQx3PMP6/1Ynh1rjQLWN8rrevLHzuSMr61zA++AgwOWUgD",
+	"UQhgcUsuARp9bVGJ9DU0TUugrc1mVK1F5mmOi9NCPGEuiypNr37eb1/AtN/XF42t5niLSUUOWnOsLpT0",
+	"qd8xNYVd7FzwS1rwS3609Y47DdAUJjZALu05/iDnosPAdrGDBAGmiKO/a4Mo3cEgo1wIfe4YSaORT8vJ",
+	"LmtD7zDlYey9XmohI8PQzU8jJdcS5VFNB6/q5RKC+Ch3WLCHqSgLZ6HVMiqDV5a7ko6eQO0F61N37sj6",
+	"6d3wxZATfiTuzyRYbNPQR80I8iboEzOW4iRLoSiTTlotpJd7XPyxRaSr+8C20G4AQNIJ+nXHmN14J9Mu",
+	"1duJG1AIHjKAWRHWt/tY9jfEo2465D7dSh29+wjhgEhT0kWVofoZMgYYMC9LmW86hicadVAJxg/SLg9I",
+	"W8ha/GB7MNB2gk4SXKsWgXe19gr2U3zznsKrjHyvvWMx0DfPfG6IvDJowWh5NvcLX9RvtZFr//anK6cN",
+	"XwpvhZoRSPcaApdzCBqishKWOZ/BLpeLhYitL/YuloMWcD0dez6CdBNEljbRVFK5z5+myGgP9TQw7kdZ",
+	"mmIStDBkk3/dt3L5trEqqb4Soq25g6kqmUniW7Gd/QRKB1ZyaWzjnuvNTu3L94Bdv1l/K7Y48l6vVwBs",
+	"z66g5umVQBpMafrrTzaqAPDAxhij52VrCw/YqfP0Lh1pa3xVm2Hib26ZeEWdpdznYDROEgDLmN24Svsm",
+	"wOkRbcR3SXnfJsh8vwwSyfvxVNKGGsD9q6hOk7KPdiHHYSBeXM7k/XRyP0+A1G3mR9yD68v6Ak3iGT1N",
+	"yTLccuw5EOW8BP8tXsy8v8TQ5W/0jb/8sXlwr/jAL5k0Zb/+6vzlpQcfTNKF4GZWawIGV4Xtyj/MqqgO",
+	"zu6rhMoleEUnaYqiza9T2sc+FrdYGqGjbOpVlWr8Z5rxgs/FIu3wvpf3eVcfWuIOlx9R1h4/jc0TO3ec",
+	"fPgNl0UwNgZoB5zTcXHjSpMluUI8wL2dhSKfr9lR2U3vdKdPR0Nde3gSzvUDZk1NvziUz6mKrMg7//Cj",
+	"S09fa9Ni/j4yMek89OuJVSBkEx4HfLVDAeCuMHXCSPD6+/LvcBofPYqP2qNHU/b3wn+IAMTf5/53fF88",
+	"etQHmm67NJNALZXia/GwjrIY3IgP+wBX4nbcBX1+s64lSz1MhjWFkhdQQPetx96tkR6fuf8FzLHw08mY",
+	"R3q86YTuGJgxJ+hqKBKxdjJdU81hy7Tq+lRjECyQFjJ7X9OGjLH9I6SqNRowZ7aQWdq1Q80tsFdFzpTQ",
+	"mGHjAW0tjFjJAd9cVcloLGg2Jp1vB8hojiQybTKjcIO7ufbHu1Lyn5VgMhfKwSeD91rnqguPAxy1J5Cm",
+	"9WJ+YOwTDX8fPcgOe1PQBe1Sguy0372obUphoamqaQd6gMcz9hj3Du9tTx+emimabdV2wRz3jgkGvaT6",
+	"wFsQA6PzxrqBOZoCrdiPUhdJO1sY/YtIG0LQfpRIhOEnwucI9k557nVZSm1UDuuJZ9+33ePfxkMbf++3",
+	"cFh0XbbxLpdp+lQftpF3efTadCbx6SQ+kmm46CNrhwYMsBY8XpEzLBbSCd5HXNF5oiwQrQiz9KmMWthT",
+	"Gr85lR7m7q5mBb+d8+w6/RYCmKLtbflJOc1C57ABts5xQLOzyIO7bispyWEpTGOD6CdMvuO7hqYd/aJp",
+	"HjDQsfV0mZKbQmF1YphK3XLlRHBjIH7le1tBJnjodasNpii1aZeuXGRynVTHvnnzc5713XdyuYSZKIFn",
+	"VMLeD8QoDypSUS5tWfBtnbnDo+Ziwc6mzZkMu5HLG2nBkRlbPKYWc27xuqzN4XUXWJ5QbmWx+ZMRzVeV",
+	"yo3I3coSYq1m9dsThbzaMXEu3K0Qip1hu8dfsE/QJdPKG/EQsOiFoMmzx1+gQw39cZa6ZXOx4FXhdrHs",
+	"HHl2cNZO0zH6pNIYwCT9qGnv64UR4hcxfDvsOE3UdcxZwpb+Qtl/ltZc8aVIx2es98BEfXE30ZzfwYvC",
+	"Rrmwzugtky49v3Ac+NNAzDewPwKDZXq9lm7tHfesXgM9NfXpadIw3AmeDeLpNVzhI/q/lsH9r6Pr+sDP",
+	"GL5O0wNHL+Xv0UYbo3XKOOWlLWRw7hF1wWN2EdJeYymfuvAg4QbmgqWjLAlbiJWgpHKo/6jcYvYneBYb",
+	"ngH7OxkCdzb//Gmikl+7EpQ6DPAPjncjrDA3adSbAbIPMovvC1HwaraWwOofNjkWolM56KibnNYN+YXu",
+	"Hnqs5AujzAbJrWqRG4849b0IT+0Y8J6kWK/nIHo8eGUfnDIrkyYPXsEO/fjqpZcy1tqkalk0x91LHEY4",
+	"I8WNyAc3Cca8516YYtQu3Af639b/KYickVgWznLyIRBZNHcFy4MU/9N3TVJ+NKxSJGJHB6hNQtvp9XYf",
+	"2NvwMK1b135LDmP4bQBzo9GGo/SxMuB9jz83fX4Lf6EuSLTnLYXj478zA29wlOMfPUKgQe9ITf/+pP2Z",
+	"2PujR+nc2EmVG/zaYOE+L2Lsm9pDqBzbZwV6Q1w4OBT5/Aj9/UtfUnAzzv0YU9YuPPnhxYfjBHal3UzT",
+	"5B/Wj5+7CPiNuSPu2K5TjfWTRymdcI29qrlJI/ReL4hoA2DUuQCnSduqwhThPU12nRssUOBvi29YvAc4",
+	"ie1KFvlPTcayDns0XGWrpO/rHDr+jSTP1sVCDCCFNbCjKVEkh6MX29/Cyy7x9vyHHjvPWqqRbbsJz2m5",
+	"ncU1gLfBDECFCQG90hUwQYzVdjKoOtlAsdQ5w3maKiLNye9XeE/VLO2TIA27rpz3xsQIZ59GZyEL+N+A",
+	"NRRbzgx3A/zEYHTeohlR3Aiwv+AzhEYXhnG5xuvGcijthCfzRhh4+esFRoq2u2NiMBw5KhHCbAmfsCWm",
+	"YdDMVUZBJcVoGUI5aUSxnbKSW0uDnMGyxAbnnjx7fHaWVOYgdkaslLAYlvlDs5THp9iEvviqVlR74SBg",
+	"98P6vqGoQza2Tzi+iCcWUk/xVPxA8ZjQGa8kKuBZF5s9Yd9gPh8g4lYCd4CmTo3bThNZlYXm+RRT9oK/",
+	"CaNZqY8RiCgsILoE+DvknzQajE+bGfIVDeSDGT/O7gQVsGrrZnW9z1TGPWjRVCSVHU8S1E7F2DlhL0gx",
+	"aIPaiSahasZmLfKovCg9TZE44D/O8WwFDXTrmh/mleMr3wZ21tgjopi6m/ARGTbA7YvfUu3bKcNa/rcS",
+	"kvCuuBM3op3kL4ARNL4h6V97eaHWtVSHlPivi0sdivYAHI5bm8qTkHUQf6C+hWqYH1oI+Ap7pSMMOvUy",
+	"OrbskDIuJI5m33mVecaVVjLDBP8pcRETko0zvo2ohZC2mtmJP6GJw5WsZVxHuHosDlY3nk5aiOsbsqOv",
+	"sKlEHfSnExtf424pnPWcTeTTUB3em3mkssKXDwMiivmkNglXnaR7f+0WcCAZYa6hAb3d1/Dte6/VhSPI",
+	"rqVC/Y1Hm398kCGmsBLtrYpJx5ZaWL+edoyK/Rn6nGDuwVxs3p681EuZXckljkHOYbBs8oTsD3Ue/CK9",
+	"HyK0fQ5tfUb4+ueWkxNNel6WftJknGa9w6mK24MITnnjBPeICLn1+PFoO8htp0Mz3qdAaFAqgFknSryH",
+	"e4RRFy9vjwKFAiqiKGzBKE4whZRCqgQYL6UKhsH0BZElrwTcGDyvA/1sZrjLVi02tM8NcsCtH+Nus+tj",
+	"DNXZYEQJrjHMMbyNTd31AcZRN2gkfq62LBwKoO5ImICgvtrBtF9FHaUqL0TlGDLTqaueYhzAuGchELCF",
+	"rr1BaXV3rDFx6E00lHlvXuVL4SCrWyph05f4leHXEPoEdS6quupXHfPWzrzdpzY/UaaVrdY75goN7jld",
+	"Li23VqznRcIZ8kX9UeT1DgOlgb0A/k3VFRreGe8KfHCsafD7zQ9LN9+PnU1JvUDTM8gqNB4TeKfcHx3N",
+	"1Hcj9Kb/USk9BKH+LmJMO1wu3qMUf/sKLo44HW3P65quljpbLHo4a/we0vjUeQ7bXAm+9atnoS0fNy+x",
+	"ZR3gQ8Mk4De8GIjvji0AdL+SVnwoyjsbTErAnU865TjbyYIGE/mQB2zHptA3jA15vZLT6/F08X6tOxE6",
+	"bJH6tmV/Is+nhlkM2p3uZhpqNvhQ21C3akZC8MEWEez+NdRToAy8b1oMckyRjlQ9CC8mBLUJUZnPV0NF",
+	"Mnr1NXoYfjHmZujh4/10cpEfxDtTNUUmNEpyB+Ry5TAl+V8Ez4W53JNyvUmzjsJPqa2sL2ZWwGA+x+UK",
+	"hzsZ600NKj0Zp4zvjxW87G5E5rDka+M9ZIQ4JIE8TBb0/x9Trw+/rGqnc59xfVea9X6d1z3svpcZJspu",
+	"RIUIT8YnFT+vfUQpxAUqgdX5KDpBoaND0xYLkWHa152ZeP4KD/Amy8s0PNERlkWUmEfWgRqYuPhwBVQD",
+	"UMHvCE/BjwfOUKDutdg+sKxFDcnKiHWU0l0yoyIGyBoSkuQO6RS9W4y0NWUgFoLPI3UXTfb/waS2UV6p",
+	"O84VSJLxONfUjinTBcdHzQVdD8prhzEHQ8l6QjXi/smraworkROv8ZVqvYr5DqcZLeM04MVlE8tnWCHn",
+	"5ZOSZhygKbEppUmJeD8quWlU8ljjypuypo3nFJVGXqApiXxs8Z4Hj1uu0N7F1UBM1JqrKkWEwdk4DA8b",
+	"RY/ppoaiYOeXF1NmuG/JFU67lnYuVvxGapN2PzaC25RA/NfV1lccEAYnJGyOiGyrqcEvZ4gWsMDxEDGE",
+	"LScntFC22OkDSYBHTL1eSTwe4w7TVcce+9JFTSSlK0rHHIRWVH39jgQTaF3TxkLbeSHtSgykHPMPJXjv",
+	"8+QNWXDg8LUVsxQmPKEZ9qlLzwqSiCKT7JStBbdVSDWmK7fUcAhvxdyCldZF0A4QsbebpPejMaqE6ePt",
+	"gPqpU3aL57R8UiZxHkDafUqQdNY8Fx0Mp88AQDIbuovaDGPqD7MwXcBpTU/KsMYk9FRefDfsAS/UduDU",
+	"6lQGeYO7u9DNOLbBRgRt5ZjT01CYqt2e/GNi+j9JC598sZDZYHor+MjEhiKqo1BpmGQKElqgSMeXo3PC",
+	"ANd4zZevafj9dqaaEwWa9IiLqKjekv5pbhY5xMEu5fBtRsPGsqi3lGpzIA+LStIHFvUrXFulTF9bUtm6",
+	"Ag0HbepgId4B7A8hL9rIQ4iI12wLoK6T4VGNWr5kuHcj+GrCPXQWcrontMrwvc75TjJKIOcdWqKZFcoN",
+	"jWajdNPD44QV7gDuu4CEQ+Crx02DWI85DkrHl+ldjI453rM/fv9tzXDqzQsZZip1rfStCjyhR+G3UuX6",
+	"1u6ml5oX20JiaIbvVfvuxGdyyuwKfrSO3lmHsSKa8a84/l5uBCiadgmtRSepze5uVIOEwXPVguqYRwsx",
+	"yzt4/XiWjn2WPG9Ob11gwEGGo02IWPoIzhwmuD81pmkQc5RHWtFhi8ML4bgsrA/04HUBjdguxy5opdFv",
+	"yM8BKEyPW3tLhVIcwobfQi5smqWQ174OFj5+yTcN0qeHFkdJborNwDqfAnpRzyybQOS+W3OfKCimPyu0",
+	"hfzvQ4kR2rG/deDMA0sRTk0iSoRrIYwRee0EVWgrZk4nZIweHLtQYTGM605IsINlPAm4wRIur5oaNVjO",
+	"mGPJFu6jt+IFMiPWHKAzUSWZ4Tl3Ifs5fQ/JpMKtttemXNPrbG/EQAhBl7aHxJjqF8wrRfcnqbqLeVkq",
+	"Jcws+Jp1y8qodmZhzB+fVxnds/HBqE3wB1yyg6wkaZnN+qvsmIKiZE/XYntK9i26BG29gzHQpCAn0KPE",
+	"+Z1NPqrB3abgXh4FvN82H3KpdTEbcG+66NfC6VL8tcyu/es1hGqCCPegfTZgEvYJetXU/qu3q22o/VKW",
+	"Qon84Qlj54qC44Mra7tMdmdy9cDtmn+Ds+YVlafyZvSTNyodZYyXqbknNwvD7OZhVqj83lPRILsnchs1",
+	"5GR/i0Wm2tXoT8YaX/vOpR1hJiIqgiIlk1yRj9pzPOipJzum8opyzqHrImfet43ZQqdi0u6SbgyGSmMq",
+	"ngwBcmLMO7uBwg+eRID329+T2tp/Dsmb9YIZ0biN3jWLtU8MTazZDhluuzPXs7T53UIbEc+IYSmUsT6c",
+	"SmQ46Kxt5tIZbrZ3yTXdRlXqdTeI5b0BGHXsRbOQJv6ij8Oi0LczZFazul5byoIJ7Wz7Mg6qm6YfnOq5",
+	"iCI5uPWC2pateM4ybYzI4h5pFSRBtdZGzKAyQVIl+xIUSqyQa+ksw3JgS6ZLsJpT3cM0BQ3NVSnFUWwS",
+	"kR99EgVEO7BS3yei45FTwp1KnmMzFLX2lgkKm/8a+lAGpiY7KS16Rt6LAzGKwvpspB5D1LgPLxIOpe/r",
+	"uoykefNCbpBuhEkd+QVzBoJHfQscvUVCePC5EWwtrSVQalq6lUWBCZDkpuEHonZVHlJeJ8XeCwykupHo",
+	"bd9OhoU9QMjNRJ0hLOYBV3H6zsgoFgql1HAGy6apvN0zHuVHW2FABGZCgCmesrW2zr80aaRmyU2QySeZ",
+	"Vs7ooujoe4luvD/ad3xznmXupdbXkNTqIb5rlXb1SvNpyBPUDQdqZjKdFLntC3iGNGD3l5ygdjBL4AKj",
+	"GWSHxfV8n/ZqwRow3+7noPtdq877C+uuq81M08+Yc8W402uZpc/UHyu+ZjAqJsWiUqigHnTwiYjxsMeX",
+	"Ve1OjSyyj2aheLLI8TnzjMC7lZKZsXQkgXfHZQvBXW/u6KLsMxcvRc2yQVmvAwBCSil8XGWosHgsidVc",
+	"RS/JRoe60i6gI28VjD24H2wwwtGBcuJeQPXinWoAPyHlw5RyJFPsFMTL++8PG8vgnYB/v5vKW8xjKKjj",
+	"qiEtg03qhIsDHCFdqmVnBMRrTN80HxsHYYMSfuQNHwEwHBnRgmFUfMShYCw4BMjNuBu43FFHNY1e2j4Z",
+	"QzR6KAOLs7CMV6GEN4xdGeETAJKIb9pujiV3q3B1QvO+Jhm0ksKiMPOLMJpqc08jNztRUOnujjJAl7NC",
+	"3IhWwAjRsq1Q1AR/C9/X1p1ZLkSJBpWujiwVCRHf5R3FiV/7LPKlH4PdpCaFEEs7xfaoSZJKnY2a0TGx",
+	"Y48SQHQj84q38GcPFTnaakA4yglU9d4Is/COHDvNjzTCqzDAeeifEmUCJt6O40MHs6A06nYxoL2RUZUd",
+	"OvUqHRgVp9ysDSw4W1772xKJN3zDlvxWDSsk+yTfPLdG7pPUKkLsVxuRoVTj3zvoxAHvmQEjhc/eh9Su",
+	"hMjpVQBdEtr2lVBM6ebZg9rI8FRpcoGHH2hibCSVf03fwXe4iV+6/84yHIzZTlLgwYeEqen07ur53+Qk",
+	"7jyIg+OlaMQKn/Bjh/4rULd/dmADXRU5U7CfIPtjsXF/i3kuPmXzKgwE2gryMYzfoS9EsINqFZuAaEUh",
+	"my7qgAnddIP1VR0yilAFR21t8B+lHftnxQu5IGcxAj90Y3bFgYS84ZUcv33cF0y8W7yaBsCCtkWHqWjd",
+	"cuyY0XBbGCUCGi7yUKRSszW/FvE2oE878c/MAeO01Rw1F3Bld7azjwW/+JBqEF0Im/c3JjzftrhDKIEB",
+	"vf+ryX4RTxXyFJcFz0TeKrXZ5jMgDNXE5VZivTs9Sp+vBRIIrSKiNSGfVn4HlemBrCsVczxURrAFdvSM",
+	"aFcRPM4yRmp+O7XidiSWGbWUY+/C2OCKHtBxvfF94Mfl1z8M/pO1CIaWMQb83wve6yKew/Bikw+B5VbO",
+	"vQSspK2e683MiIXd52CCrQH4BmBbq1ilyozgljxuLn7wD88m1b5U8BCm0L/aplmPkouFVA2zlKqsXOId",
+	"gxn31TZCWKz0R7QOmNCGpAQQJm948cONMEbmQxsHp0Mv4sIAAEkwdPi+CRVGfaf2B5C2ecNhRpZGjR43",
+	"gwuciqmSZ5p1XOXc5HFzqVgmjOMSbNdbe3eLUm0c2GdT4pE0084TFlmXkLQJkGLrjcL3tPfUAPIjGn5G",
+	"GGxer4Sn/raxxkfl6AH7TB+GP4TBZs03YOPDvCEDB8LXWEALHzZjWqEanOSzcesO81j5i9g9DZaX8ozI",
+	"aZx1zBS7z/0PuJX4jPxRSbfz5JOOspvIhcIr6WAGpKplE+NNxNI/j+WAD3zZzr8ThM2QryzQnog2cShq",
+	"qK0XH9hFdIPwiZtiJfj4sr1tT4vEDeM1AzPUGNgdUdzCNhHLPPPuWX1VWk/VQEiZ+vxIB2raSD8f7qUB",
+	"8ADRIkTgtaetXWZgnENqHe/OiDQrdTnLxvh8UgW6nAAIkLZhHKCPyAgwsO7aPcbWNRljamwXZzy03PNg",
+	"cch91q4y2/XoH1ITDXD0tglCL5CX4REm5Zg2sTJlGp7XwSbdVoPVTIJxZkRWGVQT3/Lt/vK5A5VPrv5y",
+	"/tnjJ3978tnnDBqwXC6FbarndMrPNn6BUnX1Ph/WE7C3PJfehJBvDD/X9seQO6PeFH/WiNvaJjV+r/ju",
+	"IfrlxAWQOI6Jsqd32iscp4ng/n1tV2qRR9+xFAp+/T0DN4109bJarkoYUFK7FZlQ4AVSCmOldUK5jgVU",
+	"usYj2q5QPYg1LG4of6QO4WoNFUg34HKVWsiQQy3yM/hUh2CJTVl4XnXrg7uH1+XfaaShQ6ERvWJAi6VL",
+	"L9rLBUtBxFB/XolaM+4Vn6gRj3xka2ZL3rIpQvSe52nSA58NfAnrBdvN7RtDYWDUCU4Pm5gQL8KhvANp",
+	"DtknhjOV3YWTNKr93w3/SKReOxrXqJf7a/CK5PtgR2qp857fQ512bBRo/TRcCfJAAAaSKrXS4UT5QKKC",
+	"GoasBGhPCAbkrvjxXWNY3hsWgpCEDnvAi7MkNe26AaC/caGK72qkREt5O0QJreXvS7xUR7+GiyTaIq80",
+	"cU5YYkuJ3BZRVi37vE5WNfAq6eW0Mlo7phXoRhK5sEiPg2cqJhypnDA3vPjwXONraaw7R3yI/NVwaFSc",
+	"EClGMqHS3i0z90s+au6C/wpTq0vMv/VXAXuUvOf8UN4I37vNULnDC3KvXtTWaKHYLY6JO80ef87mvmhc",
+	"aUQmbde4fxuEkzr/jzBgHcMpxMbtSTi0b50/aXcPMl4ETxz2fWTeqm32HsLmiP7GTGXg5CapPEV9PbJI",
+	"4C/FoyAl8rgqY/ctMHa3RI9RyuYDEz3GK8OU2qOXh+vAS6eyor/O0bd1C7eJi7pZ29gspaPrlEEpyPmY",
+	"5KLpmmLQHbObHqW42EGlxX6FvKaEIz+GnzdFMT8NVbqgag4D1Xg6+wGFe/Za1eLaShBwK5Sw0mL1oL/5",
+	"Gogf9i4NEFBSo/5RJVjvkxWUEJNYa2vyaKqoatKIgkm+W6LKDWVIqIx02yvAf1Cgyb9dpxJGflOncPQp",
+	"QGtbmr/7nL4WKvh7NAkfKxtu1280L/A+IhOfgltIFyfsK6rp4w/Knx/M/1N8+qen+dmnj/9z/qezz84y",
+	"8fSzL87O+BdP+eMvPn0snvzps6dn4vHi8y/mT/InT5/Mnz55+vlnX2SfPn08f/r5F//5YDKdSACZAA3F",
+	"vJ5N/r/ZebHUs/PLi9lrALbBCS8lZMl8/x7fypRODZGa4UkUay6LybPw0/8TTthJptfN8OHXia8zOlk5",
+	"V9pnp6e3t7cncZfTJYb+z5yustVpmOf9tIPx88uL2kef/HBwRxvt8cmkIYVz/Pbqq6vXkL/upCGYybPJ",
+	"2cnZyWMYX5dC8VJOnk0+xZ/w9Kxw308xo/6p9cWyTptYraTd7hW6rAfh3IAL4yd11M1/1JZb+zAE70C1",
+	"K7gyIGADoKtXcZEjcfla+5PphJ5ZlsjxydlZ2Asv6UQXzuk/fMY94h+p1NjvpwnRyAOchKypXZ7K/0Rp",
+	"dTD9Nx2gar3mZksraGEjGhy3iS8tKtmNvOFOTN5C7y7Oy9KXKBtCOVZrbZ/y0BkJpK5xxVUofeULjdkU",
+	"yvvl0e6J/Z3p4HuTJXYHG10CzCFLaoAnGIQ8ztBmTAirzwjuSB/R00lZJdD5FQbW2F04m0ZltwgaXeQ1",
+	"xnsYvaz+RTAKpOvvpsmzd/DXSvDCrfwfayDULHwygudb/397y5dLYU78OuGnmyen4RVy+s5nTHm/69tp",
+	"hDD4uflrJvM9PYPH074mp+98epY9A8YKzlPvaxp1GAnormanc705oKmIVze8FKR5e/oOH+CDv596LWr6",
+	"IypC6IY9DXl4B1pSKo70xxYK37kNLGT3cNAmGi8DM3lVnr7D/yDZvqfTXohUwl4qysdZ03zKpIPMScZZ",
+	"+hW4AYU/orW3adk78ufQ6zlBgLdpcC+aPPu5H/+FA7EwEooocP82EkRrpkZIRHNKxBRqEbjVvhGEfz6b",
+	"ffH23ePp47P3/waCrv/zs0/fj/Sef16Py65qKXZkw7f35Hg9nU2zSNqkmoH1HxmeFobje/xWdQZiNTL2",
+	"1CnvDN9/KyEDfnpEHt+uNJLg71/ynIU0CTj34w8394UiH3EQVEmgfj+dfPYhV3+hgOR5EUSyOwpv53T4",
+	"Y6bA/GanhLfpRGkV5cxXSxIztHWj+Y11/A785gp6feQ3rYY9Kx/G4ZG2dS0Vurk1fj10mdTVq0UoJBJi",
+	"C3h+w1UWgrGa6AjcL+wQCKN2wK2sWFRFSENSQiAE2SF0ESayVVkCx1lwW1OWD8mABzNlUaiHZpXKtCLX",
+	"KYx+CQZgzIaARmR7LctWF7kAqsJUSiES6yRs+j8rYbbNrq+lmkz7b6bIua9HSnOri8pRZOi0LuxJSZtW",
+	"2ropRUdFxwdfVehRyPMYz+DS186unutbBa2CmqA7CPSgnMon7IIKJfDCYu4VznJpUM+9DT723e52CqGl",
+	"K8ZtZ3Ab9UXgKY2pVBFtdEHBZNKYvMqnp/CFThfd1gWfi4Jczru/0jtDmGtMQ6HJwRQNBJK8fCvInjGt",
+	"99wZH4HrKReaB5SJvIWd84YalBPGVKXPZcbhqkMCNgJC3yyLEY4BNLA+mlRauh7JMYFCemntCrZTwS7W",
+	"kTxDNAZdUkRW36y/pphQL/W+YkJ7oCOLCU8OvKr/+Cv+1xaMnp796cNB4FfOoIq2rtwfVTC7IinpXoJZ",
+	"eCeiQu7UCGB9AEVaXHsleO6Tgi5RT9HkLcayfc2lga675Lbms065VfBWa7REoQ4AqcXDV42urL44N1Zk",
+	"ofAkvBV7Q8QF8xlm7IWjRw7xnrH3VaqvcKE7taqpHa7bnVJXGiaOR356dvbhT1LInlO7Mn986NzlPNFu",
+	"2m5qrbgYwQFHigqlnrqNOsWonNN3LS2T/9zTMrV/b7rHLW7WOhdB86MXCyvcns+n7+jfaCKxKYWRa6Ec",
+	"L5pfSRA9BZG82PZ/3qos+WN/Ha2qaQM/nwZDWEq52W75rvVnW2GHAt4oY0ynWEmn6s+0SRNEBvBO5nqM",
+	"tiJxdp0oLtKzIFwiXHfhLtizzVY+HujDD/RLab3dqdlmeg0ceJax0+mcq3Fk1tQCEyFkM67uY0cUIZsy",
+	"IfG+o/JWBaZVaJXaUsNE9yVXd6c76PyR9I5Hen6v70l3LQ45qL6nTKh+Vp9tp12YjidK07Vp6Ec15woI",
+	"Ya8KbczYCb1aUzBpWKk28kHcBuiHbycf31FnTz8cBJe+hpjSzpP5H/W4ItFbX5vm3lrtL3GsfcdjN/uH",
+	"ayOX1n/23hNBH+5VWr78JGrefGBQznhmtKWk8eH9Y/un/Mvf3xmfJtDYVKKJas8wrByrff0wbTDL3pS5",
+	"FjaoDOaQBi4M29LCeX345NnZdITa9xWWtKyjDOZcDU3mi19+5G//orLAl3dgLS0BwDOBYb3M85hLKB8h",
+	"7mdkf62rauJoqDBpFSeVoaRsdr3EZxwU7AOtdnTUqVsuOaafpvz4dWbWPnvxAI1iMVHZTyotGdhNME2s",
+	"tHXPSm3ctMWB1lXhJLRlPo8ZPtJOyyfl6Zvq7OzTzLMo/GNQF/9RGvl4WluntTlL+l6Htrm8d5zbQlth",
+	"e3UkmzJ5dMfD/0IN9NbJJS1rq9JgpQpfXVjG1ZTbx/NFDduYExqXOI6K+k53ygMfj9m/gNBfE+Mf9bC/",
+	"iCTs+xz2Uio77mFeSp8sNUzWfX2XUo09lAM1ez+ev3+V80cE8Md9dJfyLo/ukWr+PbWx+5pTbH8/pf2l",
+	"/Kg8PabyNOJw9t5qmcuI2thlNHL/SUShDa2fIjo6YZC4MXq3NMKbJb81ylRoNVMCWjayII2F2eqM1msq",
+	"TIKKfg8JWMwxgaHURjr5i8ibirKX9OMWafS/qFIyyF7J6TGTSnsNuGpaLXKPA3VGl1Id/UHX0pL9Xt53",
+	"0wTlHKqJQuCs49uaEVXKycIL5pWiH4+nn/p4Wf9fzBAv5Z01SHZVOfCIHH6EokswL8DSyJdUmKQOrHSa",
+	"hQFi7lfWzre+HgHjzJEzVRP5SqolX6Skzk2CPKbOULWEw7Sq0JUHHTcZXzis/944JUclxzv+3h6y73Uu",
+	"+kwpdaw8jK1TVW/IAafq0IjLyNHv/YHb57gTlMml72MBHyvb/fv0lksHXuEzdAGZIUb7nZ3gBdK0LETn",
+	"11xabq1Yz/tfzNZUkTtHq9JK8tdT3nYaaX3DLRvq2AsZS331UVEDjUKC4PC5CUiPA7yRXOrQ7p/fwq5b",
+	"YW4CJTXxys9OTzFjPFxgp+hd345ljj++rTf6XSC/sOHwbTPTRi6lgoqlFPg3a2KSn5ycTd7/nwEAo1Aq",
+	"1fpDAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
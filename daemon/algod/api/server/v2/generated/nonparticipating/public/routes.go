@@ -774,308 +774,323 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+3fbttIo+q9g6Zy18jiinFf77eauvc518+j2aV4rdvs9mtwWIkcSPlMANwDKUnPz",
-	"v5+FAUCCJChRtuwku/4psUgCg8FgZjDPT6NULAvBgWs1evppVFBJl6BB4l80TUXJdcIy81cGKpWs0Ezw",
-	"0VP/jCgtGZ+PxiNmfi2oXozGI06XUL9jvh+PJPyzZBKy0VMtSxiPVLqAJTUD601h3q5GWidzkbghju0Q",
-	"J89Hn7c8oFkmQakulG95viGMp3mZAdGSckVT80iRC6YXRC+YIu5jwjgRHIiYEb1ovExmDPJMTfwi/1mC",
-	"3ASrdJP3L+lzDWIiRQ5dOJ+J5ZRx8FBBBVS1IUQLksEMX1pQTcwMBlb/ohZEAZXpgsyE3AGqBSKEF3i5",
-	"HD39baSAZyBxt1JgK/zvTAL8CYmmcg569HEcW9xMg0w0W0aWduKwL0GVuVYE38U1ztkKODFfTcjrUmky",
-	"BUI5ef/yGXn8+PEPZiFLqjVkjsh6V1XPHq7Jfj56OsqoBv+4S2s0nwtJeZZU779/+QznP3ULHPoWVQri",
-	"h+XYPCEnz/sW4D+MkBDjGua4Dw3qN19EDkX98xRmQsLAPbEvH3RTwvm/6K6kVKeLQjCuI/tC8Cmxj6M8",
-	"LPh8Gw+rAGi8XxhMSTPobw+SHz5+ejh++ODz//jtOPkv9+d3jz8PXP6zatwdGIi+mJZSAk83yVwCxdOy",
-	"oLyLj/eOHtRClHlGFnSFm0+XyOrdt8R8a1nniualoROWSnGcz4Ui1JFRBjNa5pr4iUnJc8OmzGiO2glT",
-	"pJBixTLIxob7XixYuiApVXYIfI9csDw3NFgqyPpoLb66LYfpc4gSA9el8IEL+nqRUa9rByZgjdwgSXOh",
-	"INFih3jyEofyjIQCpZZVaj9hRc4WQHBy88AKW8QdNzSd5xuicV8zQhWhxIumMWEzshElucDNydk5fu9W",
-	"Y7C2JAZpuDkNOWoObx/6OsiIIG8qRA6UI/L8ueuijM/YvJSgyMUC9MLJPAmqEFwBEdP/hlSbbf8/p2/f",
-	"ECHJa1CKzuEdTc8J8FRkkE3IyYxwoQPScLSEODRf9q3DwRUT8v+thKGJpZoXND2PS/ScLVlkVa/pmi3L",
-	"JeHlcgrSbKkXIVoQCbqUvA8gO+IOUlzSdXfSM1nyFPe/nrahyxlqY6rI6QYRtqTrvz8YO3AUoXlOCuAZ",
-	"43Oi17xXjzNz7wYvkaLk2QA1R5s9DQSrKiBlMwYZqUbZAombZhc8jO8HT618BeD4QXrBqWbZAQ6HdYRm",
-	"zOk2T0hB5xCQzIT84pgbPtXiHHhF6GS6wUeFhBUTpao+6oERp96ugXOhISkkzFiExk4dOgyDse84Drx0",
-	"OlAquKaMQ2aYMwItNFhm1QtTMOH2+05Xik+pgu+f9Mn4+unA3Z+J9q5v3fFBu40vJfZIRkSneeoObFyz",
-	"anw/4H4Yzq3YPLE/dzaSzc+MtJmxHCXRf5v982goFTKBBiK8bFJszqkuJTz9wO+bv0hCTjXlGZWZ+WVp",
-	"f3pd5pqdsrn5Kbc/vRJzlp6yeQ8yK1ijFy78bGn/MePF2bFeR+8Vr4Q4L4twQWnj4jrdkJPnfZtsx9yX",
-	"MI+r22548Thb+8vIvl/odbWRPUD24q6g5sVz2Egw0NJ0hv+sZ0hPdCb/NP8URW6+1sUshlpDx04ko/nA",
-	"mRWOiyJnKTVIfO8em6eGCYC9SND6jSMUqE8/BSAWUhQgNbOD0qJIcpHSPFGaahzpf0qYjZ6O/sdRbX85",
-	"sp+ro2DyV+arU/zIqKxWDUpoUewxxjuj+qgtzMIwaHyEbMKyPVSaGLebaEiJGRacw4pyPamvLA1+UB3g",
-	"39xMNb6ttmPx3bqC9SKc2BenoKwGbF+8o0iAeoJoJYhWVEjnuZhWP9w9Looag/j8uCgsPlB7BIaKGayZ",
-	"0uoeLp/WJymc5+T5hPwUjo2quOD5xggHq2oY2TBzUstJscq25NZQj3hHEdxOISdmazwajJp/CIrDa8VC",
-	"5Ebr2Ukr5uV/uHdDMjO/D/r42yCxELf9xIUXLYc5e8fBX4LLzd0W5XQJx5l7JuS4/e3lyMaMsoVg1EmN",
-	"xUMTD/7CNCzVTkoIIAqoyW0PlZJuRk5JTFDZ65LJLwoshRR0zjhCOzbXJ06W9Nzuh0C8G0IAVd2LLC1Z",
-	"DbIyoTqd06F+0rGzfAPUGttYr4kaTTVnSuO9Gl8mC8hRcabcE3RIKpeijAEbvmURFcwXkhaWlt0Tq3Yx",
-	"jvd5+5KF9YqCd6BMjMIcsPtgoxGqS7PlnawzCglyjRYMP+YiPf8HVYsDnPCpH6tL+zgNWQDNQJIFVYvI",
-	"wWnRdj3aEPo2LyLNkmkw1aRa4isxVwdYYi72YV1F8YzmuZm6y7Jaq8WBBx3kPCfmZQJLhgZzd3G0FnZ7",
-	"/yIvaLowagFJaZ6Pa1ORKJIcVpCbSzvjHOSY6AXV9eHHkf29Bs+RAsPsNJBgNc7MhCY2WdkiJJAlRQm0",
-	"NLeZIm9+U3FQRZfQ0oJQIooSrQjBRePkuV8drIAjT6qGRvCrNaK1Jhx8YuZ2j3BmLuzirAVQe/ddhb+K",
-	"XzSANm/X8pTXUwiZWZu1Nr8xSVIh7RBWwrvJzX+AyvpjS513CwmJG0LSFUhFc7O61qLuVeR7qNO542Rm",
-	"VNPgZDoqjF/ALOfA71C9Axmx0rzF/9CcmMdGizGUVFMPQ2VEBO7UzApmgyo7k3kB7a2CLK0pkxQ0Pd8L",
-	"ymf15HE2M+jkvbDWU7eFbhHVDp2tWaYOtU04WN9eNU+ItV15dtTRRbYynWCuIQg4EwWx7KMFguUUOJpF",
-	"iFgfXKz9KNYxmH4U645IE2s4yE6YcQYze4TvVi91hIWoG++hn+KmoQDnoWwwYNeux+OpkJdTmFoylJPa",
-	"oUqoGTXQF8ctOsBXyyJx7CfilLEvtAaqY1i26znt4WPYamDhVNNrwIIyox4CC82BDo0FsSxYDgc43Yuo",
-	"njqlCh4/Iqf/OP7u4aPfH333vSHJQoq5pEsy3WhQ5K6zPBKlNzncix40VKDio3//xLvhmuPGxlGilCks",
-	"adEdyrr37AXfvkbMe12sNdGMq64AHMT0wUhvi3ZiPdcGtOcwLeenoLW5zL+TYnZwht+ZIQYdvvSukEZ3",
-	"Uk1XqFMIjzLzyhGstaRHBb4JPLOhFGYdTJlr7nJ6EKLq2/isniUjDqMZ7DwU+25TPc0m3Cq5keUhLDgg",
-	"pZBRLaOQQotU5IlRZZmIyLp37g3i3vDbVbR/t9CSC6qImRsdtCXPekSaXvPhItoOfbbmNW62qkd2vZHV",
-	"uXmH7EsT+fVFqwCZ6DUnSJ0NSTuTYkkoyfBDVKd+Am1VTLaEU02XxdvZ7DAGXYEDRVQCtgRlZiL2DaPg",
-	"KUgFt/GKO6S/G3UIetqI8Y403Q+Aw8jphqfoDTzEse1XjJaMY2iC2vA00JIMjDlk8wZZXt1K14cOO9Ud",
-	"FQHHoOMVPkZ3xHPINX0p5Fmtof8kRVkcnD235xy6HOoW4xwemfnWW7oZn+fNGNm5gX0SW+MXWdCzyk5i",
-	"14DQI0W+YvOFDq7E76S4BpkYnSUGKD6w9rDcfNO1ir0RmWEmulQHUCXrwWoOZ+g25Gt0KkpNKOEiA9z8",
-	"UsWVzJ6oSgznwig0HeqtaIJhikzBUFdKS7PasiAYY9WRF/WHCU3tCU0QNaonwqQKDbJv2elsxF4ugWYb",
-	"MgXgRExdGIcLMMFFUgwQ015NcypuhF804CqkSEEpyBJnbd8Jmn/Pig69BU8IOAJczUKUIDMqrwzs+Won",
-	"nOewSTCcUZG7P/+q7n0BeLXQNN+BWHwnht62ybAL9bDptxFce/KQ7Kwx0lKtUW8Ng8hBQx8K98JJ7/61",
-	"Iers4tXRsgKJUTPXSvF+kqsRUAXqNdP7VaEti54gfXdNNxqe2TBOufCKVWywnCqd7GLL5qWGLcGsIOCE",
-	"MU6MA/coXq+o0jbSi/EMzbZWnOA8VgkzU/QD3HsNMSP/6m8g3bFTIwe5KlV1HVFlUQipIYutAY17vXO9",
-	"gXU1l5gFY1d3Hi1IqWDXyH1YCsZ3yHI3YPyD6sqU54yD3cVh2ICR85soKhtA1IjYBsipfyvAbhio3AMI",
-	"UzWiLeEw1aKcKjp6PFJaFIXhFjopefVdH5pO7dvH+pf63S5xWT+OlduZAIU+Ive+g/zCYtaGqC+oIg4O",
-	"b61Fc44NSevCbA5johhPIdlG+XjFM2+FR2DnIS2LuaQZJBnkdBOxM9vHxD7eNgDueH3dFRoSG2sc3/Sa",
-	"kn1o55ahBY6nYsojwSckNUfQXAVqAnFf7xg5Axw7xpwcHd2phsK5olvkx8Nl262OjIjScCW02XFHDwiy",
-	"4+hDAO7BQzX05VGBHyf13bM9xX+CchNUesT+k2xA9S2hHn+vBfTYgl0aV3BeWuy9xYGjbLOXje3gI31H",
-	"tscw/Y5KzVJW4F3nZ9gc/OrXniAaG0Ay0JTlkJHggb0GFuH3xEbJtse83FVwkO2tC37H+BZZjo9EagJ/",
-	"Dhu8c7+z6ReBqeMQd9nIqEY+UU4QUB/UbVTw8BVY01TnG6Oo6QVsyAVIIKqc2iiNrj9FiyIJB4j6Z7bM",
-	"6BzQUffvVo/4KQ4VLC/mtrR3gu3wnbUuBg10uLtAIUQ+wELWQUYUgkHhMaQQZteZy/DyOT6ekhpAOqaN",
-	"0QeV+L+jGmjGFZD/FCVJKccrV6mh0mmEREUBFUgzg1HBqjld/GWNIchhCfYmiU/u328v/P59t+dMkRlc",
-	"+LRI82IbHffvox3nnVC6cbgOYA81x+0kIj7QcWUEn7uFtHnK7qAuN/KQnXzXGrzydpkzpZQjXLP8KzOA",
-	"1slcD1l7SCPDAtpw3EG+nGYIVGfduO+nbFnmVB/CawUrmidiBVKyDHZycjcxE/zFiuZvq88w5RNSQ6Mp",
-	"JCkmKg4cC87MNza30YzDODMH2OY1DAUITuxXp/ajHVfMOuiBLZeQMaoh35BCQgo2pc9ojqpa6oTYYP90",
-	"QfkcLwxSlHMXJ2HHQYZfKmuakSXvDBFVqvSaJ2jkjgkAF4nnszqNOgXUXOnaFnJ7gbmg1XwukXeIZA72",
-	"oO0xiDrJxqPeG69B6qq+8VrkNFNTBwiDhr4X4KeeeKArBVFndJ8uvsJtMYfJbO71mOzroWNQdicOgprr",
-	"h31xzea6nW8OoPTYgYiEQoJCERWaqZR9KmZhGrqPhtwoDcuuJd9++nvP8Xvfe18UPGcckqXgsIlWXmEc",
-	"XuPD6HFCMdnzMSosfd+27yAN+FtgNecZQo1XxS/udvuEtj1W6qWQh3KJ2gEHq/cDPJA73e1uysv6SWme",
-	"R1yLLkm1zQDUuIqcY5JQpUTKUGc7ydTYBT5bb6TLaG2i/12VenOAs9cet+VDC+sfoI0Y8oJQkuYMLciC",
-	"Ky3LVH/gFG1UwVIjQVz+Mt5vtXzmX4mbSSNWTDfUB04xgK+yXEUDNmYQMdO8BPDGS1XO56B0664zA/jA",
-	"3VuMk5IzjXMtzXFJ7HkpQGIk1cS+uaQbMjM0oQX5E6Qg01I3tX/MwVaa5blz6JlpiJh94FSTHKjS5DXj",
-	"Z2sczjv9/ZHloC+EPK+wEJfuc+CgmEriwWY/2aeYuuCWv3BpDBjRbx/7uNq6KMTILLNRB+b/u/u/n/52",
-	"nPwXTf58kPzwv44+fnry+d79zo+PPv/97/9/86fHn/9+73//z9hOedhjGcIO8pPn7mZ88hyvP0E2Qhv2",
-	"G7P/LxlPokQWRnO0aIvcxWoYjoDuNY1jegEfuF5zQ0grmrPM8JbLkENbwnTOoj0dLappbETLGObXuuel",
-	"4gpchkSYTIs1XlqL6sZnxnPx0Snp0uvxvMxKbrfSa9821dTHl4nZuKq3YEuxPSWYjL+gPsjT/fnou+9H",
-	"4zqJvno+Go/c048RSmbZOlYqIYN17K4Y5oHcUaSgGwU6zj0Q9mgonY3tCIddwnIKUi1YcfOcQmk2jXM4",
-	"n5XlbE5rfsJtDoM5P+ji3DjPiZjdPNxaAmRQ6EWsRFNDUcO36t0EaIWdFFKsgI8Jm8CkbfPJzH3RBfXl",
-	"QGc+MFUKMeQ2VJ0DS2ieKgKshwsZZFiJ0U8rg8MJf3Xw65AbOAZXe85YRO+dn16ckSPHMNUdW7XDDh3U",
-	"WYhcpV1+aCMgyXCzMG3uA//An8MMrQ+CP/3AM6rp0ZQqlqqjUoH8keaUpzCZC/LUp5w+p5p+4B1Nq7d2",
-	"ZJAXTopymrOUnIcXkpo8bT2w7ggfPvxG87n48OFjJzaje31wU0X5i50gMYqwKHXiqhklEi6ojPm+VFXN",
-	"Bke25cq2zWqVbFFaA6mvluTGj/M8WhSqXdWiu/yiyM3yAzJUrmaD2TKitKhS7oyC4rKWzf6+EU4wSHrh",
-	"7SqlAkX+WNLiN8b1R5J8KB88eIzJi3WZhz+cyDc0uSlgsHWlt+pG26iCC7fXSoxVTwo6j7nYPnz4TQMt",
-	"cPdRX16ijSPPCX7WSKz0CQY4VL2AKou7dwMsHHvnP+PiTu1XvnJlfAn4CLewmWN+pf0KSgRcert2lBmg",
-	"pV4k5mxHV6UMifudqQrazY2S5aMxFJvjbdXV/psCSReQnruibLAs9Gbc+NwH/DhF07MOpmy5PptEiQWj",
-	"0EExBVIWGXWqOOWbduUeZTMqcND3cA6bM1HXm9qnVE+zcozqO6hIqYF2aYg1PLZujPbmu6gyn0vrCrBg",
-	"fqoni6cVXfhv+g+yVXkPcIhjRNGobNKHCCojiLDE34OCSyzUjHcl0o8tj/EUuGYrSCBnczaNVRr+964/",
-	"zMNqqNIVV3RRyNWAirAZMVf5qRWs7novKZ+DEc9GpApFc1s4Nhq0gfehBVCpp0D1Vjs/D3MbPXR4pbzA",
-	"5HK08I3NEmBt9ptptNhxuDC3CjQU2Xdc9PKkP/7MAg7ZJeHxn9c3hUnvXdehLlJU0UvlCrvVtdaF5oV0",
-	"hnDZ50vAqqziwuyLgUK4gqK2bk0gX0pF59Bzdwm9dwNLfjQ8fjjILo0kqoOIWVvV6GgCUZDty4lZc/QM",
-	"g3liDjFeM1sBmX4m6yB2PiOsE+4QNs1Rga0iV+3eU9nwotrCx32gxVkLSF6rgh6MJkbC47igyh9HLAnr",
-	"uewg7ewaM4i3Vd87CWIJg7qvVW09Lw3bHLRz73c1+HzhPV9tL7z0D6icZ+5emL4Q2w7BUTXNIIe5Xbh9",
-	"2RNKXROq3iADx9vZDHlLEgtLDAzUgQLg5gBzc7lPiPWNkMEjxMg4ABsDH3Bg8kaEZ5PP9wGSu5pW1I+N",
-	"IiL4G+KJfTZQ3yijojDClfX4G1PPAVy1jVqzaEVU4zCE8TExbG5Fc8Pm3F28HqRTBA4vFK2Sby705l7f",
-	"RWOLa8qK/L3WZJWEy6wm1GY90HFVewvEU7FObIZy9C4yXU8NvUdzFzBfOnYwbbm9O4pMxRrDuVC02Fj5",
-	"HbD0w+HBCGwva6aQXvG7Pj3LArNt2u16bowKFZKMM7RW5NKn6A2Zuke37COXu0EFvUsB0DJD1e0onFli",
-	"p/mgqZ50hXkt1cZ1ZVifFhY7/n1HKLpLPfjr2seaNe/+Udc27K+f5k/UjRT761qWrlKE0X5c2MKK+9Rg",
-	"bJNDA4gtWH3X1gOjaG3GejXxGmAtxkoM8+06JbtoU5ADXoKThmqanMciBcxdHlCOn/rPAmMd7h7lm3tB",
-	"AKGEOVMaaqeRjwv6EuZ4ihWihZj1r04XcmbW916ISvhbtzl+2Fjmja8AI/BnTCqdoMctugTz0kuFRqSX",
-	"5tW4BtoMUbT9FFgW57g47TlskozlZZxe3bw/PzfTvqkEjSqnKMUYtwFaU+z/EQ1c3jK1jW3fuuBXdsGv",
-	"6MHWO+w0mFfNxNKQS3OOb+RctBjYNnYQIcAYcXR3rRelWxhkkHDe5Y6BNhrEtEy2eRs6hynzY++MUvNp",
-	"732S344UXUtQ6TCeISjmc8h8BTfvD+NBnbxc8HnQqKootpUFnBBbnQ+L622py+fC8KEvCD9Q9xPGM1jH",
-	"oQ9vBQh5nVmHNQVxkjlwW64kbhaKoiYM8cc3AlvdDftC2wkA0SDos5Yzu45OtrtUbSduQA40c3cSBX59",
-	"249ld0Mc6sZ94dON4q7bjxAOiDTFdNC7pVuGoIcB06Jg2brleLKj9hrB6F7W5R5tC1mLG2wHBppB0FGC",
-	"a1QLd6HWzsB+hHfeI3Mrs7HXLrDY0DdNXQJ+Vkr0YDQim7ul6au72sC1//zrqRaSzsF5oRIL0pWGwOXs",
-	"g4ag8LsimtlwkozNZhB6X9RlPAcN4Do29mwA6UaILO6iKRnX3z+JkdEO6qlh3I2yOMVEaKHPJ3/W9XJ5",
-	"nT4wJVUiIdiaS7iqoun6P8Mm+ZXmpblkMKnq8FzndmoK3z12fbX8GTY48s6oVwPYjl1By9N7QBqMWfqr",
-	"Ryqo0X1HNboY4PWysYV77NRxfJcOtDWu70Q/8ddSptGXobmUqxyMOkjCwDJkN07jsQnm9EAT8W1S3rUJ",
-	"LNutgwT6fjgVU75LZ1cUVbUodtHuGdDcEy8uZ/R5PLpaJEBMmrkRd+D6XSVAo3jGSFPrGW4E9uyJcloU",
-	"Uqxonrh4iT7hL8XKCX983YdX3PBNJk7ZZy+OX71z4H8ej9IcqEwqS0DvqvC94ptZle1UsV2U2ILmztBp",
-	"LUXB5ldFp8MYiwssXt4yNnX6vtTxM8FRdDEXs3jA+07e50J97BK3hPxAUUX81D5PG/DTDPKhK8py72z0",
-	"0PYEp+PihjUPinKFcIArBwsFMV/JQdlN53THT0dNXTt4Es71FktTxm8c3BWuRFbkgn/owbWnl0I2mL/L",
-	"TIwGD12fWmWUbIvHnlht36KzrUxNiFW8/pj/YU7j/fvhUbt/f0z+yN2DAED8fep+x/vF/ftR72HUjGWY",
-	"BFqpOF3CvSrLoncjbvYCzuFimIA+Xi0rzVL0k2FFoTYKyKP7wmHvQjKHz8z9kkEO5qfJkEt6uOkW3SEw",
-	"Q07QaV8mYhVkurRdQRURvB1TjUmwhrSQ2buuE9YZ2z1CvFyiAzNROUvjoR18qgx75TaY0rxM8OUea60Z",
-	"sWQ9sbm8ZMFY5rUhNVNbQAZzRJGpomVba9xNhTveJWf/LIGwzNxqZgwkyrWWqPOXAxy1o5DG7WJuYOun",
-	"qoe/ih1ki7/J24K2GUG2+u+eVz4lv9BYX6M9I8DDGTuMe0v0tqMPR802m23RDMEcdo8Z0h3eMzrnrOuZ",
-	"I9rtnalkJsWfEHeEoP8oUgjDOz4Zmnn/BB6L3GuzlMqpXDetr2fftd3D78Z9G3/lu7BfdNVY7TLCNH6q",
-	"99vIy1x6Vbxcs0Ny3yUsjDBopgb0sBY8XkEwLLa68NFHlNvzZKtANDLM4qcyzOU8suPXp9LB3Ml/zenF",
-	"lMba4Ji7kIEp2N5GnJQWxH/sN0BVNQ7s7CSI4K7eZbaSXAGy9kF0q9Je8l5jpx18o6kvMEhR4dVlbMMU",
-	"ciUiw5T8gnLbKN18Z/mV+1qBdcGbry6ExDqQKh7SlUHKllFz7IcPv2VpN3wnY3Nme4CXCoIm024gYotN",
-	"IhW5Rt1V5Q6HmpMZeTAOOt273cjYiik2zQHfeGjfmFKF4rJyh1efmOUB1wuFrz8a8Pqi5JmETC+URawS",
-	"pLp7opJXBSZOQV8AcPIA33v4A7mLIZmKreCewaJTgkZPH/6AATX2jwcxKet6uG9j2RnybB+sHadjjEm1",
-	"Yxgm6UaNR1/PJMCf0C8dtpwm++mQs4RvOoGy+ywtKadziOdnLHfAZL/F3UR3fgsv3HoDQGkpNoTp+Pyg",
-	"qeFPPTnfhv1ZMEgqlkumly5wT4mloae6g7Sd1A+HvdZ8SywPl3+I8a+FD/9r2bpu+BpDlz05Wxil/AZ9",
-	"tCFax4Ta4p85qyPTfUtScuJrC2OPsKo1mMWNmcssHXVJDFSfkUIyrtH+UepZ8jdzLZY0Nexv0gduMv3+",
-	"SaTXVrNXC98P8BvHuwQFchVHvewhe6+zuG/JXS54sjQcJbtX11gITmVvoG48JLMvLnT70EM1XzNK0ktu",
-	"ZYPcaMCpr0R4fMuAVyTFaj170ePeK7txyixlnDxoaXbol/evnJaxFDLWMKA+7k7jkKAlgxVmzMU3yYx5",
-	"xb2Q+aBduAr0Xzb+yaucgVrmz3L0IhB4NLclyxst/tfXdeVzdKzaTMSWDVDIiLXT2e1uONpwP6tb239r",
-	"A8bwWQ/mBqMNR+lipSf63obXV998iXihNkh2zxsGx4d/EGnu4KjH37+PQN+/P3Zq8B+Pmo8te79/P16A",
-	"OGpyM7/WWLjKjRi/je3hjyJiAPtRrC0X9gFFrj5CxAAZFVJGMk7dGGPSbA138+rDYRK74mGmcfL368fH",
-	"bQR8Ye6IO7btVGOH00FGJ1xjp69l1Am9Mwoi2AAz6hRyYa5OYaub0EodJbuWBPMU+GXxbRbvAI5iu2R5",
-	"9mtdsazFHiXl6SIa+zo1H/5uNc+GYLEMINo9Y0E5hzw6nL2x/e5vdpG753+LofMsGR/4bru3ql1ua3E1",
-	"4E0wPVB+QoNepnMzQYjVZjGoqthAPhcZwXnqVg31ye/2YI41hoxk7eKwy1K7aEzMcHZldGYsx+DCuDcU",
-	"30wk1T38BBuV+645ZhzsG67s5dmODpJQtkRxo+iyyAFP5gqkufmLGWaKNj/HwmA4ctCHgajCPMI3sQyD",
-	"ILqUnIjZLFgGcM0k5JsxKahSdpAHZlmwxrlHTx8+eBA15iB2BqzUYtEv8229lIdH+Ip94loH2QL3ewG7",
-	"G9bPNUXts7FdwnGdErHVcYyn2h7IaO9A358RSbZLYtXRc0J+wno+hogbBdzRCOdL4zbLRJZFLmg2xpK9",
-	"Zy+OXxE7q/3G9n63XRrnaINqkn/UaTC8bKavV9RTD2b4ONsLVJhVK51UTRVjFffMG3XbR9aKJEHrVIid",
-	"CXluDYNV5307CcHCz3IJWdDD0V5NkTjMf7Sm6QItbg0x388rh7cX9eys9kcEOXVVTx9k2AZu12HUNhgd",
-	"E+y2fcEUYJ45rKBZ5K+qeOksvr7oX3N5suTcUso+TbirDj77ot0DZ9U07yqPQtZC/J72FttleN9uq6f4",
-	"VTzDoNW6teXL9iXjfOFo8tqZzFPKBWcpFviPqYtYkGyY821AL4S410yN3AmNHK5ow9gqw9VhsbeFrGeE",
-	"DnFdR3bw1GyqpQ77p4a1ayQ2B60cZ4Ns7Ps3OzcP4wpcjyZDRCGfFDISqhMN76/CAvYkI6w11GO3e2me",
-	"vXFWXSz1cM442m8c2tzlwzpicsXQ38oJ02QuQLn1NHNU1G/mmwnWHsxg/XHySsxZesrmOIYNDjPLtpGQ",
-	"3aGOfVyki0M07z4z77qK8NXPjSAnO+lxUbhJ+7t7RxVJvea9CI5F4/jwiAC51fjhaFvIbWtAM8pTQ2iw",
-	"wlgsKFAOdwij6hDdHOWFuUhZisI3iM0TjJaFZTwCxivGvWMwLiDSqEjAjcHz2vOdSiXV9u4wiKedAc17",
-	"wvox79Z6lq86VLsevkEJrtHP0b+NdXPrHsZRvVBr/JRviD8UhroDZeIZzauA4EiratSqnBKVYcpMq3l1",
-	"jHEYxu3b/DcFwM6ktOpz7DGxryTqq7w3LbM56IRmWaxg04/4lOBTn/oEa0jLqrVSlfPWrLzdpTY3USq4",
-	"Kpdb5vIvXHG6oBt8hBrCjvR+h7F+zHSD/8b6CvXvjAsF3jvX1Mf9ZvuVm+/mzsa0XkPTiWLzZDgmUKZc",
-	"HR311Jcj9Pr7g1K6T0L9KnJMW1wu3KMYf3thBEdYjrYTdW1FS1UtFiOcBT73ZXyqOodNroSirNM9C335",
-	"uHmRLWsB71+MAr6ieU9+d+gBsPLVWsX7srzT3qIEVLuiU5qSrSyot5CPjYBt+RS6jrG+qFcb9Ho4W7xb",
-	"61aE9nukfm74n2zkU80sev1Ol3MN1Ru8r2+o03K+q/hYS0X9Cql6uw3q9dZgkEOadMT6QTg1odH0fkfL",
-	"/g6Gnw+RDN0W/OPRSbYX74z1FBnZUaI7EG2o319yvS6zjspPIRSrWyzGOu0PjKY+w2b5Qcn47lg+ym4F",
-	"qca+mnX0kATYp4C8mczb/29Lr/ffrKqgc1dxfVuZ9W4zzR3svlMZJqhuZBsRToYXFT+uYkRtissFVXU9",
-	"ilZS6ODUtNkMUiz7urUSz7+bC3hd5WXsr+gIyywozMOqRA0sXLy/AaoGaFuhnK3wBA1ErgxOX6LuOWzu",
-	"KNKghmhnxCpL6TKVURED1hvii+T22RRdWAxTFWUgFnzMo6s1W1f/7y1qG9SVuuRcniSN4KhrTW2ZMt7V",
-	"edBc5tO96tphzkFfsZ5uU9h+VfQ59uBVLgKIVpVVwwsbOel2BrlwlVmxblJlRvc1WkH533yRNDtLzs4h",
-	"bPuOTosLKjP/xkGq3ljZxOJAz6qZWR2h3vV3R2rNY7JHmgujRiR9GTPNoPAqouqOsqFvdYUShGsGUkJW",
-	"WcdzoSDRwke0b4NjGypsfN+lkKB6+7tY4Hpr+76vixdjnyuKtXypC+sLF0gkLKmBTgYlhvvn3IbsZ/a5",
-	"zzL2fY52Ghsqet3dcNPnJjDVQWJI9TPipOXu7OXL2B0Y5yAT74Ro1xvmzZJTWFgwK1MroMODUdlmBhcH",
-	"2cJKolf2tLvK1h0hyAI+h82Rvfj4TqV+B0OgreZkQQ8qKrY2+aCWGBWDe34Q8L5soaxCiDzpsXufdIsk",
-	"tyn+nKXngEXOqhjenibU5C6aWyvH5sVi44sCFwVwyO5NCDnmNmvC+zib/dNak/M7etv8a5w1K23dcmdf",
-	"mXzg8fBzrCgur8jN/DDbeZgCw+quOJUdZEcJ3jXvi764iLRknwy9lXe9ju022TVRWShiOsmpdV48w4Me",
-	"6x6MOd5BMQL0aVHinB5E5SIWrHiZPHQzVBxT4WQIkAY+JB26gsINHkVAtPFz5BTa2l6uqpeYEQm1P/Gy",
-	"5c26PapjN/r2zNUsTX43ExIa3abN17aUYRXZ79vCUzllWlK5uUwRsk6P7I71pBfLOyNzqqCceiF1YE4X",
-	"h3kuLhJkVklVyD92tTXvqaYw9l2l6u/MqZ5CEOJDlVPUNmRBM5IKKSENv4gntFmolkJCkguM+Ik5I2fa",
-	"6N1LzGLhJBdzIopUZGAbYsQpqG+uknOKahMEARZRFFjawXRI+01AxwOnPFSDdlu2xi46sW6tnuBVUK5M",
-	"jcOQfbkL75bm5nHePGNrpBuQsSM/I1qWMCbujXYTYHfwqQSCPfURlIqWLlieY2YsWwdOuMqHHUdtj9p7",
-	"ghF2K4ZhGM0saasNhy3nYc+O8w7ObU3nyS+qxEgZTJExUzwhS6G0u2nakeol19FHd1PBtRR53jRKWRV9",
-	"7hwVr+n6OE31KyHOpzQ9v4f3Wi50tdJs7BNI23Fi9UyyVTtpYHf8di1S+x5GTTmi3bsFvuNke3euDsD8",
-	"uJuD7ra5H8c6/DfX1WSm8WvMMSdUiyVL42fq2wq86g2XirGoaFEm2zzOptHja3jYQ2FV+dmRRXbRDJxG",
-	"u18dE8cInL8R2Y35L2rg7XHJDByj6RGUXebitKgk7dX1WgAgpDa3U5fSdpwLNbGKq4i5zQVHb2kb0IFS",
-	"BYNSrgabGeHgQGm4ElCdQLgKwLvW+DC2xbNsUN1UrP3ze3V1rUsB/3k7lTeYR1+0z2lNWtLG+/hKHD0c",
-	"IV7Dd2tozBnm9U6HBshU3UEHSvgAgP6QmQYMgwJn9gVjRlkOWRJrLndS2ajGwU3bZem0ez4z5Th5Skvf",
-	"282MXUpwlSGsii+b/q+CGlIS1etdSzLPYA02xN82uqfK+j28/wVy29OtZQwQRZLDChqRRK5cRYmqJluB",
-	"/1ZVH5MMoEBvZNtGFguRCWV5y3Di1p4EQRZDsBu1pFjE2p0iO8wkUaPOmif2mKihR8lAtGJZSRv4U/uq",
-	"HE0zoDnKEVR17giJv0cOneYXO8J7P8Cx/z6mynhMfBzGh/ZmQXHUbWNAO0PmStV36nk8Yi6sxVI5WHC2",
-	"rHLEWhKv+YYq6AXvN0h2Sb6+bg3cJyZ4gNgXa0hRq3H3HcjcjafHSeHKOiC1c4DM3grMJxFr+wI44SLo",
-	"oXdBVXVVqYvE+R/sxPgS4+42fQmnch3YdvWdJTgYUa1qUb0XCVnR6eXN81/kJG49iL3jxWhEgcsE22L/",
-	"8tTtrh34AvYq5mY/je6PXeicFHNcfEympR8oz8WFbYoX3kOfg/eDWurzLiCnlrNKLPsAvrGrX9g2dbAg",
-	"dHlJN0RI/MfcOv9Z0pzNNshnLPj+M6IW1JCQc7zaiAAXEGgm3q5ejT1g3toi/FR23WzomMFwGzNKALQR",
-	"5L57iSBLeg7hNmCwg+WfqTaMU5VTtFwYkd3azi4W3OJ9DYolzcKbPlbCa/aJ9rVRzdf/T50WFU7lC1gV",
-	"OU19C0TXg6XJZ7DNqScuvYDl9ry5Ll/zJFC1Tq2JVvpE6+wSJtM9WVcsGL2vv0QD7E5LyU5rjSstY5/u",
-	"83XO+paMw0FLOfQuDI266QAdNqLbBX7Yl+9m8B8tUtm3jCHgfy147+nEGcJrm27eAJYbxRgisFpr9VSs",
-	"EwkztSvAxJqrzXVe1mUcvImV8VQCVTbi5uStu3jWNRgZNxdhGxNa+TSrUTKYMV4zS8aLUkfuMViKkW8C",
-	"hIVGf0RrjwutT0swyuSK5m9XICXL+jbOnA7bsy6sge8dHe7biAmjkqndAZiq73CYqleb0cPXjAC3XXZs",
-	"uKbSlGdUZuHrjJMUpJH75IJu1OU9SpVzYJdPiQbaTDOBPPAuIWlbQPKNcwpf0d9TAUgP6PgZ4LDBuOCI",
-	"s8aadrTo8c90YfgmHDZLuk5yMceEsp4D4YpvoofPXgEFRzO41c+GrdvPo9ifsH0arDvuGJEWOOuQKbaf",
-	"+7e4lXiN/IUzvfXkWxtlO8PPxt3ag+mRyud18L8llu55jCVlujocYWKmVzZ9IrunPQg2EXr8Q027eM8u",
-	"YhiEy+gNjeDD+zk1Iy1iqZ/WMpCgxUBtCe8HVYey09SFZ3VNaR1Tg0XK2CXO7mlps/Z5L5d6wLPNt91Z",
-	"b05bhcyYcfZpgrU9VTYpRJGkQ2I+bWuCzLkJHKRNGHvoI3AC9Ky7Co9RVbOORgmcRteOffuA9XYN2eXt",
-	"KtJtl/4+M1EPR2+6IMQMeZltTY3WLczkqYwpY3+99j7pphmsYhKEEglpKdFMfEE3u/sq9ZTEPf3H8XcP",
-	"H/3+6LvviXmBZGwOqi6r3OpLVMcFMt62+9xsJGBneTq+CT4R3SLO+x99UlW1Ke6sWW6r6pqJna5M+9iX",
-	"IwIgchwj/XAutVc4Th3a/3VtV2yRB9+xGAquf8+kyPN4WftKr4o4UGK7FbhQzA2kAKmY0oYRNj2gTNcR",
-	"0WqB5kEsbrqyhUUET8Hbjx0VMN0TchVbSF9ALfIzTPN1XiMC6yJ3vMp6eraty93TrIUOlUaMipkCKUTh",
-	"VHs2IzGIMINIllBZxp3hEy3iQYxsxWxttGyMEF3keZz0wo7A27l9s1uljnN6s4kR9cIfykuQZp9/oj+F",
-	"/TKcpDbtfzX8I5KTfzCuUS33OnhF9H5wua7jg0Dr5mdHyAMB6Mm2beRJBoliQaVVab0E6E/wDuS2+vG6",
-	"dizvTAtBSPwHO8AL02fr96pMBgfOF65g+rpCSrCUj32U0Fj+roxcz3orQRJskTOaaA3KsiXRVQuDdGv1",
-	"rMpi7rmVdJKdpRCamJtpnkeSpK0dB89USDjmSiBXNL95rvGSSaWPER+Qve9PjQozZUMkW1Sqy5Vse0UH",
-	"zR1kxR5uav4OE7P/HcweReWcG8o54TvSDI072JJ77qWCzfUmFzimDbJ6+D2Zum4ChYSUqbZz/8IrJ1Vi",
-	"KEg2cwGtsNY7MlF3rfNXoa9AxjMfiUPeBO6tymfvIKyP6BdmKj0nN0rlMerrkEUEfzEeFXYf3SEurlh5",
-	"/nIVQIJaXntWAOn2VR26PFwHCp1SQXedg6V1A7cRQV2vbWj5msEF7D98+E1Ph1SdiRebN59j2ZuDVJ3f",
-	"q+b8NRS8sThyY7h5YxTza18JVFvms6dMc2s/SpbvDFhpFN3+PB7NgYNiCstK/+6aY9ysLPUQ2MoL3aNq",
-	"Yb1KuRiLmMhaG5MHUwXltAdU0nafRcofY1ZjWkqmN9gY1RvQ2O/RVrY/VbU9XG2YypfmZJ8W51A1p64r",
-	"gZTKS9efBM1RHlkXHzdSSOQT8sIWe3YH5e93pv8Gj//2JHvw+OG/Tf/24LsHKTz57ocHD+gPT+jDHx4/",
-	"hEd/++7JA3g4+/6H6aPs0ZNH0yePnnz/3Q/p4ycPp0++/+Hf7hg+ZEC2gPoq709H/5Ec53ORHL87Sc4M",
-	"sDVOaMF+BrM3eFeeCWzcZ5Ca4kmEJWX56Kn/6f/1J2ySimU9vP915BrQjBZaF+rp0dHFxcUk/ORojqn/",
-	"iRZlujjy82A7tYa+8u6kitG3cTi4o7X1GDfVkcIxPnv/4vSMHL87mdQEM3o6ejB5MHnoevdyWrDR09Fj",
-	"/AlPzwL3/QhLLR4pV0X9qMrV+jzuPCsKW2PdPHI06v5aAM2xwI75YwlastQ/kkCzjfu/uqDzOcgJZm/Y",
-	"n1aPjrw2cvTJVU74bACLug1tye2gzrIPRCzKac5SI7NcFRa0H9sAexV2z3SW9VKNydQ2WPVBvDzDECVb",
-	"jUCFTYZPMoNo+/1Jzex8j1j0K4+e/hYpZ+UzP3zr0jDoLAhH+z+nb98QIYm7Fr2j6XmV9eLTnOrUrjDL",
-	"yXw58XT/zxLkpqZLxzHHo7rHOfByaZiPS59ZqnnRLPJZa2Mxa1EH2X5mQ07BgagKndQMD02DASQ1+zYs",
-	"+UHyw8dP3/3t82gAIFh1RwF2svuD5vkf1rwGa4ysbUXejPtiosZ14Qz8oN7JMVqyqqfB5/U7zdrYf3DB",
-	"4Y++bXCARfeB5rl5UXCI7cFH7LWGxIJn9dGDB55BOfU/gO7IHarRwI72vhy89S5Uo3iSuMRAXUZmH72v",
-	"yiRKWtjDeOzjhzdF5d+xL00Mv3pywIU2izleebnt4TqL/pFmRLr8ZVzKw292KSfcxoIagWQF5+fx6Ltv",
-	"eG9OuOE5NCf4ZtDItCtpfuHnXFxw/6ZRmsrlksoNqkS64oXtHiV0rtCpiizSnu2g/Bqfjz5+7hV7R2HQ",
-	"49GnRu2k7EpC0XpZGh1+dsvJHs6JY9msNPfD3eOiwJjP0+r5cVHYvsgYRwAMpR+smdLq3oT8FH7dcI5Y",
-	"SKxvpJEU4NsE++bDDV950HAwKrQbVQlu5feXld/HTSMJy4BrNmOosMeAaZyCrTB1opWuKkC7SUJBjaR9",
-	"A6KrUslOtUhcG66BY7g244droDagNIqd6WPsCrmTUd/irgd3fWpSAG+lMdkXp3BTrNmX2q0kSUNkXCPj",
-	"/saVvtc0N3QSLLfV3eTk+a0y+JdSBquSnHOrnRXFAdRDn7mx65WjT67M5CG0RrweD9IXw5t38G0QfH+3",
-	"xXHuTchx+53LsRVXpnOnJmjeu9UBvwYd0NY53aX9OTr+onpfmPe1TxpWQ2Exvw/6+BtX9P7CyOrV7Ayk",
-	"u3W6S7DPjr7mmPW1sdV/ST3NIe1WQ/tLa2hV8ewr6Whh7OuRK0MQaGxXMvC1DXhMV5pYs4B6wNmw3ggm",
-	"5NsjPK7j/A2LsQHMLnRZjf3lET219l5pN2vcuVp2VayfILzD/rg5eb5Lu/qGTEGDW+JGpEB8b66bl0Y9",
-	"E+9vxjMxjDc9efDk5iAId+GN0OQlSvFr5pDXytLiZLUvC9vGkY6mYr2LK/EWW6oq1JlD2+BRVSHScfDc",
-	"vG0DQO5iyu+UKvj+ib853ZuQH92rdRkQl9I+F4ZR+VQxKuf2I8PrDDLIHf/nUxz/zoS8xARIrcYYx4aZ",
-	"Ffgi4/rpw0ePn7hXJL2wYWLt96bfP3l6/Pe/u9cKybjGkAF7z+m8rrR8uoA8F+4DJyO645oHT//jP/9r",
-	"Mpnc2clWxfrHzRvbdfVr4a3jWMnDigD6dusb36TYbd11w92Juhvx8P8o1lEpINa3UuiLSSGD/X8J6TNt",
-	"kpG7iFbGzkYzngNKI3tM9pFHYyd/XI0YxkkOa6PuFgtmFFxbGma6QXZVNUtydQkrmaNlyVOqsa05Rson",
-	"NuyRKaLKutuD2UbGS9clyVL5AI6O0bhfLTd/7WpG1EnyrtyOcKidkFOQK7DF0djS9fjKxQVIW9Klj18u",
-	"6Xp0WclCCgkztv5rCRi75tE2kXJQYYyxdrXV2lK1Mx1ZIpjCnHFyt3Gm8k1Qlrg6HvZ8PaN57svwsGXh",
-	"ysUWdM6461CxIRIYX4nzKtfUx8JWY9qz57otFhJWTJTWNXFHBaezV0zDWu+Hwyq32aDSFYXwlWA8Qvpm",
-	"s6/H5qurMB/WYF3xyaElrWLJGDUaI8JB2VaPwb6NbZnPJT231kss5OdZoCchVxsUd6/aTMw7qoPKoy1/",
-	"bsy2O3XMeLiNF9l3Xf61NgT81fWqb1izccVyDqDP7O2Ord2toXXP9QTcatezHF5jkXJkupu6PLVh917u",
-	"xPURM8NQk91X7Lnb6TCKmoba6L09vLemuSuZ5toEtSfbwDRzdfQJpVDIMzrnFtNk/1pBDIHUNwqgE/uC",
-	"zECnC5eh30J9hD1JlyXcz5uWjJtrz+jpg/GAi0mlIVbNjxoN1e9iFgjWr8KqlRssgyexzCSbYbmce75J",
-	"tKsRj2VI6rSIOGrt8ImZ9EY1TCS7bh31cMkZtYU8hrQpDLK9MQ4AZOTUvcX/0DxEWtU4yFdFRfRXGHSN",
-	"m+0Vy7Zyd5lDvvJAQRuNqHdD+ayevKs9IloOEUZxi+D9ENzh5i9c1RR7Ct0i/hVyi3wLxIS8EXVhC2ub",
-	"+ZeMYLhOVeS6F/RGcLChOkZVt7R4G5VR6Um1mPQVjeyFq27Td1md6chXAtuqOP3DvLRDeRqibmBVsWvX",
-	"Oa5BhP8jWi+tIWXM2iY7y7XUow1hzuZF21clVJImX/La9UX46Vd4F/sSHOtmWAweUs9nnFrAD8t0sEiY",
-	"Jeajwld06+NAr8zLgV5m66YN5kZaVBZPiFQnI1PIBZ+rr5MVbaOOOF4iVGJr4dn2TJ31T/6CZ/eZ652k",
-	"XfUCV5FOMZ4CUWIJeGUwOrorbG8h/NvNQajZEjIiSiyrF2TJf2Hu8t2Dxzc3/SnIFUuBnMGyEJJKlm/I",
-	"L7zqkXQVbqfQHeYrRHrzdYQ5MK5YBq3KhWlYZu0KTFDMtzjpQWM5xbr2qrJ6lSg1SFt1s9UKj3WYdMyA",
-	"jQzjlZn6APpcLubfmjrnsT60WPwzmueIrl11qnDgQckOeW73E5ZM67r1TChdyQuaLqq9HdfmyKpBqO9R",
-	"MG5VtcWRfVSGLboJZp81kGA1gbUCJMwEdn4DCd60tixzzYq8+U3tqqZLiEUzWtoMm5GcPPergxU2kpjV",
-	"Q7fp13c0cINPzNzuEc7MhV0clYC8OzT/hWbaSQNo21vPZ3EEHdFcXzdXMJXJVgXb2utfFEBl/bGl/LuF",
-	"hMQNIekKpKJ4WFuLunerqn8dqvralUz/ShT1rifkALz+8qKokYzxSa9Z9nm3Xh5UHd9TJWc8UMlDdmHP",
-	"2uV18d3uh7PWjCfPw5gIUdXl8wpCDygGRXumfP6v0UCfDdZ6EjN3Dyu5BdSXynUaq0tGE7NxFXpnLqRi",
-	"9pR84PeJWlBfyd39+ei77/tcI1QtXIXLrt+pHsg8tsMMcT590660w2ocFX6f3vRu77eJ4xHL1pF2FTyD",
-	"ddAhqdlB3cnDO8r56uI9f4p41fbqYhoOuwQjptSCFTdfGVxpNo23RvCWuFNsJne25if8x8oga8tXG62h",
-	"+BIVoccjLQEyKPRiZ6F4fKveTXAl45lyzb1sOe8xYROY2KizugljNgflgwlzoLOqm6IQQ0LGAj5jCM1T",
-	"RYD1cCFDNOko/aDOi0R583bSOm3WCjqPvLZS/EWVMP2llLCkpYU10fLldDJsCzMOQsUKKbRIRY6yR5VF",
-	"IaSuTreaDLI8QJ+i1zA89BHulZS5NcvUTpfOGb51ABtAk7LVN+PSOfNoivl0You6ZPnqeq4hLO1MFMRe",
-	"8FsgfFG+dnupjPGzlvvnW/f+6F7SO7AzKKU6XZTF0Sf8D6YsfK5T/7GxkTrSa36ErWyPPm0NB0aWmhvd",
-	"RNqeSA2TbqcxbjSo9xV+XvdfeilkcLn9yXy3M9y3hbRxW+jbtrwYNxxhj9dzm/xLX8K2us5aG371aJDI",
-	"iJ3zWlW2CZp5VrQbdPXyxWpsK98ICd9GL31dC6r9iTPGM0KDbWzZmoSsGcE1+xSve9FfwkV58yFb333D",
-	"5+yN0OTEpw5CdrVIfdLmcF56bBW3+ykGTvR3w/m7Mj+U+D4JqdJFdgr4Pe49QcIc+OmoxOpkRlbfUNT8",
-	"rST/qiT5s8rbGpLhrVz+duSy9KlTtyL46xfBj7/Z1VxjDNNAkXwJ53BTDNc38T0FckcZcDasluFgm18Z",
-	"r97tVaqXQvrelbdS/Bt1itqdHByINcRCs8sS66Y8RNbZVwX9MDtDnkcsDX0HdVzFejEs+ypShk2+TjI1",
-	"dkFl1jjhTvGt4vNVKz7BXt/qPbemh2/M9NCj5bhbf54PUTT2VYBWS5GBd6yK2cyVWe/TfpqNZQ15Kk2X",
-	"BbFfTnrjsM/YEk7Nm2/tFAcVsTXYLbWoBZ5BloJU8EwNiOJwo15WDqGjqR+AG/dsVjvgYUGXP+jJpUn2",
-	"fVDFtUMJpI18hQ2Bfbl5h4wMVsQQ4OQAZHv0yf6L5rRCqMhqTj0BdzbmrtsWWz/fjtsAkLxDJdSWsvJf",
-	"iRl5YKt+lRyT3OvO/5RnRMuNUVR9UTcJNCdpI7m1gqN7ck57T87Oq0BndT1rit8FRH1CDxnB0Cos8PON",
-	"H4BnlDuS7yJICyziOKearcC7/Ce3VbMuLc1c7aotDHBMaJbZ01hvAqxAbogqp8roOryZo3RHNc/LHgwD",
-	"1gVIZkQ0zWsHvL0mHNnSWNviiE7tG1cUWi1eZAtyyWbUopesrlyXmJHXLJXiOJ+LKhZebZSGZaevvvv0",
-	"957SeN6Q0I1ZFTxnHJKl4LFu72/x6Wt8GPsay4v1fXxmHvZ925K3TfhbYDXnGSKTr4rfr+T0XynQpbVa",
-	"CYWQ5nY7tfWILP3veZT8odnwtHuSNjwNnFruYTBQ2OS98fORT0dotHyPvvmp8acroefeVItSZ+IimAVt",
-	"ADaccUj1LFS+90zyqG1uzexJpq7X6nad3qYAD7GzVT2NdPKuH/Y38/6LJmE750xIJC6ncQVStS5yt5nY",
-	"/1KZ2IP3fS9ubIYs1S6OVqrD6i5vRAZ23Dod1xz9WC8lLjIgygPRUlmqsMh4ypCXX/V7rSSOlJbzhSZl",
-	"QbSIpYvUHyY0tUw2sReh+IRBQXN7XcLpFnQFhOYSaGYur8CJmJpF15IUF0kVMbvkc05c8GdUaQrgKqRI",
-	"QSnIEt87ahdo/j0bqq634AkBR4CrWYgSZEbllYE9X+2E8xw2iauMfffnX83V+sbhtUrjdsTa+rgR9LbT",
-	"rrtQD5t+G8G1Jw/JziZ0W6q1rQSWRQ4uSS6Cwr1w0rt/bYg6u3h1tGAWGbtmiveTXI2AKlCvmd6vCm1Z",
-	"JEZ+d0F8Zp+esSVqYpxy4S2QscFyqnSyiy2bl8K1KLOCgBPGODEO3HM1fUWVfu/ypTMs82jFCc5jdWwz",
-	"RT/ARorau0Vk5F/tw9jYqZGHXJWKuBF8DhRksTVgAfzeud7AupoLa6f4saskK2sL3DVyH5aC8R2yggZa",
-	"hOrA748F9LuLQ0sldaaMLiobQNSI2AbIqX8rwG7o8O8BxPVICS6jTLUop6pTOx4pLYrCcAudlLz6rg9N",
-	"p/btY/1L/W6XuGwtDCu3MwEqTIBzkF9YzCo05S6oIg4O39EAWyTahshdmM1hTLDMUrKN8tG4a94Kj8DO",
-	"Q1oWc0kzSDLIacTo8ot9TOzjbQPgjnvyTFZCQzLFGinxTa8pWfYak6qhBY6nYsojwSckNUfQXJ5rAnFf",
-	"7xg5Axw7xpwcHd2phsK5olvkx8Nl263uMWCZMcyOO3pAkB1HHwJwDx6qoS+PCvw4qc0H7Sn+E5SboNIj",
-	"9p9kA6pvCfX4ey2gbfgLBVhDUrTYe4sDR9lmLxvbwUf6jmzM1PhNugXaUU7XmGTXNLUGF8DJZS63RxeU",
-	"6WQmpFWkEzrTIHeGzv87Zd5x7tN3hau6QnAEJzfdOMjkw7aUjotYEIgTF4ZEXCUpI8MoeUiWjJfaPhGl",
-	"dj1vJNB0YZT20AZrR8LmM65Ik4Q5lVmOTadnldwU0hZ90i0Bj0BH8hGbN36z7pdCDuoC0CwdSZkmJdcs",
-	"dwAajlfd278+6+WtReLWInFrkbi1SNxaJG4tErcWiVuLxK1F4tYicWuRuLVI/HUtEl+qTFLiNQ5fsZEL",
-	"nrSDKW9jKf+lqspXosobSNA6cUEZsqWgSkG/3WIPQ5AGmiMOWA790d026PTsxfErokQpUyCpgZBxUuTU",
-	"XA1greve71TB9098qqEVnXRp+3ujfDUvPH5ETv9x7CuOLlxlzOa7d49tvBpRepPDPdcWrepQ7fujATdI",
-	"d+3RqBcJqcuTdB3zWY6R8Yq8wLefwwpyUYC0xQyxnWDX4nMGNH/mcLPD4IPNu12o7R9mtD/GDaOXQ9uS",
-	"Fl7N92ulilCbcUmeBzmYf8xoruCPvjRMO96SFgM6ESIz+VFkm9YJMbt2hBvYPBt13VHGqdxEqkR1UyDa",
-	"pKGFYVeOsLq2rM8Hr47bJdoume2isGg7bCyDHx+9j8qjZWGrDesMZRN1Zy06GcVyTNu1UEcVgIMKA2Ka",
-	"hN0T8t5+92XLACJE7ojVzPyriWJsvlkxDXzXXCIc6/lWcwk84qOnF8/+2BB2VqZAmFbEF9jdLV7Go3Vi",
-	"RpoDTxwDSqYi2yQN9jVqSKGMKaoULKe7JVHIP/HEVcLHPNkup76MGHkeLG4bTw6JZp04BtzDnTcaBvPm",
-	"Cls4omPPAcavm0X3sdEQBOL4U8yo1OJ9+zK9eprNLeO7ZXzBaWxpBIy7guRtJjK5RsYnN7Lk/TzvxRrS",
-	"0gAXnuS7aJ1HlxysdcPJmsG0nM/NbaHro8M2OjgeE/wLsUK73KFccD8KsoNX7f2vmqTeHq7LXYK88bu+",
-	"MuM93A7KN+jMWBaUb7zLFxLFlmVucWibSh+W0dqa4bES07Xtr8+q/c6b/ALbrRO1zd8tWsgFVcTuL2Sk",
-	"5JnLeOrUtl7z4XVO7NBna16z6a01Tex6I6tz8w4REX6Xm6nmihQgE73m9kA1DpPrYGBP7hetpX0rNm5O",
-	"bNhEdehhsN1q/DVDOJD0kAFfQ/ER9FyqE/ManZhoM52w8QwtGv0pLmFzJvvmQQNLOsM340tqc4vzn0Je",
-	"EErSnKF3VXClZZnqD5yi/yZY2KQbe+IN1f2875l/Je5CjHj43FAfOMUgo8qrE+WBM4i4MF4CeBaryvkc",
-	"lOGjIQHNAD5w9xbjpOTmFiZmZMlSKRKbWmvOl9FdJvbNJd2QGVY0EeRPkIJMjdQPdt3akpVmee6CXcw0",
-	"RMw+cKpJDlRp8poZDmyG8+UUqpAz0BdCnldYiPfqmQMHxVQSN8z8ZJ9iOxy3fG8ARGOmfVy3sbjZPjge",
-	"dpb1Qn7yHGPUsBpzzlTYf7EN+435xpeMJ1EiO1sAceFibdoid7EGnCOge03HkV7AB26knxYEOT7VlyOH",
-	"tgeocxbt6WhRTWMjWo4iv9ZB17+DcBkSYTK3bpd/oRTSgA68ZxM33tbXb+39ni6WhsgFbA3aJ5DtU9c+",
-	"secld4FoGMlaBW7cG2cNkLf6L779spKHv0t6NB7sNtkdsMuumg3yEG9+w8eE5oLPbV1Fc7sUuE+MF6XG",
-	"APDrNODBiuaJWIGULAM1cKVM8Bcrmr+tPvs8HsEa0kRLmkJiLQpDsXZmvrF0io0GOdOM5gneqocCBCf2",
-	"q1P70Q55HHQbXS4hY1RDviGFhBQyW4iMKVLf5ye2QANJF5TPUXRLUc4X9jU7zgVIqBozmit0e4h4IZg1",
-	"T2xRui6Mx65Rc1i3F2i6iDSOQQFn7uyeoLJGT6qBe9AoOdp3SR+PehVtg9RVHTpnkdNkMwO0iIY+EOCn",
-	"nvgQNVpvif6W6L91oo+VVETUzVrWCouvcFuu2ax13QVEb9BK9kWqC9+W6P9XL9HvOZAilEjauIPEe8NR",
-	"RZgmF1gWaQrEyK8SrfOu4Z67r2OmXXDUXaVN5drzpQvKuKupU+U1IBzmSrxcMq19e9prMWxaZoYWTYMO",
-	"SEvJ9AZvLbRgv5+D+f9Ho/YrkCt/oSllPno6WmhdPD06ykVK84VQ+mj0eRw+U62HHyv4P/m7SCHZytyv",
-	"PiPYQrI540bmXtD5HGRtQhw9mjwYff6/AQAA///6hygTFcEBAA==",
+	"H4sIAAAAAAAC/+y9/XMbN9Ig/K+gdFfljyMl23HybPzW1r2KnWR1cRyXpWTvuTjvBpxpkng8BGYBjERu",
+	"Xv/vV90AZjAzGHIo0bK90U+2OPhoNBqNRn/+cZSpVakkSGuOnv1xVHLNV2BB0188y1Ql7VTk+FcOJtOi",
+	"tELJo2fhGzNWC7k4mhwJ/LXkdnk0OZJ8BUfP4v6TIw3/rISG/OiZ1RVMjky2hBXHge2mxNb1SOvpQk39",
+	"EKduiLMXR++3fOB5rsGYPpQ/yWLDhMyKKgdmNZeGZ/jJsCthl8wuhWG+MxOSKQlMzZldthqzuYAiN8dh",
+	"kf+sQG+iVfrJh5f0vgFxqlUBfTifq9VMSAhQQQ1UvSHMKpbDnBotuWU4A8IaGlrFDHCdLdlc6R2gOiBi",
+	"eEFWq6Nnvx4ZkDlo2q0MxCX9d64B/gVTy/UC7NFvk9Ti5hb01IpVYmlnHvsaTFVYw6gtrXEhLkEy7HXM",
+	"fqyMZTNgXLI33z1nX3zxxde4kBW3FnJPZIOramaP1+S6Hz07yrmF8LlPa7xYKM1lPq3bv/nuOc1/7hc4",
+	"thU3BtKH5RS/sLMXQwsIHRMkJKSFBe1Di/qxR+JQND/PYK40jNwT1/igmxLP/1F3JeM2W5ZKSJvYF0Zf",
+	"mfuc5GFR9208rAag1b5ETGkc9NdH069/++Px5PGj9//t19Pp//F/fvnF+5HLf16PuwMDyYZZpTXIbDNd",
+	"aOB0WpZc9vHxxtODWaqqyNmSX9Lm8xWxet+XYV/HOi95USGdiEyr02KhDOOejHKY86qwLEzMKlmAMTSa",
+	"p3YmDCu1uhQ55BMmJLtaimzJMm7cENSOXYmiQBqsDORDtJZe3ZbD9D5GCcJ1LXzQgj5dZDTr2oEJWBM3",
+	"mGaFMjC1asf1FG4cLnMWXyjNXWX2u6zYxRIYTY4f3GVLuJNI00WxYZb2NWfcMM7C1TRhYs42qmJXtDmF",
+	"eEf9/WoQayuGSKPNad2jeHiH0NdDRgJ5M6UK4JKQF85dH2VyLhaVBsOulmCX/s7TYEolDTA1+y/ILG77",
+	"/zr/6RVTmv0IxvAFvObZOwYyUznkx+xszqSyEWl4WiIcYs+hdXi4Upf8fxmFNLEyi5Jn79I3eiFWIrGq",
+	"H/larKoVk9VqBhq3NFwhVjENttJyCCA34g5SXPF1f9ILXcmM9r+ZtiXLIbUJUxZ8Qwhb8fVfH008OIbx",
+	"omAlyFzIBbNrOSjH4dy7wZtqVcl8hJhjcU+ji9WUkIm5gJzVo2yBxE+zCx4h94OnEb4icITcAY6Q48CR",
+	"sE7QDJ5u/MJKvoCIZI7Zz5650Ver3oGsCZ3NNvSp1HApVGXqTgMw0tTbJXCpLExLDXORoLFzjw7DOHNt",
+	"PAdeeRkoU9JyISFnQjqglQXHrAZhiibc/t7p3+IzbuCrp0fvd30duftz1d31rTs+arep0dQdycTViV/9",
+	"gU1LVq3+I96H8dxGLKbu595GisUF3jZzUdBN9F+4fwENlSEm0EJEuJuMWEhuKw3P3sqH+BebsnPLZc51",
+	"jr+s3E8/VoUV52KBPxXup5dqIbJzsRhAZg1r8sFF3VbuHxwvzY7tOvmueKnUu6qMF5S1Hq6zDTt7MbTJ",
+	"bsx9CfO0fu3GD4+LdXiM7NvDruuNHAByEHclx4bvYKMBoeXZnP5Zz4me+Fz/C/8pywJ723KeQi3Ssb+S",
+	"SX3g1QqnZVmIjCMS3/jP+BWZALiHBG9anNCF+uyPCMRSqxK0FW5QXpbTQmW8mBrLLY303zXMj54d/beT",
+	"Rv9y4rqbk2jyl9jrnDqhyOrEoCkvyz3GeI2ij9nCLJBB0ydiE47tkdAkpNtEJCWBLLiASy7t8dEkdSab",
+	"A/yrn6nBt5N2HL47T7BBhDPXcAbGScCu4T3DItQzQisjtJJAuijUrP7h/mlZNhik76dl6fBB0iMIEsxg",
+	"LYw1D2j5vDlJ8TxnL47Z9/HYJIorVC/NwIsaeDfM/a3lb7Fat+TX0Ix4zzDaTlTWvJ/UaDAG7CEojp4V",
+	"S1Wg1LOTVrDx33zbmMzw91GdPw8Si3E7TFzYinnMuTcO/RI9bu53KKdPOF7dc8xOu32vRzY4yhaCMWcN",
+	"Fg9NPPSLsLAyOykhgiiiJr89XGu+OfJC4pSEvT6Z/GzAUUjJF0IStBN8Pkm24u/cfijCOxICmPpd5GiJ",
+	"Bm1UqF7m9Kg/7ulZPgNqTW1skEQN46wQxtK7mhqzJRQkOHMZCDomlWtRxogN37KIGuYrzUtHy/6LE7uE",
+	"pPe8a+RgveHFO/JOTMLcfI43mqC6NlveyTqTkOCHLgzfFCp79zdulgc44bMwVp/2aRq2BJ6DZktulomD",
+	"06HtZrQx9I0NiWbZLJrquF7iS7UwB1hiofZhXWX5nBcFTt1nWZ3V0sCjDnJRMGzMYCWsbR6OTsPu3l/s",
+	"W54tUSxgGS+KSaMqUuW0gEsomNJMSInaLrvktjn8NHJ419A5MoDMzgKLVuPVTKRi07UuQgNbcbqBVvia",
+	"KYt2n5qDGr6CjhREN6KqSIsQPTTOXoTVwSVI4kn10AR+vUbS1sSDH7PT+hPNLJVbnNMA2mC+q/FX84sW",
+	"0Ni6uU9lM4XSudNZW/xNaJYp7YZwN7yfHP8DXDedHXXeLzVM/RCaX4I2vMDVdRb1oCbfQ53OHScz55ZH",
+	"J9NTYfoB5jgH9SPxDnRCS/MT/YcXDD+jFIOU1FCPIGFERebU3F3MiCo3EzYgfatiK6fKZKhf3AvK583k",
+	"aTYz6uR967Snfgv9IuoduliL3Bxqm2iwob1qnxCnuwrsqCeLbGU60VxjEHChSubYRwcExyloNIcQtT74",
+	"tfaNWqdg+kate1eaWsNBdkKt3X9GMXuC704u9YRFqJvsIZ/SptEFLuO7AcFuTI+nM6WvJzB17lDJGoMq",
+	"4zhqJC9OOnRATaty6tlPwijjGnQGanxYtss53eFT2Gph4dzyD4AFY3kE/A2w0B7o0FhQq1IUcIDTvUzK",
+	"qagC/+IJO//b6ZePn/zjyZdfIUmWWi00X7HZxoJh973mkRm7KeBB8qCRAJUe/aunwQzXHjc1jlGVzmDF",
+	"y/5QzrznHviuGcN2fay10UyrrgEcxfQBb2+HduYs13QoyRD5BgrF88PoMgsBA8wpW3K5gJwZsFbIhVfV",
+	"QR5kPl1JiexSqhz2uQ0JD0it0wZFo+Z3PJLEFsvfAYP5HDLrzWCc+VFvcDEHdCQgHLVnEdCZtxg7Nh6W",
+	"gBC8gFm1OPc/vNZqfvA7uzdDClhq9LrUKP6atjXb7+9Jjk1OYG01PympJcic2BatQxhuDKxmB+ELQ2c3",
+	"b2bJmT8UOezka/uetGaaTXTaXuiNrg6hhAOtlU7SY6mVVZkqpvgaESohrrz2LZhvEbar7P7uoGVX3DCc",
+	"m2zslcwHpBI0no+WstzQF2vZ4GbrQXLrTazOzztmX9rIb97KJboErSUj6mwJS3OtVoyznDqSRPw9WPdK",
+	"ECs4t3xV/jSfH0Ynr2igBOMSKzA4E3MtmMDTnynpXE53CHB+1DHo6SIm2ELtMAAeI+cbmZFB9xDHdli2",
+	"XQlJ3iVmI7NI0EUYC8gXoEfgY7wgO4QON9U9kwAH0fGSPpNF6QUUln+n9EXzyPpeq6o8OHvuzjl2Odwv",
+	"xtuscuwbjBVCLoq2m/MCYT9OrfGjLOh5repyayDoiSJfisXSRlqN11p9gDsxOUsKUPrgVJoF9ukrNl+p",
+	"HJmJrcwBXgPNYA2HQ7qN+RqfqcoyToIWbX5l0u+EAcdYkkvIkdDGTw/SognDZoDUlfEKV1uVjNzkevdF",
+	"03HKM3dCp4Qak56w8e5yrdx0zumy0MBzVFmCZGrmPXG8jxAtkpOPnw2Stn+lJPhFC65SqwyMQWOns0vs",
+	"BC20c1eH3YInApwArmdhRrE51zcG9t3lTjjfwWZKHqmG3f/hF/PgI8BrleXFDsRSmxR6u1rfPtTjpt9G",
+	"cN3JY7Jz+mRHtcwqelgVYGEIhXvhZHD/uhD1dvHmaLkETY5PH5TiwyQ3I6Aa1A9M7zeFtioH4iy8pgUl",
+	"PNwwyaUKglVqsIIbO93FlrFRvBaDK4g4YYoT08ADgtdLbqxz1hMyJ827u05oHupDUwwDPPgMwZF/CS+Q",
+	"/tiZkgakqUz9HDFVWSptIW8ma9ZA+tnBuV7Bup5LzaOx6zePVawysGvkISxF43tk+Rcw/cFtrY31+t3+",
+	"4sjzA+/5TRKVLSAaRGwD5Dy0irAb+5oPACJMg2hHOMJ0KKd2cJ8cGavKErmFnVay7jeEpnPX+tT+3LTt",
+	"E5czxdGcLFdgyMzn23vIrxxmXZTBkhvm4QgKd9LIOa/CPsx4GKdGyAym2yifnnjYKj4COw9pVS40z2Ga",
+	"Q8E3CVOB+8zc520D0I43z11lYercxdOb3lBy8M7dMrSi8RJM85Vi9IVleATxKdAQiO+9Y+QcaOwUc/J0",
+	"dK8eiuZKblEYj5bttjoxIt2Gl8rijrtGDmTP0ccAPICHeujro4I6b1FI/icYP0Foc41JNmCGltCMv9cC",
+	"BtT5PhIvOi8d9t7hwEm2OcjGdvCRoSM7YFt4zbUVmSjprfMDbA7+9OtOkHTvYDlYLlDJGH1wz8Ay7s+c",
+	"o3N3zOs9BUfp3vrg95RvieUEZ7I28O9gQ2/u1wD6Gy4PYq7le+gR/by7LeR8pJIQZagSQLMZl7TmsLrX",
+	"4iCrK8Weq3stdq+uFPusTkh8HeMi6607yMoA9H5LQ/fF3WujYccuLlNSQmbb6yNXnkgLdwg1S2JUJlzM",
+	"Ji4jhIzg6zBuAmue2WLDOMmHG3YFGpipZs4HrG+tRU+veICk9XfLjN69JelcstXf5pyGipaXMru55+p2",
+	"+C46b9YWOvwztVSqGKG87SEjCcEo5ztWKtx14eNHQwRhYHItIL08UWwCuF6KidFMK2D/qSqWcUnagMpC",
+	"LW4rTTIs9qUZhInm9N7dDYaggBU4JQd9efiwu/CHD/2eC8PmcBWCrh8+7KPj4UNSMb5Wxrb4/iEOPNf2",
+	"LCHZkFkcZTL/QO5ed7tdRv3IY3bydWfwMCmdKWM84eLyb8wAOidzPWbtMY2Mc5e165Erv2g7WPbWTft+",
+	"LlZVwe0hDKpwyYupugStRQ47+bufWCj57SUvfqq7UUA5ZEijGUydUXvkWHCBfZzDAo4jpLAiRE2NBQjO",
+	"XK9z12mH9qNxqRKrFeSCWyg2rNSQQe4MQsIwUy/1mNGw3maPLF6rauG9sNw4xPAxQJ9CoivZGyIp79u1",
+	"nJL9JXUBeD/fEDOOkj5w1DZ0jTfubX3F6/kgb90LI/ega8xK2m8nR4PKGETqZaOMcchpB76PuAxaT5EI",
+	"P83EI618hDoUy/v4ircFDxNu7oexJjVDp6DsTxyFTDQfh6ImUBNUbA4g9LiBmIZSg6ErKtagGvdVzeMk",
+	"F8HXemMsrPpGJtf1HwPH782gKkPJQkiYrpSETTKvk5DwI31M9XbX5EBnEliG+nafxy34O2C15xlDjTfF",
+	"L+1294R2janmO6UPZa13A44W+kcYx3e+B/yU1zXhoy9/3+rtQ+C7DMBMar9coRk3RmWCZLaz3EzcQfOG",
+	"ch8v30b/6zqw7wBnrztux7wbZ1ch8wUUJeMsKwQZN5Q0VleZfSs5qU+jpSZcRIOeaFih/jw0SWvwEwp2",
+	"P9Rbyck9uFaqJn2J5pDQIH4HEPTqploswNjOW2cO8Fb6VkKySgpLc63wuEzdeSnxab+xcOxaYqDLHGnC",
+	"KvYv0IrNKtuW/inDg7Gonne2ZpyGqflbyS0rgBvLfhToyYTDBX+UcGQl2Cul39VYSN/uC5BghJmmXVm/",
+	"d18pMMovf+mDpPD/vnPw2m9SzhzhMltZpv6/+//zGWaX4tN/PZp+/T9Ofvvj6fsHD3s/Pnn/17/+/+2f",
+	"vnj/1wf/87+ndirALvJByM9e+Jfx2Qt6/kSxTl3Yb800hUlLkkQWOxp1aIvdp1w7noAetPW2dglvJXqR",
+	"WYWpnkTO7fXIoXvD9M6iOx0dqmltREdPG9a656PiBlyGJZhMhzVeW4rqe3+nM33gRobkHdiKzSvptjJI",
+	"3y6QPbg+qvmkzubiEj0+Y5TqY8mDC7n/88mXXx1NmhQd9fejyZH/+luCkkW+TiViyWGdeivGUWb3DCv5",
+	"xoBNcw+CPenl6dyO4mFXgEoGsxTl7XMKY8UszeFCzKfXOa3lmXQRUnh+yPq+8UY9Nb99uK0GyKG0y1QC",
+	"uJagRq2a3QToeERh2A/ICRPHcNzV+eT4XvT+pgXwee0Tr9SY11B9DhyhBaqIsB4vZJRiJUU/nfgwf/mb",
+	"gz+H/MApuLpzppzN733/7QU78QzT3CNs+aGjLC6Jp7T70PaVs4y3gnLfyrfyBcxJ+6Dks7cy55afzLgR",
+	"mTmpDNojCi4zOF4o9iwEtL/glr+VPUlrMDNtlHWCldWsEBmaWlLk6bIN9kd4+/ZX1Oq+fftbz22o/3zw",
+	"UyX5i5tgioKwquzU50qbarjiOmWWNXWuLBqZem+d1QnZqnIKUj8+8+OneR4vS9PNmdNfflkWuPyIDI3P",
+	"CINbxoxVdUCvMHVOBNzfV8pfDJpfBb1KZcCw31e8/FVI+xubvq0ePfoCWCuJzO/+ykea3JQwWrsymNOn",
+	"q1ShhbtnJYVRTEu+SFl/37791QIvafdJXl7hFqCgS91inNThSzRUs4CAj+ENcHDsnV2BFnfueoW8uOkl",
+	"0CfawnYGixvtV5SA5NrbtSOJCa/scopnO7kqgyQedqZOl7ngQprgKGTEgl6rPrPoDFWKkL3zKR9hVdrN",
+	"pNVdzVuCZmAdwrhkoC5Em9LRkYECk4SWOfeiOJebbl4wH19Eg76Bd7C5UE02u30SgbXzUpmhg0qUGkmX",
+	"SKzxsfVjdDffOzyGSH2f3omi3wNZPKvpIvQZPshO5D3AIU4RRStv0hAiuE4ggjoMoeAaC8XxbkT6qeUJ",
+	"mYG04hKmUIiFmKXymP+9bw8LsCJV+tSt3kG+HtCgiUxYw2buYvXPe83lAhgnz6dSGV64tNRJfyJ6Dy2B",
+	"azsDbrfq+WUcOR2gw/7sCk+W0/BNcAmwxv0WljR2Eq4g94oi18Y71h8Pu0Y6wCG/Jjyhe/NSOB5863rU",
+	"JVK2hlu5xm79rPVeozGdXSzr7yugnM/qCvcFoVA+XbHLihXdL5XhCxh4u8TWu5EJhVoWPxpkl0SSlEHQ",
+	"laUtavQkgSTIrvEU15w8w4Bf8BDTM7PjKxxmcgZibzOiKgQeYbOCBNjaqdrtPdctK6pcbAMtzVpAy0YU",
+	"DGC0MRIfxyU34Tjmk4jLjpLOPmB+gm25Pc8iN9coq3SduTPchl0O2nv3+wyfIa1nyOUZP/pH5OWcHDkG",
+	"kNwOJUk0zaGAhVu4axwIpck412wQwvHTfE68ZZrymI0U1JEA4OcAfLk8ZMzZRtjoEVJkHIFNjg80MHul",
+	"4rMpF/sAKX3GPB7Gpisi+hvSMacuhgSFUVXi5SoG7I1Z4AA+l08jWXSc/WkYJuSEIZu75AVIG97izSC9",
+	"FJP0oOgklPSuNw+GHhpbTFPuyt9rTdTjWquJpdkAdFrU3gLxTK2nLv9B8i0yW8+Q3pNhNdgreTBdMs97",
+	"hs3UmjwN6WpxYRw7YBmGI4DRAEBZGnHt1G9IznLAbJt2u5ybokLD7tdSZ0MuQ4LemKkHZMshcrkf5ee8",
+	"FgDdfAd1Ml+vltipPmiLJ/3LvLnVJk3e6RCxmDr+Q0couUsD+Ovrx9oZNf/WZE4dzs7oG91OKtG+Zukm",
+	"KV5dZwLE7JXhtUsOLSC2YPV1Vw5MorXVqoPXCGspVsKETBgl+2gzUAA9gqct0XT6DjbptzzQPX4eukXK",
+	"Oto9LjcPIgdCDQthLDRGo+AX9DHU8Zzyzys1H16dLfUc1/dGqfryp45OGd9a5q2vgIJD5kJjFAJa3JJL",
+	"wEbfGVIifYdN0xJoa7OZq9Yi8jTHpWkxnjAXRZWmVz/vDy9w2lf1RWOqGd1iQjoHrRlVF0r61G+Z2oVd",
+	"bF3wS7fgl/xg6x13GrApTqyRXNpzfCbnosPAtrGDBAGmiKO/a4Mo3cIgo1wIfe4YSaORT8vxNmtD7zDl",
+	"YeydXmohI8PQze9GSq4lyqOaDl5ViwUG8bncYcEeJqMsnIWSi6gMXlluSzp6jLUXjE/duSXrp3fDhyEn",
+	"/Ejcnwq02Kahj5o5yJugT8pYSpMsQLpMOmm1kFrscPGnFpGu7pZtod0AgKQT9EXHmN14J7tdqreTNqAA",
+	"HjKAGQjr234s+xviUTcZcp9upY7efoRoQKIpYaPKUP0MGQMMmJelyNcdw5MbdVAJxvfSLg9IW8Ra/GA7",
+	"MNB2gk4SXKsWgXe19gr2E3rznuCrzPlee8dipG+e+dwQeaXJgtHybO4XvqjfaiPX/sMv51ZpvgBvhZo6",
+	"kG40BC1nHzREZSUMsz6DXS7mc4itL+Y6loMWcD0dez6CdBNEljbRVELar56myGgH9TQw7kZZmmIStDBk",
+	"k7/oW7l821iVVF8J0dZcw1SVzCTxA2ymv6DSgZVcaNO453qzU/vy3WPXL1c/wIZG3un1ioDt2BXSPL0B",
+	"osGUpr/+ZKIKAPdMjDH3vGxt4R47dZrepQNtja9qM0z8zS0Tr6izlJscjMZJAmEZsxvnad8EPD3QRnyX",
+	"lHdtgsh3yyCRvB9PJUyoAdy/iuo0KbtoF3McBuKl5Ry9nxzdzBMgdZv5EXfg+nV9gSbxTJ6mzjLccuzZ",
+	"E+W8RP8tXky9v8TQ5a/Vpb/8qXlwr7jll0yasi++PX352oOPJukCuJ7WmoDBVVG78rNZlauDs/0qceUS",
+	"vKLTaYqiza9T2sc+FldUGqGjbOpVlWr8Z5rxgs/FPO3wvpP3eVcft8QtLj9Q1h4/jc2TOnecfPglF0Uw",
+	"NgZoB5zTaXHjSpMluUI8wI2dhSKfr+lB2U3vdKdPR0NdO3gSzfUTZU1Nvzikz6lKrMg7//CDS0/fKd1i",
+	"/j4yMek89OHEKhSyHR4HfLVDAeCuMHXMnOD1++J3PI0PH8ZH7eHDCfu98B8iAOn3mf+d3hcPH/aBdrdd",
+	"mkmQlkryFTyooywGN+J2H+ASrsZd0KeXq1qyVMNkWFOo8wIK6L7y2LvSwuMz97+gORZ/Oh7zSI833aE7",
+	"BmbMCTofikSsnUxXruawYUp2faopCBZJi5i9r2njjLH9IySrFRkwp6YQWdq1Q84MslfpnCmxMaPGA9pa",
+	"HLESA765shLRWNhsTDrfDpDRHElkmmRG4QZ3M+WPdyXFPytgIgdp8ZOme61z1YXHAY3aE0jTejE/MPWJ",
+	"hr+JHmSLvSnogrYpQbba717UNqWw0FTVtD09wOMZe4x7i/e2pw9PzS6abdl2wRz3jgkGvaT6wFsQA6Pz",
+	"xrqBOZoCrdTPpS4SZjrX6l+QNoSQ/SiRCMNPRM8R6p3y3OuylNqoHNYTz75ru8e/jYc2/sZv4bDoumzj",
+	"dS7T9KnebyOv8+g16Uzik6P4SKbhch9ZOzRggLXQ8YqcYamQTvA+4tKdJ5cFohVhlj6VUQtz4sZvTqWH",
+	"uburWcGvZjx7l34LIUzR9rb8pKxioXPYAFPnOHCzs8iDu24rXJLDEnRjg+gnTL7mu8ZNO/pF0zxgsGPr",
+	"6TJxbgqFUYlhKnnFpYXgxuD4le9twJngsdeV0pSi1KRdunLIxCqpjn379tc867vv5GKBM7kEnlEJez8Q",
+	"c3lQiYpyYcqCb+rMHR41Z3P2aNKcybAbubgUBh2ZqcVj12LGDV2XtTm87oLLA2mXhpo/GdF8WclcQ26X",
+	"xiHWKFa/PUnIqx0TZ2CvACR7RO0ef83uk0umEZfwALHohaCjZ4+/Joca98ej1C2bw5xXhd3GsnPi2cFZ",
+	"O03H5JPqxkAm6UdNe1/PNcC/YPh22HKaXNcxZ4la+gtl91lacckXkI7PWO2AyfWl3SRzfgcvkhrlYKxW",
+	"GyZsen6wHPnTQMw3sj8HBsvUaiXsyjvuGbVCemrq07tJw3DHdDYcT6/hCh/J/7UM7n8dXdctP2P4Kk0P",
+	"nLyUX5GNNkbrhHGXl7YQwbkH6oLH7CykvaZSPnXhQYcbnAuXTrIkbiFVghLSkv6jsvPpX/BZrHmG7O94",
+	"CNzp7KuniUp+7UpQcj/Abx3vGgzoyzTq9QDZB5nF98UoeDldCWT1D5ocC9GpHHTUTU5rh/xCtw89VvLF",
+	"UaaD5Fa1yI1HnPpGhCe3DHhDUqzXsxc97r2yW6fMSqfJg1e4Qz+/eemljJXSqVoWzXH3EocGqwVcQj64",
+	"STjmDfdCF6N24SbQf1z/pyByRmJZOMvJh0Bk0dwWLI9S/C8/Nkn5ybDqIhE7OkClE9pOr7e7ZW/D/bRu",
+	"XfutcxijbwOYG402GqWPlQHve/q56fMx/IW6ILk9bykcH//ONL7BSY5/+JCARr2ja/r7k/Znx94fPkzn",
+	"xk6q3PDXBgs3eRFT39QeYuXYPitQa8eFg0ORz4/Q37/0JYU348yPMWHtwpO3Lz4cJrAr7WaaJv+wfvrc",
+	"RcBH5o60Y9tONdVPHqV0ojX2quYmjdA7vSCiDcBRZ4BOk6ZVhSnCe5rsOjdYoMCPi29cvAc4ie1KFPkv",
+	"TcayDnvUXGbLpO/rDDv+w0merYvFMYAU1tCOJqFIDudebP8IL7vE2/O/1Nh5VkKObNtNeO6W21lcA3gb",
+	"zABUmBDRK2yBE8RYbSeDqpMNFAuVM5qnqSLSnPx+hfdUzdI+CbphV5X13pgU4ezT6MxFgf8bsIZSy6nm",
+	"doCfaIrOmzcjwiWg/YWeIW500IyLFV03hmNpJzqZl6Dx5a/mFCna7k6JwWjkqEQIMyV+opaUhkExW2mJ",
+	"lRSjZYC0QkOxmbCSG+MGeYTLgjXNffTs8aNHSWUOYWfESh0WwzJ/apby+ISauC++qpWrvbAXsLthfd9Q",
+	"1D4b2yccX8STCqmneCp9cPGY2JmuJFfAsy42e8y+p3w+SMStBO4ITZ0at50msioLxfMJpexFfxPmZnV9",
+	"NBCiqIDoAuHvkH/SaDA+bWbIVzSQD2b8ONsTVOCqjZ3W9T5TGfewRVORVHQ8SUg7FWPnmL1wikET1E5u",
+	"ElfNWK8gj8qLuqcpEQf+x1qeLbGBal3zw7xyfOXbwM4ae0QUU3cZPhLDRrh98VtX+3bCqJb/lcAkvEtu",
+	"4RLaSf4CGEHjG5L+tZcXal0LuU+J/7q41L5oD8DRuLWpPAlZB/F76ltcDfN9CwGfU690hEGnXkbHlh1S",
+	"xoXE0exHrzLPuFRSZJTgPyUuUkKycca3EbUQ0lYzc+RPaOJwJWsZ1xGuHouD1Y0nRy3E9Q3Z0VfcVEcd",
+	"7k8La1/jbgHWeM4G+SRUh/dmHiEN+PJhSEQxn1Q64aqTdO+v3QL2JCPKNTSgt/sOv73yWl08guydkKS/",
+	"8Wjzjw9niCmMIHurZMKyhQLj19OOUTG/Yp9jyj2Yw/q345dqIbJzsaAxnHMYLtt5QvaHOg1+kd4PEds+",
+	"x7Y+I3z9c8vJyU16WpZ+0mScZr3DqYrbgwhOeeME94gIufX48WhbyG2rQzPdp0hoWCqAGQsl3cM9wqiL",
+	"l7dHwUIBlaMoasFcnGAKKYWQCTBeChkMg+kLIkteCbQxdF4H+plMc5stW2xolxvkgFs/xd1m7w4xVGeD",
+	"CSW0xjDH8DY2ddcHGEfdoJH4udywcCiQuiNhAoP6agfTfhV1kqq8EJVTyEynrnqKcSDjnoZAwBa6dgal",
+	"1d2pxsS+N9FQ5r1ZlS/AYla3VMKmb+gro68h9AnrXFR11a865q2debtPbX6iTElTrbbMFRrccLpcGG4M",
+	"rGZFwhnyRf0R8nqHkdLQXoD/puoKDe+MdwXeO9Y0+P3m+6Wb78fOpqRepOkpZhUajwm6U26Ojmbq6xF6",
+	"0/+glB6CUD+JGNMOl4v3KMXfvsWLI05H2/O6dldLnS2WPJwVfQ9pfOo8h22uhN/61bPIlk+bl9iyDvCh",
+	"YRLwS14MxHfHFgB3vzqt+FCUdzaYlIBbn3TKcraVBQ0m8nEesB2bQt8wNuT16pxeD6eL92vditBhi9QP",
+	"LfuT83xqmMWg3el6pqFmg/e1DXWrZiQEH2oRwe5fQz0FysD7psUgxxTpSNWD8GJCUJs4KvP5alyRjF59",
+	"jR6GX4y5GXr4eD85Osv34p2pmiJHbpTkDojF0lJK8r8Bz0G/3pFyvUmzTsJPqYyoL2ZW4GA+x+WShjse",
+	"602NKj0Rp4zvjxW87C4hs1TytfEe0gD7JJDHyYL+/y71+vDLqnY69xnXt6VZ79d53cHue5lhouxGrhDh",
+	"8fik4qe1j6gLccFKYHU+ik5Q6OjQtPkcMkr7ujUTz9/xAd5keZmEJzrBMo8S84g6UIMSF++vgGoAKvg1",
+	"4Sn44cAZCtR9B5t7hrWoIVkZsY5Suk5mVMKAs4aEJLlDOkXvFiNMTRmEheDz6LpDk/1/MKltlFfqmnMF",
+	"kmQ8zjW1Zcp0wfFRc2HXvfLaUczBULKeUI24f/LqmsIScsdrfKVar2K+xmkmy7gb8Ox1E8unWSFm5ZPS",
+	"zThAU7AuhU6JeD9LsW5U8lTjypuyJo3nlCuNPCdTkvOxpXsePW65JHsXlwMxUSsuqxQRBmfjMDxulHtM",
+	"NzUUgZ2+PpswzX1LLmnalTAzWPJLoXTa/VgDNymB+O/Lja84AJomdNgcEdlWU4NfzhAtUIHjIWIIW+6c",
+	"0ELZYqv2JAEeMfV6JfF4jFtKVx177AsbNREuXVE65iC0ctXXr0kwgdaV21hsOyuEWcJAyjH/UML3Pk/e",
+	"kAVHDl9bMUvQ4QnNqE9dehacRBSZZCdsBdxUIdWYquxC4SG8gplBK62NoB0gYm83Se9HY1QJ08fbgfVT",
+	"J+yKzmn5pEziPIC0/ZQQ6ax4Dh0Mp88AQjIduovaDGPiDzPoLuBuTU/KsMYk9K68+HbYA15c24FTq1IZ",
+	"5DXt7lw145gGGxG0lWVWTUJhqnZ75x8T0/9xWvjk87nIBtNb4UcGaxdRHYVK4yQTlNACRVq+GJ0TBrnG",
+	"BV9cuOF325lqThRo0iMuoqJ6S/qnuVnkEAd7LYZvMzdsLIt6S6nSe/KwqCR9YFEf4NoqRfraEtLUFWg4",
+	"alMHC/EOYH8IedFG7kNEvGZbCHWdDM/VqOULRns3gq8m3EOnIad7QquM3+uc705GCeS8RUs0NSDt0Ggm",
+	"Sjc9PE5Y4RbgfgxI2Ae+etw0iPWY46C0fJHexeiY0z3786sfaoZTb17IMFPJd1JdycATehR+JWSursx2",
+	"eql5sSkEhWb4XrXvTnwmJ8ws8Udj3TtrP1bkZvw7jb+TGyGKJl1Ca9FJarO7G9UgYfBctaA65NEizPIO",
+	"Xu/O0qHPkufN6a0LDDjIcG4TIpY+gjOHCW5OjWkapBzlkVZ02OLwAiwXhfGBHrwuoBHb5diZW2n0G/Fz",
+	"BIrS49beUqEUB5jwW8iF7WYpxDtfB4sev843DdOnhxYHSW5KzdA6nwJ6Xs8smkDkvltznyhcTH9WKIP5",
+	"34cSI7Rjf+vAmXvGRTg1iSgJrjloDXntBFUoA1OrEjJGD45tqDAUxnUtJJjBMp4OuMESLm+aGjVUzphT",
+	"yRbuo7fiBTINK47Q6aiSzPCc25D93H0PyaTCrbbTplzT63RnxEAIQRemh8SY6ufMK0V3J6m6jnlZSAl6",
+	"GnzNumVlZDuzMOWPz6vM3bPxwahN8HtcsoOsJGmZzfqr7JiComRP72Bz4uxb7hI09Q7GQDsFuQM9Spzf",
+	"2eSDGtxNCu7FQcD7uPmQS6WK6YB701m/Fk6X4t+J7J1/vYZQTRTh7rXPBk7C7pNXTe2/erXchNovZQkS",
+	"8gfHjJ1KFxwfXFnbZbI7k8t7dtv8a5o1r1x5Km9GP34r01HGdJnqG3KzMMx2HmZA5jeeyg2yfSK7lkNO",
+	"9ldUZKpdjf54rPG171zaEWYionJQpGSSc+ej9pwOeurJTqm8opxz5LrImfdtY6ZQqZi066Qbw6HSmIon",
+	"I4AsjHlnN1D4wZMI8H77O1Jb+88hebOaMw2N2+h1s1j7xNCONZshw2135nqWNr+bKw3xjBSW4jLWh1NJ",
+	"DIectfVMWM315jq5ptuoSr3uBrG8MwCjjr1oFtLEX/RxWBTqakrMalrXa0tZMLGdaV/GQXXT9MNTPYMo",
+	"koMbL6ht2JLnLFNaQxb3SKsgHVQrpWGKlQmSKtmXqFBihVgJaxiVA1swVaLV3NU9TFPQ0FyVlJzEJoj8",
+	"6JMocLSDK/V9IjoeOSXeqc5zbEqi1s4yQWHzL7CPy8DUZCd1i54678WBGEUwPhupx5Br3IeXCMel7+u6",
+	"jKR581ysiW5Ap478nFmNwaO+BY3eIiE6+FwDWwljHCg1LV2JoqAESGLd8AOoXZWHlNdJsfeMAqkuBXnb",
+	"t5NhUQ8UcjOoM4TFPOA8Tt8ZGcVCoZQazmDZ1JW3e8aj/GwqCoigTAg4xVO2Usb6l6YbqVlyE2RyP1PS",
+	"alUUHX2voxvvj/YjX59mmX2p1DtMavWA3rVS2Xql+STkCeqGAzUz6U6K3PYFPCUaMLtLTrh2OEvgAqMZ",
+	"ZIfF9XyfdmrBGjB/281Bd7tWnfYX1l1Xm5mmnzGnknGrViJLn6nPK75mMComxaJSqHA93MF3REyHPb6s",
+	"andqYpF9NIPkySLHp8wzAu9W6syMpXUSeHdcNgdue3NHF2WfuXgpapoNynodAAhSl8LHVtoVFo8lsZqr",
+	"qIWz0ZGutAvoyFuFYg9uBhuOcHCgLNwIqF68Uw3gfad8mLgcyS52CuPl/fcHjWXwWsC/307lLeYxFNRx",
+	"3pCWpiZ1wsUBjpAu1bI1AuKC0jfNxsZBmKCEH3nDRwAMR0a0YBgVH7EvGHOOAXJTbgcud9JRTaKXtk/G",
+	"EI0eysDSLCzjVSjhjWNXGnwCQCfi67abY8ntMlyd2LyvSUatJBgSZv4FWrna3JPIzQ4KV7q7owxQ5bSA",
+	"S2gFjDhaNhWJmuhv4fuaujPLAUoyqHR1ZKlIiPgu7yhO/NqnkS/9GOwmNSkOsW6n2A41SVKps5ZTd0zM",
+	"2KOEEF2KvOIt/Jl9RY62GhCPcgJVvTfCNLwjx07zsxvhTRjgNPRPiTIBE7+N40N7s6A06rYxoJ2RUZUZ",
+	"OvUyHRgVp9ysDSw0W1772zoSb/iGKfmVHFZI9km+eW6N3CehZITYb9eQkVTj3zvkxIHvmQEjhc/eR9Qu",
+	"AXL3KsAuCW37EiSTqnn2kDYyPFWaXODhBzcxNRLSv6av4TvcxC/dfGcZDcZMJynw4ENC13R6ffX8RzmJ",
+	"Ww/i4HgpGjHgE35s0X8F6vbPDmqgqiJnEvcTZX8qNu5vMc/FJ2xWhYFQW+F8DON36AsIdlAlYxOQW1HI",
+	"pks6YIdud4P1VR0iilBFR22l6R+pLPtnxQsxd85iDvzQjZklRxLyhlfn+O3jvnDi7eLVJAAWtC0qTOXW",
+	"LcaOGQ23wVEioPEiD0UqFVvxdxBvA/m0O/6ZWWScppqR5gKv7M529rHgFx9SDZILYfP+poTnmxZ3CCUw",
+	"sPf/02S/iKcKeYrLgmeQt0pttvkMCkM1cdklrLanR+nztUACoVVEtDrk08qvoTLdk3WlYo6Hygi2wI6e",
+	"Ee0qgodZxkjNb6dW3JbEMqOWcuhdGBtc0QM6rje+C/y4/Prt4D9Zi2BoGWPA/1TwXhfxHIaXmtwGlls5",
+	"9xKwOm31TK2nGuZml4MJtUbgG4BNrWIVMtPAjfO4OfvJPzybVPtC4kPYhf7VNs16lBzmQjbMUsiysol3",
+	"DGXcl5sIYbHSn9A6YEIbkhJQmLzkxU+XoLXIhzYOT4eax4UBEJJg6PB9EyqM+k7tDyBM84ajjCyNGj1u",
+	"hhe4K6bqPNOM5TLnOo+bC8ky0JYLtF1vzPUtSrVxYJdNiUfSTDtPWGRdItJ2gBQbbxS+ob2nBpAf0PAz",
+	"wmBzsQRP/W1jjY/KUQP2mT4Mn4XBZsXXaOOjvCEDB8LXWCALHzVjSpIa3Mln49Yd5jHiX7B9Giov5RmR",
+	"VTTrmCm2n/ufaCvpGfmzFHbryXc6ym4iFxde6Q5mQKpcNDHejlj657Ec8IEv2/l3grAZ8pUF2oNoE4ei",
+	"htp68YFdJDcIn7gpVoKPL9vb9rRI3DBeMzAljYHZEsUNpolY5pl3z+qr0nqqBoeUic+PtKemzennw700",
+	"AB4iGkIEXnva2mUGx9mn1vH2jEjTUpXTbIzPp6tAlzsAAqRtGAfoIzICDKy7do8xdU3GmBrbxRn3Lfc8",
+	"WBxyl7WrzLY9+ofURAMcvW2CUHPiZXSEnXJM6ViZMgnP62CTbqvBaibBONOQVZrUxFd8s7t87kDlk/O/",
+	"nX75+Mk/nnz5FcMGLBcLME31nE752cYvUMiu3ud2PQF7y7PpTQj5xuhzbX8MuTPqTfFnzXFb06TG7xXf",
+	"3Ue/nLgAEscxUfb0WntF4zQR3J/WdqUWefAdS6Hgw+8Zummkq5fVclXCgJLarciEgi+QErQRxoK0HQuo",
+	"sI1HtFmSepBqWFy6/JEqhKs1VCDsgMtVaiFDDrXEz/BTHYIF67LwvOrKB3cPr8u/05yGjoRG8opBLZYq",
+	"vWgv5iwFESP9eQW1ZtwrPkkjHvnI1szWecumCNF7nqdJD3026CWs5mw7t28MhYFRJzg9bmJCvAiH8hqk",
+	"OWSfGM5Udh1O0qj2Pxn+kUi9djCuUS/3Q/CK5PtgS2qp057fQ512bBRo/TRcCfIgAAaSKrXS4UT5QKKC",
+	"GtpZCcieEAzIXfHjx8awvDMshCAJHXaAF2dJatp1A0A/cqGKH2ukREv5bYgSWsvflXipjn4NF0m0RV5p",
+	"Yi0Yx5YSuS2irFrmeZ2sauBV0stppZWyTEnUjSRyYTk9Dp2pmHCEtKAveXH7XOM7oY09JXxA/mY4NCpO",
+	"iBQj2aHSXC8z90s+au6Cf4Cp5WvKv/V3wD1K3nN+KG+E791mpNzhhXOvntfWaJDsisaknWaPv2IzXzSu",
+	"1JAJ0zXuXwXhpM7/AxqtYzQFrO2OhEO71vmLsjcg43nwxGGvIvNWbbP3EDZH9CMzlYGTm6TyFPX1yCKB",
+	"vxSPwpTI46qM3bTA2PUSPUYpm/dM9BivjFJqj14erYMuncpAf52jb+sWbhMXdbO2sVlKR9cpw1KQszHJ",
+	"RdM1xbA7ZTc9SHGxvUqLfYC8pg5Hfgw/b4pifhmqdOGqOQxU4+nsBxbu2WlVi2srYcAtSDDCUPWgf/ga",
+	"iLd7lwYIXFKj/lF1sN4kK6hDTGKtrcmjqaKqSSMKJvluiSo3LkNCpYXdnCP+gwJN/ONdKmHk93UKR58C",
+	"tLal+bvPqncgg79Hk/CxMuF2/V7xgu4jZ+KTeAup4ph962r6+IPy13uz/4Av/vI0f/TF4/+Y/eXRl48y",
+	"ePrl148e8a+f8sdff/EYnvzly6eP4PH8q69nT/InT5/Mnj55+tWXX2dfPH08e/rV1/9xD/kQguwADcW8",
+	"nh397+lpsVDT09dn0wsEtsEJLwVmyXz/nt7KLp0aITWjkwgrLoqjZ+Gn/zecsONMrZrhw69Hvs7o0dLa",
+	"0jw7Obm6ujqOu5wsKPR/alWVLU/CPO8nHYyfvj6rffSdHw7taKM9Pj5qSOGUvr359vwC89cdNwRz9Ozo",
+	"0fGj48c4vipB8lIcPTv6gn6i07OkfT+hjPonxhfLOqljtd5Pet/K0pXSwk+eRv1fS+CFXfo/VmC1yMIn",
+	"DTzf+P+bK75YgD7+L5cxD3+6fHISpJGTP3zmhPcIWNJs6CorReV0fF9WVrNCZHhn+WSbpD92DvbuePiW",
+	"XrNeGaz2V3CZQXDilTm5KLlsBOZoclQj/CxHRLv+Zw2zIzQGu/LRs18TWYtD5MdVlJ6sTg7euKP9r/Of",
+	"XjGlmX8WvUYlUIh6CWFOTWhXHOWEPY8D3f+zAr1p6NIBejQ5cmyWCFpWK2Q+PnxmZRZlu5ZDI42ltEU9",
+	"ZIeZkZyaiZu8YQ3DI9VgBEnDvpElP5p+/dsfX/7l/dEIQCi5qgFKBPM7L4rfnXoN1uRZ2/G8mQz5RE2a",
+	"xBnUodnJCWmy6q9R96ZNuwTS71JJ+H1oGzxgyX3gRYENlYTUHvw2OQrEQmf1yaNHgUF58T+C7sQfqmiW",
+	"UVW/3k9aowSSuMZAfUbmPr2ps+FrXrrD6L+4OF5v33GNjpFfPT3gQts5+2+83O5wvUV/w3OmffwyLeXx",
+	"Z7uUM+l8QfFCchfn+8nRl5/x3pxJC1ryglFLd/PSMU7l/HOp1HxLFJqq1YrrDYlEtuaF3VKUfGHIqEos",
+	"0p3tKMu2XBz99n7w2juJVo8/x7mT8htdis7K0irkuvueHOCcNJaLSvM/3D8tS/L5PK+/n5bla+SWhvwI",
+	"QNDtB2thrHlwzL6Pe7eMIw4SZxtpBQXUuWVNN4fLPRPXlU9e2q2sBHf398e9v0/bShKRg7QYP6UHgGmd",
+	"gq0w9byVbnqB9oOEohxJ+zpE1xVxvGgx9dWWR47hjtMB62SPSI3iZvot9YTcyajvcDeAuyExKYK3lphc",
+	"wxncFmsOFVXqm6R1ZXxAxv2ZC30/8gLpJFpup4jl2Ys7YfBPJQzWKTkXTjorywOIhyFyY1eTkz98mslD",
+	"SI040jh5MX55R30j5/v7HY7z4Jiddttcj634NJ07JUFsdycDfgoyIO37TunP0/FHlfviuK99wrBaAgv+",
+	"PqrzZy7o/YmRNSjZIaS7ZbprsM+evOaZ9Qdjq/+WcppH2p2E9qeW0Ork2TeS0WLf1xOfhiCS2G6k4Osq",
+	"8IStJbH4U4uzUb4RCsh3R3jS+Pkji3EOzN512UzC4xE/+Xel26xJ72nZF7G+h/gN+83m7MUu6eozUgWN",
+	"1DQkb4H03nxoXpq0TLy5HcvEON709NHT24Mg3oVXyrLv6Bb/wBzyg7K0NFnty8K2caSTmVrv4kqyw5bq",
+	"DHV4aFs8qk5EOom+Y2vnAHKfQn5n3MBXT8PL6cEx+8Y3bdKA+JD2heJFEyrG9cJ1Ql6HyGD3wp/PaPx7",
+	"x+w7CoC0ZkJ+bDiGayikffb4yRdPfRPMuE0uUt12s6+ePjv96199s1ILacllwL1zes2N1c+WUBTKd/B3",
+	"RH9c/PDsf//n/zk+Pr63k62q9TebV3wFnxBvnaRSHtYEMLRbn/kmpV7r0u3LTtTdioX/G7VO3gJqfXcL",
+	"fbRbCLH/b3H7zNpk5B+itbKzVYzngLcRmH3vo4m/f3yOGCFZAWsUd8ulQAHXpYaZbYhd1cWSfF7C+s6x",
+	"upIZt6i64+QpP3Vuj8IwUzXVHnAbhazAj0FUPoKjg/mUufmPPmdEEyTvUGmVR+0xOweNpTQwt4pY+VLO",
+	"mMxEu5QuQ/xyxddH171ZWKlhLtZ/rgvGrflo25Vy0MuYfO0arbWjaq86ckQwg4WQ7H7rTBWbKC1xfTzc",
+	"+XrOiyKk4RHo/kshKiVfCOkrVGyYBiEv1bs61jT4wtZjurPni+qXGi6Fqpxp4p6JTufgNQ1rux8O69hm",
+	"RKVPChEywQSEDM3mmqfma7IwH1ZhXfPJsSmtUsEYDRoTl4MJ1U3rffOVUFf8ndNeUiK/wAIDCfncoLR7",
+	"9WbiRJFTebLkz63pdmeeGY/X8RL7btK/NoqAP7tc9RlLNmAOJc/sbY5tzK2xdo9+3KHXcxzeUpJyYrqb",
+	"Jj01L5p7Jy2P4AxjVXafsOVup8EoqRrqovfu8N6p5m6kmusS1J5sg8LMzckfdAvFPKN3bilM9s/lxBDd",
+	"+igA+mtfsTlY1B8iQrqoT7An7aOEh3nTSkh89hw9ezQZ8TCpJcS6+FGcK4DdpygQyl9FWSs3SCBKU5pJ",
+	"tNxyCw9IJp7VOeIpDUkTFpFGrRt+ipPeqoRJZNfPox4vOecukceYMoVRtDf5AYBOnLqf6D8YS9ggrS4c",
+	"FLKiEvprDNbV+d1MzCcOtarOPFD6PHOjoXzeTN6XHgvVIuLru1HcIXg/BPe4+bc+a4o7hX4R/w6xRaEE",
+	"4pS9Uk1iC6eb+bf0YPiQosiHXtArJcG56qCo7mjxziujlpOaazJkNHIPrqZM33VlppOQCWyr4PQ3bLRD",
+	"eBojbuBkH17m+ABX+N+S+dJatwyu7XhnupZmtDHMGRu6uiqxkHT8MZ9dH4WffoJvsY/BsW6HxdAhDXzG",
+	"/aTkYZkOJQlzxHxShoxuQxzoJTaO5DKXN200N7Kq1nhCIjsZm0Gh5MJ8mqxoG3Wk8ZKgEvrgyzP11n/8",
+	"Jzy7z33tJOuzF/iMdEbIDJhRK6AnA8roPrG9g/AvtwehFeh7qypKqxdFyX9k7vLloy9ub3q0lYoM2AWs",
+	"SqW5FsWG/SzrGkk34XaGcb/nsfo6wRyENGiOaWcuzOI0azdggmqxxUgPltIpNrlXjZOrVGVBu6ybnVJ4",
+	"osekUwpsYhgvceoDyHOYB/AzE+cC1scmi0c7LKFrV54qGnhUsENRuP2ElbAW8sTGHbNv0ccv7O2kUUfW",
+	"BUJDjYJJJ6stjRy8MlzSTcB9tsCi1UTaCtAwV1T5DTQE1dqqKqwoi3afxlTNV5DyZnS0GRcjOXsRVgeX",
+	"VEhi3gzdpV+rWoMfs9P6E80slVsc10C8O1b/xWra4xbQXMdRHFFFNF/XzSdMFbqTwbax+pclcN10dpR/",
+	"v9Qw9UNofgnacDqsnUU9uBPVPw1Rfe1Tpn8ignrfEnIAXn/9q6gVjPGHXaMT2U65PMo6vqdILmQkksfs",
+	"wp2168viu80PF50Zz17EPhGqzssXBIQBUBBFe4Z8/o+jkTYbbIS04N5hlXSAhlS5XmL1wWhqPqld75TE",
+	"bs/YW/mQmSUPmdz9n0++/GrINMLN0me47NudmoHwsxtmjPHpszalHVbiqPH77LZ3e79NnByJfN0HkmqZ",
+	"RxWS2hXU/X14z3hbXbrmT5nO2l4/TONhV4DXlFmK8vYzgxsrZunSCEETd07F5C7W8kx+UytkXfpqlBrK",
+	"j5ERenJkNUAOpV3uTBRPrZrdBJ8yXhhf3Mul854wcQzH1CYqwpgvwARnwgL4vK6mqNQYl7GIzyChBaqI",
+	"sB4vZIwknaQfknmJKG9fT9qEzbqLLiCvKxR/VCHMfiwhbNqRwtpo+XgyGWDLSeQqVmplVaYKunvQRUxp",
+	"W59uczxK8wBDgl5L8TBEuDcS5tYiNztNOhfU6gA6gDZlm8/GpHMR0JSy6aQWdc301c1cY1jahSqZe+B3",
+	"QPiofO3uUZniZx3zz+du/bGDpHdgY1DGbbasypM/6D8UsvC+Cf13ibVPNBSK583PVO/InNi1PKEKtyd/",
+	"bPUSJk5boMiiXamklqa3Vy836ev7kro3ZZm+Uzp6836P/XZ6AXdwOenKAjQ7O3uR5pof5pH5p36bbbWo",
+	"dTb85k4iiRF7xzgc8bjGZ027UbEvT8G+wm+ChO+cmj6tBTVmxrmQOePRNnZUUEo3jOADmxo/9KI/huXy",
+	"9j25vvyMzxlGDpyFiELIb+bAz7ocLtweW6/b/eQFf/X3vfz7d35844fYpFpE2XnB7/EciuLoIEzHNf7X",
+	"4F19S870dzf5J3WTP6+NsDEZ3t3Ln8+9rENE1d0V/OlfwV98tqv5gK5NI6/ka9iM29dw8xLf80LuCQNe",
+	"tdXRJ2wzN9PTu7tK853SoaTl3S3+mdpK3U6O9s8ao6HZpaD1Ux4iGO2Tgn6cngF90XqahqGDOqldwARl",
+	"g1WZoNpfZ7mZuEPslRP+FN8JPp+04BPt9Z3cc6d6+MxUDwNSjn/1F8UYQWNfAehypXII9lY1n/vs60PS",
+	"T7veLJKnsXxVMtfzeNA9+0Ks4Bxb/uSmOOgV24DdEYs64CGyDGRK5maEc4cf9br3EOLJDgNw6wbPegcC",
+	"LOQJAPb42iT7Jkru2qME1kW+oTrBIQu9R0YOlwwJ8PgAZHvyh/uX1GmlMonVnINNg8vu+21xafXduC0A",
+	"2WsSQl2Gq9BLzdkjlwyskoZsjsIXGCcXV6s3KKiGXG8aML6+FfNaw9E/OeeDJ2fnU6C3uoE1pd8Cqjmh",
+	"h3Rs6OQb+OHWD8BzLj3J9xFkFeV2XHArLiF4AhzfJdO69m3mU1ptYYATTEvlTmOzCXAJesNMNTMo68h2",
+	"6NI90z4vezAMWJegBV7RvGgM8O6ZcOIyZm1zLzp3LW54aXV4EY3JdNuZMdysDiZkMD+KTCss9V27yJuN",
+	"sbDqldv3Xf8xkDEvKBL6rqxKFkLCdKVkqgj8T/T1R/qY6k1Zx4Y6X+DHob6d+7YNfwes9jxj7uSb4vcT",
+	"Of038n/prFZDqTS+bmcuTZGj/z2PUjg0G5n1T9JGZpFRy3+MBlJy4OeTEKXQqgSfbPlH60+fWS+0BLoV",
+	"W3+ezLhM/pacir5mSkokq87PuTADX0oRT2GWlc3VVbRW0kQ4X8sxqb3oCbBnBEqj+WuHdgrzYXV/H9Lm",
+	"FeEhdcLrr4ky483H4Urjf9IIcW8iionEB1xegjad5+RdmPi/VZj46H3f607AISuzi6NV5rAS1CuVgxu3",
+	"iRXGo58q9CRVDswEIDqCU+2zmY5nCrdo064TYZLxCsPsq5JZlYplaTpOeeaY7NQ9x9ITRtnWqZWbbskv",
+	"gfFCA8/xCQ2SqRkuurnPaZHcMNylEBDjPVOTolsEV6lVBsZgAT5f2GoXaKGd86O3W/BEgBPA9SzMKDbn",
+	"+sbAvrvcCec72Ex92u77P/xiHnwEeJ3ouh2x1CaF3m5MeB/qcdNvI7ju5DHZuWhzR7WuzgFqOy0MALMf",
+	"Tgb3rwtRbxdvjhYKcRMfmOLDJDcjoBrUD0zvN4W2Kqd4f/dBfO6+oi4LN0xyqYIeNDVYwY2d7mLL2Che",
+	"i8EVRJwwxYlp4IEH8ktu7BsfzJ3jHeTLdNI81IemGAYYb1H3wkmM/Iv7mBo7U9KANJVhfoQQoAV5ag2U",
+	"nX9wrlewrudS82jsOgLMaSR3jTyEpWh8j6youhfjNvI+wOESiyN9KfcKlT4qW0A0iNgGyHloFWE3djsY",
+	"AMQXcImexMJ0KKdOojs5MlaVJXILO61k3W8ITeeu9an9uWnbJy6XqIPmZLkCE0fnecivHGYNKZSX3DAP",
+	"Ryi3QPUbXbXmPsx4GKeUA2q6jfJJxYyt4iOw85BW5ULzHKY5FDyh+vnZfWbu87YBaMcDeU4vlYXpjBK4",
+	"pDe9oWQ9qNKqh1Y0XoJpvlKMvrAMjyA+nhsC8b13jJwDjZ1iTp6O7tVD0VzJLQrj0bLdVg+o0XAM3HHX",
+	"yIHsOfoYgAfwUA99fVRQ52mjPuhO8Z9g/AShzTUm2YAZWkIz/l4L6Kof4wusdVN02HuHAyfZ5iAb28FH",
+	"ho5sSuH5WRonur5WHzACsK3wjR6Ax9d53J5ccWExXbUTpKd8bkHvdOD/OxfBfB9ii5VPCcNoBH9v+nGI",
+	"ycc1Mz0XcSAwf10gifg0V0wYxtljthKysu6LqqwvyKOBZ0vIW2jwIwnTZJDSsOA6L8BQKZtwbypNl5Gw",
+	"nQuegE4ES7Zf/Lju75QeVaKgndeSC8sqaUXhAUSOV7/bPz3t5Z1G4k4jcaeRuNNI3Gkk7jQSdxqJO43E",
+	"nUbiTiNxp5G400j8eTUSHyuH0zRIHCGdpFRy2nXpvPPo/LdKeV9fVUFBQtoJ1CEgW4pyJQzrLfZQBFng",
+	"BeFAFDDsY+5cXy++PX3JjKp0BixDCIVkZcGFZBbWtilMzw189TQEPLqrk69c8XG6X7HBF0/Y+d9OQzrU",
+	"pU/b2W57/9S5sjFjNwU88DXb6vLZoXgbSES6r93Gw5WQ+WhNX85fFOSfb9i31PoFJtBSJWiXaZFqHfY1",
+	"PhfAi+ceNzsUPlRZ3Dv8/o6j/T5pKb082la8DGJ+WCs3jLu4T/YiigT9fc4LA78PBYO68Va8HFEmkZjJ",
+	"NyrfdE4I7toJbWD7bDRJUYXkepPIVdUPxOiShlXIrjxh9XVZ7w+eurdPtH0y20VhyVrdlKM/PfoQlafG",
+	"aTasN5QLF5536OQoFenaTdR6VAM4KmshBWu4PWFvXL+Per8xgsgfsYaZfzJejO2WNdOgtlLZwHo+14iG",
+	"gPjk6aWzP0HCzqsMmLCGeYobcb1gPUwcaQFy6hnQdKbyzbTFvo5at1AuDDcGVrPdN1HMP+nE1ZePXSaW",
+	"07qnPs418iJa3DaeHBPNeuoZ8AB33lgYzZtrbNGInj1HGP/QLHqIjcYgMM+fUkqlDu/bl+k102zuGN8d",
+	"44tOY0ciENJnS+8ykeMPyPj0RldymOd9u4asQuDik3yftPNkkkNtTWxkzWFWLRb4Wujb6HBpQONhIaiP",
+	"wwrdcsdywf0oyA3+JvjY3zRUvjtcn7tE0ev3Q37IB7QdXG7ImLEqudwEky9qHVZV4XDoKl4fltG6hOap",
+	"/NeN7m9Iq/3at4h1t/6qbf/u0MKuuGFufyFnlcx93FV3YruW47OtuKEv1rJh01szq7j1Jlbn5x1zRYRd",
+	"bge8G1aCntq1dAeqdZh8eQV3cj9qou+7a+P2rg0XLg8DDLZfKqBhCAe6PXTE1+j6aCaLQvXiX094O6ix",
+	"9Y00GsMhLnHlKNfyoI4lveHb/iWNusXbT6EoGWdZIci6qqSxusrsW8nJfhMt7LjvexIU1cO873lokjYh",
+	"Jix8fqi3kpOTUW3VSfLAOSRMGN8BBBZrqsUCDPLRmIDmAG+lbyUkq6SwNNdKZFpNXYAvni+UXY5dS6wM",
+	"OKe8Kor9C7Ris8rGYxqnSzYW7YPO2QWnYWr+VnLLCuDGsh8FcmAcLiR1qF3OwF4p/a7GQrqQ0AIkGGGm",
+	"acXM9+4r1erxyw8KQPy/79zU2LjdIj0BdpEPQo7lEg3jlBO6ECYuDtmF/dZs4yshp0kiQyO+dxfr0ha7",
+	"T5noPAE9aBuO7BLeSrz9rGLE8bm9Hjl0LUC9s+hOR4dqWhvRMRSFtY56/h2Ey7AEk7kzu/wbhZBGdBAs",
+	"m7TxLst/Z+/3NLG0rlyguqVDF7L76ms7DjTyD4iWkqyTZse3uGiBvNV+8fkntzz8WzKg8WCvyf6A7ycp",
+	"r7z4traKhQ2fMI418F12R3xdKtonIcvKkgP4h1TgwSUvpuoStBY5mJErFUp+e8mLn+pu7ydHqH2YWs0z",
+	"mDqNwlisXWAfR6c4jpDCCl5M6VU9FiA4c73OXacd93FUCnW1glxwC8WGlRoyyF06NGFY854/dgkaWLbk",
+	"ckFXt1bVYumauXGuQENdNRKf0N0hkne7XcupS43Xh/HUV5GOswejj3yifA1dcFe8ns9nzxjzKk9wFEp8",
+	"OvRInxwNCtqI1MvGdc4hp81mRkgRLXkgwk8z8SEyxd4R/R3Rf+5En0rsSKibd7QVDl/xtnxgtdaHTmN6",
+	"i1qyj5Lj+K5QwL97oYDAgQzjTPPWGyRdoY4bJiy7orRIM2B4f1WknffVAP17nSLtoqPu830aXzswW3Ih",
+	"fU6dOq6B4LAsU6uVsDbUzv0gik3HzEijieiArNLCbujVwkvxj3eA//8NxX4D+jI8aCpdHD07WlpbPjs5",
+	"KVTGi6Uy9uTo/ST+Zjoff6vh/yO8RUotLrkF+raeKi0WQuKde8UXC9CNCvHoyfGjo/f/dwATwyri99MB",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
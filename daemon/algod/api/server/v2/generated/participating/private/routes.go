@@ -248,233 +248,248 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbtpIo/lVQ2q3yY8UZ23GyJ/7Vqf1N7CRnNk7i8jjZuxv7JhDZknCGAngAcEaK",
-	"r7/7LXQDJEiCEjUzcU7q5i97RDwajUajX+h+P8vVplISpDWzZ+9nFdd8AxY0/sXzXNXSZqJwfxVgci0q",
-	"K5ScPQvfmLFayNVsPhPu14rb9Ww+k3wDbRvXfz7T8I9aaChmz6yuYT4z+Ro23A1sd5Vr3Yy0zVYq80Oc",
-	"0RDnL2Yf9nzgRaHBmCGU38tyx4TMy7oAZjWXhufuk2HXwq6ZXQvDfGcmJFMSmFoyu+40ZksBZWFOwiL/",
-	"UYPeRav0k48v6UMLYqZVCUM4n6vNQkgIUEEDVLMhzCpWwBIbrbllbgYHa2hoFTPAdb5mS6UPgEpAxPCC",
-	"rDezZz/NDMgCNO5WDuIK/7vUAL9CZrlegZ29m6cWt7SgMys2iaWde+xrMHVpDcO2uMaVuALJXK8T9m1t",
-	"LFsA45K9/uo5++STTz53C9lwa6HwRDa6qnb2eE3UffZsVnAL4fOQ1ni5UprLImvav/7qOc5/4Rc4tRU3",
-	"BtKH5cx9YecvxhYQOiZISEgLK9yHDvW7HolD0f68gKXSMHFPqPGdbko8/++6Kzm3+bpSQtrEvjD8yuhz",
-	"kodF3ffxsAaATvvKYUq7QX96lH3+7v3j+eNHH/7lp7Psf/yfn37yYeLynzfjHsBAsmFeaw0y32UrDRxP",
-	"y5rLIT5ee3owa1WXBVvzK9x8vkFW7/sy15dY5xUva0cnItfqrFwpw7gnowKWvC4tCxOzWpaOTbnRPLUz",
-	"YVil1ZUooJg77nu9Fvma5dzQENiOXYuydDRYGyjGaC29uj2H6UOMEgfXjfCBC/rnRUa7rgOYgC1ygywv",
-	"lYHMqgPXU7hxuCxYfKG0d5U57rJib9bAcHL3gS5bxJ10NF2WO2ZxXwvGDeMsXE1zJpZsp2p2jZtTikvs",
-	"71fjsLZhDmm4OZ171B3eMfQNkJFA3kKpErhE5IVzN0SZXIpVrcGw6zXYtb/zNJhKSQNMLf4OuXXb/p8X",
-	"33/HlGbfgjF8Ba94fslA5qqA4oSdL5lUNiINT0uIQ9dzbB0ertQl/3ejHE1szKri+WX6Ri/FRiRW9S3f",
-	"ik29YbLeLEC7LQ1XiFVMg621HAOIRjxAihu+HU76Rtcyx/1vp+3Ico7ahKlKvkOEbfj2r4/mHhzDeFmy",
-	"CmQh5IrZrRyV49zch8HLtKplMUHMsW5Po4vVVJCLpYCCNaPsgcRPcwgeIY+DpxW+InDCIKPgNLMcAEfC",
-	"NkEz7nS7L6ziK4hI5oT94JkbfrXqEmRD6Gyxw0+VhiuhatN0GoERp94vgUtlIas0LEWCxi48OhyDoTae",
-	"A2+8DJQrabmQUDjmjEArC8SsRmGKJtyv7wxv8QU38NnTsTu+/Tpx95eqv+t7d3zSbmOjjI5k4up0X/2B",
-	"TUtWnf4T9MN4biNWGf082EixeuNum6Uo8Sb6u9u/gIbaIBPoICLcTUasJLe1hmdv5UP3F8vYheWy4Lpw",
-	"v2zop2/r0ooLsXI/lfTTS7US+YVYjSCzgTWpcGG3Df3jxkuzY7tN6hUvlbqsq3hBeUdxXezY+YuxTaYx",
-	"jyXMs0bbjRWPN9ugjBzbw26bjRwBchR3FXcNL2GnwUHL8yX+s10iPfGl/tX9U1Wl622rZQq1jo79lYzm",
-	"A29WOKuqUuTcIfG1/+y+OiYApEjwtsUpXqjP3kcgVlpVoK2gQXlVZaXKeZkZyy2O9K8alrNns385be0v",
-	"p9TdnEaTv3S9LrCTE1lJDMp4VR0xxisn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMWlPWlVlg4/aA7w",
-	"T36mFt8k7RC+eyrYKMIZNVyAIQmYGt4zLEI9Q7QyRCsKpKtSLZof7p9VVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"eYDL5+1Jiuc5f3HCvo7HRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/m28Zk5n6f1PmPQWIxbseJCxUtjznScfCXSLm536OcIeF4c88JO+v3vRnZuFH2EIw5b7F4",
-	"18SDvwgLG3OQEiKIImry28O15ruZFxIzFPaGZPKDAaKQiq+ERGjnTn2SbMMvaT8U4t0RAphGLyJaIgmy",
-	"MaF6mdOj/mRgZ/kDUGtqY4Mk6iTVUhiLejU2ZmsoUXDmMhB0TCo3oowJG75nEQ3M15pXRMv+C4ldQqI+",
-	"T40I1ltevBPvxCTMEbuPNhqhujFbPsg6k5Ag1+jB8EWp8su/cbO+gxO+CGMNaR+nYWvgBWi25madODg9",
-	"2m5Hm0LfriHSLFtEU500S3ypVuYOlliqY1hXVT3nZemmHrKs3mpx4EkHuSyZa8xgI9Bg7hVHsrCT/sW+",
-	"5PnaiQUs52U5b01FqspKuILSKe1CStBzZtfctocfRw56DZ4jA47ZWWDRaryZCU1surFFaGAbjjfQxmkz",
-	"Vdnt03BQwzfQk4LwRlQ1WhEiReP8RVgdXIFEntQMjeA3a0RrTTz4iZvbf8KZpaLFkQXQBvddg7+GX3SA",
-	"dq3b+1S2UyhdkM3aut+EZrnSNATd8H5y9x/guu1M1Hm/0pD5ITS/Am146VbXW9SDhnzv6nQeOJkFtzw6",
-	"mZ4K0woYcQ7sh+Id6ISV5nv8Dy+Z++ykGEdJLfUIFEZU5E4t6GJ2qKKZXAO0tyq2IVMmq3h+eRSUz9vJ",
-	"02xm0sn7kqynfgv9IpoderMVhbmrbcLBxvaqe0LIdhXY0UAW2ct0ormmIOCNqhixjx4IxClwNEKI2t75",
-	"tfaF2qZg+kJtB1ea2sKd7IQbZzKzR/j+lEs9YSHq5kfIp7hpeIHL+G5wYLeux7OF0jcTmHp3qGStQ5Vx",
-	"N2okL857dIBN6yrz7CfhlKEGvYHaGJb9ck5/+BS2Oli4sPw3wIJxo94FFroD3TUW1KYSJdzB6V4n5dQF",
-	"N/DJE3bxt7NPHz/5+cmnnzmSrLRaab5hi50Fw+57yyMzdlfCg+RBQwEqPfpnT4Mbrjtuahyjap3DhlfD",
-	"oci9Rwo+NWOu3RBrXTTjqhsAJzF9cLc3oZ2R59qB9gIW9eoCrHXK/CutlnfO8AczpKDDRq8q7WQn03WF",
-	"eoHwtHBNTmFrNT+tsCXIgkIp3DqEcWruZnEnRDW28UU7S8E8Rgs4eCiO3aZ2ml28VXqn67uw4IDWSiel",
-	"jEorq3JVZk6UFSpx173yLZhvEbar6v9O0LJrbpibGx20tSxGrjS7ldOvaBr6zVa2uNkrHtF6E6vz807Z",
-	"ly7yW0WrAp3ZrWRInZ2bdqnVhnFWYEcUp74GSyKm2MCF5Zvq++Xybgy6CgdKiARiA8bNxKiFE/AM5EpS",
-	"vOKB29+POgU9fcQER5odB8Bj5GInc/QG3sWxHReMNkJiaILZyTySkhyMJRSrDlne3ko3hg6a6p5JgOPQ",
-	"8RI/ozviBZSWf6X0m1ZC/1qrurpz9tyfc+pyuF+Md3gUrm+wdAu5KrsxsisH+0lqjb/Lgp43dhJaA0KP",
-	"FPlSrNY2UolfafUb3InJWVKA4geyh5Wuz9Aq9p0qHDOxtbkDUbIdrOVwjm5jvsYXqraMM6kKwM2vTVrI",
-	"HImqxHAujEKzsdyKJhhh2AIcdeW8dqutK4YxVoP7ou2Y8ZxOaIaoMSMRJk1oELWi6Shir9TAix1bAEim",
-	"Fj6MwweY4CI5BojZIKZ5ETfBLzpwVVrlYAwUmbe2HwQttKOrw+7BEwKOADezMKPYkutbA3t5dRDOS9hl",
-	"GM5o2P1vfjQPfgd4rbK8PIBYbJNCb99kOIR62vT7CK4/eUx2ZIwkqnXirWMQJVgYQ+FROBndvz5Eg128",
-	"PVquQGPUzG9K8WGS2xFQA+pvTO+3hbauRoL0vZruJDy3YZJLFQSr1GAlNzY7xJZdo44twa0g4oQpTowD",
-	"jwheL7mxFOklZIFmW7pOcB4SwtwU4wCPqiFu5B+DBjIcO3f3oDS1adQRU1eV0haK1BrQuDc613ewbeZS",
-	"y2jsRuexitUGDo08hqVofI8srwHjH9w2pjxvHBwuDsMG3D2/S6KyA0SLiH2AXIRWEXbjQOURQIRpEU2E",
-	"I0yPcpro6PnMWFVVjlvYrJZNvzE0XVDrM/tD23ZIXOTHoXu7UGDQR+Tbe8ivCbMUor7mhnk4grUWzTkU",
-	"kjaE2R3GzAiZQ7aP8lHFc63iI3DwkNbVSvMCsgJKvkvYmekzo8/7BsAdb9VdZSGjWOP0preUHEI79wyt",
-	"cDyTEh4ZfmG5O4JOFWgJxPc+MHIBOHaKOXk6utcMhXMltyiMh8umrU6MiLfhlbJuxz09IMieo08BeAQP",
-	"zdA3RwV2zlrdsz/Ff4PxEzRyxPGT7MCMLaEd/6gFjNiC/TOu6Lz02HuPAyfZ5igbO8BHxo7siGH6FddW",
-	"5KJCXecb2N256tefIBkbwAqwXJRQsOgDqYFV3J9RlGx/zJupgpNsb0PwB8a3xHJCJFIX+EvYoc79ip5f",
-	"RKaOu9BlE6O6+4lLhoCGoG4ngsdNYMtzW+6coGbXsGPXoIGZekFRGkN/ilVVFg+Q9M/smdE7oJPu370e",
-	"8QscKlpeym1JOsF++N70FIMOOrwuUClVTrCQDZCRhGBSeAyrlNt14V94hTc+gZI6QHqmjdEHzfV/z3TQ",
-	"jCtg/61qlnOJKldtoZFplEZBAQVIN4MTwZo5ffxliyEoYQOkSeKXhw/7C3/40O+5MGwJ1+FZpGvYR8fD",
-	"h2jHeaWM7RyuO7CHuuN2nrg+0HHlLj6vhfR5yuGgLj/ylJ181Ru88Xa5M2WMJ1y3/FszgN7J3E5Ze0wj",
-	"0wLacNxJvpxuCNRg3bjvF2JTl9zehdcKrniZqSvQWhRwkJP7iYWSX17x8vumGz75hNzRaA5Zjg8VJ44F",
-	"b1wfetvoxhFSuANM7xqmAgTn1OuCOh1QMdugB7HZQCG4hXLHKg050JM+JzmaZqknjIL98zWXK1QYtKpX",
-	"Pk6CxkGGXxsyzehaDoZIClV2KzM0cqcuAB+JF151OnEKuFPp+hZyUmCueTOff8g75WaO9qDvMUg6yeaz",
-	"UY3XIfWq1XgJOd2nqRMug468F+GnnXiiKwVR52SfIb7ibXGHyW3ub2Oyb4dOQTmcOApqbj+OxTU7dbvc",
-	"3YHQQwMxDZUGg1dUbKYy9FUt42foIRpyZyxshpZ86vrzyPF7PaovKlkKCdlGSdglM68ICd/ix+Rxwmty",
-	"pDMKLGN9+zpIB/4eWN15plDjbfGLu90/oX2PlflK6btyidKAk8X7CR7Ig+52P+VN/aS8LBOuRf9Itc8A",
-	"zLyJnBOacWNULlBmOy/M3Ac+kzfSv2jtov9V8/TmDs5ef9yeDy3Of4A2YigrxlleCrQgK2msrnP7VnK0",
-	"UUVLTQRxBWV83Gr5PDRJm0kTVkw/1FvJMYCvsVwlAzaWkDDTfAUQjJemXq3A2J6uswR4K30rIVkthcW5",
-	"Nu64ZHReKtAYSXVCLTd8x5aOJqxiv4JWbFHbrvSPb7CNFWXpHXpuGqaWbyW3rARuLPtWyDdbHC44/cOR",
-	"lWCvlb5ssJC+3VcgwQiTpYPNvqav+HTBL3/tnzFgRD99DnG1bVKImVtmJw/M/77/H89+Osv+h2e/Pso+",
-	"/7fTd++ffnjwcPDjkw9//ev/6f70yYe/PviPf03tVIA99ULYQ37+wmvG5y9Q/YleI/Rh/2j2/42QWZLI",
-	"4miOHm2x+5gNwxPQg65xzK7hrbRb6QjpipeicLzlJuTQv2EGZ5FOR49qOhvRM4aFtR6pVNyCy7AEk+mx",
-	"xhtLUcP4zPRbfHRK+uf1eF6WtaStDNI3PTUN8WVqOW/yLVAqtmcMH+OveQjy9H8++fSz2bx9RN98n81n",
-	"/uu7BCWLYptKlVDANqUrxu9A7hlW8Z0Bm+YeCHsylI5iO+JhN7BZgDZrUX18TmGsWKQ5XHiV5W1OW3ku",
-	"6Q2DOz/o4tx5z4lafny4rQYooLLrVIqmjqCGrdrdBOiFnVRaXYGcM3ECJ32bT+H0RR/UVwJfhsBUrdQU",
-	"bag5B0RogSoirMcLmWRYSdFP7wWHv/zNnatDfuAUXP05UxG9977+8g079QzT3KOsHTR0lGchoUr796Gd",
-	"gCTHzeJnc2/lW/kClmh9UPLZW1lwy08X3IjcnNYG9Be85DKHk5Viz8KT0xfc8rdyIGmN5o6M3oWzql6U",
-	"ImeXsULSkiflAxuO8PbtT7xcqbdv3w1iM4bqg58qyV9ogswJwqq2mc9mlGm45jrl+zJNNhscmdKV7ZuV",
-	"hGxVk4E0ZEvy46d5Hq8q089qMVx+VZVu+REZGp+zwW0ZM1Y1T+6cgOJfLbv9/U75i0Hz62BXqQ0Y9suG",
-	"Vz8Jad+x7G396NEn+HixTfPwi7/yHU3uKphsXRnNutE3quDCSa3EWPWs4quUi+3t258s8Ap3H+XlDdo4",
-	"ypJht87DyvDAAIdqF9C84h7dAILj6PfPuLgL6hUyV6aXgJ9wC7tvzG+1X1GKgBtv14E0A7y268yd7eSq",
-	"jCPxsDNNQruVE7JCNIYRK9RWfe6/BbB8DfmlT8oGm8ru5p3uIeDHC5qBdQhD6froESUmjEIHxQJYXRXc",
-	"i+Jc7vqZewy9qMBBX8Ml7N6oNt/UMal6upljzNhBRUqNpEtHrPGx9WP0N99HlYW3tD4BC75PDWTxrKGL",
-	"0Gf8IJPIeweHOEUUncwmY4jgOoEIIv4RFNxgoW68W5F+anlC5iCtuIIMSrESi1Sm4f8a+sMCrI4qfXJF",
-	"H4XcDGiYWDKnyi/oYvXqveZyBe56dleqMrykxLHJoA3Uh9bAtV0At3vt/DJ+2xigQ5XyGh+Xo4Vv7pYA",
-	"W7ffwqLFTsK10yrQUERtfPTyyXj8GQEOxQ3hCd1bTeFkVNf1qEskVQy3coPdRq31oXkxnSFc9H0DmJVV",
-	"Xbt9cVAon1CU8tZE90tt+ApGdJfYezcx5UfH44eDHJJIkjKIWvZFjYEkkASZGmduzckzDO6LO8SoZvYC",
-	"MsNM5CD2PiPME+4RtihRgG0iV2nvue54USnx8RhoadYCWraiYACji5H4OK65CccRU8IGLjtJOvsNXxDv",
-	"y753HsUSRnlfm9x64Tbsc9CB3u9z8IXEeyHbXqz0T8ic53QvfL6Q2g4lUTQtoIQVLZwaB0Jpc0K1G+Tg",
-	"+H65RN6SpcISIwN1JAD4OcBpLg8ZI98ImzxCiowjsDHwAQdm36n4bMrVMUBKn9OKh7Hxioj+hvTDPgrU",
-	"d8KoqtzlKkb8jXngAD7bRitZ9CKqcRgm5Jw5NnfFS8fmvC7eDjJIAocKRS/lmw+9eTCmaOxxTdGVf9Sa",
-	"SEi4yWpiaTYAnRa190C8UNuMXigndZHFduHoPfl2Ad9Lpw4mpdu7Z9hCbTGcC68WipU/AMs4HAGMyPay",
-	"FQbpFfuNyVkEzL5p98u5KSo0SDLe0NqQy5igN2XqEdlyjFzuRxn0bgRAzwzVlqPwZomD5oOueDK8zNtb",
-	"bd5mhg3PwlLHf+wIJXdpBH9D+1g3593f2tyG4/nTwon6KMn+hpal2yRhpM4VJVY8Jgdjnxw6QOzB6qu+",
-	"HJhEazfWq4vXCGspVuKY79ApOUSbgRJQCc46oml2mYoUcLo84D1+EbpFxjrcPS53D6IAQg0rYSy0TqMQ",
-	"F/R7mOM5ZohWajm+OlvppVvfa6Way5/c5tixs8yPvgKMwF8KbWyGHrfkElyjrwwakb5yTdMSaDdEkeop",
-	"iCLNcXHaS9hlhSjrNL36eb954ab9rrloTL3AW0xICtBaYP2PZODynqkptn3vgl/Sgl/yO1vvtNPgmrqJ",
-	"tSOX7hx/kHPRY2D72EGCAFPEMdy1UZTuYZDRg/Mhd4yk0Sim5WSft2FwmIow9sEotfDsfezmp5GSa4ky",
-	"HaZfCKrVCoqQwS34w2SUJ69UchUVqqqqfWkBTxhl58Pkenvy8vkwfBgLwo/E/UzIArZp6GOtACFvX9Zh",
-	"TkGcZAWS0pWkzUJJ1MQh/tgistV9ZF9o/wFAMgj6Tc+Z3UYn0y4124kbUAIvvE5iIKxv/7EcbohH3Xws",
-	"fLqT3HX/EcIBkaaEjWq3DNMQjDBgXlWi2PYcTzTqqBGMH2VdHpG2kLX4wQ5goBsEnSS4TrZwH2rtDeyn",
-	"qPOeOq2MYq99YLGjb577B/hFrdGD0YlsHqamb3S1iWv/5scLqzRfgfdCZQTSrYbA5RyDhijxu2FWUDhJ",
-	"IZZLiL0v5iaegw5wAxt7MYF0E0SWdtHUQtrPnqbI6AD1tDAeRlmaYhK0MOaTfzP0cgWZPjIlNVdCtDU3",
-	"cFUln+t/A7vsR17WTskQ2rThud7t1L18j9j1q803sMORD0a9OsAO7Apanl4D0mDK0t98MlGO7numU8UA",
-	"1cvOFh6xU2fpXbqjrfF1J8aJv71lOnUZuku5zcFogyQcLFN24yIdm+BOD3QR3yflQ5sgisMySCTvx1MJ",
-	"E6p0Dq+iJhfFIdp9A7wMxIvLmX2Yz24XCZC6zfyIB3D9qrlAk3jGSFPyDHcCe45EOa8qra54mfl4ibHL",
-	"X6srf/lj8xBe8ZE1mTRlv/ny7OUrD/6H+SwvgeussQSMrgrbVX+YVVGliv1XCSU094ZOshRFm98knY5j",
-	"LK4xeXnP2DSo+9LGz0RH0cdcLNMB7wd5nw/1oSXuCfmBqon4aX2eFPDTDfLhV1yUwdkYoB0JTsfFTSse",
-	"lOQK8QC3DhaKYr6yO2U3g9OdPh0tdR3gSTjX95iaMq1xSJ+4ElmRD/7hdy49faV0h/n7l4nJ4KHfTqxy",
-	"QjbhcSRWO5To7AtTJ4wEr19Wv7jT+PBhfNQePpyzX0r/IQIQf1/431G/ePgw6T1MmrEck0ArleQbeNC8",
-	"shjdiI+rgEu4nnZBn11tGslSjZNhQ6EUBRTQfe2xd62Fx2fhfymgBPfTyRQlPd50QncMzJQTdDH2ErEJ",
-	"Mt1QVVDDlOzHVOMjWEdayOx91Qlyxg6PkKw36MDMTCnydGiHXBjHXiUFU7rGDBuPWGvdiLUYic2VtYjG",
-	"cs2m5EztARnNkUSmSaZtbXG3UP5411L8owYmCqfVLAVovNd6V11QDnDUgUCatov5gclP1Q5/GzvIHn9T",
-	"sAXtM4Ls9d+9aHxKYaGpukZHRoDHMw4Y957obU8fnprpNdu6G4I5TY+ZUh0+MDrvrBuZI1ntXZhsqdWv",
-	"kHaEoP8okQgjOD4Fmnl/BZmK3OuzlMap3Batb2c/tN3TdeOxjb+1LhwW3RRWu8llmj7Vx23kTZRek07X",
-	"7JE8poTFEQbdpwEjrAWPVxQMi6UuQvQRl3SeKAtE54VZ+lTGbzlPafz2VHqYB+9fS3694KkyOE4XcjBF",
-	"29uJk7KKhc5hA0yT44BmZ1EEd9NWUCa5CnTrgxhmpb2hXkPTTtZoWgUGKSpWXeYUplAalRimltdcUqF0",
-	"14/4le9tgFzwrte10pgH0qRDugrIxSZpjn379qciH4bvFGIlqAZ4bSAqMu0HYpRsEqnIF+puMnd41Jwv",
-	"2aN5VOne70YhroQRixKwxWNqseAGr8vGHd50ccsDadcGmz+Z0Hxdy0JDYdeGEGsUa3RPFPKawMQF2GsA",
-	"yR5hu8efs/sYkmnEFTxwWPRC0OzZ488xoIb+eJS6ZX0N930su0CeHYK103SMMak0hmOSftR09PVSA/wK",
-	"47fDntNEXaecJWzpL5TDZ2nDJV9B+n3G5gBM1Bd3E935PbxI8gaAsVrtmLDp+cFyx59G3nw79kdgsFxt",
-	"NsJufOCeURtHT20FaZo0DIe11kJJrABX+Ijxr1UI/+vZuj6yGsM3I2+2MEr5O/TRxmidM07JP0vRRqaH",
-	"kqTsPOQWxhphTWkwwo2byy0dZUkMVF+ySgtp0f5R22X2F6cWa5479ncyBm62+OxpotZWt1aLPA7wj453",
-	"DQb0VRr1eoTsg8zi+7L7Usls4zhK8aDNsRCdytFA3XRI5lhc6P6hp0q+bpRslNzqDrnxiFPfivDkngFv",
-	"SYrNeo6ix6NX9tEps9Zp8uC126EfXr/0UsZG6VTBgPa4e4lDg9UCrvDFXHqT3Ji33AtdTtqF20D/+8Y/",
-	"BZEzEsvCWU4qApFHc99jeSfF//htm/kcHav0ErFnA1Q6Ye30druPHG14nNWt77+lgDH8NoK5yWjDUYZY",
-	"GYm+p/D6ps/vES/UB4n2vGNwfPwL004HRzn+4UME+uHDuReDf3nS/Uzs/eHDdALipMnN/dpi4TYaMfZN",
-	"7eEXKmEA+0JtiQuHgCKfHyFhgExeUu5mXPgx5qxbGu7jiw9387ArHWaaJv+wfvzcR8DvzB1xx/adaqxw",
-	"OsnohGsc1LVMOqEPRkFEG+BGXUCpnOoUl7qJrdRJsuvdYIECf198u8V7gJPYrkVZ/NhmLOuxR81lvk7G",
-	"vi5cx59J8uxcLMQAktUz1lxKKJPDkcb2c9DsErrn39XUeTZCTmzbr61Ky+0trgW8C2YAKkzo0Cts6SaI",
-	"sdpNBtUkGyhXqmA4T1uqoT35wxrMqcKQiVe7OOymtj4aE184+zQ6S1FicGHaG4otM83tCD/BQuWhao4b",
-	"B+uGG1KeaXTQjIsNXjeGb6oS8GRegXaav1riS9Fud0wMhiNHdRiYqdwnbIlpGBSztZZMLZfRMkBaoaHc",
-	"zVnFjaFBHrllwRbnnj17/OhR0piD2JmwUsJiWOb37VIen2IT+uJLB1GC+6OAPQzrh5aijtnYIeH4SolY",
-	"6jjFU6kGMto70PfnriSqkthU9DxhX2M+H0fEnQTuaIQLqXG7aSLrqlS8mGPK3jdfnr1kNCv1odrvVKVx",
-	"hTaoLvknnQbT02aGfEUj+WCmj7M/QYVbtbFZU1QxlXHPtWjLPopeJAlap2LsnLAXZBhsKu/TJAwTP+sN",
-	"FFENR1JNkTjcf6zl+Rotbp1rfpxXTi8vGthZ64+I3tQ1NX2QYTu4fYVRKjA6Z1ht+1oYwHfmcAXdJH9N",
-	"xktv8Q1J/7rL07WURCnHFOFuKvgci/YAHIlpwVWehKyH+CPtLVRl+NhqqxfYK/3CoFe6tefLDinjQuJo",
-	"9q03medcKilyTPCfEhcxIdk059uEWghpr5mZ+ROaOFzJgrHNC1ePxdESsoEResQNHdnRV7epRB30p4Wt",
-	"LyS2Ams8Z4NiHuo3ezePkAZ8jSZHRDGfVDoRqpMM72/CAo4kI8w1NGK3+8p9+85bdTHVw6WQaL/xaPPK",
-	"BzliSiPQ3yqZsGylwPj1dN+omJ9cnxPMPVjA9t3JS7US+YVY4RgUHOaWTZGQw6HOQlykj0N0bZ+7tj4j",
-	"fPNzJ8iJJj2rKj/peHXvpCBpt3IUwalonBAeESG3GT8ebQ+57Q1oxvvUERpcYSwWVHgPDwijqRDdHeVL",
-	"p0gRRWELRu8Ek2lhhUyA8VLI4BhMXxB58krAjcHzOtLP5Jpb0h0m8bQ3wMuRsH58d0ue5dsO1c+H71CC",
-	"awxzjG9jW9x6hHE0DVqJn8sdC4fCUXckTDznZRMQnChVjVKVF6IKfDLTK16dYhyOcYcy/90L4OCjtKY7",
-	"1pg49iYay7y3qIsV2IwXRSph0xf4leHX8PQJtpDXTWml5s1bN/P2kNr8RLmSpt7smSs0uOV0UTX4BDXE",
-	"FenDDmP+mMUO/03VFRrfGR8KfPRb0xD3WxyXbn74djYl9TqazoxYZdMxgXfK7dHRTn0zQm/73ymlh0eo",
-	"/xRvTHtcLt6jFH/70l0ccTraQdQ1XS1NtliMcFb4PaTxafIcdrkSXmWD6lnoy8fNS2xZD/jQMAn4FS9H",
-	"3nfHHgC6X8kqPvbKOx9NSsCtTzplOdvLgkYT+VAEbM+nMHSMjUW9UtDr3dni/Vr3InTcI/VNx/9EkU8t",
-	"sxj1O93MNdRu8LG+oUHJ+aHgQ5aKtglrartNqvXWYZBTinSk6kF4MaFT9P5Ayf4Bhl9MuRmGJfjns/Pi",
-	"KN6Zqikyo1GSO5AsqD+ecr1Ns47CT6WMaEsspirtT4ymfoPF8qOU8cOxQpTdFeQW62q20UMa4JgE8m6y",
-	"YP//M/X6uGbVBJ37jOv70qwPi2keYPeDzDBRdiMqRHgyPan4WRMjSk9crrlp81H0HoVOfpq2XEKOaV/3",
-	"ZuL5L6eAt1le5kFFR1iWUWIe0TzUwMTFxxugWoD2JcrZC09UQOTW4Iw91L2E3T3DOtSQrIzYvFK6SWZU",
-	"xAB5Q0KS3DGbog+LEaahDMRCiHn0uWbb7P+jSW2jvFI3nCuQpLs42lxTe6ZMV3WeNJfrelReO3xzMJas",
-	"Z1gUdlwUfYE1eI2PAOJNZtVYYWPnw8og1z4zK+ZNaszoIUcrmPBbSJJGs5TiEuKy7+i0uOa6CC3uJOsN",
-	"3U0iDfSymVm0EepDf3ci1zw+9shL5cSIbOzFTDcovImoumco9K3NUIJwLUFrKBrreKkMZFaFiPZ9cOxD",
-	"BcX33QgJZrS+CwE3mtv3dZu8GOtccczly31YX7xApmHDHXQ6SjE8Puc+ZD+n7+GVcahzdNDY0NDr4YKb",
-	"4W2CMAMkxlS/ZP62PPx6+SZ2ByEl6Cw4Ifr5hmU35RQmFizqnC7o+GA0tpnJyUH2sJKkyp4PV9nTEaJX",
-	"wJewOyXFJ1QqDTsYA02SE4EeZVTsbfKdWmJMCu7VnYD3+ybKqpQqsxG79/kwSXKf4i9FfgmY5KyJ4R0p",
-	"Qs3uo7m1cWxer3chKXBVgYTiwQljZ5JeTQQfZ7d+Wm9yec/um3+LsxY15S339pWTtzIdfo4ZxfUtuVkY",
-	"Zj8PM+BY3S2nokEOpODdyrHoi+tESfaTqVr50OvYL5PdEhVBkZJJLsh58RwPeqp6ML7xjpIRoE+LM+/0",
-	"YKZUqWDFm7xDd0OlMRVPhgBZkFOeQzdQ+MGTCEgWfk6cQsrt5bN6qSXT0PoTb5rebFijOqXR92duZuny",
-	"u6XS0Kk27XpTKsMmsj+Uhed6IazmeneTJGSDGtkD68kolg9G5jRBOe1C2sCcIQ7LUl1nyKyyJpF/SrV1",
-	"7Uz3Mg5Vpdp+7lQvIArx4cYLaju25gXLldaQxz3SD9oIqo3SkJUKI35SzsildXL3Bl+xSFaqFVNVrgqg",
-	"ghhpChqbq5aSo9gEUYBFEgVEO/gckvpEdDxxyrsq0E5pa2jRGbm1RoJXwfg0NR5D1HgI757i5mnevBRb",
-	"pBvQqSO/ZFbXMGe+Rb8IsD/4XAPDmvoISkNL16Is8WWs2EZOuMaHnUbtiNh7jhF2VwLDMLqvpEkajkvO",
-	"w5EV5z2c+4rOsx9MjZEy+ETGTfGUbZSxXtOkkdolt9FH93MlrVZl2TVKkYi+8o6Kb/n2LM/tS6UuFzy/",
-	"fIB6rVS2WWkxDw9I+3Fi7Uy6lztpYnX8fi5SaodRU55ojy6B7znZ0ZWrIzDfHeagh23uZ6kK/911dZlp",
-	"Wo05k4xbtRF5+kz9sQKvRsOlUiwqmZSJisfRM3pshoc9vqwaPzuyyCGaQfJk9asz5hmB9zciu3H/RQm8",
-	"Py5bgmc0IxflkLl4KSrLR2W9HgAIKb3ttLWminOxJNZwFbWit+DoLe0DOvFWwaCU28HmRrhzoCzcCqhB",
-	"IFwD4H0yPswpeRYF1S3UNnx/0GbXuhHwH/ZTeYd5jEX7XLSkpSneJ2TiGOEI6Ry+e0Nj3uC73sXUAJmm",
-	"OujEGz4CYDxkpgPDpMCZY8FYclFCkaWKy503Nqp5pGn7Vzr9ms/CeE6e8zrUdnNj1xp8ZggS8XXX/1Vx",
-	"R0qqaT60JMsCtkAh/lTonhvyewT/C5RU061nDFBVVsIVdCKJfLqKGkVNcQWhr2k6swKgQm9k30aWCpGJ",
-	"7/Ke4cSvPYuCLKZgN2lJIcTSTrEDZpKkUWcrMzomZupRchBdiaLmHfyZY0WOrhnQHeUEqgY6Qhb0yKnT",
-	"/EAjvA4DnIX+KVEmYOLdND50NAtKo24fAzoYMlebsVMv0xFzcS6WxsGCsxWNI5ZIvOUbpuLXctwgOST5",
-	"Vt2auE9CyQixX24hR6nG6ztQeI1nxEnh0zogtUuAgrQC1yVhbV+DZFJFNfSuuWlUlTZJXPiBJsZGQnpt",
-	"+gZO5Taw7fY7y3AwZnrZokYVCd3Q6c3N87/LSdx7EEfHS9GIAf8SbI/9K1C3VzuwAdYqlm4/neyPVej8",
-	"Lea5+Jwt6jBQWaprKooX66EvIPhBifqCC8iL5aK5lkMA39znL+ybOkQUurzhO6Y0/uO0zn/UvBTLHfIZ",
-	"Aj90Y2bNHQl5xytFBPiAQDfxfvFqHgAL1hYVpqJ1i6ljRsPt3CgR0O4iD9VLFNvwS4i3AYMdiH/m1jFO",
-	"Uy/QcuGu7N52DrHgFx9yUGx4EWv6mAmvWyc65EZ1vf+/9llUPFVIYFWVPA8lEH0Nli6fwTKngbjsGjb7",
-	"380N+VoggaZ0aku0Ojy0Lm5gMj2SdaWC0cfqS3TAHpSUHJTWuNUyjqk+375Z3/PicNJS7noXpkbdDICO",
-	"C9EdAj+uy/dx8J9MUjm2jCng/7PgfaQSZwwvFd38CFjuJGNIwErW6oXaZhqW5lCACZmrnTqv2zQOwcQq",
-	"ZK6BG4q4Of/eK55tDkYhnSJMMaGNT7MZpYClkC2zFLKqbUKPwVSMchchLDb6I1pHXGhjUoITJq94+f0V",
-	"aC2KsY1zp4Nq1sU58IOjw/dNmDCaO3U4gDCtDodP9VozetzMXeBUZYfCNY3lsuC6iJsLyXLQ7t5n13xn",
-	"bu5RapwDh3xKPJJmug/II+8SkjYBUu68U/iW/p4GQH6Hjp8JDhuMC044a8i0Y9WIf2YIwx/CYbPh26xU",
-	"K3xQNnIgfPJN9PCRCqgkmsFJPpu27jCPEb/C/mkw77hnRFbhrFOm2H/uv8etRDXyByns3pNPNsr+Cz+K",
-	"u6WDGZAqV23wPxHL8DymHmX6PBzxw8wgbIaH7IH2INpEGPEPde3iI7uIYRD+RW9sBJ9ez6kbaZF6+kmW",
-	"gQwtBmZPeD+YNpSd5z48a2hKG5gaCClz/3D2SEsb2efDvTQCHhXf9me9O20TMuPGOaYI1v6nslmlqiyf",
-	"EvNJpQkK7ybwkHZhHKGPyAkwsu4mPMY0xTo6KXA6VTuOrQM2WjXkkLeryvcp/WNmohGO3nVBqCXyMipN",
-	"jdYtfMnTGFPmQb0OPumuGaxhEowzDXmt0Ux8zXeH6yqNpMS9+NvZp4+f/Pzk08+Ya8AKsQLTplXu1SVq",
-	"4wKF7Nt9Pm4k4GB5Nr0J4SE6IS74H8OjqmZT/FkjbmvanImDqkzH2JcTF0DiOCbq4dxor3CcNrT/n2u7",
-	"Uou88x1LoeC33zOtyjKd1r6RqxIOlNRuRS4Up4FUoI0w1jHCrgdU2DYi2qzRPIjJTa8osYiSOQT7sacC",
-	"YUdCrlILGQuoRX6Gz3y914jBtio9ryJPz751eT2NLHQoNGJUzAJYpSov2oslS0GEL4h0DY1l3Bs+0SIe",
-	"xcg2zJaiZVOE6CPP06QXVwTez+271SptmtO7TUyIF+FQ3oA0x/wT40/Yb8JJWtP+Pw3/SLzJvzOu0Sz3",
-	"t+AVSf3gZlXHJ4E2fJ+dIA8EYOS1beedZPRQLMq0qslLgP6E4EDuix/fto7lg89CEJLQ4QB48fPZtl3z",
-	"ksGD8ztnMP22QUq0lHdjlNBZ/qEXuYH1NhdJtEXeaGItGGJLaigWRs+tzfPmFfOIVjJ47KyVssxppmWZ",
-	"eCRNdhw8UzHhOJVAX/Hy43ONr4Q29gzxAcXr8adR8UvZGMmESnOzlG0v+aS5o1exdze1fIUPs/8L3B4l",
-	"7zk/lHfCD24zNO5gSe5VuBXorTe7xjEpyOrxZ2zhqwlUGnJh+s796yCcNA9DQYulD2iFrT3wEvXQOn9U",
-	"9hZkvAyROOy7yL3V+Ow9hO0R/Z2ZysjJTVJ5ivoGZJHAX4pHxdVHD1wXt8w8f7MMIFEuryMzgAzrqk5d",
-	"Hq4DL53awHCdk2/rDm4TF3W7tqnpayYnsH/79ie7mJJ1Jp1s3nXHtDd3knX+qJzzv0HCG8KRH8PPm6KY",
-	"H8dSoFKaz5E0zb39qEV5MGClk3T7w3y2AglGGEwr/bMvjvFx79IAAWVeGB5VgvU26WIIMYm1diaPporS",
-	"aU/IpO27JdIf46vGvNbC7rAwajCgiZ+TpWy/bnJ7+NwwjS/N331WXUJTnLrNBFKbcLt+rXiJ9xG5+KS7",
-	"hVR5wr6kZM/+oPz13uLf4ZO/PC0effL43xd/efTpoxyefvr5o0f886f88eefPIYnf/n06SN4vPzs88WT",
-	"4snTJ4unT55+9unn+SdPHy+efvb5v99zfMiBTICGLO/PZv8rOytXKjt7dZ69ccC2OOGV+Abc3qCuvFRY",
-	"uM8hNceTCBsuytmz8NP/H07YSa427fDh15kvQDNbW1uZZ6en19fXJ3GX0xU+/c+sqvP1aZgHy6l15JVX",
-	"502MPsXh4I621mPcVE8KZ/jt9ZcXb9jZq/OTlmBmz2aPTh6dPPa1eyWvxOzZ7BP8CU/PGvf9FFMtnhqf",
-	"Rf20fauV9Nu9xpD1IJzrFRTsfvPq5t8az615EB7vLEWJV8bfDRFjs4rzAonLF2GcYVkpDMZCsJ48ehT2",
-	"wks60YVziq8/nr2ftcW7+8LEAKlvWoCTkLVF7YaL/kFeSnUtGeaFowNUbzZc72gFHWxEg+M28ZVBI7sW",
-	"V9zC7J3r3cd5Vfnc9WMoxzI+3VMeOiOBNMnP3QmjnOg+A71JoXyYN/+W2N+bJ3AwWWJ3sNErB3NIn9Pk",
-	"1vMOIY8z9BkTwpozQmaHAaLns6pOoPNLfFhj9uFsHuVjJ2hUWTQYH2D0Vf3/CEYd6fq7afbsvftrDbzE",
-	"xFruj40j1Dx80sCLnf+/uearFegTv07309WT06CFnL73GVM+7Pt2GkeEnb7vJJYpDvQMEU+Hmpy+DzWB",
-	"9w/YqQfrY02jDhMB3dfsdIF1gKY2hXh140tBmjen71EBH/391FtR0x/REEI37GlI0DTSklJxpD92UPje",
-	"bt1C9g/n2kTj5dzm67o6fY//QbKNVkRJXk/tVp5i4Mjp+w4i/OcBIrq/t93jFlcbVUAATi2XVEh53+fT",
-	"9/RvNBFsK9DCaaGYTcv/SlkPT7Ge3m74807myR+H6+hkfDtwmWM2QROiqbqJ4pLXRz/7nLkts5uWy6af",
-	"824oYA8lqX0r+zCfPb1DrtxNGpsA5gtesJDYAOd+/PHmPpcU1e1ESxKBEYKnHw+Czvaxb2DHvlOWfYW2",
-	"mg/z2acfcyfOpdMceRkEuhuKftOOT/8adbJ300yuSFBRlOyie9TOimJA9KRDgrFfKLxdxzC2MavKe2lb",
-	"pLUqtJBuCfNpYvMwfSQlOQuChFQFzGLl1uoaPtySJ/Tiu7i25wmTMvpG8KHHMlQgj0BN5kLsR7/QyEPz",
-	"xyESbovkt+8j/uQpf/KUhqd8+uiTjzf9BegrkQN7A5tKaa5FuWM/yObhzY153FlRJBPIdo/+QR43n22z",
-	"XBWwApl5BpYtVLHzxVlmnQkugaxlA0HmNFiXOhrDCPcMdquUtNKGg8+e/ZQKi/DPG6t6UYqckWUdTUsV",
-	"t+vI8tNk9Owyv/key8Q8kUCcFaKsm2wO9lr519LDCyWytljFzD80Xjx4EIXdsWshC3WN1fwR3H/UgHze",
-	"wxummSUAjGJ8h7VyWoehA3AA1th86Gmcgp09k7/kN5u75MdO/e63tkE1GfP+8+L776LXiGRpoIAgfAtH",
-	"pIsPF7TCgPxrjhGhVFPvOdmAyh2+qrXc1qZTzuvkz3voT95/e97/dZNCmQp5WazQM2RJ0V1wMkngTfL2",
-	"950/vd1iRuHYqcTH7nfG2QqLMA4vqMWOnb8YaK/UrX8lfLHDpr1bIcHv+yAexfhH2Ms+kcYtZKVsE5RO",
-	"i/pTyPxTyLyV4jr58EzRXZOWJSqNygf62DxUOe08/MHk5RjiNQBliv3pdz2+d7LxQ9tWypZFSdahYNEH",
-	"yk7RR/OfLOJPFnE7FvE1JA4jnlrPNBJEd5ytayrDwFRKRSfEMkgdoXldch09CD5kwj7DEdOq4G/CNT62",
-	"wS6JK7LX4bsBQQGziQ28WxvenyzvT5b3x2F5Z4cZTVcwubXV6xJ2G141ti6zrm2hriMPOcJCwe5DHx8p",
-	"/v2/T6+5sNlSaV+yhy8t6GFnC7w89aWae7+21REHX7DkY/RjnIwu+esp7zotu45zx3rHOg686qmv3nE8",
-	"0ijkUAif25i9OAYO2X4T/fbTO8eyDeircCO0IV3PTk8xqc5aGXs6+zB/3wv3ij++a8jjfXOPeDL5gHSh",
-	"tFgJycvMx0a09eZnT04ezT783wAAAP//7QzMgdgaAQA=",
+	"H4sIAAAAAAAC/+y9f5Mbt44o+lVY2q3yj5VmbMfJnvjVqX0TO8mZjeO4PE7O2xf7JlQ3JPFMi+xDsmek",
+	"+Pq73wJIdrO72VJrRrZP6vove9T8AYAgCAIg8G6SqXWpJEhrJk/eTUqu+RosaPqLZ5mqpJ2JHP/KwWRa",
+	"lFYoOXkSvjFjtZDLyXQi8NeS29VkOpF8DZMncf/pRMM/K6EhnzyxuoLpxGQrWHMc2G5LbF2PtJkt1cwP",
+	"ceaGOH82eb/jA89zDcb0ofxJFlsmZFZUOTCruTQ8w0+GXQu7YnYlDPOdmZBMSWBqweyq1ZgtBBS5OQlI",
+	"/rMCvY2w9JMPo/S+AXGmVQF9OJ+q9VxICFBBDVS9IMwqlsOCGq24ZTgDwhoaWsUMcJ2t2ELpPaA6IGJ4",
+	"QVbryZNfJwZkDppWKwNxRf9daIA/YGa5XoKdvJ2mkFtY0DMr1gnUzj31NZiqsIZRW8JxKa5AMux1wn6s",
+	"jGVzYFyyV989ZV988cXXiMiaWwu5Z7JBrJrZY5xc98mTSc4thM99XuPFUmku81nd/tV3T2n+C4/g2Fbc",
+	"GEhvljP8ws6fDSEQOiZYSEgLS1qHFvdjj8SmaH6ew0JpGLkmrvFRFyWe/5OuSsZttiqVkDaxLoy+Mvc5",
+	"KcOi7rtkWA1Aq32JlNI46K8PZl+/ffdw+vDB+3/79Wz2//s/v/zi/Uj0n9bj7qFAsmFWaQ0y286WGjjt",
+	"lhWXfXq88vxgVqoqcrbiV7T4fE2i3vdl2NeJziteVMgnItPqrFgqw7hnoxwWvCosCxOzShZgDI3muZ0J",
+	"w0qtrkQO+ZQJya5XIluxjBs3BLVj16IokAcrA/kQr6Wx27GZ3sckQbhuRA9C6F+XGA1eeygBG5IGs6xQ",
+	"BmZW7TmewonDZc7iA6U5q8xhhxV7vQJGk+MHd9gS7STydFFsmaV1zRk3jLNwNE2ZWLCtqtg1LU4hLqm/",
+	"xwaptmZINFqc1jmKm3eIfD1iJIg3V6oALol4Yd/1SSYXYllpMOx6BXblzzwNplTSAFPzf0Bmcdn/++Kn",
+	"F0xp9iMYw5fwkmeXDGSmcshP2PmCSWUj1vC8RDTEnkN4eLhSh/w/jEKeWJtlybPL9IleiLVIYPUj34h1",
+	"tWayWs9B45KGI8QqpsFWWg4B5Ebcw4prvulP+lpXMqP1b6Zt6XLIbcKUBd8SwdZ889cHUw+OYbwoWAky",
+	"F3LJ7EYO6nE4937wZlpVMh+h5lhc0+hgNSVkYiEgZ/UoOyDx0+yDR8jD4GmUrwgcIfeAI+Q4cCRsEjyD",
+	"uxu/sJIvIWKZE/azF2701apLkDWjs/mWPpUaroSqTN1pAEaaercGLpWFWalhIRI8duHJYRhnro2XwGuv",
+	"A2VKWi4k5ExIB7Sy4ITVIEzRhLvvO/1TfM4NfPV48n7f15Grv1DdVd+54qNWmxrN3JZMHJ341W/YtGbV",
+	"6j/ifhjPbcRy5n7uLaRYvsbTZiEKOon+gesXyFAZEgItQoSzyYil5LbS8OSNvI9/sRm7sFzmXOf4y9r9",
+	"9GNVWHEhlvhT4X56rpYiuxDLAWLWsCYvXNRt7f7B8dLi2G6S94rnSl1WZYxQ1rq4zrfs/NnQIrsxD2XM",
+	"s/q2G188Xm/CZeTQHnZTL+QAkIO0Kzk2vIStBoSWZwv6Z7MgfuIL/Qf+U5YF9rblIkVa5GN/JJP5wJsV",
+	"zsqyEBlHIr7yn/ErCgFwFwnetDilA/XJuwjEUqsStBVuUF6Ws0JlvJgZyy2N9O8aFpMnk387bewvp667",
+	"OY0mf469LqgTqqxODZrxsjxgjJeo+pgdwgIFNH0iMeHEHilNQrpFRFYSKIILuOLSnkymqT3ZbOBf/UwN",
+	"vZ224+jduYINEpy5hnMwTgN2De8YFpGeEVkZkZUU0mWh5vUPd8/KsqEgfT8rS0cP0h5BkGIGG2GsuUfo",
+	"82YnxfOcPzth38djkyqu0Lw0B69q4Nmw8KeWP8Vq25LHoRnxjmG0nGiseT+tyWAM2GNwHF0rVqpArWcv",
+	"r2Djv/m2MZvh76M6/zlYLKbtMHNhK+Yp5+449Et0ubnb4Zw+43hzzwk76/a9GdvgKDsYxpw3VDw289Av",
+	"wsLa7OWECKKIm/zycK35duKVxBkpe302+dmA45CSL4UkaKd4fZJszS/deiiiOzICmPpe5HiJBm1MqF7n",
+	"9KQ/6dlZ/gTcmlrYoIkaxlkhjKV7NTVmKyhIceYyMHTMKjfijBELvgOJGuZrzUvHy/6LU7uEpPu8a+Rg",
+	"veXBO/JMTMLcfI4XmqC6sVjeKzqTkOCHLgzfFCq7/Bs3qyPs8HkYq8/7NA1bAc9BsxU3q8TG6fB2M9oY",
+	"/saGxLNsHk11UqP4XC3NEVAs1CGiqyyf8qLAqfsiq4MtDTxqIxcFw8YM1sLa5uLoLOzu/sW+5dkK1QKW",
+	"8aKYNqYiVc4KuIKCKc2ElGjtsitum81PI4d7De0jAyjsLLAIG29mIhObrm0RGtia0wm0xttMWbT71BLU",
+	"8DV0tCA6EVVFVoToonH+LGAHVyBJJtVDE/g1jmStiQc/YWf1J5pZKoecswDa4L6r6VfLixbQ2Lo5T2Uz",
+	"hdK5s1lb/E1olinthnAnvJ8c/wNcN50dd94tNcz8EJpfgTa8QOw6SN2r2fdYu3PPzsy55dHO9FyYvoA5",
+	"yUH9SL0DnbDS/ET/4QXDz6jFICc13CNIGVGROzV3BzOSys2EDcjeqtjamTIZ2hcPgvJpM3lazIzaed86",
+	"66lfQo9EvUKvNyI3x1omGmxordo7xNmugjjq6SI7hU401xgCvFYlc+KjA4KTFDSaI4jaHP1Y+0ZtUjB9",
+	"oza9I01t4CgroTbuP6OEPcH3WS/1jEWkmx6gn9Ki0QEu47MBwW5cj2dzpW+mMHXOUMkahyrjOGqkL047",
+	"fEBNq3LmxU/CKeMadAZqYlh26znd4VPUalHhwvIPQAVjeQT8LajQHujYVFDrUhRwhN29SuqpaAL/4hG7",
+	"+NvZlw8f/fboy6+QJUutlpqv2XxrwbC73vLIjN0WcC+50UiBSo/+1ePghmuPmxrHqEpnsOZlfyjn3nMX",
+	"fNeMYbs+1dpkJqxrAEcJfcDT25GdOc81bUpyRL6CQvH8OLbMQsCAcMpWXC4hZwasFXLpTXWQB51PV1Ki",
+	"uJQqh0NOQ6IDcuusIdGo+Z2MJLXF8ktgsFhAZr0bjDM/6i0O5kCOBISj1iwCOvMeYyfGAwoIwTOYV8sL",
+	"/8NLrRZHP7N7M6SApUYvS43qr2l7s/36nubY5BQ2VvPTklqCzElsER7CcGNgPT+KXBjau3kzS878pshh",
+	"r1w7dKc102yj3fZMb3V1DCMcaK10kh9LrazKVDHD24hQCXXlpW/BfIuwXGX3dwctu+aG4dzkY69kPqCV",
+	"oPN8tJblhn69kQ1tdm4kh28COz/vmHVpE7+5K5cYErSRjLizpSwttFozznLqSBrx92DdLUGs4cLydfnT",
+	"YnEcm7yigRKCS6zB4EzMtWACd3+mpAs53aPA+VHHkKdLmOALtcMAeIpcbGVGDt1jbNth3XYtJEWXmK3M",
+	"IkUXYSwgX4IeQY/xiuwQOdxUd0wCHCTHc/pMHqVnUFj+ndKvm0vW91pV5dHFc3fOsehwj4z3WeXYNzgr",
+	"hFwW7TDnJcJ+ksLxkyD0tDZ1ORwIeuLI52K5spFV46VWH+BMTM6SApQ+OJNmgX36hs0XKkdhYitzhNtA",
+	"M1gj4ZBvY7nG56qyjJOiRYtfmfQ9YSAwlvQSCiS08dWDrGjCsDkgd2W8QmyrklGYXO+8aDrOeOZ26IxI",
+	"Y9ITNtFdrpWbzgVdFhp4jiZLkEzNfSSOjxEiJDnF+NmgaftbSkJetOAqtcrAGHR2Or/EXtBCO3d02B10",
+	"IsAJ4HoWZhRbcH1rYC+v9sJ5CdsZRaQadveHX8y9TwCvVZYXewhLbVLk7Vp9+1CPm34Xw3Unj9nO2ZMd",
+	"1zKr6GJVgIUhEh5Ek8H160LUW8Xbk+UKNAU+fVCOD5PcjoFqUD8wv98W2qoceGfhLS2o4eGCSS5VUKxS",
+	"gxXc2Nk+sYyNYlwMYhBJwpQkpoEHFK/n3FgXrCdkTpZ3d5zQPNSHphgGePAagiP/Em4g/bEzJQ1IU5n6",
+	"OmKqslTaQt5M1uBA9tnBuV7App5LLaKx6zuPVawysG/kISpF43ti+Rsw/cFtbY319t0+chT5gef8NknK",
+	"FhANIXYBchFaRdSNY80HABGmIbRjHGE6nFMHuE8nxqqyRGlhZ5Ws+w2R6cK1PrM/N237zOVccTQnyxUY",
+	"cvP59h7ya0dZ98pgxQ3zcASDO1nkXFRhH2bcjDMjZAazXZxPVzxsFW+BvZu0Kpea5zDLoeDbhKvAfWbu",
+	"864BaMWb666yMHPh4ulFbzg5ROfuGFrReAmh+UIx+sIy3IJ4FWgYxPfeM3IONHZKOHk+ulMPRXMllyiM",
+	"R2i7pU6MSKfhlbK44q6RA9lL9DEAD9ChHvrmpKDOOwyS/wPGTxDa3GCSLZghFJrxD0JgwJzvX+JF+6Uj",
+	"3jsSOCk2B8XYHjkytGUHfAsvubYiEyXddX6A7dGvft0JkuEdLAfLBRoZow/uGljG/ZkLdO6OebOr4Cjb",
+	"Wx/8nvEtgU4IJmsDfwlbunO/BNDfcHkUdy0/wI7o593vIecjjYSoQ5UAms25JJwDdi/FUbArxYHYvRT7",
+	"sSvFIdgJibdjRLJeuqNgBqAPQw3DF/fjRsOORS5TUkJm2/hRKE9khTuGmSUxKhPuzSaiEZ6M4O0wbgIb",
+	"ntliyzjph1t2DRqYqeYuBqzvrcVIr3iApPd3x4w+vCUZXLIz3uaChorQS7nd3HV1N3yvO3fWFjn8NbVU",
+	"qhhhvO0RIwnBqOA7VipcdeHfj4YXhEHItYD0+kSxDeB6LSYmM2HA/kdVLOOSrAGVhVrdVpp0WOxLMwgT",
+	"zemjuxsKQQFrcEYO+nL/fhfx+/f9mgvDFnAdHl3fv98nx/37ZGJ8qYxtyf1jbHiu7XlCsyG3OOpk/oLc",
+	"Pe72h4z6kces5MvO4GFS2lPGeMZF9G8tADo7czMG95hHxoXL2s1IzF+3Ayx7eNO6X4h1VXB7DIcqXPFi",
+	"pq5Aa5HDXvnuJxZKfnvFi5/qbvSgHDLk0Qxmzqk9cix4jX1cwAKOI6SwIryaGgsQnLteF67THutHE1Il",
+	"1mvIBbdQbFmpIYPcOYSEYaZG9YTRsN5njyJeq2rpo7DcOCTw8YE+PYmuZG+IpL5vN3JG/pfUAeDjfMOb",
+	"cdT0gaO1oeu8cXfra17PB3nrXBi5Bl1nVtJ/O50MGmOQqFeNMcYRp/3wfcRh0LqKRPRpJh7p5SPSoVre",
+	"p1e8LLiZcHE/jDepGToFZX/i6MlE83Ho1QRagortEZQeNxDTUGowdETFFlTjvqpFnOQixFpvjYV138nk",
+	"uv42sP1eDZoylCyEhNlaSdgm8zoJCT/Sx1Rvd0wOdCaFZahv93rcgr8DVnueMdx4W/rSand3aNeZar5T",
+	"+ljeejfgaKV/hHN8733AT3lTFz7G8ve93v4JfFcAmGkdlys048aoTJDOdp6bqdto3lHu38u3yf+yfth3",
+	"hL3XHbfj3o2zq5D7AoqScZYVgpwbShqrq8y+kZzMpxGqiRDRYCcaNqg/DU3SFvyEgd0P9UZyCg+ujarJ",
+	"WKIFJCyI3wEEu7qplkswtnPXWQC8kb6VkKySwtJca9wuM7dfSrzaby2cuJb40GWBPGEV+wO0YvPKtrV/",
+	"yvBgLJrnna8Zp2Fq8UZyywrgxrIfBUYy4XAhHiVsWQn2WunLmgrp030JEowws3Qo6/fuKz2M8uiv/CMp",
+	"/L/vHKL2m5QzE0SzlWXqf939ryeYXYrP/ngw+/o/Tt++e/z+3v3ej4/e//Wv/7v90xfv/3rvv/49tVIB",
+	"dpEPQn7+zN+Mz5/R9Sd669SF/aO5pjBpSZLJ4kCjDm+xu5RrxzPQvbbd1q7gjcQoMqsw1ZPIub0ZO3RP",
+	"mN5edLujwzWthejYaQOuB14qbiFlWELIdETjjbWofvR3OtMHLmRI3oGt2KKSbimD9u0esofQR7WY1tlc",
+	"XKLHJ4xSfax4CCH3fz768qvJtEnRUX+fTCf+69sEJ4t8k0rEksMmdVeMX5ndMazkWwM2LT0I9mSUpws7",
+	"ioddAxoZzEqUH19SGCvmaQkX3nx6m9NGnkv3Qgr3D3nft96ppxYfH26rAXIo7SqVAK6lqFGrZjUBOhFR",
+	"+OwH5JSJEzjp2nxyvC/6eNMC+KKOiVdqzG2o3geO0QJXRFSPERllWEnxT+d9mD/8zdGvQ37gFFzdOVPB",
+	"5ne+//Y1O/UC09whavmhoywuiau0+9COlbOMtx7lvpFv5DNYkPVBySdvZM4tP51zIzJzWhn0RxRcZnCy",
+	"VOxJeND+jFv+RvY0rcHMtFHWCVZW80Jk6GpJsafLNtgf4c2bX9Gq++bN217YUP/64KdKyhc3wQwVYVXZ",
+	"mc+VNtNwzXXKLWvqXFk0MvXeOatTslXlDKR+fObHT8s8XpammzOnj35ZFoh+xIbGZ4TBJWPGqvpBrzB1",
+	"TgRc3xfKHwyaXwe7SmXAsN/XvPxVSPuWzd5UDx58AayVROZ3f+QjT25LGG1dGczp0zWqEOLuWknPKGYl",
+	"X6a8v2/e/GqBl7T6pC+vcQlQ0aVuMU3q50s0VINAoMfwAjg4Ds6uQMhduF4hL24aBfpES9jOYHGr9YoS",
+	"kNx4ufYkMeGVXc1wbyexMsjiYWXqdJlLLqQJgUJGLOm26jOLztGkCNmlT/kI69Jup63uatFSNIPoEMYl",
+	"A3VPtCkdHTkoMElomXOvinO57eYF8++LaNBXcAnb16rJZndIIrB2XioztFGJUyPtEpk13rZ+jO7i+4DH",
+	"8FLfp3ei1++BLZ7UfBH6DG9kp/IeYROnmKKVN2mIEFwnCEEdhkhwA0RxvFuxfgo9ITOQVlzBDAqxFPNU",
+	"HvO/9/1hAVbkSp+61QfI1wMadJEJa9jcHaz+eq+5XALjFPlUKsMLl5Y6GU9E96EVcG3nwO1OO7+MX04H",
+	"6LA/u8ad5Sx8U0QBNrjewpLFTsI15N5Q5Nr4wPqT4dBIBzjkN4QndG9uCieDd11PukTK1nAq19Str7U+",
+	"ajTms9er+vsaKOezusZ1QSiUT1fssmJF50tl+BIG7i6x925kQqGWx48G2aeRJHUQDGVpqxo9TSAJsms8",
+	"Q5yTexjwC25iumZ2YoXDTM5B7H1GVIXAE2xekAJbB1W7tee65UWVy12gpUULaNmoggGMNkXi7bjiJmzH",
+	"fBpJ2VHa2QfMT7Art+d5FOYaZZWuM3eG07ArQXv3fp/hM6T1DLk840v/iLyc04kTAMnlUJJU0xwKWDrE",
+	"XePAKE3GuWaBEI6fFguSLbNUxGxkoI4UAD8H4M3lPmPON8JGj5Bi4whsCnyggdkLFe9NuTwESOkz5vEw",
+	"Nh0R0d+QfnPq3pCgMqpKPFzFgL8xCxLA5/JpNItOsD8Nw4ScMhRzV7wAacNdvBmkl2KSLhSdhJI+9Obe",
+	"0EVjh2vKHfkH4UQ9boRNrM0GoNOq9g6I52ozc/kPkneR+WaO/J58VoO9khvTJfO8Y9hcbSjSkI4W94xj",
+	"DyzDcAQwGgAoSyPiTv2G9CwHzK5pd+u5KS407G6tdTbsMqTojZl6QLccYpe7UX7OGwHQzXdQJ/P1Zom9",
+	"5oO2etI/zJtTbdrknQ4vFlPbf2gLJVdpgH59+1g7o+bfmsypw9kZfaOPk0q0b1m6TYpX15kAMQdleO2y",
+	"QwuIHVR92dUDk2RtterQNaJaSpQwIRNOyT7ZDBRAl+BZSzWdXcI2fZcHOscvQrfIWEerx+X2XhRAqGEp",
+	"jIXGaRTigj6FOZ5T/nmlFsPY2VIvEL9XStWHP3V0xvgWmh8dA3ocshAaXyGgxy2JAjb6zpAR6TtsmtZA",
+	"W4vNXLUWkaclLk2L7wlzUVRpfvXz/vAMp31RHzSmmtMpJqQL0JpTdaFkTP2Oqd2zi50IP3cIP+dHw3fc",
+	"bsCmOLFGdmnP8SfZFx0BtkscJBgwxRz9VRsk6Q4BGeVC6EvHSBuNYlpOdnkbepspD2PvjVILGRmGTn43",
+	"UhKXKI9q+vGqWi7xEZ/LHRb8YTLKwlkouYzK4JXlrqSjJ1h7wfjUnTuyfvowfBgKwo/U/ZlAj20a+qiZ",
+	"g7x59EkZS2mSJUiXSSdtFlLLPSH+1CKy1X1kX2j3AUAyCPp1x5ndRCe7VaqXkxagAB4ygBkI+O3elv0F",
+	"8aSbDoVPt1JH795CNCDxlLBRZah+howBAczLUuSbjuPJjTpoBOMHWZcHtC0SLX6wPRRoB0EnGa5Vi8CH",
+	"WnsD+yndeU/xVuZir31gMfI3z3xuiLzS5MFoRTb3C1/Ud7WRuP/wy4VVmi/Be6FmDqRbDUHoHEKGqKyE",
+	"YdZnsMvFYgGx98XcxHPQAq5nY89HsG6CydIumkpI+9XjFBvt4Z4Gxv0kS3NMgheGfPKv+14u3zY2JdVH",
+	"QrQ0N3BVJTNJ/ADb2S9odGAlF9o04bne7dQ+fA9Y9av1D7ClkfdGvSJge1aFLE+vgHgwZemvP5moAsAd",
+	"E1PMXS9bS3jASp2lV+lIS+Or2gwzf3PKxBh1ULnNxmiCJBCWMatxkY5NwN0DbcJ3WXnfIoh8vw4S6fvx",
+	"VMKEGsD9o6hOk7KPdzHHYWBeQmfyfjq5XSRA6jTzI+6h9cv6AE3SmSJNnWe4FdhzIMl5ifFbvJj5eImh",
+	"w1+rK3/4U/MQXvGRbzJpzn797dnzlx58dEkXwPWstgQMYkXtyj8NVq4Ozu6jxJVL8IZOZymKFr9OaR/H",
+	"WFxTaYSOsalXVaqJn2nGCzEXi3TA+17Z50N9HIo7Qn6grCN+Gp8nde4E+fArLorgbAzQDgSnE3LjSpMl",
+	"pUI8wK2DhaKYr9lRxU1vd6d3R8Nde2QSzfUTZU1N3zikz6lKosgH//Cja0/fKd0S/v5lYjJ46MOpVahk",
+	"OzoOxGqHAsBdZeqEOcXr9+XvuBvv34+32v37U/Z74T9EANLvc/873S/u3+8D7U67tJAgK5Xka7hXv7IY",
+	"XIiPewGXcD3ugD67WteapRpmw5pDXRRQIPe1p961Fp6euf8F3bH408mYS3q86I7cMTBjdtDF0EvEOsh0",
+	"7WoOG6ZkN6aaHsEia5Gw9zVtnDO2v4VktSYH5swUIkuHdsi5QfEqXTAlNmbUeMBaiyNWYiA2V1YiGgub",
+	"jUnn2wEymiNJTJPMKNzQbq789q6k+GcFTOQgLX7SdK51jrpwOaBRewpp2i7mB6Y+0fC3sYPs8DcFW9Au",
+	"I8hO/92z2qcUEE1VTTswAjyesSe4d0Rve/7w3Oxes63aIZjj7jHBoZc0H3gPYhB03lk3MEdToJX6udRF",
+	"wswWWv0BaUcI+Y8SiTD8RHQdod6pyL2uSKmdygGfePZ9yz3+bjy08Le+Cwek67KNNzlM07v6sIW8yaXX",
+	"pDOJTyfxlkzD5T6y9tOAAdFC2ysKhqVCOiH6iEu3n1wWiNYLs/SujFqYUzd+sys9zN1VzQp+PefZZfou",
+	"hDBFy9uKk7KKhc5hAUyd48DNzqII7rqtcEkOS9CND6KfMPmG9xo37egbTXOBwY6tq8vUhSkURiWGqeQ1",
+	"lxZCGIOTV763AeeCx17XSlOKUpMO6cohE+ukOfbNm1/zrB++k4slzuQSeEYl7P1AzOVBJS7KhSkLvq0z",
+	"d3jSnC/Yg2mzJ8Nq5OJKGAxkphYPXYs5N3Rc1u7wuguiB9KuDDV/NKL5qpK5htyujCOsUay+e5KSVwcm",
+	"zsFeA0j2gNo9/JrdpZBMI67gHlLRK0GTJw+/poAa98eD1Cmbw4JXhd0lsnOS2SFYO83HFJPqxkAh6UdN",
+	"R18vNMAfMHw67NhNruuYvUQt/YGyfy+tueRLSL/PWO+ByfWl1SR3focukhrlYKxWWyZsen6wHOXTwJtv",
+	"FH8ODJap9VrYtQ/cM2qN/NTUp3eThuFOaG84mV7DFT5S/GsZwv86tq6PfI3h6zQ/cIpSfkE+2pisU8Zd",
+	"XtpChOAeqAses/OQ9ppK+dSFBx1tcC5EnXRJXEKqBCWkJftHZRezv+C1WPMMxd/JELiz+VePE5X82pWg",
+	"5GGAf3S6azCgr9Kk1wNsH3QW3xdfwcvZWqCov9fkWIh25WCgbnJaOxQXunvosZovjjIbZLeqxW48ktS3",
+	"Yjy5Y8BbsmKNz0H8eDBmH50zK51mD17hCv386rnXMtZKp2pZNNvdaxwarBZwBfngIuGYt1wLXYxahdtA",
+	"/2njn4LKGallYS8nLwKRR3PXY3nU4n/5sUnKT45V9xKxYwNUOmHt9Ha7jxxteJjVreu/dQFj9G2AcqPJ",
+	"RqP0qTIQfU8/N30+RbxQFyS35i2D48PfmcY7OOnx9+8T0Gh3dE1/f9T+7MT7/fvp3NhJkxv+2lDhNjdi",
+	"6ptaQ6wc2xcFauOkcAgo8vkR+uuXPqTwZJz7MaasXXjy46sPx3nYlQ4zTbN/wJ8+dwnwiaUjrdiuXU31",
+	"k0cZnQjHXtXcpBN6bxREtAA46hwwaNK0qjBFdE+zXecECxz4aemNyHuAk9SuRJH/0mQs64hHzWW2Ssa+",
+	"zrHjb07zbB0sTgCkqIZ+NAlFcjh3Y/st3OwSd89/qLHzrIUc2bab8Nyh20GuAbwNZgAqTIjkFbbACWKq",
+	"tpNB1ckGiqXKGc3TVBFpdn6/wnuqZmmfBd2w68r6aEx64ezT6CxEgf8b8IZSy5nmdkCeaHqdt2hGhCtA",
+	"/wtdQ9zooBkXazpuDMfSTrQzr0DjzV8t6KVouzslBqORoxIhzJT4iVpSGgbFbKUlVlKM0ABphYZiO2Ul",
+	"N8YN8gDRgg3NPXny8MGDpDGHqDMCU0fFgOZPDSoPT6mJ++KrWrnaCwcBux/W9w1HHbKwfcbxRTypkHpK",
+	"ptIH9x4TO9OR5Ap41sVmT9j3lM8HmbiVwB2hqVPjttNEVmWheD6llL0Yb8LcrK6PBiIUFRBdIvwd9k86",
+	"DcanzQz5igbywYwfZ3eCCsTa2Fld7zOVcQ9bNBVJRSeShKxTMXVO2DNnGDTB7OQmcdWM9RryqLyou5oS",
+	"c+B/rOXZChuo1jE/LCvHV74N4qzxR0Rv6q7CRxLYCLcvfutq304Z1fK/FpiEd8UtXEE7yV8AI1h8Q9K/",
+	"Nnqh1rWQh5T4r4tLHUr2AByNW7vKk5B1CH+gvcXVMD+0EPAF9Uq/MOjUy+j4skPKuJA4mv3oTeYZl0qK",
+	"jBL8p9RFSkg2zvk2ohZC2mtmJn6HJjZXspZx/cLVU3GwuvF00iJc35EdfcVFddzh/rSw8TXulmCNl2yQ",
+	"T0N1eO/mEdKALx+GTBTLSaUToTrJ8P46LOBANqJcQwN2u+/w2wtv1cUtyC6FJPuNJ5u/fDhHTGEE+Vsl",
+	"E5YtFRiPT/uNivkV+5xQ7sEcNm9PnqulyC7EksZwwWGItouE7A91FuIifRwitn2KbX1G+PrnVpCTm/Ss",
+	"LP2kyXea9QqnKm4PEjgVjRPCIyLi1uPHo+1gt50BzXSeIqNhqQBmLJR0DvcYoy5e3h4FCwVUjqOoBXPv",
+	"BFNEKYRMgPFcyOAYTB8QWfJIoIWh/TrQz2Sa22zVEkP7wiAHwvrp3W12eYyhOgtMJCEcwxzDy9jUXR8Q",
+	"HHWDRuPncsvCpkDujpQJfNRXB5j2q6iTVuWVqJyezHTqqqcEBwruWXgI2CLX3kdpdXeqMXHoSTSUeW9e",
+	"5UuwmNUtlbDpG/rK6Gt4+oR1Lqq66lf95q2debvPbX6iTElTrXfMFRrccrpcGG4MrOdFIhjyWf0R8nqF",
+	"kdPQX4D/puoKDa+MDwU++K1piPvND0s33387m9J6kadnmFVoPCXoTLk9OZqpb8boTf+jcnp4hPov8ca0",
+	"I+XiNUrJt2/x4IjT0fairt3RUmeLpQhnRd9DGp86z2FbKuG3fvUs8uXT4iWWrAN8aJgE/IoXA++7Yw+A",
+	"O1+dVXzolXc2mJSAW590ynK2UwQNJvJxEbAdn0LfMTYU9eqCXo9ni/e47iTosEfqh5b/yUU+NcJi0O90",
+	"M9dQs8CH+oa6VTMSig+1iGD3t6GeAWXgftMSkGOKdKTqQXg1IZhNHJf5fDWuSEavvkaPws/GnAw9eryf",
+	"Ts7zg2RnqqbIxI2SXAGxXFlKSf434Dnol3tSrjdp1kn5KZUR9cHMChzM57hc0XAnY6Op0aQn4pTx/bFC",
+	"lN0VZJZKvjbRQxrgkATyOFmw/39OvT58s6qDzn3G9V1p1vt1XveI+15mmCi7kStEeDI+qfhZHSPqnrhg",
+	"JbA6H0XnUejop2mLBWSU9nVnJp6/4wW8yfIyDVd0gmURJeYR9UMNSlx8uAGqAajgN4Sn4McDZ+ih7iVs",
+	"7xjW4oZkZcT6ldJNMqMSBZw3JCTJHbIp+rAYYWrOICqEmEfXHZrs/4NJbaO8UjecK7Ak43GuqR1TpguO",
+	"j5oLux6U147eHAwl6wnViPs7r64pLCF3ssZXqvUm5hvsZvKMuwHPXzZv+TQrxLx8VLoZB3gKNqXQKRXv",
+	"Zyk2jUmealx5V9a0iZxypZEX5EpyMbZ0zmPELZfk7+Jy4E3UmssqxYQh2DgMjwvlLtNNDUVgZy/Pp0xz",
+	"35JLmnYtzBxW/EoonQ4/1sBNSiH++2rrKw6ApgkdNUe8bKu5waMzxAtU4HiIGcKSuyC0ULbYqgNZgEdC",
+	"vcYkHo9xS+mq44h9YaMmwqUrSr85CK1c9fUbMkzgdeUWFtvOC2FWMJByzF+U8L7PkydkwVHC117MEnS4",
+	"QjPqU5eeBacRRS7ZKVsDN1VINaYqu1S4Ca9hbtBLayNoB5jY+03S69E4VcL08XJg/dQpu6Z9Wj4qkzQP",
+	"IO3eJcQ6a55Dh8LpPYCQzIbOorbAmPrNDLoLuMPpURlwTELvyovvhj3QxbUd2LUqlUFe0+ouVDOOaagR",
+	"QVtZZtU0FKZqt3fxMTH/n6SVT75YiGwwvRV+ZLBxL6qjp9I4yRQ1tMCRli9H54RBqfGaL1+74ff7mWpJ",
+	"FHjSEy7ionpJ+ru5QXJIgr0Uw6eZGzbWRb2nVOkDZVhUkj6IqA9wbJUifWwJaeoKNBytqYOFeAeoP0S8",
+	"aCEPYSJeiy2Euk6G52rU8iWjtRshVxPhobOQ0z1hVcbvdc53p6MEdt5hJZoZkHZoNBOlmx4eJ2C4A7gf",
+	"AxEOga8eNw1iPeY4KC1fplcx2uZ0zv784oda4NSLFzLMVPJSqmsZZEKPw6+FzNW12c0vtSw2haCnGb5X",
+	"HbsT78kpMyv80Vh3zzpMFLkZ/07j75VGSKJpl9FafJJa7O5CNUQY3FctqI65tYiyvEPXz3vp2HvJy+b0",
+	"0gUBHHQ4twiRSB8hmcMEt+fGNA9SjvLIKjrscXgGlovC+IcevC6gEfvl2LnDNPqN5DkCRelx62ipUIoD",
+	"TPgt5MJ2sxTi0tfBosuvi03D9OmhxVGSm1Iz9M6ngF7UM4vmIXI/rLnPFO5Nf1Yog/nfhxIjtN/+1g9n",
+	"7hj3wqlJRElwLUBryOsgqEIZmFmV0DF6cOwihaFnXDcighks4+mAGyzh8qqpUUPljDmVbOH+9VaMINOw",
+	"5gidjirJDM+5i9hP3feQTCqcant9yjW/zva+GAhP0IXpETHm+gXzRtH9Sapu4l4WUoKehVizblkZ2c4s",
+	"TPnj8ypz52y8MWoX/AGH7KAoSXpmsz6WHVdQlOzpEranzr/lDkFTr2AMtDOQO9CjxPmdRT6qw92k4F4e",
+	"BbxPmw+5VKqYDYQ3nfdr4XQ5/lJkl/72Gp5qogp3p703cBJ2l6Jq6vjV69U21H4pS5CQ3zth7Ey6x/Eh",
+	"lLVdJrszubxjd82/oVnzypWn8m70kzcy/cqYDlN9S2kWhtktwwzI/NZTuUF2T2Q3cijI/pqKTLWr0Z+M",
+	"db72g0s7ykzEVA6KlE5y4WLUntJGT13ZKZVXlHOOQhc587FtzBQq9SbtJunGcKg0peLJCCALY+7ZDRR+",
+	"8CQBfNz+ntTW/nNI3qwWTEMTNnrTLNY+MbQTzWbIcduduZ6lLe8WSkM8Iz1LcRnrw64kgUPB2nourOZ6",
+	"e5Nc021SpW53g1Te+wCjfnvRINK8v+jTsCjU9YyE1ayu15byYGI70z6Mg+mm6Ye7eg7RSw5uvKK2ZSue",
+	"s0xpDVncI22CdFCtlYYZViZImmSfo0GJFWItrGFUDmzJVIlec1f3MM1BQ3NVUnJSmyCKo0+SwPEOYur7",
+	"RHw8cko8U13k2IxUrb1lgsLiv8Y+LgNTk53UIT1z0YsDbxTB+GyknkKucR9eYhyXvq8bMpKWzQuxIb4B",
+	"ndryC2Y1Ph71LWj0FgvRxuca2FoY40CpeelaFAUlQBKbRh5AHao8ZLxOqr3n9JDqSlC0fTsZFvVAJTeD",
+	"OkNYLAMu4vSdkVMsFEqp4QyeTV15v2c8ys+mogcRlAkBp3jM1spYf9N0IzUoN49M7mZKWq2KomPvdXzj",
+	"49F+5JuzLLPPlbrEpFb36F4rla0xzachT1D3OVAzk+6kyG0fwDPiAbO/5IRrh7MEKTBaQHZEXC/2aa8V",
+	"rAHz7X4Juj+06qyPWBevtjBNX2POJONWrUWW3lN/rvc1g69iUiIqRQrXw218x8S02ePDqg6nJhHZJzNI",
+	"nixyfMa8IPBhpc7NWFqngXfHZQvgtjd3dFD2hYvXombZoK7XAYAgdSl8bKVdYfFYE6ulilo6Hx3ZSruA",
+	"jjxV6O3B7WDDEY4OlIVbAdV771QDeNcZH6YuR7J7O4Xv5f33e41n8EbAv9/N5S3hMfSo46JhLU1N6oSL",
+	"AxIhXapl5wuI15S+aT72HYQJRviRJ3wEwPDLiBYMo95HHArGguMDuRm3A4c72aim0U3bJ2OIRg9lYGkW",
+	"lvEqlPDGsSsNPgGgU/F1O8yx5HYVjk5s3rcko1USDCkzf4BWrjb3NAqzg8KV7u4YA1Q5K+AKWg9GHC+b",
+	"ilRNjLfwfU3dmeUAJTlUujay1EuI+CzvGE487rMoln4MdZOWFEdYt1Jsj5kkadTZyJnbJmbsVkKIrkRe",
+	"8Rb9zKEqR9sMiFs5QareHWEW7pFjp/nZjfAqDHAW+qdUmUCJt+Pk0MEiKE26XQJo78uoygztepl+GBWn",
+	"3KwdLDRbXsfbOhZv5IYp+bUcNkj2Wb65bo1cJ6FkRNhvN5CRVuPvOxTEgfeZASeFz95H3C4BcncrwC4J",
+	"a/sKJJOqufaQNTJcVZpc4OEHNzE1EtLfpm8QO9y8X7r9yjIajJlOUuDBi4Su+fTm5vlPshN3bsTB8VI8",
+	"YsAn/Nhh/wrc7a8d1EBVRc4krifq/lRs3J9iXopP2bwKA6G1wsUYxvfQZxD8oErGLiCHUcimSzZgR253",
+	"gvVNHSJ6oYqB2krTP1JZ9s+KF2LhgsUc+KEbMyuOLOQdry7w27/7wol3q1fTAFiwtqgwlcNbjB0zGm6L",
+	"o0RA40EeilQqtuaXEC8DxbQ7+ZlZFJymmpPlAo/sznL2qeCRD6kGKYSwuX9TwvNtSzqEEhjY+/9psl/E",
+	"U4U8xWXBM8hbpTbbcgaVoZq57ArWu9Oj9OVaYIHQKmJaHfJp5TcwmR4oulJvjofKCLbAjq4R7SqCx0Fj",
+	"pOW3UytuR2KZUagcexXGPq7oAR3XG98Hflx+/ePQP1mLYAiNMeD/q9C9LuI5DC81+RhUbuXcS8DqrNVz",
+	"tZlpWJh9ASbUGoFvADa1iVXITAM3LuLm/Cd/8WxS7QuJF2H39K/2adaj5LAQshGWQpaVTdxjKOO+3EYE",
+	"i43+RNYBF9qQloDK5BUvfroCrUU+tHC4O9QiLgyAkARHh++bMGHUZ2p/AGGaOxxlZGnM6HEzPMBdMVUX",
+	"mWYslznXedxcSJaBtlyg73prbu5Rqp0D+3xKPNJm2nnCIu8SsbYDpNh6p/At/T01gPyIjp8RDpvXK/Dc",
+	"33bW+Fc5asA/04fhT+GwWfMN+vgob8jAhvA1FsjDR82YkmQGd/rZOLzDPEb8AbunofJSXhBZRbOOmWL3",
+	"vv+JlpKukT9LYXfufGej7CZycc8r3cYMRJXL5o23Y5b+fiwHYuDLdv6doGyGfGWB9yBaxKFXQ227+MAq",
+	"UhiET9wUG8HHl+1tR1okThhvGZiRxcDseMUNpnmxzDMfntU3pfVMDY4oU58f6UBLm7PPh3NpADwkNIQX",
+	"eO1p65AZHOeQWse7MyLNSlXOsjExn64CXe4ACJC2YRzgj8gJMIB3HR5j6pqMMTe2izMeWu55sDjkPm9X",
+	"me269A+ZiQYketsFoRYky2gLO+OY0rExZRqu18En3TaD1UKCcaYhqzSZia/5dn/53IHKJxd/O/vy4aPf",
+	"Hn35FcMGLBdLME31nE752SYuUMiu3efjRgL20LPpRQj5xuhz7X8MuTPqRfF7zUlb06TG7xXfPcS+nDgA",
+	"EtsxUfb0RmtF4zQvuP+1liuF5NFXLEWCD79mGKaRrl5W61UJB0pqtSIXCt5AStBGGAvSdjygwjYR0WZF",
+	"5kGqYXHl8keq8Fyt4QJhB0KuUogMBdSSPMNP9RMs2JSFl1XX/nH3MF7+nuYsdKQ0UlQMWrFU6VV7sWAp",
+	"iBjZzyuoLePe8EkW8ShGtha2Llo2xYg+8jzNehizQTdhtWC7pX3jKAyCOiHpcRET6kXYlDdgzSH/xHCm",
+	"sptIksa0/y8jPxKp144mNWp0P4SsSN4PdqSWOuvFPdRpx0aB1k/DlWAPAmAgqVIrHU6UDyQqqKGdl4D8",
+	"CcGB3FU/fmwcy3ufhRAkocMe8OIsSU277gPQT1yo4seaKBEqb4c4oYX+vsRL9evXcJBES+SNJtaCcWIp",
+	"kdsiyqplntbJqgZuJb2cVlopy5RE20giF5az49CeihlHSAv6ihcfX2p8J7SxZ0QPyF8NP42KEyLFRHak",
+	"NDfLzP2cj5q74B9gavmS8m/9HXCNkuecH8o74XunGRl3eOHCqxe1Nxoku6YxaaXZw6/Y3BeNKzVkwnSd",
+	"+9dBOanz/4BG7xhNARu7J+HQPjx/UfYWbLwIkTjsReTeqn32HsJmi35ioTKwc5NcnuK+Hlsk6JeSUZgS",
+	"eVyVsdsWGLtZoscoZfOBiR5jzCil9mj0CA86dCoDfTxHn9Yt2iYO6ga3sVlKR9cpw1KQ8zHJRdM1xbA7",
+	"ZTc9SnGxg0qLfYC8po5Gfgw/b4pjfhmqdOGqOQxU4+msBxbu2etVi2sr4YNbkGCEoepBv/kaiB/3LA0Q",
+	"uKRG/a3qYL1NVlBHmASurcmjqaKqSSMKJvluiSo3LkNCpYXdXiD9gwFN/HaZShj5fZ3C0acArX1p/uyz",
+	"6hJkiPdoEj5WJpyu3yte0HnkXHwSTyFVnLBvXU0fv1H+emf+n/DFXx7nD754+J/zvzz48kEGj7/8+sED",
+	"/vVj/vDrLx7Co798+fgBPFx89fX8Uf7o8aP540ePv/ry6+yLxw/nj7/6+j/vTKYTgSA7QEMxryeT/292",
+	"VizV7Ozl+ew1AtvQhJcCs2S+f093ZZdOjYia0U6ENRfF5En46f8NO+wkU+tm+PDrxNcZnaysLc2T09Pr",
+	"6+uTuMvpkp7+z6yqstVpmOf9tEPxs5fndYy+i8OhFW2sxyeThhXO6Nurby9eY/66k4ZhJk8mD04enDzE",
+	"8VUJkpdi8mTyBf1Eu2dF635KGfVPjS+Wddq81Ur67V5RyHpQzjWGMN6tX938R+25NffC4x2sdoVHBj7Y",
+	"QOhqLM5zYi5fa38ynbhrlnHs+OjBg7AWXtOJDpzTf/iMe05+JPbe+/fThGrkAU5C1tQuT+V/cml1KP23",
+	"20DVes311mHQokY0OC0TXxoysmtxxS1M3mLvLs3R8LrYRXKq1tre5aEzMUhd44rLUPrKFxozKZL3y6Pd",
+	"kvo708H3JkusDjV6iTCHLKkBnuAQ8jQjn7EjWL1HaEX6hJ5OyipBzm/pYY3ZRbNpVHbLQaOKvKZ4j6Iv",
+	"q/9LKIqs68+myZN3+NcKeGFX/o81MmoWPmng+db/31zz5RL0iccTf7p6dBpuIafvfMaU97u+nUYEw5+b",
+	"v2Yi39MzRDzta3L6zqdn2TNgbOA89bGmUYeRgO5qdjpXmwOaQozdMCrE8+b0HV3AB38/9VbU9EcyhLgT",
+	"9jTk4R1o6VJxpD+2SPjObhCR3cNhm2i8DN3kVXn6jv5DbBth5MTwqYZC8bz52ZX4OLUbeUrxJKfvWvTx",
+	"n3v0af/edI9bXK1VDgFmtVgYsHs+n75z/0YTwaYELfByyovmV5fz/pSqqW/7P29llvyxj0cr3/eeM55y",
+	"yZsQZNVOE548Vbq5x81tZeC4FDedWRN6d1/B2oXZ++nk8RGFdbtkSAKYb3jOQr4Dmvvhx5v7XLpgb9Q4",
+	"nWZMEDz+eBC0lo/9AFv2Qln2HfItwvLlx1yJc2lBS14EPe+GGuG47dM9XaeTqJlcOv1FuRwY7a12luc9",
+	"pndXSzD2G5Vvd1BsbZald942RGtu1kIiCtNx2nQPLeZyn8UJxyfxnRd9mu9vKRM6YV9c2/OEpZlcJvT+",
+	"wxtzW6Ams5Z3g2LcyH2ryD4WPn8WJm2eTXyWKZ9lSi1Tvnzwxceb/gL0lciAvYZ1qTTXotiyn2X9HufG",
+	"Mu4sz5PlQ9pbf6+MQ6slOjeXgO/PiF9nc5VvfWnOSWuCS3BGtJ4icxqMTq2LxID0DOaslLbSRIlPnvya",
+	"ipbwrx7Lal6IjDmDO1mc0JwSGYTqvNlt4TfdYbCYJspHsVwUVZ3kwV4r/4i6f6BERhirmPmnpoOHNqKw",
+	"W5/k9N5JAPefFehtA2+YZpIAMAr97VdKbfyICGAPrKH5yAE5hjo7Jn/ObzZ3wQ+d+u2HNk3VifT+++Kn",
+	"F9EjRWeAcHFC9ETOsS4iWGpFcfoYEWQsdxXVnzrTULGlx7aW28q0ijmffD6HPsv+28v+7+sCOq6Ms6X6",
+	"rH2R1Kk7s1/hTcr2d60/vTlj4qK0U/mQ8XfG2ZJK8PcPqPmWnT/r3V5dt+6R8M32/Fn/VEjI+y6IBwn+",
+	"AfGyS6VBRJbK1rHqDqnPSuZnJfNWF9fRm2fM3TVpWfqeBua9+9jUn3Xt90CU05wiv3qgjLE/fdLte5SF",
+	"79u2UrYsl3sdH6M1H1zSii6ZP4uIzyLidiLie0hsRtq1XmgkmO4wW9dYgUEZlvJW5GXQOkLzquA6eie8",
+	"z4R9RiOmr4IfRGp8bINdklZ5Xj8nEC6ONrGAx7XhfRZ5n0Xen0fkne0XNG3F5NZWr0vYrnnZ2LrAvfON",
+	"/zydc5n8LeVLd199Na/uz7kwA19KEU9hVpXN1XXkvieKEEESHlNnfuj+fXrNhcXwRF82li8s6H5nC7yg",
+	"JRcFdH5tKvT3vuitriLwWpnykr+e8rbrtPWNDoChjj2Xf+qr92oPNAoJHsLnJqAwDtCjw6cOzfv1LR4c",
+	"BvRVOJeaeLMnp6eU8WeljD2dvJ/G30zn49uaSd/Vp5ln1vfEnUqLpZCYcd4FbsyamLJHJw8m7//PAKCv",
+	"FJy6LQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,236 +177,251 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPctpLgv4Kb3Srb2qEkfyT74qvUnmInedrYsctSsvvW8iUYsmcGTyTAB4DzEZ//",
-	"9ys0ABIkwRmOpNjJrn+yNSSBRqPR6O9+P0lFUQoOXKvJ0/eTkkpagAaJf9E0FRXXCcvMXxmoVLJSM8En",
-	"T/0zorRkfDGZTpj5taR6OZlOOC2gecd8P51I+EfFJGSTp1pWMJ2odAkFNQPrbWnerkfaJAuRuCHO7BDn",
-	"zycfdjygWSZBqT6Ur3i+JYyneZUB0ZJyRVPzSJE100uil0wR9zFhnAgORMyJXrZeJnMGeaaO/SL/UYHc",
-	"Bqt0kw8v6UMDYiJFDn04n4lixjh4qKAGqt4QogXJYI4vLakmZgYDq39RC6KAynRJ5kLuAdUCEcILvCom",
-	"T99OFPAMJO5WCmyF/51LgN8g0VQuQE/eTWOLm2uQiWZFZGnnDvsSVJVrRfBdXOOCrYAT89UxeVkpTWZA",
-	"KCdvvntGHj9+/JVZSEG1hswR2eCqmtnDNdnPJ08nGdXgH/dpjeYLISnPkvr9N989w/kv3ALHvkWVgvhh",
-	"OTNPyPnzoQX4DyMkxLiGBe5Di/rNF5FD0fw8g7mQMHJP7Mt3uinh/J90V1Kq02UpGNeRfSH4lNjHUR4W",
-	"fL6Lh9UAtN4vDaakGfTtafLVu/cPpw9PP/zT27Pkv9yfXzz+MHL5z+px92Ag+mJaSQk83SYLCRRPy5Ly",
-	"Pj7eOHpQS1HlGVnSFW4+LZDVu2+J+dayzhXNK0MnLJXiLF8IRagjowzmtMo18ROTiueGTZnRHLUTpkgp",
-	"xYplkE0N910vWbokKVV2CHyPrFmeGxqsFGRDtBZf3Y7D9CFEiYHrRvjABf1xkdGsaw8mYIPcIElzoSDR",
-	"Ys/15G8cyjMSXijNXaUOu6zI5RIITm4e2MsWcccNTef5lmjc14xQRSjxV9OUsDnZioqscXNydo3fu9UY",
-	"rBXEIA03p3WPmsM7hL4eMiLImwmRA+WIPH/u+ijjc7aoJCiyXoJeujtPgioFV0DE7O+QarPt/37x6kci",
-	"JHkJStEFvKbpNQGeigyyY3I+J1zogDQcLSEOzZdD63BwxS75vythaKJQi5Km1/EbPWcFi6zqJd2woioI",
-	"r4oZSLOl/grRgkjQleRDANkR95BiQTf9SS9lxVPc/2balixnqI2pMqdbRFhBN1+fTh04itA8JyXwjPEF",
-	"0Rs+KMeZufeDl0hR8WyEmKPNngYXqyohZXMGGalH2QGJm2YfPIwfBk8jfAXg+EEGwaln2QMOh02EZszp",
-	"Nk9ISRcQkMwx+ckxN3yqxTXwmtDJbIuPSgkrJipVfzQAI069WwLnQkNSSpizCI1dOHQYBmPfcRy4cDJQ",
-	"KrimjENmmDMCLTRYZjUIUzDhbn2nf4vPqIIvnwzd8c3Tkbs/F91d37njo3YbX0rskYxcneapO7Bxyar1",
-	"/Qj9MJxbsUVif+5tJFtcmttmznK8if5u9s+joVLIBFqI8HeTYgtOdSXh6RU/Mn+RhFxoyjMqM/NLYX96",
-	"WeWaXbCF+Sm3P70QC5ZesMUAMmtYowoXflbYf8x4cXasN1G94oUQ11UZLihtKa6zLTl/PrTJdsxDCfOs",
-	"1nZDxeNy45WRQ7/Qm3ojB4AcxF1JzYvXsJVgoKXpHP/ZzJGe6Fz+Zv4py9x8rct5DLWGjt2VjOYDZ1Y4",
-	"K8ucpdQg8Y17bJ4aJgBWkaDNGyd4oT59H4BYSlGC1MwOSssyyUVK80RpqnGkf5Ywnzyd/NNJY385sZ+r",
-	"k2DyF+arC/zIiKxWDEpoWR4wxmsj+qgdzMIwaHyEbMKyPRSaGLebaEiJGRacw4pyfdyoLC1+UB/gt26m",
-	"Bt9W2rH47qhggwgn9sUZKCsB2xfvKRKgniBaCaIVBdJFLmb1D/fPyrLBID4/K0uLD5QegaFgBhumtHqA",
-	"y6fNSQrnOX9+TL4Px0ZRXPB8ay4HK2qYu2Hubi13i9W2JbeGZsR7iuB2CnlstsajwYj5d0FxqFYsRW6k",
-	"nr20Yl7+q3s3JDPz+6iP/xwkFuJ2mLhQ0XKYszoO/hIoN/c7lNMnHGfuOSZn3W9vRjZmlB0Eo84bLN41",
-	"8eAvTEOh9lJCAFFATW57qJR0O3FCYoLCXp9MflJgKaSkC8YR2qlRnzgp6LXdD4F4N4QAqtaLLC1ZCbI2",
-	"oTqZ06H+uGdn+RNQa2xjvSRqJNWcKY16Nb5MlpCj4Ey5J+iQVG5EGSM2fMciapjXkpaWlt0TK3Yxjvq8",
-	"fcnCesuLd+SdGIU5YPfBRiNUN2bLe1lnFBLkGh0YvslFev1XqpZ3cMJnfqw+7eM0ZAk0A0mWVC0jB6dD",
-	"281oY+jbvIg0S2bBVMf1El+IhbqDJebiENZVls9onpup+yyrs1oceNRBznNiXiZQMDSYO8XRWtit/kW+",
-	"penSiAUkpXk+bUxFokxyWEFulHbGOcgp0Uuqm8OPI3u9Bs+RAsPsNJBgNc7MhCY2WdsiJJCC4g1UGG2m",
-	"zNvf1BxU0QI6UhDeiKJCK0KgaJw/96uDFXDkSfXQCH69RrTWhIMfm7ndI5yZC7s4awHU3n1X46/mFy2g",
-	"zdvNfcqbKYTMrM1am9+YJKmQdgh7w7vJzX+AyuZjS533SwmJG0LSFUhFc7O6zqIe1OR7V6dzz8nMqKbB",
-	"yXRUGFfALOfA71C8Axmx0rzC/9CcmMdGijGU1FAPQ2FEBO7UzF7MBlV2JvMC2lsFKawpk5Q0vT4IymfN",
-	"5HE2M+rkfWutp24L3SLqHbrcsEzd1TbhYEN71T4h1nbl2VFPFtnJdIK5xiDgUpTEso8OCJZT4GgWIWJz",
-	"59faN2ITg+kbseldaWIDd7ITZpzRzB7h+yyXOsJC1E0PkE9x0/AC5+HdYMBuXI9nMyFvJjB17lBOGocq",
-	"oWbUQF6cdugAX63KxLGfiFPGvtAZqIlh2S3ndIePYauFhQtNfwcsKDPqXWChPdBdY0EUJcvhDk73Miqn",
-	"zqiCx4/IxV/Pvnj46JdHX3xpSLKUYiFpQWZbDYrcd5ZHovQ2hwfRg4YCVHz0L594N1x73Ng4SlQyhYKW",
-	"/aGse88q+PY1Yt7rY62NZlx1DeAopg/m9rZoJ9ZzbUB7DrNqcQFaG2X+tRTzO2f4vRli0OFLr0tpZCfV",
-	"doU6gfAkM6+cwEZLelLim8AzG0ph1sGUUXOL2Z0Q1dDGZ80sGXEYzWDvoTh0m5pptuFWya2s7sKCA1IK",
-	"GZUySim0SEWeGFGWichd99q9QdwbfrvK7u8WWrKmipi50UFb8WzgStMbPv6KtkNfbniDm53ikV1vZHVu",
-	"3jH70kZ+o2iVIBO94QSps3XTzqUoCCUZfoji1PegrYjJCrjQtChfzed3Y9AVOFBEJGAFKDMTsW8YAU9B",
-	"KriNV9xz+7tRx6CnixjvSNPDADiMXGx5it7Auzi2w4JRwTiGJqgtTwMpycCYQ7ZokeXtrXRD6LBT3VMR",
-	"cAw6XuBjdEc8h1zT74S8bCT076Woyjtnz905xy6HusU4h0dmvvWWbsYXeTtGdmFgP46t8ZMs6FltJ7Fr",
-	"QOiRIl+wxVIHKvFrKX6HOzE6SwxQfGDtYbn5pm8V+1FkhpnoSt2BKNkM1nA4Q7chX6MzUWlCCRcZ4OZX",
-	"Ki5kDkRVYjgXRqHpUG5FEwxTZAaGulJamdVWJcEYq9590XyY0NSe0ARRowYiTOrQIPuWnc5G7OUSaLYl",
-	"MwBOxMyFcbgAE1wkxQAx7cU0J+JG+EULrlKKFJSCLHHW9r2g+ffs1aF34AkBR4DrWYgSZE7lrYG9Xu2F",
-	"8xq2CYYzKnL/h5/Vg08Arxaa5nsQi+/E0Ns1GfahHjf9LoLrTh6SnTVGWqo14q1hEDloGELhQTgZ3L8u",
-	"RL1dvD1aViAxauZ3pXg/ye0IqAb1d6b320JblQNB+k5NNxKe2TBOufCCVWywnCqd7GPL5qWWLcGsIOCE",
-	"MU6MAw8IXi+o0jbSi/EMzbb2OsF5rBBmphgGeFANMSP/7DWQ/tipuQe5qlStjqiqLIXUkMXWgMa9wbl+",
-	"hE09l5gHY9c6jxakUrBv5CEsBeM7ZDkNGP+gujblOeNgf3EYNmDu+W0UlS0gGkTsAuTCvxVgNwxUHgCE",
-	"qQbRlnCY6lBOHR09nSgtytJwC51UvP5uCE0X9u0z/VPzbp+4rB/H3tuZAIU+Ive+g3xtMWtD1JdUEQeH",
-	"t9aiOceGpPVhNocxUYynkOyifFTxzFvhEdh7SKtyIWkGSQY53UbszPYxsY93DYA73qi7QkNiY43jm95Q",
-	"sg/t3DG0wPFUTHgk+ISk5ggaVaAhEPf1npEzwLFjzMnR0b16KJwrukV+PFy23erIiHgbroQ2O+7oAUF2",
-	"HH0MwAN4qIe+OSrw46TRPbtT/A2Um6CWIw6fZAtqaAnN+ActYMAW7NK4gvPSYe8dDhxlm4NsbA8fGTqy",
-	"A4bp11RqlrISdZ0fYHvnql93gmhsAMlAU5ZDRoIHVg0sw++JjZLtjnkzVXCU7a0Pfs/4FlmOj0RqA38N",
-	"W9S5X9v0i8DUcRe6bGRUcz9RThBQH9RtRPDwFdjQVOdbI6jpJWzJGiQQVc1slEbfn6JFmYQDRP0zO2Z0",
-	"Duio+3enR/wChwqWF3NbWp1gN3yXHcWghQ6nC5RC5CMsZD1kRCEYFR5DSmF2nbkML5/j4ympBaRj2hh9",
-	"UF//91QLzbgC8jdRkZRyVLkqDbVMIyQKCihAmhmMCFbP6eIvGwxBDgVYTRKfHB11F3505PacKTKHtU+L",
-	"NC920XF0hHac10Lp1uG6A3uoOW7nkesDHVfm4nNaSJen7A/qciOP2cnXncFrb5c5U0o5wjXLvzUD6JzM",
-	"zZi1hzQyLqANxx3ly2mHQPXWjft+wYoqp/ouvFawonkiViAly2AvJ3cTM8G/XdH8Vf0ZpnxCamg0hSTF",
-	"RMWRY8Gl+cbmNppxGGfmANu8hrEAwbn96sJ+tEfFbIIeWFFAxqiGfEtKCSnYlD4jOap6qcfEBvunS8oX",
-	"qDBIUS1cnIQdBxl+paxpRla8N0RUqNIbnqCRO3YBuEg8n9VpxCmgRqXrWsitArOm9XwukXfMzRzsQddj",
-	"EHWSTSeDGq9B6qrReC1y2qmpIy6DlrwX4KeZeKQrBVFnZJ8+vsJtMYfJbO7vY7Jvho5B2Z84CGpuHg7F",
-	"NRt1O9/egdBjByISSgkKr6jQTKXsUzEP09B9NORWaSj6lnz76S8Dx+/NoL4oeM44JIXgsI1WXmEcXuLD",
-	"6HHCa3LgYxRYhr7t6iAt+DtgtecZQ423xS/udveEdj1W6jsh78olagccLd6P8EDudbe7KW/qJ6V5HnEt",
-	"uiTVLgNQ0zpyjklClRIpQ5ntPFNTF/hsvZEuo7WN/td16s0dnL3uuB0fWlj/AG3EkJeEkjRnaEEWXGlZ",
-	"pfqKU7RRBUuNBHF5ZXzYavnMvxI3k0asmG6oK04xgK+2XEUDNuYQMdN8B+CNl6paLEDpjq4zB7ji7i3G",
-	"ScWZxrkKc1wSe15KkBhJdWzfLOiWzA1NaEF+AynIrNJt6R9zsJVmee4cemYaIuZXnGqSA1WavGT8coPD",
-	"eae/P7Ic9FrI6xoL8dt9ARwUU0k82Ox7+xRTF9zyly6NASP67WMfV9sUhZiYZbbqwPzf+//29O1Z8l80",
-	"+e00+epfTt69f/LhwVHvx0cfvv76/7V/evzh6wf/9s+xnfKwxzKEHeTnz51mfP4c1Z8gG6EL+0ez/xeM",
-	"J1EiC6M5OrRF7mM1DEdAD9rGMb2EK6433BDSiuYsM7zlJuTQvWF6Z9Gejg7VtDaiYwzzaz1QqbgFlyER",
-	"JtNhjTeWovrxmfFcfHRKuvR6PC/zitut9NK3TTX18WViPq3rLdhSbE8JJuMvqQ/ydH8++uLLybRJoq+f",
-	"T6YT9/RdhJJZtomVSshgE9MVwzyQe4qUdKtAx7kHwh4NpbOxHeGwBRQzkGrJyo/PKZRmsziH81lZzua0",
-	"4efc5jCY84Muzq3znIj5x4dbS4AMSr2MlWhqCWr4VrObAJ2wk1KKFfApYcdw3LX5ZEZfdEF9OdC5D0yV",
-	"QozRhupzYAnNU0WA9XAhowwrMfrpZHC4y1/duTrkBo7B1Z0zFtF77/tvL8mJY5jqnq3aYYcO6ixEVGmX",
-	"H9oKSDLcLEybu+JX/DnM0fog+NMrnlFNT2ZUsVSdVArkNzSnPIXjhSBPfcrpc6rpFe9JWoO1I4O8cFJW",
-	"s5yl5DpUSBrytPXA+iNcXb2l+UJcXb3rxWb01Qc3VZS/2AkSIwiLSieumlEiYU1lzPel6mo2OLItV7Zr",
-	"Vitki8oaSH21JDd+nOfRslTdqhb95ZdlbpYfkKFyNRvMlhGlRZ1yZwQUl7Vs9vdH4S4GSdferlIpUOTX",
-	"gpZvGdfvSHJVnZ4+xuTFpszDr+7KNzS5LWG0dWWw6kbXqIILt2olxqonJV3EXGxXV2810BJ3H+XlAm0c",
-	"eU7ws1ZipU8wwKGaBdRZ3IMbYOE4OP8ZF3dhv/KVK+NLwEe4he0c81vtV1Ai4MbbtafMAK30MjFnO7oq",
-	"ZUjc70xd0G5hhCwfjaHYArVVV/tvBiRdQnrtirJBUerttPW5D/hxgqZnHUzZcn02iRILRqGDYgakKjPq",
-	"RHHKt93KPcpmVOCgb+AatpeiqTd1SKmeduUYNXRQkVID6dIQa3hs3RjdzXdRZT6X1hVgwfxUTxZPa7rw",
-	"3wwfZCvy3sEhjhFFq7LJECKojCDCEv8ACm6wUDPerUg/tjzGU+CarSCBnC3YLFZp+D/6/jAPq6FKV1zR",
-	"RSHXAyrC5sSo8jN7sTr1XlK+AHM9mytVKJrbwrHRoA3Uh5ZApZ4B1Tvt/DzMbfTQoUq5xuRytPBNzRJg",
-	"Y/ababTYcVgbrQINRfYdF718PBx/ZgGH7Ibw+M8bTeF4UNd1qIsUVfS3co3dWq11oXkhnSFc9nkBWJVV",
-	"rM2+GCiEKyhq69YE90ul6AIGdJfQezey5EfL44eD7JNIojKImHdFjZ4kEAXZvpyYNUfPMJgn5hCjmtkJ",
-	"yPQzWQex8xlhnXCHsFmOAmwduWr3nsqWF9UWPh4CLc5aQPJGFPRgtDESHsclVf44YklYz2VHSWe/Ywbx",
-	"rup750EsYVD3ta6t52/DLgft6f2uBp8vvOer7YVK/4jKeUb3wvSF2HYIjqJpBjks7MLty55QmppQzQYZ",
-	"OF7N58hbklhYYmCgDgQANwcYzeWIEOsbIaNHiJFxADYGPuDA5EcRnk2+OARI7mpaUT82XhHB3xBP7LOB",
-	"+kYYFaW5XNmAvzH1HMBV22gki05ENQ5DGJ8Sw+ZWNDdszunizSC9InCoUHRKvrnQmwdDisYO15S98g9a",
-	"kxUSbrKaUJr1QMdF7R0Qz8QmsRnKUV1ktpkZeo/mLmC+dOxg2nJ79xSZiQ2Gc+HVYmPl98AyDIcHI7C9",
-	"bJhCesXvhuQsC8yuaXfLuTEqVEgyztBak8uQoDdm6gHZcohc7gcV9G4EQMcM1bSjcGaJveaDtnjSv8yb",
-	"W23aVIb1aWGx4z90hKK7NIC/vn2sXfPur01tw+H6af5EfZRif33L0m2KMNqPS1tY8ZAajF1yaAGxA6uv",
-	"u3JgFK3tWK82XgOsxViJYb59p2QfbQpyQCU4aYmmyXUsUsDo8oD3+IX/LDDW4e5Rvn0QBBBKWDCloXEa",
-	"+bigT2GOp1ghWoj58Op0KedmfW+EqC9/6zbHD1vL/OgrwAj8OZNKJ+hxiy7BvPSdQiPSd+bVuATaDlG0",
-	"/RRYFue4OO01bJOM5VWcXt28Pzw30/5YXzSqmuEtxrgN0Jph/49o4PKOqW1s+84Fv7ALfkHvbL3jToN5",
-	"1UwsDbm05/iTnIsOA9vFDiIEGCOO/q4NonQHgwwSzvvcMZBGg5iW413eht5hyvzYe6PUfNr70M1vR4qu",
-	"Jah0GM8QFIsFZL6Cm/eH8aBOXi74ImhUVZa7ygIeE1udD4vr7ajL58LwYSgIPxD3E8Yz2MShD7UChLzJ",
-	"rMOagjjJArgtVxI3C0VRE4b44xuBre4j+0K7CQDRIOjLjjO7iU62u1RvJ25ADjRzOokCv77dx7K/IQ51",
-	"06Hw6VZx191HCAdEmmI66N3SL0MwwIBpWbJs03E82VEHjWD0IOvygLSFrMUNtgcD7SDoKMG1qoW7UGtn",
-	"YD9BnffEaGU29toFFhv6pqlLwM8qiR6MVmRzvzR9rauNXPsPP19oIekCnBcqsSDdaghcziFoCAq/K6KZ",
-	"DSfJ2HwOofdF3cRz0AKuZ2PPRpBuhMjiLpqKcf3lkxgZ7aGeBsb9KItTTIQWhnzyl30vl5fpA1NSfSUE",
-	"W3MDV1U0Xf8H2CY/07wySgaTqgnPdW6n9uV7wK6vih9giyPvjXo1gO3ZFbQ8vQGkwZilv36kghrd91Sr",
-	"iwGql60tPGCnzuK7dEdb4/pODBN/c8u0+jK0l3Kbg9EESRhYxuzGRTw2wZweaCO+S8r7NoFl+2WQQN4P",
-	"p2LKd+nsX0V1LYp9tHsJNPfEi8uZfJhObhcJELvN3Ih7cP26vkCjeMZIU+sZbgX2HIhyWpZSrGieuHiJ",
-	"octfipW7/PF1H17xkTWZOGVffnv24rUD/8N0kuZAZVJbAgZXhe+Vf5pV2U4Vu68SW9DcGTqtpSjY/Lro",
-	"dBhjscbi5R1jU6/vSxM/ExxFF3Mxjwe87+V9LtTHLnFHyA+UdcRP4/O0AT/tIB+6oiz3zkYP7UBwOi5u",
-	"XPOgKFcIB7h1sFAQ85XcKbvpne746Wioaw9PwrleYWnKuMbBXeFKZEUu+IfeufT0nZAt5u8yE6PBQ7+f",
-	"WGWEbIvHgVht36KzK0wdEyt4/br41ZzGo6PwqB0dTcmvuXsQAIi/z9zvqF8cHUW9h1EzlmESaKXitIAH",
-	"dZbF4EZ8XAWcw3rcBX22KmrJUgyTYU2hNgrIo3vtsLeWzOEzc79kkIP56XiMkh5uukV3CMyYE3QxlIlY",
-	"B5kWtiuoIoJ3Y6oxCdaQFjJ713XCOmP7R4hXBTowE5WzNB7awWfKsFdugynNywRfHrDWmhErNhCbyysW",
-	"jGVeG1MztQNkMEcUmSpatrXB3Uy4411x9o8KCMuMVjNnIPFe61x1XjnAUXsCadwu5ga2fqpm+NvYQXb4",
-	"m7wtaJcRZKf/7nntU/ILjfU1OjACPJyxx7h3RG87+nDUbLPZlu0QzHF6zJju8J7ROWfdwBzRbu9MJXMp",
-	"foO4IwT9R5FCGN7xydDM+xvwWORel6XUTuWmaX0z+77tHq8bD238rXVhv+i6sdpNLtP4qT5sI2+i9Kp4",
-	"uWaH5CElLIwwaKcGDLAWPF5BMCy2uvDRR5Tb82SrQLQyzOKnMszlPLHjN6fSwdzLf83pekZjbXCMLmRg",
-	"Cra3FSelBfEf+w1QdY0DOzsJIrjrd5mtJFeCbHwQ/aq0N9Rr7LSjNZpGgUGKClWXqQ1TyJWIDFPxNeW2",
-	"Ubr5zvIr97UC64I3X62FxDqQKh7SlUHKiqg59urqbZb2w3cytmC2B3ilIGgy7QYittgkUpFr1F1X7nCo",
-	"OZ+T02nQ6d7tRsZWTLFZDvjGQ/vGjCq8Lmt3eP2JWR5wvVT4+qMRry8rnknI9FJZxCpBat0Thbw6MHEG",
-	"eg3AySm+9/Arch9DMhVbwQODRScETZ4+/AoDauwfp7Fb1vVw38WyM+TZPlg7TscYk2rHMEzSjRqPvp5L",
-	"gN9g+HbYcZrsp2POEr7pLpT9Z6mgnC4gnp9R7IHJfou7ie78Dl649QaA0lJsCdPx+UFTw58Gcr4N+7Ng",
-	"kFQUBdOFC9xTojD01HSQtpP64bDXmm+J5eHyDzH+tfThfx1b10dWY2gxkLOFUco/oo82ROuUUFv8M2dN",
-	"ZLpvSUrOfW1h7BFWtwazuDFzmaWjLImB6nNSSsY12j8qPU/+YtRiSVPD/o6HwE1mXz6J9Npq92rhhwH+",
-	"0fEuQYFcxVEvB8jeyyzuW3KfC54UhqNkD5oaC8GpHAzUjYdkDsWF7h56rORrRkkGya1qkRsNOPWtCI/v",
-	"GPCWpFiv5yB6PHhlH50yKxknD1qZHfrpzQsnZRRCxhoGNMfdSRwStGSwwoy5+CaZMW+5FzIftQu3gf7T",
-	"xj95kTMQy/xZjioCgUdzV7K8keJ/ftlUPkfHqs1E7NgAhYxYO53d7iNHGx5mdev6b23AGD4bwNxotOEo",
-	"fawMRN/b8Pr6m08RL9QFye55y+D48FcijQ6OcvzREQJ9dDR1YvCvj9qPLXs/OooXII6a3MyvDRZuoxHj",
-	"t7E9/EZEDGDfiI3lwj6gyNVHiBggo5eUuRlnbowpabeG+/jiw90kdsXDTOPk79ePj7sI+MTcEXds16nG",
-	"DqejjE64xl5fy6gTem8URLABZtQZ5MKoTmGrm9BKHSW7zg3mKfDT4tss3gEcxXbF8uznpmJZhz1KytNl",
-	"NPZ1Zj78xUqerYvFMoBo94wl5Rzy6HBWY/vFa3YR3fPvYuw8BeMj3+32VrXL7SyuAbwNpgfKT2jQy3Ru",
-	"Jgix2i4GVRcbyBciIzhP06qhOfn9HsyxxpCRrF0ctqi0i8bEDGdXRmfOcgwujHtD8c1EUj3AT7BRue+a",
-	"Y8bBvuHKKs92dJCEsgKvG0WLMgc8mSuQRvMXc8wUbX+OhcFw5KAPA1GleYRvYhkGQXQlORHzebAM4JpJ",
-	"yLdTUlKl7CCnZlmwwbknTx+enkaNOYidESu1WPTLfNUs5eEJvmKfuNZBtsD9QcDuh/VDQ1GHbGyfcFyn",
-	"RGx1HOOptgcy2jvQ92euJNslse7oeUy+x3o+hohbBdzRCOdL47bLRFZlLmg2xZK9l9+evSB2VvuN7f1u",
-	"uzQu0AbVJv+o02B82Uxfr2igHsz4cXYXqDCrVjqpmyrGKu6ZN5q2j6wTSYLWqRA7x+S5NQzWnfftJAQL",
-	"P8sCsqCHo1VNkTjMf7Sm6RItbq1rfphXjm8v6tlZ448Icurqnj7IsA3crsOobTA6Jdhte80UYJ45rKBd",
-	"5K+ueOksvr7oX3t5suLcUsohTbjrDj6Hot0DZ8U07yqPQtZB/IH2Fttl+NBuqxf4VTzDoNO6tePL9iXj",
-	"fOFo8tKZzFPKBWcpFviPiYtYkGyc821EL4S410xN3AmNHK5ow9g6w9VhcbCFrGeEDnF9R3bw1GyqpQ77",
-	"p4aNayS2AK0cZ4Ns6vs3OzcP4wpcjyZDRCGfFDISqhMN76/DAg4kI6w1NGC3+848+9FZdbHUwzXjaL9x",
-	"aHPKh3XE5Iqhv5UTpslCgHLraeeoqLfmm2OsPZjB5t3xC7Fg6QVb4Bg2OMws20ZC9oc683GRLg7RvPvM",
-	"vOsqwtc/t4Kc7KRnZekmHe7uHRUk9YYPIjgWjePDIwLk1uOHo+0gt50BzXifGkKDFcZiQYn3cI8w6g7R",
-	"7VG+NYqUpSh8g9g8wWhZWMYjYLxg3DsG4xdEGr0ScGPwvA58p1JJtdUdRvG0S6D5QFg/5t1az/Jth+rW",
-	"wzcowTX6OYa3sWluPcA46hcaiZ/yLfGHwlB3IEw8o3kdEBxpVY1SlROiMkyZ6TSvjjEOw7h9m//2BbA3",
-	"Ka3+HHtMHHoTDVXem1XZAnRCsyxWsOkbfErwqU99gg2kVd1aqc55a1fe7lObmygVXFXFjrn8C7ecLugG",
-	"H6GGsCO932GsHzPb4r+xvkLDO+NCgQ/ONfVxv9lh5eb7ubMxqdfQdKLYIhmPCbxTbo+OZuqbEXrz/Z1S",
-	"uk9C/UPkmHa4XLhHMf72rbk4wnK0vahre7XU1WIxwlngc1/Gp65z2OZKeJX1umehLx83L7JlHeD9i1HA",
-	"VzQfyO8OPQD2frVW8aEs73SwKAHVruiUpmQnCxos5GMjYDs+hb5jbCjq1Qa93p0t3q11J0KHPVI/tPxP",
-	"NvKpYRaDfqebuYaaDT7UN9RrOd8XfKylonmF1L3dRvV6azHIMU06Yv0gnJjQanq/p2V/D8PPx9wM/Rb8",
-	"08l5dhDvjPUUmdhRojsQbag/XHK9KbOOwk8pFGtaLMY67Y+Mpr7EZvlByfj+WD7KbgWpxr6aTfSQBDik",
-	"gLyZzNv/P5deH9as6qBzV3F9V5n1fjPNPey+VxkmqG5kGxEejy8qflbHiNoUlzVVTT2KTlLo6NS0+RxS",
-	"LPu6sxLPfxgFvKnyMvUqOsIyDwrzsDpRAwsXH26AagDaVShnJzxBA5FbgzOUqHsN23uKtKgh2hmxzlK6",
-	"SWVUxID1hvgiuUM2RRcWw1RNGYgFH/Poas021f8Hi9oGdaVuOJcnSXNxNLWmdkwZ7+o8ai7z6UF17TDn",
-	"YKhYT78p7LAo+hx78CoXAUTryqqhwkbO+51B1q4yK9ZNqs3ovkYrKP+bL5JmZ8nZNYRt39FpsaYy82/c",
-	"SdUbezexONDzembWRKj3/d2RWvOY7JHmwogRyVDGTDsovI6ouqds6FtToQThmoOUkNXW8VwoSLTwEe27",
-	"4NiFChvfdyMkqMH+Lha4wdq+b5rixdjnimItX+rC+sIFEgkFNdDJoMTw8Jy7kP3MPvdZxr7P0V5jQ02v",
-	"+xtu+twEpnpIDKl+TtxtuT97+SZ2B8Y5yMQ7Ibr1hnm75BQWFsyq1F7Q4cGobTOji4PsYCVRlT3tr7Kj",
-	"IwRZwNewPbGKj+9U6ncwBNpKThb0oKJiZ5Pv1BKjYnAv7gS8T1soqxQiTwbs3uf9Isldir9m6TVgkbM6",
-	"hnegCTW5j+bW2rG5Xm59UeCyBA7Zg2NCzrjNmvA+znb/tM7k/J7eNf8GZ80qW7fc2VeOr3g8/Bwristb",
-	"cjM/zG4epsCwultOZQfZU4J3w4eiL9aRluzHY7Xyvtex2ya7ISoLRUwmubDOi2d40GPdgzHHOyhGgD4t",
-	"SpzTg6hcxIIVb5KHboaKYyqcDAHSwMekQ9dQuMGjCIg2fo6cQlvby1X1EnMiofEn3rS8Wb9HdUyj785c",
-	"z9Lmd3MhodVt2nxtSxnWkf2+LTyVM6YlldubFCHr9cjuWU8Gsbw3MqcOymkW0gTm9HGY52KdILNK6kL+",
-	"MdXWvKfal7HvKtV8Z071DIIQH6qcoLYlS5qRVEgJafhFPKHNQlUICUkuMOIn5oycayN3F5jFwkkuFkSU",
-	"qcjANsSIU9DQXBXnFMUmCAIsoiiwtIPpkPabgI5HTnlXDdpt2Rq76MS6tQaCV0G5MjUOQ/blPrw7mpvH",
-	"efOcbZBuQMaO/JxoWcGUuDe6TYDdwacSCPbUR1BqWlqzPMfMWLYJnHC1DzuO2gGx9xwj7FYMwzDaWdJW",
-	"Gg5bzsOBHecdnLuazpOfVIWRMpgiY6Z4QgqhtNM07UjNkpvoo/up4FqKPG8bpayIvnCOipd0c5am+oUQ",
-	"1zOaXj9AvZYLXa80m/oE0m6cWDOT7NROGtkdv1uL1L6HUVOOaA9uge842cGdqwMw3+3noPtt7mexDv/t",
-	"dbWZaVyNOeOEalGwNH6m/lyBV4PhUjEWFS3KZJvH2TR6fA0Pe3hZ1X52ZJF9NAOn0e5XZ8QxAudvRHZj",
-	"/osSeHdcMgfHaAYuyj5zcVJUkg7Keh0AEFKb26kraTvOhZJYzVXEwuaCo7e0C+jIWwWDUm4HmxnhzoHS",
-	"cCugeoFwNYD3rfFhaotn2aC6mdj45w+a6lo3Av7DbipvMY+haJ+LhrSkjffxlTgGOEK8hu/O0JhLzOud",
-	"jQ2QqbuDjrzhAwCGQ2ZaMIwKnDkUjDllOWRJrLnceW2jmgaatsvS6fZ8Zspx8pRWvrebGbuS4CpDWBFf",
-	"tv1fJTWkJOrX+5ZknsEGbIi/bXRPlfV7eP8L5LanW8cYIMokhxW0IolcuYoKRU22Av+tqj8mGUCJ3siu",
-	"jSwWIhPe5R3DiVt7EgRZjMFu1JJiEWt3iuwxk0SNOhue2GOixh4lA9GKZRVt4U8dKnK0zYDmKEdQ1dMR",
-	"Eq9Hjp3mJzvCGz/Amf8+Jsp4TLwbx4cOZkFx1O1iQHtD5io1dOp5PGIurMVSO1hwtqx2xFoSb/iGKuma",
-	"Dxsk+yTfqFsj94kJHiD22w2kKNU4fQcyp/EMOClcWQekdg6QWa3AfBKxti+BEy6CHnprqmpVpSkS53+w",
-	"E+NLjDtt+gZO5Saw7fY7S3AwojrVogYVCVnT6c3N85/kJO48iIPjxWhEgcsE22H/8tTt1A58AXsVc7Of",
-	"RvbHLnTuFnNcfEpmlR8oz8XaNsUL9dDn4P2glvq8C8iJ5ay+ln0A39TVL+yaOlgQulzQLRES/zFa5z8q",
-	"mrP5FvmMBd9/RtSSGhJyjlcbEeACAs3Eu8WrqQfMW1uEn8qum40dMxhua0YJgDYXue9eIkhBryHcBgx2",
-	"sPwz1YZxqmqGlgtzZXe2s48Ft3hfg6KgWajpYyW8dp9oXxvVfP2/m7SocCpfwKrMaepbILoeLG0+g21O",
-	"PXHpJRS78+b6fM2TQN06tSFa6ROtsxuYTA9kXbFg9KH+Ei2wey0le601brWMQ7rPNznrOzIORy3lrndh",
-	"bNRND+iwEd0+8MO+fB8H/9EilUPLGAP+HwXvA504Q3ht082PgOVWMYYIrNZaPRObRMJc7QswseZqo87L",
-	"poyDN7EynkqgykbcnL9yimdTg5FxowjbmNDap1mPksGc8YZZMl5WOqLHYClGvg0QFhr9Ea0DLrQhKcEI",
-	"kyuav1qBlCwb2jhzOmzPurAGvnd0uG8jJoz6Tu0PwFSjw2GqXmNGD18zF7jtsmPDNZWmPKMyC19nnKQg",
-	"zb1P1nSrbu5Rqp0D+3xKNJBm2gnkgXcJSdsCkm+dU/iW/p4aQHqHjp8RDhuMC444a6xpR4sB/0wfhj+F",
-	"w6agmyQXC0woGzgQrvgmevisCig4msGtfDZu3X4exX6D3dNg3XHHiLTAWcdMsfvcv8KtRDXyJ870zpNv",
-	"bZTdDD8bd2sPpkcqXzTB/5ZY+ucxlpTp6nCEiZle2PSJ7J72INhEGPAPte3iA7uIYRAuozc0go/v59SO",
-	"tIilflrLQIIWA7UjvB9UE8pOUxee1Tel9UwNFilTlzh7oKXN2uf9vTQAnm2+7c56e9o6ZMaMc0gTrN2p",
-	"skkpyiQdE/NpWxNkzk3gIG3DOEAfgRNgYN11eIyqm3W0SuC0unYc2gdssGvIPm9Xme5S+ofMRAMcve2C",
-	"EHPkZbY1NVq3MJOnNqZMvXrtfdJtM1jNJAglEtJKopl4Tbf7+yoNlMS9+OvZFw8f/fLoiy+JeYFkbAGq",
-	"Kavc6UvUxAUy3rX7fNxIwN7ydHwTfCK6RZz3P/qkqnpT3Fmz3FY1NRN7XZkOsS9HLoDIcYz0w7nRXuE4",
-	"TWj/H2u7You88x2LoeD33zMp8jxe1r6WqyIOlNhuBS4Uo4GUIBVT2jDCtgeU6SYiWi3RPIjFTVe2sIjg",
-	"KXj7saMCpgdCrmILGQqoRX6Gab7Oa0RgU+aOV1lPz651OT3NWuhQaMSomBmQUpROtGdzEoMIM4hkBbVl",
-	"3Bk+0SIexMjWzNZGy8YI0UWex0kv7Ai8m9u3u1XqOKc3mxgRL/yhvAFpDvknhlPYb8JJGtP+H4Z/RHLy",
-	"74xr1Mv9PXhFVD+4WdfxUaD187Mj5IEADGTbtvIkg0SxoNKqtF4C9Cd4B3JX/HjZOJb3poUgJP6DPeCF",
-	"6bPNe3UmgwPnE1cwfVkjJVjKuyFKaC1/X0auZ731RRJskTOaaA3KsiXRFwuDdGv1rM5iHtBKesnOUghN",
-	"jGaa55EkaWvHwTMVEo5RCeSK5h+fa3zHpNJniA/I3gynRoWZsiGSLSrVzUq2vaCj5g6yYu9uav4aE7P/",
-	"A8weRe85N5RzwvduMzTuYEvuhb8VbK43WeOYNsjq4Zdk5roJlBJSprrO/bUXTurEUJBs7gJaYaP3ZKLu",
-	"W+fPQt+CjOc+Eof8GLi3ap+9g7A5op+YqQyc3CiVx6ivRxYR/MV4VNh9dM91ccvK8zerABLU8jqwAki/",
-	"r+rY5eE68NKpFPTXOfq2buE2clE3axtbvmZ0Afurq7d6NqbqTLzYvPkcy97cSdX5g2rO/w4FbyyO3Bhu",
-	"3hjF/DxUAtWW+Rwo09zZj4rlewNWWkW3P0wnC+CgmMKy0r+45hgf9y71ENjKC/2jamG9TbkYi5jIWluT",
-	"B1MF5bRHVNJ2n0XKH2NWY1pJprfYGNUb0Ngv0Va239e1PVxtmNqX5u4+La6hbk7dVAKplL9dvxc0x/vI",
-	"uvi4uYVEfky+tcWe3UH5+t7sX+HxX55kp48f/uvsL6dfnKbw5IuvTk/pV0/ow68eP4RHf/niySk8nH/5",
-	"1exR9ujJo9mTR0++/OKr9PGTh7MnX371r/cMHzIgW0B9lfenk/9MzvKFSM5enyeXBtgGJ7RkP4DZG9SV",
-	"5wIb9xmkpngSoaAsnzz1P/0ff8KOU1E0w/tfJ64BzWSpdamenpys1+vj8JOTBab+J1pU6fLEz4Pt1Fry",
-	"yuvzOkbfxuHgjjbWY9xURwpn+OzNtxeX5Oz1+XFDMJOnk9Pj0+OHrncvpyWbPJ08xp/w9Cxx30+w1OKJ",
-	"clXUT+pcrQ/T3rOytDXWzSNHo+6vJdAcC+yYPwrQkqX+kQSabd3/1ZouFiCPMXvD/rR6dOKlkZP3rnLC",
-	"h13PTsLIkJP3rQIT2Z4vfeTDvldO3vveoLsHbPWFdDFnBqlRl+f3oF25JWt7iNTqQE+DG31KFJZQNz+V",
-	"kglzXqfm8s0A4wIwvE1iLWktK55aZ7GdAjj+9+XZf6LD/OXZf5KvyenUJRwoVGhi09uM65rQzjMLdj9O",
-	"UX2zPaurmTTO9cnTtzEjkwsWLatZzlJi5RQ8qIYKg3NUj9jwSbQoTpom7Q3XN5z8NPnq3fsv/vIhJk32",
-	"ZOMaSUGBj5bXV/jWjoi0gm6+HkLZxkWgm3H/UYHcNoso6GYSAtz3oEaqnvkEId/hNoxNDKIW//3i1Y9E",
-	"SOK059c0va6To3w2XJMBGCbDmS+HIHYXawg08Kowd5TLsirUomzXgq3R/A7bwSGgyE4enZ56Huo0lOCA",
-	"nrhzH8zUMWv1CQ3DdAJDZT8VXhHY0FTnW0JVECeBUYu+dWMnhU2USSuQfqdptD+j25JoFsKh2fiRYuVC",
-	"03wPfJedNnctdLiQn9JcsvvT33vIiELwLiZGhFvraeTz7v732N2+VEJKYc40w7js5srx11kLSCeL5lsP",
-	"7kChkWPyN1Gh7Gi0gkpDrMk3zmB9Im5OVxcpCKRrUofwydFRd+FHR03Y3xzWyGQpxxe76Dg6OjY79eRA",
-	"VrbTTt2qKDvq7BwyXG+zXtJNHTVNCRc84bCgmq2ABArnk9OHf9oVnnMbp26EZSvUf5hOvvgTb9k5N4IN",
-	"zQm+aVfz+E+7mguQK5YCuYSiFJJKlm/JT7xOBAh6SPfZ30/8mos194gw+mpVFFRunRBNa55T8aAFzE7+",
-	"06tw1AjayEXpQmEsDIqoVqb1VRD5YvLug9cBRuoeu147mWGLv7GvQqiwDGsn6JlQJ+/Rtj74+4lzkMYf",
-	"oo/DKs8nvvbiwJu2ylb8YUsreq83ZiG7hzPvBOOlVKfLqjx5j/9BPThYka3ffqI3/ARjQk/etxDhHvcQ",
-	"0f69+Tx8Y1WIDDxwYj5XqMftenzy3v4bTASbEiQz1xEWynS/2oLGJ9gqd9v/ecvT6I/9dbSKuQ78fOLN",
-	"MDGVuv3m+9afbZpSy0pnYh3Mgg4M633rQ2YeVqr798maMm2EJFdDFHvr9z/WQPMT1zum82tTrr33BGvQ",
-	"Bz92xKpS2CJCbY32DV1ftnJBpS2W8Y1AQ8UQw90kM8aRC4VcsjFL2od9FanHG7Eb5VY3nt2IDKoFmUlB",
-	"s5QqbNnuuiz1dOMPt9S/urU9ziN+OwQTzQ39cpSGnxzvdebguGOEzGBfyPlzP2GTgPa7C2Y9iL6hGfFV",
-	"pxLykuZmwyEjZ078b2Hj9xaqPr0U9InFlo8mZ3zjD58iFEvwtRREGS+aE7RDGyNUGC3SMIAF8MSxoGQm",
-	"sq3rWDWRdK03tkZHl7md0PaN0TZEUkkLNfTwDqyUf2zT5D6L5GdD4GdD4GdT0WdD4Ofd/WwIHGkI/Gwm",
-	"+2wm+x9pJjvENhYTM535Z1jaxBbatDWv1fto05+iZvHt6mFM1zJZK40UW2EwfUzIJZZ+oeaWgBVImpOU",
-	"KitduTJFBUZ3Yg0yyJ5e8aQFiY2hNBPfb/5rg1evqtPTx0BOH3S/UZrlecib+9+ivIuPbH7J1+RqcjXp",
-	"jSShECvIbDJsWB/dfrV32P9Vj/uq11gBs+Cxto4vVUZUNZ+zlFmU54IvCF2IJvAaC7JygU9AGuBseyrC",
-	"9NQlqjCXHe0ambfLuLcl974EcN5s4d6Qgg65xKMJDOEdGErwL2PiCP5HS+k3rWZ1W0a6c+weV/3MVT4G",
-	"V/nkfOXP7qQNTIv/LcXMJ6dP/rQLCg3RPwpNvsOkgtuJY65QaBrt0nVTQcsXivHmviYwOQz0xVu0DvF9",
-	"+85cBArkyl+wTdzq05MTrBy2FEqfTMz1145pDR++q2F+72+nUrIVdgRG66aQbME4zRMX+Jk0samPjk8n",
-	"H/5/AAAA//+T3ME+vR8BAA==",
+	"H4sIAAAAAAAC/+y9/3cbt444+q/wafecNF7Jdr60e5t3eva5TdvrbdLmxGnv7mvy7qVmIInXI3IuybGl",
+	"5vl//xyA5AxnhiONbCe53e1PiTUkCIIgCAIg8H6SqXWpJEhrJs/eT0qu+RosaPqLZ5mqpJ2JHP/KwWRa",
+	"lFYoOXkWvjFjtZDLyXQi8NeS29VkOpF8DZNncf/pRMM/KqEhnzyzuoLpxGQrWHMEbLcltq4hbWZLNfMg",
+	"zhyI8+eTmx0feJ5rMKaP5U+y2DIhs6LKgVnNpeEZfjLsWtgVsythmO/MhGRKAlMLZletxmwhoMjNcZjk",
+	"PyrQ22iWfvDhKd00KM60KqCP5zdqPRcSAlZQI1UvCLOK5bCgRituGY6AuIaGVjEDXGcrtlB6D6oOiRhf",
+	"kNV68uzXiQGZg6bVykBc0X8XGuA3mFmul2An76apyS0s6JkV68TUzj31NZiqsIZRW5rjUlyBZNjrmL2s",
+	"jGVzYFyy1999w548efIlTmTNrYXcM9ngrJrR4zm57pNnk5xbCJ/7vMaLpdJc5rO6/evvvqHxL/wEx7bi",
+	"xkB6s5zhF3b+fGgCoWOChYS0sKR1aHE/9khsiubnOSyUhpFr4hrf66LE43/SVcm4zValEtIm1oXRV+Y+",
+	"J2VY1H2XDKsRaLUvkVIagf56Ovvy3ftH00enN//y69ns//V/fv7kZuT0v6nh7qFAsmFWaQ0y286WGjjt",
+	"lhWXfXq89vxgVqoqcrbiV7T4fE2i3vdl2NeJziteVMgnItPqrFgqw7hnoxwWvCosCwOzShZgDEHz3M6E",
+	"YaVWVyKHfMqEZNcrka1Yxo0DQe3YtSgK5MHKQD7Ea+nZ7dhMNzFJEK9b0YMm9M9LjGZeeygBG5IGs6xQ",
+	"BmZW7TmewonDZc7iA6U5q8xhhxV7swJGg+MHd9gS7STydFFsmaV1zRk3jLNwNE2ZWLCtqtg1LU4hLqm/",
+	"nw1Sbc2QaLQ4rXMUN+8Q+XrESBBvrlQBXBLxwr7rk0wuxLLSYNj1CuzKn3kaTKmkAabmf4fM4rL/58VP",
+	"PzKl2Uswhi/hFc8uGchM5ZAfs/MFk8pGrOF5iWiIPYfm4fFKHfJ/Nwp5Ym2WJc8u0yd6IdYiMauXfCPW",
+	"1ZrJaj0HjUsajhCrmAZbaTmEkIO4hxXXfNMf9I2uZEbr3wzb0uWQ24QpC74lgq355qvTqUfHMF4UrASZ",
+	"C7lkdiMH9Tgcez96M60qmY9QcyyuaXSwmhIysRCQsxrKDkz8MPvwEfIwfBrlK0JHyD3oCDkOHQmbBM/g",
+	"7sYvrORLiFjmmP3shRt9teoSZM3obL6lT6WGK6EqU3cawJGG3q2BS2VhVmpYiASPXXhyGMaZa+Ml8Nrr",
+	"QJmSlgsJORPSIa0sOGE1iFM04O77Tv8Un3MDXzyd3Oz7OnL1F6q76jtXfNRqU6OZ25KJoxO/+g2b1qxa",
+	"/UfcD+OxjVjO3M+9hRTLN3jaLERBJ9Hfcf0CGSpDQqBFiHA2GbGU3FYanr2VR/gXm7ELy2XOdY6/rN1P",
+	"L6vCiguxxJ8K99MLtRTZhVgOELPGNXnhom5r9w/CS4tju0neK14odVmV8YSy1sV1vmXnz4cW2cE8lDHP",
+	"6ttufPF4swmXkUN72E29kANIDtKu5NjwErYaEFueLeifzYL4iS/0b/hPWRbY25aLFGmRj/2RTOYDb1Y4",
+	"K8tCZByJ+Np/xq8oBMBdJHjT4oQO1GfvIxRLrUrQVjigvCxnhcp4MTOWW4L0rxoWk2eTfzlp7C8nrrs5",
+	"iQZ/gb0uqBOqrE4NmvGyPADGK1R9zA5hgQKaPpGYcGKPlCYh3SIiKwkUwQVccWmPJ9PUnmw28K9+pIbe",
+	"Tttx9O5cwQYJzlzDORinAbuGDwyLSM+IrIzISgrpslDz+ofPzsqyoSB9PytLRw/SHkGQYgYbYax5SNPn",
+	"zU6Kxzl/fsy+j2GTKq7QvDQHr2rg2bDwp5Y/xWrbkp9DA/GBYbScaKy5mdZkMAbsfXAcXStWqkCtZy+v",
+	"YOM/+7Yxm+Hvozr/Plgspu0wc2Er5inn7jj0S3S5+azDOX3G8eaeY3bW7Xs7tkEoOxjGnDdUvG/moV+E",
+	"hbXZywkRRhE3+eXhWvPtxCuJM1L2+mzyswHHISVfCknYTvH6JNmaX7r1UER3ZAQw9b3I8RIBbUyoXuf0",
+	"pD/u2Vl+B9yaWtigiRrGWSGMpXs1NWYrKEhx5jIwdMwqt+KMEQu+YxI1zteal46X/RendglJ93nXyOF6",
+	"x4N35JmYxLn5HC80YXVrsbxXdCYxwQ9dHL4uVHb5Z25W97DD5wFWn/dpGLYCnoNmK25WiY3T4e0G2hj+",
+	"xobEs2weDXVcT/GFWpp7mGKhDhFdZfkNLwocui+yOrMlwKM2clEwbMxgLaxtLo7Owu7uX+xbnq1QLWAZ",
+	"L4ppYypS5ayAKyiY0kxIidYuu+K22fwEOdxraB8ZQGFngUWz8WYmMrHp2hahga05nUBrvM2URbtPLUEN",
+	"X0NHC6ITUVVkRYguGufPw+zgCiTJpBo0oV/Pkaw1MfBjdlZ/opGlcpNzFkAb3Hc1/Wp50UIaWzfnqWyG",
+	"UDp3NmuLvwnNMqUdCHfC+8HxP8B109lx52elhpkHofkVaMMLnF1nUg9r9r2v3blnZ+bc8mhnei5MX8Cc",
+	"5KB+pN6BTlhpfqL/8ILhZ9RikJMa7hGkjKjInZq7gxlJ5UbCBmRvVWztTJkM7YsHYflNM3hazIzaed86",
+	"66lfQj+JeoXebERu7muZCNjQWrV3iLNdBXHU00V2Cp1orDEEeKNK5sRHBwUnKQiaI4ja3Pux9rXapHD6",
+	"Wm16R5rawL2shNq4/4wS9oTfH3qpZywi3fQA/ZQWjQ5wGZ8NiHbjejybK307halzhkrWOFQZR6iRvjjt",
+	"8AE1rcqZFz8Jp4xr0AHUxLDs1nO64FPUalHhwvIPQAVjeYT8HajQBnTfVFDrUhRwD7t7ldRT0QT+5DG7",
+	"+PPZ548e//Xx518gS5ZaLTVfs/nWgmGfecsjM3ZbwMPkRiMFKg39i6fBDdeGm4JjVKUzWPOyD8q599wF",
+	"3zVj2K5PtTaZadY1gqOEPuDp7cjOnOeaNiU5Il9DoXh+P7bMQsCAcMpWXC4hZwasFXLpTXWQB51PV1Ki",
+	"uJQqh0NOQ6IDcuusIdGo8Z2MJLXF8ktgsFhAZr0bjDMP9Q4HcyBHAsNRaxYhnXmPsRPjYQqIwXOYV8sL",
+	"/8MrrRb3fmb3RkghS41elRrVX9P2Zvv1PcmxyQlsrOYnJbUEmZPYonkIw42B9fxe5MLQ3s2bUXLmN0UO",
+	"e+XaoTutGWYb7bbnequr+zDCgdZKJ/mx1MqqTBUzvI0IlVBXXvkWzLcIy1V2f3fYsmtuGI5NPvZK5gNa",
+	"CTrPR2tZDvSbjWxos3MjufkmZufHHbMubeI3d+USQ4I2khF3tpSlhVZrxllOHUkj/h6suyWINVxYvi5/",
+	"WizuxyavCFBCcIk1GByJuRZM4O7PlHQhp3sUOA91DHm6hAm+UDuMgKfIxVZm5NC9j207rNuuhaToErOV",
+	"WaToIo4F5EvQI+gxXpEdIocb6oFJoIPkeEGfyaP0HArLv1P6TXPJ+l6rqrx38dwdc+x0uJ+M91nl2Dc4",
+	"K4RcFu0w5yXifpya4yeZ0De1qcvNgbAnjnwhlisbWTVeafUBzsTkKClE6YMzaRbYp2/Y/FHlKExsZe7h",
+	"NtAAayQc8m0s1/hcVZZxUrRo8SuTvicMBMaSXkKBhDa+epAVTRg2B+SujFc426pkFCbXOy+ajjOeuR06",
+	"I9KY9IBNdJdr5YZzQZeFBp6jyRIkU3MfieNjhGiSnGL8bNC0/S0lIS9aeJVaZWAMOjudX2IvaqGdOzrs",
+	"DjoR4oRwPQozii24vjOyl1d78byE7YwiUg377IdfzMNPgK9Vlhd7CEttUuTtWn37WI8bfhfDdQeP2c7Z",
+	"kx3XMqvoYlWAhSESHkSTwfXrYtRbxbuT5Qo0BT59UI4Pg9yNgWpUPzC/3xXbqhx4Z+EtLajh4YJJLlVQ",
+	"rFLACm7sbJ9YxkbxXAzOIJKEKUlMgAcUrxfcWBesJ2ROlnd3nNA41IeGGEZ48BqCkH8JN5A+7ExJA9JU",
+	"pr6OmKoslbaQN4M1cyD77OBYP8KmHkstItj1nccqVhnYB3mIShF8Tyx/A6Y/uK2tsd6+258cRX7gOb9N",
+	"krKFREOIXYhchFYRdeNY8wFEhGkI7RhHmA7n1AHu04mxqixRWthZJet+Q2S6cK3P7M9N2z5zOVccjcly",
+	"BYbcfL69x/zaUda9MlhxwzweweBOFjkXVdjHGTfjzAiZwWwX59MVD1vFW2DvJq3KpeY5zHIo+DbhKnCf",
+	"mfu8CwCteHPdVRZmLlw8vegNJ4fo3B2gFcFLCM0fFaMvLMMtiFeBhkF87z2QcyDYKeHk+ehBDYrGSi5R",
+	"gEfTdkudgEin4ZWyuOKukUPZS/QxCA/QoQZ9e1JQ5x0Gyf8G4wcIbW4xyBbM0BQa+AdNYMCc71/iRful",
+	"I947EjgpNgfF2B45MrRlB3wLr7i2IhMl3XV+gO29X/26AyTDO1gOlgs0MkYf3DWwjPszF+jchXm7q+Ao",
+	"21sf/Z7xLTGdEEzWRv4StnTnfgWgv+byXty1/AA7oh93v4ecjzQSog5VAmg255LmHGb3StzL7Epx4Oxe",
+	"if2zK8UhsxMSb8c4yXrp7mVmAPqwqWH44v65Edixk8uUlJDZ9vwolCeywt2HmSUBlQn3ZhOnEZ6M4O0w",
+	"bgIbntliyzjph1t2DRqYqeYuBqzvrcVIrxhA0vu7Y0Qf3pIMLtkZb3NBoKLppdxu7rq6G783nTtrixz+",
+	"mloqVYww3vaIkcRgVPAdKxWuuvDvR8MLwiDkWkh6faLYBnS9FhOTmWbA/ltVLOOSrAGVhVrdVpp0WOxL",
+	"IwgTjemjuxsKQQFrcEYO+nJ01J340ZFfc2HYAq7Do+ujoz45jo7IxPhKGduS+/ex4bm25wnNhtziqJP5",
+	"C3L3uNsfMuohj1nJVx3gYVDaU8Z4xsXp31kAdHbmZszcYx4ZFy5rNyNn/qYdYNmbN637hVhXBbf34VCF",
+	"K17M1BVoLXLYK9/9wELJb6948VPdjR6UQ4Y8msHMObVHwoI32McFLCAcIYUV4dXUWITg3PW6cJ32WD+a",
+	"kCqxXkMuuIViy0oNGeTOISQMM/VUjxmB9T57FPFaVUsfheXgkMDHB/r0JLqSPRBJfd9u5Iz8L6kDwMf5",
+	"hjfjqOkDR2tD13nj7tbXvB4P8ta5MHINus6spP92Ohk0xiBRrxpjjCNO++H7iMOgdRWJ6NMMPNLLR6RD",
+	"tbxPr3hZcDPh4n4Yb1IDOoVlf+DoyUTzcejVBFqCiu09KD0OENNQajB0RMUWVOO+qkWc5CLEWm+NhXXf",
+	"yeS6/nVg+70eNGUoWQgJs7WSsE3mdRISXtLHVG93TA50JoVlqG/3etzCv4NWe5wx3HhX+tJqd3do15lq",
+	"vlP6vrz1DuBopX+Ec3zvfcAPeVsXPsby973e/gl8VwCYaR2XKzTjxqhMkM52npup22jeUe7fy7fJ/6p+",
+	"2HcPe68Lt+PejbOrkPsCipJxlhWCnBtKGqurzL6VnMyn0VQTIaLBTjRsUP8mNElb8BMGdg/qreQUHlwb",
+	"VZOxRAtIWBC/Awh2dVMtl2Bs566zAHgrfSshWSWFpbHWuF1mbr+UeLXfWjh2LfGhywJ5wir2G2jF5pVt",
+	"a/+U4cFYNM87XzMOw9TireSWFcCNZS8FRjIhuBCPErasBHut9GVNhfTpvgQJRphZOpT1e/eVHkb56a/8",
+	"Iyn8v+8covablDMTnGYry9T/99l/PMPsUnz22+nsy387eff+6c3Do96Pj2+++ur/b//05Oarh//xr6mV",
+	"CriLfBDz8+f+Znz+nK4/0VunLu4fzTWFSUuSTBYHGnV4i31GuXY8Az1s223tCt5KjCKzClM9iZzb27FD",
+	"94Tp7UW3Ozpc01qIjp02zPXAS8UdpAxLCJmOaLy1FtWP/k5n+sCFDMk7sBVbVNItZdC+3UP2EPqoFtM6",
+	"m4tL9PiMUaqPFQ8h5P7Px59/MZk2KTrq75PpxH99l+BkkW9SiVhy2KTuivErsweGlXxrwKalB+GejPJ0",
+	"YUcx2DWgkcGsRPnxJYWxYp6WcOHNp7c5beS5dC+kcP+Q933rnXpq8fHxthogh9KuUgngWooatWpWE6AT",
+	"EYXPfkBOmTiG467NJ8f7oo83LYAv6ph4pcbchup94BgtcEVE9XgiowwrKf7pvA/zh7+59+uQB5zCqztm",
+	"Ktj8wfffvmEnXmCaB0QtDzrK4pK4SrsP7Vg5y3jrUe5b+VY+hwVZH5R89lbm3PKTOTciMyeVQX9EwWUG",
+	"x0vFnoUH7c+55W9lT9MazEwbZZ1gZTUvRIaulhR7umyDfQhv3/6KVt23b9/1wob61wc/VFK+uAFmqAir",
+	"ys58rrSZhmuuU25ZU+fKIsjUe+eoTslWlTOQevjMw0/LPF6Wppszpz/9sixw+hEbGp8RBpeMGavqB73C",
+	"1DkRcH1/VP5g0Pw62FUqA4b9bc3LX4W079jsbXV6+gRYK4nM3/yRjzy5LWG0dWUwp0/XqEITd9dKekYx",
+	"K/ky5f19+/ZXC7yk1Sd9eY1LgIoudYtpUj9fIlDNBAI9hhfA4XFwdgWa3IXrFfLipqdAn2gJ2xks7rRe",
+	"UQKSWy/XniQmvLKrGe7t5KwMsnhYmTpd5pILaUKgkBFLuq36zKJzNClCdulTPsK6tNtpq7tatBTNIDqE",
+	"cclA3RNtSkdHDgpMElrm3KviXG67ecH8+yIC+houYftGNdnsDkkE1s5LZYY2KnFqpF0is8bb1sPoLr4P",
+	"eAwv9X16J3r9HtjiWc0Xoc/wRnYq7z1s4hRTtPImDRGC6wQhqMMQCW4xUYR3J9ZPTU/IDKQVVzCDQizF",
+	"PJXH/C99f1jAFbnSp271AfI1QIMuMmENm7uD1V/vNZdLYJwin0pleOHSUifjieg+tAKu7Ry43Wnnl/HL",
+	"6YAd9mfXuLOchW+KU4ANrrewZLGTcA25NxS5Nj6w/ng4NNIhDvkt8Qndm5vC8eBd15MukbI1nMo1detr",
+	"rY8ajfnszar+vgbK+ayucV0QC+XTFbusWNH5Uhm+hIG7S+y9G5lQqOXxIyD7NJKkDoKhLG1Vo6cJJFF2",
+	"jWc45+QeBvyCm5iumZ1Y4TCScxB7nxFVIfAEmxekwNZB1W7tuW55UeVyF2pp0QJaNqpgQKNNkXg7rrgJ",
+	"2zGfRlJ2lHb2AfMT7MrteR6FuUZZpevMneE07ErQ3r3fZ/gMaT1DLs/40j8iL+d04gRAcjmUJNU0hwKW",
+	"buKucWCUJuNcs0CIx0+LBcmWWSpiNjJQRwqAHwPw5nLEmPONsNEQUmwcoU2BDwSY/ajivSmXhyApfcY8",
+	"HmDTERH9Dek3p+4NCSqjqsTDVQz4G7MgAXwun0az6AT7Exgm5JShmLviBUgb7uINkF6KSbpQdBJK+tCb",
+	"h0MXjR2uKXfkHzQn6nGr2cTabEA6rWrvwHiuNjOX/yB5F5lv5sjvyWc12Cu5MV0yzweGzdWGIg3paHHP",
+	"OPbgMoxHQKNBgLI04typ35Ce5ZDZNexuPTfFhYZ9VmudDbsMKXpjhh7QLYfY5bMoP+etEOjmO6iT+Xqz",
+	"xF7zQVs96R/mzak2bfJOhxeLqe0/tIWSqzRAv759rJ1R889N5tTh7Iy+0cdJJdq3LN0lxavrTIiYgzK8",
+	"dtmhhcQOqr7q6oFJsrZadegaUS0lSpiQCadkn2wGCqBL8Kylms4uYZu+ywOd4xehW2Sso9XjcvswCiDU",
+	"sBTGQuM0CnFBn8Iczyn/vFKL4dnZUi9wfq+Vqg9/6uiM8a1pfvQZ0OOQhdD4CgE9bskpYKPvDBmRvsOm",
+	"aQ20tdjMVWsReVri0rD4njAXRZXmVz/uD89x2B/rg8ZUczrFhHQBWnOqLpSMqd8xtHt2sXPCL9yEX/B7",
+	"m++43YBNcWCN7NIe43eyLzoCbJc4SDBgijn6qzZI0h0CMsqF0JeOkTYaxbQc7/I29DZTHmDvjVILGRmG",
+	"Tn4HKTmXKI9q+vGqWi7xEZ/LHRb8YTLKwlkouYzK4JXlrqSjx1h7wfjUnTuyfvowfBgKwo/U/ZlAj20a",
+	"+6iZw7x59EkZS2mQJUiXSSdtFlLLPSH+1CKy1X1kX2j3AUAyCPpNx5ndRCe7VaqXkxagAB4ygBkI89u9",
+	"LfsL4kk3HQqfbqWO3r2FCCDxlLBRZah+howBAczLUuSbjuPJQR00gvGDrMsD2haJFg9sDwXaQdBJhmvV",
+	"IvCh1t7AfkJ33hO8lbnYax9YjPzNM58bIq80eTBakc39whf1XW3k3H/45cIqzZfgvVAzh9KdQNB0DiFD",
+	"VFbCMOsz2OVisYDY+2Ju4zloIdezsecjWDfBZGkXTSWk/eJpio32cE+D436SpTkmwQtDPvk3fS+Xbxub",
+	"kuojIVqaW7iqkpkkfoDt7Bc0OrCSC22a8Fzvdmofvges+tX6B9gS5L1Rr4jYnlUhy9NrIB5MWfrrTyaq",
+	"APDAxBRz18vWEh6wUmfpVbqnpfFVbYaZvzll4hl1pnKXjdEESSAuY1bjIh2bgLsH2oTvsvK+RRD5fh0k",
+	"0vfjoYQJNYD7R1GdJmUf72KOw8C8NJ3JzXRyt0iA1GnmIe6h9av6AE3SmSJNnWe4FdhzIMl5ifFbvJj5",
+	"eImhw1+rK3/4U/MQXvGRbzJpzn7z7dmLVx59dEkXwPWstgQMzoralb+bWbk6OLuPElcuwRs6naUoWvw6",
+	"pX0cY3FNpRE6xqZeVakmfqaBF2IuFumA972yz4f6uCnuCPmBso74aXye1LkT5MOvuCiCszFgOxCcTpMb",
+	"V5osKRViAHcOFopivmb3Km56uzu9Oxru2iOTaKyfKGtq+sYhfU5VEkU++Iffu/b0ndIt4e9fJiaDhz6c",
+	"WoVKtqPjQKx2KADcVaaOmVO8/rb8G+7Go6N4qx0dTdnfCv8hQpB+n/vf6X5xdNRH2p12aSFBVirJ1/Cw",
+	"fmUxuBAf9wIu4XrcAX12ta41SzXMhjWHuiigQO5rT71rLTw9c/8LumPxp+Mxl/R40R25Y2TG7KCLoZeI",
+	"dZDp2tUcNkzJbkw1PYJF1iJh72vaOGdsfwvJak0OzJkpRJYO7ZBzg+JVumBKbMyo8YC1FiFWYiA2V1Yi",
+	"goXNxqTz7SAZjZEkpklmFG5oN1d+e1dS/KMCJnKQFj9pOtc6R124HBDUnkKatot5wNQnAn8XO8gOf1Ow",
+	"Be0yguz03z2vfUphoqmqaQdGgMcj9gT3juhtzx+em91rtlU7BHPcPSY49JLmA+9BDILOO+sGxmgKtFI/",
+	"l7pImNlCq98g7Qgh/1EiEYYfiK4j1DsVudcVKbVTOcwnHn3fco+/Gw8t/J3vwmHSddnG2xym6V192ELe",
+	"5tJr0pnEp5N4S6bxch9Z+2nAgGih7RUFw1IhnRB9xKXbTy4LROuFWXpXRi3MiYPf7EqPc3dVs4Jfz3l2",
+	"mb4LIU7R8rbipKxioXNYAFPnOHCjsyiCu24rXJLDEnTjg+gnTL7lvcYNO/pG01xgsGPr6jJ1YQqFUQkw",
+	"lbzm0kIIY3Dyyvc24Fzw2OtaaUpRatIhXTlkYp00x759+2ue9cN3crHEkVwCz6iEvQfEXB5U4qJcmLLg",
+	"2zpzhyfN+YKdTps9GVYjF1fCYCAztXjkWsy5oeOydofXXXB6IO3KUPPHI5qvKplryO3KOMIaxeq7Jyl5",
+	"dWDiHOw1gGSn1O7Rl+wzCsk04goeIhW9EjR59uhLCqhxf5ymTtkcFrwq7C6RnZPMDsHaaT6mmFQHA4Wk",
+	"h5qOvl5ogN9g+HTYsZtc1zF7iVr6A2X/XlpzyZeQfp+x3oOT60urSe78Dl0kNcrBWK22TNj0+GA5yqeB",
+	"N98o/hwaLFPrtbBrH7hn1Br5qalP7wYN4I5pbziZXuMVPlL8axnC/zq2ro98jeHrND9wilL+kXy0MVmn",
+	"jLu8tIUIwT1QFzxm5yHtNZXyqQsPOtrgWDh10iVxCakSlJCW7B+VXcz+hNdizTMUf8dD6M7mXzxNVPJr",
+	"V4KShyH+0emuwYC+SpNeD7B90Fl8X3wFL2drgaL+YZNjIdqVg4G6yWHtUFzobtBjNV+EMhtkt6rFbjyS",
+	"1HdiPLkD4B1ZsZ7PQfx48Mw+OmdWOs0evMIV+vn1C69lrJVO1bJotrvXODRYLeAK8sFFQph3XAtdjFqF",
+	"u2D/aeOfgsoZqWVhLycvApFHc9djedTif3nZJOUnx6p7idixASqdsHZ6u91HjjY8zOrW9d+6gDH6NkC5",
+	"0WQjKH2qDETf089Nn08RL9RFya15y+D46G9M4x2c9PijI0Ia7Y6u6d8etz878X50lM6NnTS54a8NFe5y",
+	"I6a+qTXEyrF9UaA2TgqHgCKfH6G/fulDCk/GuYcxZe3Ckx9ffbifh13pMNM0+4f50+cuAT6xdKQV27Wr",
+	"qX7yKKMTzbFXNTfphN4bBREtAEKdAwZNmlYVpojuabbrnGCBAz8tvXHyHuEktStR5L80Gcs64lFzma2S",
+	"sa9z7PhXp3m2DhYnAFJUQz+ahCIJzt3Y/hpudom759/V2HHWQo5s20147qbbmVyDeBvNgFQYEMkrbIED",
+	"xFRtJ4Oqkw0US5UzGqepItLs/H6F91TN0j4LOrDryvpoTHrh7NPoLESB/xvwhlLLmeZ2QJ5oep23aCDC",
+	"FaD/ha4hDjpoxsWajhvDsbQT7cwr0HjzVwt6KdruTonBCHJUIoSZEj9RS0rDoJittMRKitE0QFqhodhO",
+	"WcmNcUBOcVqwobEnzx6dniaNOUSdETN1VAzT/KmZyqMTauK++KpWrvbCQcjux/Wm4ahDFrbPOL6IJxVS",
+	"T8lU+uDeY2JnOpJcAc+62Owx+57y+SATtxK4IzZ1atx2msiqLBTPp5SyF+NNmBvV9dFAhKICokvEv8P+",
+	"SafB+LSZIV/RQD6Y8XB2J6jAWRs7q+t9pjLuYYumIqnoRJKQdSqmzjF77gyDJpid3CCumrFeQx6VF3VX",
+	"U2IO/I+1PFthA9U65odl5fjKt0GcNf6I6E3dVfhIAhvx9sVvXe3bKaNa/tcCk/CuuIUraCf5C2gEi29I",
+	"+teeXqh1LeQhJf7r4lKHkj0gR3BrV3kSsw7hD7S3uBrmhxYCvqBe6RcGnXoZHV92SBkXEkezl95knnGp",
+	"pMgowX9KXaSEZOOcbyNqIaS9Zmbid2hicyVrGdcvXD0VB6sbTyctwvUd2dFXXFTHHe5PCxtf424J1njJ",
+	"Bvk0VIf3bh4hDfjyYchEsZxUOhGqkwzvr8MCDmQjyjU0YLf7Dr/96K26uAXZpZBkv/Fk85cP54gpjCB/",
+	"q2TCsqUC4+fTfqNifsU+x5R7MIfNu+MXaimyC7EkGC44DKftIiH7oM5CXKSPQ8S232BbnxG+/rkV5OQG",
+	"PStLP2jynWa9wqmK24METkXjhPCIiLg1/BjaDnbbGdBM5ykyGpYKYMZCSedwjzHq4uVtKFgooHIcRS2Y",
+	"eyeYIkohZAKNF0IGx2D6gMiSRwItDO3XgX4m09xmq5YY2hcGORDWT+9us8v7ANVZYCIJzTGMMbyMTd31",
+	"AcFRN2g0fi63LGwK5O5ImcBHfXWAab+KOmlVXonK6clMp656SnCg4J6Fh4Atcu19lFZ3pxoTh55EQ5n3",
+	"5lW+BItZ3VIJm76mr4y+hqdPWOeiqqt+1W/e2pm3+9zmB8qUNNV6x1ihwR2Hy4XhxsB6XiSCIZ/XHyGv",
+	"Vxg5Df0F+G+qrtDwyvhQ4IPfmoa43/ywdPP9t7MprRd5eoZZhcZTgs6Uu5OjGfp2jN70v1dOD49Q/yne",
+	"mHakXLxGKfn2LR4ccTraXtS1O1rqbLEU4azoe0jjU+c5bEsl/NavnkW+fFq8xJJ1kA8Nk4hf8WLgfXfs",
+	"AXDnq7OKD73yzgaTEnDrk05ZznaKoMFEPi4CtuNT6DvGhqJeXdDr/dni/Vx3EnTYI/VDy//kIp8aYTHo",
+	"d7qda6hZ4EN9Q92qGQnFh1pEuPvbUM+AMnC/aQnIMUU6UvUgvJoQzCaOy3y+Glcko1dfo0fh52NOhh49",
+	"bqaT8/wg2ZmqKTJxUJIrIJYrSynJ/ww8B/1qT8r1Js06KT+lMqI+mFmBwHyOyxWBOx4bTY0mPRGnjO/D",
+	"ClF2V5BZKvnaRA9pgEMSyONgwf7/R+r14ZtVHXTuM67vSrPer/O6R9z3MsNE2Y1cIcLj8UnFz+oYUffE",
+	"BSuB1fkoOo9CRz9NWywgo7SvOzPx/AUv4E2Wl2m4ohMuiygxj6gfalDi4sMNUA1CBb8lPgW/P3SGHupe",
+	"wvaBYS1uSFZGrF8p3SYzKlHAeUNCktwhm6IPixGm5gyiQoh5dN2hyf4/mNQ2yit1y7ECSzIe55raMWS6",
+	"4PiosbDrQXnt6M3BULKeUI24v/PqmsIScidrfKVab2K+xW4mz7gDeP6qecunWSHm5ePSjTjAU7AphU6p",
+	"eD9LsWlM8lTjyruypk3klCuNvCBXkouxpXMeI265JH8XlwNvotZcVikmDMHGATwulLtMNzUUgZ29Op8y",
+	"zX1LLmnYtTBzWPEroXQ6/FgDNymF+C+rra84AJoGdNQc8bKt5gY/nSFeoALHQ8wQltwFoYWyxVYdyAI8",
+	"Eur1TGJ4jFtKVx1H7AsbNREuXVH6zUFo5aqv35JhAq8rt7DYdl4Is4KBlGP+ooT3fZ48IQuOEr72Ypag",
+	"wxWaUZ+69Cw4jShyyU7ZGripQqoxVdmlwk14DXODXlobYTvAxN5vkl6PxqkSho+XA+unTtk17dPycZmk",
+	"eUBp9y4h1lnzHDoUTu8BxGQ2dBa1BcbUb2bQXcTdnB6XYY5J7F158d24B7q4tgO7VqUyyGta3YVq4JiG",
+	"GhG2lWVWTUNhqnZ7Fx8T8/9xWvnki4XIBtNb4UcGG/eiOnoqjYNMUUMLHGn5cnROGJQab/jyjQO/389U",
+	"S6LAk55wERfVS9Lfzc0khyTYKzF8mjmwsS7qPaVKHyjDopL0QUR9gGOrFOljS0hTV6DhaE0dLMQ7QP0h",
+	"4kULeQgT8VpsIdZ1MjxXo5YvGa3dCLmaCA+dhZzuCasyfq9zvjsdJbDzDivRzIC0Q9BMlG56GE6Y4Q7k",
+	"XgYiHIJfDTeNYg1zHJaWL9OrGG1zOmd//vGHWuDUixcyzFTyUqprGWRCj8OvhczVtdnNL7UsNoWgpxm+",
+	"Vx27E+/JKTMr/NFYd886TBS5Ef9C8PdKIyTRtMtoLT5JLXZ3oRoiDO6rFlb3ubWIsrxD1z/20n3vJS+b",
+	"00sXBHDQ4dwiRCJ9hGQOA9ydG9M8SDnKI6vosMfhOVguCuMfevC6gEbsl2PnbqbRbyTPESlKj1tHS4VS",
+	"HGDCbyEXthulEJe+DhZdfl1sGqZPDy3uJbkpNUPvfArpRT2yaB4i98Oa+0zh3vRnhTKY/30oMUL77W/9",
+	"cOaBcS+cmkSUhNcCtIa8DoIqlIGZVQkdo4fHLlIYesZ1KyKYwTKeDrnBEi6vmxo1VM6YU8kW7l9vxRNk",
+	"GtYcsdNRJZnhMXcR+xv3PSSTCqfaXp9yza+zvS8GwhN0YXpEjLl+wbxRdH+Sqtu4l4WUoGch1qxbVka2",
+	"MwtT/vi8ytw5G2+M2gV/wCE7KEqSntmsP8uOKyhK9nQJ2xPn33KHoKlXMEbaGcgd6lHi/M4i36vD3aTw",
+	"Xt4Lep82H3KpVDEbCG8679fC6XL8pcgu/e01PNVEFe5Be2/gIOwziqqp41evV9tQ+6UsQUL+8JixM+ke",
+	"x4dQ1naZ7M7g8oHdNf6GRs0rV57Ku9GP38r0K2M6TPUdpVkAs1uGGZD5nYdyQHYPZDdyKMj+mopMtavR",
+	"H491vvaDSzvKTMRUDouUTnLhYtS+oY2eurJTKq8o5xyFLnLmY9uYKVTqTdpt0o0hqDSl4sEIIQtj7tkN",
+	"Fh54kgA+bn9Pamv/OSRvVgumoQkbvW0Wa58Y2olmM+S47Y5cj9KWdwulIR6RnqW4jPVhV5LAoWBtPRdW",
+	"c729Ta7pNqlSt7tBKu99gFG/vWgm0ry/6NOwKNT1jITVrK7XlvJgYjvTPoyD6abph7t6DtFLDm68orZl",
+	"K56zTGkNWdwjbYJ0WK2VhhlWJkiaZF+gQYkVYi2sYVQObMlUiV5zV/cwzUFDY1VSclKbIIqjT5LA8Q7O",
+	"1PeJ+HjkkHimusixGalae8sEhcV/g31cBqYmO6mb9MxFLw68UQTjs5F6CrnGfXyJcVz6vm7ISFo2L8SG",
+	"+AZ0assvmNX4eNS3IOgtFqKNzzWwtTDGoVLz0rUoCkqAJDaNPIA6VHnIeJ1Ue8/pIdWVoGj7djIs6oFK",
+	"bgZ1hrBYBlzE6Tsjp1golFLjGTybuvJ+zxjKz6aiBxGUCQGHeMrWylh/03SQmik3j0w+y5S0WhVFx97r",
+	"+MbHo73km7Mssy+UusSkVg/pXiuVrWeaT0OeoO5zoGYk3UmR2z6AZ8QDZn/JCdcORwlSYLSA7Ii4XuzT",
+	"XitYg+a7/RJ0f2jVWX9i3Xm1hWn6GnMmGbdqLbL0nvp9va8ZfBWTElEpUrgebuM7JqbNHh9WdTg1icg+",
+	"mUHyZJHjM+YFgQ8rdW7G0joNvAuXLYDb3tjRQdkXLl6LmmWDul4HAcLUpfCxlXaFxWNNrJYqaul8dGQr",
+	"7SI68lShtwd3ww0h3DtSFu6EVO+9U43gZ874MHU5kt3bKXwv778/bDyDt0L+ZjeXt4TH0KOOi4a1NDWp",
+	"Ey4OSIR0qZadLyDeUPqm+dh3ECYY4Uee8BECwy8jWjiMeh9xKBoLjg/kZtwOHO5ko5pGN22fjCGCHsrA",
+	"0igs41Uo4Y2wKw0+AaBT8XU7zLHkdhWOTmzetySjVRIMKTO/gVauNvc0CrODwpXu7hgDVDkr4ApaD0Yc",
+	"L5uKVE2Mt/B9Td2Z5QAlOVS6NrLUS4j4LO8YTvzcZ1Es/RjqJi0pjrBupdgeM0nSqLORM7dNzNithBhd",
+	"ibziLfqZQ1WOthkQt3KCVL07wizcI8cO87OD8DoAOAv9U6pMoMS7cXLoYBGUJt0uAbT3ZVRlhna9TD+M",
+	"ilNu1g4WGi2v420dizdyw5T8Wg4bJPss31y3Rq6TUDIi7LcbyEir8fcdCuLA+8yAk8Jn7yNulwC5uxVg",
+	"l4S1fQWSSdVce8gaGa4qTS7w8IMbmBoJ6W/Tt4gdbt4v3X1lGQFjppMUePAioWs+vb15/pPsxJ0bcRBe",
+	"ikcM+IQfO+xfgbv9tYMaqKrImcT1RN2fio37U8xL8SmbVwEQWitcjGF8D30OwQ+qZOwCcjMK2XTJBuzI",
+	"7U6wvqlDRC9UMVBbafpHKsv+UfFCLFywmEM/dGNmxZGFvOPVBX77d1848G71ahoQC9YWFYZy8xZjYUbg",
+	"tgglQhoP8lCkUrE1v4R4GSim3cnPzKLgNNWcLBd4ZHeWs08FP/mQapBCCJv7NyU837akQyiBgb3/7yb7",
+	"RTxUyFNcFjyDvFVqsy1nUBmqmcuuYL07PUpfrgUWCK0iptUhn1Z+C5PpgaIr9eZ4qIxgC+3oGtGuIng/",
+	"0xhp+e3UituRWGbUVO57FcY+rughHdcb34d+XH7949A/WYtgaBpj0P9noXtdxHMYX2ryMajcyrmXwNVZ",
+	"q+dqM9OwMPsCTKg1It8gbGoTq5CZBm5cxM35T/7i2aTaFxIvwu7pX+3TrKHksBCyEZZClpVN3GMo477c",
+	"RgSLjf5E1gEX2pCWgMrkFS9+ugKtRT60cLg71CIuDICYBEeH75swYdRnah+AMM0djjKyNGb0uBke4K6Y",
+	"qotMM5bLnOs8bi4ky0BbLtB3vTW39yjVzoF9PiUeaTPtPGGRd4lY2yFSbL1T+I7+nhpBfo+OnxEOmzcr",
+	"8Nzfdtb4VzlqwD/Tx+F34bBZ8w36+ChvyMCG8DUWyMNHzZiSZAZ3+tm4eYdxjPgNdg9D5aW8ILKKRh0z",
+	"xO59/xMtJV0jf5bC7tz5zkbZTeTinle6jRmIKpfNG2/HLP39WA7EwJft/DtB2Qz5ygLvQbSIQ6+G2nbx",
+	"gVWkMAifuCk2go8v29uOtEicMN4yMCOLgdnxihtM82KZZz48q29K65kaHFGmPj/SgZY2Z58P59IAekho",
+	"CC/w2sPWITMI55Bax7szIs1KVc6yMTGfrgJd7hAImLZxHOCPyAkwMO86PMbUNRljbmwXZzy03PNgcch9",
+	"3q4y23XpHzITDUj0tgtCLUiW0RZ2xjGlY2PKNFyvg0+6bQarhQTjTENWaTITX/Pt/vK5A5VPLv589vmj",
+	"x399/PkXDBuwXCzBNNVzOuVnm7hAIbt2n48bCdibnk0vQsg3Rp9r/2PInVEvit9rTtqaJjV+r/juIfbl",
+	"xAGQ2I6Jsqe3WiuC07zg/udartQk733FUiT48GuGYRrp6mW1XpVwoKRWK3Kh4A2kBG2EsSBtxwMqbBMR",
+	"bVZkHqQaFlcuf6QKz9UaLhB2IOQqNZGhgFqSZ/ipfoIFm7LwsuraP+4enpe/pzkLHSmNFBWDVixVetVe",
+	"LFgKI0b28wpqy7g3fJJFPIqRrYWti5ZNMaKPPE+zHsZs0E1YLdhuad84CoOgTkh6XMSEehE25S1Yc8g/",
+	"MZyp7DaSpDHt/9PIj0TqtXuTGvV0P4SsSN4PdqSWOuvFPdRpx0ah1k/DlWAPQmAgqVIrHU6UDyQqqKGd",
+	"l4D8CcGB3FU/XjaO5b3PQgiT0GEPenGWpKZd9wHoJy5U8bImSjSVd0Oc0Jr+vsRL9evXcJBES+SNJtaC",
+	"cWIpkdsiyqplvqmTVQ3cSno5rbRSlimJtpFELixnx6E9FTOOkBb0FS8+vtT4Tmhjz4gekL8efhoVJ0SK",
+	"iexIaW6XmfsFHzV2wT/A0PIV5d/6C+AaJc85D8o74XunGRl3eOHCqxe1NxokuyaYtNLs0Rds7ovGlRoy",
+	"YbrO/eugnNT5f0Cjd4yGgI3dk3Bo3zx/UfYObLwIkTjsx8i9VfvsPYbNFv3EQmVg5ya5PMV9PbZI0C8l",
+	"ozAl8rgqY3ctMHa7RI9RyuYDEz3GM6OU2qOnR/OgQ6cy0J/n6NO6RdvEQd3MbWyW0tF1yrAU5HxMctF0",
+	"TTHsTtlN76W42EGlxT5AXlNHIw/Dj5vimF+GKl24ag4D1Xg664GFe/Z61eLaSvjgFiQYYah60F99DcSP",
+	"e5YGDFxSo/5WdbjeJSuoI0xirq3Bo6GiqkkjCib5bokqNy5DQqWF3V4g/YMBTfz1MpUw8vs6haNPAVr7",
+	"0vzZZ9UlyBDv0SR8rEw4Xb9XvKDzyLn4JJ5Cqjhm37qaPn6jfPVg/u/w5E9P89Mnj/59/qfTz08zePr5",
+	"l6en/Mun/NGXTx7B4z99/vQUHi2++HL+OH/89PH86eOnX3z+Zfbk6aP50y++/PcHKIcQZYdoKOb1bPJf",
+	"s7NiqWZnr85nbxDZhia8FJgl8+aG7sounRoRNaOdCGsuismz8NP/E3bYcabWDfjw68TXGZ2srC3Ns5OT",
+	"6+vr47jLyZKe/s+sqrLVSRjnZtqh+Nmr8zpG38Xh0Io21uPjScMKZ/Tt9bcXbzB/3XHDMJNnk9Pj0+NH",
+	"CF+VIHkpJs8mT+gn2j0rWvcTyqh/YnyxrJP6rdbNtPetLF0pLfzkedT/tQJe2JX/Yw1Wiyx80sDzrf+/",
+	"uebLJejjv7uMefjT1eOToI2cvPeZE252fTuJI0NO3rcSTOR7eobIh31NTt77NA17ALbK//uYMyRq0uX5",
+	"PVifVdfZHhK5OsjT4KFPmamz7ZRaKNyvlHoqB4oLwK4U1oDO5UpmzlnshgBJ/3159l/kMH959l/sKyxC",
+	"7x4cGLrQpIZ3L65rRjvPHdr9OEXz9fasTg7WONcnz35NGZkc4VhZzQuRMaen0EZFLoz2UQ2xkZNkUZy4",
+	"c4IcfbXUR0l+Ovvy3fvP/3ST0iZ7unFNpCjBR0x6q0IFfyLamm++GiLZxkegI9x/VKC3zSTWfDOJEe57",
+	"UBPJrcMDoesoi12dQ76JWvzPi59+ZEozf3t+hbbC8DgqvIZrXgDGj+Gw5xDG/mCNkQZZrfGM8q+s1mZZ",
+	"tkt+1GR+N50EREmcPD49DTLU31CiDXri9300Uses1Wc0nDePDJX9p/BoYuQZJrLhJoqToKjFUKG/84RN",
+	"lbMYwG7TaH9EvyTJVwiHvsbva8FUAHkPfm861cxb5PAhPyUesvufv/eIkcTgXUqNiJc28Mgfq/s/Y3X7",
+	"WgkrFe5pQXHZzZETjrMWkl4XLbYB3YFEI8fsv1VFuiPeCioLtQhUmsRZfWAKE43p8yI1FIqeDtGXo6Pu",
+	"xI+OmrC/BVyHFL9HR31yHB0d40o9PVCU7bRTtwqHjNo7h4DrLdZLvqmjpjmTSs4kLF1W2+jC+fT00e92",
+	"hufSxamjsuyU+pvp5PPf8ZKdSwta8oJRSzebJ7/b2VyAvhIZsDewLpXmWhRb9rOsHwK4Sw/pJ6l0qy6L",
+	"pScE3ler9ZrrrVeieS1zKhlV+twpf3oZjhpFm6QoXxqKhSEV1em0Idm9XE7e3YQ7wMi7x65mJ3O1OaAp",
+	"xBeW4dsJeSbMyXuyrQ/+fuIdpOmP5ONwl+eTkGJ/oKXLspX+2LoVvbcbnMhucNgmgpdxm62q8uQ9/Yfu",
+	"wdGM3A31REOheN787Kp3ndiNPKFQ0ZP3Lfr4zz36tH9vusctrtYqh4CzWiwM2D2fT967f6OBYFOCFnhK",
+	"8aL51ZWzOTFVWRbb/s9bmSV/7M+jVcpj4OeTYJ1J3bTbLd+3/myzGuW57vx5Mucy+VtyKPrq0wt3f86F",
+	"GfhSingIs6psrq6juZJ3xbkG+/TBj5Xp/n1yzYVFDc7XseALC7rf2QIvTnz90s6vTcmw3heqgxb92NH5",
+	"SuUyHLWv26/59ZvWQ1XtMnl8rfLtjtNgM5sLSSIyFuGNzdR97N/fbqYJFxIFBwe3c0JBtorNteJ5xg2l",
+	"Y42yg7cu7jd3vBx2E4+cJ5yKhCbZQvq5MlHY7a+3QHDHaMDRurDz52HA5nXcB9caexh9zXMWUmLN2Ete",
+	"4IJDzs783aRFjQ+t8X16Fe0T61QfTQn6Omw+wzjlB2zdXnU6o09UknuMxoNXXBQAS5AzL4Jmc5VvfdXk",
+	"iebXduMSiHSF2wlvn1utb2QsNEMf78GE+s9tN91nLv3DSvmHlfIPO9YfVso/VvcPK+VIK+UfNrw/bHj/",
+	"K214hxjuUmqmt00Na5sCI0A5s717H2+KZ9Qivp3aTNhaJ2u9caU6HcIeM0xLoF2KHANXoDFEhhunXfkc",
+	"SmsKPaUEaZA/eytnLUxcgCcO/FnzXxdZ+7Y6PX0C7PRht4+x+BAlks39vqTv0if3+OUr9nbydtKDpGGt",
+	"6uIucfJ212sv2P+rhvtTr+oDPdGnxD8hjxozFdbbEY7khZJLxpeqiQqnbLFS0RfQiJwrkcyEnfpXNMI/",
+	"3Xar0skx39bc+xrAebOEe+MdOuySDnVAxjswzuHfxgQ5/K/W0m+bauuugnQn7JvpH1LlE0iVTy5Xfu8e",
+	"5Mi0+D9SzXx6+vR3O6HYEP2jsuw73Ax3VMd8FtMsWULstopWyGITzH1N1HQchUynaB1//Os7PAgM6Ktw",
+	"wDZBtc9OTiit2UoZezK5mcbfTOfjuxrn9+F0KrW4QmxuyLqptFgKiWU1XFTqrAmcfXx8Orn5PwMAoZFu",
+	"SJ8yAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
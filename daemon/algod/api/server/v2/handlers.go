@@ -26,6 +26,7 @@ import (
 	"math"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"slices"
 	"strconv"
@@ -56,10 +57,12 @@ import (
 	"github.com/DePINNetwork/depin-sdk/ledger/simulation"
 	"github.com/DePINNetwork/depin-sdk/libgoal/participation"
 	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/node"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/rpcs"
 	"github.com/DePINNetwork/depin-sdk/stateproof"
+	"github.com/DePINNetwork/depin-sdk/util/tracing"
 )
 
 // MaxTealSourceBytes sets a size limit for TEAL source programs for requests
@@ -135,7 +138,7 @@ type NodeInterface interface {
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
-	StartCatchup(catchpoint string) error
+	StartCatchup(catchpoint string, catchpointFile string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
@@ -148,6 +151,17 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	ListPeerBans() []network.PeerBan
+	BanPeer(address string, duration time.Duration, reason string) error
+	UnbanPeer(address string) error
+	ListPeers() ([]network.PeerInfo, error)
+	ConnectPeer(address string) error
+	DisconnectPeer(address string) error
+	PinPeer(address string, duration time.Duration) error
+	UnpinPeer(address string) error
+	ListPinnedPeers() ([]network.PeerPin, error)
+	ReloadConfig() (node.ConfigReloadResult, error)
+	OnConfigReload(handler func(cfg config.Local))
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -1125,16 +1139,32 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
+	// For backwards compatibility, return txid of first tx in group
+	txid := txgroup[0].ID()
+
+	txnTrace := startTxnTrace(ctx, txgroup, "rest")
+	_, span := txnTrace.StartSpan("rest.RawTransaction")
 	err = v2.Node.BroadcastSignedTxGroup(txgroup)
 	if err != nil {
+		span.End()
+		txnTrace.Reject(err)
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
+	span.End()
 
-	// For backwards compatibility, return txid of first tx in group
-	txid := txgroup[0].ID()
 	return ctx.JSON(http.StatusOK, model.PostTransactionsResponse{TxId: txid.String()})
 }
 
+// startTxnTrace starts tracing the lifecycle of a submitted transaction group, continuing the
+// trace of the request if it carries a traceparent header
+func startTxnTrace(ctx echo.Context, txgroup []transactions.SignedTxn, origin string) *tracing.TxnTrace {
+	if !tracing.Enabled() {
+		return nil
+	}
+	req := ctx.Request()
+	return tracing.StartTxnTrace(tracing.ExtractHTTP(req.Context(), req.Header), crypto.Digest(txgroup[0].ID()), origin)
+}
+
 // RawTransactionAsync broadcasts a raw transaction to the network without ensuring it is accepted by transaction pool.
 // (POST /v2/transactions/async)
 func (v2 *Handlers) RawTransactionAsync(ctx echo.Context) error {
@@ -1148,8 +1178,10 @@ func (v2 *Handlers) RawTransactionAsync(ctx echo.Context) error {
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
+	txnTrace := startTxnTrace(ctx, txgroup, "rest-async")
 	err = v2.Node.AsyncBroadcastSignedTxGroup(txgroup)
 	if err != nil {
+		txnTrace.Reject(err)
 		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
 	}
 	return ctx.NoContent(http.StatusOK)
@@ -1663,12 +1695,22 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 }
 
 // startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds uint64) error {
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds uint64, catchpointFile string) error {
 	catchpointRound, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
 	}
 
+	if catchpointFile != "" {
+		if !filepath.IsAbs(catchpointFile) {
+			return badRequest(ctx, nil, errCatchpointFileNotAbsolute, v2.Log)
+		}
+		catchpointFile, err = catchup.ResolveCatchpointFile(catchpointFile, catchpoint)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedToOpenCatchpointFile, err), v2.Log)
+		}
+	}
+
 	if minRounds > 0 {
 		ledgerRound := v2.Node.LedgerForAPI().Latest()
 		if catchpointRound < (ledgerRound + basics.Round(minRounds)) {
@@ -1681,7 +1723,7 @@ func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	err = v2.Node.StartCatchup(catchpoint, catchpointFile)
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1913,7 +1955,7 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr strin
 // (POST /v2/catchup/{catchpoint})
 func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string, params model.StartCatchupParams) error {
 	min := nilToZero(params.Min)
-	return v2.startCatchup(ctx, catchpoint, min)
+	return v2.startCatchup(ctx, catchpoint, min, nilToZero(params.File))
 }
 
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
@@ -2176,6 +2218,218 @@ func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) err
 	return ctx.NoContent(http.StatusOK)
 }
 
+// GetPeerBans returns the peer ban list.
+// (GET /v2/peers/bans)
+func (v2 *Handlers) GetPeerBans(ctx echo.Context) error {
+	bans := v2.Node.ListPeerBans()
+	response := model.PeerBansResponse{Bans: make([]model.PeerBan, len(bans))}
+	for i, ban := range bans {
+		response.Bans[i] = model.PeerBan{
+			Address: ban.Address,
+			Manual:  ban.Manual,
+			Reason:  omitEmpty(ban.Reason),
+		}
+		if !ban.Permanent() {
+			expires := uint64(ban.Expires.Unix())
+			response.Bans[i].Expires = &expires
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// BanPeer bans an IP address or a libp2p peer ID.
+// (POST /v2/peers/bans/{address})
+func (v2 *Handlers) BanPeer(ctx echo.Context, address string, params model.BanPeerParams) error {
+	var duration time.Duration
+	if params.Duration != nil {
+		if *params.Duration > uint64(math.MaxInt64/time.Second) {
+			err := fmt.Errorf("ban duration %d is too large", *params.Duration)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		duration = time.Duration(*params.Duration) * time.Second
+	}
+	var reason string
+	if params.Reason != nil {
+		reason = *params.Reason
+	}
+	err := v2.Node.BanPeer(address, duration, reason)
+	if err != nil {
+		if errors.Is(err, network.ErrBadPeerBanAddress) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToBanPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// UnbanPeer lifts the ban of an IP address or a libp2p peer ID.
+// (DELETE /v2/peers/bans/{address})
+func (v2 *Handlers) UnbanPeer(ctx echo.Context, address string) error {
+	err := v2.Node.UnbanPeer(address)
+	if err != nil {
+		switch {
+		case errors.Is(err, network.ErrBadPeerBanAddress):
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		case errors.Is(err, network.ErrPeerNotBanned):
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToUnbanPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetPeers returns the connected peers.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	peers, err := v2.Node.ListPeers()
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedToListPeers, err), v2.Log)
+	}
+	response := model.PeersResponse{Peers: make([]model.PeerInfo, len(peers))}
+	for i, peer := range peers {
+		response.Peers[i] = model.PeerInfo{
+			Address:        peer.Address,
+			PeerId:         omitEmpty(peer.PeerID),
+			Network:        peer.Network,
+			Role:           peer.Role,
+			Outgoing:       peer.Outgoing,
+			Pinned:         peer.Pinned,
+			ConnectedSince: uint64(peer.ConnectedSince.Unix()),
+			Traffic:        peerTraffic(peer),
+		}
+		if peer.MessageDelay > 0 {
+			delay := uint64(peer.MessageDelay)
+			response.Peers[i].MessageDelay = &delay
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// peerTraffic converts the traffic exchanged with a peer by message tag
+func peerTraffic(peer network.PeerInfo) []model.PeerTagTraffic {
+	traffic := make([]model.PeerTagTraffic, len(peer.Traffic))
+	for i, t := range peer.Traffic {
+		traffic[i] = model.PeerTagTraffic{
+			Tag:              string(t.Tag),
+			BytesReceived:    t.Total.BytesReceived,
+			BytesSent:        t.Total.BytesSent,
+			MessagesReceived: t.Total.MessagesReceived,
+			MessagesSent:     t.Total.MessagesSent,
+			Windows:          make([]model.PeerTrafficWindow, len(t.Windows)),
+		}
+		for j, w := range t.Windows {
+			traffic[i].Windows[j] = model.PeerTrafficWindow{
+				Seconds:          uint64(network.PeerTrafficWindows[j] / time.Second),
+				BytesReceived:    w.BytesReceived,
+				BytesSent:        w.BytesSent,
+				MessagesReceived: w.MessagesReceived,
+				MessagesSent:     w.MessagesSent,
+			}
+		}
+	}
+	return traffic
+}
+
+// ConnectPeer connects the node to a peer.
+// (POST /v2/peers/connect)
+func (v2 *Handlers) ConnectPeer(ctx echo.Context, params model.ConnectPeerParams) error {
+	err := v2.Node.ConnectPeer(params.Address)
+	if err != nil {
+		if errors.Is(err, network.ErrBadPeerAddress) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToConnectPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DisconnectPeer closes the connection with a peer.
+// (POST /v2/peers/disconnect)
+func (v2 *Handlers) DisconnectPeer(ctx echo.Context, params model.DisconnectPeerParams) error {
+	err := v2.Node.DisconnectPeer(params.Address)
+	if err != nil {
+		switch {
+		case errors.Is(err, network.ErrBadPeerAddress):
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		case errors.Is(err, network.ErrPeerNotConnected):
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToDisconnectPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetPinnedPeers returns the pinned peers.
+// (GET /v2/peers/pins)
+func (v2 *Handlers) GetPinnedPeers(ctx echo.Context) error {
+	pins, err := v2.Node.ListPinnedPeers()
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedToListPeers, err), v2.Log)
+	}
+	response := model.PeerPinsResponse{Pins: make([]model.PeerPin, len(pins))}
+	for i, pin := range pins {
+		response.Pins[i] = model.PeerPin{Address: pin.Address}
+		if !pin.Expires.IsZero() {
+			expires := uint64(pin.Expires.Unix())
+			response.Pins[i].Expires = &expires
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PinPeer pins a peer.
+// (POST /v2/peers/pins)
+func (v2 *Handlers) PinPeer(ctx echo.Context, params model.PinPeerParams) error {
+	var duration time.Duration
+	if params.Duration != nil {
+		if *params.Duration > uint64(math.MaxInt64/time.Second) {
+			err := fmt.Errorf("pin duration %d is too large", *params.Duration)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		duration = time.Duration(*params.Duration) * time.Second
+	}
+	err := v2.Node.PinPeer(params.Address, duration)
+	if err != nil {
+		if errors.Is(err, network.ErrBadPeerAddress) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPinPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// UnpinPeer lifts the pin of a peer.
+// (DELETE /v2/peers/pins)
+func (v2 *Handlers) UnpinPeer(ctx echo.Context, params model.UnpinPeerParams) error {
+	err := v2.Node.UnpinPeer(params.Address)
+	if err != nil {
+		switch {
+		case errors.Is(err, network.ErrBadPeerAddress):
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		case errors.Is(err, network.ErrPeerNotPinned):
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToUnpinPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ReloadConfig reads config.json again and applies the settings which can be changed on a running node.
+// (POST /v2/config/reload)
+func (v2 *Handlers) ReloadConfig(ctx echo.Context) error {
+	result, err := v2.Node.ReloadConfig()
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedToReloadConfig, err), v2.Log)
+	}
+	response := model.ConfigReloadResponse{
+		Applied:         make([]string, 0, len(result.Applied)),
+		RestartRequired: make([]string, 0, len(result.RestartRequired)),
+	}
+	response.Applied = append(response.Applied, result.Applied...)
+	response.RestartRequired = append(response.RestartRequired, result.RestartRequired...)
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // savedBlockingRate is the current blocking rate
 var savedBlockingRate atomic.Int32
 
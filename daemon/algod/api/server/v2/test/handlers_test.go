@@ -27,6 +27,8 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"strings"
@@ -65,6 +67,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/data/txntest"
 	simulationtesting "github.com/DePINNetwork/depin-sdk/ledger/simulation/testing"
 	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/node"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/stateproof"
@@ -1444,6 +1447,42 @@ func TestStartCatchupInit(t *testing.T) {
 	startCatchupTestFull(t, catchpointOK, nil, 201, minRoundsToInitialize, catchpointOK)
 }
 
+func TestStartCatchupFromFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+
+	catchpoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	catchpointFile := filepath.Join(t.TempDir(), "5894690.catchpoint")
+	require.NoError(t, os.WriteFile(catchpointFile, []byte{}, 0600))
+
+	testcases := []struct {
+		file         string
+		expectedCode int
+	}{
+		{catchpointFile, http.StatusCreated},
+		{filepath.Dir(catchpointFile), http.StatusCreated},
+		{"5894690.catchpoint", http.StatusBadRequest},
+		{catchpointFile + ".missing", http.StatusBadRequest},
+		{t.TempDir(), http.StatusBadRequest},
+	}
+	for _, tc := range testcases {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		err := handler.StartCatchup(c, catchpoint, model.StartCatchupParams{File: &tc.file})
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedCode, rec.Code, tc.file)
+	}
+}
+
 func TestStartCatchup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2450,7 +2489,7 @@ func TestRouterRequestBody(t *testing.T) {
 	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
 	dummyShutdownChan := make(chan struct{})
 	l, err := net.Listen("tcp", ":0") // create listener so requests are buffered
-	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", l, 1000)
+	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", nil, l, 1000)
 	go e.Start(":0")
 	defer e.Close()
 
@@ -2611,3 +2650,219 @@ func TestGetConfigEndpoint(t *testing.T) {
 
 	require.Equal(t, handler.Node.Config(), responseConfig)
 }
+
+func TestPeerBans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(req, rec), rec
+	}
+	listBans := func() []model.PeerBan {
+		c, rec := newContext()
+		require.NoError(t, handler.GetPeerBans(c))
+		require.Equal(t, 200, rec.Code)
+		var response model.PeerBansResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response.Bans
+	}
+
+	require.Empty(t, listBans())
+
+	c, rec := newContext()
+	require.NoError(t, handler.BanPeer(c, "not-an-address", model.BanPeerParams{}))
+	require.Equal(t, 400, rec.Code)
+
+	reason := "spam"
+	c, rec = newContext()
+	require.NoError(t, handler.BanPeer(c, "10.0.0.1", model.BanPeerParams{Reason: &reason}))
+	require.Equal(t, 200, rec.Code)
+
+	duration := uint64(3600)
+	c, rec = newContext()
+	require.NoError(t, handler.BanPeer(c, "10.0.0.2", model.BanPeerParams{Duration: &duration}))
+	require.Equal(t, 200, rec.Code)
+
+	bans := listBans()
+	require.Len(t, bans, 2)
+	require.Equal(t, "10.0.0.1", bans[0].Address)
+	require.True(t, bans[0].Manual)
+	require.Equal(t, &reason, bans[0].Reason)
+	require.Nil(t, bans[0].Expires)
+	require.Equal(t, "10.0.0.2", bans[1].Address)
+	require.Nil(t, bans[1].Reason)
+	require.NotNil(t, bans[1].Expires)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnbanPeer(c, "10.0.0.1"))
+	require.Equal(t, 200, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnbanPeer(c, "10.0.0.1"))
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnbanPeer(c, "not-an-address"))
+	require.Equal(t, 400, rec.Code)
+
+	require.Len(t, listBans(), 1)
+}
+
+func TestPeerManagement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(req, rec), rec
+	}
+	listPeers := func() []model.PeerInfo {
+		c, rec := newContext()
+		require.NoError(t, handler.GetPeers(c))
+		require.Equal(t, 200, rec.Code)
+		var response model.PeersResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response.Peers
+	}
+	listPins := func() []model.PeerPin {
+		c, rec := newContext()
+		require.NoError(t, handler.GetPinnedPeers(c))
+		require.Equal(t, 200, rec.Code)
+		var response model.PeerPinsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response.Pins
+	}
+
+	require.Empty(t, listPeers())
+	require.Empty(t, listPins())
+
+	c, rec := newContext()
+	require.NoError(t, handler.ConnectPeer(c, model.ConnectPeerParams{}))
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.ConnectPeer(c, model.ConnectPeerParams{Address: "relay.example.com:4160"}))
+	require.Equal(t, 200, rec.Code)
+
+	mock := handler.Node.(*mockNode)
+	mock.peers = append(mock.peers, network.PeerInfo{
+		Address:        "10.0.0.1:4160",
+		PeerID:         "QmPeer",
+		Network:        "p2p",
+		Role:           network.PeerRoleClient,
+		MessageDelay:   time.Millisecond,
+		ConnectedSince: time.Unix(1000, 0),
+		Traffic: []network.PeerTagTraffic{
+			{
+				Tag:     protocol.AgreementVoteTag,
+				Total:   network.TrafficCounts{BytesReceived: 10, MessagesReceived: 1},
+				Windows: []network.TrafficCounts{{BytesReceived: 10, MessagesReceived: 1}, {BytesReceived: 10, MessagesReceived: 1}},
+			},
+			{
+				Tag:     protocol.TxnTag,
+				Total:   network.TrafficCounts{BytesReceived: 100, BytesSent: 50, MessagesReceived: 4, MessagesSent: 2},
+				Windows: []network.TrafficCounts{{BytesSent: 50, MessagesSent: 2}, {BytesReceived: 100, BytesSent: 50, MessagesReceived: 4, MessagesSent: 2}},
+			},
+		},
+	})
+
+	peers := listPeers()
+	require.Len(t, peers, 2)
+	require.Equal(t, "relay.example.com:4160", peers[0].Address)
+	require.Equal(t, network.PeerRoleRelay, peers[0].Role)
+	require.True(t, peers[0].Outgoing)
+	require.Nil(t, peers[0].PeerId)
+	require.Empty(t, peers[0].Traffic)
+	require.Equal(t, "QmPeer", *peers[1].PeerId)
+	require.Equal(t, uint64(time.Millisecond), *peers[1].MessageDelay)
+	require.Equal(t, uint64(1000), peers[1].ConnectedSince)
+	require.Equal(t, []model.PeerTagTraffic{
+		{
+			Tag: string(protocol.AgreementVoteTag), BytesReceived: 10, MessagesReceived: 1,
+			Windows: []model.PeerTrafficWindow{
+				{Seconds: 60, BytesReceived: 10, MessagesReceived: 1},
+				{Seconds: 300, BytesReceived: 10, MessagesReceived: 1},
+			},
+		},
+		{
+			Tag: string(protocol.TxnTag), BytesReceived: 100, BytesSent: 50, MessagesReceived: 4, MessagesSent: 2,
+			Windows: []model.PeerTrafficWindow{
+				{Seconds: 60, BytesSent: 50, MessagesSent: 2},
+				{Seconds: 300, BytesReceived: 100, BytesSent: 50, MessagesReceived: 4, MessagesSent: 2},
+			},
+		},
+	}, peers[1].Traffic)
+
+	c, rec = newContext()
+	require.NoError(t, handler.DisconnectPeer(c, model.DisconnectPeerParams{Address: "10.0.0.1:4160"}))
+	require.Equal(t, 200, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.DisconnectPeer(c, model.DisconnectPeerParams{Address: "10.0.0.1:4160"}))
+	require.Equal(t, 404, rec.Code)
+	require.Len(t, listPeers(), 1)
+
+	c, rec = newContext()
+	require.NoError(t, handler.PinPeer(c, model.PinPeerParams{Address: "relay.example.com:4160"}))
+	require.Equal(t, 200, rec.Code)
+
+	duration := uint64(3600)
+	c, rec = newContext()
+	require.NoError(t, handler.PinPeer(c, model.PinPeerParams{Address: "10.0.0.2", Duration: &duration}))
+	require.Equal(t, 200, rec.Code)
+
+	tooLong := uint64(math.MaxUint64)
+	c, rec = newContext()
+	require.NoError(t, handler.PinPeer(c, model.PinPeerParams{Address: "10.0.0.3", Duration: &tooLong}))
+	require.Equal(t, 400, rec.Code)
+
+	pins := listPins()
+	require.Len(t, pins, 2)
+	require.Equal(t, "relay.example.com:4160", pins[0].Address)
+	require.Nil(t, pins[0].Expires)
+	require.Equal(t, "10.0.0.2", pins[1].Address)
+	require.NotNil(t, pins[1].Expires)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnpinPeer(c, model.UnpinPeerParams{Address: "10.0.0.2"}))
+	require.Equal(t, 200, rec.Code)
+
+	c, rec = newContext()
+	require.NoError(t, handler.UnpinPeer(c, model.UnpinPeerParams{Address: "10.0.0.2"}))
+	require.Equal(t, 404, rec.Code)
+	require.Len(t, listPins(), 1)
+}
+
+func TestReloadConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	mockedNode := handler.Node.(*mockNode)
+	mockedNode.reloadResult = node.ConfigReloadResult{Applied: []string{"TxPoolSize"}}
+	require.NoError(t, handler.ReloadConfig(c))
+	require.Equal(t, 200, rec.Code)
+	var response model.ConfigReloadResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, []string{"TxPoolSize"}, response.Applied)
+	require.Empty(t, response.RestartRequired)
+	require.NotContains(t, rec.Body.String(), "null")
+
+	mockedNode.reloadErr = errors.New("invalid value for TxPoolSize: 0")
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.ReloadConfig(echo.New().NewContext(req, rec)))
+	require.Equal(t, 400, rec.Code)
+	require.Contains(t, rec.Body.String(), "TxPoolSize")
+}
@@ -19,8 +19,10 @@ package test
 import (
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -37,6 +39,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/data/transactions/logic"
 	"github.com/DePINNetwork/depin-sdk/ledger/simulation"
 	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/node"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/util/db"
@@ -97,6 +100,11 @@ type mockNode struct {
 	devmode         bool
 	timestampOffset *int64
 	PartKeyBinary   []byte
+	peerBans        *network.PeerBanList
+	peers           []network.PeerInfo
+	pins            []network.PeerPin
+	reloadResult    node.ConfigReloadResult
+	reloadErr       error
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -140,6 +148,10 @@ func makeMockNode(ledger v2.LedgerForAPI, genesisID string, nodeError error, sta
 }
 
 func makeMockNodeWithConfig(ledger v2.LedgerForAPI, genesisID string, nodeError error, status node.StatusReport, devMode bool, cfg config.Local) *mockNode {
+	peerBans, err := network.LoadPeerBanList(logging.Base(), cfg, "")
+	if err != nil {
+		panic(err)
+	}
 	return &mockNode{
 		ledger:    ledger,
 		genesisID: genesisID,
@@ -147,6 +159,7 @@ func makeMockNodeWithConfig(ledger v2.LedgerForAPI, genesisID string, nodeError
 		err:       nodeError,
 		status:    status,
 		devmode:   devMode,
+		peerBans:  peerBans,
 	}
 }
 
@@ -196,7 +209,7 @@ func (m *mockNode) Config() config.Local {
 	return m.config
 }
 
-func (m *mockNode) StartCatchup(catchpoint string) error {
+func (m *mockNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	return m.err
 }
 
@@ -221,6 +234,72 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) ListPeerBans() []network.PeerBan {
+	return m.peerBans.List()
+}
+
+func (m *mockNode) BanPeer(address string, duration time.Duration, reason string) error {
+	return m.peerBans.Ban(address, duration, reason)
+}
+
+func (m *mockNode) UnbanPeer(address string) error {
+	return m.peerBans.Unban(address)
+}
+
+func (m *mockNode) ListPeers() ([]network.PeerInfo, error) {
+	return m.peers, nil
+}
+
+func (m *mockNode) ConnectPeer(address string) error {
+	if address == "" {
+		return network.ErrBadPeerAddress
+	}
+	m.peers = append(m.peers, network.PeerInfo{Address: address, Network: "ws", Role: network.PeerRoleRelay, Outgoing: true, ConnectedSince: time.Now()})
+	return nil
+}
+
+func (m *mockNode) DisconnectPeer(address string) error {
+	for i, peer := range m.peers {
+		if peer.Address == address {
+			m.peers = slices.Delete(m.peers, i, i+1)
+			return nil
+		}
+	}
+	return network.ErrPeerNotConnected
+}
+
+func (m *mockNode) PinPeer(address string, duration time.Duration) error {
+	if address == "" {
+		return network.ErrBadPeerAddress
+	}
+	pin := network.PeerPin{Address: address}
+	if duration > 0 {
+		pin.Expires = time.Now().Add(duration)
+	}
+	m.pins = append(m.pins, pin)
+	return nil
+}
+
+func (m *mockNode) UnpinPeer(address string) error {
+	for i, pin := range m.pins {
+		if pin.Address == address {
+			m.pins = slices.Delete(m.pins, i, i+1)
+			return nil
+		}
+	}
+	return network.ErrPeerNotPinned
+}
+
+func (m *mockNode) ListPinnedPeers() ([]network.PeerPin, error) {
+	return m.pins, nil
+}
+
+func (m *mockNode) ReloadConfig() (node.ConfigReloadResult, error) {
+	return m.reloadResult, m.reloadErr
+}
+
+func (m *mockNode) OnConfigReload(handler func(cfg config.Local)) {}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -48,6 +48,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/util"
 	"github.com/DePINNetwork/depin-sdk/util/metrics"
 	"github.com/DePINNetwork/depin-sdk/util/tokens"
+	"github.com/DePINNetwork/depin-sdk/util/tracing"
 )
 
 var server http.Server
@@ -74,6 +75,7 @@ type Server struct {
 	node                 ServerNode
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
+	scopedTokens         *tokens.ScopedTokenStore
 	stopping             chan struct{}
 }
 
@@ -342,6 +344,18 @@ func (s *Server) Start() {
 		s.metricServiceStarted = true
 	}
 
+	if cfg.EnableTracing {
+		err = tracing.Start(context.Background(), tracing.Config{
+			Endpoint:      cfg.TracingOTLPEndpoint,
+			SamplePercent: cfg.TracingSamplePercent,
+			ServiceName:   "algod",
+			Attributes:    map[string]string{"service.version": config.GetCurrentVersion().String()},
+		})
+		if err != nil {
+			s.log.Warnf("Unable to start tracing : %v", err)
+		}
+	}
+
 	var apiToken string
 	fmt.Printf("API authentication disabled: %v\n", cfg.DisableAPIAuth)
 	if !cfg.DisableAPIAuth {
@@ -358,6 +372,12 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	s.scopedTokens, err = tokens.MakeScopedTokenStore(s.RootPath)
+	if err != nil {
+		fmt.Printf("Scoped API tokens error: %v\n", err)
+		os.Exit(1)
+	}
+
 	s.stopping = make(chan struct{})
 
 	addr := cfg.EndpointAddress
@@ -382,7 +402,7 @@ func (s *Server) Start() {
 	}
 
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, s.scopedTokens, listener,
 		cfg.RestConnectionsSoftLimit)
 
 	// Set up files for our PID and our listening address
@@ -434,7 +454,21 @@ func (s *Server) Start() {
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
-	signal.Ignore(syscall.SIGHUP)
+
+	// SIGHUP reloads config.json, applying the settings that can change while running
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-hup:
+				s.reloadConfig()
+			case <-s.stopping:
+				signal.Stop(hup)
+				return
+			}
+		}
+	}()
 
 	fmt.Printf("Node running and accepting RPC requests over HTTP on port %v. Press Ctrl-C to exit\n", addr)
 	select {
@@ -452,6 +486,18 @@ func (s *Server) Start() {
 	}
 }
 
+// reloadConfig re-reads config.json and reports which changes were applied and which need a restart.
+func (s *Server) reloadConfig() {
+	result, err := s.node.ReloadConfig()
+	if err != nil {
+		s.log.Warnf("Unable to reload config : %v", err)
+		return
+	}
+	if len(result.RestartRequired) > 0 {
+		s.log.Warnf("Config changes to %v require a restart to take effect", result.RestartRequired)
+	}
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
 	// close the s.stopping, which would signal the rest api router that any pending commands
@@ -467,6 +513,9 @@ func (s *Server) Stop() {
 	if err != nil {
 		s.log.Error(err)
 	}
+	if s.scopedTokens != nil {
+		s.scopedTokens.Close()
+	}
 
 	if s.metricServiceStarted {
 		if err := s.metricCollector.Shutdown(); err != nil {
@@ -476,6 +525,10 @@ func (s *Server) Stop() {
 		s.metricServiceStarted = false
 	}
 
+	if err := tracing.Shutdown(context.Background()); err != nil {
+		s.log.Infof("Unable to shutdown tracing : %v", err)
+	}
+
 	s.log.CloseTelemetry()
 
 	os.Remove(s.pidFile)
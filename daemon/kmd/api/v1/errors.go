@@ -26,3 +26,6 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errNotHDWallet = fmt.Errorf("wallet does not derive keys along derivation paths")
+var errNoBIP39Support = fmt.Errorf("wallet driver does not support recovery from a BIP-39 mnemonic")
+var errNoBackupSupport = fmt.Errorf("wallet driver does not support backups")
@@ -22,7 +22,9 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/backup"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/lib/kmdapi"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/policy"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/session"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet/driver"
@@ -171,8 +173,18 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		walletName = walletID
 	}
 
-	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	// Create the wallet via its driver, recovering it from a BIP-39 mnemonic
+	// if one was passed
+	if req.BIP39Mnemonic != "" {
+		bip39Driver, ok := walletDriver.(driver.BIP39Driver)
+		if !ok || req.MasterDerivationKey != (kmdapi.APIV1MasterDerivationKey{}) {
+			errorResponse(w, http.StatusBadRequest, errNoBIP39Support)
+			return
+		}
+		err = bip39Driver.CreateWalletFromBIP39Mnemonic(walletName, walletID, []byte(req.WalletPassword), req.BIP39Mnemonic)
+	} else {
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -310,6 +322,189 @@ func postWalletInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	successResponse(w, resp)
 }
 
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Export everything stored in the wallet (its master derivation key, generated and imported
+	//      keys, multisig preimages, and metadata) as a single archive, encrypted with a key derived
+	//      from the backup password using Argon2id. The archive can be restored with
+	//      `POST /v1/wallet/restore`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	authWallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Fetch metadata about the wallet
+	metadata, err := authWallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch the wallet's driver, which reads its contents
+	walletDriver, err := driver.FetchWalletDriver(metadata.DriverName)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	backupDriver, ok := walletDriver.(driver.BackupDriver)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBackupSupport)
+		return
+	}
+
+	// Read the wallet contents. This checks the wallet password, and the
+	// signing policy of the wallet if it has one
+	var contents wallet.Contents
+	readContents := func() (err error) {
+		contents, err = backupDriver.BackupWallet(metadata.ID, []byte(req.WalletPassword))
+		return
+	}
+	if exporter, ok := authWallet.(policy.Exporter); ok {
+		err = exporter.Export(policy.OpBackupWallet, readContents)
+	} else {
+		err = readContents()
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Encrypt the contents into an archive
+	archive, err := backup.Seal(contents, []byte(req.BackupPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: archive,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet from a backup
+	//    Description: >
+	//      Create a new wallet holding the contents of an archive made by `POST /v1/wallet/backup`.
+	//      The archive is rejected if it has been modified, and generated keys are checked against
+	//      the archived master derivation key. The new wallet gets a fresh ID, and the archived name
+	//      unless another one is given.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Decrypt and verify the archive
+	contents, err := backup.Open(req.Backup, []byte(req.BackupPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the driver of the wallet that was backed up
+	walletDriver, err := driver.FetchWalletDriver(contents.DriverName)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	backupDriver, ok := walletDriver.(driver.BackupDriver)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBackupSupport)
+		return
+	}
+
+	// Generate a wallet ID
+	walletID, err := wallet.GenerateWalletID()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// If the wallet name is blank, use the archived name
+	walletName := []byte(req.WalletName)
+	if len(walletName) == 0 {
+		walletName = contents.Name
+	}
+
+	// Restore the wallet via its driver
+	err = backupDriver.RestoreWallet(walletName, walletID, []byte(req.WalletPassword), contents)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := walletDriver.FetchWallet(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postMasterKeyExportHandler handles `POST /v1/master-key/export`
 func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/master-key/export ExportMasterKey
@@ -342,15 +537,26 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	authWallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
 	}
 
+	// HD wallets are backed up by their BIP-39 mnemonic, which may be too
+	// short to be exported as a master derivation key
+	var mnemonic string
+	if hdWallet, ok := authWallet.(wallet.HDWallet); ok {
+		mnemonic, err = hdWallet.ExportBIP39Mnemonic([]byte(req.WalletPassword))
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	// Export the master derivation key
-	mdk, err := wallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
-	if err != nil {
+	mdk, err := authWallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
+	if err != nil && mnemonic == "" {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -358,6 +564,7 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	// Build the response
 	resp := kmdapi.APIV1POSTMasterKeyExportResponse{
 		MasterDerivationKey: mdk,
+		BIP39Mnemonic:       mnemonic,
 	}
 
 	// Return and encode the response
@@ -796,6 +1003,67 @@ func postKeyListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request)
 	successResponse(w, resp)
 }
 
+// postKeyDerivedHandler handles `POST /v1/key/derived`
+func postKeyDerivedHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/key/derived ListDerivedKeysInWallet
+	//---
+	//    Summary: List derived keys in wallet
+	//    Description: Lists the keys generated by a hierarchical deterministic wallet, along with the derivation path of each. Imported keys are not listed.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Derived Keys Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListDerivedKeysRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListDerivedKeysResponse"
+	var req kmdapi.APIV1POSTKeyDerivedRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	authWallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Only hierarchical deterministic wallets know their derivation paths
+	hdWallet, ok := authWallet.(wallet.HDWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNotHDWallet)
+		return
+	}
+
+	// List the derived keys
+	derivedKeys, err := hdWallet.ListDerivedKeys()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTKeyDerivedResponse{}
+	for _, key := range derivedKeys {
+		resp.Keys = append(resp.Keys, kmdapi.APIV1DerivedKey{
+			Address: encodeAddress(key.Address),
+			Path:    key.Path,
+		})
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postTransactionSignHandler handles `POST /v1/transaction/sign`
 func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/transaction/sign SignTransaction
@@ -1299,9 +1567,12 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
+	router.HandleFunc("/key/derived", wrapCtx(ctx, postKeyDerivedHandler)).Methods("POST")
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
 	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
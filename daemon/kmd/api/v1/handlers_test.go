@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/lib/kmdapi"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/policy"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/session"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet/driver"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+// callHandler runs handler on req, decoding its response into resp, and returns the status code
+func callHandler(t *testing.T, ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request), req interface{}, resp interface{}) int {
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeJSON(req)))
+	w := httptest.NewRecorder()
+	handler(ctx, w, r)
+	require.NoError(t, protocol.DecodeJSON(w.Body.Bytes(), resp))
+	return w.Code
+}
+
+func TestPolicyHDWalletHandlers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams.ScryptN = 2
+	cfg.DriverConfig.LedgerWalletDriverConfig.Disable = true
+	require.NoError(t, driver.InitWalletDrivers(cfg, logging.TestingLog(t)))
+
+	hdDriver, err := driver.FetchWalletDriver("hd")
+	require.NoError(t, err)
+	pw := []byte("password")
+	var mdk crypto.MasterDerivationKey
+	mdk[0] = 1
+	require.NoError(t, hdDriver.CreateWallet([]byte("restricted"), []byte("restrictedid"), pw, mdk))
+	require.NoError(t, hdDriver.CreateWallet([]byte("exportable"), []byte("exportableid"), pw, mdk))
+
+	cfg.SigningPolicy = config.SigningPolicyConfig{
+		Default: &config.WalletPolicyConfig{MaxAmount: 10},
+		Wallets: map[string]config.WalletPolicyConfig{
+			"exportableid": {MaxAmount: 10, AllowExport: true},
+		},
+	}
+	engine, err := policy.MakeEngine(cfg)
+	require.NoError(t, err)
+	defer engine.Close()
+	sm := session.MakeManager(cfg, engine)
+	defer sm.Kill()
+	ctx := reqContext{sm: sm}
+
+	initHandle := func(id string) (string, wallet.Wallet) {
+		w, err := hdDriver.FetchWallet([]byte(id))
+		require.NoError(t, err)
+		token, err := sm.InitWalletHandle(w, pw)
+		require.NoError(t, err)
+		policed, _, err := sm.AuthWithWalletHandleToken(token)
+		require.NoError(t, err)
+		_, err = policed.GenerateKey(false)
+		require.NoError(t, err)
+		return string(token), w
+	}
+
+	for _, id := range []string{"restrictedid", "exportableid"} {
+		token, w := initHandle(id)
+
+		// Policed HD wallets are still HD wallets
+		var derived kmdapi.APIV1POSTKeyDerivedResponse
+		code := callHandler(t, ctx, postKeyDerivedHandler, kmdapi.APIV1POSTKeyDerivedRequest{WalletHandleToken: token}, &derived)
+		require.Equal(t, http.StatusOK, code, derived.Message)
+		require.Len(t, derived.Keys, 1)
+		require.Equal(t, "m/44'/283'/0'/0'/0'", derived.Keys[0].Path)
+
+		var exported kmdapi.APIV1POSTMasterKeyExportResponse
+		code = callHandler(t, ctx, postMasterKeyExportHandler, kmdapi.APIV1POSTMasterKeyExportRequest{WalletHandleToken: token, WalletPassword: string(pw)}, &exported)
+		if id == "restrictedid" {
+			require.Equal(t, http.StatusBadRequest, code)
+			require.Contains(t, exported.Message, "denied by policy")
+			require.Empty(t, exported.BIP39Mnemonic)
+			continue
+		}
+		require.Equal(t, http.StatusOK, code, exported.Message)
+		mnemonic, err := w.(wallet.HDWallet).ExportBIP39Mnemonic(pw)
+		require.NoError(t, err)
+		require.Equal(t, mnemonic, exported.BIP39Mnemonic)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package backup implements kmd's encrypted wallet backup archives. An
+// archive holds the full wallet.Contents of a wallet, encrypted with
+// XChaCha20-Poly1305 under a key derived from a backup password with
+// Argon2id. The archive header, which records the format version and KDF
+// parameters, is authenticated along with the contents, so any tampering is
+// detected when the archive is opened.
+package backup
+
+import (
+	"crypto/rand"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+const (
+	archiveMagic   = "kmd-wallet-backup"
+	archiveVersion = 1
+
+	kdfArgon2id = "argon2id"
+	saltLen     = 16
+	keyLen      = chacha20poly1305.KeySize
+
+	// Opening an archive runs the KDF with the parameters it names, so they
+	// are bounded to keep a crafted archive from exhausting kmd's memory
+	maxArgon2Time      = 16
+	maxArgon2MemoryKiB = 1 << 20
+)
+
+// KDFParams are the Argon2id parameters used to derive an archive's key
+type KDFParams struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Algorithm string `codec:"alg"`
+	Salt      []byte `codec:"salt"`
+	Time      uint32 `codec:"t"`
+	MemoryKiB uint32 `codec:"m"`
+	Threads   uint8  `codec:"p"`
+}
+
+// DefaultKDFParams are the Argon2id parameters new archives are sealed with
+var DefaultKDFParams = KDFParams{
+	Algorithm: kdfArgon2id,
+	Time:      3,
+	MemoryKiB: 64 * 1024,
+	Threads:   4,
+}
+
+// header describes how an archive was sealed. Its encoding is the
+// additional data of the AEAD, so it can't be altered without detection.
+type header struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Magic   string    `codec:"magic"`
+	Version uint32    `codec:"v"`
+	KDF     KDFParams `codec:"kdf"`
+	Nonce   []byte    `codec:"nonce"`
+}
+
+// archive is the encoding of a backup on disk
+type archive struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Header []byte `codec:"hdr"`
+	Sealed []byte `codec:"sealed"`
+}
+
+// Seal encrypts contents into an archive with a key derived from password
+// using the DefaultKDFParams
+func Seal(contents wallet.Contents, password []byte) ([]byte, error) {
+	return SealWithParams(contents, password, DefaultKDFParams)
+}
+
+// SealWithParams encrypts contents into an archive with a key derived from
+// password using the given KDF parameters. A fresh salt is always generated.
+func SealWithParams(contents wallet.Contents, password []byte, params KDFParams) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, errEmptyPassword
+	}
+	err := params.check()
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := header{
+		Magic:   archiveMagic,
+		Version: archiveVersion,
+		KDF:     params,
+		Nonce:   make([]byte, chacha20poly1305.NonceSizeX),
+	}
+	hdr.KDF.Salt = make([]byte, saltLen)
+	_, err = rand.Read(hdr.KDF.Salt)
+	if err != nil {
+		return nil, err
+	}
+	_, err = rand.Read(hdr.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(hdr.KDF.deriveKey(password))
+	if err != nil {
+		return nil, err
+	}
+
+	hdrBytes := protocol.EncodeReflect(&hdr)
+	return protocol.EncodeReflect(&archive{
+		Header: hdrBytes,
+		Sealed: aead.Seal(nil, hdr.Nonce, protocol.EncodeReflect(&contents), hdrBytes),
+	}), nil
+}
+
+// Open decrypts an archive created by Seal, verifying that neither its
+// header nor its contents have been modified
+func Open(data []byte, password []byte) (contents wallet.Contents, err error) {
+	var a archive
+	err = protocol.DecodeReflect(data, &a)
+	if err != nil {
+		err = errNotArchive
+		return
+	}
+	var hdr header
+	err = protocol.DecodeReflect(a.Header, &hdr)
+	if err != nil || hdr.Magic != archiveMagic {
+		err = errNotArchive
+		return
+	}
+	if hdr.Version != archiveVersion {
+		err = errUnsupportedVersion(hdr.Version)
+		return
+	}
+	err = hdr.KDF.check()
+	if err != nil {
+		return
+	}
+	if len(hdr.KDF.Salt) != saltLen || len(hdr.Nonce) != chacha20poly1305.NonceSizeX {
+		err = errNotArchive
+		return
+	}
+
+	aead, err := chacha20poly1305.NewX(hdr.KDF.deriveKey(password))
+	if err != nil {
+		return
+	}
+	plaintext, err := aead.Open(nil, hdr.Nonce, a.Sealed, a.Header)
+	if err != nil {
+		err = errDecrypt
+		return
+	}
+
+	err = protocol.DecodeReflect(plaintext, &contents)
+	if err != nil {
+		err = errNotArchive
+	}
+	return
+}
+
+// check ensures the KDF parameters are ones we are willing to run
+func (p KDFParams) check() error {
+	if p.Algorithm != kdfArgon2id {
+		return errUnsupportedKDF(p.Algorithm)
+	}
+	if p.Time == 0 || p.Time > maxArgon2Time || p.MemoryKiB == 0 || p.MemoryKiB > maxArgon2MemoryKiB || p.Threads == 0 {
+		return errBadKDFParams
+	}
+	return nil
+}
+
+// deriveKey derives the archive key from password
+func (p KDFParams) deriveKey(password []byte) []byte {
+	return argon2.IDKey(password, p.Salt, p.Time, p.MemoryKiB, p.Threads, keyLen)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+var testKDFParams = KDFParams{
+	Algorithm: kdfArgon2id,
+	Time:      1,
+	MemoryKiB: 64,
+	Threads:   1,
+}
+
+func testContents() wallet.Contents {
+	secrets := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	return wallet.Contents{
+		Name:                []byte("wallet"),
+		DriverName:          "sqlite",
+		DriverVersion:       1,
+		MasterDerivationKey: crypto.MasterDerivationKey{2},
+		MaxKeyIndex:         1,
+		Keys: []wallet.ContentsKey{
+			{SecretKey: crypto.PrivateKey(secrets.SK), Index: 1},
+		},
+		Multisigs: []wallet.ContentsMultisig{
+			{Version: 1, Threshold: 1, PKs: []crypto.PublicKey{crypto.PublicKey(secrets.SignatureVerifier)}},
+		},
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	contents := testContents()
+	data, err := SealWithParams(contents, []byte("backup password"), testKDFParams)
+	require.NoError(t, err)
+
+	opened, err := Open(data, []byte("backup password"))
+	require.NoError(t, err)
+	require.Equal(t, contents, opened)
+
+	_, err = Open(data, []byte("wrong password"))
+	require.ErrorIs(t, err, errDecrypt)
+
+	_, err = SealWithParams(contents, nil, testKDFParams)
+	require.ErrorIs(t, err, errEmptyPassword)
+}
+
+func TestOpenDetectsTampering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pw := []byte("backup password")
+	data, err := SealWithParams(testContents(), pw, testKDFParams)
+	require.NoError(t, err)
+
+	var a archive
+	require.NoError(t, protocol.DecodeReflect(data, &a))
+
+	// Flip a bit of the sealed contents
+	sealed := append([]byte{}, a.Sealed...)
+	sealed[len(sealed)/2] ^= 1
+	_, err = Open(protocol.EncodeReflect(&archive{Header: a.Header, Sealed: sealed}), pw)
+	require.ErrorIs(t, err, errDecrypt)
+
+	// Change the authenticated header without changing the key it derives
+	var hdr header
+	require.NoError(t, protocol.DecodeReflect(a.Header, &hdr))
+	hdr.Nonce[0] ^= 1
+	_, err = Open(protocol.EncodeReflect(&archive{Header: protocol.EncodeReflect(&hdr), Sealed: a.Sealed}), pw)
+	require.ErrorIs(t, err, errDecrypt)
+
+	// Refuse to run the KDF with unreasonable parameters
+	hdr.Nonce[0] ^= 1
+	hdr.KDF.MemoryKiB = maxArgon2MemoryKiB + 1
+	_, err = Open(protocol.EncodeReflect(&archive{Header: protocol.EncodeReflect(&hdr), Sealed: a.Sealed}), pw)
+	require.ErrorIs(t, err, errBadKDFParams)
+
+	// Unknown versions are rejected
+	hdr.KDF.MemoryKiB = testKDFParams.MemoryKiB
+	hdr.Version = archiveVersion + 1
+	_, err = Open(protocol.EncodeReflect(&archive{Header: protocol.EncodeReflect(&hdr), Sealed: a.Sealed}), pw)
+	require.Error(t, err)
+
+	_, err = Open([]byte("not an archive"), pw)
+	require.ErrorIs(t, err, errNotArchive)
+}
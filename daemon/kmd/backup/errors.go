@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package backup
+
+import (
+	"fmt"
+)
+
+var errEmptyPassword = fmt.Errorf("backup password must not be empty")
+var errNotArchive = fmt.Errorf("not a kmd wallet backup")
+var errBadKDFParams = fmt.Errorf("backup key derivation parameters out of range")
+var errDecrypt = fmt.Errorf("could not decrypt backup: wrong password, or the backup is corrupted")
+
+func errUnsupportedVersion(v uint32) error {
+	return fmt.Errorf("unsupported wallet backup version %d", v)
+}
+
+func errUnsupportedKDF(alg string) error {
+	return fmt.Errorf("unsupported backup key derivation function %q", alg)
+}
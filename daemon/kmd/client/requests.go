@@ -99,6 +99,12 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletBackupRequest:
+		reqPath = "v1/wallet/backup"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletRestoreRequest:
+		reqPath = "v1/wallet/restore"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
@@ -117,6 +123,9 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTKeyListRequest:
 		reqPath = "v1/key/list"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTKeyDerivedRequest:
+		reqPath = "v1/key/derived"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTProgramSignRequest:
 		reqPath = "v1/program/sign"
 		reqMethod = "POST"
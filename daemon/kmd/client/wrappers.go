@@ -59,6 +59,19 @@ func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, wa
 	return
 }
 
+// CreateWalletFromBIP39Mnemonic wraps kmdapi.APIV1POSTWalletRequest, recovering
+// the wallet from a BIP-39 mnemonic
+func (kcl KMDClient) CreateWalletFromBIP39Mnemonic(walletName []byte, walletDriverName string, walletPassword []byte, mnemonic string) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: walletDriverName,
+		WalletPassword:   string(walletPassword),
+		BIP39Mnemonic:    mnemonic,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // RenameWallet wraps kmdapi.APIV1POSTWalletRenameRequest
 func (kcl KMDClient) RenameWallet(walletID []byte, newWalletName []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletRenameResponse, err error) {
 	req := kmdapi.APIV1POSTWalletRenameRequest{
@@ -70,6 +83,29 @@ func (kcl KMDClient) RenameWallet(walletID []byte, newWalletName []byte, walletP
 	return
 }
 
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		BackupPassword:    string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(walletName []byte, walletPassword []byte, backup []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:     string(walletName),
+		WalletPassword: string(walletPassword),
+		BackupPassword: string(backupPassword),
+		Backup:         backup,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // InitWallet wraps kmdapi.APIV1POSTWalletInitRequest
 func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
 	req := kmdapi.APIV1POSTWalletInitRequest{
@@ -98,6 +134,15 @@ func (kcl KMDClient) ListKeys(walletHandle []byte) (resp kmdapi.APIV1POSTKeyList
 	return
 }
 
+// ListDerivedKeys wraps kmdapi.APIV1POSTKeyDerivedRequest
+func (kcl KMDClient) ListDerivedKeys(walletHandle []byte) (resp kmdapi.APIV1POSTKeyDerivedResponse, err error) {
+	req := kmdapi.APIV1POSTKeyDerivedRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // DeleteKey wraps kmdapi.APIV1DELETEKeyRequest
 func (kcl KMDClient) DeleteKey(walletHandle []byte, pw []byte, addr string) (resp kmdapi.APIV1DELETEKeyResponse, err error) {
 	req := kmdapi.APIV1DELETEKeyRequest{
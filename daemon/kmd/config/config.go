@@ -35,18 +35,20 @@ const (
 
 // KMDConfig contains global configuration information for kmd
 type KMDConfig struct {
-	DataDir             string       `json:"-"`
-	DriverConfig        DriverConfig `json:"drivers"`
-	SessionLifetimeSecs uint64       `json:"session_lifetime_secs"`
-	Address             string       `json:"address"`
-	AllowedOrigins      []string     `json:"allowed_origins"`
-	AllowHeaderPNA      bool         `json:"allow_header_pna"`
+	DataDir             string              `json:"-"`
+	DriverConfig        DriverConfig        `json:"drivers"`
+	SessionLifetimeSecs uint64              `json:"session_lifetime_secs"`
+	Address             string              `json:"address"`
+	AllowedOrigins      []string            `json:"allowed_origins"`
+	AllowHeaderPNA      bool                `json:"allow_header_pna"`
+	SigningPolicy       SigningPolicyConfig `json:"signing_policy"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	PKCS11WalletDriverConfig PKCS11WalletDriverConfig `json:"pkcs11"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -61,6 +63,59 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// PKCS11WalletDriverConfig is configuration specific to the PKCS11WalletDriver
+type PKCS11WalletDriverConfig struct {
+	// ModulePath is the path to the PKCS#11 shared library exposing the
+	// HSM (e.g. /usr/lib/softhsm/libsofthsm2.so). The driver is disabled
+	// when it is empty.
+	ModulePath string `json:"module_path"`
+	// TokenLabels optionally restricts the driver to tokens with these
+	// labels. All initialized tokens are exposed when it is empty.
+	TokenLabels []string `json:"token_labels"`
+}
+
+// SigningPolicyConfig contains the policies that kmd evaluates before any
+// wallet signs a transaction or program
+type SigningPolicyConfig struct {
+	// AuditLogFile is the file every signing request and its outcome are
+	// appended to. Relative paths are relative to the kmd data directory.
+	// Auditing is disabled when it is empty.
+	AuditLogFile string `json:"audit_log_file"`
+	// Default applies to wallets that have no entry in Wallets. Wallets are
+	// unrestricted when it is nil.
+	Default *WalletPolicyConfig `json:"default"`
+	// Wallets maps wallet IDs to the policy that applies to them
+	Wallets map[string]WalletPolicyConfig `json:"wallets"`
+}
+
+// WalletPolicyConfig restricts what a single wallet is allowed to sign. Zero
+// values leave the corresponding check disabled.
+type WalletPolicyConfig struct {
+	// AllowedTxTypes lists the transaction types the wallet may sign
+	AllowedTxTypes []string `json:"allowed_tx_types"`
+	// MaxAmount is the largest payment, in microAlgos, of a single transaction
+	MaxAmount uint64 `json:"max_amount"`
+	// MaxWindowAmount is the largest total payment, in microAlgos, across
+	// all transactions signed within the last WindowSecs seconds
+	MaxWindowAmount uint64 `json:"max_window_amount"`
+	WindowSecs      uint64 `json:"window_secs"`
+	// AllowedReceivers lists the addresses that payments and asset
+	// transfers, including their close-to targets, may be sent to
+	AllowedReceivers []string `json:"allowed_receivers"`
+	// ForbidRekey rejects transactions that set RekeyTo
+	ForbidRekey bool `json:"forbid_rekey"`
+	// ForbidClose rejects transactions that set CloseRemainderTo or
+	// AssetCloseTo
+	ForbidClose bool `json:"forbid_close"`
+	// AllowProgramSigning permits signing programs (delegated logic sigs),
+	// which can authorize transactions that bypass every check above
+	AllowProgramSigning bool `json:"allow_program_signing"`
+	// AllowExport permits exporting the secrets of the wallet: its keys,
+	// master derivation key, mnemonic and backups. Exported secrets can sign
+	// anything outside of kmd, so they are denied unless this is set
+	AllowExport bool `json:"allow_export"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -101,6 +156,26 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	// Rolling window limits need a window
+	policies := make([]WalletPolicyConfig, 0, len(k.SigningPolicy.Wallets)+1)
+	if k.SigningPolicy.Default != nil {
+		policies = append(policies, *k.SigningPolicy.Default)
+	}
+	for _, policy := range k.SigningPolicy.Wallets {
+		policies = append(policies, policy)
+	}
+	for _, policy := range policies {
+		if policy.MaxWindowAmount != 0 && policy.WindowSecs == 0 {
+			return ErrPolicyWindowRequired
+		}
+	}
+	// If a PKCS#11 module is passed, ensure that it is absolute
+	pkcs11Module := k.DriverConfig.PKCS11WalletDriverConfig.ModulePath
+	if pkcs11Module != "" {
+		if !filepath.IsAbs(pkcs11Module) {
+			return ErrPKCS11ModuleNotAbsolute
+		}
+	}
 	return nil
 }
 
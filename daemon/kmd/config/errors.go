@@ -22,3 +22,9 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrPKCS11ModuleNotAbsolute is returned when the passed PKCS#11 module path is relative
+var ErrPKCS11ModuleNotAbsolute = fmt.Errorf("pkcs11 module path must be absolute path")
+
+// ErrPolicyWindowRequired is returned when a signing policy sets a rolling window limit without a window
+var ErrPolicyWindowRequired = fmt.Errorf("signing policy max_window_amount requires window_secs")
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/policy"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/server"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/session"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet/driver"
@@ -57,6 +58,17 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Load the signing policies and open the audit log
+	policyEngine, err := policy.MakeEngine(kmdCfg)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			policyEngine.Close()
+		}
+	}()
+
 	// Make or read the API token + check that it's reasonable
 	apiToken, _, err := tokens.ValidateOrGenerateAPIToken(startConfig.DataDir, tokens.KmdTokenFilename)
 	if err != nil {
@@ -70,7 +82,7 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
 		AllowHeaderPNA: kmdCfg.AllowHeaderPNA,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: session.MakeManager(kmdCfg, policyEngine),
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
 	}
@@ -82,6 +94,19 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 	}
 
 	// Start the wallet API server
-	died, sock, err = ws.Start(startConfig.Kill)
+	serverDied, sock, err := ws.Start(startConfig.Kill)
+	if err != nil {
+		return
+	}
+
+	// Close the audit log once the server is gone
+	died = make(chan error)
+	go func() {
+		serverErr := <-serverDied
+		if closeErr := policyEngine.Close(); closeErr != nil {
+			startConfig.Log.Warnf("failed to close the signing policy audit log: %v", closeErr)
+		}
+		died <- serverErr
+	}()
 	return
 }
@@ -47,6 +47,13 @@ type APIV1Wallet struct {
 	SupportedTransactions []protocol.TxType `json:"supported_txs"`
 }
 
+// APIV1DerivedKey is the API's representation of a key generated by a
+// hierarchical deterministic wallet, along with its derivation path
+type APIV1DerivedKey struct {
+	Address string `json:"address"`
+	Path    string `json:"path"`
+}
+
 // APIV1WalletHandle includes the wallet the handle corresponds to
 // and the number of number of seconds to expiration
 type APIV1WalletHandle struct {
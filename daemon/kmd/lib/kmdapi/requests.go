@@ -49,6 +49,7 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	BIP39Mnemonic       string                   `json:"bip39_mnemonic"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -90,6 +91,30 @@ type APIV1POSTWalletRenameRequest struct {
 	NewWalletName  string `json:"wallet_name"`
 }
 
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	BackupPassword    string `json:"backup_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletName     string `json:"wallet_name"`
+	WalletPassword string `json:"wallet_password"`
+	BackupPassword string `json:"backup_password"`
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
 // APIV1POSTWalletInfoRequest is the request for `POST /v1/wallet/info`
 //
 // swagger:model WalletInfoRequest
@@ -160,6 +185,15 @@ type APIV1POSTKeyListRequest struct {
 	WalletHandleToken string `json:"wallet_handle_token"`
 }
 
+// APIV1POSTKeyDerivedRequest is the request for `POST /v1/key/derived`
+//
+// swagger:model ListDerivedKeysRequest
+type APIV1POSTKeyDerivedRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
 // APIV1POSTTransactionSignRequest is the request for `POST /v1/transaction/sign`
 //
 // swagger:model SignTransactionRequest
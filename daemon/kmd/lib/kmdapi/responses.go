@@ -145,6 +145,36 @@ type renameWalletResponse struct {
 	Body *APIV1POSTWalletRenameResponse
 }
 
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
 // APIV1POSTWalletInfoResponse is the response to `POST /v1/wallet/info`
 // friendly:WalletInfoResponse
 type APIV1POSTWalletInfoResponse struct {
@@ -164,6 +194,7 @@ type walletInfoResponse struct {
 type APIV1POSTMasterKeyExportResponse struct {
 	APIV1ResponseEnvelope
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	BIP39Mnemonic       string                   `json:"bip39_mnemonic,omitempty"`
 }
 
 // Response to `POST /v1/master-key/export`
@@ -242,6 +273,20 @@ type listKeysResponse struct {
 	Body *APIV1POSTKeyListResponse
 }
 
+// APIV1POSTKeyDerivedResponse is the response to `POST /v1/key/derived`
+// friendly:ListDerivedKeysResponse
+type APIV1POSTKeyDerivedResponse struct {
+	APIV1ResponseEnvelope
+	Keys []APIV1DerivedKey `json:"keys"`
+}
+
+// Response to `POST /v1/key/derived`
+// swagger:response ListDerivedKeysResponse
+type listDerivedKeysResponse struct {
+	//in: body
+	Body *APIV1POSTKeyDerivedResponse
+}
+
 // APIV1POSTTransactionSignResponse is the response to `POST /v1/transaction/sign`
 // friendly:SignTransactionResponse
 type APIV1POSTTransactionSignResponse struct {
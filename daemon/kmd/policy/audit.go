@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+)
+
+const auditLogPermissions = 0600
+
+// auditRecord is one line of the audit log
+type auditRecord struct {
+	Time     time.Time `json:"time"`
+	WalletID string    `json:"wallet_id"`
+	Op       string    `json:"op"`
+	TxID     string    `json:"txid,omitempty"`
+	TxType   string    `json:"type,omitempty"`
+	Sender   string    `json:"sender,omitempty"`
+	Receiver string    `json:"receiver,omitempty"`
+	Amount   uint64    `json:"amount,omitempty"`
+	RekeyTo  string    `json:"rekey_to,omitempty"`
+	Signer   string    `json:"signer,omitempty"`
+	Program  string    `json:"program_address,omitempty"`
+	Key      string    `json:"key,omitempty"`
+	Decision string    `json:"decision"`
+	Reason   string    `json:"reason,omitempty"`
+}
+
+const (
+	decisionAllow = "allow"
+	decisionDeny  = "deny"
+	decisionError = "error"
+)
+
+// auditLog appends JSON records to a file opened in append-only mode. Each
+// record is synced before the signing request returns.
+type auditLog struct {
+	mu deadlock.Mutex
+	f  *os.File
+}
+
+func openAuditLog(path string) (*auditLog, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, auditLogPermissions)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLog{f: f}, nil
+}
+
+func (a *auditLog) write(rec auditRecord) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, err = a.f.Write(line)
+	if err != nil {
+		return err
+	}
+	return a.f.Sync()
+}
+
+func (a *auditLog) close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.f.Close()
+}
@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package policy evaluates configurable signing policies before kmd wallets
+// sign or export anything, and keeps an append-only audit log of every
+// signing and export request.
+package policy
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+// DeniedError is returned when a policy rejects a signing or export request
+type DeniedError struct {
+	Reason string
+}
+
+// Error implements the error interface
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("request denied by policy: %s", e.Reason)
+}
+
+func deny(format string, args ...interface{}) *DeniedError {
+	return &DeniedError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// rules is the parsed form of a config.WalletPolicyConfig
+type rules struct {
+	txTypes         map[protocol.TxType]bool
+	maxAmount       uint64
+	maxWindowAmount uint64
+	window          time.Duration
+	receivers       map[basics.Address]bool
+	forbidRekey     bool
+	forbidClose     bool
+	allowPrograms   bool
+	allowExport     bool
+}
+
+func makeRules(cfg config.WalletPolicyConfig) (*rules, error) {
+	r := &rules{
+		maxAmount:       cfg.MaxAmount,
+		maxWindowAmount: cfg.MaxWindowAmount,
+		window:          time.Duration(cfg.WindowSecs) * time.Second,
+		forbidRekey:     cfg.ForbidRekey,
+		forbidClose:     cfg.ForbidClose,
+		allowPrograms:   cfg.AllowProgramSigning,
+		allowExport:     cfg.AllowExport,
+	}
+
+	if len(cfg.AllowedTxTypes) != 0 {
+		r.txTypes = make(map[protocol.TxType]bool, len(cfg.AllowedTxTypes))
+		for _, txType := range cfg.AllowedTxTypes {
+			r.txTypes[protocol.TxType(txType)] = true
+		}
+	}
+
+	if len(cfg.AllowedReceivers) != 0 {
+		r.receivers = make(map[basics.Address]bool, len(cfg.AllowedReceivers))
+		for _, receiver := range cfg.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(receiver)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed receiver %s: %w", receiver, err)
+			}
+			r.receivers[addr] = true
+		}
+	}
+
+	return r, nil
+}
+
+// checkTransaction applies every stateless rule to tx
+func (r *rules) checkTransaction(tx transactions.Transaction) *DeniedError {
+	if r.txTypes != nil && !r.txTypes[tx.Type] {
+		return deny("transaction type %s is not allowed", tx.Type)
+	}
+
+	if r.forbidRekey && !tx.RekeyTo.IsZero() {
+		return deny("rekeying is not allowed")
+	}
+
+	if r.forbidClose && (!tx.CloseRemainderTo.IsZero() || !tx.AssetCloseTo.IsZero()) {
+		return deny("closing an account or asset holding is not allowed")
+	}
+
+	if r.receivers != nil {
+		for _, receiver := range receiversOf(tx) {
+			if !r.receivers[receiver] {
+				return deny("receiver %s is not allowed", receiver)
+			}
+		}
+	}
+
+	if r.maxAmount != 0 && paymentAmount(tx) > r.maxAmount {
+		return deny("amount %d exceeds the per-transaction limit of %d", paymentAmount(tx), r.maxAmount)
+	}
+
+	return nil
+}
+
+// receiversOf returns the nonzero addresses that tx moves funds to
+func receiversOf(tx transactions.Transaction) (receivers []basics.Address) {
+	var candidates []basics.Address
+	switch tx.Type {
+	case protocol.PaymentTx:
+		candidates = []basics.Address{tx.Receiver, tx.CloseRemainderTo}
+	case protocol.AssetTransferTx:
+		candidates = []basics.Address{tx.AssetReceiver, tx.AssetCloseTo}
+	}
+	for _, addr := range candidates {
+		if !addr.IsZero() {
+			receivers = append(receivers, addr)
+		}
+	}
+	return
+}
+
+// paymentAmount is the amount of microAlgos tx pays to its receiver
+func paymentAmount(tx transactions.Transaction) uint64 {
+	if tx.Type != protocol.PaymentTx {
+		return 0
+	}
+	return tx.Amount.Raw
+}
+
+// spend is an amount signed at a point in time, counted against a rolling
+// window limit
+type spend struct {
+	at     time.Time
+	amount uint64
+}
+
+// Engine holds the signing policies of every wallet along with the state
+// needed to enforce rolling window limits
+type Engine struct {
+	mu       deadlock.Mutex
+	defaults *rules
+	wallets  map[string]*rules
+	spends   map[string][]*spend
+	audit    *auditLog
+	now      func() time.Time
+}
+
+// MakeEngine parses the signing policy configuration and opens the audit
+// log. It returns a nil Engine, which signs everything unchecked, if no
+// policy or audit log is configured.
+func MakeEngine(cfg config.KMDConfig) (*Engine, error) {
+	policyCfg := cfg.SigningPolicy
+	if policyCfg.AuditLogFile == "" && policyCfg.Default == nil && len(policyCfg.Wallets) == 0 {
+		return nil, nil
+	}
+
+	e := &Engine{
+		wallets: make(map[string]*rules, len(policyCfg.Wallets)),
+		spends:  make(map[string][]*spend),
+		now:     time.Now,
+	}
+
+	var err error
+	if policyCfg.Default != nil {
+		e.defaults, err = makeRules(*policyCfg.Default)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for id, walletCfg := range policyCfg.Wallets {
+		e.wallets[id], err = makeRules(walletCfg)
+		if err != nil {
+			return nil, fmt.Errorf("wallet %s: %w", id, err)
+		}
+	}
+
+	if policyCfg.AuditLogFile != "" {
+		auditPath := policyCfg.AuditLogFile
+		if !filepath.IsAbs(auditPath) {
+			auditPath = filepath.Join(cfg.DataDir, auditPath)
+		}
+		e.audit, err = openAuditLog(auditPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+// Close closes the audit log
+func (e *Engine) Close() error {
+	if e == nil || e.audit == nil {
+		return nil
+	}
+	return e.audit.close()
+}
+
+// rulesFor returns the rules that apply to a wallet, or nil if it is
+// unrestricted
+func (e *Engine) rulesFor(walletID string) *rules {
+	if r, ok := e.wallets[walletID]; ok {
+		return r
+	}
+	return e.defaults
+}
+
+// Wrap returns a wallet that enforces the policy for w, and audits its
+// signing requests. w is returned unchanged if e is nil.
+func (e *Engine) Wrap(w wallet.Wallet) (wallet.Wallet, error) {
+	if e == nil {
+		return w, nil
+	}
+
+	md, err := w.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	pw := &policyWallet{
+		Wallet:   w,
+		engine:   e,
+		walletID: string(md.ID),
+	}
+	// Keep HD wallets recognizable as such once wrapped
+	if hd, ok := w.(wallet.HDWallet); ok {
+		return &policyHDWallet{policyWallet: pw, hd: hd}, nil
+	}
+	return pw, nil
+}
+
+// authorizeTransaction checks tx against the policy for the wallet. If the
+// policy has a rolling window limit, the payment is counted against it
+// right away so that concurrent requests can't overshoot the limit; the
+// returned release func undoes that if signing doesn't go through.
+func (e *Engine) authorizeTransaction(walletID string, tx transactions.Transaction) (release func(), err error) {
+	release = func() {}
+
+	r := e.rulesFor(walletID)
+	if r == nil {
+		return
+	}
+
+	if denied := r.checkTransaction(tx); denied != nil {
+		return release, denied
+	}
+
+	amount := paymentAmount(tx)
+	if r.maxWindowAmount == 0 || amount == 0 {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	cutoff := now.Add(-r.window)
+	var total uint64
+	live := e.spends[walletID][:0]
+	for _, s := range e.spends[walletID] {
+		if s.at.After(cutoff) {
+			live = append(live, s)
+			total += s.amount
+		}
+	}
+	e.spends[walletID] = live
+
+	if total+amount < total || total+amount > r.maxWindowAmount {
+		return release, deny("amount %d would exceed the limit of %d per %s (%d already signed)", amount, r.maxWindowAmount, r.window, total)
+	}
+
+	s := &spend{at: now, amount: amount}
+	e.spends[walletID] = append(e.spends[walletID], s)
+	release = func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		spends := e.spends[walletID]
+		for i := range spends {
+			if spends[i] == s {
+				e.spends[walletID] = append(spends[:i], spends[i+1:]...)
+				return
+			}
+		}
+	}
+	return release, nil
+}
+
+// authorizeProgram checks whether the wallet may sign programs
+func (e *Engine) authorizeProgram(walletID string) error {
+	r := e.rulesFor(walletID)
+	if r == nil || r.allowPrograms {
+		return nil
+	}
+	return deny("program signing is not allowed")
+}
+
+// authorizeExport checks whether the secrets of the wallet may be exported
+func (e *Engine) authorizeExport(walletID string) error {
+	r := e.rulesFor(walletID)
+	if r == nil || r.allowExport {
+		return nil
+	}
+	return deny("exporting secrets is not allowed")
+}
@@ -0,0 +1,365 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+const testWalletID = "test-wallet"
+
+var errSignFailed = errors.New("sign failed")
+
+// stubWallet signs everything, or fails every request if fail is set
+type stubWallet struct {
+	wallet.Wallet
+	fail    bool
+	signs   int
+	exports int
+}
+
+func (sw *stubWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: []byte(testWalletID)}, nil
+}
+
+func (sw *stubWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if sw.fail {
+		return nil, errSignFailed
+	}
+	sw.signs++
+	return []byte("signed"), nil
+}
+
+func (sw *stubWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	sw.signs++
+	return []byte("signed"), nil
+}
+
+func (sw *stubWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	sw.exports++
+	return crypto.PrivateKey{1}, nil
+}
+
+func (sw *stubWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	sw.exports++
+	return crypto.MasterDerivationKey{1}, nil
+}
+
+func addr(b byte) basics.Address {
+	var a basics.Address
+	a[0] = b
+	return a
+}
+
+func payment(amount uint64, receiver basics.Address) transactions.Transaction {
+	return transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: addr(1)},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func makeTestEngine(t *testing.T, policyCfg config.SigningPolicyConfig) (*Engine, *stubWallet, wallet.Wallet) {
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.SigningPolicy = policyCfg
+	require.NoError(t, cfg.Validate())
+
+	engine, err := MakeEngine(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, engine)
+	t.Cleanup(func() { engine.Close() })
+
+	stub := &stubWallet{}
+	w, err := engine.Wrap(stub)
+	require.NoError(t, err)
+	return engine, stub, w
+}
+
+func requireDenied(t *testing.T, err error) {
+	var denied *DeniedError
+	require.ErrorAs(t, err, &denied)
+}
+
+func readAuditLog(t *testing.T, path string) []auditRecord {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []auditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec auditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		records = append(records, rec)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestPolicyDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	engine, err := MakeEngine(config.DefaultConfig(t.TempDir()))
+	require.NoError(t, err)
+	require.Nil(t, engine)
+
+	stub := &stubWallet{}
+	w, err := engine.Wrap(stub)
+	require.NoError(t, err)
+	require.Same(t, stub, w)
+}
+
+func TestPolicyTransactionRules(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	allowed := addr(2)
+	_, stub, w := makeTestEngine(t, config.SigningPolicyConfig{
+		Wallets: map[string]config.WalletPolicyConfig{
+			testWalletID: {
+				AllowedTxTypes:   []string{string(protocol.PaymentTx)},
+				MaxAmount:        1000,
+				AllowedReceivers: []string{allowed.String()},
+				ForbidRekey:      true,
+				ForbidClose:      true,
+			},
+		},
+	})
+
+	_, err := w.SignTransaction(payment(1000, allowed), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+
+	_, err = w.SignTransaction(payment(1001, allowed), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	_, err = w.SignTransaction(payment(1, addr(3)), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	tx := payment(1, allowed)
+	tx.RekeyTo = addr(4)
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	tx = payment(1, allowed)
+	tx.CloseRemainderTo = allowed
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	tx = transactions.Transaction{Type: protocol.KeyRegistrationTx}
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	_, err = w.SignProgram([]byte{1}, crypto.Digest{}, nil)
+	requireDenied(t, err)
+
+	require.Equal(t, 1, stub.signs)
+}
+
+func TestPolicyDefault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, stub, w := makeTestEngine(t, config.SigningPolicyConfig{
+		Default: &config.WalletPolicyConfig{AllowProgramSigning: true, MaxAmount: 10},
+		Wallets: map[string]config.WalletPolicyConfig{
+			"some-other-wallet": {},
+		},
+	})
+
+	_, err := w.SignProgram([]byte{1}, crypto.Digest{}, nil)
+	require.NoError(t, err)
+
+	_, err = w.SignTransaction(payment(11, addr(2)), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	require.Equal(t, 1, stub.signs)
+}
+
+func TestPolicyRollingWindow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	engine, stub, w := makeTestEngine(t, config.SigningPolicyConfig{
+		Wallets: map[string]config.WalletPolicyConfig{
+			testWalletID: {MaxWindowAmount: 100, WindowSecs: 60},
+		},
+	})
+	now := time.Unix(1000000, 0)
+	engine.now = func() time.Time { return now }
+
+	_, err := w.SignTransaction(payment(60, addr(2)), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Second)
+	_, err = w.SignTransaction(payment(50, addr(2)), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	// A failed signature doesn't count against the window
+	stub.fail = true
+	_, err = w.SignTransaction(payment(40, addr(2)), crypto.PublicKey{}, nil)
+	require.ErrorIs(t, err, errSignFailed)
+	stub.fail = false
+
+	_, err = w.SignTransaction(payment(40, addr(2)), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+
+	_, err = w.SignTransaction(payment(1, addr(2)), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+
+	// The first payment has left the window
+	now = now.Add(31 * time.Second)
+	_, err = w.SignTransaction(payment(60, addr(2)), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, 3, stub.signs)
+}
+
+func TestPolicyAuditLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	cfg := config.DefaultConfig(dir)
+	cfg.SigningPolicy = config.SigningPolicyConfig{
+		AuditLogFile: "audit.log",
+		Wallets: map[string]config.WalletPolicyConfig{
+			testWalletID: {MaxAmount: 10},
+		},
+	}
+	engine, err := MakeEngine(cfg)
+	require.NoError(t, err)
+
+	stub := &stubWallet{}
+	w, err := engine.Wrap(stub)
+	require.NoError(t, err)
+
+	tx := payment(5, addr(2))
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	_, err = w.SignTransaction(payment(50, addr(2)), crypto.PublicKey{}, nil)
+	requireDenied(t, err)
+	stub.fail = true
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorIs(t, err, errSignFailed)
+	require.NoError(t, engine.Close())
+
+	records := readAuditLog(t, filepath.Join(dir, "audit.log"))
+	require.Len(t, records, 3)
+
+	require.Equal(t, decisionAllow, records[0].Decision)
+	require.Equal(t, testWalletID, records[0].WalletID)
+	require.Equal(t, opSignTransaction, records[0].Op)
+	require.Equal(t, tx.ID().String(), records[0].TxID)
+	require.Equal(t, uint64(5), records[0].Amount)
+	require.Equal(t, addr(2).String(), records[0].Receiver)
+
+	require.Equal(t, decisionDeny, records[1].Decision)
+	require.NotEmpty(t, records[1].Reason)
+
+	require.Equal(t, decisionError, records[2].Decision)
+	require.Equal(t, errSignFailed.Error(), records[2].Reason)
+}
+
+func TestPolicyExports(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	cfg := config.DefaultConfig(dir)
+	cfg.SigningPolicy = config.SigningPolicyConfig{
+		AuditLogFile: "audit.log",
+		Default:      &config.WalletPolicyConfig{AllowExport: true},
+		Wallets: map[string]config.WalletPolicyConfig{
+			testWalletID: {MaxAmount: 10},
+		},
+	}
+	engine, err := MakeEngine(cfg)
+	require.NoError(t, err)
+
+	// A restricted wallet can't hand out the secrets that would let anyone
+	// sign outside of the policy
+	stub := &stubWallet{}
+	w, err := engine.Wrap(stub)
+	require.NoError(t, err)
+	_, err = w.ExportKey(crypto.Digest(addr(2)), nil)
+	requireDenied(t, err)
+	_, err = w.ExportMasterDerivationKey(nil)
+	requireDenied(t, err)
+	exported := false
+	err = w.(Exporter).Export(OpBackupWallet, func() error {
+		exported = true
+		return nil
+	})
+	requireDenied(t, err)
+	require.False(t, exported)
+	require.Zero(t, stub.exports)
+
+	// Exports are allowed by allow_export, and still audited
+	cfg.SigningPolicy.Wallets[testWalletID] = config.WalletPolicyConfig{MaxAmount: 10, AllowExport: true}
+	engine.wallets[testWalletID], err = makeRules(cfg.SigningPolicy.Wallets[testWalletID])
+	require.NoError(t, err)
+	sk, err := w.ExportKey(crypto.Digest(addr(2)), nil)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PrivateKey{1}, sk)
+	mdk, err := w.ExportMasterDerivationKey(nil)
+	require.NoError(t, err)
+	require.Equal(t, crypto.MasterDerivationKey{1}, mdk)
+	require.NoError(t, w.(Exporter).Export(OpBackupWallet, func() error {
+		exported = true
+		return nil
+	}))
+	require.True(t, exported)
+	require.Equal(t, 2, stub.exports)
+	require.NoError(t, engine.Close())
+
+	records := readAuditLog(t, filepath.Join(dir, "audit.log"))
+	require.Len(t, records, 6)
+	for i, op := range []string{opExportKey, opExportMasterKey, OpBackupWallet} {
+		require.Equal(t, op, records[i].Op)
+		require.Equal(t, decisionDeny, records[i].Decision)
+		require.Equal(t, op, records[i+3].Op)
+		require.Equal(t, decisionAllow, records[i+3].Decision)
+		require.Equal(t, testWalletID, records[i+3].WalletID)
+	}
+	require.Equal(t, addr(2).String(), records[3].Key)
+}
+
+func TestPolicyConfigValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.SigningPolicy.Default = &config.WalletPolicyConfig{MaxWindowAmount: 1}
+	require.ErrorIs(t, cfg.Validate(), config.ErrPolicyWindowRequired)
+
+	cfg.SigningPolicy.Default = &config.WalletPolicyConfig{AllowedReceivers: []string{"not an address"}}
+	_, err := MakeEngine(cfg)
+	require.Error(t, err)
+}
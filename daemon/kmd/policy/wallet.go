@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"errors"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/data/transactions/logic"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+const (
+	opSignTransaction         = "sign_transaction"
+	opSignProgram             = "sign_program"
+	opMultisigSignTransaction = "multisig_sign_transaction"
+	opMultisigSignProgram     = "multisig_sign_program"
+	opExportKey               = "export_key"
+	opExportMasterKey         = "export_master_key"
+	opExportMnemonic          = "export_mnemonic"
+)
+
+// OpBackupWallet is the audited operation of a wallet backup
+const OpBackupWallet = "backup_wallet"
+
+// Exporter is implemented by the wallets wrapped by an Engine. Exports which
+// read the secrets of the wallet without going through it, such as driver
+// backups, are run through Export so that the policy applies to them and
+// they are audited.
+type Exporter interface {
+	Export(op string, export func() error) error
+}
+
+// policyWallet wraps a wallet so that every signing and export request is
+// checked against the policy and audited before anything is handed out
+type policyWallet struct {
+	wallet.Wallet
+	engine   *Engine
+	walletID string
+}
+
+// policyHDWallet is a policyWallet wrapping a wallet.HDWallet
+type policyHDWallet struct {
+	*policyWallet
+	hd wallet.HDWallet
+}
+
+// ListDerivedKeys lists the keys derived by the wrapped wallet
+func (w *policyHDWallet) ListDerivedKeys() ([]wallet.DerivedKey, error) {
+	return w.hd.ListDerivedKeys()
+}
+
+// ExportBIP39Mnemonic implements the HDWallet interface.
+func (w *policyHDWallet) ExportBIP39Mnemonic(pw []byte) (mnemonic string, err error) {
+	err = w.Export(opExportMnemonic, func() (err error) {
+		mnemonic, err = w.hd.ExportBIP39Mnemonic(pw)
+		return
+	})
+	if err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+var _ wallet.HDWallet = (*policyHDWallet)(nil)
+
+// txRecord builds the audit record describing a transaction signing request
+func (w *policyWallet) txRecord(op string, tx transactions.Transaction, pk crypto.PublicKey) auditRecord {
+	rec := auditRecord{
+		Op:       op,
+		WalletID: w.walletID,
+		TxID:     tx.ID().String(),
+		TxType:   string(tx.Type),
+		Sender:   tx.Sender.String(),
+		Amount:   paymentAmount(tx),
+	}
+	switch tx.Type {
+	case protocol.PaymentTx:
+		rec.Receiver = tx.Receiver.String()
+	case protocol.AssetTransferTx:
+		rec.Receiver = tx.AssetReceiver.String()
+	}
+	if !tx.RekeyTo.IsZero() {
+		rec.RekeyTo = tx.RekeyTo.String()
+	}
+	if (pk != crypto.PublicKey{}) {
+		rec.Signer = basics.Address(pk).String()
+	}
+	return rec
+}
+
+// programRecord builds the audit record describing a program signing request
+func (w *policyWallet) programRecord(op string, program []byte, src crypto.Digest, pk crypto.PublicKey) auditRecord {
+	rec := auditRecord{
+		Op:       op,
+		WalletID: w.walletID,
+		Sender:   basics.Address(src).String(),
+		Program:  basics.Address(logic.HashProgram(program)).String(),
+	}
+	if (pk != crypto.PublicKey{}) {
+		rec.Signer = basics.Address(pk).String()
+	}
+	return rec
+}
+
+// finish records the outcome of a signing or export request. If the audit log can't be
+// written, the signature is withheld by returning an error.
+func (w *policyWallet) finish(rec auditRecord, err error) error {
+	audit := w.engine.audit
+	if audit == nil {
+		return err
+	}
+
+	var denied *DeniedError
+	switch {
+	case err == nil:
+		rec.Decision = decisionAllow
+	case errors.As(err, &denied):
+		rec.Decision = decisionDeny
+		rec.Reason = denied.Reason
+	default:
+		rec.Decision = decisionError
+		rec.Reason = err.Error()
+	}
+	rec.Time = w.engine.now()
+
+	auditErr := audit.write(rec)
+	if err == nil && auditErr != nil {
+		return auditErr
+	}
+	return err
+}
+
+// signTransaction runs sign if the policy allows tx
+func (w *policyWallet) signTransaction(op string, tx transactions.Transaction, pk crypto.PublicKey, sign func() error) error {
+	rec := w.txRecord(op, tx, pk)
+
+	release, err := w.engine.authorizeTransaction(w.walletID, tx)
+	if err == nil {
+		err = sign()
+	}
+	err = w.finish(rec, err)
+	if err != nil {
+		release()
+	}
+	return err
+}
+
+// signProgram runs sign if the policy allows program signing
+func (w *policyWallet) signProgram(op string, program []byte, src crypto.Digest, pk crypto.PublicKey, sign func() error) error {
+	rec := w.programRecord(op, program, src, pk)
+
+	err := w.engine.authorizeProgram(w.walletID)
+	if err == nil {
+		err = sign()
+	}
+	return w.finish(rec, err)
+}
+
+// Export runs export if the policy allows exporting the secrets of the wallet
+func (w *policyWallet) Export(op string, export func() error) error {
+	rec := auditRecord{
+		Op:       op,
+		WalletID: w.walletID,
+	}
+	return w.exportRecord(rec, export)
+}
+
+// exportRecord runs export if the policy allows it, auditing it as rec
+func (w *policyWallet) exportRecord(rec auditRecord, export func() error) error {
+	err := w.engine.authorizeExport(w.walletID)
+	if err == nil {
+		err = export()
+	}
+	return w.finish(rec, err)
+}
+
+// ExportKey implements the Wallet interface.
+func (w *policyWallet) ExportKey(pk crypto.Digest, pw []byte) (sk crypto.PrivateKey, err error) {
+	rec := auditRecord{
+		Op:       opExportKey,
+		WalletID: w.walletID,
+		Key:      basics.Address(pk).String(),
+	}
+	err = w.exportRecord(rec, func() (err error) {
+		sk, err = w.Wallet.ExportKey(pk, pw)
+		return
+	})
+	if err != nil {
+		return crypto.PrivateKey{}, err
+	}
+	return sk, nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (w *policyWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterDerivationKey, err error) {
+	err = w.Export(opExportMasterKey, func() (err error) {
+		mdk, err = w.Wallet.ExportMasterDerivationKey(pw)
+		return
+	})
+	if err != nil {
+		return crypto.MasterDerivationKey{}, err
+	}
+	return mdk, nil
+}
+
+// SignTransaction implements the Wallet interface.
+func (w *policyWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) (stx []byte, err error) {
+	err = w.signTransaction(opSignTransaction, tx, pk, func() (err error) {
+		stx, err = w.Wallet.SignTransaction(tx, pk, pw)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stx, nil
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (w *policyWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (sig crypto.MultisigSig, err error) {
+	err = w.signTransaction(opMultisigSignTransaction, tx, pk, func() (err error) {
+		sig, err = w.Wallet.MultisigSignTransaction(tx, pk, partial, pw, signer)
+		return
+	})
+	if err != nil {
+		return partial, err
+	}
+	return sig, nil
+}
+
+// SignProgram implements the Wallet interface.
+func (w *policyWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) (sig []byte, err error) {
+	err = w.signProgram(opSignProgram, program, src, crypto.PublicKey{}, func() (err error) {
+		sig, err = w.Wallet.SignProgram(program, src, pw)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (w *policyWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (sig crypto.MultisigSig, err error) {
+	err = w.signProgram(opMultisigSignProgram, program, src, pk, func() (err error) {
+		sig, err = w.Wallet.MultisigSignProgram(program, src, pk, partial, pw)
+		return
+	})
+	if err != nil {
+		return partial, err
+	}
+	return sig, nil
+}
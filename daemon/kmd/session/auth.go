@@ -132,6 +132,13 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 		return nil, err
 	}
 
+	// Enforce the signing policy for this wallet on every request made with
+	// the handle
+	w, err = sm.policy.Wrap(w)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate wallet handle credentials
 	handleID, handleSecret, err := generateHandleIDAndSecret()
 	if err != nil {
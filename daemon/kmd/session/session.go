@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/policy"
 	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
 	"github.com/DePINNetwork/go-deadlock"
 )
@@ -38,19 +39,22 @@ type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
 	sessionLifetime time.Duration
+	policy          *policy.Engine
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
-// configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+// configuration. Wallets initialized through the Manager enforce the signing
+// policies of engine, which may be nil.
+func MakeManager(cfg config.KMDConfig, engine *policy.Engine) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
+		policy:          engine,
 		Kill:            cancel,
 		ctx:             ctx,
 	}
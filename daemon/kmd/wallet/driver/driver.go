@@ -27,7 +27,9 @@ import (
 
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
+	hdWalletDriverName:     &HDWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	pkcs11WalletDriverName: &PKCS11WalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -42,6 +44,21 @@ type Driver interface {
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
+// BIP39Driver is implemented by drivers which can create a wallet from a
+// BIP-39 mnemonic of any standard length
+type BIP39Driver interface {
+	Driver
+	CreateWalletFromBIP39Mnemonic(name []byte, id []byte, pw []byte, mnemonic string) error
+}
+
+// BackupDriver is implemented by drivers whose wallets can be exported into
+// a backup and restored from one
+type BackupDriver interface {
+	Driver
+	BackupWallet(id []byte, pw []byte) (wallet.Contents, error)
+	RestoreWallet(name []byte, id []byte, pw []byte, contents wallet.Contents) error
+}
+
 // InitWalletDrivers accepts a KMDConfig and uses it to initialize each driver
 func InitWalletDrivers(cfg config.KMDConfig, log logging.Logger) error {
 	for _, driver := range walletDrivers {
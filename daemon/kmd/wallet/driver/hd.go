@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/crypto/passphrase"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/logging"
+)
+
+const (
+	hdWalletDriverName    = "hd"
+	hdWalletDriverVersion = 1
+	hdWalletsDirName      = "hd_wallets"
+)
+
+var hdKind = &sqliteWalletKind{
+	driverName:     hdWalletDriverName,
+	driverVersion:  hdWalletDriverVersion,
+	walletsDirName: hdWalletsDirName,
+	deriveKey:      hdDeriveKey,
+}
+
+// HDWalletDriver is a hierarchical deterministic variant of the
+// SQLiteWalletDriver. Its wallets are stored the same way, but their master
+// derivation key is the entropy of a BIP-39 mnemonic, and keys are
+// derived from the mnemonic's seed along BIP-44 paths (m/44'/283'/n'/0'/0')
+// using SLIP-0010, so that they match other BIP-39 wallets.
+type HDWalletDriver struct {
+	SQLiteWalletDriver
+}
+
+// HDWallet represents a particular wallet under the HDWalletDriver
+type HDWallet struct {
+	*SQLiteWallet
+}
+
+// InitWithConfig accepts a driver configuration so that the HD driver knows
+// where to read and write its wallet databases. It shares its configuration
+// with the SQLite driver.
+func (hwd *HDWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.kind = hdKind
+	return hwd.SQLiteWalletDriver.InitWithConfig(cfg, log)
+}
+
+// CreateWalletFromBIP39Mnemonic creates a wallet recovered from a BIP-39
+// mnemonic of any standard length (12 to 24 words). Unlike CreateWallet, which
+// takes the entropy of a 24-word mnemonic as master derivation key, the
+// entropy is kept at its own length so that keys are derived from the seed of
+// the mnemonic as given.
+func (hwd *HDWalletDriver) CreateWalletFromBIP39Mnemonic(name []byte, id []byte, pw []byte, mnemonic string) error {
+	entropy, err := passphrase.BIP39MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return err
+	}
+	_, err = hwd.createWallet(name, id, pw, entropy)
+	return err
+}
+
+// FetchWallet looks up a wallet by ID and returns it, failing if there's more
+// than one wallet with the given ID
+func (hwd *HDWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	w, err := hwd.SQLiteWalletDriver.FetchWallet(id)
+	if err != nil {
+		return nil, err
+	}
+	sqWallet, ok := w.(*SQLiteWallet)
+	if !ok {
+		return nil, errSQLiteWrongType
+	}
+	return &HDWallet{SQLiteWallet: sqWallet}, nil
+}
+
+// ExportMasterDerivationKey returns the entropy of the wallet's BIP-39
+// mnemonic, failing if the mnemonic is shorter than 24 words
+func (hw *HDWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterDerivationKey, err error) {
+	err = hw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+	if len(hw.masterDerivationKey) != len(mdk) {
+		err = errHDShortEntropy
+		return
+	}
+	copy(mdk[:], hw.masterDerivationKey)
+	return
+}
+
+// ExportBIP39Mnemonic returns the BIP-39 mnemonic the wallet's keys are
+// derived from
+func (hw *HDWallet) ExportBIP39Mnemonic(pw []byte) (string, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return "", err
+	}
+	return passphrase.EntropyToBIP39Mnemonic(hw.masterDerivationKey)
+}
+
+// ListDerivedKeys lists the keys generated by the wallet along with their
+// derivation paths. Imported keys are not included.
+func (hw *HDWallet) ListDerivedKeys() (keys []wallet.DerivedKey, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var rows []struct {
+		Address []byte `db:"address"`
+		KeyIdx  uint64 `db:"key_idx"`
+	}
+	err = db.Select(&rows, "SELECT address, key_idx FROM keys WHERE key_idx IS NOT NULL ORDER BY key_idx")
+	if err != nil {
+		return nil, errDatabase
+	}
+
+	for _, row := range rows {
+		account, err := hdAccountForIndex(row.KeyIdx)
+		if err != nil {
+			return nil, err
+		}
+		var key wallet.DerivedKey
+		copy(key.Address[:], row.Address)
+		key.Path = formatDerivationPath(hdKeyPath(account))
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// hdAccountForIndex maps the wallet's key indices, which start at 1, onto
+// BIP-44 account numbers, which start at 0
+func hdAccountForIndex(index uint64) (uint32, error) {
+	if index == 0 || index > hdHardenedOffset {
+		return 0, errHDBadIndex
+	}
+	return uint32(index - 1), nil
+}
+
+// hdDeriveKey derives the key with the given index from the BIP-39 entropy
+// stored as the wallet's master derivation key
+func hdDeriveKey(mdk []byte, index uint64) (pk crypto.PublicKey, sk crypto.PrivateKey, err error) {
+	account, err := hdAccountForIndex(index)
+	if err != nil {
+		return
+	}
+
+	mnemonic, err := passphrase.EntropyToBIP39Mnemonic(mdk)
+	if err != nil {
+		return
+	}
+	bip39Seed, err := passphrase.BIP39MnemonicToSeed(mnemonic, "")
+	if err != nil {
+		return
+	}
+	key, err := slip10DeriveEd25519(bip39Seed, hdKeyPath(account))
+	if err != nil {
+		return
+	}
+
+	var seed crypto.Seed
+	copy(seed[:], key)
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	return crypto.PublicKey(secrets.SignatureVerifier), crypto.PrivateKey(secrets.SK), nil
+}
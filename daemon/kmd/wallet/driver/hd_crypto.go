@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+const (
+	slip10Ed25519Curve = "ed25519 seed"
+	hdHardenedOffset   = 1 << 31
+	hdPathPrefix       = "m"
+	hdPathSeparator    = "/"
+	hdHardenedMarker   = "'"
+)
+
+// hdKeyPath returns the BIP-44 path of the key for the given account, using
+// Algorand's registered coin type (283). Ed25519 only supports hardened
+// derivation, so every level of the path is hardened.
+func hdKeyPath(account uint32) []uint32 {
+	return []uint32{
+		44 | hdHardenedOffset,
+		283 | hdHardenedOffset,
+		account | hdHardenedOffset,
+		0 | hdHardenedOffset,
+		0 | hdHardenedOffset,
+	}
+}
+
+// formatDerivationPath renders a derivation path in the usual m/44'/283'/0'
+// notation
+func formatDerivationPath(path []uint32) string {
+	parts := make([]string, 0, len(path)+1)
+	parts = append(parts, hdPathPrefix)
+	for _, idx := range path {
+		if idx >= hdHardenedOffset {
+			parts = append(parts, strconv.FormatUint(uint64(idx-hdHardenedOffset), 10)+hdHardenedMarker)
+		} else {
+			parts = append(parts, strconv.FormatUint(uint64(idx), 10))
+		}
+	}
+	return strings.Join(parts, hdPathSeparator)
+}
+
+// slip10DeriveEd25519 derives the ed25519 private key seed at path from a
+// BIP-39 seed, as specified by SLIP-0010
+func slip10DeriveEd25519(seed []byte, path []uint32) ([]byte, error) {
+	mac := hmac.New(sha512.New, []byte(slip10Ed25519Curve))
+	mac.Write(seed)
+	I := mac.Sum(nil)
+	key, chainCode := I[:32], I[32:]
+
+	for _, idx := range path {
+		if idx < hdHardenedOffset {
+			return nil, errHDNotHardened
+		}
+
+		var data [1 + 32 + 4]byte
+		copy(data[1:], key)
+		binary.BigEndian.PutUint32(data[33:], idx)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data[:])
+		I = mac.Sum(nil)
+		key, chainCode = I[:32], I[32:]
+	}
+	return key, nil
+}
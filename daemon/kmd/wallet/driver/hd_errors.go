@@ -0,0 +1,25 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errHDNotHardened = fmt.Errorf("ed25519 keys can only be derived along hardened paths")
+var errHDBadIndex = fmt.Errorf("key index out of range for hd derivation")
+var errHDShortEntropy = fmt.Errorf("hd wallet is backed up by a mnemonic of less than 24 words, which does not fit a master derivation key. export its mnemonic instead")
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/crypto/passphrase"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestSLIP10Ed25519Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Test vector 1 for ed25519 from SLIP-0010
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	const h = hdHardenedOffset
+	vectors := []struct {
+		path []uint32
+		key  string
+	}{
+		{[]uint32{}, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{[]uint32{0 | h}, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{[]uint32{0 | h, 1 | h}, "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{[]uint32{0 | h, 1 | h, 2 | h}, "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		{[]uint32{0 | h, 1 | h, 2 | h, 2 | h, 1000000000 | h}, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+	}
+	for _, v := range vectors {
+		key, err := slip10DeriveEd25519(seed, v.path)
+		require.NoError(t, err)
+		require.Equal(t, v.key, hex.EncodeToString(key), formatDerivationPath(v.path))
+	}
+
+	_, err = slip10DeriveEd25519(seed, []uint32{1})
+	require.ErrorIs(t, err, errHDNotHardened)
+}
+
+func TestDerivationPathFormatting(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "m", formatDerivationPath(nil))
+	require.Equal(t, "m/44'/283'/7'/0'/0", formatDerivationPath([]uint32{44 | hdHardenedOffset, 283 | hdHardenedOffset, 7 | hdHardenedOffset, hdHardenedOffset, 0}))
+	require.Equal(t, "m/44'/283'/3'/0'/0'", formatDerivationPath(hdKeyPath(3)))
+}
+
+func TestHDWalletDriver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := testSQLiteConfig(t)
+
+	hwd := &HDWalletDriver{}
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	swd := &SQLiteWalletDriver{}
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	var mdk crypto.MasterDerivationKey
+	for i := range mdk {
+		mdk[i] = byte(i)
+	}
+	pw := []byte("password")
+	require.NoError(t, hwd.CreateWallet([]byte("hd"), []byte("hdid"), pw, mdk))
+
+	// HD wallets are only visible to the HD driver
+	metadatas, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, metadatas, 1)
+	require.Equal(t, hdWalletDriverName, metadatas[0].DriverName)
+	metadatas, err = swd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Empty(t, metadatas)
+
+	w, err := hwd.FetchWallet([]byte("hdid"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	hw, ok := w.(wallet.HDWallet)
+	require.True(t, ok)
+
+	addr0, err := hw.GenerateKey(false)
+	require.NoError(t, err)
+	addr1, err := hw.GenerateKey(false)
+	require.NoError(t, err)
+
+	keys, err := hw.ListDerivedKeys()
+	require.NoError(t, err)
+	require.Equal(t, []wallet.DerivedKey{
+		{Address: addr0, Path: "m/44'/283'/0'/0'/0'"},
+		{Address: addr1, Path: "m/44'/283'/1'/0'/0'"},
+	}, keys)
+
+	// The first key is the one derived from the mnemonic at its path
+	pk, _, err := hdDeriveKey(mdk[:], 1)
+	require.NoError(t, err)
+	require.Equal(t, addr0, publicKeyToAddress(pk))
+
+	// Imported keys are not derived keys
+	_, err = hw.ImportKey(crypto.PrivateKey(crypto.GenerateSignatureSecrets(crypto.Seed{1}).SK))
+	require.NoError(t, err)
+	keys, err = hw.ListDerivedKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	// A wallet recovered from the same mnemonic derives the same keys
+	require.NoError(t, hwd.CreateWallet([]byte("hd2"), []byte("hdid2"), pw, mdk))
+	w2, err := hwd.FetchWallet([]byte("hdid2"))
+	require.NoError(t, err)
+	require.NoError(t, w2.Init(pw))
+	addr, err := w2.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, addr0, addr)
+}
+
+func TestHDWalletMnemonicLengths(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := testSQLiteConfig(t)
+	hwd := &HDWalletDriver{}
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	pw := []byte("password")
+
+	for _, words := range []int{12, 15, 18, 21, 24} {
+		entropy := make([]byte, words*4/3)
+		for i := range entropy {
+			entropy[i] = byte(words + i)
+		}
+		mnemonic, err := passphrase.EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+
+		id := []byte(fmt.Sprintf("hd%d", words))
+		require.NoError(t, hwd.CreateWalletFromBIP39Mnemonic(id, id, pw, mnemonic))
+		w, err := hwd.FetchWallet(id)
+		require.NoError(t, err)
+		require.NoError(t, w.Init(pw))
+		hw := w.(wallet.HDWallet)
+
+		// Keys are derived from the seed of the mnemonic as given, not from a padded key
+		addr, err := hw.GenerateKey(false)
+		require.NoError(t, err)
+		seed, err := passphrase.BIP39MnemonicToSeed(mnemonic, "")
+		require.NoError(t, err)
+		key, err := slip10DeriveEd25519(seed, hdKeyPath(0))
+		require.NoError(t, err)
+		var sd crypto.Seed
+		copy(sd[:], key)
+		require.Equal(t, crypto.Digest(crypto.GenerateSignatureSecrets(sd).SignatureVerifier), addr)
+
+		exported, err := hw.ExportBIP39Mnemonic(pw)
+		require.NoError(t, err)
+		require.Equal(t, mnemonic, exported)
+		_, err = hw.ExportBIP39Mnemonic([]byte("wrong"))
+		require.Error(t, err)
+
+		mdk, err := hw.ExportMasterDerivationKey(pw)
+		if words == 24 {
+			require.NoError(t, err)
+			require.Equal(t, entropy, mdk[:])
+		} else {
+			require.ErrorIs(t, err, errHDShortEntropy)
+		}
+
+		// Backups keep the length of the entropy
+		contents, err := hwd.BackupWallet(id, pw)
+		require.NoError(t, err)
+		restoredID := append([]byte("restored"), id...)
+		require.NoError(t, hwd.RestoreWallet(restoredID, restoredID, pw, contents))
+		rw, err := hwd.FetchWallet(restoredID)
+		require.NoError(t, err)
+		require.NoError(t, rw.Init(pw))
+		exported, err = rw.(wallet.HDWallet).ExportBIP39Mnemonic(pw)
+		require.NoError(t, err)
+		require.Equal(t, mnemonic, exported)
+	}
+
+	require.Error(t, hwd.CreateWalletFromBIP39Mnemonic([]byte("bad"), []byte("bad"), pw, "abandon abandon"))
+}
@@ -0,0 +1,972 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"github.com/miekg/pkcs11"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/data/transactions/logic"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+const (
+	pkcs11WalletDriverName    = "pkcs11"
+	pkcs11WalletDriverVersion = 1
+	pkcs11IDLen               = 16
+	pkcs11KeyIDLen            = 16
+	pkcs11FindBatch           = 64
+	pkcs11KeyLabel            = "kmd"
+	pkcs11MsigApplication     = "kmd-msig"
+
+	// Ed25519 identifiers from PKCS#11 v3.0, which predate the constants
+	// shipped with github.com/miekg/pkcs11
+	pkcs11CKKECEdwards            = uint(0x00000040)
+	pkcs11CKMECEdwardsKeyPairGen  = uint(0x00001055)
+	pkcs11CKMEdDSA                = uint(0x00001057)
+	pkcs11Ed25519PointDERLen      = 2 + len(crypto.PublicKey{})
+	pkcs11Ed25519PointOctetString = byte(0x04)
+)
+
+// pkcs11Ed25519Params is the DER encoding of the id-Ed25519 OID
+// (1.3.101.112), used as CKA_EC_PARAMS for generated keys
+var pkcs11Ed25519Params = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+
+var pkcs11WalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// PKCS11WalletDriver exposes the tokens of a PKCS#11 hardware security
+// module as kmd wallets. Ed25519 keys are generated on the token and are
+// never extractable, so every signature is computed by the module itself.
+// Each initialized token is one wallet, and the wallet password is the
+// token's user PIN.
+type PKCS11WalletDriver struct {
+	mu      deadlock.Mutex
+	ctx     *pkcs11.Ctx
+	wallets map[string]*PKCS11Wallet
+	log     logging.Logger
+	cfg     config.PKCS11WalletDriverConfig
+}
+
+// PKCS11Wallet represents a particular token under the PKCS11WalletDriver.
+// PKCS#11 sessions may not be used concurrently, so the lock serializes
+// access to the token.
+type PKCS11Wallet struct {
+	mu      deadlock.Mutex
+	ctx     *pkcs11.Ctx
+	slot    uint
+	id      string
+	label   string
+	session pkcs11.SessionHandle
+	open    bool
+
+	pinSalt   [saltLen]byte
+	pinHash   crypto.Digest
+	pinHashed bool
+}
+
+// pkcs11MsigPreimage is the msgpack-encoded value of the data objects that
+// store multisig preimages on the token
+type pkcs11MsigPreimage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"v"`
+	Threshold uint8              `codec:"thr"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// InitWithConfig loads the configured PKCS#11 module and enumerates its
+// tokens. The driver does nothing if no module is configured.
+func (hwd *PKCS11WalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	hwd.log = log
+	hwd.cfg = cfg.DriverConfig.PKCS11WalletDriverConfig
+	hwd.wallets = make(map[string]*PKCS11Wallet)
+
+	if hwd.cfg.ModulePath == "" {
+		return nil
+	}
+
+	ctx := pkcs11.New(hwd.cfg.ModulePath)
+	if ctx == nil {
+		return fmt.Errorf("could not load pkcs11 module %s", hwd.cfg.ModulePath)
+	}
+	err := ctx.Initialize()
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		ctx.Destroy()
+		return fmt.Errorf("could not initialize pkcs11 module %s: %w", hwd.cfg.ModulePath, err)
+	}
+	hwd.ctx = ctx
+
+	return hwd.scanWalletsLocked()
+}
+
+// scanWalletsLocked enumerates the initialized tokens of the module and
+// stores them. hwd.mu must be held
+func (hwd *PKCS11WalletDriver) scanWalletsLocked() error {
+	if hwd.ctx == nil {
+		return nil
+	}
+
+	slots, err := hwd.ctx.GetSlotList(true)
+	if err != nil {
+		return err
+	}
+
+	// Anything left in stale after the scan is no longer present
+	stale := make(map[string]bool)
+	for id := range hwd.wallets {
+		stale[id] = true
+	}
+
+	for _, slot := range slots {
+		info, err := hwd.ctx.GetTokenInfo(slot)
+		if err != nil {
+			hwd.log.Warnf("failed to get info for pkcs11 slot %d: %v", slot, err)
+			continue
+		}
+		if info.Flags&pkcs11.CKF_TOKEN_INITIALIZED == 0 {
+			continue
+		}
+
+		label := strings.TrimSpace(info.Label)
+		if !hwd.tokenAllowed(label) {
+			continue
+		}
+
+		id := pkcs11TokenToID(info)
+		if _, ok := hwd.wallets[id]; ok {
+			delete(stale, id)
+			continue
+		}
+
+		hwd.wallets[id] = &PKCS11Wallet{
+			ctx:   hwd.ctx,
+			slot:  slot,
+			id:    id,
+			label: label,
+		}
+	}
+
+	for id := range stale {
+		hwd.wallets[id].close()
+		delete(hwd.wallets, id)
+	}
+
+	return nil
+}
+
+// tokenAllowed reports whether the token with the given label should be
+// exposed as a wallet
+func (hwd *PKCS11WalletDriver) tokenAllowed(label string) bool {
+	if len(hwd.cfg.TokenLabels) == 0 {
+		return true
+	}
+	for _, allowed := range hwd.cfg.TokenLabels {
+		if allowed == label {
+			return true
+		}
+	}
+	return false
+}
+
+func pkcs11TokenToID(info pkcs11.TokenInfo) string {
+	// Token serial numbers are only unique per manufacturer and model, so
+	// we hash all three to build the wallet ID
+	tokenName := fmt.Sprintf("%s/%s/%s", strings.TrimSpace(info.ManufacturerID), strings.TrimSpace(info.Model), strings.TrimSpace(info.SerialNumber))
+	tokenHashFull := sha512.Sum512_256([]byte(tokenName))
+	return fmt.Sprintf("%x", tokenHashFull[:pkcs11IDLen])
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (hwd *PKCS11WalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	err = hwd.scanWalletsLocked()
+	if err != nil {
+		return
+	}
+
+	for _, w := range hwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. Tokens are initialized
+// out of band with the tools shipped by the HSM vendor, so there is no way
+// to create a new wallet through kmd.
+func (hwd *PKCS11WalletDriver) CreateWallet(name []byte, id []byte, hw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (hwd *PKCS11WalletDriver) RenameWallet(newName []byte, id []byte, hw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (hwd *PKCS11WalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	hw, ok := hwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+
+	return hw, nil
+}
+
+// sessionLocked returns an open read/write session on the token, opening
+// one if needed. hw.mu must be held
+func (hw *PKCS11Wallet) sessionLocked() (pkcs11.SessionHandle, error) {
+	if hw.open {
+		return hw.session, nil
+	}
+
+	session, err := hw.ctx.OpenSession(hw.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		if err == pkcs11.Error(pkcs11.CKR_TOKEN_NOT_PRESENT) || err == pkcs11.Error(pkcs11.CKR_SLOT_ID_INVALID) {
+			return 0, errPKCS11TokenGone
+		}
+		return 0, err
+	}
+
+	hw.session = session
+	hw.open = true
+	return session, nil
+}
+
+// close closes the session on the token, if any
+func (hw *PKCS11Wallet) close() {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	if hw.open {
+		hw.ctx.CloseSession(hw.session)
+		hw.open = false
+	}
+	hw.pinHashed = false
+}
+
+// Init logs in to the token, using the wallet password as the user PIN
+func (hw *PKCS11Wallet) Init(pin []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	return hw.loginLocked(pin)
+}
+
+// loginLocked logs in to the token and remembers a hash of the PIN so that
+// later password checks don't need to round-trip to the module. hw.mu must
+// be held
+func (hw *PKCS11Wallet) loginLocked(pin []byte) error {
+	session, err := hw.sessionLocked()
+	if err != nil {
+		return err
+	}
+
+	err = hw.ctx.Login(session, pkcs11.CKU_USER, string(pin))
+	if err == pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		// Login state is shared by every session on the token, so an
+		// existing login tells us nothing about whether this PIN is correct,
+		// and logging out to check it would log out every other wallet
+		// handle. Check it against the PIN of the existing login instead
+		return hw.comparePINLocked(pin)
+	}
+	if err != nil {
+		hw.pinHashed = false
+		return errPKCS11Login
+	}
+
+	err = fillRandomBytes(hw.pinSalt[:])
+	if err != nil {
+		return err
+	}
+	hw.pinHash = fastHashWithSalt(pin, hw.pinSalt[:])
+	hw.pinHashed = true
+	return nil
+}
+
+// CheckPassword checks the PIN against the one the wallet was initialized
+// with, logging in to the token if it hasn't been initialized yet
+func (hw *PKCS11Wallet) CheckPassword(pin []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	return hw.checkPasswordLocked(pin)
+}
+
+// checkPasswordLocked is CheckPassword with hw.mu held
+func (hw *PKCS11Wallet) checkPasswordLocked(pin []byte) error {
+	if !hw.pinHashed {
+		return hw.loginLocked(pin)
+	}
+	return hw.comparePINLocked(pin)
+}
+
+// comparePINLocked checks the PIN against the hash remembered by the last
+// login, failing if there is none. hw.mu must be held
+func (hw *PKCS11Wallet) comparePINLocked(pin []byte) error {
+	if !hw.pinHashed {
+		return errPKCS11Login
+	}
+	pinHash := fastHashWithSalt(pin, hw.pinSalt[:])
+	if subtle.ConstantTimeCompare(pinHash[:], hw.pinHash[:]) != 1 {
+		return errPKCS11Login
+	}
+	return nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface. Keys are
+// generated by the token, so there is no master derivation key.
+func (hw *PKCS11Wallet) ExportMasterDerivationKey(pin []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (hw *PKCS11Wallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(hw.id),
+		Name:                  []byte(hw.label),
+		DriverName:            pkcs11WalletDriverName,
+		DriverVersion:         pkcs11WalletDriverVersion,
+		SupportsMnemonicUX:    false,
+		SupportsMasterKey:     false,
+		SupportedTransactions: pkcs11WalletSupportedTxs,
+	}, nil
+}
+
+// findObjectsLocked returns the handles of all objects on the token
+// matching the template. hw.mu must be held
+func (hw *PKCS11Wallet) findObjectsLocked(template []*pkcs11.Attribute) ([]pkcs11.ObjectHandle, error) {
+	session, err := hw.sessionLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	err = hw.ctx.FindObjectsInit(session, template)
+	if err != nil {
+		return nil, err
+	}
+	defer hw.ctx.FindObjectsFinal(session)
+
+	var handles []pkcs11.ObjectHandle
+	for {
+		batch, _, err := hw.ctx.FindObjects(session, pkcs11FindBatch)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return handles, nil
+		}
+		handles = append(handles, batch...)
+	}
+}
+
+// pkcs11DecodeEd25519Point extracts the public key from a CKA_EC_POINT
+// value. PKCS#11 v3.0 wraps the point in a DER OCTET STRING, but some
+// modules return the raw 32 bytes.
+func pkcs11DecodeEd25519Point(point []byte) (pk crypto.PublicKey, err error) {
+	switch {
+	case len(point) == len(pk):
+		copy(pk[:], point)
+	case len(point) == pkcs11Ed25519PointDERLen && point[0] == pkcs11Ed25519PointOctetString && int(point[1]) == len(pk):
+		copy(pk[:], point[2:])
+	default:
+		err = errPKCS11BadPublicKey
+	}
+	return
+}
+
+// pkcs11KeyPair is an Ed25519 key pair stored on the token
+type pkcs11KeyPair struct {
+	pk     crypto.PublicKey
+	id     []byte
+	public pkcs11.ObjectHandle
+}
+
+// listKeyPairsLocked returns every Ed25519 public key object on the token.
+// hw.mu must be held
+func (hw *PKCS11Wallet) listKeyPairsLocked() ([]pkcs11KeyPair, error) {
+	handles, err := hw.findObjectsLocked([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11CKKECEdwards),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]pkcs11KeyPair, 0, len(handles))
+	for _, handle := range handles {
+		attrs, err := hw.ctx.GetAttributeValue(hw.session, handle, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pk, err := pkcs11DecodeEd25519Point(attrs[0].Value)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, pkcs11KeyPair{pk: pk, id: attrs[1].Value, public: handle})
+	}
+	return keys, nil
+}
+
+// findKeyPairLocked looks up the key pair for a public key. hw.mu must be
+// held
+func (hw *PKCS11Wallet) findKeyPairLocked(pk crypto.PublicKey) (kp pkcs11KeyPair, err error) {
+	keys, err := hw.listKeyPairsLocked()
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		if key.pk == pk {
+			return key, nil
+		}
+	}
+	err = errKeyNotFound
+	return
+}
+
+// findPrivateKeyLocked returns the private key object paired with kp.
+// hw.mu must be held
+func (hw *PKCS11Wallet) findPrivateKeyLocked(kp pkcs11KeyPair) (pkcs11.ObjectHandle, error) {
+	handles, err := hw.findObjectsLocked([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11CKKECEdwards),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, kp.id),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(handles) != 1 {
+		return 0, errKeyNotFound
+	}
+	return handles[0], nil
+}
+
+// ListKeys lists the addresses of the Ed25519 keys on the token
+func (hw *PKCS11Wallet) ListKeys() ([]crypto.Digest, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	keys, err := hw.listKeyPairsLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]crypto.Digest, len(keys))
+	for i, key := range keys {
+		addrs[i] = publicKeyToAddress(key.pk)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface. Keys must be generated on the
+// token so that they never exist outside of it.
+func (hw *PKCS11Wallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface. Private keys are not
+// extractable from the token.
+func (hw *PKCS11Wallet) ExportKey(addr crypto.Digest, pin []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey generates a new non-extractable Ed25519 key pair on the token
+func (hw *PKCS11Wallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, err error) {
+	// Keys never leave the token, so there is no mnemonic to display
+	if displayMnemonic {
+		err = errPKCS11NoMnemonicUX
+		return
+	}
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	if !hw.pinHashed {
+		err = errPKCS11NotInitialized
+		return
+	}
+
+	session, err := hw.sessionLocked()
+	if err != nil {
+		return
+	}
+
+	// The key ID only ties the two halves of the pair together; the
+	// address is always derived from CKA_EC_POINT
+	keyID := make([]byte, pkcs11KeyIDLen)
+	_, err = rand.Read(keyID)
+	if err != nil {
+		err = errRandBytes
+		return
+	}
+
+	publicTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11CKKECEdwards),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, pkcs11Ed25519Params),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, pkcs11KeyLabel),
+	}
+	privateTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11CKKECEdwards),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, keyID),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, pkcs11KeyLabel),
+	}
+
+	mech := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11CKMECEdwardsKeyPairGen, nil)}
+	public, _, err := hw.ctx.GenerateKeyPair(session, mech, publicTemplate, privateTemplate)
+	if err != nil {
+		return
+	}
+
+	attrs, err := hw.ctx.GetAttributeValue(session, public, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return
+	}
+
+	pk, err := pkcs11DecodeEd25519Point(attrs[0].Value)
+	if err != nil {
+		return
+	}
+
+	return publicKeyToAddress(pk), nil
+}
+
+// DeleteKey destroys both halves of the key pair for the passed address
+func (hw *PKCS11Wallet) DeleteKey(addr crypto.Digest, pin []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return err
+	}
+
+	kp, err := hw.findKeyPairLocked(crypto.PublicKey(addr))
+	if err != nil {
+		return err
+	}
+
+	private, err := hw.findPrivateKeyLocked(kp)
+	if err != nil {
+		return err
+	}
+
+	err = hw.ctx.DestroyObject(hw.session, private)
+	if err != nil {
+		return err
+	}
+	return hw.ctx.DestroyObject(hw.session, kp.public)
+}
+
+// signLocked signs msg on the token with the private key for pk, and checks
+// the result before handing it out. hw.mu must be held
+func (hw *PKCS11Wallet) signLocked(msg []byte, pk crypto.PublicKey) (sig crypto.Signature, err error) {
+	kp, err := hw.findKeyPairLocked(pk)
+	if err != nil {
+		return
+	}
+
+	private, err := hw.findPrivateKeyLocked(kp)
+	if err != nil {
+		return
+	}
+
+	mech := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11CKMEdDSA, nil)}
+	err = hw.ctx.SignInit(hw.session, mech, private)
+	if err != nil {
+		return
+	}
+
+	raw, err := hw.ctx.Sign(hw.session, msg)
+	if err != nil {
+		return
+	}
+
+	if len(raw) != len(sig) {
+		err = errPKCS11BadSignature
+		return
+	}
+	copy(sig[:], raw)
+
+	if !crypto.SignatureVerifier(pk).VerifyBytes(msg, sig) {
+		err = errPKCS11BadSignature
+		return
+	}
+	return
+}
+
+// multisigSignLocked produces a multisig with a single subsignature from pk
+// for the multisig address addr. hw.mu must be held
+func (hw *PKCS11Wallet) multisigSignLocked(msg crypto.Hashable, addr crypto.Digest, version, threshold uint8, pks []crypto.PublicKey, pk crypto.PublicKey) (sig crypto.MultisigSig, err error) {
+	addr2, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return
+	}
+	if addr2 != addr {
+		err = errMsigWrongAddr
+		return
+	}
+
+	sig = crypto.MultisigPreimageFromPKs(version, threshold, pks)
+	for i := range sig.Subsigs {
+		if sig.Subsigs[i].Key != pk {
+			continue
+		}
+		sig.Subsigs[i].Sig, err = hw.signLocked(crypto.HashRep(msg), pk)
+		return
+	}
+
+	err = errMsigWrongKey
+	return
+}
+
+// multisigSignPartialLocked adds a subsignature from pk to a partial
+// multisig for one of the addresses in allowed. hw.mu must be held
+func (hw *PKCS11Wallet) multisigSignPartialLocked(msg crypto.Hashable, partial crypto.MultisigSig, pk crypto.PublicKey, allowed ...crypto.Digest) (sig crypto.MultisigSig, err error) {
+	// Check preimage matches one of the allowed addresses
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return
+	}
+
+	err = errMsigWrongAddr
+	for _, a := range allowed {
+		if addr == a {
+			err = nil
+			break
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	// Sign, and merge the multisig into the partial
+	version, threshold, pks := partial.Preimage()
+	msig2, err := hw.multisigSignLocked(msg, addr, version, threshold, pks, pk)
+	if err != nil {
+		return
+	}
+	return crypto.MultisigMerge(partial, msig2)
+}
+
+// ImportMultisigAddr stores the preimage of a multisig address as a data
+// object on the token
+func (hw *PKCS11Wallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (addr crypto.Digest, err error) {
+	addr, err = crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return
+	}
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	if !hw.pinHashed {
+		err = errPKCS11NotInitialized
+		return
+	}
+
+	session, err := hw.sessionLocked()
+	if err != nil {
+		return
+	}
+
+	existing, err := hw.findMsigObjectsLocked(addr)
+	if err != nil {
+		return
+	}
+	if len(existing) != 0 {
+		err = errKeyExists
+		return
+	}
+
+	preimage := pkcs11MsigPreimage{Version: version, Threshold: threshold, PKs: pks}
+	_, err = hw.ctx.CreateObject(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, pkcs11MsigApplication),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, basics.Address(addr).String()),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, msgpackEncode(preimage)),
+	})
+	return
+}
+
+// findMsigObjectsLocked returns the data objects holding multisig
+// preimages, restricted to addr if it's nonzero. hw.mu must be held
+func (hw *PKCS11Wallet) findMsigObjectsLocked(addr crypto.Digest) ([]pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_APPLICATION, pkcs11MsigApplication),
+	}
+	if (addr != crypto.Digest{}) {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, basics.Address(addr).String()))
+	}
+	return hw.findObjectsLocked(template)
+}
+
+// readMsigObjectLocked decodes a multisig preimage data object and checks
+// that it still hashes to its address. hw.mu must be held
+func (hw *PKCS11Wallet) readMsigObjectLocked(handle pkcs11.ObjectHandle) (addr crypto.Digest, preimage pkcs11MsigPreimage, err error) {
+	attrs, err := hw.ctx.GetAttributeValue(hw.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return
+	}
+
+	err = msgpackDecode(attrs[1].Value, &preimage)
+	if err != nil {
+		return
+	}
+
+	// Sanity check: make sure the preimage is correct
+	addr, err = crypto.MultisigAddrGen(preimage.Version, preimage.Threshold, preimage.PKs)
+	if err != nil {
+		return
+	}
+	if basics.Address(addr).String() != string(attrs[0].Value) {
+		err = errTampering
+		return
+	}
+	return
+}
+
+// LookupMultisigPreimage exports the preimage of a multisig address: version,
+// threshold, public keys
+func (hw *PKCS11Wallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	return hw.lookupMultisigPreimageLocked(addr)
+}
+
+// lookupMultisigPreimageLocked is LookupMultisigPreimage with hw.mu held
+func (hw *PKCS11Wallet) lookupMultisigPreimageLocked(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	handles, err := hw.findMsigObjectsLocked(addr)
+	if err != nil {
+		return
+	}
+	if len(handles) == 0 {
+		err = errMsigDataNotFound
+		return
+	}
+
+	_, preimage, err := hw.readMsigObjectLocked(handles[0])
+	if err != nil {
+		return
+	}
+	return preimage.Version, preimage.Threshold, preimage.PKs, nil
+}
+
+// ListMultisigAddrs lists the multisig addresses whose preimages are stored
+// on the token
+func (hw *PKCS11Wallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	handles, err := hw.findMsigObjectsLocked(crypto.Digest{})
+	if err != nil {
+		return
+	}
+
+	for _, handle := range handles {
+		addr, _, err := hw.readMsigObjectLocked(handle)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return
+}
+
+// DeleteMultisigAddr destroys the data object holding the preimage of addr
+func (hw *PKCS11Wallet) DeleteMultisigAddr(addr crypto.Digest, pin []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return err
+	}
+
+	handles, err := hw.findMsigObjectsLocked(addr)
+	if err != nil {
+		return err
+	}
+	for _, handle := range handles {
+		err = hw.ctx.DestroyObject(hw.session, handle)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignTransaction signs the passed transaction with the key whose public key
+// is provided, or if the provided public key is zero, with the key for the
+// transaction sender
+func (hw *PKCS11Wallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pin []byte) ([]byte, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+
+	sig, err := hw.signLocked(crypto.HashRep(tx), pk)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram signs the passed program for the src address
+func (hw *PKCS11Wallet) SignProgram(data []byte, src crypto.Digest, pin []byte) ([]byte, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return nil, err
+	}
+
+	progb := logic.Program(data)
+	sig, err := hw.signLocked(crypto.HashRep(&progb), crypto.PublicKey(src))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:], nil
+}
+
+// MultisigSignTransaction starts a multisig signature or adds a signature to a
+// partially signed multisig transaction signature of the passed transaction
+// using the key
+func (hw *PKCS11Wallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pin []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return partial, err
+	}
+
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		// We weren't given a partial multisig, so create a new one from
+		// the preimage stored on the token
+		from := crypto.Digest(tx.Src())
+		version, threshold, pks, err := hw.lookupMultisigPreimageLocked(from)
+		if err != nil {
+			return partial, err
+		}
+		return hw.multisigSignLocked(tx, from, version, threshold, pks, pk)
+	}
+
+	// The multisig address must equal either the sender or the signer
+	return hw.multisigSignPartialLocked(tx, partial, pk, crypto.Digest(tx.Src()), signer)
+}
+
+// MultisigSignProgram starts a multisig signature or adds a signature to a
+// partially signed multisig program signature using the key
+func (hw *PKCS11Wallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pin []byte) (crypto.MultisigSig, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.checkPasswordLocked(pin)
+	if err != nil {
+		return partial, err
+	}
+
+	progb := logic.Program(data)
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		// We weren't given a partial multisig, so create a new one from
+		// the preimage stored on the token
+		version, threshold, pks, err := hw.lookupMultisigPreimageLocked(src)
+		if err != nil {
+			return partial, err
+		}
+		return hw.multisigSignLocked(&progb, src, version, threshold, pks, pk)
+	}
+
+	return hw.multisigSignPartialLocked(&progb, partial, pk, src)
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errPKCS11Login = fmt.Errorf("could not log in to pkcs11 token. wrong PIN?")
+var errPKCS11NotInitialized = fmt.Errorf("pkcs11 wallet has not been initialized with a PIN")
+var errPKCS11NoMnemonicUX = fmt.Errorf("pkcs11 wallet driver cannot display mnemonics")
+var errPKCS11BadPublicKey = fmt.Errorf("pkcs11 token returned a malformed ed25519 public key")
+var errPKCS11BadSignature = fmt.Errorf("pkcs11 token produced a signature that does not verify")
+var errPKCS11TokenGone = fmt.Errorf("pkcs11 token is no longer present")
@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/data/transactions/logic"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestPKCS11DecodeEd25519Point(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var pk crypto.PublicKey
+	for i := range pk {
+		pk[i] = byte(i)
+	}
+
+	decoded, err := pkcs11DecodeEd25519Point(pk[:])
+	require.NoError(t, err)
+	require.Equal(t, pk, decoded)
+
+	der := append([]byte{0x04, 0x20}, pk[:]...)
+	decoded, err = pkcs11DecodeEd25519Point(der)
+	require.NoError(t, err)
+	require.Equal(t, pk, decoded)
+
+	_, err = pkcs11DecodeEd25519Point(der[:len(der)-1])
+	require.ErrorIs(t, err, errPKCS11BadPublicKey)
+
+	der[0] = 0x03
+	_, err = pkcs11DecodeEd25519Point(der)
+	require.ErrorIs(t, err, errPKCS11BadPublicKey)
+}
+
+// TestPKCS11WalletSoftHSM exercises the driver against a real module. Point
+// KMD_PKCS11_TEST_MODULE at libsofthsm2.so and KMD_PKCS11_TEST_PIN at the
+// user PIN of a token initialized with softhsm2-util to run it.
+func TestPKCS11WalletSoftHSM(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	module := os.Getenv("KMD_PKCS11_TEST_MODULE")
+	pin := []byte(os.Getenv("KMD_PKCS11_TEST_PIN"))
+	if module == "" {
+		t.Skip("KMD_PKCS11_TEST_MODULE not set")
+	}
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.PKCS11WalletDriverConfig.ModulePath = module
+	if label := os.Getenv("KMD_PKCS11_TEST_TOKEN"); label != "" {
+		cfg.DriverConfig.PKCS11WalletDriverConfig.TokenLabels = []string{label}
+	}
+
+	var hwd PKCS11WalletDriver
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.NotEmpty(t, mds)
+	require.Equal(t, pkcs11WalletDriverName, mds[0].DriverName)
+
+	w, err := hwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+
+	_, err = w.GenerateKey(false)
+	require.ErrorIs(t, err, errPKCS11NotInitialized)
+	_, err = w.ImportMultisigAddr(1, 1, []crypto.PublicKey{{}})
+	require.ErrorIs(t, err, errPKCS11NotInitialized)
+	require.ErrorIs(t, w.Init([]byte("not the pin")), errPKCS11Login)
+	require.NoError(t, w.Init(pin))
+	require.ErrorIs(t, w.CheckPassword([]byte("not the pin")), errPKCS11Login)
+
+	addr, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	defer w.DeleteKey(addr, pin)
+
+	// a wrong PIN must not log out the token for the handles already using it
+	require.ErrorIs(t, w.Init([]byte("not the pin")), errPKCS11Login)
+	require.NoError(t, w.Init(pin))
+
+	addrs, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Contains(t, addrs, addr)
+
+	_, err = w.ExportKey(addr, pin)
+	require.ErrorIs(t, err, errNotSupported)
+
+	pk := crypto.PublicKey(addr)
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(addr),
+			Fee:        basics.MicroAlgos{Raw: 1000},
+			FirstValid: 1,
+			LastValid:  1000,
+		},
+	}
+
+	stxBytes, err := w.SignTransaction(tx, crypto.PublicKey{}, pin)
+	require.NoError(t, err)
+	var stx transactions.SignedTxn
+	require.NoError(t, protocol.Decode(stxBytes, &stx))
+	require.True(t, crypto.SignatureVerifier(pk).Verify(tx, stx.Sig))
+	require.True(t, stx.AuthAddr.IsZero())
+
+	program := []byte{0x01, 0x20, 0x01, 0x01, 0x22}
+	progSig, err := w.SignProgram(program, addr, pin)
+	require.NoError(t, err)
+	var sig crypto.Signature
+	copy(sig[:], progSig)
+	progb := logic.Program(program)
+	require.True(t, crypto.SignatureVerifier(pk).Verify(&progb, sig))
+
+	other := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	pks := []crypto.PublicKey{pk, other.SignatureVerifier}
+	msigAddr, err := w.ImportMultisigAddr(1, 2, pks)
+	require.NoError(t, err)
+	defer w.DeleteMultisigAddr(msigAddr, pin)
+
+	msigAddrs, err := w.ListMultisigAddrs()
+	require.NoError(t, err)
+	require.Contains(t, msigAddrs, msigAddr)
+
+	version, threshold, gotPKs, err := w.LookupMultisigPreimage(msigAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), version)
+	require.Equal(t, uint8(2), threshold)
+	require.Equal(t, pks, gotPKs)
+
+	tx.Sender = basics.Address(msigAddr)
+	msig, err := w.MultisigSignTransaction(tx, pk, crypto.MultisigSig{}, pin, crypto.Digest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, msig.Signatures())
+
+	otherSig, err := crypto.MultisigSign(tx, msigAddr, 1, 2, pks, *other)
+	require.NoError(t, err)
+	msig, err = crypto.MultisigMerge(msig, otherSig)
+	require.NoError(t, err)
+	require.NoError(t, crypto.MultisigVerify(tx, msigAddr, msig))
+
+	_, err = w.MultisigSignTransaction(tx, other.SignatureVerifier, crypto.MultisigSig{}, pin, crypto.Digest{})
+	require.ErrorIs(t, err, errKeyNotFound)
+
+	require.NoError(t, w.DeleteKey(addr, pin))
+	addrs, err = w.ListKeys()
+	require.NoError(t, err)
+	require.NotContains(t, addrs, addr)
+}
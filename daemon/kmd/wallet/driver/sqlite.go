@@ -80,11 +80,30 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 );
 `
 
+// sqliteWalletKind describes a family of wallets stored in the sqlite wallet
+// database format: the driver name and version recorded in each database,
+// where the databases live by default, and how keys are derived from the
+// master derivation key
+type sqliteWalletKind struct {
+	driverName     string
+	driverVersion  uint32
+	walletsDirName string
+	deriveKey      func(mdk []byte, index uint64) (crypto.PublicKey, crypto.PrivateKey, error)
+}
+
+var sqliteKind = &sqliteWalletKind{
+	driverName:     sqliteWalletDriverName,
+	driverVersion:  sqliteWalletDriverVersion,
+	walletsDirName: sqliteWalletsDirName,
+	deriveKey:      extractKeyWithIndex,
+}
+
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
 // as authenticated-encrypted blobs in a sqlite 3 database.
 type SQLiteWalletDriver struct {
 	globalCfg config.KMDConfig
 	sqliteCfg config.SQLiteWalletDriverConfig
+	kind      *sqliteWalletKind
 	mux       *deadlock.Mutex
 
 	claimedWallets [][][]byte
@@ -100,6 +119,7 @@ type SQLiteWallet struct {
 	walletPasswordHashed bool
 	dbPath               string
 	cfg                  config.SQLiteWalletDriverConfig
+	kind                 *sqliteWalletKind
 }
 
 // The following msgpack codec interface was lifted from algod's network
@@ -137,6 +157,9 @@ func msgpackDecode(b []byte, objptr interface{}) error {
 func (swd *SQLiteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
 	swd.globalCfg = cfg
 	swd.sqliteCfg = cfg.DriverConfig.SQLiteWalletDriverConfig
+	if swd.kind == nil {
+		swd.kind = sqliteKind
+	}
 
 	// Make sure the scrypt params are reasonable
 	if !swd.sqliteCfg.UnsafeScrypt {
@@ -173,8 +196,8 @@ func dbConnectionURL(path string) string {
 }
 
 // walletMetadataFromDB accepts a *sqlx.DB and extracts a wallet.Metadata from
-// it
-func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
+// it, failing if the database does not hold a wallet of the given kind
+func walletMetadataFromDB(db *sqlx.DB, kind *sqliteWalletKind) (metadata wallet.Metadata, err error) {
 	var driverName string
 	var walletID, walletName []byte
 	var driverVersion uint32
@@ -188,11 +211,11 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 	}
 
 	// Ensure this database is the correct version + driver
-	if driverName != sqliteWalletDriverName {
+	if driverName != kind.driverName {
 		err = errWrongDriver
 		return
 	}
-	if driverVersion != sqliteWalletDriverVersion {
+	if driverVersion != kind.driverVersion {
 		err = errWrongDriverVer
 		return
 	}
@@ -213,7 +236,7 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 
 // walletMetadataFromDBPath accepts path to a sqlite wallet database and
 // returns a Metadata struct with information about it
-func walletMetadataFromDBPath(dbPath string) (metadata wallet.Metadata, err error) {
+func walletMetadataFromDBPath(dbPath string, kind *sqliteWalletKind) (metadata wallet.Metadata, err error) {
 	// Connect to the database
 	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
 	if err != nil {
@@ -221,7 +244,7 @@ func walletMetadataFromDBPath(dbPath string) (metadata wallet.Metadata, err erro
 		return
 	}
 	defer db.Close()
-	return walletMetadataFromDB(db)
+	return walletMetadataFromDB(db, kind)
 }
 
 // potentialWalletPaths lists paths to plausible databases in the wallets
@@ -254,7 +277,7 @@ func (swd *SQLiteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadat
 	}
 	for _, path := range paths {
 		// Ignore errors in case people dumped non-database files into this directory
-		walletMetadata, err := walletMetadataFromDBPath(path)
+		walletMetadata, err := walletMetadataFromDBPath(path, swd.kind)
 		if err != nil {
 			continue
 		}
@@ -283,7 +306,7 @@ func (swd *SQLiteWalletDriver) findDBPathsByField(fieldName string, testValue []
 	}
 	for _, path := range potentialPaths {
 		// Ignore errors in case people dumped non-database files into this directory
-		walletMetadata, err := walletMetadataFromDBPath(path)
+		walletMetadata, err := walletMetadataFromDBPath(path, swd.kind)
 		if err != nil {
 			continue
 		}
@@ -322,7 +345,7 @@ func (swd SQLiteWalletDriver) walletsDir() string {
 	if swd.sqliteCfg.WalletsDir != "" {
 		return swd.sqliteCfg.WalletsDir
 	}
-	return filepath.Join(swd.globalCfg.DataDir, sqliteWalletsDirName)
+	return filepath.Join(swd.globalCfg.DataDir, swd.kind.walletsDirName)
 }
 
 // nameIDToPath turns a wallet name and wallet id into a path to the
@@ -412,31 +435,48 @@ func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
 func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	// If we were passed a blank master derivation key, generate one here
+	if mdk == (crypto.MasterDerivationKey{}) {
+		err := fillRandomBytes(mdk[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := swd.createWallet(name, id, pw, mdk[:])
+	return err
+}
+
+// createWallet is the guts of CreateWallet. It returns the path to the new
+// wallet database. The master derivation key is usually
+// crypto.MasterDerivationKey sized, but HD wallets store the entropy of their
+// BIP-39 mnemonic, which may be shorter.
+func (swd *SQLiteWalletDriver) createWallet(name []byte, id []byte, pw []byte, mdk []byte) (dbPath string, err error) {
 	if len(name) > sqliteMaxWalletNameLen {
-		return errNameTooLong
+		return "", errNameTooLong
 	}
 
 	if len(id) > sqliteMaxWalletIDLen {
-		return errIDTooLong
+		return "", errIDTooLong
 	}
 
-	dbPath, err := swd.claimWalletNameID(name, id)
+	dbPath, err = swd.claimWalletNameID(name, id)
 	if err != nil {
-		return err
+		return "", err
 	}
 	// TODO? drop the entry in swd.claimedWallets on exit?
 
 	// Create the database
 	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
 	if err != nil {
-		return errDatabaseConnect
+		return "", errDatabaseConnect
 	}
 	defer db.Close()
 
 	// Run the schema
 	_, err = db.Exec(walletSchema)
 	if err != nil {
-		return errDatabase
+		return "", errDatabase
 	}
 
 	// Generate the master encryption password, used to encrypt the master
@@ -444,30 +484,21 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	var masterKey [masterKeyLen]byte
 	err = fillRandomBytes(masterKey[:])
 	if err != nil {
-		return err
-	}
-
-	// If we were passed a blank master derivation key, generate one here
-	masterDerivationKey := mdk
-	if masterDerivationKey == (crypto.MasterDerivationKey{}) {
-		err = fillRandomBytes(masterDerivationKey[:])
-		if err != nil {
-			return err
-		}
+		return "", err
 	}
 
 	// Encrypt the master encryption password using the user's password (which
 	// may be blank)
 	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &swd.sqliteCfg.ScryptParams)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Encrypt the master derivation key using the master encryption password
 	// (which may not be blank)
-	encryptedMDKBlob, err := encryptBlobWithKey(masterDerivationKey[:], PTMasterDerivationKey, masterKey[:])
+	encryptedMDKBlob, err := encryptBlobWithKey(mdk, PTMasterDerivationKey, masterKey[:])
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Encrypt the max key index using the master encryption password. We encrypt
@@ -476,16 +507,16 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	maxKeyIdx := 0
 	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(maxKeyIdx), PTMaxKeyIdx, masterKey[:])
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Store the metadata row in the database
-	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", sqliteWalletDriverName, sqliteWalletDriverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
+	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", swd.kind.driverName, swd.kind.driverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
 	if err != nil {
-		return errDatabase
+		return "", errDatabase
 	}
 
-	return nil
+	return dbPath, nil
 }
 
 // FetchWallet looks up a wallet by ID and returns it, failing if there's more
@@ -534,6 +565,7 @@ func (swd *SQLiteWalletDriver) fetchWalletLocked(id []byte) (sqWallet wallet.Wal
 		masterDerivationKey: nil,
 		dbPath:              dbPath,
 		cfg:                 swd.sqliteCfg,
+		kind:                swd.kind,
 	}
 	return
 }
@@ -596,7 +628,7 @@ func (sw *SQLiteWallet) Metadata() (meta wallet.Metadata, err error) {
 	}
 	defer db.Close()
 
-	return walletMetadataFromDB(db)
+	return walletMetadataFromDB(db, sw.kind)
 }
 
 // decryptAndGetMasterKey fetches the master key from the metadata table and
@@ -792,7 +824,6 @@ func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey
 	}
 	defer db.Close()
 
-	var skCandidate crypto.PrivateKey
 	var blob []byte
 
 	// Fetch the encrypted secret key from the database
@@ -802,6 +833,14 @@ func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey
 		return
 	}
 
+	return sw.decryptSecretKey(addr[:], blob)
+}
+
+// decryptSecretKey decrypts the secret key stored for addr, and checks that
+// it is in fact the secret key for addr
+func (sw *SQLiteWallet) decryptSecretKey(addr []byte, blob []byte) (sk crypto.PrivateKey, err error) {
+	var skCandidate crypto.PrivateKey
+
 	// Decrypt the secret key
 	skEncoded, err := decryptBlobWithPassword(blob, PTSecretKey, sw.masterEncryptionKey)
 	if err != nil {
@@ -825,7 +864,7 @@ func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey
 	derivedAddr := publicKeyToAddress(derivedPK)
 
 	// Ensure the derived address matches the one we used to look the key up
-	if addr != derivedAddr {
+	if !bytes.Equal(addr, derivedAddr[:]) {
 		err = errTampering
 		return
 	}
@@ -882,23 +921,8 @@ func (sw *SQLiteWallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, e
 // computes the next key that should be generated, inserts it, and returns
 // its address
 func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, err error) {
-	// Fetch the encrypted highest index
-	var encryptedHighestIndexBlob []byte
-	err = tx.Get(&encryptedHighestIndexBlob, "SELECT max_key_idx_encrypted FROM metadata LIMIT 1")
-	if err != nil {
-		err = errDatabase
-		return
-	}
-
-	// Decrypt the highest index
-	highestIndexBlob, err := decryptBlobWithPassword(encryptedHighestIndexBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
-	if err != nil {
-		return
-	}
-
-	// Decode the highest index
-	var highestIndex uint64
-	err = msgpackDecode(highestIndexBlob, &highestIndex)
+	// Fetch the highest index
+	highestIndex, err := sw.fetchMaxKeyIndex(tx)
 	if err != nil {
 		return
 	}
@@ -921,7 +945,7 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 		}
 
 		// Compute the secret key and public key for nextIndex
-		genPK, genSK, err = extractKeyWithIndex(sw.masterDerivationKey, nextIndex)
+		genPK, genSK, err = sw.kind.deriveKey(sw.masterDerivationKey, nextIndex)
 		if err != nil {
 			return
 		}
@@ -974,6 +998,27 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 	return genAddr, nil
 }
 
+// fetchMaxKeyIndex reads and decrypts the index of the last generated key
+func (sw *SQLiteWallet) fetchMaxKeyIndex(q sqlx.Queryer) (highestIndex uint64, err error) {
+	// Fetch the encrypted highest index
+	var encryptedHighestIndexBlob []byte
+	err = sqlx.Get(q, &encryptedHighestIndexBlob, "SELECT max_key_idx_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	// Decrypt the highest index
+	highestIndexBlob, err := decryptBlobWithPassword(encryptedHighestIndexBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	// Decode the highest index
+	err = msgpackDecode(highestIndexBlob, &highestIndex)
+	return
+}
+
 // DeleteKey deletes the key corresponding to the passed public key from the wallet
 func (sw *SQLiteWallet) DeleteKey(addr crypto.Digest, pw []byte) (err error) {
 	// Check the password
@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+)
+
+// BackupWallet checks the password of the wallet with the given id and
+// returns a snapshot of everything stored in it
+func (swd *SQLiteWalletDriver) BackupWallet(id []byte, pw []byte) (contents wallet.Contents, err error) {
+	curWallet, err := swd.FetchWallet(id)
+	if err != nil {
+		return
+	}
+	sqWallet, ok := curWallet.(*SQLiteWallet)
+	if !ok {
+		err = errSQLiteWrongType
+		return
+	}
+
+	// Decrypt the master keys, which also checks the password
+	err = sqWallet.Init(pw)
+	if err != nil {
+		return
+	}
+
+	metadata, err := sqWallet.Metadata()
+	if err != nil {
+		return
+	}
+	contents.Name = metadata.Name
+	contents.DriverName = metadata.DriverName
+	contents.DriverVersion = metadata.DriverVersion
+	copy(contents.MasterDerivationKey[:], sqWallet.masterDerivationKey)
+	if len(sqWallet.masterDerivationKey) < len(contents.MasterDerivationKey) {
+		contents.MasterDerivationKeyLen = uint8(len(sqWallet.masterDerivationKey))
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sqWallet.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	contents.MaxKeyIndex, err = sqWallet.fetchMaxKeyIndex(db)
+	if err != nil {
+		return
+	}
+
+	var keyRows []struct {
+		Address   []byte        `db:"address"`
+		SecretKey []byte        `db:"secret_key_encrypted"`
+		KeyIdx    sql.NullInt64 `db:"key_idx"`
+	}
+	err = db.Select(&keyRows, "SELECT address, secret_key_encrypted, key_idx FROM keys ORDER BY key_idx, address")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, row := range keyRows {
+		var sk crypto.PrivateKey
+		sk, err = sqWallet.decryptSecretKey(row.Address, row.SecretKey)
+		if err != nil {
+			return
+		}
+		key := wallet.ContentsKey{SecretKey: sk}
+		if row.KeyIdx.Valid {
+			key.Index = uint64(row.KeyIdx.Int64)
+		}
+		contents.Keys = append(contents.Keys, key)
+	}
+
+	var msigRows []struct {
+		Address   []byte `db:"address"`
+		Version   uint8  `db:"version"`
+		Threshold uint8  `db:"threshold"`
+		PKs       []byte `db:"pks"`
+	}
+	err = db.Select(&msigRows, "SELECT address, version, threshold, pks FROM msig_addrs ORDER BY address")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, row := range msigRows {
+		var pks []crypto.PublicKey
+		err = msgpackDecode(row.PKs, &pks)
+		if err != nil {
+			return
+		}
+
+		// Sanity check: make sure the preimage is correct
+		var addr crypto.Digest
+		addr, err = crypto.MultisigAddrGen(row.Version, row.Threshold, pks)
+		if err != nil {
+			return
+		}
+		if !bytes.Equal(addr[:], row.Address) {
+			err = errTampering
+			return
+		}
+
+		contents.Multisigs = append(contents.Multisigs, wallet.ContentsMultisig{
+			Version:   row.Version,
+			Threshold: row.Threshold,
+			PKs:       pks,
+		})
+	}
+
+	return
+}
+
+// RestoreWallet creates a wallet with the given name, id and password holding
+// the contents of a backup. Generated keys are checked against the backup's
+// master derivation key, and nothing is created if any check fails.
+func (swd *SQLiteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, contents wallet.Contents) error {
+	// Ensure the backup was taken from a wallet of this driver
+	if contents.DriverName != swd.kind.driverName {
+		return errWrongDriver
+	}
+	if contents.DriverVersion != swd.kind.driverVersion {
+		return errWrongDriverVer
+	}
+
+	mdk := contents.MasterDerivationKey[:]
+	if contents.MasterDerivationKeyLen != 0 {
+		if int(contents.MasterDerivationKeyLen) > len(mdk) {
+			return errBackupKeyLen
+		}
+		mdk = mdk[:contents.MasterDerivationKeyLen]
+	}
+
+	dbPath, err := swd.createWallet(name, id, pw, mdk)
+	if err != nil {
+		return err
+	}
+
+	err = swd.restoreContents(dbPath, pw, contents)
+	if err != nil {
+		os.Remove(dbPath)
+		swd.releaseWalletNameID(name, id)
+		return err
+	}
+	return nil
+}
+
+// restoreContents fills the freshly created wallet database at dbPath with
+// the keys and multisig preimages of a backup
+func (swd *SQLiteWalletDriver) restoreContents(dbPath string, pw []byte, contents wallet.Contents) error {
+	sqWallet := &SQLiteWallet{
+		dbPath: dbPath,
+		cfg:    swd.sqliteCfg,
+		kind:   swd.kind,
+	}
+	err := sqWallet.Init(pw)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	for _, key := range contents.Keys {
+		// Extract the seed from the secret key so that we don't trust the
+		// public part
+		seed, err := crypto.SecretKeyToSeed(key.SecretKey)
+		if err != nil {
+			return errSKToPK
+		}
+		sigSecrets := crypto.GenerateSignatureSecrets(seed)
+		pk, sk := crypto.PublicKey(sigSecrets.SignatureVerifier), crypto.PrivateKey(sigSecrets.SK)
+
+		// Generated keys must be the ones the master derivation key derives
+		var keyIdx interface{}
+		if key.Index != 0 {
+			if key.Index > contents.MaxKeyIndex {
+				return errBackupKeyMismatch
+			}
+			derivedPK, _, err := swd.kind.deriveKey(sqWallet.masterDerivationKey, key.Index)
+			if err != nil {
+				return err
+			}
+			if derivedPK != pk {
+				return errBackupKeyMismatch
+			}
+			keyIdx = key.Index
+		}
+
+		skEncrypted, err := encryptBlobWithKey(msgpackEncode(sk), PTSecretKey, sqWallet.masterEncryptionKey)
+		if err != nil {
+			return err
+		}
+		addr := publicKeyToAddress(pk)
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msig := range contents.Multisigs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(contents.MaxKeyIndex), PTMaxKeyIdx, sqWallet.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// releaseWalletNameID forgets a name/id pair claimed by claimWalletNameID,
+// once the wallet it was claimed for has been removed
+func (swd *SQLiteWalletDriver) releaseWalletNameID(name []byte, id []byte) {
+	swd.mux.Lock()
+	defer swd.mux.Unlock()
+
+	for i, nameID := range swd.claimedWallets {
+		if bytes.Equal(nameID[0], name) && bytes.Equal(nameID[1], id) {
+			swd.claimedWallets = append(swd.claimedWallets[:i], swd.claimedWallets[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/config"
+	"github.com/DePINNetwork/depin-sdk/daemon/kmd/wallet"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func testSQLiteConfig(t *testing.T) config.KMDConfig {
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams.ScryptN = 2
+	return cfg
+}
+
+func TestSQLiteBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	swd := &SQLiteWalletDriver{}
+	require.NoError(t, swd.InitWithConfig(testSQLiteConfig(t), logging.TestingLog(t)))
+
+	pw := []byte("password")
+	require.NoError(t, swd.CreateWallet([]byte("orig"), []byte("origid"), pw, crypto.MasterDerivationKey{}))
+	orig, err := swd.FetchWallet([]byte("origid"))
+	require.NoError(t, err)
+	require.NoError(t, orig.Init(pw))
+
+	// Two generated keys with the first deleted, one imported key, and a
+	// multisig address
+	gen1, err := orig.GenerateKey(false)
+	require.NoError(t, err)
+	_, err = orig.GenerateKey(false)
+	require.NoError(t, err)
+	require.NoError(t, orig.DeleteKey(gen1, pw))
+	secrets := crypto.GenerateSignatureSecrets(crypto.Seed{7})
+	_, err = orig.ImportKey(crypto.PrivateKey(secrets.SK))
+	require.NoError(t, err)
+	msigAddr, err := orig.ImportMultisigAddr(1, 1, []crypto.PublicKey{crypto.PublicKey(secrets.SignatureVerifier)})
+	require.NoError(t, err)
+
+	_, err = swd.BackupWallet([]byte("origid"), []byte("wrong"))
+	require.Error(t, err)
+	contents, err := swd.BackupWallet([]byte("origid"), pw)
+	require.NoError(t, err)
+	require.Equal(t, []byte("orig"), contents.Name)
+	require.Equal(t, uint64(2), contents.MaxKeyIndex)
+	require.Len(t, contents.Keys, 2)
+	require.Len(t, contents.Multisigs, 1)
+
+	newPW := []byte("new password")
+	require.NoError(t, swd.RestoreWallet([]byte("restored"), []byte("restoredid"), newPW, contents))
+	restored, err := swd.FetchWallet([]byte("restoredid"))
+	require.NoError(t, err)
+	require.NoError(t, restored.Init(newPW))
+
+	origKeys, err := orig.ListKeys()
+	require.NoError(t, err)
+	restoredKeys, err := restored.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, origKeys, restoredKeys)
+
+	origMDK, err := orig.ExportMasterDerivationKey(pw)
+	require.NoError(t, err)
+	restoredMDK, err := restored.ExportMasterDerivationKey(newPW)
+	require.NoError(t, err)
+	require.Equal(t, origMDK, restoredMDK)
+
+	version, threshold, pks, err := restored.LookupMultisigPreimage(msigAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), version)
+	require.Equal(t, uint8(1), threshold)
+	require.Equal(t, []crypto.PublicKey{crypto.PublicKey(secrets.SignatureVerifier)}, pks)
+
+	// Both wallets continue generating from the same index, and the deleted
+	// key isn't resurrected
+	next, err := orig.GenerateKey(false)
+	require.NoError(t, err)
+	restoredNext, err := restored.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, next, restoredNext)
+	require.NotEqual(t, gen1, restoredNext)
+}
+
+func TestSQLiteRestoreRejectsBadContents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	swd := &SQLiteWalletDriver{}
+	require.NoError(t, swd.InitWithConfig(testSQLiteConfig(t), logging.TestingLog(t)))
+	hwd := &HDWalletDriver{}
+	require.NoError(t, hwd.InitWithConfig(testSQLiteConfig(t), logging.TestingLog(t)))
+
+	pw := []byte("password")
+	require.NoError(t, swd.CreateWallet([]byte("orig"), []byte("origid"), pw, crypto.MasterDerivationKey{}))
+	orig, err := swd.FetchWallet([]byte("origid"))
+	require.NoError(t, err)
+	require.NoError(t, orig.Init(pw))
+	_, err = orig.GenerateKey(false)
+	require.NoError(t, err)
+
+	contents, err := swd.BackupWallet([]byte("origid"), pw)
+	require.NoError(t, err)
+
+	// A backup can only be restored by the driver that made it
+	require.ErrorIs(t, hwd.RestoreWallet([]byte("restored"), []byte("restoredid"), pw, contents), errWrongDriver)
+
+	// A generated key that the master derivation key doesn't derive is
+	// rejected, and no wallet is left behind
+	bad := contents
+	bad.Keys = []wallet.ContentsKey{{SecretKey: crypto.PrivateKey(crypto.GenerateSignatureSecrets(crypto.Seed{9}).SK), Index: 1}}
+	require.ErrorIs(t, swd.RestoreWallet([]byte("restored"), []byte("restoredid"), pw, bad), errBackupKeyMismatch)
+	metadatas, err := swd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, metadatas, 1)
+
+	// The name is free to use again
+	require.NoError(t, swd.RestoreWallet([]byte("restored"), []byte("restoredid"), pw, contents))
+}
+
+func TestHDBackupRestoreKeepsPaths(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hwd := &HDWalletDriver{}
+	require.NoError(t, hwd.InitWithConfig(testSQLiteConfig(t), logging.TestingLog(t)))
+
+	pw := []byte("password")
+	require.NoError(t, hwd.CreateWallet([]byte("orig"), []byte("origid"), pw, crypto.MasterDerivationKey{}))
+	orig, err := hwd.FetchWallet([]byte("origid"))
+	require.NoError(t, err)
+	require.NoError(t, orig.Init(pw))
+	for i := 0; i < 3; i++ {
+		_, err = orig.GenerateKey(false)
+		require.NoError(t, err)
+	}
+
+	contents, err := hwd.BackupWallet([]byte("origid"), pw)
+	require.NoError(t, err)
+	require.NoError(t, hwd.RestoreWallet([]byte("restored"), []byte("restoredid"), pw, contents))
+	restored, err := hwd.FetchWallet([]byte("restoredid"))
+	require.NoError(t, err)
+	require.NoError(t, restored.Init(pw))
+
+	origKeys, err := orig.(wallet.HDWallet).ListDerivedKeys()
+	require.NoError(t, err)
+	restoredKeys, err := restored.(wallet.HDWallet).ListDerivedKeys()
+	require.NoError(t, err)
+	require.Len(t, restoredKeys, 3)
+	require.Equal(t, origKeys, restoredKeys)
+}
@@ -45,3 +45,5 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errBackupKeyMismatch = fmt.Errorf("backup holds a generated key that does not match its master derivation key")
+var errBackupKeyLen = fmt.Errorf("backup holds a master derivation key of invalid length")
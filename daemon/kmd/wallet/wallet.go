@@ -59,6 +59,22 @@ type Wallet interface {
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
 }
 
+// HDWallet is implemented by wallets whose keys are derived from a single
+// seed along hierarchical deterministic derivation paths
+type HDWallet interface {
+	Wallet
+
+	ListDerivedKeys() ([]DerivedKey, error)
+	ExportBIP39Mnemonic(pw []byte) (string, error)
+}
+
+// DerivedKey is a key generated by an HDWallet, along with the derivation
+// path it was generated at (e.g. m/44'/283'/0'/0'/0')
+type DerivedKey struct {
+	Address crypto.Digest
+	Path    string
+}
+
 // Metadata represents high-level information about a wallet, like its name, id
 // and what operations it supports
 type Metadata struct {
@@ -71,6 +87,45 @@ type Metadata struct {
 	SupportedTransactions []protocol.TxType
 }
 
+// Contents is a snapshot of everything stored in a wallet, used to back it up
+// and restore it elsewhere
+type Contents struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Name                []byte                     `codec:"name"`
+	DriverName          string                     `codec:"driver"`
+	DriverVersion       uint32                     `codec:"driverv"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	// MasterDerivationKeyLen is the length of the master derivation key when
+	// it is shorter than MasterDerivationKey, as the BIP-39 entropy of HD
+	// wallets recovered from a mnemonic of less than 24 words, and zero
+	// otherwise
+	MasterDerivationKeyLen uint8 `codec:"mdklen"`
+	// MaxKeyIndex is the index of the last key generated from the master
+	// derivation key, so that a restored wallet doesn't reuse indices
+	MaxKeyIndex uint64             `codec:"maxidx"`
+	Keys        []ContentsKey      `codec:"keys"`
+	Multisigs   []ContentsMultisig `codec:"msigs"`
+}
+
+// ContentsKey is a secret key held by a wallet. Index is the derivation index
+// of generated keys, and zero for imported keys.
+type ContentsKey struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	SecretKey crypto.PrivateKey `codec:"sk"`
+	Index     uint64            `codec:"idx"`
+}
+
+// ContentsMultisig is the preimage of a multisig address held by a wallet
+type ContentsMultisig struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"v"`
+	Threshold uint8              `codec:"thr"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
 // GenerateWalletID generates a random hex wallet ID
 func GenerateWalletID() ([]byte, error) {
 	bytes := make([]byte, walletIDBytes)
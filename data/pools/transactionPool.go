@@ -24,8 +24,10 @@ import (
 	"time"
 
 	"github.com/DePINNetwork/go-deadlock"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/crypto"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
 	"github.com/DePINNetwork/depin-sdk/data/transactions"
@@ -36,6 +38,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/logging/telemetryspec"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/util/condvar"
+	"github.com/DePINNetwork/depin-sdk/util/tracing"
 )
 
 // A TransactionPool prepares valid blocks for proposal and caches
@@ -55,9 +58,11 @@ type TransactionPool struct {
 	logProcessBlockStats bool
 	logAssembleStats     bool
 	expFeeFactor         uint64
-	txPoolMaxSize        int
 	ledger               *ledger.Ledger
 
+	// txPoolMaxSize is the TxPoolSize, which can be changed on a config reload
+	txPoolMaxSize atomic.Int64
+
 	mu                     deadlock.Mutex
 	cond                   sync.Cond
 	expiredTxCount         map[basics.Round]int
@@ -136,11 +141,11 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 		logProcessBlockStats: cfg.EnableProcessBlockStats,
 		logAssembleStats:     cfg.EnableAssembleStats,
 		expFeeFactor:         cfg.TxPoolExponentialIncreaseFactor,
-		txPoolMaxSize:        cfg.TxPoolSize,
 		proposalAssemblyTime: cfg.ProposalAssemblyTime,
 		log:                  log,
 		vac:                  vac,
 	}
+	pool.txPoolMaxSize.Store(int64(cfg.TxPoolSize))
 	if cfg.EnableDeveloperAPI {
 		pool.evalTracer = logic.EvalErrorDetailsTracer{}
 	}
@@ -277,6 +282,12 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 }
 
+// SetMaxSize changes the number of transactions the pool holds. Pending transactions are kept when
+// the pool shrinks below its current size, only new transactions are rejected until it drains.
+func (pool *TransactionPool) SetMaxSize(size int) {
+	pool.txPoolMaxSize.Store(int64(size))
+}
+
 // PendingCount returns the number of transactions currently pending in the pool.
 func (pool *TransactionPool) PendingCount() int {
 	pool.pendingMu.RLock()
@@ -301,7 +312,7 @@ func (pool *TransactionPool) pendingCountNoLock() int {
 func (pool *TransactionPool) checkPendingQueueSize(txnGroup []transactions.SignedTxn) error {
 	pendingSize := pool.pendingTxIDsCount()
 	txCount := len(txnGroup)
-	if pendingSize+txCount > pool.txPoolMaxSize {
+	if int64(pendingSize+txCount) > pool.txPoolMaxSize.Load() {
 		// Allow the state proof transaction to go over the txPoolMaxSize if it already didn't
 		if len(txnGroup) == 1 && txnGroup[0].Txn.Type == protocol.StateProofTx {
 			pool.pendingMu.Lock()
@@ -535,6 +546,10 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 	var unknownCommitted uint
 
 	committedTxids := delta.Txids
+	tracing.EndCommittedTxnTraces(uint64(block.Round()), func(txid crypto.Digest) bool {
+		_, ok := committedTxids[transactions.Txid(txid)]
+		return ok
+	})
 	if pool.logProcessBlockStats {
 		pool.pendingMu.RLock()
 		for txid := range committedTxids {
@@ -860,6 +875,20 @@ func (pool *TransactionPool) getStateProofStats(txib *transactions.SignedTxnInBl
 func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Time) (assembled *ledgercore.UnfinishedBlock, err error) {
 	var stats telemetryspec.AssembleBlockMetrics
 
+	if tracing.TrackedTxnTraces() > 0 {
+		start := time.Now()
+		defer func() {
+			if err != nil || assembled == nil {
+				return
+			}
+			included := assembled.UnfinishedDeltas().Txids
+			tracing.RecordTxnSpans("pool.AssembleBlock", start, func(txid crypto.Digest) bool {
+				_, ok := included[transactions.Txid(txid)]
+				return ok
+			}, attribute.Int64("round", int64(round)))
+		}()
+	}
+
 	if pool.logAssembleStats {
 		start := time.Now()
 		defer func() {
@@ -1392,6 +1392,31 @@ func TestTxPoolSizeLimits(t *testing.T) {
 			require.NoError(t, transactionPool.RememberOne(txgroup[0]))
 		}
 	}
+
+	// growing the pool makes room for more transactions, shrinking it keeps the pending ones
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      firstAddress,
+			Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + 1},
+			FirstValid:  0,
+			LastValid:   10,
+			Note:        []byte{byte(uniqueTxID), byte(uniqueTxID >> 8), byte(uniqueTxID >> 16)},
+			GenesisHash: ledger.GenesisHash(),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: 0},
+		},
+	}
+	require.Error(t, transactionPool.RememberOne(tx.Sign(secrets[0])))
+	transactionPool.SetMaxSize(cfg.TxPoolSize + 1)
+	require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[0])))
+	pending := transactionPool.PendingCount()
+	transactionPool.SetMaxSize(1)
+	tx.Note = append(tx.Note, 1)
+	require.Error(t, transactionPool.RememberOne(tx.Sign(secrets[0])))
+	require.Equal(t, pending, transactionPool.PendingCount())
 }
 
 func TestStateProofLogging(t *testing.T) {
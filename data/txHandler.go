@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/DePINNetwork/depin-sdk/config"
@@ -38,6 +39,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/util"
 	"github.com/DePINNetwork/depin-sdk/util/execpool"
 	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/depin-sdk/util/tracing"
 	"github.com/DePINNetwork/go-deadlock"
 )
 
@@ -65,6 +67,12 @@ var transactionGroupTxSyncRemember = metrics.MakeCounter(metrics.TransactionGrou
 var transactionGroupTxSyncAlreadyCommitted = metrics.MakeCounter(metrics.TransactionGroupTxSyncAlreadyCommitted)
 var txBacklogDroppedCongestionManagement = metrics.MakeCounter(metrics.TransactionMessagesTxnDroppedCongestionManagement)
 
+// txGroupLatencyBuckets range from 100µs to about 3.3s
+var txGroupLatencyBuckets = metrics.ExponentialBuckets(0.0001, 2, 16)
+
+var transactionGroupVerificationSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_transaction_group_verification_seconds", Description: "Time from handing a transaction group to the verifier until its result is processed"}, txGroupLatencyBuckets)
+var transactionGroupRememberSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_transaction_group_remember_seconds", Description: "Time spent adding a verified transaction group to the transaction pool"}, txGroupLatencyBuckets)
+
 // ErrInvalidTxPool is reported when nil is passed for the tx pool
 var ErrInvalidTxPool = errors.New("MakeTxHandler: txPool is nil on initialization")
 
@@ -110,6 +118,8 @@ type txBacklogMsg struct {
 	verificationErr       error                         // The verification error generated by the verification function, if any.
 	capguard              *util.ErlCapacityGuard        // the structure returned from the elastic rate limiter, to be released when dequeued
 	syncCh                chan network.ForwardingPolicy // channel to signal the synchronous mode and its ops completion
+	verificationStart     time.Time                     // when the group was handed to the stream verifier, if it was
+	txnTrace              *tracing.TxnTrace             // the lifecycle trace of the group, if it is being traced
 }
 
 // TxHandler handles transaction messages
@@ -118,7 +128,7 @@ type TxHandler struct {
 	ledger                     *Ledger
 	txVerificationPool         execpool.BacklogPool
 	backlogQueue               chan *txBacklogMsg
-	backlogCongestionThreshold float64
+	backlogCongestionPct       atomic.Int64
 	postVerificationQueue      chan *verify.VerificationResult
 	backlogWg                  sync.WaitGroup
 	net                        network.GossipNode
@@ -132,7 +142,7 @@ type TxHandler struct {
 	erl                        *util.ElasticRateLimiter
 	erlClientMapper            erlClientMapper
 	appLimiter                 *appRateLimiter
-	appLimiterBacklogThreshold int
+	appLimiterBacklogThreshold atomic.Int64
 	appLimiterCountERLDrops    bool
 
 	// batchVerifier provides synchronous verification of transaction groups, used only by pubsub validation in validateIncomingTxMessage.
@@ -195,7 +205,6 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 		if opts.Config.EnableTxBacklogAppRateLimiting && opts.Config.TxBacklogAppTxRateLimiterMaxSize == 0 {
 			return nil, fmt.Errorf("invalid value for TxBacklogAppTxRateLimiterMaxSize: %d. App rate limiter enabled with zero size", opts.Config.TxBacklogAppTxRateLimiterMaxSize)
 		}
-		handler.backlogCongestionThreshold = float64(opts.Config.TxBacklogRateLimitingCongestionPct) / 100
 		if opts.Config.EnableTxBacklogRateLimiting {
 			handler.erl = util.NewElasticRateLimiter(
 				txBacklogSize,
@@ -214,10 +223,9 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 				uint64(opts.Config.TxBacklogAppTxPerSecondRate),
 				time.Duration(opts.Config.TxBacklogServiceRateWindowSeconds)*time.Second,
 			)
-			// set appLimiter triggering threshold at 50% of the base backlog size
-			handler.appLimiterBacklogThreshold = int(float64(opts.Config.TxBacklogSize) * float64(opts.Config.TxBacklogRateLimitingCongestionPct) / 100)
 			handler.appLimiterCountERLDrops = opts.Config.TxBacklogAppRateLimitingCountERLDrops
 		}
+		handler.ApplyConfig(opts.Config)
 	}
 
 	// prepare the batch processor for pubsub synchronous verification
@@ -251,6 +259,14 @@ func (handler *TxHandler) droppedTxnWatcher() {
 	}
 }
 
+// ApplyConfig applies the TxBacklogRateLimitingCongestionPct of cfg, the share of the backlog above which the
+// rate limiters consider it congested. It is called again when the node configuration is reloaded.
+func (handler *TxHandler) ApplyConfig(cfg config.Local) {
+	handler.backlogCongestionPct.Store(int64(cfg.TxBacklogRateLimitingCongestionPct))
+	// the app limiter threshold is relative to the base backlog size, without the capacity reserved for peers
+	handler.appLimiterBacklogThreshold.Store(int64(cfg.TxBacklogSize) * int64(cfg.TxBacklogRateLimitingCongestionPct) / 100)
+}
+
 // Start enables the processing of incoming messages at the transaction handler
 func (handler *TxHandler) Start() {
 	handler.ctx, handler.ctxCancel = context.WithCancel(context.Background())
@@ -348,8 +364,10 @@ func (handler *TxHandler) backlogWorker() {
 					logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
 				}
 			}
+			wi.txnTrace.RecordSpan("txHandler.backlog", wi.txnTrace.StartTime(), nil)
 			if handler.checkAlreadyCommitted(wi) {
 				transactionMessagesAlreadyCommitted.Inc(nil)
+				wi.txnTrace.Reject(errTxAlreadyCommitted)
 				if wi.capguard != nil {
 					wi.capguard.Served()
 				}
@@ -360,6 +378,7 @@ func (handler *TxHandler) backlogWorker() {
 				continue
 			}
 			// handler.streamVerifierChan does not receive if ctx is cancelled
+			wi.verificationStart = time.Now()
 			select {
 			case handler.streamVerifierChan <- &verify.UnverifiedTxnSigJob{TxnGroup: wi.unverifiedTxGroup, BacklogMessage: wi}:
 			case <-handler.ctx.Done():
@@ -518,7 +537,12 @@ func (handler *TxHandler) rememberReportErrors(err error) {
 }
 
 func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
+	if !wi.verificationStart.IsZero() {
+		transactionGroupVerificationSeconds.ObserveSince(wi.verificationStart, nil)
+		wi.txnTrace.RecordSpan("verify", wi.verificationStart, wi.verificationErr)
+	}
 	if wi.verificationErr != nil {
+		wi.txnTrace.Reject(wi.verificationErr)
 		// disconnect from peer.
 		handler.postProcessReportErrors(wi.verificationErr)
 		logging.Base().Warnf("Received a malformed tx group %v: %v", wi.unverifiedTxGroup, wi.verificationErr)
@@ -538,8 +562,12 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	verifiedTxGroup := wi.unverifiedTxGroup
 
 	// save the transaction, if it has high enough fee and not already in the cache
+	rememberStart := time.Now()
 	err := handler.txPool.Remember(verifiedTxGroup)
+	transactionGroupRememberSeconds.ObserveSince(rememberStart, nil)
+	wi.txnTrace.RecordSpan("pool.Remember", rememberStart, err)
 	if err != nil {
+		wi.txnTrace.Reject(err)
 		handler.rememberReportErrors(err)
 		logging.Base().Debugf("could not remember tx: %v", err)
 		// if in synchronous mode, signal the completion of the operation
@@ -563,7 +591,9 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	}
 
 	// We reencode here instead of using rawmsg.Data to avoid broadcasting non-canonical encodings
-	handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	relayStart := time.Now()
+	err = handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	wi.txnTrace.RecordSpan("network.Relay", relayStart, err)
 }
 
 func (handler *TxHandler) deleteFromCaches(msgKey crypto.Digest, canonicalKey crypto.Digest) {
@@ -738,7 +768,7 @@ func (eic *erlIPClient) connClosed(ec util.ErlClient) {
 // - the capacity guard returned by the elastic rate limiter
 // - a boolean indicating if the sender is rate limited
 func (handler *TxHandler) incomingMsgErlCheck(sender util.ErlClient) (*util.ErlCapacityGuard, bool) {
-	congestedERL := float64(cap(handler.backlogQueue))*handler.backlogCongestionThreshold < float64(len(handler.backlogQueue))
+	congestedERL := float64(cap(handler.backlogQueue))*float64(handler.backlogCongestionPct.Load())/100 < float64(len(handler.backlogQueue))
 	// consume a capacity unit
 	// if the elastic rate limiter cannot vend a capacity, the error it returns
 	// is sufficient to indicate that we should enable Congestion Control, because
@@ -823,7 +853,7 @@ func (handler *TxHandler) incomingTxGroupCanonicalDedup(unverifiedTxGroup []tran
 func (handler *TxHandler) incomingTxGroupAppRateLimit(unverifiedTxGroup []transactions.SignedTxn, sender network.DisconnectableAddressablePeer) bool {
 	// rate limit per application in a group. Limiting any app in a group drops the entire message.
 	if handler.appLimiter != nil {
-		congestedARL := len(handler.backlogQueue) > handler.appLimiterBacklogThreshold
+		congestedARL := int64(len(handler.backlogQueue)) > handler.appLimiterBacklogThreshold.Load()
 		if congestedARL && handler.appLimiter.shouldDrop(unverifiedTxGroup, sender.RoutingAddr()) {
 			transactionMessagesAppLimiterDrop.Inc(nil)
 			return true
@@ -886,6 +916,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		return network.OutgoingMessage{Action: network.Ignore}
 	}
 
+	txnTrace := startTxnTrace(unverifiedTxGroup, "gossip")
 	select {
 	case handler.backlogQueue <- &txBacklogMsg{
 		rawmsg:                &rawmsg,
@@ -893,12 +924,14 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		rawmsgDataHash:        msgKey,
 		unverifiedTxGroupHash: canonicalKey,
 		capguard:              capguard,
+		txnTrace:              txnTrace,
 	}:
 		accepted = true
 	default:
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
 		// want to increase the queue size.
 		transactionMessagesDroppedFromBacklog.Inc(nil)
+		txnTrace.Reject(errBackLogFull)
 
 		// additionally, remove the txn from duplicate caches to ensure it can be re-submitted
 		handler.deleteFromCaches(msgKey, canonicalKey)
@@ -941,6 +974,7 @@ func (handler *TxHandler) validateIncomingTxMessage(rawmsg network.IncomingMessa
 		unverifiedTxGroupHash: canonicalKey,
 		capguard:              nil,
 		syncCh:                make(chan network.ForwardingPolicy, 1),
+		txnTrace:              startTxnTrace(unverifiedTxGroup, "gossip"),
 	}
 
 	var action network.ForwardingPolicy
@@ -951,6 +985,7 @@ func (handler *TxHandler) validateIncomingTxMessage(rawmsg network.IncomingMessa
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
 		// want to increase the queue size.
 		transactionMessagesDroppedFromBacklog.Inc(nil)
+		wi.txnTrace.Reject(errBackLogFull)
 
 		// additionally, remove the txn from duplicate caches to ensure it can be re-submitted
 		handler.deleteFromCaches(crypto.Digest{}, canonicalKey)
@@ -968,21 +1003,38 @@ func (handler *TxHandler) validateIncomingTxMessage(rawmsg network.IncomingMessa
 	}
 }
 
-var errBackLogFullLocal = errors.New("backlog full")
+var errBackLogFull = errors.New("backlog full")
+
+// errTxAlreadyCommitted is recorded in the traces of the transaction groups that the transaction pool test rejects,
+// which are mostly groups that were already committed or are already pending
+var errTxAlreadyCommitted = errors.New("already committed")
+
+// startTxnTrace starts tracing the lifecycle of a transaction group received from origin
+func startTxnTrace(txgroup []transactions.SignedTxn, origin string) *tracing.TxnTrace {
+	if !tracing.Enabled() || len(txgroup) == 0 {
+		return nil
+	}
+	return tracing.StartTxnTrace(context.Background(), crypto.Digest(txgroup[0].ID()), origin)
+}
 
 // LocalTransaction is a special shortcut handler for local transactions and intended to be used
 // for performance testing and debugging purposes only since it does not have congestion control
 // and duplicates detection.
 func (handler *TxHandler) LocalTransaction(txgroup []transactions.SignedTxn) error {
+	var txnTrace *tracing.TxnTrace
+	if tracing.Enabled() && len(txgroup) > 0 {
+		txnTrace = tracing.LookupTxnTrace(crypto.Digest(txgroup[0].ID()))
+	}
 	select {
 	case handler.backlogQueue <- &txBacklogMsg{
 		rawmsg:            &network.IncomingMessage{},
 		unverifiedTxGroup: txgroup,
 		capguard:          nil,
+		txnTrace:          txnTrace,
 	}:
 	default:
 		transactionMessagesDroppedFromBacklog.Inc(nil)
-		return errBackLogFullLocal
+		return errBackLogFull
 	}
 	return nil
 }
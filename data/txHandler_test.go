@@ -2626,6 +2626,24 @@ func TestTxHandlerAppRateLimiterERLEnabled(t *testing.T) {
 	require.Equal(t, 1, handler.appLimiter.len())
 }
 
+func TestTxHandlerApplyConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxBacklogSize = 1000
+	cfg.TxBacklogRateLimitingCongestionPct = 50
+
+	handler := &TxHandler{}
+	handler.ApplyConfig(cfg)
+	require.Equal(t, int64(50), handler.backlogCongestionPct.Load())
+	require.Equal(t, int64(500), handler.appLimiterBacklogThreshold.Load())
+
+	cfg.TxBacklogRateLimitingCongestionPct = 25
+	handler.ApplyConfig(cfg)
+	require.Equal(t, int64(25), handler.backlogCongestionPct.Load())
+	require.Equal(t, int64(250), handler.appLimiterBacklogThreshold.Load())
+}
+
 // TestTxHandlerAppRateLimiter submits few app txns to make the app rate limit to filter one the last txn
 // to ensure it is propely integrated with the txHandler
 func TestTxHandlerAppRateLimiter(t *testing.T) {
@@ -2655,7 +2673,7 @@ func TestTxHandlerAppRateLimiter(t *testing.T) {
 	defer handler.txVerificationPool.Shutdown()
 	defer close(handler.streamVerifierDropped)
 
-	handler.appLimiterBacklogThreshold = -1 // force the rate limiter to start checking transactions
+	handler.appLimiterBacklogThreshold.Store(-1) // force the rate limiter to start checking transactions
 	tx := transactions.Transaction{
 		Type: protocol.ApplicationCallTx,
 		Header: transactions.Header{
@@ -1741,6 +1741,7 @@ var ledgerAccountsinitCount = metrics.NewCounter("ledger_accountsinit_count", "c
 var ledgerAccountsinitMicros = metrics.NewCounter("ledger_accountsinit_micros", "µs spent")
 var ledgerCommitroundCount = metrics.NewCounter("ledger_commitround_count", "calls")
 var ledgerCommitroundMicros = metrics.NewCounter("ledger_commitround_micros", "µs spent")
+var ledgerCommitroundSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_ledger_commitround_seconds", Description: "Time spent committing rounds to the trackers database"}, metrics.DefaultHistogramBuckets)
 var ledgerGeneratecatchpointCount = metrics.NewCounter("ledger_generatecatchpoint_count", "calls")
 var ledgerGeneratecatchpointMicros = metrics.NewCounter("ledger_generatecatchpoint_micros", "µs spent")
 var ledgerVacuumCount = metrics.NewCounter("ledger_vacuum_count", "calls")
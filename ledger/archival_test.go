@@ -814,3 +814,68 @@ func checkTrackers(t *testing.T, wl *wrappedLedger, rnd basics.Round) (basics.Ro
 
 	return minMinSave, nil
 }
+
+// TestArchivalFromSparseRetention checks that switching to archival mode a ledger which kept only every so many old
+// blocks resets the blocks DB, even though the genesis block was kept.
+func TestArchivalFromSparseRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbPrefix := filepath.Join(t.TempDir(), t.Name())
+
+	genesisInitState := getInitState()
+	genesisInitState.Block.CurrentProtocol = protocol.ConsensusFuture
+	genesisInitState.GenesisHash = crypto.Digest{1}
+	genesisInitState.Block.BlockHeader.GenesisHash = crypto.Digest{1}
+
+	const inMem = false // use persistent storage
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = false
+	cfg.BlockRetentionSparseInterval = 100
+
+	log := logging.TestingLog(t)
+	l, err := OpenLedger(log, dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	blk := genesisInitState.Block
+
+	const maxBlocks = 2000
+	for i := 0; i < maxBlocks; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+		err := l.AddBlock(blk, agreement.Certificate{})
+		require.NoError(t, err)
+	}
+	l.WaitForCommit(blk.Round())
+
+	var latest, earliest, contiguous basics.Round
+	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		latest, err = blockdb.BlockLatest(tx)
+		require.NoError(t, err)
+		earliest, err = blockdb.BlockEarliest(tx)
+		require.NoError(t, err)
+		contiguous, err = blockdb.BlockContiguousEarliest(tx)
+		require.NoError(t, err)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(maxBlocks), latest)
+	// the genesis block is kept along with every 100th block
+	require.Equal(t, basics.Round(0), earliest)
+	require.Greater(t, contiguous, basics.Round(100))
+	l.Close()
+
+	cfg.Archival = true
+	l, err = OpenLedger(log, dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		latest, err = blockdb.BlockLatest(tx)
+		require.NoError(t, err)
+		earliest, err = blockdb.BlockEarliest(tx)
+		require.NoError(t, err)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), earliest)
+	require.Equal(t, basics.Round(0), latest)
+}
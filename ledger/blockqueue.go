@@ -50,12 +50,19 @@ type blockQueue struct {
 	cond    *sync.Cond
 	running bool
 	closed  chan struct{}
+
+	// retention decides which blocks are kept beyond those required by the trackers
+	retention blockRetentionPolicy
+	// prunedBefore is the earliest round of the contiguous range of persisted blocks, the blocks before it
+	// being those kept by a sparse retention policy. It is only accessed by the syncer.
+	prunedBefore basics.Round
 }
 
 func newBlockQueue(l *Ledger) (*blockQueue, error) {
 	bq := &blockQueue{}
 	bq.cond = sync.NewCond(&bq.mu)
 	bq.l = l
+	bq.retention = makeBlockRetentionPolicy(l.cfg)
 	return bq, nil
 }
 
@@ -82,6 +89,10 @@ func (bq *blockQueue) start() error {
 	err := bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var err0 error
 		bq.lastCommitted, err0 = blockdb.BlockLatest(tx)
+		if err0 != nil || !bq.retention.sparse() {
+			return err0
+		}
+		bq.prunedBefore, err0 = blockdb.BlockContiguousEarliest(tx)
 		return err0
 	})
 	ledgerBlockqInitMicros.AddMicrosecondsSince(start, nil)
@@ -167,28 +178,60 @@ func (bq *blockQueue) syncer() {
 
 			minToSave := bq.l.notifyCommit(committed)
 			var earliest basics.Round
+			var keepEvery []uint64
 			err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 				var err0 error
 				earliest, err0 = blockdb.BlockEarliest(tx)
 				if err0 != nil {
 					bq.l.log.Warnf("blockQueue.syncer: BlockEarliest(): %v", err0)
+					return err0
 				}
-				return err0
+				// the blocks kept by a sparse retention policy are not part of the range being pruned
+				earliest = max(earliest, bq.prunedBefore)
+				if bq.retention == (blockRetentionPolicy{}) {
+					return nil
+				}
+
+				latest, err0 := blockdb.BlockGetHdr(tx, committed)
+				if err0 != nil {
+					// without the latest block, it cannot be told which blocks the retention policy keeps
+					bq.l.log.Warnf("blockQueue.syncer: BlockGetHdr(%d): %v", committed, err0)
+					minToSave = earliest
+					return nil
+				}
+				keepEvery = bq.retention.keepEvery(latest)
+				if bq.retention.window != 0 {
+					windowStart, err0 := bq.retention.windowStart(tx, earliest, latest)
+					if err0 != nil {
+						bq.l.log.Warnf("blockQueue.syncer: unable to find the start of the block retention window: %v", err0)
+						windowStart = earliest
+					}
+					minToSave = min(minToSave, windowStart)
+				}
+				return nil
 			})
 			if err == nil {
 				if basics.SubSaturate(minToSave, earliest) > maxDeletionBatchSize {
 					minToSave = basics.AddSaturate(earliest, maxDeletionBatchSize)
 				}
+			} else if bq.retention.sparse() {
+				// the blocks kept by the retention policy are unknown, so do not forget anything this time
+				minToSave = 0
 			}
 
 			bfstart := time.Now()
 			ledgerSyncBlockforgetCount.Inc(nil)
 			err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+				if bq.retention.sparse() {
+					return blockdb.BlockForgetRange(tx, earliest, minToSave, keepEvery)
+				}
 				return blockdb.BlockForgetBefore(tx, minToSave)
 			})
 			ledgerSyncBlockforgetMicros.AddMicrosecondsSince(bfstart, nil)
 			if err != nil {
 				bq.l.log.Warnf("blockQueue.syncer: blockForgetBefore(%d): %v", minToSave, err)
+			} else if bq.retention.sparse() {
+				bq.prunedBefore = max(bq.prunedBefore, minToSave)
 			}
 
 			bq.mu.Lock()
@@ -244,3 +244,121 @@ func TestBlockQueueSyncerDeletion(t *testing.T) {
 		})
 	}
 }
+
+// TestBlockQueueRetentionPolicy ensures that the block queue syncer keeps the blocks selected by the block retention policy
+func TestBlockQueueRetentionPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	require.NotZero(t, proto.StateProofInterval)
+	spInterval := basics.Round(proto.StateProofInterval)
+
+	tests := []struct {
+		name      string
+		numBlocks basics.Round
+		cfg       config.Local
+		kept      func(rnd, latest basics.Round) bool
+	}{
+		{
+			name:      "lookback",
+			numBlocks: 200,
+			cfg:       config.Local{MaxBlockHistoryLookback: 20},
+			kept:      func(rnd, latest basics.Round) bool { return rnd >= latest-20 },
+		},
+		{
+			// blocks are 10 seconds apart, so the window covers the last 30 rounds
+			name:      "window",
+			numBlocks: 200,
+			cfg:       config.Local{MaxBlockHistoryLookback: 20, BlockRetentionWindowSeconds: 300},
+			kept:      func(rnd, latest basics.Round) bool { return rnd >= latest-30 },
+		},
+		{
+			name:      "sparse",
+			numBlocks: 200,
+			cfg:       config.Local{MaxBlockHistoryLookback: 20, BlockRetentionSparseInterval: 50},
+			kept:      func(rnd, latest basics.Round) bool { return rnd >= latest-20 || rnd%50 == 0 },
+		},
+		{
+			name:      "state_proof_rounds",
+			numBlocks: 3*spInterval + 10,
+			cfg:       config.Local{MaxBlockHistoryLookback: 20, BlockRetentionKeepStateProofRounds: true},
+			kept:      func(rnd, latest basics.Round) bool { return rnd >= latest-20 || rnd%spInterval == 0 },
+		},
+		{
+			name:      "archival",
+			numBlocks: 200,
+			cfg:       config.Local{Archival: true, BlockRetentionSparseInterval: 50},
+			kept:      func(rnd, latest basics.Round) bool { return true },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			const dbMem = true
+			blockDBs, err := db.OpenPair(t.Name()+".block.sqlite", dbMem)
+			require.NoError(t, err)
+			defer blockDBs.Close()
+
+			log := logging.TestingLog(t)
+			makeBlock := func(rnd basics.Round) bookkeeping.Block {
+				return bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+					Round:        rnd,
+					TimeStamp:    int64(rnd) * 10,
+					UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+				}}
+			}
+			err = blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+				err0 := initBlocksDB(tx, log, []bookkeeping.Block{makeBlock(0)}, test.cfg.Archival)
+				if err0 != nil {
+					return err0
+				}
+				for rnd := basics.Round(1); rnd < test.numBlocks; rnd++ {
+					err0 = blockdb.BlockPut(tx, makeBlock(rnd), agreement.Certificate{})
+					if err0 != nil {
+						return err0
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+
+			l := &Ledger{
+				log:      log,
+				blockDBs: blockDBs,
+				trackers: trackerRegistry{log: log},
+				archival: test.cfg.Archival,
+				cfg:      test.cfg,
+			}
+
+			// the retention policy applies to the blocks falling out of the retained range as new blocks come in,
+			// also across restarts of the block queue
+			for _, rnd := range []basics.Round{test.numBlocks, test.numBlocks + 1} {
+				blockq, err := newBlockQueue(l)
+				require.NoError(t, err)
+				require.NoError(t, blockq.start())
+				require.NoError(t, blockq.putBlock(makeBlock(rnd), agreement.Certificate{}))
+				blockq.waitCommit(rnd)
+				blockq.stop()
+			}
+
+			last := test.numBlocks + 1
+			present := make(map[basics.Round]bool)
+			err = blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+				for rnd := basics.Round(0); rnd <= last; rnd++ {
+					_, err0 := blockdb.BlockGetHdr(tx, rnd)
+					if err0 == nil {
+						present[rnd] = true
+					} else if !errors.As(err0, &ledgercore.ErrNoEntry{}) {
+						return err0
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			for rnd := basics.Round(0); rnd <= last; rnd++ {
+				require.Equal(t, test.kept(rnd, last), present[rnd], "round %d", rnd)
+			}
+		})
+	}
+}
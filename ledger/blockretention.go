@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
+	"github.com/DePINNetwork/depin-sdk/ledger/store/blockdb"
+)
+
+// blockRetentionPolicy describes the blocks a non-archival ledger keeps in addition to those required by its trackers
+// and the MaxBlockHistoryLookback most recent rounds. The zero value keeps no additional blocks.
+type blockRetentionPolicy struct {
+	// window keeps the blocks created within that duration of the latest committed block
+	window time.Duration
+	// sparseInterval keeps the blocks whose round is a multiple of it, once they fall out of the retained range
+	sparseInterval uint64
+	// stateProofRounds keeps the blocks whose round is a multiple of the state proof interval, once they fall out of the retained range
+	stateProofRounds bool
+}
+
+func makeBlockRetentionPolicy(cfg config.Local) blockRetentionPolicy {
+	if cfg.Archival {
+		// archival ledgers keep everything anyway
+		return blockRetentionPolicy{}
+	}
+	return blockRetentionPolicy{
+		window:           time.Duration(cfg.BlockRetentionWindowSeconds) * time.Second,
+		sparseInterval:   cfg.BlockRetentionSparseInterval,
+		stateProofRounds: cfg.BlockRetentionKeepStateProofRounds,
+	}
+}
+
+// sparse returns true if the policy keeps some of the blocks below the retained range,
+// which is then no longer removed with a plain BlockForgetBefore.
+func (p blockRetentionPolicy) sparse() bool {
+	return p.sparseInterval != 0 || p.stateProofRounds
+}
+
+// keepEvery returns the round intervals whose multiples are kept below the retained range, given the latest block header
+func (p blockRetentionPolicy) keepEvery(latest bookkeeping.BlockHeader) []uint64 {
+	var intervals []uint64
+	if p.sparseInterval != 0 {
+		intervals = append(intervals, p.sparseInterval)
+	}
+	if p.stateProofRounds {
+		if interval := config.Consensus[latest.CurrentProtocol].StateProofInterval; interval != 0 {
+			intervals = append(intervals, interval)
+		}
+	}
+	return intervals
+}
+
+// windowStart returns the earliest round, out of the contiguous range of blocks from earliest to latest, whose block
+// was created within the retention window of the latest block. Block timestamps never decrease, which allows for a binary search.
+func (p blockRetentionPolicy) windowStart(tx *sql.Tx, earliest basics.Round, latest bookkeeping.BlockHeader) (basics.Round, error) {
+	cutoff := latest.TimeStamp - int64(p.window/time.Second)
+	lo, hi := earliest, latest.Round
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		hdr, err := blockdb.BlockGetHdr(tx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if hdr.TimeStamp >= cutoff {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/DePINNetwork/depin-sdk/ledger/store/trackerdb"
+)
+
+// CatchpointEntriesStream is implemented by the catchpoint file streams returned by GetCatchpointStream when the
+// catchpoint file was written along with an index of its entries. It lets a part of the catchpoint file be read
+// without decompressing the entries before that part.
+type CatchpointEntriesStream interface {
+	ReadCloseSizer
+	// EntryCount returns the number of entries held by the catchpoint file.
+	EntryCount() uint64
+	// EntryFrames returns a reader of the compressed frames holding count entries of the catchpoint file, starting
+	// at the entry with index start, or all of the entries following start if count is zero. The frames are followed
+	// by the frame ending the tar archive, so that they make a valid catchpoint file part, compressed with the
+	// encoding of the catchpoint file.
+	EntryFrames(start, count uint64) io.Reader
+}
+
+// indexedCatchpointStream is an instance of the CatchpointEntriesStream interface
+type indexedCatchpointStream struct {
+	readCloseSizer
+	file *os.File
+	// offsets holds the offset of the frame of each entry within the file, followed by the offset of the frame ending
+	// the tar archive.
+	offsets []uint64
+}
+
+// makeCatchpointStream returns a stream of the catchpoint file opened at path, indexed by entry if the entries index
+// of the file is available. size is negative when unknown.
+func makeCatchpointStream(file *os.File, path string, size int64) ReadCloseSizer {
+	stream := readCloseSizer{ReadCloser: file, size: size}
+	if size < 0 {
+		return &stream
+	}
+	offsets, err := readCatchpointEntriesIndex(trackerdb.MakeCatchpointEntriesIndexPath(path), uint64(size))
+	if err != nil {
+		// catchpoint files written before entries got indexed are served by decompressing them from their start.
+		return &stream
+	}
+	return &indexedCatchpointStream{readCloseSizer: stream, file: file, offsets: offsets}
+}
+
+// EntryCount returns the number of entries held by the catchpoint file.
+func (s *indexedCatchpointStream) EntryCount() uint64 {
+	return uint64(len(s.offsets) - 1)
+}
+
+// EntryFrames returns a reader of the compressed frames holding count entries starting at start, followed by the
+// frame ending the tar archive.
+func (s *indexedCatchpointStream) EntryFrames(start, count uint64) io.Reader {
+	entries := s.EntryCount()
+	start = min(start, entries)
+	end := entries
+	if count != 0 && count < entries-start {
+		end = start + count
+	}
+	trailer := s.offsets[entries]
+	return io.MultiReader(
+		io.NewSectionReader(s.file, int64(s.offsets[start]), int64(s.offsets[end]-s.offsets[start])),
+		io.NewSectionReader(s.file, int64(trailer), s.size-int64(trailer)),
+	)
+}
+
+// writeCatchpointEntriesIndex writes the offsets of the catchpoint file frames to the entries index at path.
+func writeCatchpointEntriesIndex(path string, offsets []uint64) error {
+	buf := make([]byte, 8*len(offsets))
+	for i, offset := range offsets {
+		binary.LittleEndian.PutUint64(buf[8*i:], offset)
+	}
+	return os.WriteFile(path, buf, 0644)
+}
+
+// readCatchpointEntriesIndex reads the offsets of the frames of a catchpoint file of the given size off the entries
+// index at path, checking they are consistent with the file.
+func readCatchpointEntriesIndex(path string, size uint64) ([]uint64, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf)%8 != 0 || len(buf) < 16 {
+		return nil, fmt.Errorf("catchpoint entries index %s has an invalid length of %d bytes", path, len(buf))
+	}
+	offsets := make([]uint64, len(buf)/8)
+	for i := range offsets {
+		offsets[i] = binary.LittleEndian.Uint64(buf[8*i:])
+		if (i == 0 && offsets[i] != 0) || (i > 0 && offsets[i] <= offsets[i-1]) || offsets[i] >= size {
+			return nil, fmt.Errorf("catchpoint entries index %s doesn't match a catchpoint file of %d bytes", path, size)
+		}
+	}
+	return offsets, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/DataDog/zstd"
+	kzstd "github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CatchpointFileEncodingGzip is the content encoding of catchpoint files prior to CatchpointFileVersionV9
+	CatchpointFileEncodingGzip = "gzip"
+	// CatchpointFileEncodingZstd is the content encoding of catchpoint files since CatchpointFileVersionV9
+	CatchpointFileEncodingZstd = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1F, 0x8B}
+	zstdMagic = []byte{0x28, 0xB5, 0x2F, 0xFD}
+)
+
+// CatchpointFileEncoding returns the content encoding of the catchpoint file read by r, by peeking at its first bytes.
+// An empty string is returned for an uncompressed tar archive.
+func CatchpointFileEncoding(r *bufio.Reader) string {
+	if prefix, err := r.Peek(len(gzipMagic)); err == nil && bytes.Equal(prefix, gzipMagic) {
+		return CatchpointFileEncodingGzip
+	}
+	if prefix, err := r.Peek(len(zstdMagic)); err == nil && bytes.Equal(prefix, zstdMagic) {
+		return CatchpointFileEncodingZstd
+	}
+	return ""
+}
+
+// MakeCatchpointFileReader returns a reader of the tar archive held by the catchpoint file read by r,
+// decompressing it according to its content encoding.
+func MakeCatchpointFileReader(r *bufio.Reader) (io.ReadCloser, error) {
+	return NewCatchpointFileDecoder(r, CatchpointFileEncoding(r))
+}
+
+// NewCatchpointFileDecoder returns a reader decompressing the catchpoint file, or the part of a catchpoint file, read
+// by r according to the given content encoding. Catchpoint files are compressed in independent frames, one per entry,
+// so the decoder reads through concatenated frames.
+func NewCatchpointFileDecoder(r io.Reader, encoding string) (io.ReadCloser, error) {
+	switch encoding {
+	case CatchpointFileEncodingGzip:
+		return gzip.NewReader(r)
+	case CatchpointFileEncodingZstd:
+		// unlike the cgo decoder, this one carries on with the next frame once a frame ends.
+		decoder, err := kzstd.NewReader(r, kzstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	case "":
+		return io.NopCloser(r), nil
+	default:
+		return nil, fmt.Errorf("unsupported catchpoint file encoding %s", encoding)
+	}
+}
+
+// zstdWriteCloser makes closing a zstd writer idempotent, as closing it twice would release its compression context twice.
+type zstdWriteCloser struct {
+	*zstd.Writer
+	closed bool
+}
+
+func (w *zstdWriteCloser) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.Writer.Close()
+}
+
+// catchpointFileEncoder returns a writer compressing a catchpoint file of the given version into w.
+func catchpointFileEncoder(w io.Writer, version uint64) (io.WriteCloser, error) {
+	if version >= CatchpointFileVersionV9 {
+		return &zstdWriteCloser{Writer: zstd.NewWriterLevel(w, zstd.DefaultCompression)}, nil
+	}
+	return gzip.NewWriterLevel(w, gzip.BestSpeed)
+}
+
+// catchpointFrameWriter compresses the catchpoint file written through it in independent frames, one per entry, and
+// records the offset of each frame within the file. Any frame can then be decompressed without the ones before it,
+// which lets a part of the catchpoint file be served without decompressing the whole file up to that part.
+type catchpointFrameWriter struct {
+	out     io.Writer
+	version uint64
+	written uint64
+	frame   io.WriteCloser
+	offsets []uint64
+}
+
+func (w *catchpointFrameWriter) Write(p []byte) (int, error) {
+	if w.frame == nil {
+		frame, err := catchpointFileEncoder(frameOutput{w}, w.version)
+		if err != nil {
+			return 0, err
+		}
+		w.offsets = append(w.offsets, w.written)
+		w.frame = frame
+	}
+	return w.frame.Write(p)
+}
+
+// endFrame ends the frame being written, if any. The next write starts a new frame.
+func (w *catchpointFrameWriter) endFrame() error {
+	if w.frame == nil {
+		return nil
+	}
+	err := w.frame.Close()
+	w.frame = nil
+	return err
+}
+
+// frameOutput counts the compressed bytes written by the catchpointFrameWriter
+type frameOutput struct {
+	w *catchpointFrameWriter
+}
+
+func (o frameOutput) Write(p []byte) (int, error) {
+	n, err := o.w.out.Write(p)
+	o.w.written += uint64(n)
+	return n, err
+}
@@ -22,6 +22,10 @@ import (
 	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
 )
 
+// catchpointLabelMaxLen is the length of the longest catchpoint label: a round number of up to 20 digits,
+// followed by a '#' and the 52 characters long base32 encoding of the label digest.
+const catchpointLabelMaxLen = 20 + 1 + 52
+
 // CatchpointFileHeader is the content we would have in the "content.msgpack" file in the catchpoint tar archive.
 // we need it to be public, as it's being decoded externally by the catchpointdump utility.
 type CatchpointFileHeader struct {
@@ -36,6 +40,14 @@ type CatchpointFileHeader struct {
 	TotalKVs               uint64                   `codec:"kvsCount"`
 	TotalOnlineAccounts    uint64                   `codec:"onlineAccountsCount"`
 	TotalOnlineRoundParams uint64                   `codec:"onlineRoundParamsCount"`
-	Catchpoint             string                   `codec:"catchpoint"`
+	Catchpoint             string                   `codec:"catchpoint,allocbound=catchpointLabelMaxLen"`
 	BlockHeaderDigest      crypto.Digest            `codec:"blockHeaderDigest"`
+
+	// ChunkHashes holds the hash of every chunk file in the catchpoint tar archive, allowing each of them
+	// to be validated as it is being staged. It is only set for CatchpointFileVersionV9 and later: the
+	// first hash covers the state proof verification context file, and the n-th hash covers balances.n.msgpack.
+	// Note that the header itself is not covered by the catchpoint label, so the hashes only catch chunks
+	// corrupted on disk or in transit; they do not protect against a peer tampering with the file, which
+	// is only detected once the staged data is checked against the label.
+	ChunkHashes []crypto.Digest `codec:"chunkHashes,allocbound=ChunkHashesPerCatchpointFile"`
 }
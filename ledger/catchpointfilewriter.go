@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 
 	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/crypto"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/ledger/encoded"
 	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
@@ -46,6 +47,11 @@ const (
 	// in the catchpoint file.
 	// (2 years * 31536000 seconds per year) / (256 rounds per state proof verification data * 3.6 seconds per round) ~= 70000
 	SPContextPerCatchpointFile = 70000
+
+	// ChunkHashesPerCatchpointFile defines the maximum number of chunk hashes listed by the catchpoint file header,
+	// one per chunk plus one for the state proof verification data.
+	// 2^20 chunks * 512 records per chunk => roughly 500M accounts, resources, kvs and online accounts records.
+	ChunkHashesPerCatchpointFile = 1 << 20
 )
 
 // catchpointFileWriter is the struct managing the persistence of accounts data into the catchpoint file.
@@ -68,6 +74,7 @@ type catchpointFileWriter struct {
 	chunkNum               uint64
 	writtenBytes           int64
 	biggestChunkLen        uint64
+	chunkHashes            []crypto.Digest
 	accountsIterator       trackerdb.EncodedAccountsBatchIter
 	maxResourcesPerChunk   int
 	accountsRound          basics.Round
@@ -202,6 +209,7 @@ func (cw *catchpointFileWriter) FileWriteSPVerificationContext(encodedData []byt
 	if chunkLen := uint64(len(encodedData)); cw.biggestChunkLen < chunkLen {
 		cw.biggestChunkLen = chunkLen
 	}
+	cw.chunkHashes = append(cw.chunkHashes, crypto.Hash(encodedData))
 
 	return nil
 }
@@ -332,6 +340,7 @@ func (cw *catchpointFileWriter) asyncWriter(chunks chan CatchpointSnapshotChunkV
 		if chunkLen := uint64(len(encodedChunk)); cw.biggestChunkLen < chunkLen {
 			cw.biggestChunkLen = chunkLen
 		}
+		cw.chunkHashes = append(cw.chunkHashes, crypto.Hash(encodedChunk))
 	}
 }
 
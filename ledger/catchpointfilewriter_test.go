@@ -18,8 +18,8 @@ package ledger
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
-	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/binary"
@@ -105,11 +105,11 @@ func readCatchpointFile(t *testing.T, catchpointPath string) []decodedCatchpoint
 	fileContent, err := os.ReadFile(catchpointPath)
 	require.NoError(t, err)
 
-	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
+	tarStream, err := MakeCatchpointFileReader(bufio.NewReader(bytes.NewBuffer(fileContent)))
 	require.NoError(t, err)
-	defer gzipReader.Close()
+	defer tarStream.Close()
 
-	tarReader := tar.NewReader(gzipReader)
+	tarReader := tar.NewReader(tarStream)
 	return readCatchpointContent(t, tarReader)
 }
 
@@ -316,8 +316,13 @@ func TestBasicCatchpointWriter(t *testing.T) {
 }
 
 func testWriteCatchpoint(t *testing.T, params config.ConsensusParams, rdb trackerdb.Store, datapath string, filepath string, maxResourcesPerChunk int, onlineExcludeBefore basics.Round) CatchpointFileHeader {
+	return testWriteCatchpointVersion(t, CatchpointFileVersionV8, params, rdb, datapath, filepath, maxResourcesPerChunk, onlineExcludeBefore)
+}
+
+func testWriteCatchpointVersion(t *testing.T, version uint64, params config.ConsensusParams, rdb trackerdb.Store, datapath string, filepath string, maxResourcesPerChunk int, onlineExcludeBefore basics.Round) CatchpointFileHeader {
 	var totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks uint64
 	var biggestChunkLen uint64
+	var chunkHashes []crypto.Digest
 	var accountsRnd basics.Round
 	var totals ledgercore.AccountTotals
 	if maxResourcesPerChunk <= 0 {
@@ -362,6 +367,7 @@ func testWriteCatchpoint(t *testing.T, params config.ConsensusParams, rdb tracke
 		totalOnlineRoundParams = writer.totalOnlineRoundParams
 		totalChunks = writer.chunkNum
 		biggestChunkLen = writer.biggestChunkLen
+		chunkHashes = writer.chunkHashes
 		totals, err = ar.AccountsTotals(ctx, false)
 		return
 	})
@@ -370,7 +376,7 @@ func testWriteCatchpoint(t *testing.T, params config.ConsensusParams, rdb tracke
 	blockHeaderDigest := crypto.Hash([]byte{1, 2, 3})
 	catchpointLabel := fmt.Sprintf("%d#%v", blocksRound, blockHeaderDigest) // this is not a correct way to create a label, but it's good enough for this unit test
 	catchpointFileHeader := CatchpointFileHeader{
-		Version:                CatchpointFileVersionV8,
+		Version:                version,
 		BalancesRound:          accountsRnd,
 		BlocksRound:            blocksRound,
 		Totals:                 totals,
@@ -382,6 +388,9 @@ func testWriteCatchpoint(t *testing.T, params config.ConsensusParams, rdb tracke
 		Catchpoint:             catchpointLabel,
 		BlockHeaderDigest:      blockHeaderDigest,
 	}
+	if version >= CatchpointFileVersionV9 {
+		catchpointFileHeader.ChunkHashes = chunkHashes
+	}
 	err = repackCatchpoint(
 		context.Background(), catchpointFileHeader, biggestChunkLen,
 		datapath, filepath)
@@ -844,6 +853,165 @@ func TestFullCatchpointWriter(t *testing.T) {
 	}
 }
 
+func TestFullCatchpointWriterChunkHashes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// t.Parallel() NO! config.Consensus is modified
+
+	// create new protocol version, which has lower lookback
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestFullCatchpointWriterChunkHashes")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	config.Consensus[testProtocolVersion] = protoParams
+	temporaryDirectory := t.TempDir()
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk*3, false)
+	ml := makeMockLedgerForTracker(t, true, 10, testProtocolVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au, _ := newAcctUpdates(t, ml, conf)
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	au.close()
+
+	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
+	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
+	header := testWriteCatchpointVersion(t, CatchpointFileVersionV9, protoParams, ml.trackerDB(), catchpointDataFilePath, catchpointFilePath, 0, 0)
+	require.Len(t, header.ChunkHashes, int(header.TotalChunks)+1)
+
+	// the catchpoint file is compressed with zstd, and its header lists the hash of every chunk in order
+	file, err := os.Open(catchpointFilePath)
+	require.NoError(t, err)
+	require.Equal(t, CatchpointFileEncodingZstd, CatchpointFileEncoding(bufio.NewReader(file)))
+	file.Close()
+
+	catchpointContent := readCatchpointFile(t, catchpointFilePath)
+	require.Equal(t, CatchpointContentFileName, catchpointContent[0].headerName)
+	require.Equal(t, catchpointSPVerificationFileName, catchpointContent[1].headerName)
+	require.Len(t, catchpointContent, len(header.ChunkHashes)+1)
+	for i, chunk := range catchpointContent[1:] {
+		require.Equal(t, header.ChunkHashes[i], crypto.Hash(chunk.data), chunk.headerName)
+	}
+
+	l := testNewLedgerFromCatchpoint(t, ml.trackerDB(), catchpointFilePath)
+	defer l.Close()
+	for addr, acct := range accts {
+		acctData, _, _, err := l.LookupLatest(addr)
+		require.NoErrorf(t, err, "failed to lookup for account %v after restoring from catchpoint", addr)
+		require.Equal(t, acct, acctData)
+	}
+
+	// a corrupted chunk is rejected as soon as it is processed, before anything gets staged
+	accessor := MakeCatchpointCatchupAccessor(l, l.log)
+	err = accessor.ResetStagingBalances(context.Background(), true)
+	require.NoError(t, err)
+	var progress CatchpointCatchupAccessorProgress
+	for _, chunk := range catchpointContent[:3] {
+		err = accessor.ProcessStagingBalances(context.Background(), chunk.headerName, chunk.data, &progress)
+		require.NoError(t, err)
+	}
+	corrupted := bytes.Clone(catchpointContent[3].data)
+	corrupted[len(corrupted)/2]++
+	err = accessor.ProcessStagingBalances(context.Background(), catchpointContent[3].headerName, corrupted, &progress)
+	require.ErrorIs(t, err, ErrCatchpointChunkHashMismatch)
+	require.EqualValues(t, BalancesPerCatchpointFileChunk, progress.ProcessedAccounts)
+	err = accessor.ProcessStagingBalances(context.Background(), catchpointContent[3].headerName, catchpointContent[3].data, &progress)
+	require.NoError(t, err)
+
+	// chunks missing from the header are rejected as well
+	err = accessor.ProcessStagingBalances(context.Background(), fmt.Sprintf(catchpointBalancesFileNameTemplate, len(header.ChunkHashes)), catchpointContent[3].data, &progress)
+	require.ErrorIs(t, err, ErrCatchpointChunkHashMismatch)
+
+	// and so is a header that doesn't list a hash for every chunk
+	header.ChunkHashes = header.ChunkHashes[1:]
+	err = accessor.ResetStagingBalances(context.Background(), true)
+	require.NoError(t, err)
+	err = accessor.ProcessStagingBalances(context.Background(), CatchpointContentFileName, protocol.Encode(&header), &CatchpointCatchupAccessorProgress{})
+	require.ErrorContains(t, err, "chunk hashes listed")
+
+	// a header listing more chunk hashes than a catchpoint file may have doesn't even decode
+	header.ChunkHashes = make([]crypto.Digest, ChunkHashesPerCatchpointFile+1)
+	var decodedHeader CatchpointFileHeader
+	err = protocol.Decode(protocol.Encode(&header), &decodedHeader)
+	require.ErrorContains(t, err, "ChunkHashes")
+}
+
+// TestCatchpointEntriesIndex checks the entries of catchpoint files are compressed in independent frames, indexed so
+// that a part of the catchpoint file can be read without decompressing the entries before it.
+func TestCatchpointEntriesIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// t.Parallel() NO! config.Consensus is modified
+
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestCatchpointEntriesIndex")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	config.Consensus[testProtocolVersion] = protoParams
+	temporaryDirectory := t.TempDir()
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk*3, false)
+	ml := makeMockLedgerForTracker(t, true, 10, testProtocolVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au, _ := newAcctUpdates(t, ml, conf)
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	au.close()
+
+	for _, version := range []uint64{CatchpointFileVersionV8, CatchpointFileVersionV9} {
+		catchpointDataFilePath := filepath.Join(temporaryDirectory, fmt.Sprintf("%d.data", version))
+		catchpointFilePath := filepath.Join(temporaryDirectory, fmt.Sprintf("%d.catchpoint", version))
+		testWriteCatchpointVersion(t, version, protoParams, ml.trackerDB(), catchpointDataFilePath, catchpointFilePath, 0, 0)
+		catchpointContent := readCatchpointFile(t, catchpointFilePath)
+		require.Greater(t, len(catchpointContent), 3)
+
+		fileInfo, err := os.Stat(catchpointFilePath)
+		require.NoError(t, err)
+		file, err := os.Open(catchpointFilePath)
+		require.NoError(t, err)
+		stream, ok := makeCatchpointStream(file, catchpointFilePath, fileInfo.Size()).(CatchpointEntriesStream)
+		require.True(t, ok)
+		require.EqualValues(t, len(catchpointContent), stream.EntryCount())
+		encoding := CatchpointFileEncoding(bufio.NewReader(file))
+
+		readEntries := func(start, count uint64) []decodedCatchpointChunkData {
+			decoder, err := NewCatchpointFileDecoder(stream.EntryFrames(start, count), encoding)
+			require.NoError(t, err)
+			defer decoder.Close()
+			return readCatchpointContent(t, tar.NewReader(decoder))
+		}
+		require.Equal(t, catchpointContent, readEntries(0, 0))
+		require.Equal(t, catchpointContent[1:3], readEntries(1, 2))
+		require.Equal(t, catchpointContent[3:], readEntries(3, 1000))
+		require.Empty(t, readEntries(uint64(len(catchpointContent)), 1))
+		require.NoError(t, stream.Close())
+
+		// an index not matching the catchpoint file is ignored
+		indexPath := trackerdb.MakeCatchpointEntriesIndexPath(catchpointFilePath)
+		file, err = os.Open(catchpointFilePath)
+		require.NoError(t, err)
+		_, ok = makeCatchpointStream(file, catchpointFilePath, fileInfo.Size()/2).(CatchpointEntriesStream)
+		require.False(t, ok)
+		require.NoError(t, file.Close())
+
+		// and the index is removed along with the catchpoint file
+		require.FileExists(t, indexPath)
+		err = trackerdb.RemoveSingleCatchpointFileFromDisk(temporaryDirectory, filepath.Base(catchpointFilePath))
+		require.NoError(t, err)
+		require.NoFileExists(t, indexPath)
+	}
+}
+
 // ensure both committed all pending changes before taking a catchpoint
 // another approach is to modify the test and craft round numbers,
 // and make the ledger to generate catchpoint itself when it is time
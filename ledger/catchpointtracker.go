@@ -19,7 +19,6 @@ package ledger
 import (
 	"archive/tar"
 	"bytes"
-	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/base32"
@@ -68,6 +67,9 @@ const (
 	// as historical onlineaccounts and onlineroundparamstail table data (added in DB version V7,
 	// but until this version initialized with current round data, not 320 rounds of historical info).
 	CatchpointFileVersionV8 = uint64(0203)
+	// CatchpointFileVersionV9 is the catchpoint file version that holds the same data as V8, compressed with zstd rather
+	// than gzip, and whose header lists a hash of every chunk file so that a corrupted chunk is detected as it is staged.
+	CatchpointFileVersionV9 = uint64(0204)
 
 	// CatchpointContentFileName is a name of a file with catchpoint header info inside tar archive
 	CatchpointContentFileName = "content.msgpack"
@@ -109,6 +111,9 @@ type catchpointTracker struct {
 	// enableGeneratingCatchpointFiles determines whether catchpoints files should be generated by the trackers.
 	enableGeneratingCatchpointFiles bool
 
+	// enableCatchpointChunkHashes determines whether the generated catchpoint files are of CatchpointFileVersionV9.
+	enableCatchpointChunkHashes bool
+
 	// log copied from ledger
 	log logging.Logger
 
@@ -183,6 +188,8 @@ func (ct *catchpointTracker) initialize(cfg config.Local, paths DirsAndPrefix) {
 		ct.enableGeneratingCatchpointFiles = true
 	}
 
+	ct.enableCatchpointChunkHashes = cfg.EnableCatchpointChunkHashes
+
 	ct.catchpointFileHistoryLength = cfg.CatchpointFileHistoryLength
 	if cfg.CatchpointFileHistoryLength < -1 {
 		ct.catchpointFileHistoryLength = -1
@@ -219,6 +226,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 	var totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams uint64
 	var totalChunks uint64
 	var biggestChunkLen uint64
+	var chunkHashes []crypto.Digest
 	var spVerificationHash crypto.Digest
 	var spVerificationEncodedData []byte
 	var catchpointGenerationStats telemetryspec.CatchpointGenerationEventDetails
@@ -281,7 +289,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		var err error
 
 		catchpointGenerationStats.BalancesWriteTime = uint64(updatingBalancesDuration.Nanoseconds())
-		totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen, err = ct.generateCatchpointData(
+		totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen, chunkHashes, err = ct.generateCatchpointData(
 			ctx, params, dbRound, onlineExcludeBefore, &catchpointGenerationStats, spVerificationEncodedData)
 		ct.catchpointDataWriting.Store(0)
 		if err != nil {
@@ -296,7 +304,7 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		}
 
 		err = ct.recordFirstStageInfo(ctx, tx, &catchpointGenerationStats, dbRound,
-			totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen,
+			totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen, chunkHashes,
 			spVerificationHash, onlineAccountsHash, onlineRoundParamsHash)
 		if err != nil {
 			return err
@@ -681,7 +689,7 @@ func (ct *catchpointTracker) postCommit(ctx context.Context, dcc *deferredCommit
 	}
 }
 
-func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer) error {
+func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer, entryDone func() error) error {
 	bytes := protocol.Encode(&header)
 
 	err := out.WriteHeader(&tar.Header{
@@ -698,6 +706,11 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 		return err
 	}
 
+	err = entryDone()
+	if err != nil {
+		return err
+	}
+
 	// make buffer for re-use that can fit biggest chunk
 	buf := make([]byte, biggestChunkLen)
 	for {
@@ -731,6 +744,11 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 		if err != nil {
 			return err
 		}
+
+		err = entryDone()
+		if err != nil {
+			return err
+		}
 	}
 }
 
@@ -738,7 +756,9 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 // the latest blockhash) and the (snappy compressed) catchpoint data from
 // dataPath and regurgitates it to look like catchpoints have always looked - a
 // tar file with the header in the first "file" and the catchpoint data in file
-// chunks, all compressed with gzip instead of snappy.
+// chunks, all compressed with gzip instead of snappy, or with zstd as of
+// CatchpointFileVersionV9. Each entry is compressed in a frame of its own, and
+// the offsets of the frames are written to the entries index next to outPath.
 func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, dataPath string, outPath string) error {
 	// Initialize streams.
 	fin, err := os.OpenFile(dataPath, os.O_RDONLY, 0666)
@@ -761,28 +781,31 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 	}
 	defer fout.Close()
 
-	gzipOut, err := gzip.NewWriterLevel(fout, gzip.BestSpeed)
-	if err != nil {
-		return err
-	}
-	defer gzipOut.Close()
+	compressorOut := &catchpointFrameWriter{out: fout, version: header.Version}
+	defer compressorOut.endFrame()
 
-	tarOut := tar.NewWriter(gzipOut)
+	tarOut := tar.NewWriter(compressorOut)
 	defer tarOut.Close()
 
-	// Repack.
-	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut)
+	// Repack, ending a frame along with each entry, including its padding.
+	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut, func() error {
+		err := tarOut.Flush()
+		if err != nil {
+			return err
+		}
+		return compressorOut.endFrame()
+	})
 	if err != nil {
 		return err
 	}
 
-	// Close streams.
+	// Close streams. The end of the tar archive gets a frame of its own.
 	err = tarOut.Close()
 	if err != nil {
 		return err
 	}
 
-	err = gzipOut.Close()
+	err = compressorOut.endFrame()
 	if err != nil {
 		return err
 	}
@@ -802,7 +825,7 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 		return err
 	}
 
-	return nil
+	return writeCatchpointEntriesIndex(trackerdb.MakeCatchpointEntriesIndexPath(outPath), compressorOut.offsets)
 }
 
 // Create a catchpoint (a label and possibly a file with db record) and remove
@@ -818,6 +841,13 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		}
 		labelMaker = ledgercore.MakeCatchpointLabelMakerCurrent(round, &blockHash, &dataInfo.TrieBalancesHash, dataInfo.Totals, &dataInfo.StateProofVerificationHash, &dataInfo.OnlineAccountsHash, &dataInfo.OnlineRoundParamsHash)
 		version = CatchpointFileVersionV8
+		// the chunk hashes are missing if the catchpoint data file was written before the chunk hashes got enabled,
+		// and there are too many of them to fit in the header if the file has more than ChunkHashesPerCatchpointFile-1
+		// chunks; the label doesn't depend on the file version, so we just fall back to V8 in these cases.
+		if ct.enableCatchpointChunkHashes && uint64(len(dataInfo.ChunkHashes)) == dataInfo.TotalChunks+1 &&
+			len(dataInfo.ChunkHashes) <= ChunkHashesPerCatchpointFile {
+			version = CatchpointFileVersionV9
+		}
 	} else if params.EnableCatchpointsWithSPContexts {
 		labelMaker = ledgercore.MakeCatchpointLabelMakerV7(round, &blockHash, &dataInfo.TrieBalancesHash, dataInfo.Totals, &dataInfo.StateProofVerificationHash)
 		version = CatchpointFileVersionV7
@@ -872,6 +902,9 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		Catchpoint:             label,
 		BlockHeaderDigest:      blockHash,
 	}
+	if version >= CatchpointFileVersionV9 {
+		header.ChunkHashes = dataInfo.ChunkHashes
+	}
 
 	relCatchpointFilePath := filepath.Join(trackerdb.CatchpointDirName, trackerdb.MakeCatchpointFilePath(round))
 	absCatchpointFilePath := filepath.Join(ct.dbDirectory, relCatchpointFilePath)
@@ -1231,7 +1264,7 @@ func (ct *catchpointTracker) isWritingCatchpointDataFile() bool {
 //   - Balance and KV chunk (named balances.x.msgpack).
 //     ...
 //   - Balance and KV chunk (named balances.x.msgpack).
-func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, params config.ConsensusParams, accountsRound basics.Round, onlineExcludeBefore basics.Round, catchpointGenerationStats *telemetryspec.CatchpointGenerationEventDetails, encodedSPData []byte) (totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen uint64, err error) {
+func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, params config.ConsensusParams, accountsRound basics.Round, onlineExcludeBefore basics.Round, catchpointGenerationStats *telemetryspec.CatchpointGenerationEventDetails, encodedSPData []byte) (totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen uint64, chunkHashes []crypto.Digest, err error) {
 	ct.log.Debugf("catchpointTracker.generateCatchpointData() writing catchpoint accounts for round %d", accountsRound)
 
 	startTime := time.Now()
@@ -1319,7 +1352,7 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, params
 	ledgerGeneratecatchpointMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
 		ct.log.Warnf("catchpointTracker.generateCatchpointData() %v", err)
-		return 0, 0, 0, 0, 0, 0, err
+		return 0, 0, 0, 0, 0, 0, nil, err
 	}
 
 	catchpointGenerationStats.FileSize = uint64(catchpointWriter.writtenBytes)
@@ -1330,13 +1363,13 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, params
 	catchpointGenerationStats.OnlineRoundParamsCount = catchpointWriter.totalOnlineRoundParams
 	catchpointGenerationStats.AccountsRound = uint64(accountsRound)
 
-	return catchpointWriter.totalAccounts, catchpointWriter.totalKVs, catchpointWriter.totalOnlineAccounts, catchpointWriter.totalOnlineRoundParams, catchpointWriter.chunkNum, catchpointWriter.biggestChunkLen, nil
+	return catchpointWriter.totalAccounts, catchpointWriter.totalKVs, catchpointWriter.totalOnlineAccounts, catchpointWriter.totalOnlineRoundParams, catchpointWriter.chunkNum, catchpointWriter.biggestChunkLen, catchpointWriter.chunkHashes, nil
 }
 
 func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx trackerdb.TransactionScope,
 	catchpointGenerationStats *telemetryspec.CatchpointGenerationEventDetails,
 	accountsRound basics.Round,
-	totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen uint64,
+	totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen uint64, chunkHashes []crypto.Digest,
 	stateProofVerificationHash, onlineAccountsVerificationHash, onlineRoundParamsVerificationHash crypto.Digest) error {
 	ar, err := tx.MakeAccountsReader()
 	if err != nil {
@@ -1384,6 +1417,7 @@ func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx tracke
 		TotalOnlineRoundParams:     totalOnlineRoundParams,
 		TotalChunks:                totalChunks,
 		BiggestChunkLen:            biggestChunkLen,
+		ChunkHashes:                chunkHashes,
 		TrieBalancesHash:           trieBalancesHash,
 		StateProofVerificationHash: stateProofVerificationHash,
 		OnlineAccountsHash:         onlineAccountsVerificationHash,
@@ -1482,7 +1516,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		catchpointPath := filepath.Join(ct.dbDirectory, dbFileName)
 		file, openErr := os.OpenFile(catchpointPath, os.O_RDONLY, 0666)
 		if openErr == nil && file != nil {
-			return &readCloseSizer{ReadCloser: file, size: fileSize}, nil
+			return makeCatchpointStream(file, catchpointPath, fileSize), nil
 		}
 		// else, see if this is a file-not-found error
 		if os.IsNotExist(openErr) {
@@ -1523,7 +1557,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		if err != nil {
 			ct.log.Warnf("catchpointTracker.GetCatchpointStream() unable to save missing catchpoint entry: %v", err)
 		}
-		return &readCloseSizer{ReadCloser: file, size: fileInfo.Size()}, nil
+		return makeCatchpointStream(file, absCatchpointFilePath, fileInfo.Size()), nil
 	}
 	return nil, ledgercore.ErrNoEntry{}
 }
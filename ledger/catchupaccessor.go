@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -56,12 +57,25 @@ type CatchpointCatchupAccessor interface {
 	// SetLabel set the catchpoint catchup label
 	SetLabel(ctx context.Context, label string) (err error)
 
+	// GetCatchpointFile returns the path of the local catchpoint file the catchpoint catchup loads the ledger from, if any
+	GetCatchpointFile(ctx context.Context) (path string, err error)
+
+	// SetCatchpointFile set the path of the local catchpoint file the catchpoint catchup loads the ledger from
+	SetCatchpointFile(ctx context.Context, path string) (err error)
+
 	// ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 	ResetStagingBalances(ctx context.Context, newCatchup bool) (err error)
 
 	// ProcessStagingBalances deserialize the given bytes as a temporary staging balances
 	ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error)
 
+	// GetStagingProgress returns the number of catchpoint file entries staged so far and the staging progress, as stored by SetStagingProgress,
+	// allowing a catchpoint catchup interrupted by a restart to resume staging from the first entry that was not staged yet
+	GetStagingProgress(ctx context.Context) (staged uint64, progress CatchpointCatchupAccessorProgress, err error)
+
+	// SetStagingProgress stores the number of catchpoint file entries staged so far and the staging progress
+	SetStagingProgress(ctx context.Context, staged uint64, progress *CatchpointCatchupAccessorProgress) (err error)
+
 	// BuildMerkleTrie inserts the account hashes into the merkle trie
 	BuildMerkleTrie(ctx context.Context, progressUpdates func(uint64, uint64)) (err error)
 
@@ -323,6 +337,24 @@ func (c *catchpointCatchupAccessorImpl) SetLabel(ctx context.Context, label stri
 	return
 }
 
+// GetCatchpointFile returns the path of the local catchpoint file the catchpoint catchup loads the ledger from, if any
+func (c *catchpointCatchupAccessorImpl) GetCatchpointFile(ctx context.Context) (path string, err error) {
+	path, err = c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFile, err)
+	}
+	return
+}
+
+// SetCatchpointFile set the path of the local catchpoint file the catchpoint catchup loads the ledger from
+func (c *catchpointCatchupAccessorImpl) SetCatchpointFile(ctx context.Context, path string) (err error) {
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, path)
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFile, err)
+	}
+	return
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	if !newCatchup {
@@ -340,6 +372,15 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 		if err != nil {
 			return fmt.Errorf("unable to reset catchpoint catchup balances : %v", err)
 		}
+		// the staging progress goes along with the staging balances.
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFileHeader, "")
+		if err != nil {
+			return err
+		}
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupStagingProgress, "")
+		if err != nil {
+			return err
+		}
 		if !newCatchup {
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBalancesRound, 0)
 			if err != nil {
@@ -355,6 +396,10 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 			if err != nil {
 				return err
 			}
+			err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, "")
+			if err != nil {
+				return err
+			}
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupState, 0)
 			if err != nil {
 				return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupState, err)
@@ -362,10 +407,115 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 		}
 		return
 	})
+	if err == nil {
+		c.acctResCnt = catchpointAccountResourceCounter{}
+		c.expectingSpecificAccount = false
+		c.nextExpectedAccount = basics.Address{}
+	}
 	ledgerResetstagingbalancesMicros.AddMicrosecondsSince(start, nil)
 	return
 }
 
+// catchpointStagingProgress is the staging progress stored by SetStagingProgress after each staged catchpoint file entry.
+// Along with the catchpoint file header, it's all that's needed to resume staging from the next entry.
+//
+//msgp:ignore catchpointStagingProgress
+type catchpointStagingProgress struct {
+	Staged                     uint64 `codec:"staged"`
+	ProcessedAccounts          uint64 `codec:"accounts"`
+	ProcessedBytes             uint64 `codec:"bytes"`
+	ProcessedKVs               uint64 `codec:"kvs"`
+	ProcessedOnlineAccounts    uint64 `codec:"onlineaccounts"`
+	ProcessedOnlineRoundParams uint64 `codec:"onlineroundparams"`
+	TotalAccountHashes         uint64 `codec:"hashes"`
+
+	// the resources counted so far for an account split across several chunks
+	AppParams                uint64         `codec:"appparams"`
+	AppLocalStates           uint64         `codec:"applocalstates"`
+	AssetParams              uint64         `codec:"assetparams"`
+	Assets                   uint64         `codec:"assets"`
+	ExpectingSpecificAccount bool           `codec:"expecting"`
+	NextExpectedAccount      basics.Address `codec:"next"`
+}
+
+// GetStagingProgress returns the number of catchpoint file entries staged so far and the staging progress, as stored by SetStagingProgress,
+// allowing a catchpoint catchup interrupted by a restart to resume staging from the first entry that was not staged yet
+func (c *catchpointCatchupAccessorImpl) GetStagingProgress(ctx context.Context) (staged uint64, progress CatchpointCatchupAccessorProgress, err error) {
+	encodedHeader, err := c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFileHeader)
+	if err != nil {
+		return 0, progress, fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFileHeader, err)
+	}
+	encodedProgress, err := c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupStagingProgress)
+	if err != nil {
+		return 0, progress, fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupStagingProgress, err)
+	}
+	if encodedHeader == "" || encodedProgress == "" {
+		// nothing was staged past the header, if at all.
+		return 0, progress, nil
+	}
+
+	var fileHeader CatchpointFileHeader
+	err = protocol.DecodeJSON([]byte(encodedHeader), &fileHeader)
+	if err != nil {
+		return 0, progress, fmt.Errorf("unable to decode catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFileHeader, err)
+	}
+	var stagingProgress catchpointStagingProgress
+	err = protocol.DecodeJSON([]byte(encodedProgress), &stagingProgress)
+	if err != nil {
+		return 0, progress, fmt.Errorf("unable to decode catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupStagingProgress, err)
+	}
+
+	progress.setHeader(&fileHeader)
+	progress.ProcessedAccounts = stagingProgress.ProcessedAccounts
+	progress.ProcessedBytes = stagingProgress.ProcessedBytes
+	progress.ProcessedKVs = stagingProgress.ProcessedKVs
+	progress.ProcessedOnlineAccounts = stagingProgress.ProcessedOnlineAccounts
+	progress.ProcessedOnlineRoundParams = stagingProgress.ProcessedOnlineRoundParams
+	progress.TotalAccountHashes = stagingProgress.TotalAccountHashes
+
+	c.acctResCnt = catchpointAccountResourceCounter{
+		totalAppParams:      stagingProgress.AppParams,
+		totalAppLocalStates: stagingProgress.AppLocalStates,
+		totalAssetParams:    stagingProgress.AssetParams,
+		totalAssets:         stagingProgress.Assets,
+	}
+	c.expectingSpecificAccount = stagingProgress.ExpectingSpecificAccount
+	c.nextExpectedAccount = stagingProgress.NextExpectedAccount
+
+	if progress.ProcessedAccounts < progress.TotalAccounts {
+		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
+	}
+	return stagingProgress.Staged, progress, nil
+}
+
+// SetStagingProgress stores the number of catchpoint file entries staged so far and the staging progress
+func (c *catchpointCatchupAccessorImpl) SetStagingProgress(ctx context.Context, staged uint64, progress *CatchpointCatchupAccessorProgress) (err error) {
+	stagingProgress := catchpointStagingProgress{
+		Staged:                     staged,
+		ProcessedAccounts:          progress.ProcessedAccounts,
+		ProcessedBytes:             progress.ProcessedBytes,
+		ProcessedKVs:               progress.ProcessedKVs,
+		ProcessedOnlineAccounts:    progress.ProcessedOnlineAccounts,
+		ProcessedOnlineRoundParams: progress.ProcessedOnlineRoundParams,
+		TotalAccountHashes:         progress.TotalAccountHashes,
+		AppParams:                  c.acctResCnt.totalAppParams,
+		AppLocalStates:             c.acctResCnt.totalAppLocalStates,
+		AssetParams:                c.acctResCnt.totalAssetParams,
+		Assets:                     c.acctResCnt.totalAssets,
+		ExpectingSpecificAccount:   c.expectingSpecificAccount,
+		NextExpectedAccount:        c.nextExpectedAccount,
+	}
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupStagingProgress, string(protocol.EncodeJSON(&stagingProgress)))
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupStagingProgress, err)
+	}
+	return
+}
+
+// ErrCatchpointChunkHashMismatch is returned by ProcessStagingBalances when a catchpoint file chunk doesn't match its hash
+// in the catchpoint file header. The chunk is rejected before anything gets staged, so the caller may retry it.
+var ErrCatchpointChunkHashMismatch = errors.New("catchpoint file chunk hash mismatch")
+
 // CatchpointCatchupAccessorProgress is used by the caller of ProcessStagingBalances to obtain progress information
 type CatchpointCatchupAccessorProgress struct {
 	TotalAccounts              uint64
@@ -382,6 +532,9 @@ type CatchpointCatchupAccessorProgress struct {
 	Version                    uint64
 	TotalAccountHashes         uint64
 
+	// ChunkHashes are the hashes of the chunk files listed by the header of catchpoint files since CatchpointFileVersionV9
+	ChunkHashes []crypto.Digest
+
 	// Having the cachedTrie here would help to accelerate the catchup process since the trie maintain an internal cache of nodes.
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
 	cachedTrie *merkletrie.Trie
@@ -400,6 +553,12 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 	if sectionName == CatchpointContentFileName {
 		return c.processStagingContent(ctx, bytes, progress)
 	}
+	if progress.Version >= CatchpointFileVersionV9 {
+		err = verifyCatchpointChunkHash(sectionName, bytes, progress.ChunkHashes)
+		if err != nil {
+			return err
+		}
+	}
 	if sectionName == catchpointSPVerificationFileName {
 		return c.processStagingStateProofVerificationContext(bytes)
 	}
@@ -411,6 +570,32 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 	return nil
 }
 
+// verifyCatchpointChunkHash checks the given catchpoint file chunk against its hash in the catchpoint file header.
+// The first hash covers the state proof verification context file, and the n-th hash covers balances.n.msgpack.
+func verifyCatchpointChunkHash(sectionName string, bytes []byte, chunkHashes []crypto.Digest) error {
+	var index uint64
+	switch {
+	case sectionName == catchpointSPVerificationFileName:
+		index = 0
+	case strings.HasPrefix(sectionName, catchpointBalancesFileNamePrefix) && strings.HasSuffix(sectionName, catchpointBalancesFileNameSuffix):
+		var err error
+		index, err = strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(sectionName, catchpointBalancesFileNamePrefix), catchpointBalancesFileNameSuffix), 10, 64)
+		if err != nil || index == 0 {
+			return fmt.Errorf("%w: invalid chunk name '%s'", ErrCatchpointChunkHashMismatch, sectionName)
+		}
+	default:
+		// undefined sections are ignored by ProcessStagingBalances, and aren't hashed.
+		return nil
+	}
+	if index >= uint64(len(chunkHashes)) {
+		return fmt.Errorf("%w: chunk '%s' is missing from the catchpoint file header", ErrCatchpointChunkHashMismatch, sectionName)
+	}
+	if crypto.Hash(bytes) != chunkHashes[index] {
+		return fmt.Errorf("%w: chunk '%s'", ErrCatchpointChunkHashMismatch, sectionName)
+	}
+	return nil
+}
+
 // processStagingStateProofVerificationContext deserialize the given bytes as a temporary staging state proof verification data
 func (c *catchpointCatchupAccessorImpl) processStagingStateProofVerificationContext(bytes []byte) (err error) {
 	var decodedData catchpointStateProofVerificationContext
@@ -447,6 +632,11 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 	case CatchpointFileVersionV6:
 	case CatchpointFileVersionV7:
 	case CatchpointFileVersionV8:
+	case CatchpointFileVersionV9:
+		// the chunk hashes cover the state proof verification context file as well as every balances file.
+		if uint64(len(fileHeader.ChunkHashes)) != fileHeader.TotalChunks+1 {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to process catchpoint - %d chunk hashes listed for %d chunks", len(fileHeader.ChunkHashes), fileHeader.TotalChunks)
+		}
 
 	default:
 		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to process catchpoint - version %d is not supported", fileHeader.Version)
@@ -482,24 +672,38 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 			}
 		}
 		err = aw.AccountsPutTotals(fileHeader.Totals, true)
+		if err != nil {
+			return err
+		}
+		// keep the header, and its chunk hashes, for resuming the staging after a restart.
+		err = cw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFileHeader, string(protocol.EncodeJSON(&fileHeader)))
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFileHeader, err)
+		}
 		return
 	})
 	ledgerProcessstagingcontentMicros.AddMicrosecondsSince(start, nil)
 	if err == nil {
-		progress.SeenHeader = true
-		progress.TotalAccounts = fileHeader.TotalAccounts
-		progress.TotalKVs = fileHeader.TotalKVs
-		progress.TotalOnlineAccounts = fileHeader.TotalOnlineAccounts
-		progress.TotalOnlineRoundParams = fileHeader.TotalOnlineRoundParams
-
-		progress.TotalChunks = fileHeader.TotalChunks
-		progress.Version = fileHeader.Version
+		progress.setHeader(&fileHeader)
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
 
 	return err
 }
 
+// setHeader sets the progress totals off the given catchpoint file header
+func (progress *CatchpointCatchupAccessorProgress) setHeader(fileHeader *CatchpointFileHeader) {
+	progress.SeenHeader = true
+	progress.TotalAccounts = fileHeader.TotalAccounts
+	progress.TotalKVs = fileHeader.TotalKVs
+	progress.TotalOnlineAccounts = fileHeader.TotalOnlineAccounts
+	progress.TotalOnlineRoundParams = fileHeader.TotalOnlineRoundParams
+
+	progress.TotalChunks = fileHeader.TotalChunks
+	progress.Version = fileHeader.Version
+	progress.ChunkHashes = fileHeader.ChunkHashes
+}
+
 // processStagingBalances deserialize the given bytes as a temporary staging balances
 func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
 	if !progress.SeenHeader {
@@ -542,6 +746,9 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 		fallthrough
 	case CatchpointFileVersionV8:
 		// V8 added online accounts and online round params data + hashes, and added them to the v6 chunk format
+		fallthrough
+	case CatchpointFileVersionV9:
+		// V9 added chunk hashes to the file header and switched to zstd compression, leaving the v6 chunk format unchanged
 		var chunk CatchpointSnapshotChunkV6
 		err = protocol.Decode(bytes, &chunk)
 		if err != nil {
@@ -1130,7 +1337,7 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 		catchpointLabelMaker = ledgercore.MakeCatchpointLabelMakerV6(blockRound, &blockDigest, &balancesHash, totals)
 	} else if version == CatchpointFileVersionV7 {
 		catchpointLabelMaker = ledgercore.MakeCatchpointLabelMakerV7(blockRound, &blockDigest, &balancesHash, totals, &spVerificationHash)
-	} else if version == CatchpointFileVersionV8 {
+	} else if version == CatchpointFileVersionV8 || version == CatchpointFileVersionV9 {
 		catchpointLabelMaker = ledgercore.MakeCatchpointLabelMakerCurrent(blockRound, &blockDigest, &balancesHash, totals, &spVerificationHash, &onlineAccountsHash, &onlineRoundParamsHash)
 	} else {
 		return fmt.Errorf("unable to verify catchpoint - version %d not supported", version)
@@ -1332,7 +1539,7 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
-		if catchpointFileVersion == CatchpointFileVersionV8 { // This catchpoint contains onlineaccounts and onlineroundparamstail tables.
+		if catchpointFileVersion >= CatchpointFileVersionV8 { // This catchpoint contains onlineaccounts and onlineroundparamstail tables.
 			// Upgrade to v7 (which adds the onlineaccounts & onlineroundparamstail tables, among others)
 			_, err = tx.RunMigrations(ctx, tp, c.ledger.log, 7)
 			if err != nil {
@@ -1371,6 +1578,11 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, "")
+		if err != nil {
+			return err
+		}
+
 		if hashRound != 0 {
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupHashRound, 0)
 			if err != nil {
@@ -278,8 +278,24 @@ func TestCatchupAccessorFoo(t *testing.T) {
 	require.Equal(t, calabel, label)
 	t.Logf("catchpoint label %#v", label)
 
+	cafile := "/var/lib/algorand/98.catchpoint"
+	err = catchpointAccessor.SetCatchpointFile(context.Background(), cafile)
+	require.NoError(t, err, "catchpointAccessor.SetCatchpointFile")
+
+	file, err := catchpointAccessor.GetCatchpointFile(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetCatchpointFile")
+	require.Equal(t, cafile, file)
+
 	err = catchpointAccessor.ResetStagingBalances(context.Background(), false)
 	require.NoError(t, err, "ResetStagingBalances")
+
+	// the label and catchpoint file are cleared once the catchup is over
+	label, err = catchpointAccessor.GetLabel(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetLabel")
+	require.Empty(t, label)
+	file, err = catchpointAccessor.GetCatchpointFile(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetCatchpointFile")
+	require.Empty(t, file)
 }
 
 func TestBuildMerkleTrie(t *testing.T) {
@@ -674,3 +690,79 @@ func TestCatchupAccessorProcessStagingBalances(t *testing.T) {
 		require.Equal(t, 1, count)
 	}
 }
+
+// TestCatchupAccessorStagingProgress checks the staging of a catchpoint file resumes after a restart, including an
+// account split across the chunks staged before and after the restart.
+func TestCatchupAccessorStagingProgress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	l, err := OpenLedger(log, t.Name(), true, genesisInitState, config.GetDefaultLocal())
+	require.NoError(t, err, "could not open ledger")
+	defer l.Close()
+
+	ctx := context.Background()
+	catchpointAccessor := MakeCatchpointCatchupAccessor(l, log)
+	require.NoError(t, catchpointAccessor.ResetStagingBalances(ctx, true))
+
+	// nothing was staged yet
+	staged, progress, err := catchpointAccessor.GetStagingProgress(ctx)
+	require.NoError(t, err)
+	require.Zero(t, staged)
+	require.False(t, progress.SeenHeader)
+
+	// the second account is split across both chunks
+	const numRes = 6
+	addrX := ledgertesting.RandomAddress()
+	acctX := trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: crypto.RandUint63()}, TotalAssets: numRes}
+	emptyRes := trackerdb.ResourcesData{ResourceFlags: trackerdb.ResourceFlagsEmptyAsset}
+	resources := []map[uint64]msgp.Raw{make(map[uint64]msgp.Raw), make(map[uint64]msgp.Raw)}
+	for i := 0; i < numRes; i++ {
+		resources[i%2][uint64(i+1)] = protocol.Encode(&emptyRes)
+	}
+	balanceRecord := func(addr basics.Address, base trackerdb.BaseAccountData, resources map[uint64]msgp.Raw, more bool) encoded.BalanceRecordV6 {
+		return encoded.BalanceRecordV6{Address: addr, AccountData: protocol.Encode(&base), Resources: resources, ExpectingMoreEntries: more}
+	}
+	chunks := [][]byte{
+		protocol.Encode(&CatchpointSnapshotChunkV6{Balances: []encoded.BalanceRecordV6{
+			balanceRecord(ledgertesting.RandomAddress(), trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 1}}, nil, false),
+			balanceRecord(addrX, acctX, resources[0], true),
+		}}),
+		protocol.Encode(&CatchpointSnapshotChunkV6{Balances: []encoded.BalanceRecordV6{
+			balanceRecord(addrX, acctX, resources[1], false),
+			balanceRecord(ledgertesting.RandomAddress(), trackerdb.BaseAccountData{MicroAlgos: basics.MicroAlgos{Raw: 2}}, nil, false),
+		}}),
+	}
+	fileHeader := CatchpointFileHeader{
+		Version:       CatchpointFileVersionV9,
+		TotalAccounts: 3,
+		TotalChunks:   uint64(len(chunks)),
+		ChunkHashes:   []crypto.Digest{{}, crypto.Hash(chunks[0]), crypto.Hash(chunks[1])},
+	}
+
+	require.NoError(t, catchpointAccessor.ProcessStagingBalances(ctx, CatchpointContentFileName, protocol.Encode(&fileHeader), &progress))
+	require.NoError(t, catchpointAccessor.ProcessStagingBalances(ctx, "balances.1.msgpack", chunks[0], &progress))
+	require.NoError(t, catchpointAccessor.SetStagingProgress(ctx, 2, &progress))
+
+	// a restarted node resumes staging from the third entry
+	resumedAccessor := MakeCatchpointCatchupAccessor(l, log)
+	staged, resumedProgress, err := resumedAccessor.GetStagingProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), staged)
+	require.True(t, resumedProgress.SeenHeader)
+	require.Equal(t, fileHeader.ChunkHashes, resumedProgress.ChunkHashes)
+	require.Equal(t, uint64(1), resumedProgress.ProcessedAccounts)
+	require.Equal(t, progress.TotalAccountHashes, resumedProgress.TotalAccountHashes)
+
+	require.NoError(t, resumedAccessor.ProcessStagingBalances(ctx, "balances.2.msgpack", chunks[1], &resumedProgress))
+	require.Equal(t, resumedProgress.TotalAccounts, resumedProgress.ProcessedAccounts)
+	require.Equal(t, uint64(3+numRes), resumedProgress.TotalAccountHashes)
+
+	// resetting the staging balances drops the staging progress
+	require.NoError(t, resumedAccessor.ResetStagingBalances(ctx, true))
+	staged, progress, err = resumedAccessor.GetStagingProgress(ctx)
+	require.NoError(t, err)
+	require.Zero(t, staged)
+	require.False(t, progress.SeenHeader)
+}
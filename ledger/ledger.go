@@ -383,9 +383,10 @@ func initBlocksDB(tx *sql.Tx, log logging.Logger, initBlocks []bookkeeping.Block
 
 	// in archival mode check if DB contains all blocks up to the latest
 	if isArchival {
-		earliest, err := blockdb.BlockEarliest(tx)
+		// the genesis block survives sparse retention, so the earliest block may precede a gap in the blocks.
+		earliest, err := blockdb.BlockContiguousEarliest(tx)
 		if err != nil {
-			err = fmt.Errorf("initBlocksDB.blockEarliest %v", err)
+			err = fmt.Errorf("initBlocksDB.blockContiguousEarliest %v", err)
 			return err
 		}
 
@@ -794,7 +795,9 @@ func (l *Ledger) BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreem
 func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) error {
 	// passing nil as the executionPool is ok since we've asking the evaluator to skip verification.
 
+	start := time.Now()
 	updates, err := eval.Eval(context.Background(), l, blk, false, l.verifiedTxnCache, nil, l.tracer)
+	ledgerBlockEvalSeconds.ObserveSince(start, ledgerBlockEvalApplyLabels)
 	if err != nil {
 		if errNSBE, ok := err.(ledgercore.ErrNonSequentialBlockEval); ok && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
 			return ledgercore.BlockInLedgerError{
@@ -976,7 +979,9 @@ func (l *Ledger) FlushCaches() {
 // not a valid block (e.g., it has duplicate transactions, overspends some
 // account, etc.).
 func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
+	start := time.Now()
 	delta, err := eval.Eval(ctx, l, blk, true, l.verifiedTxnCache, executionPool, l.tracer)
+	ledgerBlockEvalSeconds.ObserveSince(start, ledgerBlockEvalValidateLabels)
 	if err != nil {
 		return nil, err
 	}
@@ -985,6 +990,34 @@ func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionP
 	return &vb, nil
 }
 
+// PreverifyBlock verifies the transaction signatures and logicsigs of block blk, which does not need to be
+// the next block, and adds the verified transaction groups to the verified transactions cache. A subsequent
+// Validate of blk would only verify the transaction groups that are missing from the cache by then.
+// Since the logicsigs are verified against the blocks currently in the ledger, an error returned here does not
+// necessarily mean that blk is invalid.
+func (l *Ledger) PreverifyBlock(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) error {
+	if _, ok := config.Consensus[blk.CurrentProtocol]; !ok {
+		return protocol.Error(blk.CurrentProtocol)
+	}
+	paysetgroups, err := blk.DecodePaysetGroups()
+	if err != nil {
+		return err
+	}
+	txnGroups := make([][]transactions.SignedTxn, len(paysetgroups))
+	for i, group := range paysetgroups {
+		txnGroups[i] = make([]transactions.SignedTxn, len(group))
+		for j := range group {
+			txnGroups[i][j] = group[j].SignedTxn
+		}
+	}
+	specialAddresses := transactions.SpecialAddresses{
+		FeeSink:     blk.FeeSink,
+		RewardsPool: blk.RewardsPool,
+	}
+	txnGroups = l.verifiedTxnCache.GetUnverifiedTransactionGroups(txnGroups, specialAddresses, blk.CurrentProtocol)
+	return verify.PaysetGroups(ctx, txnGroups, blk.BlockHeader, executionPool, l.verifiedTxnCache, l)
+}
+
 // LatestTrackerCommitted returns the trackers' dbRound which "is always exactly accountsRound()"
 func (l *Ledger) LatestTrackerCommitted() basics.Round {
 	return l.trackers.getDbRound()
@@ -1010,3 +1043,7 @@ var ledgerVerifygenhashCount = metrics.NewCounter("ledger_verifygenhash_count",
 var ledgerVerifygenhashMicros = metrics.NewCounter("ledger_verifygenhash_micros", "µs spent")
 var ledgerTrackerMuLockCount = metrics.NewCounter("ledger_lock_trackermu_count", "calls")
 var ledgerTrackerMuLockMicros = metrics.NewCounter("ledger_lock_trackermu_micros", "µs spent")
+
+var ledgerBlockEvalSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_ledger_block_eval_seconds", Description: "Time spent evaluating a block, by whether it was validated or only applied"}, metrics.DefaultHistogramBuckets)
+var ledgerBlockEvalValidateLabels = map[string]string{"mode": "validate"}
+var ledgerBlockEvalApplyLabels = map[string]string{"mode": "apply"}
@@ -28,6 +28,7 @@ import (
 	"slices"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -399,6 +400,85 @@ func TestLedgerBlockHeaders(t *testing.T) {
 	a.NoError(l.appendUnvalidated(correctBlock), "could not add block with correct header")
 }
 
+func TestLedgerPreverifyBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, initSecrets := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	l, err := OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	initAccounts := genesisInitState.Accounts
+	var addrList []basics.Address
+	for addr := range initAccounts {
+		if addr != testPoolAddr && addr != testSinkAddr {
+			addrList = append(addrList, addr)
+		}
+	}
+
+	blk := makeNewEmptyBlock(t, l, t.Name(), initAccounts)
+	var stxns []transactions.SignedTxn
+	for i := 0; i < 3; i++ {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addrList[i],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  blk.Round(),
+				LastValid:   blk.Round() + 10,
+				GenesisID:   t.Name(),
+				GenesisHash: genesisInitState.GenesisHash,
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addrList[i+1],
+				Amount:   basics.MicroAlgos{Raw: 1000},
+			},
+		}
+		stxn := sign(initSecrets, tx)
+		stxns = append(stxns, stxn)
+		txib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+		require.NoError(t, err)
+		blk.Payset = append(blk.Payset, txib)
+		blk.TxnCounter++
+	}
+	require.NoError(t, endOfBlock(&blk))
+
+	txnGroups := make([][]transactions.SignedTxn, len(stxns))
+	for i := range stxns {
+		txnGroups[i] = []transactions.SignedTxn{stxns[i]}
+	}
+	specialAddresses := transactions.SpecialAddresses{FeeSink: blk.FeeSink, RewardsPool: blk.RewardsPool}
+	unverified := l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txnGroups, specialAddresses, blk.CurrentProtocol)
+	require.Len(t, unverified, len(txnGroups))
+
+	// a block with a bad signature fails the verification, and its transactions are not marked as verified
+	badBlk := blk
+	badBlk.Payset = append([]transactions.SignedTxnInBlock(nil), blk.Payset...)
+	badBlk.Payset[0].Sig[0]++
+	require.Error(t, l.PreverifyBlock(context.Background(), badBlk, backlogPool))
+	unverified = l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txnGroups[:1], specialAddresses, blk.CurrentProtocol)
+	require.Len(t, unverified, 1)
+
+	// the verified transactions are cached, and the block still validates
+	require.NoError(t, l.PreverifyBlock(context.Background(), blk, backlogPool))
+	unverified = l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txnGroups, specialAddresses, blk.CurrentProtocol)
+	require.Empty(t, unverified)
+
+	vb, err := l.Validate(context.Background(), blk, backlogPool)
+	require.NoError(t, err)
+	require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	require.Equal(t, blk.Round(), l.Latest())
+}
+
 func TestLedgerSingleTx(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -3337,6 +3417,63 @@ func TestLedgerCatchpointSPVerificationTracker(t *testing.T) {
 		numTrackedDataFirstCatchpoint, proto.StateProofInterval, true, spverDBLoc)
 }
 
+func TestLedgerCatchpointChunkHashes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	proto := config.Consensus[protocol.ConsensusFuture]
+
+	dbName := filepath.Join(t.TempDir(), t.Name())
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusFuture, 100)
+	genesisInitState.Block.CurrentProtocol = protocol.ConsensusFuture
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.CatchpointInterval = proto.StateProofInterval + proto.MaxBalLookback
+	cfg.MaxAcctLookback = 4
+	cfg.EnableCatchpointChunkHashes = true
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Info)
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	// Feeding blocks until we can know for sure we have at least one catchpoint written. The blocks are fed in
+	// two steps, as a commit spanning the first stage rounds of both catchpoints would only do the later one.
+	lastBlock := feedBlocksUntilRound(t, l, genesisInitState.Block, basics.Round(cfg.CatchpointInterval))
+	l.WaitForCommit(basics.Round(cfg.CatchpointInterval))
+	triggerTrackerFlush(t, l)
+	l.trackers.waitAccountsWriting()
+	feedBlocksUntilRound(t, l, lastBlock, basics.Round(cfg.CatchpointInterval*2))
+	l.WaitForCommit(basics.Round(cfg.CatchpointInterval * 2))
+	triggerTrackerFlush(t, l)
+	// the catchpoint file may be getting written by a commit already underway; it is recorded once complete
+	require.Eventually(t, func() bool {
+		var fileName string
+		err := l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+			cr, err := tx.MakeCatchpointReader()
+			if err != nil {
+				return err
+			}
+			fileName, _, _, err = cr.GetCatchpoint(ctx, basics.Round(cfg.CatchpointInterval))
+			return err
+		})
+		return err == nil && fileName != ""
+	}, 10*time.Second, 10*time.Millisecond)
+
+	relCatchpointFilePath := filepath.Join(dbName, trackerdb.CatchpointDirName, trackerdb.MakeCatchpointFilePath(basics.Round(cfg.CatchpointInterval)))
+	catchpointData := readCatchpointFile(t, relCatchpointFilePath)
+	require.Equal(t, CatchpointContentFileName, catchpointData[0].headerName)
+
+	var fileHeader CatchpointFileHeader
+	err = protocol.Decode(catchpointData[0].data, &fileHeader)
+	require.NoError(t, err)
+	require.Equal(t, CatchpointFileVersionV9, fileHeader.Version)
+	require.Len(t, fileHeader.ChunkHashes, int(fileHeader.TotalChunks)+1)
+	require.Len(t, catchpointData, len(fileHeader.ChunkHashes)+1)
+	for i, chunk := range catchpointData[1:] {
+		require.Equal(t, fileHeader.ChunkHashes[i], crypto.Hash(chunk.data), chunk.headerName)
+	}
+}
+
 func TestLedgerSPTrackerAfterReplay(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -127,106 +127,122 @@ func CatchpointCatchupStateMaxSize() (s int) {
 func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(11)
-	var zb0001Mask uint16 /* 12 bits */
+	zb0002Len := uint32(12)
+	var zb0002Mask uint16 /* 13 bits */
 	if (*z).Totals.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x2
+		zb0002Len--
+		zb0002Mask |= 0x2
 	}
 	if (*z).TotalAccounts == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x4
+		zb0002Len--
+		zb0002Mask |= 0x4
 	}
 	if (*z).BalancesRound.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x8
+		zb0002Len--
+		zb0002Mask |= 0x8
 	}
 	if (*z).BlockHeaderDigest.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x10
+		zb0002Len--
+		zb0002Mask |= 0x10
 	}
 	if (*z).BlocksRound.MsgIsZero() {
-		zb0001Len--
-		zb0001Mask |= 0x20
+		zb0002Len--
+		zb0002Mask |= 0x20
 	}
 	if (*z).Catchpoint == "" {
-		zb0001Len--
-		zb0001Mask |= 0x40
+		zb0002Len--
+		zb0002Mask |= 0x40
+	}
+	if len((*z).ChunkHashes) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x80
 	}
 	if (*z).TotalChunks == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x80
+		zb0002Len--
+		zb0002Mask |= 0x100
 	}
 	if (*z).TotalKVs == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x100
+		zb0002Len--
+		zb0002Mask |= 0x200
 	}
 	if (*z).TotalOnlineAccounts == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x200
+		zb0002Len--
+		zb0002Mask |= 0x400
 	}
 	if (*z).TotalOnlineRoundParams == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x400
+		zb0002Len--
+		zb0002Mask |= 0x800
 	}
 	if (*z).Version == 0 {
-		zb0001Len--
-		zb0001Mask |= 0x800
+		zb0002Len--
+		zb0002Mask |= 0x1000
 	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-	if zb0001Len != 0 {
-		if (zb0001Mask & 0x2) == 0 { // if not empty
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
 			// string "accountTotals"
 			o = append(o, 0xad, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73)
 			o = (*z).Totals.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x4) == 0 { // if not empty
+		if (zb0002Mask & 0x4) == 0 { // if not empty
 			// string "accountsCount"
 			o = append(o, 0xad, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalAccounts)
 		}
-		if (zb0001Mask & 0x8) == 0 { // if not empty
+		if (zb0002Mask & 0x8) == 0 { // if not empty
 			// string "balancesRound"
 			o = append(o, 0xad, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 			o = (*z).BalancesRound.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x10) == 0 { // if not empty
+		if (zb0002Mask & 0x10) == 0 { // if not empty
 			// string "blockHeaderDigest"
 			o = append(o, 0xb1, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74)
 			o = (*z).BlockHeaderDigest.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x20) == 0 { // if not empty
+		if (zb0002Mask & 0x20) == 0 { // if not empty
 			// string "blocksRound"
 			o = append(o, 0xab, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 			o = (*z).BlocksRound.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x40) == 0 { // if not empty
+		if (zb0002Mask & 0x40) == 0 { // if not empty
 			// string "catchpoint"
 			o = append(o, 0xaa, 0x63, 0x61, 0x74, 0x63, 0x68, 0x70, 0x6f, 0x69, 0x6e, 0x74)
 			o = msgp.AppendString(o, (*z).Catchpoint)
 		}
-		if (zb0001Mask & 0x80) == 0 { // if not empty
+		if (zb0002Mask & 0x80) == 0 { // if not empty
+			// string "chunkHashes"
+			o = append(o, 0xab, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73)
+			if (*z).ChunkHashes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).ChunkHashes)))
+			}
+			for zb0001 := range (*z).ChunkHashes {
+				o = (*z).ChunkHashes[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x100) == 0 { // if not empty
 			// string "chunksCount"
 			o = append(o, 0xab, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalChunks)
 		}
-		if (zb0001Mask & 0x100) == 0 { // if not empty
+		if (zb0002Mask & 0x200) == 0 { // if not empty
 			// string "kvsCount"
 			o = append(o, 0xa8, 0x6b, 0x76, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalKVs)
 		}
-		if (zb0001Mask & 0x200) == 0 { // if not empty
+		if (zb0002Mask & 0x400) == 0 { // if not empty
 			// string "onlineAccountsCount"
 			o = append(o, 0xb3, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalOnlineAccounts)
 		}
-		if (zb0001Mask & 0x400) == 0 { // if not empty
+		if (zb0002Mask & 0x800) == 0 { // if not empty
 			// string "onlineRoundParamsCount"
 			o = append(o, 0xb6, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalOnlineRoundParams)
 		}
-		if (zb0001Mask & 0x800) == 0 { // if not empty
+		if (zb0002Mask & 0x1000) == 0 { // if not empty
 			// string "version"
 			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendUint64(o, (*z).Version)
@@ -249,105 +265,144 @@ func (z *CatchpointFileHeader) UnmarshalMsgWithState(bts []byte, st msgp.Unmarsh
 	st.AllowableDepth--
 	var field []byte
 	_ = field
-	var zb0001 int
-	var zb0002 bool
-	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).Version, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Version")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			bts, err = (*z).BalancesRound.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "BalancesRound")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			bts, err = (*z).BlocksRound.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "BlocksRound")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			bts, err = (*z).Totals.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Totals")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).TotalAccounts, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "TotalAccounts")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).TotalChunks, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "TotalChunks")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).TotalKVs, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "TotalKVs")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).TotalOnlineAccounts, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "TotalOnlineAccounts")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			(*z).TotalOnlineRoundParams, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "TotalOnlineRoundParams")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Catchpoint")
+				return
+			}
+			if zb0004 > catchpointLabelMaxLen {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(catchpointLabelMaxLen))
+				return
+			}
 			(*z).Catchpoint, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Catchpoint")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			zb0001--
+		if zb0002 > 0 {
+			zb0002--
 			bts, err = (*z).BlockHeaderDigest.UnmarshalMsgWithState(bts, st)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "BlockHeaderDigest")
 				return
 			}
 		}
-		if zb0001 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0001)
+		if zb0002 > 0 {
+			zb0002--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ChunkHashes")
+				return
+			}
+			if zb0005 > ChunkHashesPerCatchpointFile {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(ChunkHashesPerCatchpointFile))
+				err = msgp.WrapError(err, "struct-from-array", "ChunkHashes")
+				return
+			}
+			if zb0006 {
+				(*z).ChunkHashes = nil
+			} else if (*z).ChunkHashes != nil && cap((*z).ChunkHashes) >= zb0005 {
+				(*z).ChunkHashes = ((*z).ChunkHashes)[:zb0005]
+			} else {
+				(*z).ChunkHashes = make([]crypto.Digest, zb0005)
+			}
+			for zb0001 := range (*z).ChunkHashes {
+				bts, err = (*z).ChunkHashes[zb0001].UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "ChunkHashes", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -358,11 +413,11 @@ func (z *CatchpointFileHeader) UnmarshalMsgWithState(bts []byte, st msgp.Unmarsh
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0002 {
+		if zb0003 {
 			(*z) = CatchpointFileHeader{}
 		}
-		for zb0001 > 0 {
-			zb0001--
+		for zb0002 > 0 {
+			zb0002--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -424,6 +479,16 @@ func (z *CatchpointFileHeader) UnmarshalMsgWithState(bts []byte, st msgp.Unmarsh
 					return
 				}
 			case "catchpoint":
+				var zb0007 int
+				zb0007, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Catchpoint")
+					return
+				}
+				if zb0007 > catchpointLabelMaxLen {
+					err = msgp.ErrOverflow(uint64(zb0007), uint64(catchpointLabelMaxLen))
+					return
+				}
 				(*z).Catchpoint, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Catchpoint")
@@ -435,6 +500,33 @@ func (z *CatchpointFileHeader) UnmarshalMsgWithState(bts []byte, st msgp.Unmarsh
 					err = msgp.WrapError(err, "BlockHeaderDigest")
 					return
 				}
+			case "chunkHashes":
+				var zb0008 int
+				var zb0009 bool
+				zb0008, zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ChunkHashes")
+					return
+				}
+				if zb0008 > ChunkHashesPerCatchpointFile {
+					err = msgp.ErrOverflow(uint64(zb0008), uint64(ChunkHashesPerCatchpointFile))
+					err = msgp.WrapError(err, "ChunkHashes")
+					return
+				}
+				if zb0009 {
+					(*z).ChunkHashes = nil
+				} else if (*z).ChunkHashes != nil && cap((*z).ChunkHashes) >= zb0008 {
+					(*z).ChunkHashes = ((*z).ChunkHashes)[:zb0008]
+				} else {
+					(*z).ChunkHashes = make([]crypto.Digest, zb0008)
+				}
+				for zb0001 := range (*z).ChunkHashes {
+					bts, err = (*z).ChunkHashes[zb0001].UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "ChunkHashes", zb0001)
+						return
+					}
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -458,20 +550,23 @@ func (_ *CatchpointFileHeader) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CatchpointFileHeader) Msgsize() (s int) {
-	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 20 + msgp.Uint64Size + 23 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize()
+	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 20 + msgp.Uint64Size + 23 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize() + 12 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).ChunkHashes {
+		s += (*z).ChunkHashes[zb0001].Msgsize()
+	}
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *CatchpointFileHeader) MsgIsZero() bool {
-	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).TotalOnlineAccounts == 0) && ((*z).TotalOnlineRoundParams == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero())
+	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).TotalOnlineAccounts == 0) && ((*z).TotalOnlineRoundParams == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero()) && (len((*z).ChunkHashes) == 0)
 }
 
 // MaxSize returns a maximum valid message size for this message type
 func CatchpointFileHeaderMaxSize() (s int) {
-	s = 1 + 8 + msgp.Uint64Size + 14 + basics.RoundMaxSize() + 12 + basics.RoundMaxSize() + 14 + ledgercore.AccountTotalsMaxSize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 20 + msgp.Uint64Size + 23 + msgp.Uint64Size + 11
-	panic("Unable to determine max size: String type z.Catchpoint is unbounded")
-	s += 18 + crypto.DigestMaxSize()
+	s = 1 + 8 + msgp.Uint64Size + 14 + basics.RoundMaxSize() + 12 + basics.RoundMaxSize() + 14 + ledgercore.AccountTotalsMaxSize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 20 + msgp.Uint64Size + 23 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + catchpointLabelMaxLen + 18 + crypto.DigestMaxSize() + 12
+	// Calculating size of slice: z.ChunkHashes
+	s += msgp.ArrayHeaderSize + ((ChunkHashesPerCatchpointFile) * (crypto.DigestMaxSize()))
 	return
 }
 
@@ -241,6 +241,52 @@ func BlockForgetBefore(tx *sql.Tx, rnd basics.Round) error {
 	return err
 }
 
+// BlockForgetRange removes block entries with round numbers in the range [from, to), except for those whose round
+// number is a multiple of one of the given intervals. Zero intervals are ignored.
+func BlockForgetRange(tx *sql.Tx, from, to basics.Round, keepEvery []uint64) error {
+	next, err := BlockNext(tx)
+	if err != nil {
+		return err
+	}
+
+	if to >= next {
+		return fmt.Errorf("forgetting too much: rnd %d >= next %d", to, next)
+	}
+
+	query := "DELETE FROM blocks WHERE rnd>=? AND rnd<?"
+	args := []interface{}{from, to}
+	for _, interval := range keepEvery {
+		if interval == 0 {
+			continue
+		}
+		query += " AND rnd%?!=0"
+		args = append(args, interval)
+	}
+	_, err = tx.Exec(query, args...)
+	return err
+}
+
+// BlockContiguousEarliest returns the lowest round number from which all the blocks up to the latest one are persisted.
+// It differs from BlockEarliest when older blocks were only partially removed by BlockForgetRange.
+func BlockContiguousEarliest(tx *sql.Tx) (basics.Round, error) {
+	var lastBeforeGap sql.NullInt64
+	err := tx.QueryRow("SELECT MAX(rnd) FROM blocks b WHERE rnd<(SELECT MAX(rnd) FROM blocks) AND NOT EXISTS (SELECT 1 FROM blocks c WHERE c.rnd=b.rnd+1)").Scan(&lastBeforeGap)
+	if err != nil {
+		return 0, err
+	}
+
+	if !lastBeforeGap.Valid {
+		return BlockEarliest(tx)
+	}
+
+	var earliest sql.NullInt64
+	err = tx.QueryRow("SELECT MIN(rnd) FROM blocks WHERE rnd>?", lastBeforeGap.Int64).Scan(&earliest)
+	if err != nil {
+		return 0, err
+	}
+	return basics.Round(earliest.Int64), nil
+}
+
 // BlockStartCatchupStaging initializes catchup for catchpoint
 func BlockStartCatchupStaging(tx *sql.Tx, blk bookkeeping.Block, cert agreement.Certificate) error {
 	// delete the old catchpointblocks table, if there is such.
@@ -26,6 +26,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/crypto"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
+	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
 	storetesting "github.com/DePINNetwork/depin-sdk/ledger/store/testing"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
@@ -174,3 +175,45 @@ func TestBlockDBAppend(t *testing.T) {
 		checkBlockDB(t, tx, blocks)
 	}
 }
+
+func TestBlockDBForgetRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 100)
+	err = BlockInit(tx, blockChainBlocks(blocks))
+	require.NoError(t, err)
+
+	contiguous, err := BlockContiguousEarliest(tx)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), contiguous)
+
+	err = BlockForgetRange(tx, 0, 100, nil)
+	require.Error(t, err)
+
+	// keep the multiples of 10 and 25 below round 60
+	err = BlockForgetRange(tx, 0, 60, []uint64{10, 0, 25})
+	require.NoError(t, err)
+	for rnd := basics.Round(0); rnd < 100; rnd++ {
+		_, err = BlockGetHdr(tx, rnd)
+		if rnd >= 60 || rnd%10 == 0 || rnd%25 == 0 {
+			require.NoError(t, err, "round %d", rnd)
+		} else {
+			require.ErrorAs(t, err, &ledgercore.ErrNoEntry{}, "round %d", rnd)
+		}
+	}
+
+	earliest, err := BlockEarliest(tx)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), earliest)
+	contiguous, err = BlockContiguousEarliest(tx)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(60), contiguous)
+}
@@ -69,6 +69,9 @@ const (
 	CatchpointStateCatchupState = CatchpointState("catchpointCatchupState")
 	// CatchpointStateCatchupLabel is the label to which the currently catchpoint catchup process is trying to catchup to.
 	CatchpointStateCatchupLabel = CatchpointState("catchpointCatchupLabel")
+	// CatchpointStateCatchupFile is the path of the local catchpoint file the currently catchpoint catchup process is loading the ledger from,
+	// or empty if the catchpoint file is downloaded from peers.
+	CatchpointStateCatchupFile = CatchpointState("catchpointCatchupFile")
 	// CatchpointStateCatchupBlockRound is the block round that is associated with the current running catchpoint catchup.
 	CatchpointStateCatchupBlockRound = CatchpointState("catchpointCatchupBlockRound")
 	// CatchpointStateCatchupBalancesRound is the balance round that is associated with the current running catchpoint catchup. Typically it would be
@@ -82,6 +85,12 @@ const (
 	CatchpointStateCatchpointLookback = CatchpointState("catchpointLookback")
 	// CatchpointStateCatchupVersion is the catchpoint version which the currently catchpoint catchup process is trying to catchup to.
 	CatchpointStateCatchupVersion = CatchpointState("catchpointCatchupVersion")
+	// CatchpointStateCatchupFileHeader is the header of the catchpoint file, including its chunk hashes, which the currently running catchpoint
+	// catchup process is staging. It's stored once the header is staged, so that the staging could resume after a restart.
+	CatchpointStateCatchupFileHeader = CatchpointState("catchpointCatchupFileHeader")
+	// CatchpointStateCatchupStagingProgress is the number of catchpoint file entries staged so far by the currently running catchpoint catchup
+	// process, along with the staging progress needed to resume staging from the next entry after a restart.
+	CatchpointStateCatchupStagingProgress = CatchpointState("catchpointCatchupStagingProgress")
 )
 
 // UnfinishedCatchpointRecord represents a stored record of an unfinished catchpoint.
@@ -133,6 +142,9 @@ type CatchpointFirstStageInfo struct {
 	TotalChunks uint64 `codec:"chunksCount"`
 	// BiggestChunkLen is the size in the bytes of the largest chunk, used when re-packing.
 	BiggestChunkLen uint64 `codec:"biggestChunk"`
+	// ChunkHashes holds the hashes of the chunks in the catchpoint data file, in the order they were written.
+	// Only set when catchpoint data files are generated.
+	ChunkHashes []crypto.Digest `codec:"chunkHashes,allocbound=-"`
 
 	// StateProofVerificationHash is the hash of the state proof verification data contained in the catchpoint data file.
 	StateProofVerificationHash crypto.Digest `codec:"spVerificationHash"`
@@ -154,7 +166,12 @@ func MakeCatchpointFilePath(round basics.Round) string {
 	return outStr
 }
 
-// RemoveSingleCatchpointFileFromDisk removes a single catchpoint file from the disk. this function does not leave empty directories
+// MakeCatchpointEntriesIndexPath builds the path of the index of the entries of the catchpoint file at catchpointFilePath.
+func MakeCatchpointEntriesIndexPath(catchpointFilePath string) string {
+	return catchpointFilePath + ".index"
+}
+
+// RemoveSingleCatchpointFileFromDisk removes a single catchpoint file from the disk, along with its entries index. this function does not leave empty directories
 func RemoveSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err error) {
 	absCatchpointFileName := filepath.Join(dbDirectory, fileToDelete)
 	err = os.Remove(absCatchpointFileName)
@@ -162,6 +179,10 @@ func RemoveSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err e
 		// we can't delete the file, abort -
 		return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", absCatchpointFileName, err)
 	}
+	err = os.Remove(MakeCatchpointEntriesIndexPath(absCatchpointFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to delete old catchpoint entries index '%s' : %v", absCatchpointFileName, err)
+	}
 	splitedDirName := strings.Split(fileToDelete, string(os.PathSeparator))
 
 	var subDirectoriesToScan []string
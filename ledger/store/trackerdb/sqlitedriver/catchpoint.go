@@ -594,7 +594,7 @@ func (cw *catchpointWriter) ApplyCatchpointStagingBalances(ctx context.Context,
 }
 
 // ApplyCatchpointStagingTablesV7 drops the existing onlineaccounts and onlineroundparamstail tables,
-// replacing them with data from the catchpoint staging tables. It should only be used for CatchpointFileVersionV8,
+// replacing them with data from the catchpoint staging tables. It should only be used for CatchpointFileVersionV8 and later,
 // after the ApplyCatchpointStagingBalances function has been run on DB v6, then upgraded to DB v7.
 func (cw *catchpointWriter) ApplyCatchpointStagingTablesV7(ctx context.Context) (err error) {
 	stmts := []string{
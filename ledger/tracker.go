@@ -650,6 +650,7 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 		}
 	})
 	ledgerCommitroundMicros.AddMicrosecondsSince(start, nil)
+	ledgerCommitroundSeconds.ObserveSince(start, nil)
 
 	if err != nil {
 
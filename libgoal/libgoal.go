@@ -358,6 +358,21 @@ type ListedAddress struct {
 	Multisig bool
 }
 
+// ListDerivedAddresses takes a wallet handle to a hierarchical deterministic
+// wallet and returns the addresses it generated, along with the derivation
+// path of each
+func (c *Client) ListDerivedAddresses(walletHandle []byte) ([]kmdapi.APIV1DerivedKey, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+	response, err := kmd.ListDerivedKeys(walletHandle)
+	if err != nil {
+		return nil, err
+	}
+	return response.Keys, nil
+}
+
 // DeleteAccount deletes an account.
 func (c *Client) DeleteAccount(walletHandle []byte, walletPassword []byte, addr string) error {
 	kmd, err := c.ensureKmdClient()
@@ -1138,6 +1153,15 @@ func (c *Client) Catchup(catchpointLabel string, min uint64) (model.CatchpointSt
 	return algod.Catchup(catchpointLabel, min)
 }
 
+// CatchupFromFile start catching up to the give catchpoint label, loading the ledger from the given local catchpoint file, or directory of catchpoint files.
+func (c *Client) CatchupFromFile(catchpointLabel string, min uint64, catchpointFile string) (model.CatchpointStartResponse, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return model.CatchpointStartResponse{}, err
+	}
+	return algod.CatchupFromFile(catchpointLabel, min, catchpointFile)
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1330,6 +1354,60 @@ func (c *Client) GetSyncRound() (rep model.GetSyncRoundResponse, err error) {
 	return
 }
 
+// Peers lists the peers the node is connected to
+func (c *Client) Peers() (rep model.PeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.Peers()
+	}
+	return
+}
+
+// ConnectPeer connects the node to the peer at address
+func (c *Client) ConnectPeer(address string) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ConnectPeer(address)
+	}
+	return
+}
+
+// DisconnectPeer closes the connection of the node with the peer at address
+func (c *Client) DisconnectPeer(address string) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.DisconnectPeer(address)
+	}
+	return
+}
+
+// PinnedPeers lists the peers pinned on the node
+func (c *Client) PinnedPeers() (rep model.PeerPinsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.PinnedPeers()
+	}
+	return
+}
+
+// PinPeer pins the peer at address for duration seconds, or until it is unpinned if duration is zero
+func (c *Client) PinPeer(address string, duration uint64) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.PinPeer(address, duration)
+	}
+	return
+}
+
+// UnpinPeer lifts the pin of the peer at address
+func (c *Client) UnpinPeer(address string) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.UnpinPeer(address)
+	}
+	return
+}
+
 // GetLedgerStateDelta gets the LedgerStateDelta on a node w/ EnableFollowMode
 func (c *Client) GetLedgerStateDelta(round uint64) (rep ledgercore.StateDelta, err error) {
 	algod, err := c.ensureAlgodClient()
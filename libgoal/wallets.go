@@ -26,10 +26,39 @@ import (
 
 const (
 	defaultWalletDriver = "sqlite"
+	hdWalletDriver      = "hd"
 )
 
 // CreateWallet creates a kmd wallet with the specified parameters
 func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDerivationKey) ([]byte, error) {
+	return c.createWallet(name, defaultWalletDriver, password, mdk)
+}
+
+// CreateHDWallet creates a hierarchical deterministic kmd wallet. Its master
+// derivation key is the entropy of a 24-word BIP-39 mnemonic, and its keys are
+// derived along BIP-44 paths. Use CreateHDWalletFromMnemonic to recover a
+// wallet from a shorter mnemonic.
+func (c *Client) CreateHDWallet(name []byte, password []byte, mdk crypto.MasterDerivationKey) ([]byte, error) {
+	return c.createWallet(name, hdWalletDriver, password, mdk)
+}
+
+// CreateHDWalletFromMnemonic recovers a hierarchical deterministic kmd wallet
+// from a BIP-39 mnemonic of 12, 15, 18, 21 or 24 words
+func (c *Client) CreateHDWalletFromMnemonic(name []byte, password []byte, mnemonic string) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.CreateWalletFromBIP39Mnemonic(name, hdWalletDriver, password, mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
+func (c *Client) createWallet(name []byte, driverName string, password []byte, mdk crypto.MasterDerivationKey) ([]byte, error) {
 	// Pull the list of all wallets from kmd
 	kmd, err := c.ensureKmdClient()
 	if err != nil {
@@ -37,7 +66,40 @@ func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDer
 	}
 
 	// Create the wallet
-	resp, err := kmd.CreateWallet(name, defaultWalletDriver, password, mdk)
+	resp, err := kmd.CreateWallet(name, driverName, password, mdk)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
+// BackupWallet returns an encrypted archive of everything stored in a kmd
+// wallet, which RestoreWallet can turn back into a wallet
+func (c *Client) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.BackupWallet(walletHandle, walletPassword, backupPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Backup, nil
+}
+
+// RestoreWallet creates a kmd wallet from an archive made by BackupWallet,
+// returning the new wallet's ID. If name is empty, the archived wallet name
+// is used.
+func (c *Client) RestoreWallet(name []byte, walletPassword []byte, archive []byte, backupPassword []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.RestoreWallet(name, walletPassword, archive, backupPassword)
 	if err != nil {
 		return nil, err
 	}
@@ -238,3 +300,18 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// ExportBIP39Mnemonic returns the BIP-39 mnemonic a hierarchical deterministic
+// wallet derives its keys from, or an empty string for other wallets
+func (c *Client) ExportBIP39Mnemonic(wh []byte, pw []byte) (string, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := kmd.ExportMasterDerivationKey(wh, pw)
+	if err != nil {
+		return "", err
+	}
+	return resp.BIP39Mnemonic, nil
+}
@@ -38,7 +38,7 @@ const logBufferDepth = 2
 
 // EnableTelemetry configures and enables telemetry based on the config provided
 func EnableTelemetryContext(ctx context.Context, cfg TelemetryConfig, l *logger) (err error) {
-	telemetry, err := makeTelemetryStateContext(ctx, cfg, createElasticHookContext)
+	telemetry, err := makeTelemetryStateContext(ctx, cfg, createTelemetrySinksHookContext)
 	if err != nil {
 		return
 	}
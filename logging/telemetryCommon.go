@@ -56,6 +56,8 @@ type TelemetryConfig struct {
 	Version            string       `json:"-"`
 	UserName           string
 	Password           string
+	// Sinks are additional destinations for telemetry events, beside the URI
+	Sinks []TelemetrySinkConfig `json:",omitempty"`
 }
 
 // MarshalingTelemetryConfig is used for json serialization of the TelemetryConfig
@@ -87,6 +87,9 @@ func (hook *telemetryFilteredHook) Fire(entry *logrus.Entry) error {
 	if _, has := entry.Data["v"]; !has {
 		newEntry = newEntry.WithField("v", hook.telemetryConfig.Version)
 	}
+	newEntry.Time = entry.Time
+	newEntry.Level = entry.Level
+	newEntry.Message = entry.Message
 	return hook.wrappedHook.Fire(newEntry)
 }
 
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const otlpLogsPath = "/v1/logs"
+const otlpRequestTimeout = 10 * time.Second
+
+// otlpLogHook sends each entry as an OTLP log record to an OTLP/HTTP collector
+type otlpLogHook struct {
+	client   *http.Client
+	url      string
+	resource *resourcepb.Resource
+	levels   []logrus.Level
+}
+
+func createOTLPLogHook(cfg TelemetryConfig, sink TelemetrySinkConfig) (*otlpLogHook, error) {
+	if sink.URI == "" {
+		return nil, errors.New("otlp telemetry sink has no URI")
+	}
+	url := strings.TrimSuffix(sink.URI, "/")
+	if !strings.HasSuffix(url, otlpLogsPath) {
+		url += otlpLogsPath
+	}
+	resource := &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{
+			otlpStringAttribute("service.name", "algod"),
+			otlpStringAttribute("host.id", cfg.getHostGUID()),
+		},
+	}
+	if cfg.ChainID != "" {
+		resource.Attributes = append(resource.Attributes, otlpStringAttribute("chain.id", cfg.ChainID))
+	}
+	if cfg.Version != "" {
+		resource.Attributes = append(resource.Attributes, otlpStringAttribute("service.version", cfg.Version))
+	}
+	return &otlpLogHook{
+		client:   &http.Client{Timeout: otlpRequestTimeout},
+		url:      url,
+		resource: resource,
+		levels:   makeLevels(cfg.MinLogLevel),
+	}, nil
+}
+
+// Fire is required to implement logrus hook interface
+func (hook *otlpLogHook) Fire(entry *logrus.Entry) error {
+	record := &logspb.LogRecord{
+		TimeUnixNano:         uint64(entry.Time.UnixNano()),
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       otlpSeverity(entry.Level),
+		SeverityText:         strings.ToUpper(entry.Level.String()),
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: entry.Message}},
+	}
+	for key, value := range entry.Data {
+		record.Attributes = append(record.Attributes, &commonpb.KeyValue{Key: key, Value: otlpValue(value)})
+	}
+	if category := telemetryEventCategory(entry); category != "" {
+		record.Attributes = append(record.Attributes, otlpStringAttribute("category", string(category)))
+	}
+
+	request := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource:  hook.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{LogRecords: []*logspb.LogRecord{record}}},
+		}},
+	}
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := hook.client.Post(hook.url, "application/x-protobuf", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("otlp collector at '%s' returned %s", hook.url, resp.Status)
+	}
+	return nil
+}
+
+// Levels Required for logrus hook interface
+func (hook *otlpLogHook) Levels() []logrus.Level {
+	return hook.levels
+}
+
+// Close releases the idle connections to the collector
+func (hook *otlpLogHook) Close() error {
+	hook.client.CloseIdleConnections()
+	return nil
+}
+
+func otlpSeverity(level logrus.Level) logspb.SeverityNumber {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	case logrus.ErrorLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case logrus.WarnLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case logrus.InfoLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case logrus.DebugLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE
+	}
+}
+
+func otlpStringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+// otlpValue converts a log field to an attribute value. Telemetry event details are structs, which are sent as JSON.
+func otlpValue(value interface{}) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case error:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Error()}}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(value)}}
+	}
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(data)}}
+}
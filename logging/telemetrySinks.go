@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/DePINNetwork/depin-sdk/logging/telemetryspec"
+)
+
+// Telemetry sink types
+const (
+	// TelemetrySinkElastic sends events to an ElasticSearch server, like the telemetry URI
+	TelemetrySinkElastic = "elastic"
+	// TelemetrySinkOTLP sends events as OTLP log records to an OTLP/HTTP collector
+	TelemetrySinkOTLP = "otlp"
+	// TelemetrySinkFile writes events as JSON lines to a rotating local file
+	TelemetrySinkFile = "file"
+)
+
+// defaultTelemetryFileSinkSize is the size after which a file sink is rotated when MaxFileSize is not set
+const defaultTelemetryFileSinkSize = 64 * 1024 * 1024
+
+// TelemetrySinkConfig configures an additional destination for telemetry events
+type TelemetrySinkConfig struct {
+	// Type is one of TelemetrySinkElastic, TelemetrySinkOTLP or TelemetrySinkFile
+	Type string
+	// URI is the ElasticSearch URI or the OTLP/HTTP collector URL, e.g. http://localhost:4318
+	URI string `json:",omitempty"`
+	// Path is the file the events are written to by a file sink
+	Path string `json:",omitempty"`
+	// ArchivePath is a text/template for the name the file is archived with when it is rotated,
+	// see LogArchiveName in the node config. It defaults to Path with an ".archive" suffix.
+	ArchivePath string `json:",omitempty"`
+	// MaxFileSize is the size in bytes after which the file is rotated
+	MaxFileSize uint64 `json:",omitempty"`
+	// MaxArchiveAge is the age, parsed by time.ParseDuration, after which archived files are deleted
+	MaxArchiveAge string `json:",omitempty"`
+	// Categories restricts the sink to telemetry events of these categories. Log entries reported to telemetry,
+	// which have no category, are only sent to sinks with no Categories.
+	Categories []telemetryspec.Category `json:",omitempty"`
+}
+
+// createTelemetrySinksHookContext creates the hook sending events to the telemetry URI and to each of the configured
+// sinks. It returns nil if there is nowhere to send events to, so that the hook is created once the URI is known.
+func createTelemetrySinksHookContext(ctx context.Context, cfg TelemetryConfig) (hook logrus.Hook, err error) {
+	var hooks []logrus.Hook
+	defer func() {
+		if err != nil {
+			closeHooks(hooks)
+		}
+	}()
+
+	if cfg.URI != "" {
+		hook, err = createElasticHookContext(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+	for _, sink := range cfg.Sinks {
+		hook, err = createTelemetrySinkHookContext(ctx, cfg, sink)
+		if err != nil {
+			return nil, err
+		}
+		if len(sink.Categories) > 0 {
+			hook = &categoryFilterHook{wrappedHook: hook, categories: sink.Categories}
+		}
+		hooks = append(hooks, hook)
+	}
+
+	switch len(hooks) {
+	case 0:
+		return nil, nil
+	case 1:
+		return hooks[0], nil
+	default:
+		return &fanoutHook{hooks: hooks, levels: makeLevels(cfg.MinLogLevel)}, nil
+	}
+}
+
+func createTelemetrySinkHookContext(ctx context.Context, cfg TelemetryConfig, sink TelemetrySinkConfig) (logrus.Hook, error) {
+	switch sink.Type {
+	case TelemetrySinkElastic:
+		if sink.URI == "" {
+			return nil, errors.New("elastic telemetry sink has no URI")
+		}
+		elasticCfg := cfg
+		elasticCfg.URI = sink.URI
+		return createElasticHookContext(ctx, elasticCfg)
+	case TelemetrySinkOTLP:
+		return createOTLPLogHook(cfg, sink)
+	case TelemetrySinkFile:
+		return createFileSinkHook(cfg, sink)
+	default:
+		return nil, fmt.Errorf("unknown telemetry sink type '%s'", sink.Type)
+	}
+}
+
+func closeHooks(hooks []logrus.Hook) {
+	for _, hook := range hooks {
+		if closer, ok := hook.(io.Closer); ok {
+			closer.Close()
+		}
+	}
+}
+
+// telemetryEventCategory returns the category of a telemetry event, or an empty string for log entries
+func telemetryEventCategory(entry *logrus.Entry) telemetryspec.Category {
+	if !strings.HasPrefix(entry.Message, telemetryPrefix) {
+		return ""
+	}
+	category, _, _ := strings.Cut(entry.Message[len(telemetryPrefix):], telemetrySeparator)
+	return telemetryspec.Category(category)
+}
+
+// fanoutHook sends each entry to all of its hooks
+type fanoutHook struct {
+	hooks  []logrus.Hook
+	levels []logrus.Level
+}
+
+// Fire is required to implement logrus hook interface
+func (hook *fanoutHook) Fire(entry *logrus.Entry) error {
+	var errs []error
+	for _, h := range hook.hooks {
+		if !slices.Contains(h.Levels(), entry.Level) {
+			continue
+		}
+		if err := h.Fire(entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Levels Required for logrus hook interface
+func (hook *fanoutHook) Levels() []logrus.Level {
+	return hook.levels
+}
+
+// Close closes the hooks that need to be closed
+func (hook *fanoutHook) Close() error {
+	closeHooks(hook.hooks)
+	return nil
+}
+
+// categoryFilterHook only passes telemetry events of the given categories to the wrapped hook
+type categoryFilterHook struct {
+	wrappedHook logrus.Hook
+	categories  []telemetryspec.Category
+}
+
+// Fire is required to implement logrus hook interface
+func (hook *categoryFilterHook) Fire(entry *logrus.Entry) error {
+	if !slices.Contains(hook.categories, telemetryEventCategory(entry)) {
+		return nil
+	}
+	return hook.wrappedHook.Fire(entry)
+}
+
+// Levels Required for logrus hook interface
+func (hook *categoryFilterHook) Levels() []logrus.Level {
+	return hook.wrappedHook.Levels()
+}
+
+// Close closes the wrapped hook if it needs to be closed
+func (hook *categoryFilterHook) Close() error {
+	closeHooks([]logrus.Hook{hook.wrappedHook})
+	return nil
+}
+
+// fileSinkHook writes entries as JSON lines to a rotating file
+type fileSinkHook struct {
+	writer    *CyclicFileWriter
+	formatter logrus.JSONFormatter
+	host      string
+	levels    []logrus.Level
+}
+
+func createFileSinkHook(cfg TelemetryConfig, sink TelemetrySinkConfig) (*fileSinkHook, error) {
+	if sink.Path == "" {
+		return nil, errors.New("file telemetry sink has no path")
+	}
+	archivePath := sink.ArchivePath
+	if archivePath == "" {
+		archivePath = sink.Path + ".archive"
+	}
+	maxFileSize := sink.MaxFileSize
+	if maxFileSize == 0 {
+		maxFileSize = defaultTelemetryFileSinkSize
+	}
+	var maxArchiveAge time.Duration
+	if sink.MaxArchiveAge != "" {
+		var err error
+		maxArchiveAge, err = time.ParseDuration(sink.MaxArchiveAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MaxArchiveAge of file telemetry sink: %w", err)
+		}
+	}
+	// MakeCyclicFileWriter panics when it cannot open the file so check first
+	f, err := os.OpenFile(sink.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file telemetry sink: %w", err)
+	}
+	f.Close()
+
+	return &fileSinkHook{
+		writer:    MakeCyclicFileWriter(sink.Path, archivePath, maxFileSize, maxArchiveAge),
+		formatter: logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano},
+		host:      cfg.getHostGUID(),
+		levels:    makeLevels(cfg.MinLogLevel),
+	}, nil
+}
+
+// Fire is required to implement logrus hook interface
+func (hook *fileSinkHook) Fire(entry *logrus.Entry) error {
+	newEntry := entry.WithField("host", hook.host)
+	newEntry.Time = entry.Time
+	newEntry.Level = entry.Level
+	newEntry.Message = entry.Message
+	line, err := hook.formatter.Format(newEntry)
+	if err != nil {
+		return err
+	}
+	_, err = hook.writer.Write(line)
+	return err
+}
+
+// Levels Required for logrus hook interface
+func (hook *fileSinkHook) Levels() []logrus.Level {
+	return hook.levels
+}
+
+// Close closes the file
+func (hook *fileSinkHook) Close() error {
+	return hook.writer.Close()
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/DePINNetwork/depin-sdk/logging/telemetryspec"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func makeTelemetrySinksTestState(t *testing.T, cfg TelemetryConfig) (*telemetryState, logger) {
+	cfg.Enable = true
+	cfg.MinLogLevel = logrus.InfoLevel
+	cfg.ReportHistoryLevel = logrus.ErrorLevel
+	telem, err := makeTelemetryStateContext(context.Background(), cfg, createTelemetrySinksHookContext)
+	require.NoError(t, err)
+	l := NewLogger().(logger)
+	l.SetOutput(io.Discard)
+	l.SetLevel(Debug)
+	enableTelemetryState(telem, &l)
+	return telem, l
+}
+
+func readJSONLines(t *testing.T, path string) []map[string]interface{} {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestTelemetryFileSinks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dir := t.TempDir()
+	allPath := filepath.Join(dir, "all.json")
+	agreementPath := filepath.Join(dir, "agreement.json")
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{
+		{Type: TelemetrySinkFile, Path: allPath},
+		{Type: TelemetrySinkFile, Path: agreementPath, Categories: []telemetryspec.Category{telemetryspec.Agreement}},
+	}
+	telem, l := makeTelemetrySinksTestState(t, cfg)
+
+	telem.logEvent(l, telemetryspec.ApplicationState, telemetryspec.StartupEvent, telemetryspec.StartupEventDetails{Branch: "test"})
+	telem.logEvent(l, telemetryspec.Agreement, telemetryspec.BlockAcceptedEvent, nil)
+	l.Warn("not an event")
+	telem.hook.Close()
+
+	all := readJSONLines(t, allPath)
+	a.Len(all, 3)
+	a.Equal(buildMessage(string(telemetryspec.ApplicationState), string(telemetryspec.StartupEvent)), all[0]["msg"])
+	a.Equal("test", all[0]["details"].(map[string]interface{})["Branch"])
+	a.Equal(cfg.getHostGUID(), all[0]["host"])
+	a.Equal(buildMessage(string(telemetryspec.Agreement), string(telemetryspec.BlockAcceptedEvent)), all[1]["msg"])
+	a.Equal("not an event", all[2]["msg"])
+	a.Equal("warning", all[2]["level"])
+
+	agreement := readJSONLines(t, agreementPath)
+	a.Len(agreement, 1)
+	a.Equal(buildMessage(string(telemetryspec.Agreement), string(telemetryspec.BlockAcceptedEvent)), agreement[0]["msg"])
+}
+
+func TestTelemetryFileSinkRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.json")
+	hook, err := createFileSinkHook(createTelemetryConfig(), TelemetrySinkConfig{Type: TelemetrySinkFile, Path: path, MaxFileSize: 200})
+	a.NoError(err)
+	defer hook.Close()
+
+	logger := logrus.New()
+	for i := 0; i < 5; i++ {
+		a.NoError(hook.Fire(logrus.NewEntry(logger).WithField("i", i)))
+	}
+	a.FileExists(path + ".archive")
+	a.Less(len(readJSONLines(t, path)), 5)
+}
+
+func TestTelemetryOTLPSink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var mu deadlock.Mutex
+	var records []*logspb.LogRecord
+	var resourceAttrs map[string]string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != otlpLogsPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request collogspb.ExportLogsServiceRequest
+		if err := proto.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rl := range request.ResourceLogs {
+			resourceAttrs = make(map[string]string)
+			for _, kv := range rl.Resource.Attributes {
+				resourceAttrs[kv.Key] = kv.Value.GetStringValue()
+			}
+			for _, sl := range rl.ScopeLogs {
+				records = append(records, sl.LogRecords...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.ChainID = "test-v1"
+	cfg.Sinks = []TelemetrySinkConfig{{Type: TelemetrySinkOTLP, URI: collector.URL}}
+	telem, l := makeTelemetrySinksTestState(t, cfg)
+
+	telem.logEvent(l, telemetryspec.ApplicationState, telemetryspec.StartupEvent, telemetryspec.StartupEventDetails{Branch: "test"})
+	l.Error("something failed")
+	telem.hook.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	// errors are preceded by their stack trace
+	a.Len(records, 3)
+	a.Equal("algod", resourceAttrs["service.name"])
+	a.Equal(cfg.getHostGUID(), resourceAttrs["host.id"])
+	a.Equal("test-v1", resourceAttrs["chain.id"])
+
+	a.Equal(buildMessage(string(telemetryspec.ApplicationState), string(telemetryspec.StartupEvent)), records[0].Body.GetStringValue())
+	a.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_INFO, records[0].SeverityNumber)
+	attrs := make(map[string]string)
+	for _, kv := range records[0].Attributes {
+		attrs[kv.Key] = kv.Value.GetStringValue()
+	}
+	a.Equal(string(telemetryspec.ApplicationState), attrs["category"])
+	a.Contains(attrs["details"], `"Branch":"test"`)
+
+	a.Equal("something failed", records[2].Body.GetStringValue())
+	a.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, records[2].SeverityNumber)
+}
+
+func TestTelemetrySinkErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	cfg := createTelemetryConfig()
+	hook, err := createTelemetrySinksHookContext(context.Background(), cfg)
+	a.NoError(err)
+	a.Nil(hook)
+
+	for _, sink := range []TelemetrySinkConfig{
+		{Type: "syslog"},
+		{Type: TelemetrySinkElastic},
+		{Type: TelemetrySinkOTLP},
+		{Type: TelemetrySinkFile},
+		{Type: TelemetrySinkFile, Path: filepath.Join(t.TempDir(), "missing", "events.json")},
+		{Type: TelemetrySinkFile, Path: filepath.Join(t.TempDir(), "events.json"), MaxArchiveAge: "a week"},
+	} {
+		cfg.Sinks = []TelemetrySinkConfig{sink}
+		_, err = createTelemetrySinksHookContext(context.Background(), cfg)
+		a.Error(err, "%+v", sink)
+	}
+}
+
+func TestTelemetrySinksConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	configPath := filepath.Join(t.TempDir(), TelemetryConfigFilename)
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{
+		{Type: TelemetrySinkOTLP, URI: "http://localhost:4318"},
+		{Type: TelemetrySinkFile, Path: "/tmp/telemetry.json", MaxArchiveAge: "72h", Categories: []telemetryspec.Category{telemetryspec.Agreement, telemetryspec.Network}},
+	}
+	a.NoError(cfg.Save(configPath))
+
+	loaded, err := LoadTelemetryConfig(configPath)
+	a.NoError(err)
+	a.Equal(cfg.Sinks, loaded.Sinks)
+}
@@ -181,6 +181,9 @@ func (hook *asyncTelemetryHook) Close() {
 	hook.wg.Add(1)
 	close(hook.quit)
 	hook.wg.Wait()
+	if tfh, ok := hook.wrappedHook.(*telemetryFilteredHook); ok && tfh.wrappedHook != nil {
+		closeHooks([]logrus.Hook{tfh.wrappedHook})
+	}
 }
 
 func (hook *asyncTelemetryHook) Flush() {
@@ -295,6 +298,9 @@ func (hook *asyncTelemetryHook) UpdateHookURI(uri string) (err error) {
 		newHook, err = tfh.factory(context.Background(), copy)
 
 		if err == nil && newHook != nil {
+			if tfh.wrappedHook != nil {
+				closeHooks([]logrus.Hook{tfh.wrappedHook})
+			}
 			tfh.wrappedHook = newHook
 			tfh.telemetryConfig.URI = uri
 			hook.ready = true
@@ -0,0 +1,291 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// dictionaryGenerator produces the zstd dictionaries embedded into the network package
+// for per-tag message compression. It synthesizes msgpack-encoded samples shaped like
+// the transactions and votes seen on the wire and trains a dictionary from them with
+// the zstd command line tool.
+//
+// Usage (from the repository root):
+//
+//	go run ./network/dictionaries/dictionaryGenerator -o ./network/dictionaries
+//
+// Changing a dictionary changes its identifier and therefore stops compression with
+// peers that still have the previous one, so dictionaries should be regenerated rarely.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/committee"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+var outputDir = flag.String("o", "", "Directory where the generated dictionaries would go to.")
+var samplesCount = flag.Int("n", 20000, "Number of samples to train each dictionary on.")
+var dictSize = flag.Int("size", 16*1024, "Maximal dictionary size in bytes.")
+var seed = flag.Int64("seed", 1, "Random seed used to synthesize samples.")
+var zstdBinary = flag.String("zstd", "zstd", "Path to the zstd executable.")
+
+// the following types mirror the agreement wire format (agreement.unauthenticatedVote and
+// agreement.unauthenticatedBundle) which is not exported by the agreement package.
+type proposalValue struct {
+	_struct          struct{}       `codec:",omitempty,omitemptyarray"`
+	OriginalPeriod   uint64         `codec:"oper"`
+	OriginalProposer basics.Address `codec:"oprop"`
+	BlockDigest      crypto.Digest  `codec:"dig"`
+	EncodingDigest   crypto.Digest  `codec:"encdig"`
+}
+
+type rawVote struct {
+	_struct  struct{}       `codec:",omitempty,omitemptyarray"`
+	Sender   basics.Address `codec:"snd"`
+	Round    basics.Round   `codec:"rnd"`
+	Period   uint64         `codec:"per"`
+	Step     uint64         `codec:"step"`
+	Proposal proposalValue  `codec:"prop"`
+}
+
+type unauthenticatedVote struct {
+	_struct struct{}                            `codec:",omitempty,omitemptyarray"`
+	R       rawVote                             `codec:"r"`
+	Cred    committee.UnauthenticatedCredential `codec:"cred"`
+	Sig     crypto.OneTimeSignature             `codec:"sig,omitempty,omitemptycheckstruct"`
+}
+
+type voteAuthenticator struct {
+	_struct struct{}                            `codec:",omitempty,omitemptyarray"`
+	Sender  basics.Address                      `codec:"snd"`
+	Cred    committee.UnauthenticatedCredential `codec:"cred"`
+	Sig     crypto.OneTimeSignature             `codec:"sig,omitempty,omitemptycheckstruct"`
+}
+
+type unauthenticatedBundle struct {
+	_struct  struct{}            `codec:",omitempty,omitemptyarray"`
+	Round    basics.Round        `codec:"rnd"`
+	Period   uint64              `codec:"per"`
+	Step     uint64              `codec:"step"`
+	Proposal proposalValue       `codec:"prop"`
+	Votes    []voteAuthenticator `codec:"vote"`
+}
+
+// printExit prints the given formatted string ( i.e. just like fmt.Printf ), with the dictionaryGenerator executable program name
+// at the beginning, and exit the process with a error code of 1.
+func printExit(fmtStr string, args ...interface{}) {
+	fmt.Printf("%s: "+fmtStr, append([]interface{}{filepath.Base(os.Args[0])}, args...)...)
+	os.Exit(1)
+}
+
+type sampler struct {
+	rng       *rand.Rand
+	addresses []basics.Address
+	genesis   []struct {
+		id   string
+		hash crypto.Digest
+	}
+}
+
+func makeSampler(seed int64) *sampler {
+	s := &sampler{rng: rand.New(rand.NewSource(seed))}
+	// a limited pool of addresses makes the samples resemble a real network where
+	// a small set of accounts is responsible for most of the traffic.
+	s.addresses = make([]basics.Address, 512)
+	for i := range s.addresses {
+		s.rng.Read(s.addresses[i][:])
+	}
+	for _, id := range []string{"mainnet-v1.0", "testnet-v1.0", "betanet-v1.0"} {
+		g := struct {
+			id   string
+			hash crypto.Digest
+		}{id: id}
+		s.rng.Read(g.hash[:])
+		s.genesis = append(s.genesis, g)
+	}
+	return s
+}
+
+func (s *sampler) address() basics.Address {
+	return s.addresses[s.rng.Intn(len(s.addresses))]
+}
+
+func (s *sampler) round() basics.Round {
+	return basics.Round(40_000_000 + s.rng.Intn(10_000_000))
+}
+
+func (s *sampler) bytes(n int) []byte {
+	b := make([]byte, n)
+	s.rng.Read(b)
+	return b
+}
+
+func (s *sampler) signature() crypto.OneTimeSignature {
+	var sig crypto.OneTimeSignature
+	s.rng.Read(sig.Sig[:])
+	s.rng.Read(sig.PK[:])
+	s.rng.Read(sig.PK2[:])
+	s.rng.Read(sig.PK1Sig[:])
+	s.rng.Read(sig.PK2Sig[:])
+	return sig
+}
+
+func (s *sampler) credential() committee.UnauthenticatedCredential {
+	var cred committee.UnauthenticatedCredential
+	s.rng.Read(cred.Proof[:])
+	return cred
+}
+
+func (s *sampler) proposal() proposalValue {
+	pv := proposalValue{OriginalProposer: s.address()}
+	s.rng.Read(pv.BlockDigest[:])
+	s.rng.Read(pv.EncodingDigest[:])
+	if s.rng.Intn(10) == 0 {
+		pv.OriginalPeriod = uint64(s.rng.Intn(3))
+	}
+	return pv
+}
+
+func (s *sampler) txn() []byte {
+	first := s.round()
+	g := s.genesis[s.rng.Intn(len(s.genesis))]
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Header: transactions.Header{
+				Sender:      s.address(),
+				Fee:         basics.MicroAlgos{Raw: 1000 + uint64(s.rng.Intn(4))*1000},
+				FirstValid:  first,
+				LastValid:   first + 1000,
+				GenesisID:   g.id,
+				GenesisHash: g.hash,
+			},
+		},
+	}
+	s.rng.Read(stxn.Sig[:])
+	if s.rng.Intn(4) == 0 {
+		stxn.Txn.Note = s.bytes(1 + s.rng.Intn(64))
+	}
+	if s.rng.Intn(3) == 0 {
+		s.rng.Read(stxn.Txn.Group[:])
+	}
+	switch s.rng.Intn(10) {
+	case 0, 1, 2, 3:
+		stxn.Txn.Type = protocol.PaymentTx
+		stxn.Txn.Receiver = s.address()
+		stxn.Txn.Amount = basics.MicroAlgos{Raw: uint64(s.rng.Int63n(1_000_000_000))}
+	case 4, 5, 6:
+		stxn.Txn.Type = protocol.AssetTransferTx
+		stxn.Txn.XferAsset = basics.AssetIndex(s.rng.Int63n(3_000_000_000))
+		stxn.Txn.AssetAmount = uint64(s.rng.Int63n(1_000_000_000))
+		stxn.Txn.AssetReceiver = s.address()
+	case 7, 8:
+		stxn.Txn.Type = protocol.ApplicationCallTx
+		stxn.Txn.ApplicationID = basics.AppIndex(s.rng.Int63n(3_000_000_000))
+		for i := s.rng.Intn(4); i > 0; i-- {
+			stxn.Txn.ApplicationArgs = append(stxn.Txn.ApplicationArgs, s.bytes(4+s.rng.Intn(28)))
+		}
+		for i := s.rng.Intn(3); i > 0; i-- {
+			stxn.Txn.Accounts = append(stxn.Txn.Accounts, s.address())
+		}
+		for i := s.rng.Intn(3); i > 0; i-- {
+			stxn.Txn.ForeignAssets = append(stxn.Txn.ForeignAssets, basics.AssetIndex(s.rng.Int63n(3_000_000_000)))
+		}
+	default:
+		stxn.Txn.Type = protocol.KeyRegistrationTx
+		s.rng.Read(stxn.Txn.VotePK[:])
+		s.rng.Read(stxn.Txn.SelectionPK[:])
+		s.rng.Read(stxn.Txn.StateProofPK[:])
+		stxn.Txn.VoteFirst = first
+		stxn.Txn.VoteLast = first + 3_000_000
+		stxn.Txn.VoteKeyDilution = 1733
+	}
+	return protocol.Encode(&stxn)
+}
+
+func (s *sampler) vote() []byte {
+	uv := unauthenticatedVote{
+		R: rawVote{
+			Sender:   s.address(),
+			Round:    s.round(),
+			Step:     uint64(s.rng.Intn(4)),
+			Proposal: s.proposal(),
+		},
+		Cred: s.credential(),
+		Sig:  s.signature(),
+	}
+	return protocol.EncodeReflect(&uv)
+}
+
+func (s *sampler) bundle() []byte {
+	ub := unauthenticatedBundle{
+		Round:    s.round(),
+		Period:   uint64(s.rng.Intn(3)),
+		Step:     2 + uint64(s.rng.Intn(8)),
+		Proposal: s.proposal(),
+	}
+	for i := 2 + s.rng.Intn(16); i > 0; i-- {
+		ub.Votes = append(ub.Votes, voteAuthenticator{Sender: s.address(), Cred: s.credential(), Sig: s.signature()})
+	}
+	return protocol.EncodeReflect(&ub)
+}
+
+// train writes samples into a temporary directory and trains a dictionary from them.
+func train(name string, sample func() []byte) {
+	samplesDir, err := os.MkdirTemp("", "dictionary-"+name)
+	if err != nil {
+		printExit("Unable to create samples directory : %v\n", err)
+	}
+	defer os.RemoveAll(samplesDir)
+
+	for i := 0; i < *samplesCount; i++ {
+		err = os.WriteFile(filepath.Join(samplesDir, fmt.Sprintf("%d.msgp", i)), sample(), 0644)
+		if err != nil {
+			printExit("Unable to write sample : %v\n", err)
+		}
+	}
+
+	outputFile := filepath.Join(*outputDir, name+".zdict")
+	cmd := exec.Command(*zstdBinary, "--train", "-q", "-f", "-r", samplesDir, "-o", outputFile, fmt.Sprintf("--maxdict=%d", *dictSize))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		printExit("Unable to train %s dictionary : %v\n", name, err)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if *outputDir == "" {
+		printExit("one or more of the required input arguments was not provided\n")
+	}
+
+	s := makeSampler(*seed)
+	train("txn", s.txn)
+	train("vote", func() []byte {
+		// bundles are far less frequent than votes but much larger, keep both shapes in the training set
+		if s.rng.Intn(8) == 0 {
+			return s.bundle()
+		}
+		return s.vote()
+	})
+}
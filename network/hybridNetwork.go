@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/logging"
@@ -61,11 +62,64 @@ func NewHybridP2PNetwork(log logging.Logger, cfg config.Local, datadir string, p
 	if err != nil {
 		return nil, err
 	}
-	return &HybridP2PNetwork{
+	hybrid := &HybridP2PNetwork{
 		p2pNetwork: p2pnet,
 		wsNetwork:  wsnet,
 		genesisID:  genesisID,
-	}, nil
+	}
+	// a peer misbehaving on either network is banned from both
+	hybrid.SetPeerBanList(makePeerBanList(log, cfg))
+	return hybrid, nil
+}
+
+// SetPeerBanList sets the peer ban list shared by both networks. It has to be called before Start.
+func (n *HybridP2PNetwork) SetPeerBanList(bl *PeerBanList) {
+	n.p2pNetwork.SetPeerBanList(bl)
+	n.wsNetwork.SetPeerBanList(bl)
+}
+
+// ApplyConfig applies the settings of cfg which can be changed on a running websocket network.
+func (n *HybridP2PNetwork) ApplyConfig(cfg config.Local) {
+	n.wsNetwork.ApplyConfig(cfg)
+}
+
+// ListPeers returns the peers connected over both networks.
+func (n *HybridP2PNetwork) ListPeers() []PeerInfo {
+	return append(n.wsNetwork.ListPeers(), n.p2pNetwork.ListPeers()...)
+}
+
+// peerManager returns the network managing the peers at address
+func (n *HybridP2PNetwork) peerManager(address string) PeerManager {
+	if isP2PPeerAddress(address) {
+		return n.p2pNetwork
+	}
+	return n.wsNetwork
+}
+
+// ConnectPeer connects to the peer at address over the p2p network for multiaddrs and peer IDs,
+// and over the websocket network otherwise.
+func (n *HybridP2PNetwork) ConnectPeer(address string) error {
+	return n.peerManager(address).ConnectPeer(address)
+}
+
+// DisconnectPeer closes the connection with the peer at address.
+func (n *HybridP2PNetwork) DisconnectPeer(address string) error {
+	return n.peerManager(address).DisconnectPeer(address)
+}
+
+// PinPeer pins the peer at address for duration, or until it is unpinned if duration is zero.
+func (n *HybridP2PNetwork) PinPeer(address string, duration time.Duration) error {
+	return n.peerManager(address).PinPeer(address, duration)
+}
+
+// UnpinPeer lifts the pin of the peer at address.
+func (n *HybridP2PNetwork) UnpinPeer(address string) error {
+	return n.peerManager(address).UnpinPeer(address)
+}
+
+// ListPinnedPeers returns the peers pinned on both networks.
+func (n *HybridP2PNetwork) ListPinnedPeers() []PeerPin {
+	return append(n.wsNetwork.ListPinnedPeers(), n.p2pNetwork.ListPinnedPeers()...)
 }
 
 // Address implements GossipNode
@@ -17,6 +17,8 @@
 package network
 
 import (
+	"time"
+
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	p2proto "github.com/libp2p/go-libp2p/core/protocol"
@@ -43,6 +45,13 @@ func init() {
 	networkP2PReceivedBytesByTag = metrics.NewTagCounterFiltered("algod_network_p2p_received_bytes_{TAG}", "Number of bytes that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkP2PMessageReceivedByTag = metrics.NewTagCounterFiltered("algod_network_p2p_message_received_{TAG}", "Number of complete messages that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkP2PMessageSentByTag = metrics.NewTagCounterFiltered("algod_network_p2p_message_sent_{TAG}", "Number of complete messages that were sent to the network for {TAG} messages", tagStringList, "UNK")
+
+	// per-tag compression savings: the difference between the uncompressed and compressed byte counters
+	compressedTagStringList := []string{string(protocol.AgreementVoteTag), string(protocol.TxnTag), string(protocol.VoteBundleTag)}
+	networkCompressionUncompressedSentBytesByTag = metrics.NewTagCounterFiltered("algod_network_compression_uncompressed_sent_bytes_{TAG}", "Number of bytes before compression of {TAG} messages sent compressed", compressedTagStringList, "UNK")
+	networkCompressionCompressedSentBytesByTag = metrics.NewTagCounterFiltered("algod_network_compression_compressed_sent_bytes_{TAG}", "Number of bytes after compression of {TAG} messages sent compressed", compressedTagStringList, "UNK")
+	networkCompressionUncompressedReceivedBytesByTag = metrics.NewTagCounterFiltered("algod_network_compression_uncompressed_received_bytes_{TAG}", "Number of bytes after decompression of {TAG} messages received compressed", compressedTagStringList, "UNK")
+	networkCompressionCompressedReceivedBytesByTag = metrics.NewTagCounterFiltered("algod_network_compression_compressed_received_bytes_{TAG}", "Number of bytes before decompression of {TAG} messages received compressed", compressedTagStringList, "UNK")
 }
 
 var networkSentBytesTotal = metrics.MakeCounter(metrics.NetworkSentBytesTotal)
@@ -66,6 +75,11 @@ var networkP2PMessageSentByTag *metrics.TagCounter
 var networkHandleMicrosByTag *metrics.TagCounter
 var networkHandleCountByTag *metrics.TagCounter
 
+var networkCompressionUncompressedSentBytesByTag *metrics.TagCounter
+var networkCompressionCompressedSentBytesByTag *metrics.TagCounter
+var networkCompressionUncompressedReceivedBytesByTag *metrics.TagCounter
+var networkCompressionCompressedReceivedBytesByTag *metrics.TagCounter
+
 var networkConnectionsDroppedTotal = metrics.MakeCounter(metrics.NetworkConnectionsDroppedTotal)
 var networkMessageQueueMicrosTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_message_sent_queue_micros_total", Description: "Total microseconds message spent waiting in queue to be sent"})
 var networkP2PMessageQueueMicrosTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_p2p_message_sent_queue_micros_total", Description: "Total microseconds p2p message spent waiting in queue to be sent"})
@@ -98,6 +112,7 @@ var networkPeerAlreadyClosed = metrics.MakeCounter(metrics.MetricName{Name: "alg
 
 var networkSlowPeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_slow_drops_total", Description: "number of peers dropped for being slow to send to"})
 var networkIdlePeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_idle_drops_total", Description: "number of peers dropped due to idle connection"})
+var networkPeerBans = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_bans_total", Description: "number of peer addresses banned for misbehavior"})
 
 var peers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_peers", Description: "Number of active peers."})
 var incomingPeers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_incoming_peers", Description: "Number of active incoming peers."})
@@ -108,6 +123,13 @@ var transactionMessagesP2PDuplicateMessage = metrics.MakeCounter(metrics.Transac
 var transactionMessagesP2PDeliverMessage = metrics.MakeCounter(metrics.TransactionMessagesP2PDeliverMessage)
 var transactionMessagesP2PUnderdeliverableMessage = metrics.MakeCounter(metrics.TransactionMessagesP2PUndeliverableMessage)
 
+// networkPeerTraffic exports the traffic exchanged with the busiest peers of the running networks
+var networkPeerTraffic = makePeerTrafficMetrics()
+
+func init() {
+	metrics.DefaultRegistry().Register(networkPeerTraffic)
+}
+
 var networkP2PGossipSubSentBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_p2p_gs_sent_bytes_total", Description: "Total number of bytes sent through gossipsub"})
 var networkP2PGossipSubReceivedBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_p2p_gs_received_bytes_total", Description: "Total number of bytes received through gossipsub"})
 
@@ -116,7 +138,20 @@ var networkP2PGossipSubReceivedBytesTotal = metrics.MakeCounter(metrics.MetricNa
 var _ = pubsub.RawTracer(pubsubMetricsTracer{})
 
 // pubsubMetricsTracer is a tracer for pubsub events used to track metrics.
-type pubsubMetricsTracer struct{}
+type pubsubMetricsTracer struct {
+	// peerTraffic returns the traffic accounting of a connected peer, or nil. It may be nil itself.
+	peerTraffic func(peer.ID) *peerTraffic
+}
+
+// addPeerTraffic accounts the transaction messages exchanged with p through gossipsub
+func (t pubsubMetricsTracer) addPeerTraffic(p peer.ID, counts TrafficCounts) {
+	if t.peerTraffic == nil {
+		return
+	}
+	if traffic := t.peerTraffic(p); traffic != nil {
+		traffic.add(protocol.TxnTag, counts, time.Now())
+	}
+}
 
 // AddPeer is invoked when a new peer is added.
 func (t pubsubMetricsTracer) AddPeer(p peer.ID, proto p2proto.ID) {}
@@ -138,6 +173,9 @@ func (t pubsubMetricsTracer) Prune(p peer.ID, topic string) {}
 
 // ValidateMessage is invoked when a message first enters the validation pipeline.
 func (t pubsubMetricsTracer) ValidateMessage(msg *pubsub.Message) {
+	if msg.GetTopic() == p2p.TXTopicName {
+		t.addPeerTraffic(msg.ReceivedFrom, TrafficCounts{BytesReceived: uint64(len(msg.Data)), MessagesReceived: 1})
+	}
 }
 
 // DeliverMessage is invoked when a message is delivered
@@ -167,6 +205,9 @@ func (t pubsubMetricsTracer) RejectMessage(msg *pubsub.Message, reason string) {
 // DuplicateMessage is invoked when a duplicate message is dropped.
 func (t pubsubMetricsTracer) DuplicateMessage(msg *pubsub.Message) {
 	transactionMessagesP2PDuplicateMessage.Inc(nil)
+	if msg.GetTopic() == p2p.TXTopicName {
+		t.addPeerTraffic(msg.ReceivedFrom, TrafficCounts{BytesReceived: uint64(len(msg.Data)), MessagesReceived: 1})
+	}
 }
 
 // ThrottlePeer is invoked when a peer is throttled by the peer gater.
@@ -198,6 +239,7 @@ func (t pubsubMetricsTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
 				networkP2PSentBytesByTag.Add(string(protocol.TxnTag), uint64(len(rpc.Publish[i].Data)))
 				networkP2PSentBytesTotal.AddUint64(uint64(len(rpc.Publish[i].Data)), nil)
 				networkP2PMessageSentByTag.Add(string(protocol.TxnTag), 1)
+				t.addPeerTraffic(p, TrafficCounts{BytesSent: uint64(len(rpc.Publish[i].Data)), MessagesSent: 1})
 			}
 		}
 	}
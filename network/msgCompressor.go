@@ -58,13 +58,15 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataConverter performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal
+// It supports zstd decompression for payload proposal and dictionary-based zstd decompression
+// for the tags negotiated with the peer.
 type wsPeerMsgDataConverter struct {
 	log    logging.Logger
 	origin string
 
 	// actual converter(s)
-	ppdec zstdProposalDecompressor
+	ppdec            zstdProposalDecompressor
+	tagDecompressors tagCompressors
 }
 
 type zstdProposalDecompressor struct{}
@@ -109,6 +111,17 @@ func (c *wsPeerMsgDataConverter) convert(tag protocol.Tag, data []byte) ([]byte,
 		}
 		c.log.Warnf("peer %s supported zstd but sent non-compressed data", c.origin)
 	}
+	if dec, ok := c.tagDecompressors[tag]; ok && c.ppdec.accept(data) {
+		// msgpack-encoded messages never start with the zstd magic number,
+		// and the sender falls back to non-compressed data when compression does not pay off.
+		res, err := dec.decompress(data)
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", c.origin, err)
+		}
+		networkCompressionCompressedReceivedBytesByTag.Add(string(tag), uint64(len(data)))
+		networkCompressionUncompressedReceivedBytesByTag.Add(string(tag), uint64(len(res)))
+		return res, nil
+	}
 	return data, nil
 }
 
@@ -119,5 +132,6 @@ func makeWsPeerMsgDataConverter(wp *wsPeer) *wsPeerMsgDataConverter {
 	}
 
 	c.ppdec = zstdProposalDecompressor{}
+	c.tagDecompressors = wp.compressedTags
 	return &c
 }
@@ -65,6 +65,12 @@ func (m *Multiplexer) getValidatorHandler(tag Tag) (MessageValidatorHandler, boo
 	return getHandler[MessageValidatorHandler](&m.msgValidatorHandlers, tag)
 }
 
+// HasHandler returns true if a message handler, rather than a validating handler, is registered for the given message Tag.
+func (m *Multiplexer) HasHandler(tag Tag) bool {
+	_, ok := m.getHandler(tag)
+	return ok
+}
+
 // Handle is the "input" side of the multiplexer. It dispatches the message to the previously defined handler.
 func (m *Multiplexer) Handle(msg IncomingMessage) OutgoingMessage {
 	if handler, ok := m.getHandler(msg.Tag); ok {
@@ -18,6 +18,7 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -43,8 +44,37 @@ const (
 	Catchpoints = "catchpointStoring"
 	// Gossip nodes are non permissioned relays
 	Gossip = "gossip"
+	// StateProofBlocks nodes are non-archival nodes keeping the blocks of all the state proof rounds
+	StateProofBlocks = "stateProofBlocks"
 )
 
+// BlockHistoryTiers are the depths of recent block history, in rounds, that non-archival nodes advertise serving.
+// A node advertises the BlockHistoryCapability of every tier it covers, so that a peer needing a given depth
+// finds all the nodes able to serve it with a single lookup.
+var BlockHistoryTiers = []uint64{10_000, 100_000, 1_000_000, 10_000_000}
+
+// BlockHistoryCapability returns the capability of the nodes serving at least the given number of recent rounds,
+// which should be one of BlockHistoryTiers
+func BlockHistoryCapability(rounds uint64) Capability {
+	return Capability(fmt.Sprintf("blockHistory-%d", rounds))
+}
+
+// BlockHistoryCapabilities returns the capabilities of a node serving the given number of recent rounds
+func BlockHistoryCapabilities(rounds uint64) []Capability {
+	var caps []Capability
+	for _, tier := range BlockHistoryTiers {
+		if rounds >= tier {
+			caps = append(caps, BlockHistoryCapability(tier))
+		}
+	}
+	return caps
+}
+
+// SparseBlockHistoryCapability returns the capability of the nodes keeping every block whose round is a multiple of the given interval
+func SparseBlockHistoryCapability(interval uint64) Capability {
+	return Capability(fmt.Sprintf("sparseBlockHistory-%d", interval))
+}
+
 const operationTimeout = time.Second * 5
 const maxAdvertisementInterval = time.Hour * 22
 
@@ -315,3 +315,14 @@ func TestCapabilities_ExcludesSelf(t *testing.T) {
 	require.NoError(t, err)
 	disc[0].wg.Wait()
 }
+
+func TestBlockHistoryCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Empty(t, BlockHistoryCapabilities(0))
+	require.Empty(t, BlockHistoryCapabilities(9_999))
+	require.Equal(t, []Capability{"blockHistory-10000"}, BlockHistoryCapabilities(10_000))
+	require.Equal(t, []Capability{"blockHistory-10000", "blockHistory-100000"}, BlockHistoryCapabilities(250_000))
+	require.Len(t, BlockHistoryCapabilities(20_000_000), len(BlockHistoryTiers))
+	require.Equal(t, Capability("sparseBlockHistory-1000"), SparseBlockHistoryCapability(1000))
+}
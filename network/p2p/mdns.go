@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+)
+
+// mdnsServiceNamePrefix is a prefix of the DNS-SD service name advertised by the nodes.
+// The service name is derived from the genesis ID so that only nodes of the same network discover each other.
+const mdnsServiceNamePrefix = "_algorand-"
+
+// MDNSDiscovery finds peers on the local network segment using multicast DNS
+type MDNSDiscovery interface {
+	Start() error
+	Close() error
+}
+
+// mdnsNotifee adapts a callback to mdns.Notifee
+type mdnsNotifee func(peer.AddrInfo)
+
+func (f mdnsNotifee) HandlePeerFound(info peer.AddrInfo) {
+	f(info)
+}
+
+// mdnsServiceName returns the service name advertised by the nodes of the network identified by genesisID.
+// The genesis ID is hashed because it may contain characters not allowed in DNS labels.
+func mdnsServiceName(genesisID string) string {
+	h := sha256.Sum256([]byte(genesisID))
+	return mdnsServiceNamePrefix + hex.EncodeToString(h[:8]) + "._udp"
+}
+
+// MakeMDNSDiscovery creates a multicast DNS discovery service that advertises the host and
+// reports peers of the same genesis ID found on the local network segment to peerFound.
+// peerFound is called from a new goroutine for every received announcement, including repeated ones.
+func MakeMDNSDiscovery(h host.Host, genesisID string, peerFound func(peer.AddrInfo)) MDNSDiscovery {
+	return mdns.NewMdnsService(h, mdnsServiceName(genesisID), mdnsNotifee(peerFound))
+}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/connmgr"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
@@ -44,7 +45,6 @@ import (
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
-	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
 )
@@ -64,6 +64,7 @@ type Service interface {
 	AddrInfo() peer.AddrInfo // return addrInfo for self
 
 	DialPeersUntilTargetCount(targetConnCount int)
+	DialNode(context.Context, *peer.AddrInfo) error
 	ClosePeer(peer.ID) error
 
 	Conns() []network.Conn
@@ -85,6 +86,9 @@ type serviceImpl struct {
 	pubsubCtx  context.Context
 	privKey    crypto.PrivKey
 
+	// transportListenAddrs are the QUIC and WebTransport listen addresses derived from listenAddr
+	transportListenAddrs []string
+
 	topics   map[string]*pubsub.Topic
 	topicsMu deadlock.RWMutex
 }
@@ -96,11 +100,15 @@ const AlgorandWsProtocol = "/algorand-ws/1.0.0"
 const algorandGUIDProtocolPrefix = "/algorand-telemetry/1.0.0/"
 const algorandGUIDProtocolTemplate = algorandGUIDProtocolPrefix + "%s/%s"
 
+// algorandCompressedTagsProtocolPrefix defines a libp2p protocol name for announcing message tags the node exchanges compressed
+const algorandCompressedTagsProtocolPrefix = "/algorand-compressed-tags/1.0.0/"
+
 const dialTimeout = 30 * time.Second
 
 // MakeHost creates a libp2p host but does not start listening.
 // Use host.Network().Listen() on the returned address to start listening.
-func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.Host, string, error) {
+// The optional gater is consulted before dialing or accepting connections.
+func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore, gater connmgr.ConnectionGater) (host.Host, string, error) {
 	// load stored peer ID, or make ephemeral peer ID
 	privKey, err := GetPrivKey(cfg, datadir)
 	if err != nil {
@@ -148,10 +156,9 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		return nil, "", err
 	}
 
-	host, err := libp2p.New(
+	opts := []libp2p.Option{
 		libp2p.Identity(privKey),
 		libp2p.UserAgent(ua),
-		libp2p.Transport(tcp.NewTCPTransport),
 		libp2p.Muxer("/yamux/1.0.0", &ymx),
 		libp2p.Peerstore(pstore),
 		libp2p.NoListenAddrs,
@@ -159,7 +166,11 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		enableMetrics,
 		libp2p.ResourceManager(rm),
 		libp2p.AddrsFactory(addrFactory),
-	)
+		libp2p.ConnectionGater(gater),
+	}
+	opts = append(opts, transportOptions(cfg)...)
+
+	host, err := libp2p.New(opts...)
 	return host, listenAddr, err
 }
 
@@ -185,6 +196,7 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 
 	sm := makeStreamManager(ctx, log, h, wsStreamHandler, cfg.EnableGossipService)
 	h.Network().Notify(sm)
+	h.Network().Notify(transportMetricsNotifiee)
 	h.SetStreamHandler(AlgorandWsProtocol, sm.streamHandler)
 
 	// set an empty handler for telemetryID/telemetryInstance protocol in order to allow other peers to know our telemetryID
@@ -198,14 +210,15 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 		return nil, err
 	}
 	return &serviceImpl{
-		log:        log,
-		listenAddr: listenAddr,
-		host:       h,
-		streams:    sm,
-		pubsub:     ps,
-		pubsubCtx:  ctx,
-		privKey:    h.Peerstore().PrivKey(h.ID()),
-		topics:     make(map[string]*pubsub.Topic),
+		log:                  log,
+		listenAddr:           listenAddr,
+		transportListenAddrs: transportListenAddresses(cfg, listenAddr),
+		host:                 h,
+		streams:              sm,
+		pubsub:               ps,
+		pubsubCtx:            ctx,
+		privKey:              h.Peerstore().PrivKey(h.ID()),
+		topics:               make(map[string]*pubsub.Topic),
 	}, nil
 }
 
@@ -222,7 +235,23 @@ func (s *serviceImpl) Start() error {
 		return err
 	}
 
-	return s.host.Network().Listen(listenAddr)
+	if err = s.host.Network().Listen(listenAddr); err != nil {
+		return err
+	}
+
+	// listen on the optional transports as well.
+	// TCP connectivity is sufficient, so failing to listen on these is not fatal.
+	for _, addr := range s.transportListenAddrs {
+		transportAddr, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			s.log.Warnf("failed to create multiaddress %s: %v", addr, err)
+			continue
+		}
+		if err := s.host.Network().Listen(transportAddr); err != nil {
+			s.log.Warnf("failed to listen on %s: %v", addr, err)
+		}
+	}
+	return nil
 }
 
 // Close shuts down the P2P service
@@ -241,9 +270,11 @@ func (s *serviceImpl) IDSigner() *PeerIDChallengeSigner {
 }
 
 // DialPeersUntilTargetCount attempts to establish connections to the provided phonebook addresses
+// and to the peers discovered on the local network segment
 func (s *serviceImpl) DialPeersUntilTargetCount(targetConnCount int) {
 	ps := s.host.Peerstore().(*pstore.PeerStore)
 	addrInfos := ps.GetAddresses(targetConnCount, phonebook.RelayRole)
+	addrInfos = append(addrInfos, ps.GetAddresses(targetConnCount, phonebook.LocalRole)...)
 	conns := s.host.Network().Conns()
 	var numOutgoingConns int
 	for _, conn := range conns {
@@ -267,7 +298,12 @@ func (s *serviceImpl) DialPeersUntilTargetCount(targetConnCount int) {
 	}
 }
 
-// dialNode attempts to establish a connection to the provided peer
+// DialNode connects to the given peer, unless it is this node
+func (s *serviceImpl) DialNode(ctx context.Context, peer *peer.AddrInfo) error {
+	return s.dialNode(ctx, peer)
+}
+
+// dialNode attempts to establish a connection to the provided peer within dialTimeout
 func (s *serviceImpl) dialNode(ctx context.Context, peer *peer.AddrInfo) error {
 	// don't try connecting to ourselves
 	if peer.ID == s.host.ID() {
@@ -349,6 +385,25 @@ func formatPeerTelemetryInfoProtocolName(telemetryID string, telemetryInstance s
 	)
 }
 
+// CompressedTagsProtocolName returns a protocol name announcing the compressed message tags.
+// Similarly to the telemetry info, the node registers an empty handler for it so that peers learn the value from the identify protocol.
+func CompressedTagsProtocolName(compressedTags string) protocol.ID {
+	return protocol.ID(algorandCompressedTagsProtocolPrefix + base32.StdEncoding.EncodeToString([]byte(compressedTags)))
+}
+
+// GetPeerCompressedTags returns the compressed message tags announced by a peer by looking at its protocols
+func GetPeerCompressedTags(peerProtocols []protocol.ID) string {
+	for _, protocol := range peerProtocols {
+		if strings.HasPrefix(string(protocol), algorandCompressedTagsProtocolPrefix) {
+			compressedTags, err := base32.StdEncoding.DecodeString(string(protocol[len(algorandCompressedTagsProtocolPrefix):]))
+			if err == nil {
+				return string(compressedTags)
+			}
+		}
+	}
+	return ""
+}
+
 var private6 = parseCIDR([]string{
 	"100::/64",
 	"2001:2::/48",
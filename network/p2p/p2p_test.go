@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 
 	"github.com/libp2p/go-libp2p"
@@ -145,6 +146,19 @@ func TestP2PGetPeerTelemetryInfo(t *testing.T) {
 	}
 }
 
+func TestP2PGetPeerCompressedTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	spec := "AV:vote-01020304,TX:txn-05060708"
+	protos := []protocol.ID{
+		protocol.ID(formatPeerTelemetryInfoProtocolName("telemetryID", "telemetryInstance")),
+		CompressedTagsProtocolName(spec),
+	}
+	require.Equal(t, spec, GetPeerCompressedTags(protos))
+	require.Equal(t, "", GetPeerCompressedTags(protos[:1]))
+	require.Equal(t, "", GetPeerCompressedTags([]protocol.ID{protocol.ID(algorandCompressedTagsProtocolPrefix + "not base32!")}))
+}
+
 func TestP2PProtocolAsMeta(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -282,7 +296,7 @@ func TestP2PMakeHostAddressFilter(t *testing.T) {
 	for _, addr := range []string{":0", "0.0.0.0:0"} {
 		cfg := config.GetDefaultLocal()
 		cfg.NetAddress = addr
-		host, la, err := MakeHost(cfg, td, pstore)
+		host, la, err := MakeHost(cfg, td, pstore, nil)
 		require.NoError(t, err)
 		require.Equal(t, "/ip4/0.0.0.0/tcp/0", la)
 		require.Empty(t, host.Addrs())
@@ -323,7 +337,7 @@ func TestP2PMakeHostAddressFilter(t *testing.T) {
 	for _, addr := range addresses {
 		cfg := config.GetDefaultLocal()
 		cfg.NetAddress = addr + ":0"
-		host, la, err := MakeHost(cfg, td, pstore)
+		host, la, err := MakeHost(cfg, td, pstore, nil)
 		require.NoError(t, err)
 		require.Equal(t, "/ip4/"+addr+"/tcp/0", la)
 		require.Empty(t, host.Addrs())
@@ -335,3 +349,61 @@ func TestP2PMakeHostAddressFilter(t *testing.T) {
 		host.Close()
 	}
 }
+
+func TestP2PMDNSServiceName(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mainnet := mdnsServiceName("mainnet-v1.0")
+	require.Equal(t, mainnet, mdnsServiceName("mainnet-v1.0"))
+	require.NotEqual(t, mainnet, mdnsServiceName("testnet-v1.0"))
+	require.True(t, strings.HasPrefix(mainnet, mdnsServiceNamePrefix))
+	require.True(t, strings.HasSuffix(mainnet, "._udp"))
+	// the service label must be a valid DNS label
+	label := strings.TrimSuffix(mainnet, "._udp")
+	require.LessOrEqual(t, len(label), 63)
+	require.NotContains(t, label, ".")
+}
+
+func TestP2PTransportListenAddresses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	require.Empty(t, transportListenAddresses(cfg, "/ip4/127.0.0.1/tcp/4190"))
+
+	cfg.EnableP2PQUICTransport = true
+	require.Empty(t, transportListenAddresses(cfg, ""))
+	require.Equal(t, []string{"/ip4/127.0.0.1/udp/4190/quic-v1"}, transportListenAddresses(cfg, "/ip4/127.0.0.1/tcp/4190"))
+
+	cfg.EnableP2PWebTransport = true
+	require.Equal(t, []string{"/ip4/0.0.0.0/udp/0/quic-v1", "/ip4/0.0.0.0/udp/0/quic-v1/webtransport"}, transportListenAddresses(cfg, "/ip4/0.0.0.0/tcp/0"))
+
+	for _, addr := range transportListenAddresses(cfg, "/ip4/127.0.0.1/tcp/4190") {
+		_, err := multiaddr.NewMultiaddr(addr)
+		require.NoError(t, err)
+	}
+}
+
+func TestP2PTCPFirstDialRanker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tcpAddr := multiaddr.StringCast("/ip4/1.2.3.4/tcp/4190")
+	quicAddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/4190/quic-v1")
+	wtAddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/4190/quic-v1/webtransport")
+
+	res := tcpFirstDialRanker([]multiaddr.Multiaddr{quicAddr, tcpAddr, wtAddr})
+	require.Len(t, res, 3)
+	for _, ad := range res {
+		if ad.Addr.Equal(tcpAddr) {
+			require.Zero(t, ad.Delay)
+		} else {
+			require.Equal(t, quicFallbackDelay, ad.Delay)
+		}
+	}
+
+	// without TCP addresses QUIC is dialed right away
+	res = tcpFirstDialRanker([]multiaddr.Multiaddr{quicAddr})
+	require.Len(t, res, 1)
+	require.Zero(t, res[0].Delay)
+}
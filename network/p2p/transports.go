@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"strings"
+	"time"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	webtransport "github.com/libp2p/go-libp2p/p2p/transport/webtransport"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// transport names used as metric tags
+const (
+	transportTCP          = "tcp"
+	transportQUIC         = "quic"
+	transportWebTransport = "webtransport"
+)
+
+// quicFallbackDelay is how long QUIC and WebTransport dials are postponed when TCP is preferred
+const quicFallbackDelay = 250 * time.Millisecond
+
+var networkP2PConnectionsOpenedByTransport = metrics.NewTagCounter("algod_network_p2p_connections_opened_{TAG}", "Number of p2p connections established over the {TAG} transport", transportTCP, transportQUIC, transportWebTransport)
+var networkP2PConnectionsClosedByTransport = metrics.NewTagCounter("algod_network_p2p_connections_closed_{TAG}", "Number of p2p connections closed over the {TAG} transport", transportTCP, transportQUIC, transportWebTransport)
+
+// transportOptions returns libp2p options for the transports enabled in the config.
+// TCP is always enabled; QUIC and WebTransport are optional.
+func transportOptions(cfg config.Local) []libp2p.Option {
+	opts := []libp2p.Option{libp2p.Transport(tcp.NewTCPTransport)}
+	if cfg.EnableP2PQUICTransport {
+		opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
+	if cfg.EnableP2PWebTransport {
+		opts = append(opts, libp2p.Transport(webtransport.New))
+	}
+	if (cfg.EnableP2PQUICTransport || cfg.EnableP2PWebTransport) && !cfg.P2PPreferQUIC {
+		opts = append(opts, libp2p.SwarmOpts(swarm.WithDialRanker(tcpFirstDialRanker)))
+	}
+	return opts
+}
+
+// transportListenAddresses derives QUIC and WebTransport listen addresses from the TCP listen address
+// so that all enabled transports listen on the same IP and port number.
+func transportListenAddresses(cfg config.Local, tcpListenAddr string) []string {
+	if tcpListenAddr == "" {
+		return nil
+	}
+	udpListenAddr := strings.Replace(tcpListenAddr, "/tcp/", "/udp/", 1)
+	if udpListenAddr == tcpListenAddr {
+		return nil
+	}
+	var addrs []string
+	if cfg.EnableP2PQUICTransport {
+		addrs = append(addrs, udpListenAddr+"/quic-v1")
+	}
+	if cfg.EnableP2PWebTransport {
+		addrs = append(addrs, udpListenAddr+"/quic-v1/webtransport")
+	}
+	return addrs
+}
+
+// isTCPAddr returns true if the multiaddr is dialed over the TCP transport
+func isTCPAddr(addr multiaddr.Multiaddr) bool {
+	_, err := addr.ValueForProtocol(multiaddr.P_TCP)
+	return err == nil
+}
+
+// tcpFirstDialRanker dials all TCP addresses immediately and postpones other transports,
+// so that QUIC and WebTransport are only used if TCP does not connect in time.
+func tcpFirstDialRanker(addrs []multiaddr.Multiaddr) []network.AddrDelay {
+	var hasTCP bool
+	for _, addr := range addrs {
+		if isTCPAddr(addr) {
+			hasTCP = true
+			break
+		}
+	}
+	res := make([]network.AddrDelay, 0, len(addrs))
+	for _, addr := range addrs {
+		var delay time.Duration
+		if hasTCP && !isTCPAddr(addr) {
+			delay = quicFallbackDelay
+		}
+		res = append(res, network.AddrDelay{Addr: addr, Delay: delay})
+	}
+	return res
+}
+
+// connTransportName returns the metric tag for the transport the connection is established over
+func connTransportName(conn network.Conn) string {
+	switch t := conn.ConnState().Transport; t {
+	case "quic", "quic-v1":
+		return transportQUIC
+	default:
+		return t
+	}
+}
+
+// transportMetricsNotifiee counts opened and closed connections per transport
+var transportMetricsNotifiee = &network.NotifyBundle{
+	ConnectedF: func(_ network.Network, conn network.Conn) {
+		networkP2PConnectionsOpenedByTransport.Add(connTransportName(conn), 1)
+	},
+	DisconnectedF: func(_ network.Network, conn network.Conn) {
+		networkP2PConnectionsClosedByTransport.Add(connTransportName(conn), 1)
+	},
+}
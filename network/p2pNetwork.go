@@ -20,6 +20,7 @@ import (
 	"context"
 	"math/rand"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -75,6 +76,9 @@ type P2PNetwork struct {
 	wsPeersConnectivityCheckTicker *time.Ticker
 	peerStater                     peerConnectionStater
 
+	// tagCompressors are message tags offered to stream peers for compression with a shared dictionary
+	tagCompressors tagCompressors
+
 	relayMessages bool // True if we should relay messages from other nodes (nominally true for relays, false otherwise)
 	wantTXGossip  atomic.Bool
 
@@ -87,6 +91,20 @@ type P2PNetwork struct {
 	httpServer        *p2p.HTTPServer
 
 	identityTracker identityTracker
+
+	// peerBans scores misbehaving peers and refuses connections with the banned ones
+	peerBans *PeerBanList
+
+	// mdnsDiscovery finds peers of the same genesis on the local network segment, if enabled
+	mdnsDiscovery p2p.MDNSDiscovery
+	mdnsPeers     map[peer.ID]peer.AddrInfo
+	mdnsPeersMu   deadlock.Mutex
+
+	// meshUpdateRequests asks meshThread for an immediate mesh update
+	meshUpdateRequests chan struct{}
+
+	// pins are the peer addresses pinned by the node operator
+	pins *peerPins
 }
 
 type bootstrapper struct {
@@ -235,6 +253,8 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 			lastPeerConnectionsSent:       time.Now(),
 		},
 	}
+	net.peerBans = makePeerBanList(log, cfg)
+	net.peerBans.onBan(net.disconnectBannedPeers)
 
 	net.ctx, net.ctxCancel = context.WithCancel(context.Background())
 	net.handler = msgHandler{
@@ -263,17 +283,23 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		return nil, err
 	}
 
-	h, la, err := p2p.MakeHost(cfg, datadir, pstore)
+	h, la, err := p2p.MakeHost(cfg, datadir, pstore, peerBanGater{net: net})
 	if err != nil {
 		return nil, err
 	}
 	log.Infof("P2P host created: peer ID %s addrs %s", h.ID(), h.Addrs())
 
-	net.service, err = p2p.MakeService(net.ctx, log, cfg, h, la, net.wsStreamHandler, pubsubMetricsTracer{})
+	net.service, err = p2p.MakeService(net.ctx, log, cfg, h, la, net.wsStreamHandler, pubsubMetricsTracer{peerTraffic: net.peerTraffic})
 	if err != nil {
 		return nil, err
 	}
 
+	net.tagCompressors = makeTagCompressors(cfg.CompressedMessageTags, log)
+	if len(net.tagCompressors) > 0 {
+		// set an empty handler for the compressed tags protocol in order to allow other peers to know our compression capabilities
+		h.SetStreamHandler(p2p.CompressedTagsProtocolName(net.tagCompressors.String()), func(s network.Stream) { s.Close() })
+	}
+
 	peerIDs := pstore.Peers()
 	addrInfos := make([]*peer.AddrInfo, 0, len(peerIDs))
 	for _, peerID := range peerIDs {
@@ -299,6 +325,13 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		net.capabilitiesDiscovery = disc
 	}
 
+	net.meshUpdateRequests = make(chan struct{}, 1)
+	net.pins = makePeerPins()
+	if cfg.EnableP2PMDNSDiscovery {
+		net.mdnsPeers = make(map[peer.ID]peer.AddrInfo)
+		net.mdnsDiscovery = p2p.MakeMDNSDiscovery(h, genesisID, net.mdnsPeerFound)
+	}
+
 	net.httpServer = p2p.MakeHTTPServer(h)
 
 	err = net.setup()
@@ -333,6 +366,7 @@ func (n *P2PNetwork) Start() error {
 	if err != nil {
 		return err
 	}
+	networkPeerTraffic.addSource(n)
 
 	wantTXGossip := n.relayMessages || n.config.ForceFetchTransactions || n.nodeInfo.IsParticipating()
 	if wantTXGossip {
@@ -367,6 +401,14 @@ func (n *P2PNetwork) Start() error {
 		n.capabilitiesDiscovery.AdvertiseCapabilities(n.nodeInfo.Capabilities()...)
 	}
 
+	if n.mdnsDiscovery != nil {
+		// mDNS announces listening addresses, so it fails to start if the node does not listen
+		err = n.mdnsDiscovery.Start()
+		if err != nil {
+			n.log.Warnf("Failed to start mDNS discovery: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -378,7 +420,14 @@ func (n *P2PNetwork) Stop() {
 			n.log.Warnf("Error closing capabilities discovery: %v", err)
 		}
 	}
+	if n.mdnsDiscovery != nil {
+		err := n.mdnsDiscovery.Close()
+		if err != nil {
+			n.log.Warnf("Error closing mDNS discovery: %v", err)
+		}
+	}
 
+	networkPeerTraffic.removeSource(n)
 	n.handler.ClearHandlers([]Tag{})
 	if n.wsPeersConnectivityCheckTicker != nil {
 		n.wsPeersConnectivityCheckTicker.Stop()
@@ -424,6 +473,7 @@ func (n *P2PNetwork) innerStop() {
 // meshThreadInner fetches nodes from DHT and attempts to connect to them
 func (n *P2PNetwork) meshThreadInner() int {
 	defer n.service.DialPeersUntilTargetCount(n.config.GossipFanout)
+	n.connectPinnedPeers()
 
 	// fetch peers from DNS
 	var dnsPeers, dhtPeers []peer.AddrInfo
@@ -491,6 +541,8 @@ func (n *P2PNetwork) meshThread() {
 				// no peers found, backoff
 				timer.Reset(eb.Delay())
 			}
+		case <-n.meshUpdateRequests:
+			n.meshThreadInner()
 		case <-n.ctx.Done():
 			return
 		}
@@ -502,6 +554,30 @@ func (n *P2PNetwork) meshThread() {
 	}
 }
 
+// mdnsPeerFound records a peer discovered on the local network segment in the peerstore
+// and requests a mesh update so that the node connects to it without waiting for the next mesh round.
+func (n *P2PNetwork) mdnsPeerFound(info peer.AddrInfo) {
+	n.mdnsPeersMu.Lock()
+	if known, ok := n.mdnsPeers[info.ID]; ok && slices.EqualFunc(known.Addrs, info.Addrs, multiaddr.Multiaddr.Equal) {
+		// repeated announcement
+		n.mdnsPeersMu.Unlock()
+		return
+	}
+	n.mdnsPeers[info.ID] = info
+	peers := make([]*peer.AddrInfo, 0, len(n.mdnsPeers))
+	for _, p := range n.mdnsPeers {
+		peers = append(peers, &p)
+	}
+	n.pstore.ReplacePeerList(peers, string(n.networkID), phonebook.LocalRole)
+	n.mdnsPeersMu.Unlock()
+
+	n.log.Infof("Discovered local peer %s via mDNS", info.ID)
+	select {
+	case n.meshUpdateRequests <- struct{}{}:
+	default:
+	}
+}
+
 func (n *P2PNetwork) httpdThread() {
 	defer n.wg.Done()
 
@@ -534,11 +610,12 @@ func (n *P2PNetwork) Address() (string, bool) {
 			return addr.String(), true
 		}
 	}
-	// We don't have a non loopback address, so just return the first one if it contains an ip4 address or port
-	addr := addrs[0].String()
-	if strings.Contains(addr, "/ip4/") && strings.Contains(addr, "/tcp/") {
-		return addr, true
-
+	// We don't have a non loopback address, so just return the first one that contains an ip4 address and tcp port
+	for _, addr := range addrs {
+		addrStr := addr.String()
+		if strings.Contains(addrStr, "/ip4/") && strings.Contains(addrStr, "/tcp/") {
+			return addrStr, true
+		}
 	}
 	return "", false
 
@@ -564,15 +641,19 @@ func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, w
 
 // Disconnect from a peer, probably due to protocol errors.
 func (n *P2PNetwork) Disconnect(badpeer DisconnectablePeer) {
+	n.disconnect(badpeer, disconnectBadData)
+}
+
+func (n *P2PNetwork) disconnect(badpeer DisconnectablePeer, reason disconnectReason) {
 	var peerID peer.ID
 	var wsp *wsPeer
 
 	n.wsPeersLock.Lock()
-	defer n.wsPeersLock.Unlock()
 	switch p := badpeer.(type) {
 	case *wsPeer: // Disconnect came from a message received via wsPeer
 		peerID, wsp = n.wsPeersToIDs[p], p
 	default:
+		n.wsPeersLock.Unlock()
 		n.log.Warnf("Unknown peer type %T", badpeer)
 		return
 	}
@@ -583,6 +664,10 @@ func (n *P2PNetwork) Disconnect(badpeer DisconnectablePeer) {
 	} else {
 		n.log.Warnf("Could not find wsPeer reference for peer %s", peerID)
 	}
+	n.wsPeersLock.Unlock()
+
+	// closing the peer from our side does not go through peerRemoteClose, so charge the penalty here
+	n.peerBans.penalizePeer(wsp, reason)
 	err := n.service.ClosePeer(peerID)
 	if err != nil {
 		n.log.Warnf("Error disconnecting from peer %s: %v", peerID, err)
@@ -591,7 +676,135 @@ func (n *P2PNetwork) Disconnect(badpeer DisconnectablePeer) {
 
 func (n *P2PNetwork) disconnectThread(badnode DisconnectablePeer, reason disconnectReason) {
 	defer n.wg.Done()
-	n.Disconnect(badnode) // ignores reason
+	n.disconnect(badnode, reason)
+}
+
+// SetPeerBanList replaces the network's in-memory peer ban list with a persisted
+// or shared one. It has to be called before Start.
+func (n *P2PNetwork) SetPeerBanList(bl *PeerBanList) {
+	n.peerBans = bl
+	bl.onBan(n.disconnectBannedPeers)
+}
+
+// disconnectBannedPeers closes the connections with the peers known under the newly banned address.
+func (n *P2PNetwork) disconnectBannedPeers(address string) {
+	for _, conn := range n.service.Conns() {
+		peerID := conn.RemotePeer()
+		banned := peerID.String() == address
+		if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil && ip.String() == address {
+			banned = true
+		}
+		if !banned {
+			continue
+		}
+		err := n.service.ClosePeer(peerID)
+		if err != nil {
+			n.log.Warnf("Error disconnecting from banned peer %s: %v", peerID, err)
+		}
+	}
+}
+
+// peerTraffic returns the traffic accounting of the peer with the given ID, or nil if it is not connected
+func (n *P2PNetwork) peerTraffic(id peer.ID) *peerTraffic {
+	n.wsPeersLock.RLock()
+	defer n.wsPeersLock.RUnlock()
+	if wsp, ok := n.wsPeers[id]; ok {
+		return &wsp.traffic
+	}
+	return nil
+}
+
+// ListPeers returns the connected peers.
+func (n *P2PNetwork) ListPeers() []PeerInfo {
+	var peers []*wsPeer
+	peers, _ = n.peerSnapshot(peers)
+	pinned := n.pinnedPeers()
+	infos := make([]PeerInfo, len(peers))
+	for i, wsp := range peers {
+		infos[i] = wsp.peerInfo()
+		if id, err := peer.Decode(infos[i].PeerID); err == nil {
+			_, infos[i].Pinned = pinned[id]
+		}
+	}
+	return infos
+}
+
+// ConnectPeer connects to the peer at address, a multiaddr including the peer ID or a peer ID
+// whose addresses are known to the peerstore.
+func (n *P2PNetwork) ConnectPeer(address string) error {
+	info, err := parseP2PPeerAddress(address)
+	if err != nil {
+		return err
+	}
+	return n.service.DialNode(n.ctx, info)
+}
+
+// DisconnectPeer closes the connection with the peer at address, a multiaddr including the peer ID or a peer ID.
+func (n *P2PNetwork) DisconnectPeer(address string) error {
+	info, err := parseP2PPeerAddress(address)
+	if err != nil {
+		return err
+	}
+	n.wsPeersLock.RLock()
+	wsp, ok := n.wsPeers[info.ID]
+	n.wsPeersLock.RUnlock()
+	if !ok {
+		return ErrPeerNotConnected
+	}
+	n.disconnect(wsp, disconnectRequestedByOperator)
+	return nil
+}
+
+// PinPeer pins the peer at address, a multiaddr including the peer ID or a peer ID, for duration, or until
+// it is unpinned if duration is zero. The node connects to pinned peers, and reconnects when they disconnect.
+func (n *P2PNetwork) PinPeer(address string, duration time.Duration) error {
+	if _, err := parseP2PPeerAddress(address); err != nil {
+		return err
+	}
+	n.pins.pin(address, duration)
+	select {
+	case n.meshUpdateRequests <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// UnpinPeer lifts the pin of the peer at address.
+func (n *P2PNetwork) UnpinPeer(address string) error {
+	return n.pins.unpin(address)
+}
+
+// ListPinnedPeers returns the pinned peers.
+func (n *P2PNetwork) ListPinnedPeers() []PeerPin {
+	return n.pins.list()
+}
+
+// pinnedPeers returns the addresses of the pinned peers by peer ID
+func (n *P2PNetwork) pinnedPeers() map[peer.ID]*peer.AddrInfo {
+	pins := n.pins.list()
+	peers := make(map[peer.ID]*peer.AddrInfo, len(pins))
+	for _, pin := range pins {
+		// pins are validated when added
+		if info, err := parseP2PPeerAddress(pin.Address); err == nil {
+			peers[info.ID] = info
+		}
+	}
+	return peers
+}
+
+// connectPinnedPeers connects to the pinned peers which are not connected
+func (n *P2PNetwork) connectPinnedPeers() {
+	for id, info := range n.pinnedPeers() {
+		n.wsPeersLock.RLock()
+		_, connected := n.wsPeers[id]
+		n.wsPeersLock.RUnlock()
+		if connected {
+			continue
+		}
+		if err := n.service.DialNode(n.ctx, info); err != nil {
+			n.log.Warnf("failed to connect to pinned peer %s: %v", id, err)
+		}
+	}
 }
 
 // DisconnectPeers is used by testing
@@ -808,12 +1021,14 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 		outgoing:   !incoming,
 		identity:   netIdentPeerID,
 		peerType:   peerTypeP2P,
+		createTime: time.Now(),
 	}
 	protos, err := n.pstore.GetProtocols(p2pPeer)
 	if err != nil {
 		n.log.Warnf("Error getting protocols for peer %s: %v", p2pPeer, err)
 	}
 	wsp.TelemetryGUID, wsp.InstanceName = p2p.GetPeerTelemetryInfo(protos)
+	wsp.compressedTags = n.tagCompressors.negotiate(p2p.GetPeerCompressedTags(protos))
 
 	localAddr, has := n.Address()
 	if !has {
@@ -883,7 +1098,7 @@ func (n *P2PNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	}
 	if peer.outgoing {
 		eventDetails.Endpoint = peer.GetAddress()
-		eventDetails.MessageDelay = peer.peerMessageDelay
+		eventDetails.MessageDelay = peer.peerMessageDelay.Load()
 	}
 
 	n.log.EventWithDetails(telemetryspec.Network, telemetryspec.DisconnectPeerEvent,
@@ -895,6 +1110,7 @@ func (n *P2PNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 			AVCount:          peer.avMessageCount.Load(),
 			PPCount:          peer.ppMessageCount.Load(),
 		})
+	n.peerBans.penalizePeer(peer, reason)
 }
 
 func (n *P2PNetwork) peerSnapshot(dest []*wsPeer) ([]*wsPeer, int32) {
@@ -1022,6 +1238,11 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	case Ignore:
 		return pubsub.ValidationIgnore
 	case Disconnect:
+		if wsp, ok := sender.(*wsPeer); ok {
+			n.peerBans.penalizePeer(wsp, disconnectBadData)
+		} else {
+			n.peerBans.penalize(disconnectBadData, peerID.String())
+		}
 		return pubsub.ValidationReject
 	case Accept:
 		msg.ValidatorData = outmsg
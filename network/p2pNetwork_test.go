@@ -318,6 +318,97 @@ func TestP2PSubmitWS(t *testing.T) {
 	)
 }
 
+// TestP2PMixedTransports runs a private network on loopback where a relay accepts both TCP and QUIC
+// connections, one peer connects over QUIC and another over TCP, and messages flow between them.
+func TestP2PMixedTransports(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfgQUIC := config.GetDefaultLocal()
+	cfgQUIC.NetAddress = "127.0.0.1:0"
+	cfgQUIC.EnableP2PQUICTransport = true
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log, cfgQUIC, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netA.Start()
+	require.NoError(t, err)
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	var tcpAddrA, quicAddrA string
+	for _, addr := range addrsA {
+		if _, err := addr.ValueForProtocol(ma.P_QUIC_V1); err == nil {
+			quicAddrA = addr.String()
+		} else if _, err := addr.ValueForProtocol(ma.P_TCP); err == nil {
+			tcpAddrA = addr.String()
+		}
+	}
+	require.NotEmpty(t, tcpAddrA)
+	require.NotEmpty(t, quicAddrA)
+	// the TCP address is reported for loopback-only nodes
+	addrA, ok := netA.Address()
+	require.True(t, ok)
+	require.Equal(t, tcpAddrA, addrA)
+
+	// B speaks QUIC and only knows A's QUIC address
+	cfgB := cfgQUIC
+	cfgB.NetAddress = ""
+	netB, err := NewP2PNetwork(log, cfgB, "", []string{quicAddrA}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netB.Start()
+	require.NoError(t, err)
+	defer netB.Stop()
+
+	// C is a TCP only node
+	cfgC := config.GetDefaultLocal()
+	netC, err := NewP2PNetwork(log, cfgC, "", []string{tcpAddrA}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netC.Start()
+	require.NoError(t, err)
+	defer netC.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(netA.wsPeers) == 2 && netB.hasPeers() && netC.hasPeers()
+	}, 5*time.Second, 50*time.Millisecond)
+
+	transports := func(n *P2PNetwork) []string {
+		var res []string
+		for _, conn := range n.service.Conns() {
+			res = append(res, conn.ConnState().Transport)
+		}
+		return res
+	}
+	require.Equal(t, []string{"quic-v1"}, transports(netB))
+	require.Equal(t, []string{"tcp"}, transports(netC))
+	require.ElementsMatch(t, []string{"quic-v1", "tcp"}, transports(netA))
+
+	testTag := protocol.AgreementVoteTag
+	var handlerCountB, handlerCountC atomic.Uint32
+	makeHandler := func(counter *atomic.Uint32) []TaggedMessageHandler {
+		return []TaggedMessageHandler{
+			{Tag: testTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+				counter.Add(1)
+				return OutgoingMessage{Action: Broadcast}
+			})},
+		}
+	}
+	netA.RegisterHandlers(makeHandler(&atomic.Uint32{}))
+	netB.RegisterHandlers(makeHandler(&handlerCountB))
+	netC.RegisterHandlers(makeHandler(&handlerCountC))
+
+	// messages from the QUIC peer reach the TCP peer via the relay and vice versa
+	for i := 0; i < 10; i++ {
+		err = netB.Broadcast(context.Background(), testTag, []byte(fmt.Sprintf("hello from B %d", i)), false, nil)
+		require.NoError(t, err)
+		err = netC.Broadcast(context.Background(), testTag, []byte(fmt.Sprintf("hello from C %d", i)), false, nil)
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		return handlerCountB.Load() == 10 && handlerCountC.Load() == 10
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
 type mockService struct {
 	id    peer.ID
 	addrs []ma.Multiaddr
@@ -350,6 +441,11 @@ func (s *mockService) AddrInfo() peer.AddrInfo {
 func (s *mockService) DialPeersUntilTargetCount(targetConnCount int) {
 }
 
+func (s *mockService) DialNode(ctx context.Context, info *peer.AddrInfo) error {
+	s.peers[info.ID] = *info
+	return nil
+}
+
 func (s *mockService) ClosePeer(peer peer.ID) error {
 	delete(s.peers, peer)
 	return nil
@@ -1453,3 +1549,46 @@ func TestGetPeersFiltersSelf(t *testing.T) {
 		}
 	}
 }
+
+// TestP2PMDNSPeerFound checks peers discovered via mDNS are added to the peerstore with the local role and dialed
+func TestP2PMDNSPeerFound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = "" // disable DNS lookups since the test only uses discovered peers
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log.With("net", "netA"), cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netA.Start()
+	require.NoError(t, err)
+	defer netA.Stop()
+
+	cfgB := cfg
+	cfgB.NetAddress = ""
+	cfgB.EnableP2PMDNSDiscovery = true
+	netB, err := NewP2PNetwork(log.With("net", "netB"), cfgB, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, netB.mdnsDiscovery)
+	// a non-listening node cannot announce itself, and the discovery is not started
+	err = netB.Start()
+	require.NoError(t, err)
+	defer netB.Stop()
+	require.False(t, netB.hasPeers())
+
+	// simulate an announcement
+	peerInfoA := netA.service.AddrInfo()
+	netB.mdnsPeerFound(peerInfoA)
+	local := netB.pstore.GetAddresses(10, phonebook.LocalRole)
+	require.Len(t, local, 1)
+	require.Equal(t, peerInfoA.ID, local[0].ID)
+	require.Empty(t, netB.pstore.GetAddresses(10, phonebook.RelayRole))
+
+	// repeated announcements are ignored
+	netB.mdnsPeerFound(peerInfoA)
+	require.Len(t, netB.mdnsPeers, 1)
+
+	require.Eventually(t, func() bool {
+		return netA.hasPeers() && netB.hasPeers()
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,417 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/util/codecs"
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// PeerBanListFilename is the name of the file, under the node's data directory,
+// that the peer ban list is persisted to.
+const PeerBanListFilename = "peerbans.json"
+
+// maxTrackedPeerScores is the number of peer scores after which fully decayed
+// scores are pruned, so that a flood of short lived misbehaving peers can't grow
+// the score table without bounds.
+const maxTrackedPeerScores = 10000
+
+// peerPenalties maps the reasons a peer connection gets closed for to the
+// misbehavior penalty charged to the peer. Reasons missing from this map, such as
+// idle or slow connections, are not considered misbehavior.
+var peerPenalties = map[disconnectReason]float64{
+	disconnectBadData:             25,
+	disconnectBadIdentityData:     25,
+	disconnectUnexpectedTopicResp: 25,
+	disconnectTooLargeMessage:     50,
+}
+
+// ErrBadPeerBanAddress is returned when a ban list address is neither an IP address nor a libp2p peer ID.
+var ErrBadPeerBanAddress = errors.New("peer ban address is neither an IP address nor a peer ID")
+
+// ErrPeerNotBanned is returned when removing an address that is not on the ban list.
+var ErrPeerNotBanned = errors.New("peer is not banned")
+
+// PeerBan is a single entry of the peer ban list.
+type PeerBan struct {
+	// Address is either an IP address or a libp2p peer ID.
+	Address string `json:"address"`
+	// Reason describes why the peer was banned.
+	Reason string `json:"reason,omitempty"`
+	// Expires is when the ban lifts. The zero time makes the ban permanent.
+	Expires time.Time `json:"expires"`
+	// Manual is set for bans added by the node operator rather than by peer scoring.
+	Manual bool `json:"manual"`
+}
+
+// Permanent returns true if the ban never expires.
+func (b PeerBan) Permanent() bool {
+	return b.Expires.IsZero()
+}
+
+func (b PeerBan) expired(now time.Time) bool {
+	return !b.Permanent() && !b.Expires.After(now)
+}
+
+type peerScore struct {
+	score   float64
+	updated time.Time
+}
+
+// PeerBanList accumulates misbehavior penalties for gossip peers, keyed by IP
+// address and libp2p peer ID, and bans addresses whose exponentially decaying
+// score reaches the configured threshold. Bans, including the ones added by the
+// node operator, are persisted so they survive a restart.
+// A single list is shared by all the networks a node runs.
+type PeerBanList struct {
+	log  logging.Logger
+	path string
+
+	scoring     bool
+	threshold   float64
+	halfLife    time.Duration
+	banDuration time.Duration
+
+	mu       deadlock.Mutex
+	scores   map[string]*peerScore
+	bans     map[string]PeerBan
+	banHooks []func(address string)
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func makePeerBanList(log logging.Logger, cfg config.Local) *PeerBanList {
+	return &PeerBanList{
+		log:         log,
+		scoring:     cfg.EnablePeerScoring && cfg.PeerBanThreshold > 0,
+		threshold:   float64(cfg.PeerBanThreshold),
+		halfLife:    time.Duration(cfg.PeerScoreHalfLifeSeconds) * time.Second,
+		banDuration: time.Duration(cfg.PeerBanDurationSeconds) * time.Second,
+		scores:      make(map[string]*peerScore),
+		bans:        make(map[string]PeerBan),
+		now:         time.Now,
+	}
+}
+
+// LoadPeerBanList creates a peer ban list persisted to the given path,
+// loading the bans previously saved there, if any. An empty path keeps
+// the ban list in memory only.
+func LoadPeerBanList(log logging.Logger, cfg config.Local, path string) (*PeerBanList, error) {
+	bl := makePeerBanList(log, cfg)
+	if path == "" {
+		return bl, nil
+	}
+	bl.path = path
+
+	var bans []PeerBan
+	err := codecs.LoadObjectFromFile(path, &bans)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bl, nil
+		}
+		return nil, err
+	}
+	now := bl.now()
+	for _, ban := range bans {
+		address, err := normalizeBanAddress(ban.Address)
+		if err != nil {
+			log.Warnf("ignoring malformed peer ban list entry %q: %v", ban.Address, err)
+			continue
+		}
+		if ban.expired(now) {
+			continue
+		}
+		ban.Address = address
+		bl.bans[address] = ban
+	}
+	return bl, nil
+}
+
+// normalizeBanAddress validates the address is an IP address or a libp2p peer ID
+// and returns its canonical string form.
+func normalizeBanAddress(address string) (string, error) {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String(), nil
+	}
+	if id, err := peer.Decode(address); err == nil {
+		return id.String(), nil
+	}
+	return "", ErrBadPeerBanAddress
+}
+
+// List returns the active bans, sorted by address.
+func (bl *PeerBanList) List() []PeerBan {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	bl.expireLocked()
+	bans := make([]PeerBan, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+	return bans
+}
+
+// Ban bans the given IP address or peer ID for duration, or permanently if duration is zero.
+// Peers currently connected from the address are disconnected.
+func (bl *PeerBanList) Ban(address string, duration time.Duration, reason string) error {
+	address, err := normalizeBanAddress(address)
+	if err != nil {
+		return err
+	}
+	ban := PeerBan{Address: address, Reason: reason, Manual: true}
+	if duration > 0 {
+		ban.Expires = bl.now().Add(duration)
+	}
+
+	bl.mu.Lock()
+	bl.bans[address] = ban
+	delete(bl.scores, address)
+	err = bl.saveLocked()
+	hooks := bl.banHooks
+	bl.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(address)
+	}
+	return err
+}
+
+// Unban lifts the ban of the given IP address or peer ID, and clears its misbehavior score.
+func (bl *PeerBanList) Unban(address string) error {
+	address, err := normalizeBanAddress(address)
+	if err != nil {
+		return err
+	}
+
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	delete(bl.scores, address)
+	if _, has := bl.bans[address]; !has {
+		return ErrPeerNotBanned
+	}
+	delete(bl.bans, address)
+	return bl.saveLocked()
+}
+
+// isBanned returns true if any of the given addresses is banned.
+func (bl *PeerBanList) isBanned(addresses ...string) bool {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	if len(bl.bans) == 0 {
+		return false
+	}
+	now := bl.now()
+	for _, address := range addresses {
+		ban, has := bl.bans[address]
+		if has && !ban.expired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBannedMultiaddr returns true if the IP address of the multiaddr is banned.
+func (bl *PeerBanList) isBannedMultiaddr(ma multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(ma)
+	if err != nil {
+		return false
+	}
+	return bl.isBanned(ip.String())
+}
+
+// onBan registers a function called with every newly banned address.
+func (bl *PeerBanList) onBan(hook func(address string)) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	bl.banHooks = append(bl.banHooks, hook)
+}
+
+// penalizePeer charges the peer the penalty for closing its connection because of reason.
+func (bl *PeerBanList) penalizePeer(wp *wsPeer, reason disconnectReason) {
+	if !bl.scoring {
+		return
+	}
+	if _, has := peerPenalties[reason]; !has {
+		return
+	}
+	bl.penalize(reason, wp.banKeys()...)
+}
+
+// penalize charges the penalty for reason to each of the addresses a peer is known under,
+// banning the addresses whose score reaches the threshold.
+func (bl *PeerBanList) penalize(reason disconnectReason, addresses ...string) {
+	penalty, has := peerPenalties[reason]
+	if !bl.scoring || !has {
+		return
+	}
+
+	var banned []string
+	bl.mu.Lock()
+	now := bl.now()
+	for _, address := range addresses {
+		if ban, has := bl.bans[address]; has && !ban.expired(now) {
+			continue
+		}
+		ps, has := bl.scores[address]
+		if !has {
+			ps = &peerScore{}
+			bl.scores[address] = ps
+		}
+		ps.score = bl.decay(ps.score, now.Sub(ps.updated)) + penalty
+		ps.updated = now
+		if ps.score >= bl.threshold {
+			delete(bl.scores, address)
+			bl.bans[address] = PeerBan{Address: address, Reason: string(reason), Expires: now.Add(bl.banDuration)}
+			banned = append(banned, address)
+		}
+	}
+	if len(bl.scores) > maxTrackedPeerScores {
+		bl.pruneScoresLocked(now)
+	}
+	var err error
+	if len(banned) > 0 {
+		err = bl.saveLocked()
+	}
+	hooks := bl.banHooks
+	bl.mu.Unlock()
+
+	if err != nil {
+		bl.log.Warnf("unable to save peer ban list to %s: %v", bl.path, err)
+	}
+	for _, address := range banned {
+		networkPeerBans.Inc(nil)
+		bl.log.Infof("banning peer %s for %v: %s", address, bl.banDuration, reason)
+		for _, hook := range hooks {
+			hook(address)
+		}
+	}
+}
+
+// decay returns the score remaining after elapsed time has passed.
+func (bl *PeerBanList) decay(score float64, elapsed time.Duration) float64 {
+	if bl.halfLife <= 0 {
+		return score
+	}
+	return score * math.Exp2(-float64(elapsed)/float64(bl.halfLife))
+}
+
+// pruneScoresLocked drops the scores that decayed below one penalty point.
+func (bl *PeerBanList) pruneScoresLocked(now time.Time) {
+	for address, ps := range bl.scores {
+		if bl.decay(ps.score, now.Sub(ps.updated)) < 1 {
+			delete(bl.scores, address)
+		}
+	}
+}
+
+// expireLocked drops the bans that expired.
+func (bl *PeerBanList) expireLocked() {
+	now := bl.now()
+	for address, ban := range bl.bans {
+		if ban.expired(now) {
+			delete(bl.bans, address)
+		}
+	}
+}
+
+// saveLocked writes the ban list to its file, if the list is persisted.
+func (bl *PeerBanList) saveLocked() error {
+	if bl.path == "" {
+		return nil
+	}
+	bl.expireLocked()
+	bans := make([]PeerBan, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+
+	tmpPath := bl.path + ".tmp"
+	err := codecs.SaveObjectToFile(tmpPath, bans, true)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, bl.path)
+}
+
+// banKeys returns the addresses the peer ban list tracks the peer under:
+// its IP address, and its peer ID for peers connected over libp2p.
+func (wp *wsPeer) banKeys() []string {
+	if c, ok := wp.conn.(*wsPeerConnP2P); ok {
+		conn := c.stream.Conn()
+		keys := []string{conn.RemotePeer().String()}
+		if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil {
+			keys = append(keys, ip.String())
+		}
+		return keys
+	}
+
+	var ip net.IP
+	if wp.originAddress != "" {
+		ip = net.ParseIP(wp.originAddress)
+	} else if wp.conn != nil {
+		if addr, ok := wp.conn.RemoteAddr().(*net.TCPAddr); ok {
+			ip = addr.IP
+		}
+	}
+	if ip == nil {
+		return nil
+	}
+	return []string{ip.String()}
+}
+
+// peerBanGater is a libp2p connection gater refusing connections to and from banned peers.
+type peerBanGater struct {
+	net *P2PNetwork
+}
+
+func (g peerBanGater) InterceptPeerDial(p peer.ID) bool {
+	return !g.net.peerBans.isBanned(p.String())
+}
+
+func (g peerBanGater) InterceptAddrDial(p peer.ID, ma multiaddr.Multiaddr) bool {
+	return !g.net.peerBans.isBanned(p.String()) && !g.net.peerBans.isBannedMultiaddr(ma)
+}
+
+func (g peerBanGater) InterceptAccept(cma network.ConnMultiaddrs) bool {
+	return !g.net.peerBans.isBannedMultiaddr(cma.RemoteMultiaddr())
+}
+
+func (g peerBanGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return !g.net.peerBans.isBanned(p.String())
+}
+
+func (g peerBanGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+const testBanPeerID = "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N"
+
+func makeTestPeerBanList(t *testing.T, path string) (*PeerBanList, *time.Time) {
+	cfg := config.GetDefaultLocal()
+	cfg.EnablePeerScoring = true
+	cfg.PeerBanThreshold = 100
+	cfg.PeerScoreHalfLifeSeconds = 60
+	cfg.PeerBanDurationSeconds = 3600
+	bl, err := LoadPeerBanList(logging.TestingLog(t), cfg, path)
+	require.NoError(t, err)
+	now := time.Now()
+	bl.now = func() time.Time { return now }
+	return bl, &now
+}
+
+func TestPeerBanListScoring(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bl, now := makeTestPeerBanList(t, "")
+	var hooked []string
+	bl.onBan(func(address string) { hooked = append(hooked, address) })
+
+	// reasons without a penalty are free
+	for i := 0; i < 10; i++ {
+		bl.penalize(disconnectReadError, "10.0.0.1")
+	}
+	require.False(t, bl.isBanned("10.0.0.1"))
+
+	// scores decay: three bad messages an hour apart do not add up to a ban
+	for i := 0; i < 3; i++ {
+		bl.penalize(disconnectBadData, "10.0.0.1")
+		*now = now.Add(time.Hour)
+	}
+	require.False(t, bl.isBanned("10.0.0.1"))
+	require.Empty(t, hooked)
+
+	// but four in a row do
+	for i := 0; i < 4; i++ {
+		bl.penalize(disconnectBadData, "10.0.0.1", testBanPeerID)
+	}
+	require.True(t, bl.isBanned("10.0.0.1"))
+	require.True(t, bl.isBanned("10.0.0.2", testBanPeerID))
+	require.False(t, bl.isBanned("10.0.0.2"))
+	require.ElementsMatch(t, []string{"10.0.0.1", testBanPeerID}, hooked)
+
+	bans := bl.List()
+	require.Len(t, bans, 2)
+	require.Equal(t, "10.0.0.1", bans[0].Address)
+	require.Equal(t, string(disconnectBadData), bans[0].Reason)
+	require.False(t, bans[0].Manual)
+	require.False(t, bans[0].Permanent())
+	require.Equal(t, now.Add(time.Hour), bans[0].Expires)
+
+	// bans expire
+	*now = now.Add(time.Hour + time.Second)
+	require.False(t, bl.isBanned("10.0.0.1", testBanPeerID))
+	require.Empty(t, bl.List())
+}
+
+func TestPeerBanListScoringDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	require.False(t, cfg.EnablePeerScoring)
+	bl := makePeerBanList(logging.TestingLog(t), cfg)
+	for i := 0; i < 100; i++ {
+		bl.penalize(disconnectTooLargeMessage, "10.0.0.1")
+	}
+	require.False(t, bl.isBanned("10.0.0.1"))
+	require.Empty(t, bl.scores)
+
+	// manual bans work regardless
+	require.NoError(t, bl.Ban("10.0.0.1", 0, "test"))
+	require.True(t, bl.isBanned("10.0.0.1"))
+}
+
+func TestPeerBanListManual(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), PeerBanListFilename)
+	bl, now := makeTestPeerBanList(t, path)
+	var hooked []string
+	bl.onBan(func(address string) { hooked = append(hooked, address) })
+
+	require.ErrorIs(t, bl.Ban("not-an-address", 0, ""), ErrBadPeerBanAddress)
+	require.ErrorIs(t, bl.Unban("not-an-address"), ErrBadPeerBanAddress)
+	require.ErrorIs(t, bl.Unban("10.0.0.1"), ErrPeerNotBanned)
+
+	require.NoError(t, bl.Ban("::ffff:10.0.0.1", 0, "spam"))
+	require.NoError(t, bl.Ban(testBanPeerID, time.Minute, ""))
+	require.NoError(t, bl.Ban("10.0.0.3", time.Second, ""))
+	require.Equal(t, []string{"10.0.0.1", testBanPeerID, "10.0.0.3"}, hooked)
+	require.True(t, bl.isBanned("10.0.0.1"))
+
+	id, err := peer.Decode(testBanPeerID)
+	require.NoError(t, err)
+	require.True(t, bl.isBanned(id.String()))
+
+	// reload: the expired ban is dropped, the others survive
+	*now = now.Add(2 * time.Second)
+	cfg := config.GetDefaultLocal()
+	bl2, err := LoadPeerBanList(logging.TestingLog(t), cfg, path)
+	require.NoError(t, err)
+	bl2.now = bl.now
+	bans := bl2.List()
+	require.Len(t, bans, 2)
+	require.Equal(t, "10.0.0.1", bans[0].Address)
+	require.Equal(t, "spam", bans[0].Reason)
+	require.True(t, bans[0].Manual)
+	require.True(t, bans[0].Permanent())
+	require.Equal(t, testBanPeerID, bans[1].Address)
+	require.False(t, bans[1].Permanent())
+
+	require.NoError(t, bl2.Unban("10.0.0.1"))
+	bl3, err := LoadPeerBanList(logging.TestingLog(t), cfg, path)
+	require.NoError(t, err)
+	require.False(t, bl3.isBanned("10.0.0.1"))
+	require.True(t, bl3.isBanned(testBanPeerID))
+}
+
+func TestPeerBanListRejectsIncoming(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	wn := makeTestWebsocketNode(t)
+	require.NoError(t, wn.peerBans.Ban("10.0.0.1", 0, "test"))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusForbidden, wn.checkIncomingConnectionLimits(response, request, "10.0.0.1", "", ""))
+	require.Equal(t, http.StatusForbidden, response.Code)
+
+	response = httptest.NewRecorder()
+	require.Equal(t, http.StatusOK, wn.checkIncomingConnectionLimits(response, request, "10.0.0.2", "", ""))
+}
@@ -0,0 +1,229 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// ErrBadPeerAddress is returned when a peer address can't be used with the network.
+var ErrBadPeerAddress = errors.New("invalid peer address")
+
+// ErrPeerNotConnected is returned when there is no connection with the peer.
+var ErrPeerNotConnected = errors.New("peer is not connected")
+
+// ErrPeerNotPinned is returned when unpinning an address that is not pinned.
+var ErrPeerNotPinned = errors.New("peer is not pinned")
+
+// Peer roles reported by PeerInfo
+const (
+	// PeerRoleRelay is the role of the peers the node connected out to, which relay the network messages to it
+	PeerRoleRelay = "relay"
+	// PeerRoleClient is the role of the peers which connected in to the node
+	PeerRoleClient = "client"
+)
+
+// PeerInfo describes a connection with a peer.
+type PeerInfo struct {
+	// Address is the address the peer was connected to at, or the address it connected in from.
+	Address string
+	// PeerID is the libp2p peer ID of peers connected over the p2p network.
+	PeerID string
+	// Network is "ws" or "p2p", the network the peer is connected over.
+	Network string
+	// Role is PeerRoleRelay or PeerRoleClient.
+	Role string
+	// Outgoing is set if the node made the connection.
+	Outgoing bool
+	// Pinned is set if the node operator pinned the peer.
+	Pinned bool
+	// MessageDelay is the relative average per-message delay of the peer measured by the connection
+	// performance monitor. It is only measured for outgoing websocket connections.
+	MessageDelay time.Duration
+	// ConnectedSince is when the connection was established.
+	ConnectedSince time.Time
+	// Traffic is the traffic exchanged with the peer by message tag, sorted by tag.
+	Traffic []PeerTagTraffic
+}
+
+// PeerPin is a peer address pinned by the node operator.
+type PeerPin struct {
+	// Address is the pinned peer address.
+	Address string
+	// Expires is when the pin lifts. The zero time keeps the peer pinned until it is unpinned or the node restarts.
+	Expires time.Time
+}
+
+// PeerManager is implemented by the networks whose peers can be inspected and managed by the node operator.
+//
+// Pinned peers are connected to and reconnected to by the network. The websocket network also never drops
+// them to make room for other peers and gives them the priority of the PriorityPeers; the P2P network only
+// re-dials them. Pins are not persisted.
+type PeerManager interface {
+	// ListPeers returns the connected peers.
+	ListPeers() []PeerInfo
+	// ConnectPeer connects to the peer at address, unless it is already connected.
+	ConnectPeer(address string) error
+	// DisconnectPeer closes the connection with the peer at address or with the given peer ID.
+	DisconnectPeer(address string) error
+	// PinPeer pins the peer at address for duration, or until it is unpinned if duration is zero.
+	PinPeer(address string, duration time.Duration) error
+	// UnpinPeer lifts the pin of the peer at address.
+	UnpinPeer(address string) error
+	// ListPinnedPeers returns the pinned peers.
+	ListPinnedPeers() []PeerPin
+}
+
+// peerAddress returns the address the peer was connected to at, or the address it connected in from
+func (wp *wsPeer) peerAddress() string {
+	if wp.outgoing {
+		return wp.GetAddress()
+	}
+	return wp.OriginAddress()
+}
+
+// peerInfo returns the description of the connection with the peer
+func (wp *wsPeer) peerInfo() PeerInfo {
+	info := PeerInfo{
+		Address:        wp.peerAddress(),
+		Network:        "ws",
+		Role:           PeerRoleClient,
+		Outgoing:       wp.outgoing,
+		MessageDelay:   time.Duration(wp.peerMessageDelay.Load()),
+		ConnectedSince: wp.createTime,
+	}
+	if wp.outgoing {
+		info.Role = PeerRoleRelay
+	}
+	if c, ok := wp.conn.(*wsPeerConnP2P); ok {
+		info.Network = "p2p"
+		info.PeerID = c.stream.Conn().RemotePeer().String()
+	}
+	info.Traffic = wp.traffic.snapshot(time.Now())
+	return info
+}
+
+// peerPins are the peer addresses pinned by the node operator
+type peerPins struct {
+	mu   deadlock.Mutex
+	pins map[string]time.Time
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func makePeerPins() *peerPins {
+	return &peerPins{
+		pins: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+// pin pins address for duration, or until it is unpinned if duration is zero
+func (pp *peerPins) pin(address string, duration time.Duration) {
+	var expires time.Time
+	if duration > 0 {
+		expires = pp.now().Add(duration)
+	}
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.pins[address] = expires
+}
+
+func (pp *peerPins) unpin(address string) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	if _, has := pp.pins[address]; !has {
+		return ErrPeerNotPinned
+	}
+	delete(pp.pins, address)
+	return nil
+}
+
+// list returns the active pins, sorted by address
+func (pp *peerPins) list() []PeerPin {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.expireLocked()
+	pins := make([]PeerPin, 0, len(pp.pins))
+	for address, expires := range pp.pins {
+		pins = append(pins, PeerPin{Address: address, Expires: expires})
+	}
+	sort.Slice(pins, func(i, j int) bool { return pins[i].Address < pins[j].Address })
+	return pins
+}
+
+// isPinned returns true if any of the given addresses is pinned
+func (pp *peerPins) isPinned(addresses ...string) bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	if len(pp.pins) == 0 {
+		return false
+	}
+	pp.expireLocked()
+	for _, address := range addresses {
+		if _, has := pp.pins[address]; has && address != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (pp *peerPins) expireLocked() {
+	now := pp.now()
+	for address, expires := range pp.pins {
+		if !expires.IsZero() && !expires.After(now) {
+			delete(pp.pins, address)
+		}
+	}
+}
+
+// isP2PPeerAddress returns true if address is a libp2p multiaddr or peer ID rather than a websocket peer address
+func isP2PPeerAddress(address string) bool {
+	if strings.HasPrefix(address, "/") {
+		return true
+	}
+	_, err := peer.Decode(address)
+	return err == nil
+}
+
+// parseP2PPeerAddress parses a multiaddr including the peer ID, or a bare peer ID whose addresses
+// are looked up in the peerstore
+func parseP2PPeerAddress(address string) (*peer.AddrInfo, error) {
+	if strings.HasPrefix(address, "/") {
+		info, err := peer.AddrInfoFromString(address)
+		if err != nil {
+			return nil, ErrBadPeerAddress
+		}
+		return info, nil
+	}
+	id, err := peer.Decode(address)
+	if err != nil {
+		return nil, ErrBadPeerAddress
+	}
+	return &peer.AddrInfo{ID: id}, nil
+}
+
+var _ PeerManager = (*WebsocketNetwork)(nil)
+var _ PeerManager = (*P2PNetwork)(nil)
+var _ PeerManager = (*HybridP2PNetwork)(nil)
@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestPeerPins(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	pins := makePeerPins()
+	pins.now = func() time.Time { return now }
+
+	pins.pin("r2.example.com:4160", time.Minute)
+	pins.pin("r1.example.com:4160", 0)
+	require.Equal(t, []PeerPin{
+		{Address: "r1.example.com:4160"},
+		{Address: "r2.example.com:4160", Expires: now.Add(time.Minute)},
+	}, pins.list())
+	require.True(t, pins.isPinned("10.0.0.1", "r2.example.com:4160"))
+	require.False(t, pins.isPinned("10.0.0.1", ""))
+
+	now = now.Add(time.Minute)
+	require.False(t, pins.isPinned("r2.example.com:4160"))
+	require.Equal(t, []PeerPin{{Address: "r1.example.com:4160"}}, pins.list())
+
+	require.NoError(t, pins.unpin("r1.example.com:4160"))
+	require.ErrorIs(t, pins.unpin("r1.example.com:4160"), ErrPeerNotPinned)
+	require.Empty(t, pins.list())
+}
+
+func TestP2PPeerAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const peerID = "12D3KooWKU3ZcVP4Kp6GGu2dg9Gu8pwaf3MdgmvqgVoZu8y7qz6C"
+	require.True(t, isP2PPeerAddress(peerID))
+	require.True(t, isP2PPeerAddress("/ip4/127.0.0.1/tcp/4190/p2p/"+peerID))
+	require.False(t, isP2PPeerAddress("r1.example.com:4160"))
+	require.False(t, isP2PPeerAddress("10.0.0.1"))
+
+	info, err := parseP2PPeerAddress(peerID)
+	require.NoError(t, err)
+	require.Equal(t, peerID, info.ID.String())
+	require.Empty(t, info.Addrs)
+
+	info, err = parseP2PPeerAddress("/ip4/127.0.0.1/tcp/4190/p2p/" + peerID)
+	require.NoError(t, err)
+	require.Equal(t, peerID, info.ID.String())
+	require.Len(t, info.Addrs, 1)
+
+	_, err = parseP2PPeerAddress("/ip4/127.0.0.1/tcp/4190")
+	require.ErrorIs(t, err, ErrBadPeerAddress)
+	_, err = parseP2PPeerAddress("r1.example.com:4160")
+	require.ErrorIs(t, err, ErrBadPeerAddress)
+}
+
+func TestWebsocketNetworkPeerManagement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 0
+	netA.Start()
+	defer netStop(t, netA, "A")
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 0
+	netB.Start()
+	defer netStop(t, netB, "B")
+	counter := newMessageCounter(t, 1)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	require.ErrorIs(t, netB.ConnectPeer("http://[::1"), ErrBadPeerAddress)
+	require.ErrorIs(t, netB.DisconnectPeer(addrA), ErrPeerNotConnected)
+
+	require.NoError(t, netB.ConnectPeer(addrA))
+	require.Eventually(t, func() bool { return len(netB.ListPeers()) == 1 && len(netA.ListPeers()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	peerB := netB.ListPeers()[0]
+	require.Equal(t, addrA, peerB.Address)
+	require.Equal(t, "ws", peerB.Network)
+	require.Equal(t, PeerRoleRelay, peerB.Role)
+	require.True(t, peerB.Outgoing)
+	require.False(t, peerB.Pinned)
+	require.False(t, peerB.ConnectedSince.IsZero())
+
+	peerA := netA.ListPeers()[0]
+	require.Equal(t, "127.0.0.1", peerA.Address)
+	require.Equal(t, PeerRoleClient, peerA.Role)
+	require.False(t, peerA.Outgoing)
+
+	// the messages exchanged are counted by tag
+	require.Eventually(t, func() bool {
+		return peerTagTraffic(netA.ListPeers()[0], protocol.MsgOfInterestTag).Total.BytesReceived > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	select {
+	case <-counter.done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the message")
+	}
+	txTraffic := peerTagTraffic(netB.ListPeers()[0], protocol.TxnTag)
+	require.Equal(t, TrafficCounts{BytesReceived: 5, MessagesReceived: 1}, txTraffic.Total)
+	require.Equal(t, []TrafficCounts{txTraffic.Total, txTraffic.Total}, txTraffic.Windows)
+	require.Eventually(t, func() bool {
+		return peerTagTraffic(netA.ListPeers()[0], protocol.TxnTag).Total == TrafficCounts{BytesSent: 5, MessagesSent: 1}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, netB.DisconnectPeer(addrA))
+	require.Empty(t, netB.ListPeers())
+
+	// pinned relays are connected to, and reconnected to when they disconnect
+	require.NoError(t, netB.PinPeer(addrA, 0))
+	require.Equal(t, []PeerPin{{Address: addrA}}, netB.ListPinnedPeers())
+	require.Eventually(t, func() bool { return len(netB.ListPeers()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.True(t, netB.ListPeers()[0].Pinned)
+
+	require.NoError(t, netB.DisconnectPeer(addrA))
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool { return len(netB.ListPeers()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, netB.UnpinPeer(addrA))
+	require.Empty(t, netB.ListPinnedPeers())
+	require.ErrorIs(t, netB.UnpinPeer(addrA), ErrPeerNotPinned)
+}
+
+func TestP2PNetworkPeerManagement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netA.Start()
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	peerIDA := peerInfoA.ID.String()
+
+	netB, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netB.Start()
+	defer netB.Stop()
+
+	require.ErrorIs(t, netB.ConnectPeer("/ip4/127.0.0.1/tcp/4190"), ErrBadPeerAddress)
+	require.ErrorIs(t, netB.DisconnectPeer(peerIDA), ErrPeerNotConnected)
+
+	require.NoError(t, netB.ConnectPeer(addrsA[0].String()))
+	require.Eventually(t, func() bool { return len(netB.ListPeers()) == 1 && len(netA.ListPeers()) == 1 }, 5*time.Second, 50*time.Millisecond)
+
+	peerB := netB.ListPeers()[0]
+	require.Equal(t, peerIDA, peerB.PeerID)
+	require.Equal(t, "p2p", peerB.Network)
+	require.Equal(t, PeerRoleRelay, peerB.Role)
+	require.False(t, peerB.ConnectedSince.IsZero())
+	require.Equal(t, PeerRoleClient, netA.ListPeers()[0].Role)
+
+	require.NoError(t, netB.DisconnectPeer(peerIDA))
+	require.Eventually(t, func() bool { return len(netB.ListPeers()) == 0 }, 5*time.Second, 50*time.Millisecond)
+
+	// pinned peers are reconnected to, using the addresses known to the peerstore
+	require.NoError(t, netB.PinPeer(peerIDA, time.Hour))
+	require.Eventually(t, func() bool {
+		peers := netB.ListPeers()
+		return len(peers) == 1 && peers[0].Pinned
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Len(t, netB.ListPinnedPeers(), 1)
+	require.NoError(t, netB.UnpinPeer(peerIDA))
+}
+
+func peerTagTraffic(info PeerInfo, tag protocol.Tag) PeerTagTraffic {
+	for _, traffic := range info.Traffic {
+		if traffic.Tag == tag {
+			return traffic
+		}
+	}
+	return PeerTagTraffic{Tag: tag}
+}
@@ -0,0 +1,297 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+// PeerTrafficWindows are the sliding windows, shortest first, over which the traffic exchanged with each peer is reported.
+var PeerTrafficWindows = []time.Duration{time.Minute, 5 * time.Minute}
+
+const (
+	// peerTrafficBucketDuration is the granularity of the sliding windows
+	peerTrafficBucketDuration = 10 * time.Second
+	// peerTrafficBuckets is the number of buckets kept for each tag, enough to cover the longest window
+	peerTrafficBuckets = 30
+
+	// peerTrafficUnknownTag accounts the messages with a tag missing from protocol.TagMap, so that a peer
+	// can't grow its accounting by making up tags
+	peerTrafficUnknownTag protocol.Tag = "UNK"
+
+	// peerTrafficMetricsPeers bounds the cardinality of the per-peer traffic metrics: only the peers which exchanged
+	// the most bytes over the shortest window get their own peer label, the others are summed up under peerTrafficMetricsOther
+	peerTrafficMetricsPeers = 10
+	peerTrafficMetricsOther = "other"
+)
+
+// TrafficCounts counts the bytes and the messages exchanged with a peer.
+type TrafficCounts struct {
+	BytesReceived    uint64
+	BytesSent        uint64
+	MessagesReceived uint64
+	MessagesSent     uint64
+}
+
+func (c *TrafficCounts) add(o TrafficCounts) {
+	c.BytesReceived += o.BytesReceived
+	c.BytesSent += o.BytesSent
+	c.MessagesReceived += o.MessagesReceived
+	c.MessagesSent += o.MessagesSent
+}
+
+// bytes returns the number of bytes received and sent
+func (c TrafficCounts) bytes() uint64 {
+	return c.BytesReceived + c.BytesSent
+}
+
+// PeerTagTraffic is the traffic exchanged with a peer in the messages of a tag.
+type PeerTagTraffic struct {
+	Tag protocol.Tag
+	// Total counts the traffic since the connection was established.
+	Total TrafficCounts
+	// Windows counts the traffic over each of the PeerTrafficWindows.
+	Windows []TrafficCounts
+}
+
+type trafficBucket struct {
+	// epoch is the index of the peerTrafficBucketDuration period counted by the bucket since the unix epoch
+	epoch  int64
+	counts TrafficCounts
+}
+
+type tagTraffic struct {
+	total   TrafficCounts
+	buckets [peerTrafficBuckets]trafficBucket
+}
+
+// window returns the traffic counted over the window ending at epoch
+func (t *tagTraffic) window(epoch int64, window time.Duration) (counts TrafficCounts) {
+	n := min(int64(window/peerTrafficBucketDuration), peerTrafficBuckets)
+	for e := epoch - n + 1; e <= epoch; e++ {
+		b := &t.buckets[e%peerTrafficBuckets]
+		if b.epoch == e {
+			counts.add(b.counts)
+		}
+	}
+	return counts
+}
+
+// peerTraffic accounts the messages exchanged with a peer by tag, in total and over sliding windows.
+// The zero value is ready to use.
+type peerTraffic struct {
+	mu   deadlock.Mutex
+	tags map[protocol.Tag]*tagTraffic
+}
+
+func trafficEpoch(now time.Time) int64 {
+	return now.UnixNano() / int64(peerTrafficBucketDuration)
+}
+
+func (pt *peerTraffic) addReceived(tag protocol.Tag, n uint64) {
+	pt.add(tag, TrafficCounts{BytesReceived: n, MessagesReceived: 1}, time.Now())
+}
+
+func (pt *peerTraffic) addSent(tag protocol.Tag, n uint64) {
+	pt.add(tag, TrafficCounts{BytesSent: n, MessagesSent: 1}, time.Now())
+}
+
+func (pt *peerTraffic) add(tag protocol.Tag, counts TrafficCounts, now time.Time) {
+	if _, known := protocol.TagMap[tag]; !known {
+		tag = peerTrafficUnknownTag
+	}
+	epoch := trafficEpoch(now)
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	if pt.tags == nil {
+		pt.tags = make(map[protocol.Tag]*tagTraffic)
+	}
+	t := pt.tags[tag]
+	if t == nil {
+		t = &tagTraffic{}
+		pt.tags[tag] = t
+	}
+	t.total.add(counts)
+	b := &t.buckets[epoch%peerTrafficBuckets]
+	if b.epoch != epoch {
+		*b = trafficBucket{epoch: epoch}
+	}
+	b.counts.add(counts)
+}
+
+// snapshot returns the traffic by tag at now, sorted by tag
+func (pt *peerTraffic) snapshot(now time.Time) []PeerTagTraffic {
+	epoch := trafficEpoch(now)
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	traffic := make([]PeerTagTraffic, 0, len(pt.tags))
+	for tag, t := range pt.tags {
+		tt := PeerTagTraffic{Tag: tag, Total: t.total, Windows: make([]TrafficCounts, len(PeerTrafficWindows))}
+		for i, window := range PeerTrafficWindows {
+			tt.Windows[i] = t.window(epoch, window)
+		}
+		traffic = append(traffic, tt)
+	}
+	slices.SortFunc(traffic, func(a, b PeerTagTraffic) int { return cmp.Compare(a.Tag, b.Tag) })
+	return traffic
+}
+
+// window returns the traffic by tag over the window ending at now, leaving out the tags without traffic
+func (pt *peerTraffic) window(now time.Time, window time.Duration) map[protocol.Tag]TrafficCounts {
+	epoch := trafficEpoch(now)
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	counts := make(map[protocol.Tag]TrafficCounts, len(pt.tags))
+	for tag, t := range pt.tags {
+		if c := t.window(epoch, window); c != (TrafficCounts{}) {
+			counts[tag] = c
+		}
+	}
+	return counts
+}
+
+// metricsLabel returns the peer label of the traffic metrics: the peer ID of p2p peers, the address of the others
+func (wp *wsPeer) metricsLabel() string {
+	if c, ok := wp.conn.(*wsPeerConnP2P); ok {
+		return c.stream.Conn().RemotePeer().String()
+	}
+	return wp.peerAddress()
+}
+
+// peerTrafficMetrics exports the traffic exchanged with the peers of the running networks over the shortest of
+// the PeerTrafficWindows, labeled by peer and by tag.
+type peerTrafficMetrics struct {
+	mu      deadlock.Mutex
+	sources []peerSnapshotter
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+func makePeerTrafficMetrics() *peerTrafficMetrics {
+	return &peerTrafficMetrics{now: time.Now}
+}
+
+func (m *peerTrafficMetrics) addSource(source peerSnapshotter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sources = append(m.sources, source)
+}
+
+func (m *peerTrafficMetrics) removeSource(source peerSnapshotter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sources = slices.DeleteFunc(m.sources, func(s peerSnapshotter) bool { return s == source })
+}
+
+type peerTrafficSample struct {
+	peer   string
+	tags   map[protocol.Tag]TrafficCounts
+	volume uint64
+}
+
+// samples returns the window traffic of the busiest peerTrafficMetricsPeers peers, followed by the traffic of
+// the other peers summed up
+func (m *peerTrafficMetrics) samples() []peerTrafficSample {
+	m.mu.Lock()
+	sources := slices.Clone(m.sources)
+	m.mu.Unlock()
+
+	now := m.now()
+	var samples []peerTrafficSample
+	var peers []*wsPeer
+	for _, source := range sources {
+		peers, _ = source.peerSnapshot(peers)
+		for _, wp := range peers {
+			s := peerTrafficSample{tags: wp.traffic.window(now, PeerTrafficWindows[0])}
+			if len(s.tags) == 0 {
+				continue
+			}
+			s.peer = wp.metricsLabel()
+			for _, c := range s.tags {
+				s.volume += c.bytes()
+			}
+			samples = append(samples, s)
+		}
+	}
+	if len(samples) <= peerTrafficMetricsPeers {
+		return samples
+	}
+	slices.SortFunc(samples, func(a, b peerTrafficSample) int { return cmp.Compare(b.volume, a.volume) })
+	other := peerTrafficSample{peer: peerTrafficMetricsOther, tags: make(map[protocol.Tag]TrafficCounts)}
+	for _, s := range samples[peerTrafficMetricsPeers:] {
+		for tag, c := range s.tags {
+			oc := other.tags[tag]
+			oc.add(c)
+			other.tags[tag] = oc
+		}
+	}
+	return append(samples[:peerTrafficMetricsPeers], other)
+}
+
+var peerTrafficMetricFamilies = []struct {
+	name        string
+	description string
+	value       func(TrafficCounts) uint64
+}{
+	{"algod_network_peer_received_bytes", "Bytes received from the peer over the last minute, by tag", func(c TrafficCounts) uint64 { return c.BytesReceived }},
+	{"algod_network_peer_sent_bytes", "Bytes sent to the peer over the last minute, by tag", func(c TrafficCounts) uint64 { return c.BytesSent }},
+	{"algod_network_peer_received_messages", "Messages received from the peer over the last minute, by tag", func(c TrafficCounts) uint64 { return c.MessagesReceived }},
+	{"algod_network_peer_sent_messages", "Messages sent to the peer over the last minute, by tag", func(c TrafficCounts) uint64 { return c.MessagesSent }},
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteMetric is part of the metrics.Metric interface
+func (m *peerTrafficMetrics) WriteMetric(buf *strings.Builder, parentLabels string) {
+	samples := m.samples()
+	if len(samples) == 0 {
+		return
+	}
+	for _, family := range peerTrafficMetricFamilies {
+		buf.WriteString("# HELP " + family.name + " " + family.description + "\n")
+		buf.WriteString("# TYPE " + family.name + " gauge\n")
+		for _, s := range samples {
+			for tag, c := range s.tags {
+				buf.WriteString(family.name + "{")
+				if len(parentLabels) > 0 {
+					buf.WriteString(parentLabels + ",")
+				}
+				buf.WriteString(`peer="` + labelValueEscaper.Replace(s.peer) + `",tag="` + labelValueEscaper.Replace(string(tag)) + `"} `)
+				buf.WriteString(strconv.FormatUint(family.value(c), 10))
+				buf.WriteString("\n")
+			}
+		}
+	}
+}
+
+// WriteOpenMetric is part of the metrics.OpenMetric interface. The traffic is exported as gauges,
+// which are written the same way in OpenMetrics format.
+func (m *peerTrafficMetrics) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	m.WriteMetric(buf, parentLabels)
+}
+
+// AddMetric is part of the metrics.Metric interface. The per-peer traffic is left out of the telemetry heartbeat.
+func (m *peerTrafficMetrics) AddMetric(values map[string]float64) {}
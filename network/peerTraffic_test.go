@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/network/p2p"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/depin-sdk/util/metrics/metricstest"
+)
+
+func TestPeerTraffic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var pt peerTraffic
+	require.Empty(t, pt.snapshot(time.Now()))
+
+	start := time.Unix(1700000000, 0)
+	pt.add(protocol.TxnTag, TrafficCounts{BytesReceived: 100, MessagesReceived: 1}, start)
+	pt.add(protocol.TxnTag, TrafficCounts{BytesSent: 10, MessagesSent: 1}, start.Add(30*time.Second))
+	pt.add(protocol.AgreementVoteTag, TrafficCounts{BytesReceived: 7, MessagesReceived: 1}, start.Add(2*time.Minute))
+	// made up tags are accounted together
+	pt.add(protocol.Tag("zz"), TrafficCounts{BytesReceived: 1, MessagesReceived: 1}, start)
+	pt.add(protocol.Tag("zy"), TrafficCounts{BytesReceived: 2, MessagesReceived: 1}, start)
+
+	traffic := pt.snapshot(start.Add(45 * time.Second))
+	require.Equal(t, []PeerTagTraffic{
+		{Tag: protocol.AgreementVoteTag, Total: TrafficCounts{BytesReceived: 7, MessagesReceived: 1}, Windows: []TrafficCounts{{}, {}}},
+		{Tag: protocol.TxnTag, Total: TrafficCounts{BytesReceived: 100, BytesSent: 10, MessagesReceived: 1, MessagesSent: 1},
+			Windows: []TrafficCounts{{BytesReceived: 100, BytesSent: 10, MessagesReceived: 1, MessagesSent: 1}, {BytesReceived: 100, BytesSent: 10, MessagesReceived: 1, MessagesSent: 1}}},
+		{Tag: peerTrafficUnknownTag, Total: TrafficCounts{BytesReceived: 3, MessagesReceived: 2},
+			Windows: []TrafficCounts{{BytesReceived: 3, MessagesReceived: 2}, {BytesReceived: 3, MessagesReceived: 2}}},
+	}, traffic)
+
+	// the first messages slide out of the one minute window, then out of the five minutes window
+	traffic = pt.snapshot(start.Add(2*time.Minute + 5*time.Second))
+	require.Equal(t, []TrafficCounts{{BytesReceived: 7, MessagesReceived: 1}, {BytesReceived: 7, MessagesReceived: 1}}, traffic[0].Windows)
+	require.Equal(t, []TrafficCounts{{}, {BytesReceived: 100, BytesSent: 10, MessagesReceived: 1, MessagesSent: 1}}, traffic[1].Windows)
+
+	traffic = pt.snapshot(start.Add(6 * time.Minute))
+	require.Equal(t, []TrafficCounts{{}, {}}, traffic[1].Windows)
+	require.Equal(t, TrafficCounts{BytesReceived: 100, BytesSent: 10, MessagesReceived: 1, MessagesSent: 1}, traffic[1].Total)
+
+	// buckets are reused once they slide out of the windows
+	reuse := start.Add(peerTrafficBuckets*peerTrafficBucketDuration + 30*time.Second)
+	pt.add(protocol.TxnTag, TrafficCounts{BytesReceived: 1, MessagesReceived: 1}, reuse)
+	traffic = pt.snapshot(reuse)
+	require.Equal(t, []TrafficCounts{{BytesReceived: 1, MessagesReceived: 1}, {BytesReceived: 1, MessagesReceived: 1}}, traffic[1].Windows)
+
+	require.Equal(t, map[protocol.Tag]TrafficCounts{protocol.TxnTag: {BytesReceived: 1, MessagesReceived: 1}},
+		pt.window(reuse, time.Minute))
+}
+
+type testPeerSnapshotter struct {
+	peers []*wsPeer
+}
+
+func (s *testPeerSnapshotter) peerSnapshot(dest []*wsPeer) ([]*wsPeer, int32) {
+	return append(dest[:0], s.peers...), 0
+}
+
+func TestPeerTrafficMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	m := makePeerTrafficMetrics()
+	m.now = func() time.Time { return now }
+
+	var buf strings.Builder
+	m.WriteMetric(&buf, "")
+	require.Empty(t, buf.String())
+
+	peers := &testPeerSnapshotter{}
+	for i := 0; i < peerTrafficMetricsPeers+2; i++ {
+		wp := &wsPeer{wsPeerCore: wsPeerCore{originAddress: fmt.Sprintf("10.0.0.%d", i)}}
+		wp.traffic.add(protocol.TxnTag, TrafficCounts{BytesReceived: uint64(100 + i), MessagesReceived: 1}, now)
+		peers.peers = append(peers.peers, wp)
+	}
+	// traffic which slid out of the window is not exported
+	idle := &wsPeer{wsPeerCore: wsPeerCore{originAddress: "10.0.1.1"}}
+	idle.traffic.add(protocol.TxnTag, TrafficCounts{BytesReceived: 1000, MessagesReceived: 1}, now.Add(-time.Hour))
+	peers.peers = append(peers.peers, idle)
+	m.addSource(peers)
+
+	buf.Reset()
+	m.WriteMetric(&buf, `host="a"`)
+	out := buf.String()
+	require.Contains(t, out, "# TYPE algod_network_peer_received_bytes gauge\n")
+	require.Contains(t, out, `algod_network_peer_received_bytes{host="a",peer="10.0.0.11",tag="TX"} 111`+"\n")
+	require.Contains(t, out, `algod_network_peer_received_messages{host="a",peer="10.0.0.2",tag="TX"} 1`+"\n")
+	// the two least busy peers are summed up
+	require.NotContains(t, out, `peer="10.0.0.0"`)
+	require.NotContains(t, out, `peer="10.0.0.1"`)
+	require.Contains(t, out, `algod_network_peer_received_bytes{host="a",peer="other",tag="TX"} 201`+"\n")
+	require.Contains(t, out, `algod_network_peer_received_messages{host="a",peer="other",tag="TX"} 2`+"\n")
+	require.NotContains(t, out, "10.0.1.1")
+	require.Equal(t, peerTrafficMetricsPeers+1, strings.Count(out, "algod_network_peer_sent_bytes{"))
+
+	// the metrics are valid OpenMetrics, as exported to scrapers asking for it
+	reg := metrics.MakeRegistry()
+	reg.Register(m)
+	buf.Reset()
+	reg.WriteOpenMetrics(&buf, `host="a"`)
+	families, err := metricstest.ParseOpenMetrics(buf.String())
+	require.NoError(t, err, buf.String())
+	require.Len(t, families, len(peerTrafficMetricFamilies))
+
+	m.removeSource(peers)
+	buf.Reset()
+	m.WriteMetric(&buf, "")
+	require.Empty(t, buf.String())
+}
+
+func TestPubsubTracerPeerTraffic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var traffic peerTraffic
+	known := peer.ID("known")
+	tracer := pubsubMetricsTracer{peerTraffic: func(id peer.ID) *peerTraffic {
+		if id == known {
+			return &traffic
+		}
+		return nil
+	}}
+
+	topic := p2p.TXTopicName
+	otherTopic := "other"
+	tracer.ValidateMessage(&pubsub.Message{Message: &pb.Message{Data: []byte("txn"), Topic: &topic}, ReceivedFrom: known})
+	tracer.DuplicateMessage(&pubsub.Message{Message: &pb.Message{Data: []byte("txn"), Topic: &topic}, ReceivedFrom: known})
+	tracer.ValidateMessage(&pubsub.Message{Message: &pb.Message{Data: []byte("txn"), Topic: &topic}, ReceivedFrom: peer.ID("unknown")})
+	tracer.ValidateMessage(&pubsub.Message{Message: &pb.Message{Data: []byte("other"), Topic: &otherTopic}, ReceivedFrom: known})
+	tracer.SendRPC(&pubsub.RPC{RPC: pb.RPC{Publish: []*pb.Message{{Data: []byte("txn1"), Topic: &topic}, {Data: []byte("x"), Topic: &otherTopic}}}}, known)
+
+	snapshot := traffic.snapshot(time.Now())
+	require.Len(t, snapshot, 1)
+	require.Equal(t, protocol.TxnTag, snapshot[0].Tag)
+	require.Equal(t, TrafficCounts{BytesReceived: 6, BytesSent: 4, MessagesReceived: 2, MessagesSent: 1}, snapshot[0].Total)
+
+	// a tracer without a peer lookup only updates the global metrics
+	pubsubMetricsTracer{}.ValidateMessage(&pubsub.Message{Message: &pb.Message{Data: []byte("txn"), Topic: &topic}, ReceivedFrom: known})
+}
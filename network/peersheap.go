@@ -74,15 +74,11 @@ func (ph peersHeap) Less(i, j int) bool {
 }
 
 func checkPrioPeers(wn *WebsocketNetwork, wp *wsPeer) bool {
-	pp := wn.config.PriorityPeers
-	if pp == nil {
-		return false
-	}
-
 	addr := wp.OriginAddress()
 	if addr == "" {
 		return false
 	}
 
-	return pp[addr]
+	// peers pinned by the node operator get the priority of the configured ones
+	return wn.config.PriorityPeers[addr] || wn.pins.isPinned(addr)
 }
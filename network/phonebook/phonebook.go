@@ -56,6 +56,8 @@ const (
 	RelayRole Role = 1 << iota
 	// ArchivalRole used for all the archival nodes that are provided via the archive SRV record.
 	ArchivalRole
+	// LocalRole used for the peers discovered on the local network segment via mDNS.
+	LocalRole
 )
 
 // MakeRoleSet creates a new RoleSet with the passed role
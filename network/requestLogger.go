@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/logging/telemetryspec"
@@ -35,6 +36,8 @@ type RequestLogger struct {
 	downsteamHandler    http.Handler
 	trackingWritersPool sync.Pool
 	log                 logging.Logger
+	// enabled is the EnableRequestLogger setting. Requests are passed through without logging when it is off.
+	enabled atomic.Bool
 }
 
 // create a RequestLogger object.
@@ -53,6 +56,10 @@ func makeRequestLogger(downsteamHandler http.Handler, log logging.Logger) *Reque
 
 // this is the http entry point for the request logger.
 func (rl *RequestLogger) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if !rl.enabled.Load() {
+		rl.downsteamHandler.ServeHTTP(writer, request)
+		return
+	}
 	trackingWriter := rl.trackingWritersPool.Get().(*trackingResponseWriter)
 	trackingWriter.Reset(writer)
 	defer func() {
@@ -84,7 +91,7 @@ func (rl *RequestLogger) logRequest(trackingWriter *trackingResponseWriter, requ
 
 // SetStatusCode sets the status code of a given response writer without writing it to the underlaying writer object.
 func (rl *RequestLogger) SetStatusCode(writer http.ResponseWriter, statusCode int) {
-	if trackingWriter := writer.(*trackingResponseWriter); trackingWriter != nil {
+	if trackingWriter, ok := writer.(*trackingResponseWriter); ok {
 		trackingWriter.statusCode = statusCode
 	}
 }
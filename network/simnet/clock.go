@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simnet
+
+import (
+	"time"
+
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/util/timers"
+)
+
+type simTimeout struct {
+	delta time.Duration
+	ch    <-chan time.Time
+}
+
+// Clock is a timers.Clock following the virtual clock of a Network, so that the timeouts of the nodes fire
+// in order with the message deliveries.
+type Clock[TimeoutType comparable] struct {
+	sim      *Network
+	zero     time.Duration
+	timeouts map[TimeoutType]simTimeout
+}
+
+// MakeClock creates a new clock zeroed at the current virtual time of the network.
+func MakeClock[TimeoutType comparable](sim *Network) timers.Clock[TimeoutType] {
+	return &Clock[TimeoutType]{
+		sim:  sim,
+		zero: sim.Now(),
+	}
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (c *Clock[TimeoutType]) Zero() timers.Clock[TimeoutType] {
+	return MakeClock[TimeoutType](c.sim)
+}
+
+// Since returns the virtual time elapsed since the clock was zeroed.
+func (c *Clock[TimeoutType]) Since() time.Duration {
+	return c.sim.Now() - c.zero
+}
+
+// TimeoutAt returns a channel closed when the virtual clock reaches delta past the zero of the clock.
+func (c *Clock[TimeoutType]) TimeoutAt(delta time.Duration, timeoutType TimeoutType) <-chan time.Time {
+	if c.timeouts == nil {
+		c.timeouts = make(map[TimeoutType]simTimeout)
+	}
+
+	tmt, ok := c.timeouts[timeoutType]
+	if ok && tmt.delta == delta {
+		// if the new timeout is the same as the current one for that type,
+		// return the existing channel.
+		return tmt.ch
+	}
+
+	ch := make(chan time.Time)
+	tmt = simTimeout{delta: delta, ch: ch}
+	c.sim.mu.Lock()
+	if target := c.zero + delta; target <= c.sim.now {
+		close(ch)
+	} else {
+		c.sim.schedule(target, func() { close(ch) })
+	}
+	c.sim.mu.Unlock()
+	c.timeouts[timeoutType] = tmt
+	return tmt.ch
+}
+
+// Encode implements timers.Clock.Encode.
+func (c *Clock[TimeoutType]) Encode() []byte {
+	return protocol.EncodeReflect(c.zero)
+}
+
+// Decode implements timers.Clock.Decode. The decoded clock follows the same network.
+func (c *Clock[TimeoutType]) Decode(data []byte) (timers.Clock[TimeoutType], error) {
+	var zero time.Duration
+	err := protocol.DecodeReflect(data, &zero)
+	return &Clock[TimeoutType]{sim: c.sim, zero: zero}, err
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simnet
+
+import (
+	"bytes"
+	"runtime"
+	"time"
+)
+
+// settle returns once every goroutine other than the calling one is blocked, so that the work the nodes do on their
+// own goroutines in reaction to the last event, such as handling a delivered message queued by a handler or acting on
+// a timeout, is done before the virtual clock moves again. Goroutines waiting on the real clock count as blocked.
+func settle() {
+	buf := make([]byte, 64<<10)
+	for {
+		runtime.Gosched()
+		n := runtime.Stack(buf, true)
+		if n == len(buf) {
+			buf = make([]byte, 2*len(buf))
+			continue
+		}
+		if !anyGoroutineBusy(buf[:n]) {
+			return
+		}
+		// only paces the polling while the other goroutines work, the outcome doesn't depend on it
+		time.Sleep(50 * time.Microsecond)
+	}
+}
+
+// anyGoroutineBusy tells whether any goroutine of a runtime.Stack dump, except the first one which is the caller,
+// is running, runnable or in a system call.
+func anyGoroutineBusy(dump []byte) bool {
+	for i, g := range bytes.Split(dump, []byte("\n\n")) {
+		if i == 0 {
+			continue
+		}
+		header, stack, _ := bytes.Cut(g, []byte("\n"))
+		start := bytes.IndexByte(header, '[')
+		end := bytes.LastIndexByte(header, ']')
+		if start < 0 || end < start {
+			continue
+		}
+		status, _, _ := bytes.Cut(header[start+1:end], []byte(","))
+		switch string(status) {
+		case "running", "runnable", "preempted":
+			return true
+		case "syscall":
+			// the signal handling goroutine waits for signals in a system call
+			if !bytes.Contains(stack, []byte("os/signal.signal_recv")) {
+				return true
+			}
+		}
+	}
+	return false
+}
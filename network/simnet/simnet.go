@@ -0,0 +1,781 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package simnet simulates a network of nodes in memory, driven by a virtual clock, for multi-node tests.
+package simnet
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"github.com/gorilla/mux"
+
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+// Link describes the delivery characteristics of the link between two simulated nodes.
+type Link struct {
+	// Latency is the base delay of every message sent over the link
+	Latency time.Duration
+	// Jitter is the upper bound of a random delay added to Latency. Messages are still delivered in order.
+	Jitter time.Duration
+	// LossRate is the probability, between 0 and 1, that a message sent over the link is dropped
+	LossRate float64
+}
+
+// Config configures a Network.
+type Config struct {
+	GenesisID string
+	// Seed drives the jitter and the message loss of every link, so that a given seed always yields the same deliveries
+	// over each link, whatever the interleaving of the messages the nodes send over different links
+	Seed int64
+	// Link is used by every pair of nodes without a link set with SetLink
+	Link Link
+}
+
+var errPeerClosing = errors.New("simulated peer closing")
+
+// Network is an in-memory network of simulated nodes, each implementing network.GossipNode, driven by a virtual clock.
+//
+// Nothing is delivered until the virtual clock is moved forward by Advance or Step: every message sent is queued
+// with a delivery time computed from the link latency and jitter, and the message handlers of the receiving node are
+// run synchronously, in delivery time order, by the goroutine advancing the clock. Before running each event, Advance
+// and Step wait for every other goroutine of the process to block, so that the messages the nodes send from their own
+// goroutines, as a full node does once a handler queued a message, are sent at the virtual time of the event they
+// react to. Deliveries are therefore deterministic for a given seed, as long as the nodes don't act on the real clock
+// or on their own random choices.
+//
+// HTTP requests made through GetHTTPClient are served immediately by the target node handlers, outside of the
+// virtual clock, and fail between nodes which are partitioned or stopped.
+type Network struct {
+	log       logging.Logger
+	genesisID string
+
+	mu     deadlock.Mutex
+	now    time.Duration
+	seq    uint64
+	events simEventHeap
+	seed   int64
+
+	nodes  []*Node
+	byHost map[string]*Node
+	conns  []*simConn
+
+	defaultLink Link
+	links       map[[2]*Node]Link
+	// rngs holds the random generator of each directed link, seeded from the network seed and the link ends
+	rngs map[[2]*Node]*rand.Rand
+	// lastDelivery keeps the messages of each directed link in order when jitter is set
+	lastDelivery map[[2]*Node]time.Duration
+	// groups maps every node to its partition group, nil when the network is not partitioned
+	groups map[*Node]int
+}
+
+// MakeNetwork creates an empty simulated network with its virtual clock at zero.
+func MakeNetwork(log logging.Logger, cfg Config) *Network {
+	return &Network{
+		log:          log,
+		genesisID:    cfg.GenesisID,
+		seed:         cfg.Seed,
+		byHost:       make(map[string]*Node),
+		defaultLink:  cfg.Link,
+		links:        make(map[[2]*Node]Link),
+		rngs:         make(map[[2]*Node]*rand.Rand),
+		lastDelivery: make(map[[2]*Node]time.Duration),
+	}
+}
+
+// AddNode adds a stopped node to the network. The name must be a valid host name and unique within the network.
+// Relay nodes forward the messages their handlers ask to broadcast or accept, the others only forward their own.
+func (sn *Network) AddNode(name string, relay bool) *Node {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	if _, has := sn.byHost[name]; has {
+		panic(fmt.Sprintf("simulated node %s already exists", name))
+	}
+	n := &Node{
+		sim:     sn,
+		name:    name,
+		index:   len(sn.nodes),
+		relay:   relay,
+		log:     sn.log.With("simnode", name),
+		handler: network.MakeMultiplexer(),
+		router:  mux.NewRouter(),
+	}
+	sn.nodes = append(sn.nodes, n)
+	sn.byHost[name] = n
+	return n
+}
+
+// Nodes returns the nodes of the network in the order they were added.
+func (sn *Network) Nodes() []*Node {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	return append([]*Node(nil), sn.nodes...)
+}
+
+// Connect adds an outgoing connection from one node to another. The connection is established as soon as both nodes
+// are started, and is dialed again by RequestConnectOutgoing when dropped by either side.
+func (sn *Network) Connect(from, to *Node) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	if from == to || sn.findConn(from, to) != nil {
+		return
+	}
+	c := &simConn{dialer: from, listener: to}
+	sn.conns = append(sn.conns, c)
+	sn.establish(c)
+}
+
+// Disconnect removes the connection between two nodes for good, closing it if established.
+func (sn *Network) Disconnect(a, b *Node) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	c := sn.findConn(a, b)
+	if c == nil {
+		return
+	}
+	sn.teardown(c)
+	for i := range sn.conns {
+		if sn.conns[i] == c {
+			sn.conns = append(sn.conns[:i], sn.conns[i+1:]...)
+			break
+		}
+	}
+}
+
+// SetLink overrides the default link between two nodes, in both directions.
+func (sn *Network) SetLink(a, b *Node, link Link) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	sn.links[[2]*Node{a, b}] = link
+	sn.links[[2]*Node{b, a}] = link
+}
+
+// Partition splits the network into the given groups of nodes, with the nodes not listed forming one more group.
+// Connections stay established across the partition but the messages and the HTTP requests between groups are lost,
+// including the messages already in flight, until Heal is called.
+func (sn *Network) Partition(groups ...[]*Node) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	sn.groups = make(map[*Node]int)
+	for i, group := range groups {
+		for _, n := range group {
+			sn.groups[n] = i + 1
+		}
+	}
+}
+
+// Heal removes the partition set by Partition.
+func (sn *Network) Heal() {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	sn.groups = nil
+}
+
+// Now returns the virtual time elapsed since the network was created.
+func (sn *Network) Now() time.Duration {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	return sn.now
+}
+
+// At schedules f to run at the given virtual time, or at the next step if that time has already passed.
+// This is the way to script topology faults, such as a partition, in between deliveries.
+func (sn *Network) At(at time.Duration, f func()) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	sn.schedule(max(at, sn.now), f)
+}
+
+// Pending returns the number of scheduled events, messages in flight and clock timeouts included.
+func (sn *Network) Pending() int {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+	return len(sn.events)
+}
+
+// Step moves the virtual clock to the next scheduled event and runs it, returning once the nodes are done handling
+// it. It returns false if there was none.
+func (sn *Network) Step() bool {
+	settle()
+	return sn.step(math.MaxInt64)
+}
+
+// Advance moves the virtual clock forward by d, running every event scheduled up to then, including the ones scheduled
+// by the events themselves or by the nodes handling them.
+func (sn *Network) Advance(d time.Duration) {
+	settle()
+	target := sn.Now() + d
+	for sn.step(target) {
+	}
+	sn.mu.Lock()
+	sn.now = max(sn.now, target)
+	sn.mu.Unlock()
+}
+
+func (sn *Network) step(limit time.Duration) bool {
+	sn.mu.Lock()
+	if len(sn.events) == 0 || sn.events[0].at > limit {
+		sn.mu.Unlock()
+		return false
+	}
+	ev := heap.Pop(&sn.events).(*simEvent)
+	sn.now = max(sn.now, ev.at)
+	sn.mu.Unlock()
+
+	ev.run()
+	settle()
+	return true
+}
+
+// schedule must be called with sn.mu held.
+func (sn *Network) schedule(at time.Duration, run func()) {
+	sn.seq++
+	heap.Push(&sn.events, &simEvent{at: at, seq: sn.seq, run: run})
+}
+
+// reachable must be called with sn.mu held.
+func (sn *Network) reachable(a, b *Node) bool {
+	return a.running && b.running && (sn.groups == nil || sn.groups[a] == sn.groups[b])
+}
+
+// findConn must be called with sn.mu held.
+func (sn *Network) findConn(a, b *Node) *simConn {
+	for _, c := range sn.conns {
+		if (c.dialer == a && c.listener == b) || (c.dialer == b && c.listener == a) {
+			return c
+		}
+	}
+	return nil
+}
+
+// establish creates the peers of a connection when possible, and must be called with sn.mu held.
+func (sn *Network) establish(c *simConn) {
+	if c.dropped || c.dialerPeer != nil || !c.dialer.running || !c.listener.running {
+		return
+	}
+	c.dialerPeer = makeSimPeer(c.dialer, c.listener, true)
+	c.listenerPeer = makeSimPeer(c.listener, c.dialer, false)
+}
+
+// teardown closes the peers of a connection, and must be called with sn.mu held.
+func (sn *Network) teardown(c *simConn) {
+	if c.dialerPeer == nil {
+		return
+	}
+	close(c.dialerPeer.closing)
+	close(c.listenerPeer.closing)
+	c.dialerPeer, c.listenerPeer = nil, nil
+}
+
+// send queues data for delivery from a node to one of its peers.
+func (sn *Network) send(from *Node, to *Node, tag protocol.Tag, data []byte) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	c := sn.findConn(from, to)
+	if c == nil || c.dialerPeer == nil {
+		return
+	}
+	receiver := c.peerOf(to)
+
+	key := [2]*Node{from, to}
+	link, ok := sn.links[key]
+	if !ok {
+		link = sn.defaultLink
+	}
+	rng, ok := sn.rngs[key]
+	if !ok {
+		rng = rand.New(rand.NewSource(sn.seed ^ int64(from.index)<<32 ^ int64(to.index)))
+		sn.rngs[key] = rng
+	}
+	// always draw from the generator so that the loss of one message doesn't shift the jitter of the others
+	lost := rng.Float64() < link.LossRate
+	delay := link.Latency
+	if link.Jitter > 0 {
+		delay += time.Duration(rng.Int63n(int64(link.Jitter) + 1))
+	}
+	if lost {
+		return
+	}
+	at := max(sn.now+delay, sn.lastDelivery[key])
+	sn.lastDelivery[key] = at
+
+	data = append([]byte(nil), data...)
+	sn.schedule(at, func() {
+		sn.deliver(from, to, receiver, tag, data)
+	})
+}
+
+func (sn *Network) deliver(from *Node, to *Node, sender *simPeer, tag protocol.Tag, data []byte) {
+	sn.mu.Lock()
+	c := sn.findConn(from, to)
+	live := c != nil && c.peerOf(to) == sender && sn.reachable(from, to)
+	received := sn.now
+	sn.mu.Unlock()
+	if !live {
+		return
+	}
+	// the virtual clock starts at the unix epoch
+	to.dispatch(network.IncomingMessage{Sender: sender, Tag: tag, Data: data, Net: to, Received: int64(received)})
+}
+
+// simConn is a connection between two nodes, dialed by one of them.
+type simConn struct {
+	dialer, listener *Node
+	// dropped is set when either side disconnected, until the dialer reconnects
+	dropped bool
+	// dialerPeer and listenerPeer are the peers on each side, nil while the connection is not established
+	dialerPeer, listenerPeer *simPeer
+}
+
+func (c *simConn) peerOf(n *Node) *simPeer {
+	if c.dialer == n {
+		return c.dialerPeer
+	}
+	return c.listenerPeer
+}
+
+// Node is a node of a Network.
+type Node struct {
+	// GossipNode is nil, it only provides the unexported methods of the interface, which are only called by the
+	// websocket peers
+	network.GossipNode
+
+	sim   *Network
+	name  string
+	index int
+	relay bool
+	log   logging.Logger
+
+	handler *network.Multiplexer
+	router  *mux.Router
+
+	// running is protected by sim.mu
+	running bool
+}
+
+// Name returns the name given to the node by AddNode.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// Address implements network.GossipNode.Address
+func (n *Node) Address() (string, bool) {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	return "http://" + n.name, n.running
+}
+
+// Broadcast implements network.GossipNode.Broadcast
+func (n *Node) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	for _, peer := range n.GetPeers(network.PeersConnectedOut, network.PeersConnectedIn) {
+		if peer == except {
+			continue
+		}
+		n.sim.send(n, peer.(*simPeer).remote, tag, data)
+	}
+	return nil
+}
+
+// Relay implements network.GossipNode.Relay
+func (n *Node) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	if n.relay {
+		return n.Broadcast(ctx, tag, data, wait, except)
+	}
+	return nil
+}
+
+// Disconnect implements network.GossipNode.Disconnect
+func (n *Node) Disconnect(badnode network.DisconnectablePeer) {
+	peer, ok := badnode.(*simPeer)
+	if !ok {
+		return
+	}
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	if c := n.sim.findConn(n, peer.remote); c != nil && c.peerOf(n) == peer {
+		n.sim.teardown(c)
+		c.dropped = true
+	}
+}
+
+// DisconnectPeers implements network.GossipNode.DisconnectPeers
+func (n *Node) DisconnectPeers() {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	for _, c := range n.sim.conns {
+		if (c.dialer == n || c.listener == n) && c.dialerPeer != nil {
+			n.sim.teardown(c)
+			c.dropped = true
+		}
+	}
+}
+
+// RegisterHTTPHandler implements network.GossipNode.RegisterHTTPHandler
+func (n *Node) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.router.Handle(path, handler)
+}
+
+// RegisterHTTPHandlerFunc implements network.GossipNode.RegisterHTTPHandlerFunc
+func (n *Node) RegisterHTTPHandlerFunc(path string, handler func(http.ResponseWriter, *http.Request)) {
+	n.router.HandleFunc(path, handler)
+}
+
+// RequestConnectOutgoing implements network.GossipNode.RequestConnectOutgoing by dialing again the dropped connections of
+// the node, or all of its connections when replace is set.
+func (n *Node) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	for _, c := range n.sim.conns {
+		if c.dialer != n {
+			continue
+		}
+		if replace {
+			n.sim.teardown(c)
+		}
+		c.dropped = false
+		n.sim.establish(c)
+	}
+}
+
+// GetPeers implements network.GossipNode.GetPeers. Every relay node of the network is both in the phonebook of relays and
+// in the phonebook of archival nodes.
+func (n *Node) GetPeers(options ...network.PeerOption) []network.Peer {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	peers := make([]network.Peer, 0)
+	for _, option := range options {
+		switch option {
+		case network.PeersConnectedOut, network.PeersConnectedIn:
+			for _, c := range n.sim.conns {
+				if c.dialerPeer == nil {
+					continue
+				}
+				if (option == network.PeersConnectedOut && c.dialer == n) || (option == network.PeersConnectedIn && c.listener == n) {
+					peers = append(peers, c.peerOf(n))
+				}
+			}
+		case network.PeersPhonebookRelays, network.PeersPhonebookArchivalNodes:
+			for _, other := range n.sim.nodes {
+				if other != n && other.relay {
+					peers = append(peers, &simHTTPPeer{local: n, remote: other})
+				}
+			}
+		}
+	}
+	return peers
+}
+
+// Start implements network.GossipNode.Start, establishing the connections with the started nodes.
+func (n *Node) Start() error {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.running = true
+	for _, c := range n.sim.conns {
+		n.sim.establish(c)
+	}
+	return nil
+}
+
+// Stop implements network.GossipNode.Stop, closing the connections of the node.
+func (n *Node) Stop() {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.running = false
+	for _, c := range n.sim.conns {
+		if c.dialer == n || c.listener == n {
+			n.sim.teardown(c)
+		}
+	}
+}
+
+// RegisterHandlers implements network.GossipNode.RegisterHandlers
+func (n *Node) RegisterHandlers(dispatch []network.TaggedMessageHandler) {
+	n.handler.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers implements network.GossipNode.ClearHandlers
+func (n *Node) ClearHandlers() {
+	n.handler.ClearHandlers(nil)
+}
+
+// RegisterValidatorHandlers implements network.GossipNode.RegisterValidatorHandlers. Validators are only used for the tags
+// without a regular handler.
+func (n *Node) RegisterValidatorHandlers(dispatch []network.TaggedMessageValidatorHandler) {
+	n.handler.RegisterValidatorHandlers(dispatch)
+}
+
+// ClearValidatorHandlers implements network.GossipNode.ClearValidatorHandlers
+func (n *Node) ClearValidatorHandlers() {
+	n.handler.ClearValidatorHandlers(nil)
+}
+
+// GetHTTPClient implements network.GossipNode.GetHTTPClient
+func (n *Node) GetHTTPClient(address string) (*http.Client, error) {
+	return &http.Client{Transport: simTransport{from: n}}, nil
+}
+
+// OnNetworkAdvance implements network.GossipNode.OnNetworkAdvance
+func (n *Node) OnNetworkAdvance() {}
+
+// GetGenesisID implements network.GossipNode.GetGenesisID
+func (n *Node) GetGenesisID() string {
+	return n.sim.genesisID
+}
+
+// dispatch runs the handlers of the node for an incoming message, following the forwarding policy they return.
+func (n *Node) dispatch(msg network.IncomingMessage) {
+	sender := msg.Sender.(*simPeer)
+	if msg.Tag == protocol.TopicMsgRespTag {
+		sender.handleResponse(msg.Data)
+		return
+	}
+
+	var outmsg network.OutgoingMessage
+	if n.handler.HasHandler(msg.Tag) {
+		outmsg = n.handler.Handle(msg)
+	} else {
+		outmsg = n.handler.ValidateHandle(msg)
+	}
+	switch outmsg.Action {
+	case network.Disconnect:
+		n.Disconnect(sender)
+	case network.Broadcast:
+		n.Broadcast(context.Background(), msg.Tag, msg.Data, false, sender)
+	case network.Accept:
+		n.Relay(context.Background(), msg.Tag, msg.Data, false, sender)
+	case network.Respond:
+		err := sender.Respond(context.Background(), msg, outmsg)
+		if err != nil && err != errPeerClosing {
+			n.log.Warnf("Node.dispatch: simPeer.Respond returned unexpected error %v", err)
+		}
+		return
+	}
+	if outmsg.OnRelease != nil {
+		outmsg.OnRelease()
+	}
+}
+
+// simPeer is the peer of a node on one side of a simulated connection.
+type simPeer struct {
+	local, remote *Node
+	outgoing      bool
+	closing       chan struct{}
+
+	requestNonce     uint64
+	responseChannels map[uint64]chan *network.Response
+	mu               deadlock.Mutex
+}
+
+func makeSimPeer(local, remote *Node, outgoing bool) *simPeer {
+	return &simPeer{
+		local:            local,
+		remote:           remote,
+		outgoing:         outgoing,
+		closing:          make(chan struct{}),
+		responseChannels: make(map[uint64]chan *network.Response),
+	}
+}
+
+// GetAddress implements network.UnicastPeer and network.HTTPPeer
+func (p *simPeer) GetAddress() string {
+	return "http://" + p.remote.name
+}
+
+// GetHTTPClient implements network.HTTPPeer
+func (p *simPeer) GetHTTPClient() *http.Client {
+	client, _ := p.local.GetHTTPClient(p.GetAddress())
+	return client
+}
+
+// GetNetwork implements network.DisconnectablePeer
+func (p *simPeer) GetNetwork() network.GossipNode {
+	return p.local
+}
+
+// RoutingAddr implements IPAddressable with a private address unique to the remote node
+func (p *simPeer) RoutingAddr() []byte {
+	return []byte{10, 0, byte(p.remote.index >> 8), byte(p.remote.index)}
+}
+
+// Version implements network.UnicastPeer
+func (p *simPeer) Version() string {
+	return network.SupportedProtocolVersions[0]
+}
+
+// Unicast implements network.UnicastPeer
+func (p *simPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	select {
+	case <-p.closing:
+		return errPeerClosing
+	default:
+	}
+	p.local.sim.send(p.local, p.remote, tag, data)
+	return nil
+}
+
+// Request implements network.UnicastPeer, waiting for the response to be delivered by the virtual clock.
+func (p *simPeer) Request(ctx context.Context, tag network.Tag, topics network.Topics) (resp *network.Response, e error) {
+	p.mu.Lock()
+	p.requestNonce++
+	topics = append(topics, network.MakeNonceTopic(p.requestNonce))
+	serializedMsg := topics.MarshallTopics()
+	hash := network.HashTopics(serializedMsg)
+	responseChannel := make(chan *network.Response, 1)
+	p.responseChannels[hash] = responseChannel
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.responseChannels, hash)
+		p.mu.Unlock()
+	}()
+
+	if err := p.Unicast(ctx, serializedMsg, tag); err != nil {
+		return nil, err
+	}
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-p.closing:
+		return nil, errPeerClosing
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond implements network.UnicastPeer
+func (p *simPeer) Respond(ctx context.Context, reqMsg network.IncomingMessage, outMsg network.OutgoingMessage) (e error) {
+	if outMsg.OnRelease != nil {
+		defer outMsg.OnRelease()
+	}
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, network.HashTopics(reqMsg.Data))
+	responseTopics := append(outMsg.Topics, network.MakeTopic(network.RequestHashKey, requestHashData))
+	return p.Unicast(ctx, responseTopics.MarshallTopics(), protocol.TopicMsgRespTag)
+}
+
+func (p *simPeer) handleResponse(data []byte) {
+	topics, err := network.UnmarshallTopics(data)
+	if err != nil {
+		p.local.log.Warnf("simPeer: could not read the response from %s: %v", p.remote.name, err)
+		return
+	}
+	requestHash, found := topics.GetValue(network.RequestHashKey)
+	if !found {
+		p.local.log.Warnf("simPeer: response from %s is missing the %s", p.remote.name, network.RequestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	p.mu.Lock()
+	channel, found := p.responseChannels[hashKey]
+	delete(p.responseChannels, hashKey)
+	p.mu.Unlock()
+	if found {
+		channel <- &network.Response{Topics: topics}
+	}
+}
+
+// simHTTPPeer is a phonebook entry, which can only be used for HTTP requests.
+type simHTTPPeer struct {
+	local, remote *Node
+}
+
+// GetAddress implements network.HTTPPeer
+func (p *simHTTPPeer) GetAddress() string {
+	return "http://" + p.remote.name
+}
+
+// GetHTTPClient implements network.HTTPPeer
+func (p *simHTTPPeer) GetHTTPClient() *http.Client {
+	client, _ := p.local.GetHTTPClient(p.GetAddress())
+	return client
+}
+
+// simTransport serves the HTTP requests of a node with the handlers registered by the target node.
+type simTransport struct {
+	from *Node
+}
+
+func (t simTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sn := t.from.sim
+	sn.mu.Lock()
+	to, ok := sn.byHost[req.URL.Host]
+	reachable := ok && sn.reachable(t.from, to)
+	sn.mu.Unlock()
+	if !reachable {
+		return nil, fmt.Errorf("simulated node %s can't reach %s", t.from.name, req.URL.Host)
+	}
+
+	serverReq := req.Clone(req.Context())
+	if serverReq.Body == nil {
+		serverReq.Body = http.NoBody
+	}
+	serverReq.RemoteAddr = t.from.name + ":0"
+	serverReq.RequestURI = req.URL.RequestURI()
+	recorder := httptest.NewRecorder()
+	to.router.ServeHTTP(recorder, serverReq)
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+var _ network.GossipNode = (*Node)(nil)
+var _ network.UnicastPeer = (*simPeer)(nil)
+var _ network.HTTPPeer = (*simPeer)(nil)
+var _ network.DisconnectableAddressablePeer = (*simPeer)(nil)
+var _ network.HTTPPeer = (*simHTTPPeer)(nil)
+
+type simEvent struct {
+	at  time.Duration
+	seq uint64
+	run func()
+}
+
+// simEventHeap orders the events by time, and by scheduling order for the same time.
+type simEventHeap []*simEvent
+
+func (h simEventHeap) Len() int { return len(h) }
+
+func (h simEventHeap) Less(i, j int) bool {
+	if h[i].at != h[j].at {
+		return h[i].at < h[j].at
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h simEventHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *simEventHeap) Push(x interface{}) { *h = append(*h, x.(*simEvent)) }
+
+func (h *simEventHeap) Pop() interface{} {
+	old := *h
+	ev := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return ev
+}
@@ -0,0 +1,306 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simnet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+type receivedMessage struct {
+	node     string
+	data     string
+	received time.Duration
+}
+
+// recordMessages registers a TxnTag handler on every node, returning the messages received so far
+func recordMessages(nodes []*Node, action network.ForwardingPolicy) func() []receivedMessage {
+	var received []receivedMessage
+	for _, n := range nodes {
+		n.RegisterHandlers([]network.TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: network.HandlerFunc(func(msg network.IncomingMessage) network.OutgoingMessage {
+			// handlers run on the goroutine advancing the clock, no locking needed
+			received = append(received, receivedMessage{node: n.Name(), data: string(msg.Data), received: time.Duration(msg.Received)})
+			return network.OutgoingMessage{Action: action}
+		})}})
+	}
+	return func() []receivedMessage {
+		return append([]receivedMessage(nil), received...)
+	}
+}
+
+func makeTestNetwork(t *testing.T, cfg Config, names ...string) (*Network, []*Node) {
+	sim := MakeNetwork(logging.TestingLog(t), cfg)
+	nodes := make([]*Node, len(names))
+	for i, name := range names {
+		nodes[i] = sim.AddNode(name, i == 0)
+	}
+	for _, n := range nodes[1:] {
+		sim.Connect(n, nodes[0])
+	}
+	for _, n := range nodes {
+		require.NoError(t, n.Start())
+	}
+	return sim, nodes
+}
+
+func TestNetworkDelivery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{Link: Link{Latency: 10 * time.Millisecond}}, "relay", "a", "b")
+	relay, a, b := nodes[0], nodes[1], nodes[2]
+	sim.SetLink(relay, b, Link{Latency: 30 * time.Millisecond})
+	received := recordMessages(nodes, network.Broadcast)
+
+	require.Len(t, a.GetPeers(network.PeersConnectedOut), 1)
+	require.Empty(t, a.GetPeers(network.PeersConnectedIn))
+	require.Len(t, relay.GetPeers(network.PeersConnectedIn), 2)
+
+	require.NoError(t, a.Broadcast(context.Background(), protocol.TxnTag, []byte("tx"), false, nil))
+	sim.Advance(5 * time.Millisecond)
+	require.Empty(t, received())
+
+	// the relay gets it after 10ms and forwards it to b, except back to a
+	sim.Advance(5 * time.Millisecond)
+	require.Equal(t, []receivedMessage{{"relay", "tx", 10 * time.Millisecond}}, received())
+	sim.Advance(time.Second)
+	require.Equal(t, []receivedMessage{{"relay", "tx", 10 * time.Millisecond}, {"b", "tx", 40 * time.Millisecond}}, received())
+	require.Zero(t, sim.Pending())
+	require.Equal(t, 1010*time.Millisecond, sim.Now())
+
+	// non-relays don't relay
+	require.NoError(t, b.Relay(context.Background(), protocol.TxnTag, []byte("relayed"), false, nil))
+	sim.Advance(time.Second)
+	require.Len(t, received(), 2)
+}
+
+func TestNetworkLoss(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	run := func(seed int64) []receivedMessage {
+		cfg := Config{Seed: seed, Link: Link{Latency: time.Millisecond, Jitter: 50 * time.Millisecond, LossRate: 0.3}}
+		sim, nodes := makeTestNetwork(t, cfg, "relay", "a")
+		received := recordMessages(nodes, network.Ignore)
+		for i := 0; i < 100; i++ {
+			nodes[1].Broadcast(context.Background(), protocol.TxnTag, []byte{byte(i)}, false, nil)
+			sim.Advance(time.Millisecond)
+		}
+		sim.Advance(time.Second)
+		return received()
+	}
+
+	first := run(1)
+	require.Greater(t, len(first), 50)
+	require.Less(t, len(first), 90)
+	for i := 1; i < len(first); i++ {
+		// jitter doesn't reorder the messages of a link
+		require.Less(t, first[i-1].data, first[i].data)
+		require.LessOrEqual(t, first[i-1].received, first[i].received)
+	}
+	require.Equal(t, first, run(1))
+	require.NotEqual(t, first, run(2))
+}
+
+func TestNetworkPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{Link: Link{Latency: 10 * time.Millisecond}}, "relay", "a", "b")
+	relay, a, b := nodes[0], nodes[1], nodes[2]
+	received := recordMessages(nodes, network.Broadcast)
+
+	// in flight messages are lost too
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("lost"), false, nil)
+	sim.At(5*time.Millisecond, func() { sim.Partition([]*Node{a}) })
+	sim.Advance(time.Second)
+	require.Empty(t, received())
+
+	// the other group still talks
+	b.Broadcast(context.Background(), protocol.TxnTag, []byte("b"), false, nil)
+	sim.Advance(time.Second)
+	require.Equal(t, []receivedMessage{{"relay", "b", 1010 * time.Millisecond}}, received())
+
+	sim.Heal()
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("a"), false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, received(), 3)
+	require.Equal(t, receivedMessage{"b", "a", 2020 * time.Millisecond}, received()[2])
+
+	// stopped nodes are disconnected until started again
+	relay.Stop()
+	require.Empty(t, a.GetPeers(network.PeersConnectedOut))
+	require.NoError(t, relay.Start())
+	require.Len(t, a.GetPeers(network.PeersConnectedOut), 1)
+}
+
+func TestNetworkDisconnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{Link: Link{Latency: 10 * time.Millisecond}}, "relay", "a")
+	relay, a := nodes[0], nodes[1]
+	received := recordMessages([]*Node{relay}, network.Disconnect)
+
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("bad"), false, nil)
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("dropped"), false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, received(), 1)
+	require.Empty(t, a.GetPeers(network.PeersConnectedOut))
+	require.Empty(t, relay.GetPeers(network.PeersConnectedIn))
+
+	// only the dialer reconnects
+	relay.RequestConnectOutgoing(false, nil)
+	require.Empty(t, relay.GetPeers(network.PeersConnectedIn))
+	a.RequestConnectOutgoing(false, nil)
+	require.Len(t, relay.GetPeers(network.PeersConnectedIn), 1)
+
+	sim.Disconnect(a, relay)
+	a.RequestConnectOutgoing(false, nil)
+	require.Empty(t, a.GetPeers(network.PeersConnectedOut))
+}
+
+func TestNetworkRequestRespond(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{Link: Link{Latency: 10 * time.Millisecond}}, "relay", "a")
+	relay, a := nodes[0], nodes[1]
+	relay.RegisterHandlers([]network.TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: network.HandlerFunc(func(msg network.IncomingMessage) network.OutgoingMessage {
+		topics, err := network.UnmarshallTopics(msg.Data)
+		require.NoError(t, err)
+		round, _ := topics.GetValue("round")
+		return network.OutgoingMessage{Action: network.Respond, Topics: network.Topics{network.MakeTopic("block", append([]byte("block "), round...))}}
+	})}})
+
+	peer := a.GetPeers(network.PeersConnectedOut)[0].(network.UnicastPeer)
+	done := make(chan *network.Response)
+	go func() {
+		resp, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, network.Topics{network.MakeTopic("round", []byte("7"))})
+		require.NoError(t, err)
+		done <- resp
+	}()
+
+	// the request is sent before the clock moves, and the response is back after a round trip
+	sim.Advance(20 * time.Millisecond)
+	resp := <-done
+	block, found := resp.Topics.GetValue("block")
+	require.True(t, found)
+	require.Equal(t, "block 7", string(block))
+}
+
+func TestNetworkAsyncHandlers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{Link: Link{Latency: 10 * time.Millisecond}}, "relay", "a")
+	relay, a := nodes[0], nodes[1]
+	received := recordMessages([]*Node{a}, network.Ignore)
+
+	// the relay queues the messages, and replies from another goroutine once they went through a second queue
+	queued := make(chan network.IncomingMessage, 10)
+	replies := make(chan network.IncomingMessage)
+	relay.RegisterHandlers([]network.TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: network.HandlerFunc(func(msg network.IncomingMessage) network.OutgoingMessage {
+		queued <- msg
+		return network.OutgoingMessage{Action: network.Ignore}
+	})}})
+	go func() {
+		for msg := range queued {
+			replies <- msg
+		}
+		close(replies)
+	}()
+	go func() {
+		for msg := range replies {
+			msg.Sender.(network.UnicastPeer).Unicast(context.Background(), append([]byte("re "), msg.Data...), protocol.TxnTag)
+		}
+	}()
+	defer close(queued)
+
+	a.Broadcast(context.Background(), protocol.TxnTag, []byte("tx"), false, nil)
+	sim.Advance(time.Second)
+	require.Equal(t, []receivedMessage{{"a", "re tx", 20 * time.Millisecond}}, received())
+}
+
+func TestNetworkHTTP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim, nodes := makeTestNetwork(t, Config{GenesisID: "sim-v1"}, "relay", "a")
+	relay, a := nodes[0], nodes[1]
+	relay.RegisterHTTPHandlerFunc("/v1/{genesisID}/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("pong from " + relay.Name()))
+	})
+
+	addr, started := relay.Address()
+	require.True(t, started)
+	peers := a.GetPeers(network.PeersPhonebookRelays)
+	require.Len(t, peers, 1)
+	httpPeer := peers[0].(network.HTTPPeer)
+	require.Equal(t, addr, httpPeer.GetAddress())
+
+	resp, err := httpPeer.GetHTTPClient().Get(httpPeer.GetAddress() + "/v1/" + a.GetGenesisID() + "/ping")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "pong from relay", string(body))
+
+	sim.Partition([]*Node{a})
+	_, err = httpPeer.GetHTTPClient().Get(httpPeer.GetAddress() + "/v1/sim-v1/ping")
+	require.Error(t, err)
+}
+
+func TestClock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sim := MakeNetwork(logging.TestingLog(t), Config{})
+	sim.Advance(time.Minute)
+	clock := MakeClock[int](sim)
+	require.Zero(t, clock.Since())
+
+	ch := clock.TimeoutAt(time.Second, 0)
+	require.Equal(t, ch, clock.TimeoutAt(time.Second, 0))
+	sim.Advance(999 * time.Millisecond)
+	select {
+	case <-ch:
+		require.Fail(t, "timeout fired early")
+	default:
+	}
+	sim.Advance(time.Millisecond)
+	<-ch
+	require.Equal(t, time.Second, clock.Since())
+
+	// past timeouts fire immediately
+	<-clock.TimeoutAt(time.Millisecond, 1)
+
+	decoded, err := clock.Decode(clock.Encode())
+	require.NoError(t, err)
+	require.Equal(t, time.Second, decoded.Since())
+	require.Zero(t, clock.Zero().Since())
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"crypto/sha256"
+	_ "embed" // for the compression dictionaries
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/DataDog/zstd"
+
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+)
+
+// CompressedTagsHeader is the HTTP header listing message tags the peer accepts zstd-compressed,
+// each followed by the identifier of the dictionary used for that tag, e.g. "AV:vote-1a2b3c4d,TX:txn-5e6f7a8b".
+const CompressedTagsHeader = "X-Algorand-Compressed-Tags"
+
+// the dictionaries are trained by ./dictionaries/dictionaryGenerator on synthesized transactions and votes.
+//
+//go:embed dictionaries/txn.zdict
+var txnCompressionDictionary []byte
+
+//go:embed dictionaries/vote.zdict
+var voteCompressionDictionary []byte
+
+// tagCompressor compresses and decompresses messages of a single tag using a shared zstd dictionary.
+// It is safe for concurrent use.
+type tagCompressor struct {
+	tag protocol.Tag
+	// dictID identifies the dictionary content so that peers with different dictionaries never
+	// attempt to exchange compressed messages.
+	dictID string
+	proc   *zstd.BulkProcessor
+}
+
+// supportedTagCompressors lists all tags that could be compressed with a shared dictionary.
+var supportedTagCompressors map[protocol.Tag]*tagCompressor
+
+func init() {
+	dictionaries := []struct {
+		name string
+		dict []byte
+		tags []protocol.Tag
+	}{
+		{"txn", txnCompressionDictionary, []protocol.Tag{protocol.TxnTag}},
+		{"vote", voteCompressionDictionary, []protocol.Tag{protocol.AgreementVoteTag, protocol.VoteBundleTag}},
+	}
+	supportedTagCompressors = make(map[protocol.Tag]*tagCompressor)
+	for _, d := range dictionaries {
+		proc, err := zstd.NewBulkProcessor(d.dict, zstdCompressionLevel)
+		if err != nil {
+			panic(fmt.Sprintf("failed to load %s compression dictionary: %v", d.name, err))
+		}
+		h := sha256.Sum256(d.dict)
+		dictID := d.name + "-" + hex.EncodeToString(h[:4])
+		for _, tag := range d.tags {
+			supportedTagCompressors[tag] = &tagCompressor{tag: tag, dictID: dictID, proc: proc}
+		}
+	}
+}
+
+// compress returns a concatenation of the tag and the compressed data.
+// If the compressed data is not smaller than the original it returns nil, and the caller sends the message as is.
+func (c *tagCompressor) compress(data []byte) ([]byte, error) {
+	tbytes := []byte(c.tag)
+	out := make([]byte, len(tbytes)+zstd.CompressBound(len(data)))
+	copy(out, tbytes)
+	comp, err := c.proc.Compress(out[len(tbytes):len(tbytes)], data)
+	if err != nil {
+		return nil, err
+	}
+	if len(comp) >= len(data) {
+		return nil, nil
+	}
+	return out[:len(tbytes)+len(comp)], nil
+}
+
+// compressTagMessage compresses a message for peers that negotiated compression for its tag.
+// It returns nil if the message should be sent non-compressed.
+func compressTagMessage(c *tagCompressor, data []byte, log logging.Logger) []byte {
+	compressed, err := c.compress(data)
+	if err != nil {
+		log.Warnf("failed to compress %s message of len %d: %v", c.tag, len(data), err)
+		return nil
+	}
+	return compressed
+}
+
+// countCompressedSent updates the compression savings metrics for a compressed message sent to a peer.
+func countCompressedSent(tag protocol.Tag, uncompressed, compressed []byte) {
+	networkCompressionUncompressedSentBytesByTag.Add(string(tag), uint64(len(uncompressed)))
+	networkCompressionCompressedSentBytesByTag.Add(string(tag), uint64(len(compressed)))
+}
+
+// decompress decompresses the data and ensures the result does not exceed the tag's maximum message size.
+// The size of the decompression buffer is bounded by the zstd library regardless of the frame header content.
+func (c *tagCompressor) decompress(data []byte) ([]byte, error) {
+	res, err := c.proc.Decompress(nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s message: %w", c.tag, err)
+	}
+	if maxSize := c.tag.MaxMessageSize(); uint64(len(res)) > maxSize {
+		return nil, fmt.Errorf("decompressed %s message is too large: %d > %d", c.tag, len(res), maxSize)
+	}
+	return res, nil
+}
+
+// tagCompressors is a set of per-tag compressors either offered by this node or negotiated with a peer.
+type tagCompressors map[protocol.Tag]*tagCompressor
+
+// makeTagCompressors parses a comma-separated list of tags and returns compressors for the supported ones.
+func makeTagCompressors(tags string, log logging.Logger) tagCompressors {
+	var tc tagCompressors
+	for _, part := range strings.Split(tags, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		c, ok := supportedTagCompressors[protocol.Tag(part)]
+		if !ok {
+			log.Warnf("compression is not supported for message tag %s", part)
+			continue
+		}
+		if tc == nil {
+			tc = make(tagCompressors)
+		}
+		tc[c.tag] = c
+	}
+	return tc
+}
+
+// String returns the representation of the set used in CompressedTagsHeader.
+func (tc tagCompressors) String() string {
+	parts := make([]string, 0, len(tc))
+	for tag, c := range tc {
+		parts = append(parts, string(tag)+":"+c.dictID)
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
+
+// negotiate returns compressors for tags offered by both this node and the peer with the same dictionary.
+// Since the outcome is symmetric, both sides of a connection arrive at the same set independently.
+func (tc tagCompressors) negotiate(announced string) tagCompressors {
+	var res tagCompressors
+	if len(tc) == 0 {
+		return nil
+	}
+	for _, part := range strings.Split(announced, ",") {
+		tag, dictID, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+		c, ok := tc[protocol.Tag(tag)]
+		if !ok || c.dictID != dictID {
+			continue
+		}
+		if res == nil {
+			res = make(tagCompressors)
+		}
+		res[c.tag] = c
+	}
+	return res
+}
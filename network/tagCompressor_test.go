@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/network/phonebook"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+// voteLikeMessage returns a msgpack-encoded message resembling an agreement vote
+func voteLikeMessage() []byte {
+	type proposal struct {
+		_struct struct{}      `codec:",omitempty,omitemptyarray"`
+		Dig     crypto.Digest `codec:"dig"`
+		EncDig  crypto.Digest `codec:"encdig"`
+		OProp   crypto.Digest `codec:"oprop"`
+	}
+	type vote struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+		R       struct {
+			_struct struct{}      `codec:",omitempty,omitemptyarray"`
+			Prop    proposal      `codec:"prop"`
+			Rnd     uint64        `codec:"rnd"`
+			Snd     crypto.Digest `codec:"snd"`
+			Step    uint64        `codec:"step"`
+		} `codec:"r"`
+		Cred struct {
+			_struct struct{}        `codec:",omitempty,omitemptyarray"`
+			Pf      crypto.VrfProof `codec:"pf"`
+		} `codec:"cred"`
+		Sig crypto.OneTimeSignature `codec:"sig"`
+	}
+	var v vote
+	crypto.RandBytes(v.R.Prop.Dig[:])
+	crypto.RandBytes(v.R.Prop.EncDig[:])
+	crypto.RandBytes(v.R.Prop.OProp[:])
+	crypto.RandBytes(v.R.Snd[:])
+	crypto.RandBytes(v.Cred.Pf[:])
+	crypto.RandBytes(v.Sig.Sig[:])
+	crypto.RandBytes(v.Sig.PK[:])
+	crypto.RandBytes(v.Sig.PK2[:])
+	crypto.RandBytes(v.Sig.PK1Sig[:])
+	crypto.RandBytes(v.Sig.PK2Sig[:])
+	v.R.Rnd = 45_000_000
+	v.R.Step = 2
+	return protocol.EncodeReflect(&v)
+}
+
+func TestTagCompressorsNegotiate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	require.Nil(t, makeTagCompressors("", log))
+	require.Nil(t, makeTagCompressors("PP, ZZ", log))
+
+	local := makeTagCompressors(" AV, TX ,PP", log)
+	require.Len(t, local, 2)
+	require.Contains(t, local, protocol.AgreementVoteTag)
+	require.Contains(t, local, protocol.TxnTag)
+
+	spec := local.String()
+	require.Equal(t, "AV:"+local[protocol.AgreementVoteTag].dictID+",TX:"+local[protocol.TxnTag].dictID, spec)
+	require.True(t, strings.HasPrefix(local[protocol.AgreementVoteTag].dictID, "vote-"))
+	require.True(t, strings.HasPrefix(local[protocol.TxnTag].dictID, "txn-"))
+
+	// both sides agree on the same set
+	remote := makeTagCompressors("AV,VB", log)
+	require.Equal(t, remote.negotiate(spec), local.negotiate(remote.String()))
+	negotiated := local.negotiate(remote.String())
+	require.Len(t, negotiated, 1)
+	require.Contains(t, negotiated, protocol.AgreementVoteTag)
+
+	// mismatching dictionaries and malformed entries are ignored
+	require.Nil(t, local.negotiate("AV:vote-00000000,TX"))
+	require.Nil(t, local.negotiate(""))
+	require.Nil(t, tagCompressors(nil).negotiate(spec))
+}
+
+func TestTagCompressorRoundtrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	c := supportedTagCompressors[protocol.AgreementVoteTag]
+	data := voteLikeMessage()
+	comp := compressTagMessage(c, data, logging.TestingLog(t))
+	require.NotNil(t, comp)
+	require.Less(t, len(comp), len(data)+len(protocol.AgreementVoteTag))
+	require.Equal(t, []byte(protocol.AgreementVoteTag), comp[:2])
+	require.Equal(t, zstdCompressionMagic[:], comp[2:6])
+
+	conv := wsPeerMsgDataConverter{log: logging.TestingLog(t), ppdec: zstdProposalDecompressor{}}
+	// not negotiated: data is passed through
+	r, err := conv.convert(protocol.AgreementVoteTag, comp[2:])
+	require.NoError(t, err)
+	require.Equal(t, comp[2:], r)
+
+	conv.tagDecompressors = tagCompressors{protocol.AgreementVoteTag: c}
+	r, err = conv.convert(protocol.AgreementVoteTag, comp[2:])
+	require.NoError(t, err)
+	require.Equal(t, data, r)
+
+	// non-compressed data is accepted as is
+	r, err = conv.convert(protocol.AgreementVoteTag, data)
+	require.NoError(t, err)
+	require.Equal(t, data, r)
+
+	// incompressible data is not compressed
+	random := make([]byte, 512)
+	crypto.RandBytes(random)
+	require.Nil(t, compressTagMessage(c, random, logging.TestingLog(t)))
+
+	// decompressed data must fit into the tag's maximum message size
+	large := bytes.Repeat([]byte{0x80}, protocol.AgreementVoteTagMaxSize+1)
+	largeComp, err := c.proc.Compress(nil, large)
+	require.NoError(t, err)
+	_, err = conv.convert(protocol.AgreementVoteTag, largeComp)
+	require.ErrorContains(t, err, "too large")
+
+	// corrupted data
+	_, err = conv.convert(protocol.AgreementVoteTag, append(zstdCompressionMagic[:], 1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+	require.Error(t, err)
+
+}
+
+type capturingHandler struct {
+	received chan []byte
+}
+
+func (h *capturingHandler) Handle(message IncomingMessage) OutgoingMessage {
+	h.received <- message.Data
+	return OutgoingMessage{Action: Ignore}
+}
+
+// TestWebsocketNetworkCompressedTags checks peers negotiate per-tag compression in the handshake
+// and deliver the original message content.
+func TestWebsocketNetworkCompressedTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, tc := range []struct {
+		name       string
+		tagsA      string
+		tagsB      string
+		negotiated bool
+	}{
+		{"both", "AV,TX", "TX,VB", true},
+		{"sender only", "TX", "", false},
+		{"receiver only", "", "TX", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfgA := defaultConfig
+			cfgA.GossipFanout = 1
+			cfgA.CompressedMessageTags = tc.tagsA
+			netA := makeTestWebsocketNodeWithConfig(t, cfgA)
+			netA.Start()
+			defer netStop(t, netA, "A")
+
+			cfgB := defaultConfig
+			cfgB.GossipFanout = 1
+			cfgB.CompressedMessageTags = tc.tagsB
+			netB := makeTestWebsocketNodeWithConfig(t, cfgB)
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			handler := &capturingHandler{received: make(chan []byte, 1)}
+			netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: handler}})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+
+			require.Eventually(t, func() bool { return netA.NumPeers() == 1 && netB.NumPeers() == 1 }, 2*time.Second, 10*time.Millisecond)
+			peersA, _ := netA.peerSnapshot(nil)
+			peersB, _ := netB.peerSnapshot(nil)
+			if tc.negotiated {
+				require.Len(t, peersA[0].compressedTags, 1)
+				require.Contains(t, peersA[0].compressedTags, protocol.TxnTag)
+				require.Equal(t, peersA[0].compressedTags.String(), peersB[0].compressedTags.String())
+			} else {
+				require.Empty(t, peersA[0].compressedTags)
+				require.Empty(t, peersB[0].compressedTags)
+			}
+
+			data := bytes.Repeat(voteLikeMessage(), 4)
+			require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, data, true, nil))
+			select {
+			case r := <-handler.received:
+				require.Equal(t, data, r)
+			case <-time.After(2 * time.Second):
+				require.Fail(t, "timeout waiting for the message")
+			}
+		})
+	}
+}
@@ -25,8 +25,8 @@ import (
 
 // Constant strings used as keys for topics
 const (
-	requestHashKey = "RequestHash"
-	ErrorKey       = "Error" // used for passing an error message
+	RequestHashKey = "RequestHash" // used for matching a response with its request
+	ErrorKey       = "Error"       // used for passing an error message
 )
 
 // Topic is a key-value pair
@@ -128,9 +128,9 @@ func UnmarshallTopics(buffer []byte) (ts Topics, err error) {
 	return topics, nil
 }
 
-// hashTopics returns the hash of serialized topics.
+// HashTopics returns the hash of serialized topics, which responses carry in their RequestHashKey topic.
 // Expects the nonce to be already added as a topic
-func hashTopics(topics []byte) (partialHash uint64) {
+func HashTopics(topics []byte) (partialHash uint64) {
 	digest := crypto.Hash(topics)
 	partialHash = digest.TrimUint64()
 	return partialHash
@@ -159,6 +159,8 @@ type WebsocketNetwork struct {
 	upgrader websocket.Upgrader
 
 	config config.Local
+	// configMu protects the config fields changed by ApplyConfig
+	configMu deadlock.RWMutex
 
 	log logging.Logger
 
@@ -221,6 +223,12 @@ type WebsocketNetwork struct {
 	// connPerfMonitor is used on outgoing connections to measure their relative message timing
 	connPerfMonitor *connectionPerformanceMonitor
 
+	// peerBans scores misbehaving peers and refuses connections with the banned ones
+	peerBans *PeerBanList
+
+	// pins are the peer addresses pinned by the node operator
+	pins *peerPins
+
 	// lastNetworkAdvanceMu synchronized the access to lastNetworkAdvance
 	lastNetworkAdvanceMu deadlock.Mutex
 
@@ -272,6 +280,9 @@ type WebsocketNetwork struct {
 	// protocolVersion is an actual version announced as ProtocolVersionHeader
 	protocolVersion string
 
+	// tagCompressors are message tags offered to peers for compression with a shared dictionary
+	tagCompressors tagCompressors
+
 	// resolveSRVRecords is a function that resolves SRV records for a given service, protocol and name
 	resolveSRVRecords func(ctx context.Context, service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (addrs []string, err error)
 }
@@ -555,12 +566,10 @@ func (wn *WebsocketNetwork) setup() {
 		wn.router.Handle(GossipNetworkPath, wn)
 	}
 	wn.requestsTracker = makeRequestsTracker(wn.router, wn.log, wn.config)
-	if wn.config.EnableRequestLogger {
-		wn.requestsLogger = makeRequestLogger(wn.requestsTracker, wn.log)
-		wn.server.Handler = wn.requestsLogger
-	} else {
-		wn.server.Handler = wn.requestsTracker
-	}
+	// the request logger is always in place so that EnableRequestLogger can be changed by ApplyConfig
+	wn.requestsLogger = makeRequestLogger(wn.requestsTracker, wn.log)
+	wn.requestsLogger.enabled.Store(wn.config.EnableRequestLogger)
+	wn.server.Handler = wn.requestsLogger
 	wn.server.ReadHeaderTimeout = httpServerReadHeaderTimeout
 	wn.server.WriteTimeout = httpServerWriteTimeout
 	wn.server.IdleTimeout = httpServerIdleTimeout
@@ -575,6 +584,7 @@ func (wn *WebsocketNetwork) setup() {
 	// extra space there.
 	wn.outgoingMessagesBufferSize = outgoingMessagesBufferSize
 	wn.wsMaxHeaderBytes = wsMaxHeaderBytes
+	wn.tagCompressors = makeTagCompressors(wn.config.CompressedMessageTags, wn.log)
 
 	wn.broadcaster = msgBroadcaster{
 		ctx:                    wn.ctx,
@@ -614,6 +624,11 @@ func (wn *WebsocketNetwork) setup() {
 		wn.incomingMsgFilter = makeMessageFilter(wn.config.IncomingMessageFilterBucketCount, wn.config.IncomingMessageFilterBucketSize)
 	}
 	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag})
+	if wn.peerBans == nil {
+		wn.peerBans = makePeerBanList(wn.log, wn.config)
+		wn.peerBans.onBan(wn.disconnectBannedPeers)
+	}
+	wn.pins = makePeerPins()
 	wn.lastNetworkAdvance = time.Now().UTC()
 
 	// set our supported versions
@@ -696,6 +711,7 @@ func (wn *WebsocketNetwork) Start() error {
 	}
 	wn.wg.Add(1)
 	go wn.meshThread()
+	networkPeerTraffic.addSource(wn)
 
 	// we shouldn't have any ticker here.. but in case we do - just stop it.
 	if wn.peersConnectivityCheckTicker != nil {
@@ -756,6 +772,7 @@ func (wn *WebsocketNetwork) Stop() {
 	wn.log.Debug("network is stopping")
 	defer wn.log.Debug("network has stopped")
 
+	networkPeerTraffic.removeSource(wn)
 	wn.handler.ClearHandlers([]Tag{})
 
 	// if we have a working ticker, just stop it and clear it out. The access to this variable is safe since the Start()/Stop() are synced by the
@@ -860,6 +877,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if wn.peerBans.isBanned(remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "Banned",
+			})
+		response.WriteHeader(http.StatusForbidden)
+		return http.StatusForbidden
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -875,7 +906,10 @@ func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.Response
 	}
 
 	totalConnections := wn.connectedForIP(remoteHost)
-	if totalConnections >= wn.config.MaxConnectionsPerIP {
+	wn.configMu.RLock()
+	maxConnectionsPerIP := wn.config.MaxConnectionsPerIP
+	wn.configMu.RUnlock()
+	if totalConnections >= maxConnectionsPerIP {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_per_ip_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
 			telemetryspec.ConnectPeerFailEventDetails{
@@ -1002,6 +1036,9 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
 	// set the features we support
 	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	if len(wn.tagCompressors) > 0 {
+		responseHeader.Set(CompressedTagsHeader, wn.tagCompressors.String())
+	}
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1047,9 +1084,10 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		identityChallenge: peerIDChallenge,
 		identityVerified:  atomic.Uint32{},
 		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		compressedTags:    wn.tagCompressors.negotiate(request.Header.Get(CompressedTagsHeader)),
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
-	peer.init(wn.config, wn.outgoingMessagesBufferSize)
+	peer.init(wn.currentConfig(), wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
 	wn.log.With("event", "ConnectedIn").With("remote", trackedRequest.remoteAddress()).With("local", localAddr).Infof("Accepted incoming connection from peer %s", trackedRequest.remoteAddr)
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerEvent,
@@ -1368,6 +1406,10 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 	start := time.Now()
 	data, digest := wn.preparePeerData(request, prio)
 
+	// the compressed variant is only prepared if some peer negotiated compression for this tag
+	var compressed []byte
+	compressionAttempted := false
+
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
 	for _, peer := range peers {
@@ -1377,8 +1419,23 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 		if Peer(peer) == request.except {
 			continue
 		}
-		ok := peer.writeNonBlock(request.ctx, data, prio, digest, request.enqueueTime)
+		peerData := data
+		useCompressed := false
+		if c := peer.compressedTags[request.tag]; c != nil {
+			if !compressionAttempted {
+				compressed = compressTagMessage(c, request.data, wn.log)
+				compressionAttempted = true
+			}
+			if compressed != nil {
+				peerData = compressed
+				useCompressed = true
+			}
+		}
+		ok := peer.writeNonBlock(request.ctx, peerData, prio, digest, request.enqueueTime)
 		if ok {
+			if useCompressed {
+				countCompressedSent(request.tag, data, compressed)
+			}
 			sentMessageCount++
 			continue
 		}
@@ -1487,6 +1544,7 @@ func (wn *WebsocketNetwork) meshThread() {
 		}
 
 		wn.refreshRelayArchivePhonebookAddresses()
+		wn.connectPinnedPeers()
 
 		// as long as the call to checkExistingConnectionsNeedDisconnecting is deleting existing connections, we want to
 		// kick off the creation of new connections.
@@ -1606,9 +1664,9 @@ func (wn *WebsocketNetwork) checkExistingConnectionsNeedDisconnecting() bool {
 	var leastPerformingPeer *wsPeer = nil
 	for _, stat := range peerStat.peerStatistics {
 		wsPeer := stat.peer.(*wsPeer)
-		wsPeer.peerMessageDelay = stat.peerDelay
+		wsPeer.peerMessageDelay.Store(stat.peerDelay)
 		wn.log.Infof("network performance monitor - peer '%s' delay %d first message portion %d%%", wsPeer.GetAddress(), stat.peerDelay, int(stat.peerFirstMessage*100))
-		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil {
+		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil && !wn.pins.isPinned(wsPeer.GetAddress()) {
 			leastPerformingPeer = wsPeer
 		}
 	}
@@ -1629,6 +1687,8 @@ func (wn *WebsocketNetwork) checkNetworkAdvanceDisconnect() bool {
 		return false
 	}
 	outgoingPeers := wn.outgoingPeers()
+	// pinned peers are never dropped
+	outgoingPeers = slices.DeleteFunc(outgoingPeers, func(p Peer) bool { return wn.pins.isPinned(p.(*wsPeer).GetAddress()) })
 	if len(outgoingPeers) == 0 {
 		return false
 	}
@@ -1721,7 +1781,7 @@ func getPeerConnectionTelemetryDetails(now time.Time, peers []*wsPeer) telemetry
 		if peer.outgoing {
 			connDetail.Address = justHost(peer.conn.RemoteAddrString())
 			connDetail.Endpoint = peer.GetAddress()
-			connDetail.MessageDelay = peer.peerMessageDelay
+			connDetail.MessageDelay = peer.peerMessageDelay.Load()
 			connectionDetails.OutgoingPeers = append(connectionDetails.OutgoingPeers, connDetail)
 		} else {
 			connDetail.Address = peer.OriginAddress()
@@ -2016,6 +2076,9 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
 	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	if len(wn.tagCompressors) > 0 {
+		requestHeader.Set(CompressedTagsHeader, wn.tagCompressors.String())
+	}
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2078,6 +2141,12 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		}
 	}
 
+	if wn.peerBans.isBanned(justHost(conn.RemoteAddr().String())) {
+		wn.log.Infof("ws connect(%s) aborted due to the peer being banned", gossipAddr)
+		closeEarly("Banned peer")
+		return
+	}
+
 	// no need to test the response.StatusCode since we know it's going to be http.StatusSwitchingProtocols, as it's already being tested inside websocketDialer.DialContext.
 	// we need to examine the headers here to extract which protocol version we should be using.
 	responseHeaderOk, matchingVersion := wn.checkServerResponseVariables(response.Header, gossipAddr)
@@ -2120,6 +2189,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		version:                     matchingVersion,
 		identity:                    peerID,
 		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		compressedTags:              wn.tagCompressors.negotiate(response.Header.Get(CompressedTagsHeader)),
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2137,7 +2207,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 			return
 		}
 	}
-	peer.init(wn.config, wn.outgoingMessagesBufferSize)
+	peer.init(wn.currentConfig(), wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
 
 	wn.log.With("event", "ConnectedOut").With("remote", netAddr).With("local", localAddr).Infof("Made outgoing connection to peer %v", netAddr)
@@ -2249,6 +2319,127 @@ func (wn *WebsocketNetwork) SetPrioScheme(s NetPrioScheme) {
 	wn.prioScheme = s
 }
 
+// currentConfig returns a copy of the network configuration, which ApplyConfig may change while the network runs
+func (wn *WebsocketNetwork) currentConfig() config.Local {
+	wn.configMu.RLock()
+	defer wn.configMu.RUnlock()
+	return wn.config
+}
+
+// ApplyConfig applies the settings of cfg which can be changed on a running network:
+// MaxConnectionsPerIP and EnableRequestLogger.
+func (wn *WebsocketNetwork) ApplyConfig(cfg config.Local) {
+	wn.configMu.Lock()
+	wn.config.MaxConnectionsPerIP = cfg.MaxConnectionsPerIP
+	wn.config.EnableRequestLogger = cfg.EnableRequestLogger
+	wn.configMu.Unlock()
+	if wn.requestsLogger != nil {
+		wn.requestsLogger.enabled.Store(cfg.EnableRequestLogger)
+	}
+}
+
+// SetPeerBanList replaces the network's in-memory peer ban list with a persisted
+// or shared one. It has to be called before Start.
+func (wn *WebsocketNetwork) SetPeerBanList(bl *PeerBanList) {
+	wn.peerBans = bl
+	bl.onBan(wn.disconnectBannedPeers)
+}
+
+// disconnectBannedPeers drops the connections with the peers known under the newly banned address.
+func (wn *WebsocketNetwork) disconnectBannedPeers(address string) {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	for _, peer := range wn.peers {
+		if slices.Contains(peer.banKeys(), address) {
+			wn.wg.Add(1)
+			go wn.disconnectThread(peer, disconnectPeerBanned)
+		}
+	}
+}
+
+// ListPeers returns the connected peers.
+func (wn *WebsocketNetwork) ListPeers() []PeerInfo {
+	var peers []*wsPeer
+	peers, _ = wn.peerSnapshot(peers)
+	infos := make([]PeerInfo, len(peers))
+	for i, peer := range peers {
+		infos[i] = peer.peerInfo()
+		infos[i].Pinned = wn.pins.isPinned(infos[i].Address)
+	}
+	return infos
+}
+
+// ConnectPeer starts connecting to the relay at address, a host:port or a URL, unless it is already
+// connected or being connected to. The connection is made in the background.
+func (wn *WebsocketNetwork) ConnectPeer(address string) error {
+	if _, err := wn.addrToGossipAddr(address); err != nil {
+		return ErrBadPeerAddress
+	}
+	if gossipAddr, ok := wn.tryConnectReserveAddr(address); ok {
+		wn.wg.Add(1)
+		go wn.tryConnect(address, gossipAddr)
+	}
+	return nil
+}
+
+// DisconnectPeer closes the connections with the relay at address or with the peers connected in from it.
+func (wn *WebsocketNetwork) DisconnectPeer(address string) error {
+	var peers []*wsPeer
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if peer.peerAddress() == address {
+			peers = append(peers, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	if len(peers) == 0 {
+		return ErrPeerNotConnected
+	}
+	for _, peer := range peers {
+		wn.disconnect(peer, disconnectRequestedByOperator)
+	}
+	return nil
+}
+
+// PinPeer pins the relay at address, which the node then stays connected to, or the IP address of peers
+// connecting in, which are then prioritized like PriorityPeers. The pin lasts for duration, or until it
+// is unpinned if duration is zero.
+func (wn *WebsocketNetwork) PinPeer(address string, duration time.Duration) error {
+	if net.ParseIP(address) != nil {
+		wn.pins.pin(address, duration)
+		return nil
+	}
+	if _, err := wn.addrToGossipAddr(address); err != nil {
+		return ErrBadPeerAddress
+	}
+	wn.pins.pin(address, duration)
+	return wn.ConnectPeer(address)
+}
+
+// UnpinPeer lifts the pin of the peer at address.
+func (wn *WebsocketNetwork) UnpinPeer(address string) error {
+	return wn.pins.unpin(address)
+}
+
+// ListPinnedPeers returns the pinned peers.
+func (wn *WebsocketNetwork) ListPinnedPeers() []PeerPin {
+	return wn.pins.list()
+}
+
+// connectPinnedPeers connects to the pinned relays which are not connected
+func (wn *WebsocketNetwork) connectPinnedPeers() {
+	for _, pin := range wn.pins.list() {
+		if net.ParseIP(pin.Address) != nil {
+			// peers pinned by IP address connect in
+			continue
+		}
+		if gossipAddr, ok := wn.tryConnectReserveAddr(pin.Address); ok {
+			wn.wg.Add(1)
+			go wn.tryConnect(pin.Address, gossipAddr)
+		}
+	}
+}
+
 // called from wsPeer to report that it has closed
 func (wn *WebsocketNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	wn.removePeer(peer, reason)
@@ -2259,8 +2450,8 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	// definitely don't change this to do the logging while holding the lock.
 	localAddr, _ := wn.Address()
 	logEntry := wn.log.With("event", "Disconnected").With("remote", peer.GetAddress()).With("local", localAddr)
-	if peer.outgoing && peer.peerMessageDelay > 0 {
-		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
+	if peer.outgoing && peer.peerMessageDelay.Load() > 0 {
+		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay.Load())
 	}
 	logEntry.Infof("Peer %s disconnected: %s", peer.GetAddress(), reason)
 	peerAddr := peer.OriginAddress()
@@ -2289,7 +2480,7 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	}
 	if peer.outgoing {
 		eventDetails.Endpoint = peer.GetAddress()
-		eventDetails.MessageDelay = peer.peerMessageDelay
+		eventDetails.MessageDelay = peer.peerMessageDelay.Load()
 	}
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.DisconnectPeerEvent,
 		telemetryspec.DisconnectPeerEventDetails{
@@ -2300,6 +2491,7 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 			AVCount:          peer.avMessageCount.Load(),
 			PPCount:          peer.ppMessageCount.Load(),
 		})
+	wn.peerBans.penalizePeer(peer, reason)
 
 	peers.Set(uint64(wn.NumPeers()))
 	incomingPeers.Set(uint64(wn.numIncomingPeers()))
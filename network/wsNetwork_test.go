@@ -4662,3 +4662,25 @@ func TestPeerComparisonInBroadcast(t *testing.T) {
 	require.Equal(t, 1, len(testPeer.sendBufferBulk))
 	require.Equal(t, 0, len(exceptPeer.sendBufferBulk))
 }
+
+func TestWebsocketNetworkApplyConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	wn := makeTestWebsocketNode(t)
+	require.False(t, wn.requestsLogger.enabled.Load())
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusOK, wn.checkIncomingConnectionLimits(response, request, "10.0.0.1", "", ""))
+
+	cfg := wn.config
+	cfg.MaxConnectionsPerIP = 0
+	cfg.EnableRequestLogger = true
+	wn.ApplyConfig(cfg)
+	require.True(t, wn.requestsLogger.enabled.Load())
+
+	response = httptest.NewRecorder()
+	require.Equal(t, http.StatusServiceUnavailable, wn.checkIncomingConnectionLimits(response, request, "10.0.0.1", "", ""))
+	require.Equal(t, http.StatusServiceUnavailable, response.Code)
+}
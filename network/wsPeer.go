@@ -148,6 +148,9 @@ const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectDuplicateConnection disconnectReason = "DuplicateConnection"
 const disconnectBadIdentityData disconnectReason = "BadIdentityData"
 const disconnectUnexpectedTopicResp disconnectReason = "UnexpectedTopicResp"
+const disconnectTooLargeMessage disconnectReason = "TooLargeMessage"
+const disconnectPeerBanned disconnectReason = "PeerBanned"
+const disconnectRequestedByOperator disconnectReason = "RequestedByOperator"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -238,6 +241,9 @@ type wsPeer struct {
 	// peer features derived from the peer version
 	features peerFeatureFlag
 
+	// compressedTags are message tags exchanged compressed with a shared dictionary, as negotiated on connection
+	compressedTags tagCompressors
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -257,7 +263,10 @@ type wsPeer struct {
 	connMonitor *connectionPerformanceMonitor
 
 	// peerMessageDelay is calculated by the connection monitor; it's the relative average per-message delay.
-	peerMessageDelay int64
+	peerMessageDelay atomic.Int64
+
+	// traffic accounts the messages exchanged with the peer, by message tag
+	traffic peerTraffic
 
 	// throttledOutgoingConnection determines if this outgoing connection will be throttled based on it's
 	// performance or not. Throttled connections are more likely to be short-lived connections.
@@ -401,10 +410,19 @@ func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) err
 		digest = crypto.Hash(mbytes)
 	}
 
-	ok := wp.writeNonBlock(ctx, mbytes, false, digest, time.Now())
+	data := mbytes
+	if c := wp.compressedTags[tag]; c != nil {
+		if compressed := compressTagMessage(c, msg, wp.log); compressed != nil {
+			data = compressed
+		}
+	}
+
+	ok := wp.writeNonBlock(ctx, data, false, digest, time.Now())
 	if !ok {
 		networkBroadcastsDropped.Inc(nil)
 		err = fmt.Errorf("wsPeer failed to unicast: %v", wp.GetAddress())
+	} else if len(data) != len(mbytes) {
+		countCompressedSent(tag, mbytes, data)
 	}
 
 	return err
@@ -430,12 +448,12 @@ func (wp *wsPeer) GetUnderlyingConnTCPInfo() (*util.TCPInfo, error) {
 func (wp *wsPeer) Respond(ctx context.Context, reqMsg IncomingMessage, outMsg OutgoingMessage) (e error) {
 
 	// Get the hash/key of the request message
-	requestHash := hashTopics(reqMsg.Data)
+	requestHash := HashTopics(reqMsg.Data)
 
 	// Add the request hash
 	requestHashData := make([]byte, binary.MaxVarintLen64)
 	binary.PutUvarint(requestHashData, requestHash)
-	responseTopics := append(outMsg.Topics, Topic{key: requestHashKey, data: requestHashData})
+	responseTopics := append(outMsg.Topics, Topic{key: RequestHashKey, data: requestHashData})
 
 	// Serialize the topics
 	serializedMsg := responseTopics.MarshallTopics()
@@ -580,6 +598,9 @@ func (wp *wsPeer) readLoop() {
 		slurper.Reset(uint64(msg.Tag.MaxMessageSize()))
 		err = slurper.Read(reader)
 		if err != nil {
+			if err == ErrIncomingMsgTooLarge {
+				cleanupCloseError = disconnectTooLargeMessage
+			}
 			wp.reportReadErr(err)
 			return
 		}
@@ -605,6 +626,7 @@ func (wp *wsPeer) readLoop() {
 			networkP2PReceivedBytesByTag.Add(string(tag[:]), uint64(len(msg.Data)+2))
 			networkP2PMessageReceivedByTag.Add(string(tag[:]), 1)
 		}
+		wp.traffic.addReceived(msg.Tag, uint64(len(msg.Data)+2))
 		msg.Sender = wp
 
 		// for outgoing connections, we want to notify the connection monitor that we've received
@@ -632,9 +654,9 @@ func (wp *wsPeer) readLoop() {
 				wp.log.Warnf("wsPeer readLoop: could not read the message from: %s %s", wp.conn.RemoteAddrString(), err)
 				continue
 			}
-			requestHash, found := topics.GetValue(requestHashKey)
+			requestHash, found := topics.GetValue(RequestHashKey)
 			if !found {
-				wp.log.Warnf("wsPeer readLoop: message from %s is missing the %s", wp.conn.RemoteAddrString(), requestHashKey)
+				wp.log.Warnf("wsPeer readLoop: message from %s is missing the %s", wp.conn.RemoteAddrString(), RequestHashKey)
 				continue
 			}
 			hashKey, _ := binary.Uvarint(requestHash)
@@ -833,6 +855,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		networkP2PMessageSentByTag.Add(string(tag), 1)
 		networkP2PMessageQueueMicrosTotal.AddUint64(uint64(time.Since(msg.peerEnqueued).Nanoseconds()/1000), nil)
 	}
+	wp.traffic.addSent(tag, uint64(len(msg.data)))
 	return disconnectReasonNone
 }
 
@@ -994,7 +1017,7 @@ func (wp *wsPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Re
 	serializedMsg := topics.MarshallTopics()
 
 	// Get the topics' hash
-	hash := hashTopics(serializedMsg)
+	hash := HashTopics(serializedMsg)
 
 	// Make a response channel to wait on the server response
 	responseChannel := wp.makeResponseChannel(hash)
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"os"
+
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+)
+
+// ConfigReloadResult lists the fields which changed in the configuration file when it was reloaded
+type ConfigReloadResult struct {
+	// Applied are the changed fields which were applied to the running node
+	Applied []string
+	// RestartRequired are the changed fields which only take effect after a restart
+	RestartRequired []string
+}
+
+// configApplier is implemented by the node components, like the networks, which apply reloaded configuration
+type configApplier interface {
+	ApplyConfig(cfg config.Local)
+}
+
+// networkNotApplied returns the live reloadable fields which net does not apply
+func networkNotApplied(net any) []string {
+	if _, ok := net.(configApplier); ok {
+		return nil
+	}
+	return config.NetworkReloadableFields
+}
+
+// configReloader reloads the configuration file of a node and updates the running configuration with the fields
+// which can be changed live. The fields needing a restart are left as they are in the running configuration.
+type configReloader struct {
+	rootDir string
+
+	// cfgMu protects cfg, the running node configuration, while the reloaded fields are set
+	cfgMu deadlock.RWMutex
+	cfg   *config.Local
+
+	// notApplied are the live reloadable fields which the node does not apply, set once the node is created
+	notApplied []string
+
+	// mu serializes the reloads and protects the fields below
+	mu deadlock.Mutex
+	// fileConfig is the configuration file as it was read, with only the applied fields of later reloads
+	fileConfig config.Local
+	handlers   []func(cfg config.Local)
+}
+
+// loadConfigFile reads the configuration file of the data directory, using the defaults if there is none
+func loadConfigFile(rootDir string) (config.Local, error) {
+	cfg, err := config.LoadConfigFromDisk(rootDir)
+	if err != nil && !os.IsNotExist(err) {
+		return config.Local{}, err
+	}
+	return cfg, nil
+}
+
+func makeConfigReloader(rootDir string, cfg *config.Local) *configReloader {
+	fileConfig, err := loadConfigFile(rootDir)
+	if err != nil {
+		fileConfig = *cfg
+	}
+	return &configReloader{
+		rootDir:    rootDir,
+		cfg:        cfg,
+		fileConfig: fileConfig,
+	}
+}
+
+// config returns a copy of the running configuration
+func (r *configReloader) config() config.Local {
+	r.cfgMu.RLock()
+	defer r.cfgMu.RUnlock()
+	return *r.cfg
+}
+
+// onReload registers handler to be called with the running configuration after a reload applied changes to it
+func (r *configReloader) onReload(handler func(cfg config.Local)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers = append(r.handlers, handler)
+}
+
+// reload reads the configuration file again, updates the running configuration with the changed fields
+// which can be changed live and calls apply and the registered handlers with it.
+func (r *configReloader) reload(apply func(cfg config.Local)) (ConfigReloadResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reloaded, err := loadConfigFile(r.rootDir)
+	if err != nil {
+		return ConfigReloadResult{}, err
+	}
+
+	r.cfgMu.Lock()
+	applied, restartRequired, err := r.cfg.ApplyReloaded(r.fileConfig, reloaded, r.notApplied...)
+	cfg := *r.cfg
+	r.cfgMu.Unlock()
+	if err != nil {
+		return ConfigReloadResult{}, err
+	}
+	// remember only the applied fields so that the ones needing a restart keep being reported
+	r.fileConfig.ApplyReloaded(r.fileConfig, reloaded, r.notApplied...)
+
+	if len(applied) > 0 {
+		apply(cfg)
+		for _, handler := range r.handlers {
+			handler(cfg)
+		}
+	}
+	return ConfigReloadResult{Applied: applied, RestartRequired: restartRequired}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/network"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestConfigReloader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rootDir := t.TempDir()
+	fileCfg := config.GetDefaultLocal()
+	require.NoError(t, fileCfg.SaveToDisk(rootDir))
+
+	// the running configuration may differ from the file, e.g. through command line overrides
+	cfg := fileCfg
+	cfg.NetAddress = ":4160"
+	reloader := makeConfigReloader(rootDir, &cfg)
+
+	var applied, handled []config.Local
+	reloader.onReload(func(cfg config.Local) { handled = append(handled, cfg) })
+	apply := func(cfg config.Local) { applied = append(applied, cfg) }
+
+	// nothing changed in the file
+	result, err := reloader.reload(apply)
+	require.NoError(t, err)
+	require.Empty(t, result.Applied)
+	require.Empty(t, result.RestartRequired)
+	require.Empty(t, applied)
+
+	fileCfg.TxPoolSize = 1234
+	fileCfg.EndpointAddress = "127.0.0.1:9999"
+	require.NoError(t, fileCfg.SaveToDisk(rootDir))
+
+	result, err = reloader.reload(apply)
+	require.NoError(t, err)
+	require.Equal(t, []string{"TxPoolSize"}, result.Applied)
+	require.Equal(t, []string{"EndpointAddress"}, result.RestartRequired)
+	require.Len(t, applied, 1)
+	require.Len(t, handled, 1)
+	require.Equal(t, 1234, applied[0].TxPoolSize)
+	require.Equal(t, ":4160", applied[0].NetAddress)
+
+	running := reloader.config()
+	require.Equal(t, 1234, running.TxPoolSize)
+	require.NotEqual(t, "127.0.0.1:9999", running.EndpointAddress)
+
+	// the field needing a restart keeps being reported until the node restarts
+	result, err = reloader.reload(apply)
+	require.NoError(t, err)
+	require.Empty(t, result.Applied)
+	require.Equal(t, []string{"EndpointAddress"}, result.RestartRequired)
+	require.Len(t, applied, 1)
+
+	// invalid values are rejected without changing the running configuration
+	fileCfg.TxPoolSize = 0
+	require.NoError(t, fileCfg.SaveToDisk(rootDir))
+	_, err = reloader.reload(apply)
+	require.Error(t, err)
+	require.Equal(t, 1234, reloader.config().TxPoolSize)
+}
+
+func TestConfigReloaderP2PNetwork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the websocket networks apply the network fields, the P2P network does not
+	require.Empty(t, networkNotApplied(&network.WebsocketNetwork{}))
+	require.Empty(t, networkNotApplied(&network.HybridP2PNetwork{}))
+	require.Equal(t, config.NetworkReloadableFields, networkNotApplied(&network.P2PNetwork{}))
+
+	rootDir := t.TempDir()
+	fileCfg := config.GetDefaultLocal()
+	fileCfg.EnableP2P = true
+	require.NoError(t, fileCfg.SaveToDisk(rootDir))
+	cfg := fileCfg
+	reloader := makeConfigReloader(rootDir, &cfg)
+	reloader.notApplied = networkNotApplied(&network.P2PNetwork{})
+
+	fileCfg.MaxConnectionsPerIP++
+	fileCfg.EnableRequestLogger = !fileCfg.EnableRequestLogger
+	fileCfg.TxPoolSize = 1234
+	require.NoError(t, fileCfg.SaveToDisk(rootDir))
+	result, err := reloader.reload(func(config.Local) {})
+	require.NoError(t, err)
+	require.Equal(t, []string{"TxPoolSize"}, result.Applied)
+	require.ElementsMatch(t, config.NetworkReloadableFields, result.RestartRequired)
+	require.Equal(t, cfg.MaxConnectionsPerIP, reloader.config().MaxConnectionsPerIP)
+}
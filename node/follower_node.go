@@ -20,6 +20,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/DePINNetwork/go-deadlock"
@@ -49,9 +50,12 @@ type AlgorandFollowerNode struct {
 	ctx       context.Context
 	cancelCtx context.CancelFunc
 	config    config.Local
+	// configReloader reloads config.json on request and protects the fields of config it changes
+	configReloader *configReloader
 
-	ledger *data.Ledger
-	net    network.GossipNode
+	ledger   *data.Ledger
+	net      network.GossipNode
+	peerBans *network.PeerBanList
 
 	catchupService           *catchup.Service
 	catchpointCatchupService *catchup.CatchpointCatchupService
@@ -92,6 +96,13 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		log.Warn("Follower running on a devMode network. Must submit txns to a different node.")
 	}
 	node.config = cfg
+	node.configReloader = makeConfigReloader(rootDir, &node.config)
+
+	node.peerBans, err = network.LoadPeerBanList(node.log, cfg, filepath.Join(rootDir, network.PeerBanListFilename))
+	if err != nil {
+		log.Errorf("Cannot load peer ban list: %v", err)
+		return nil, err
+	}
 
 	// tie network, block fetcher, and agreement services together
 	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil, nil)
@@ -99,10 +110,12 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		log.Errorf("could not create websocket node: %v", err)
 		return nil, err
 	}
+	p2pNode.SetPeerBanList(node.peerBans)
 	p2pNode.DeregisterMessageInterest(protocol.AgreementVoteTag)
 	p2pNode.DeregisterMessageInterest(protocol.ProposalPayloadTag)
 	p2pNode.DeregisterMessageInterest(protocol.VoteBundleTag)
 	node.net = p2pNode
+	node.configReloader.notApplied = networkNotApplied(p2pNode)
 
 	genalloc, err := genesis.Balances()
 	if err != nil {
@@ -159,7 +172,7 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 
 // Config returns a copy of the node's Local configuration
 func (node *AlgorandFollowerNode) Config() config.Local {
-	return node.config
+	return node.configReloader.config()
 }
 
 // Start the node: connect to peers while obtaining a lock. Doesn't wait for initial sync.
@@ -339,9 +352,10 @@ func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgerco
 	node.syncStatusMu.Unlock()
 }
 
-// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint, loading the ledger
+// from the local catchpoint file if one is provided.
 // this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
+func (node *AlgorandFollowerNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.catchpointCatchupService != nil {
@@ -354,7 +368,7 @@ func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, catchpointFile, node, node.log, node.net, accessor, node.Config())
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -474,3 +488,95 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
 	return nil, fmt.Errorf("cannot get block timestamp offset in follower mode")
 }
+
+// ListPeerBans returns the peers banned from connecting to the node.
+func (node *AlgorandFollowerNode) ListPeerBans() []network.PeerBan {
+	return node.peerBans.List()
+}
+
+// BanPeer bans an IP address or a libp2p peer ID for duration, or permanently if duration is zero.
+func (node *AlgorandFollowerNode) BanPeer(address string, duration time.Duration, reason string) error {
+	return node.peerBans.Ban(address, duration, reason)
+}
+
+// UnbanPeer lifts the ban of an IP address or a libp2p peer ID.
+func (node *AlgorandFollowerNode) UnbanPeer(address string) error {
+	return node.peerBans.Unban(address)
+}
+
+// ListPeers returns the peers the node is connected to.
+func (node *AlgorandFollowerNode) ListPeers() ([]network.PeerInfo, error) {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return nil, err
+	}
+	return peers.ListPeers(), nil
+}
+
+// ConnectPeer connects the node to the peer at address.
+func (node *AlgorandFollowerNode) ConnectPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.ConnectPeer(address)
+}
+
+// DisconnectPeer closes the connection with the peer at address.
+func (node *AlgorandFollowerNode) DisconnectPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.DisconnectPeer(address)
+}
+
+// PinPeer pins the peer at address for duration, or until it is unpinned if duration is zero.
+func (node *AlgorandFollowerNode) PinPeer(address string, duration time.Duration) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.PinPeer(address, duration)
+}
+
+// UnpinPeer lifts the pin of the peer at address.
+func (node *AlgorandFollowerNode) UnpinPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.UnpinPeer(address)
+}
+
+// ListPinnedPeers returns the peers pinned by the node operator.
+func (node *AlgorandFollowerNode) ListPinnedPeers() ([]network.PeerPin, error) {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return nil, err
+	}
+	return peers.ListPinnedPeers(), nil
+}
+
+// ReloadConfig reads config.json from the data directory again and applies the changed fields which can be
+// changed on a running node. The other changed fields are reported as needing a restart.
+func (node *AlgorandFollowerNode) ReloadConfig() (ConfigReloadResult, error) {
+	result, err := node.configReloader.reload(node.applyConfig)
+	if err != nil {
+		return result, err
+	}
+	node.log.Infof("Reloaded config, applied %v, restart required for %v", result.Applied, result.RestartRequired)
+	return result, nil
+}
+
+// OnConfigReload registers handler to be called with the node configuration after a reload changed it.
+func (node *AlgorandFollowerNode) OnConfigReload(handler func(cfg config.Local)) {
+	node.configReloader.onReload(handler)
+}
+
+func (node *AlgorandFollowerNode) applyConfig(cfg config.Local) {
+	node.log.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
+	if applier, ok := node.net.(configApplier); ok {
+		applier.ApplyConfig(cfg)
+	}
+}
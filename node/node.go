@@ -57,7 +57,9 @@ import (
 	"github.com/DePINNetwork/depin-sdk/util/db"
 	"github.com/DePINNetwork/depin-sdk/util/execpool"
 	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/depin-sdk/util/s3"
 	"github.com/DePINNetwork/depin-sdk/util/timers"
+	"github.com/DePINNetwork/depin-sdk/util/tracing"
 )
 
 const (
@@ -115,9 +117,12 @@ type AlgorandFullNode struct {
 	ctx       context.Context
 	cancelCtx context.CancelFunc
 	config    config.Local
+	// configReloader reloads config.json on request and protects the fields of config it changes
+	configReloader *configReloader
 
-	ledger *data.Ledger
-	net    network.GossipNode
+	ledger   *data.Ledger
+	net      network.GossipNode
+	peerBans *network.PeerBanList
 
 	transactionPool *pools.TransactionPool
 	txHandler       *data.TxHandler
@@ -128,6 +133,7 @@ type AlgorandFullNode struct {
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
 	ledgerService            *rpcs.LedgerService
+	blockArchiveExporter     *rpcs.BlockArchiveExporter
 	txPoolSyncerService      *rpcs.TxSyncer
 
 	genesisDirs     config.ResolvedGenesisDirs
@@ -179,15 +185,45 @@ type TxnWithStatus struct {
 	ApplyData transactions.ApplyData
 }
 
+type fullNodeConfig struct {
+	net            network.GossipNode
+	agreementClock timers.Clock[agreement.TimeoutType]
+}
+
+// FullNodeOption is a function that can be passed to MakeFull
+type FullNodeOption func(*fullNodeConfig)
+
+// FullNodeNetwork is an option to use the given network instead of the one configured by cfg,
+// such as a simnet.Node for in-process multi-node tests
+func FullNodeNetwork(net network.GossipNode) FullNodeOption {
+	return func(c *fullNodeConfig) {
+		c.net = net
+	}
+}
+
+// FullNodeAgreementClock is an option to drive the agreement timeouts with the given clock,
+// such as a simnet.Clock following the virtual time of a simulated network
+func FullNodeAgreementClock(clock timers.Clock[agreement.TimeoutType]) FullNodeOption {
+	return func(c *fullNodeConfig) {
+		c.agreementClock = clock
+	}
+}
+
 // MakeFull sets up an Algorand full node
 // (i.e., it returns a node that participates in consensus)
-func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis, opts ...FullNodeOption) (*AlgorandFullNode, error) {
+	var nodeCfg fullNodeConfig
+	for _, opt := range opts {
+		opt(&nodeCfg)
+	}
+
 	node := new(AlgorandFullNode)
 	node.log = log.With("name", cfg.NetAddress)
 	node.genesisID = genesis.ID()
 	node.genesisHash = genesis.Hash()
 	node.devMode = genesis.DevMode
 	node.config = cfg
+	node.configReloader = makeConfigReloader(rootDir, &node.config)
 	var err error
 	node.genesisDirs, err = cfg.EnsureAndResolveGenesisDirs(rootDir, genesis.ID(), log)
 	if err != nil {
@@ -200,20 +236,34 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	node.peerBans, err = network.LoadPeerBanList(node.log, cfg, filepath.Join(rootDir, network.PeerBanListFilename))
+	if err != nil {
+		log.Errorf("Cannot load peer ban list: %v", err)
+		return nil, err
+	}
+
 	// tie network, block fetcher, and agreement services together
 	var p2pNode network.GossipNode
-	if cfg.EnableP2PHybridMode {
-		p2pNode, err = network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
+	if nodeCfg.net != nil {
+		p2pNode = nodeCfg.net
+	} else if cfg.EnableP2PHybridMode {
+		var hybridNode *network.HybridP2PNetwork
+		hybridNode, err = network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
 		if err != nil {
 			log.Errorf("could not create hybrid p2p node: %v", err)
 			return nil, err
 		}
+		hybridNode.SetPeerBanList(node.peerBans)
+		p2pNode = hybridNode
 	} else if cfg.EnableP2P {
-		p2pNode, err = network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
+		var p2pNet *network.P2PNetwork
+		p2pNet, err = network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
 		if err != nil {
 			log.Errorf("could not create p2p node: %v", err)
 			return nil, err
 		}
+		p2pNet.SetPeerBanList(node.peerBans)
+		p2pNode = p2pNet
 	} else {
 		var wsNode *network.WebsocketNetwork
 		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
@@ -222,9 +272,11 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 			return nil, err
 		}
 		wsNode.SetPrioScheme(node)
+		wsNode.SetPeerBanList(node.peerBans)
 		p2pNode = wsNode
 	}
 	node.net = p2pNode
+	node.configReloader.notApplied = networkNotApplied(p2pNode)
 
 	node.cryptoPool = execpool.MakePool(node, "worker", "cryptoPool")
 	node.lowPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.LowPriority, node, "worker", "lowPriorityCryptoVerificationPool")
@@ -277,6 +329,20 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
 	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
+	var blockArchiveStore rpcs.BlockArchiveStore
+	if cfg.EnableBlockArchiveExport {
+		if cfg.Archival {
+			blockArchiveHelper, err := s3.MakeS3SessionWithEndpoint(cfg.BlockArchiveS3Bucket, cfg.BlockArchiveS3Endpoint)
+			if err != nil {
+				log.Errorf("Cannot initialize block archive export: %v", err)
+				return nil, err
+			}
+			blockArchiveStore = &blockArchiveHelper
+		} else {
+			log.Warn("EnableBlockArchiveExport is set on a non-archival node, not exporting blocks")
+		}
+	}
+	node.blockArchiveExporter = rpcs.MakeBlockArchiveExporter(node.log, cfg, node.ledger, blockArchiveStore, node.genesisID)
 	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	// crash data is stored in the cold data directory unless otherwise specified
@@ -290,7 +356,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
 	var agreementClock timers.Clock[agreement.TimeoutType]
-	if node.devMode {
+	if nodeCfg.agreementClock != nil {
+		agreementClock = nodeCfg.agreementClock
+	} else if node.devMode {
 		agreementClock = timers.MakeFrozenClock[agreement.TimeoutType]()
 	} else {
 		agreementClock = timers.MakeMonotonicClock[agreement.TimeoutType](time.Now())
@@ -346,7 +414,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 // Config returns a copy of the node's Local configuration
 func (node *AlgorandFullNode) Config() config.Local {
-	return node.config
+	return node.configReloader.config()
 }
 
 // Start the node: connect to peers and run the agreement service while obtaining a lock. Doesn't wait for initial sync.
@@ -381,6 +449,7 @@ func (node *AlgorandFullNode) Start() error {
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
 		node.blockService.Start()
 		node.ledgerService.Start()
+		node.blockArchiveExporter.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
@@ -406,9 +475,30 @@ func (node *AlgorandFullNode) Capabilities() []p2p.Capability {
 	if node.config.EnableGossipService && node.config.IsGossipServer() {
 		caps = append(caps, p2p.Gossip)
 	}
+	if !node.config.Archival && node.config.IsGossipServer() {
+		caps = append(caps, p2p.BlockHistoryCapabilities(retainedBlockHistoryRounds(node.config))...)
+		if node.config.BlockRetentionSparseInterval != 0 {
+			caps = append(caps, p2p.SparseBlockHistoryCapability(node.config.BlockRetentionSparseInterval))
+		}
+		if node.config.BlockRetentionKeepStateProofRounds {
+			caps = append(caps, p2p.StateProofBlocks)
+		}
+	}
 	return caps
 }
 
+// retainedBlockHistoryRounds returns the number of recent rounds a non-archival node keeps by configuration.
+// The retention window is converted to rounds using the filter timeout of the first period, which rounds
+// usually do not exceed, so that the node does not claim more history than it has.
+func retainedBlockHistoryRounds(cfg config.Local) uint64 {
+	rounds := cfg.MaxBlockHistoryLookback
+	if roundTime := config.Consensus[protocol.ConsensusCurrentVersion].AgreementFilterTimeoutPeriod0; roundTime > 0 {
+		window := time.Duration(cfg.BlockRetentionWindowSeconds) * time.Second
+		rounds = max(rounds, uint64(window/roundTime))
+	}
+	return rounds
+}
+
 // startMonitoringRoutines starts the internal monitoring routines used by the node.
 func (node *AlgorandFullNode) startMonitoringRoutines() {
 	node.monitoringRoutinesWaitGroup.Add(2)
@@ -472,6 +562,7 @@ func (node *AlgorandFullNode) Stop() {
 		node.txPoolSyncerService.Stop()
 		node.blockService.Stop()
 		node.ledgerService.Stop()
+		node.blockArchiveExporter.Stop()
 	}
 	node.catchupBlockAuth.Quit()
 	node.log.Debug("crypto worker pools are stopping")
@@ -585,13 +676,22 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 		return err
 	}
 
+	var txnTrace *tracing.TxnTrace
+	if tracing.Enabled() {
+		txnTrace = tracing.LookupTxnTrace(crypto.Digest(txgroup[0].ID()))
+	}
+
+	start := time.Now()
 	_, err = verify.TxnGroup(txgroup, &b, node.ledger.VerifiedTransactionCache(), node.ledger)
+	txnTrace.RecordSpan("verify", start, err)
 	if err != nil {
 		node.log.Warnf("malformed transaction: %v", err)
 		return err
 	}
 
+	start = time.Now()
 	err = node.transactionPool.Remember(txgroup)
+	txnTrace.RecordSpan("pool.Remember", start, err)
 	if err != nil {
 		node.log.Infof("rejected by local pool: %v - transaction group was %+v", err, txgroup)
 		return err
@@ -611,7 +711,9 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 		enc = append(enc, protocol.Encode(&tx)...)
 		txids = append(txids, tx.ID())
 	}
+	start = time.Now()
 	err = node.net.Broadcast(context.TODO(), protocol.TxnTag, enc, false, nil)
+	txnTrace.RecordSpan("network.Broadcast", start, err)
 	if err != nil {
 		node.log.Infof("failure broadcasting transaction to network: %v - transaction group was %+v", err, txgroup)
 		return err
@@ -1154,9 +1256,10 @@ func (node *AlgorandFullNode) Uint64() uint64 {
 	return crypto.RandUint64()
 }
 
-// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint, loading the ledger
+// from the local catchpoint file if one is provided.
 // this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+func (node *AlgorandFullNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.config.Archival {
@@ -1172,7 +1275,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, catchpointFile, node, node.log, node.net, accessor, node.Config())
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1237,6 +1340,7 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			node.txPoolSyncerService.Stop()
 			node.blockService.Stop()
 			node.ledgerService.Stop()
+			node.blockArchiveExporter.Stop()
 
 			prevNodeCancelFunc := node.cancelCtx
 
@@ -1256,6 +1360,7 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
 		node.blockService.Start()
 		node.ledgerService.Start()
+		node.blockArchiveExporter.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
@@ -1471,6 +1576,113 @@ func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
 	return fmt.Errorf("cannot set block timestamp offset when not in dev mode")
 }
 
+// ListPeerBans returns the peers banned from connecting to the node.
+func (node *AlgorandFullNode) ListPeerBans() []network.PeerBan {
+	return node.peerBans.List()
+}
+
+// BanPeer bans an IP address or a libp2p peer ID for duration, or permanently if duration is zero.
+func (node *AlgorandFullNode) BanPeer(address string, duration time.Duration, reason string) error {
+	return node.peerBans.Ban(address, duration, reason)
+}
+
+// UnbanPeer lifts the ban of an IP address or a libp2p peer ID.
+func (node *AlgorandFullNode) UnbanPeer(address string) error {
+	return node.peerBans.Unban(address)
+}
+
+// ListPeers returns the peers the node is connected to.
+func (node *AlgorandFullNode) ListPeers() ([]network.PeerInfo, error) {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return nil, err
+	}
+	return peers.ListPeers(), nil
+}
+
+// ConnectPeer connects the node to the peer at address.
+func (node *AlgorandFullNode) ConnectPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.ConnectPeer(address)
+}
+
+// DisconnectPeer closes the connection with the peer at address.
+func (node *AlgorandFullNode) DisconnectPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.DisconnectPeer(address)
+}
+
+// PinPeer pins the peer at address for duration, or until it is unpinned if duration is zero.
+func (node *AlgorandFullNode) PinPeer(address string, duration time.Duration) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.PinPeer(address, duration)
+}
+
+// UnpinPeer lifts the pin of the peer at address.
+func (node *AlgorandFullNode) UnpinPeer(address string) error {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return err
+	}
+	return peers.UnpinPeer(address)
+}
+
+// ListPinnedPeers returns the peers pinned by the node operator.
+func (node *AlgorandFullNode) ListPinnedPeers() ([]network.PeerPin, error) {
+	peers, err := peerManager(node.net)
+	if err != nil {
+		return nil, err
+	}
+	return peers.ListPinnedPeers(), nil
+}
+
+// errPeerManagementUnsupported is returned when the network of the node does not support managing its peers
+var errPeerManagementUnsupported = errors.New("the network does not support peer management")
+
+func peerManager(net network.GossipNode) (network.PeerManager, error) {
+	peers, ok := net.(network.PeerManager)
+	if !ok {
+		return nil, errPeerManagementUnsupported
+	}
+	return peers, nil
+}
+
+// ReloadConfig reads config.json from the data directory again and applies the changed fields which can be
+// changed on a running node: the log level, the transaction pool size, the backlog congestion threshold, the
+// per-IP connection limit and the request logger of the websocket network, and the REST API rate limits.
+// The other changed fields are reported as needing a restart.
+func (node *AlgorandFullNode) ReloadConfig() (ConfigReloadResult, error) {
+	result, err := node.configReloader.reload(node.applyConfig)
+	if err != nil {
+		return result, err
+	}
+	node.log.Infof("Reloaded config, applied %v, restart required for %v", result.Applied, result.RestartRequired)
+	return result, nil
+}
+
+// OnConfigReload registers handler to be called with the node configuration after a reload changed it.
+func (node *AlgorandFullNode) OnConfigReload(handler func(cfg config.Local)) {
+	node.configReloader.onReload(handler)
+}
+
+func (node *AlgorandFullNode) applyConfig(cfg config.Local) {
+	node.log.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
+	node.transactionPool.SetMaxSize(cfg.TxPoolSize)
+	node.txHandler.ApplyConfig(cfg)
+	if applier, ok := node.net.(configApplier); ok {
+		applier.ApplyConfig(cfg)
+	}
+}
+
 // GetBlockTimeStampOffset gets a timestamp offset.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
@@ -42,6 +42,7 @@ import (
 	"github.com/DePINNetwork/depin-sdk/logging"
 	"github.com/DePINNetwork/depin-sdk/network"
 	"github.com/DePINNetwork/depin-sdk/network/p2p"
+	"github.com/DePINNetwork/depin-sdk/network/simnet"
 	"github.com/DePINNetwork/depin-sdk/protocol"
 	"github.com/DePINNetwork/depin-sdk/stateproof"
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
@@ -86,6 +87,7 @@ func (ni nodeInfo) p2pMultiAddr() string {
 
 type configHook func(ni nodeInfo, cfg config.Local) (nodeInfo, config.Local)
 type phonebookHook func([]nodeInfo, int) []string
+type optionsHook func(ni nodeInfo) []FullNodeOption
 
 func setupFullNodes(t *testing.T, proto protocol.ConsensusVersion, customConsensus config.ConsensusProtocols) ([]*AlgorandFullNode, []string) {
 	minMoneyAtStart := 10000
@@ -112,7 +114,7 @@ func setupFullNodes(t *testing.T, proto protocol.ConsensusVersion, customConsens
 		}
 		return phonebook
 	}
-	nodes, wallets := setupFullNodesEx(t, proto, customConsensus, acctStake, configHook, phonebookHook)
+	nodes, wallets := setupFullNodesEx(t, proto, customConsensus, acctStake, configHook, phonebookHook, nil)
 	require.Len(t, nodes, numAccounts)
 	require.Len(t, wallets, numAccounts)
 	return nodes, wallets
@@ -120,16 +122,17 @@ func setupFullNodes(t *testing.T, proto protocol.ConsensusVersion, customConsens
 
 func setupFullNodesEx(
 	t *testing.T, proto protocol.ConsensusVersion, customConsensus config.ConsensusProtocols,
-	acctStake []basics.MicroAlgos, configHook configHook, phonebookHook phonebookHook,
+	acctStake []basics.MicroAlgos, configHook configHook, phonebookHook phonebookHook, optionsHook optionsHook,
 ) ([]*AlgorandFullNode, []string) {
 
 	util.SetFdSoftLimit(1000)
 
-	f, _ := os.Create(t.Name() + ".log")
+	logPath := filepath.Join(t.TempDir(), t.Name()+".log")
+	f, _ := os.Create(logPath)
 	logging.Base().SetJSONFormatter()
 	logging.Base().SetOutput(f)
 	logging.Base().SetLevel(logging.Debug)
-	t.Logf("Logging to %s\n", t.Name()+".log")
+	t.Logf("Logging to %s\n", logPath)
 
 	firstRound := basics.Round(0)
 	lastRound := basics.Round(200)
@@ -241,7 +244,11 @@ func setupFullNodesEx(
 		cfg, err := config.LoadConfigFromDisk(rootDirectory)
 		phonebook := phonebookHook(nodeInfos, i)
 		require.NoError(t, err)
-		node, err := MakeFull(logging.Base().With("net", fmt.Sprintf("node%d", i)), rootDirectory, cfg, phonebook, g)
+		var opts []FullNodeOption
+		if optionsHook != nil {
+			opts = optionsHook(nodeInfos[i])
+		}
+		node, err := MakeFull(logging.Base().With("net", fmt.Sprintf("node%d", i)), rootDirectory, cfg, phonebook, g, opts...)
 		nodes[i] = node
 		require.NoError(t, err)
 	}
@@ -939,7 +946,7 @@ func TestNodeHybridTopology(t *testing.T) {
 		return nil
 	}
 
-	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook)
+	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook, nil)
 	require.Len(t, nodes, 3)
 	require.Len(t, wallets, 3)
 	for i := 0; i < len(nodes); i++ {
@@ -1039,7 +1046,7 @@ func TestNodeP2PRelays(t *testing.T) {
 		return nil
 	}
 
-	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook)
+	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook, nil)
 	require.Len(t, nodes, 3)
 	require.Len(t, wallets, 3)
 	for i := 0; i < len(nodes); i++ {
@@ -1193,7 +1200,7 @@ func TestNodeHybridP2PGossipSend(t *testing.T) {
 		return nil
 	}
 
-	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook)
+	nodes, wallets := setupFullNodesEx(t, consensusTest0, configurableConsensus, acctStake, configHook, phonebookHook, nil)
 	require.Len(t, nodes, 3)
 	require.Len(t, wallets, 3)
 	for i := 0; i < len(nodes); i++ {
@@ -1262,3 +1269,113 @@ func TestNodeHybridP2PGossipSend(t *testing.T) {
 		require.Fail(t, fmt.Sprintf("no block notification for wallet: %v.", wallets[0]))
 	}
 }
+
+func TestNodeBlockHistoryCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = ":4160"
+	node := &AlgorandFullNode{config: cfg}
+	require.NotContains(t, node.Capabilities(), p2p.BlockHistoryCapability(p2p.BlockHistoryTiers[0]))
+
+	cfg.MaxBlockHistoryLookback = 20_000
+	cfg.BlockRetentionSparseInterval = 1000
+	cfg.BlockRetentionKeepStateProofRounds = true
+	node.config = cfg
+	caps := node.Capabilities()
+	require.Contains(t, caps, p2p.BlockHistoryCapability(10_000))
+	require.NotContains(t, caps, p2p.BlockHistoryCapability(100_000))
+	require.Contains(t, caps, p2p.SparseBlockHistoryCapability(1000))
+	require.Contains(t, caps, p2p.Capability(p2p.StateProofBlocks))
+
+	// a week of blocks is well over 100k rounds
+	cfg.BlockRetentionWindowSeconds = 7 * 24 * 60 * 60
+	require.GreaterOrEqual(t, retainedBlockHistoryRounds(cfg), uint64(100_000))
+	node.config = cfg
+	require.Contains(t, node.Capabilities(), p2p.BlockHistoryCapability(100_000))
+
+	// archival nodes advertise the archival capability instead
+	cfg.Archival = true
+	node.config = cfg
+	caps = node.Capabilities()
+	require.Contains(t, caps, p2p.Archival)
+	require.NotContains(t, caps, p2p.BlockHistoryCapability(10_000))
+}
+
+// TestNodeSimNetworkPartitionRecovery runs full nodes over a simulated network, isolating a node with a small stake
+// while the others keep agreeing on blocks, then checks that it catches up once the partition is healed.
+func TestNodeSimNetworkPartitionRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisID := bookkeeping.Genesis{SchemaID: "go-test-node-genesis", Network: config.Devtestnet}.ID()
+	sim := simnet.MakeNetwork(logging.Base(), simnet.Config{
+		GenesisID: genesisID,
+		Seed:      1,
+		Link:      simnet.Link{Latency: 20 * time.Millisecond, Jitter: 10 * time.Millisecond},
+	})
+
+	acctStake := []basics.MicroAlgos{{Raw: 10000}, {Raw: 45000}, {Raw: 45000}}
+	configHook := func(ni nodeInfo, cfg config.Local) (nodeInfo, config.Local) {
+		// the transaction syncer runs on the real clock, and would otherwise sync continuously
+		cfg.TxSyncIntervalSeconds = config.GetDefaultLocal().TxSyncIntervalSeconds
+		return ni, cfg
+	}
+	phonebookHook := func(nodes []nodeInfo, nodeIdx int) []string {
+		return nil
+	}
+	optionsHook := func(ni nodeInfo) []FullNodeOption {
+		simNode := sim.AddNode(fmt.Sprintf("node%d", ni.idx), true)
+		return []FullNodeOption{
+			FullNodeNetwork(simNode),
+			FullNodeAgreementClock(simnet.MakeClock[agreement.TimeoutType](sim)),
+		}
+	}
+	nodes, wallets := setupFullNodesEx(t, protocol.ConsensusCurrentVersion, nil, acctStake, configHook, phonebookHook, optionsHook)
+	require.Len(t, nodes, 3)
+	simNodes := sim.Nodes()
+	for i := range simNodes {
+		for j := i + 1; j < len(simNodes); j++ {
+			sim.Connect(simNodes[j], simNodes[i])
+		}
+	}
+	for i := range nodes {
+		defer os.Remove(wallets[i])
+		defer nodes[i].Stop()
+	}
+
+	startAndConnectNodes(nodes, nodelayFirstNodeStartDelay)
+
+	// waitForRound runs the network events one at a time until the nodes have the round. Each step returns once the
+	// nodes are done handling the event, so the outcome doesn't depend on how fast the nodes handle it.
+	waitForRound := func(nodes []*AlgorandFullNode, round basics.Round) {
+		deadline := sim.Now() + 5*time.Minute
+		for _, node := range nodes {
+			for node.ledger.Latest() < round {
+				require.Less(t, sim.Now(), deadline, "no block %d on %s", round, node.config.NetAddress)
+				if !sim.Step() {
+					sim.Advance(10 * time.Millisecond)
+				}
+			}
+		}
+	}
+
+	initialRound := nodes[0].ledger.NextRound()
+	waitForRound(nodes, initialRound+1)
+
+	sim.Partition(simNodes[:1])
+	partitioned := nodes[1].ledger.Latest()
+	waitForRound(nodes[1:], partitioned+3)
+	require.Less(t, nodes[0].ledger.Latest(), partitioned+3)
+
+	// the catchup service only notices on the real clock that the ledger stopped advancing, so wake it up right away
+	sim.Heal()
+	nodes[0].catchupService.TriggerSync()
+	waitForRound(nodes, partitioned+4)
+	for _, node := range nodes {
+		blk, err := node.ledger.Block(partitioned + 3)
+		require.NoError(t, err)
+		expected, err := nodes[1].ledger.Block(partitioned + 3)
+		require.NoError(t, err)
+		require.Equal(t, expected.Hash(), blk.Hash())
+	}
+}
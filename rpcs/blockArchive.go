@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/DePINNetwork/msgp/msgp"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/util/s3"
+)
+
+// blockArchiveMaxBundleBytes caps the decompressed size of a single block archive bundle
+const blockArchiveMaxBundleBytes = 1 << 30
+
+// blockArchiveRetryInterval is how long the exporter waits before retrying a failed bundle upload
+const blockArchiveRetryInterval = 30 * time.Second
+
+// BlockArchiveStore is the object store, such as an S3-compatible bucket, a block archive is kept in.
+// GetObject returns s3.ErrObjectNotFound for keys the store does not have.
+type BlockArchiveStore interface {
+	PutObject(ctx context.Context, key string, data []byte) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+}
+
+// BlockArchiveManifest describes the contents of a block archive. It is stored as JSON next to the bundles,
+// and is rewritten after every bundle upload.
+//
+//msgp:ignore BlockArchiveManifest
+type BlockArchiveManifest struct {
+	GenesisID    string       `json:"genesis-id"`
+	BundleRounds uint64       `json:"bundle-rounds"`
+	LastRound    basics.Round `json:"last-round"`
+}
+
+// BlockArchiveManifestKey returns the object key of the manifest of the block archive of the given network
+func BlockArchiveManifestKey(genesisID string) string {
+	return fmt.Sprintf("%s/manifest.json", genesisID)
+}
+
+// BlockArchiveBundleKey returns the object key of the bundle holding the rounds first through last
+func BlockArchiveBundleKey(genesisID string, first, last basics.Round) string {
+	return fmt.Sprintf("%s/blocks/%012d-%012d.msgp.gz", genesisID, first, last)
+}
+
+// BlockArchiveBundleRange returns the first and last rounds of the bundle holding the given round.
+// Bundles are aligned on multiples of bundleRounds; the genesis block has no certificate and is never archived,
+// so the first bundle starts at round 1.
+func BlockArchiveBundleRange(rnd basics.Round, bundleRounds uint64) (first, last basics.Round) {
+	first = rnd - rnd%basics.Round(bundleRounds)
+	last = first + basics.Round(bundleRounds) - 1
+	return max(first, 1), last
+}
+
+// GetBlockArchiveManifest reads the manifest of the block archive of the given network
+func GetBlockArchiveManifest(ctx context.Context, store BlockArchiveStore, genesisID string) (manifest BlockArchiveManifest, err error) {
+	data, err := store.GetObject(ctx, BlockArchiveManifestKey(genesisID))
+	if err != nil {
+		return BlockArchiveManifest{}, err
+	}
+	err = protocol.DecodeJSON(data, &manifest)
+	if err != nil {
+		return BlockArchiveManifest{}, fmt.Errorf("unable to decode block archive manifest: %w", err)
+	}
+	if manifest.GenesisID != genesisID || manifest.BundleRounds == 0 {
+		return BlockArchiveManifest{}, fmt.Errorf("invalid block archive manifest for %s: genesis %s, %d rounds per bundle", genesisID, manifest.GenesisID, manifest.BundleRounds)
+	}
+	return manifest, nil
+}
+
+// EncodeBlockArchiveBundle compresses the given block and certificate entries, as returned by RawBlockBytes, into a bundle
+func EncodeBlockArchiveBundle(entries [][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	for _, entry := range entries {
+		_, err := gz.Write(entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := gz.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeBlockArchiveBundle splits a bundle back into its block and certificate entries.
+// The entries are not decoded; each of them is a msgpack-encoded EncodedBlockCert.
+func DecodeBlockArchiveBundle(bundle []byte) ([][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	data, err := io.ReadAll(io.LimitReader(gz, blockArchiveMaxBundleBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > blockArchiveMaxBundleBytes {
+		return nil, fmt.Errorf("block archive bundle exceeds %d bytes", blockArchiveMaxBundleBytes)
+	}
+
+	var entries [][]byte
+	for len(data) > 0 {
+		rest, err := msgp.Skip(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to split block archive bundle: %w", err)
+		}
+		entries = append(entries, data[:len(data)-len(rest)])
+		data = rest
+	}
+	return entries, nil
+}
+
+// LedgerForBlockArchive describes the Ledger methods used by BlockArchiveExporter.
+type LedgerForBlockArchive interface {
+	LedgerForBlockService
+	Latest() basics.Round
+	Wait(basics.Round) chan struct{}
+}
+
+// BlockArchiveExporter uploads the committed blocks and certificates of the ledger to a block archive,
+// one bundle of consecutive rounds at a time, as soon as all the rounds of the bundle are available.
+type BlockArchiveExporter struct {
+	ledger       LedgerForBlockArchive
+	store        BlockArchiveStore
+	genesisID    string
+	bundleRounds uint64
+	log          logging.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// MakeBlockArchiveExporter creates a BlockArchiveExporter. A nil store makes an exporter that does nothing.
+func MakeBlockArchiveExporter(log logging.Logger, config config.Local, ledger LedgerForBlockArchive, store BlockArchiveStore, genesisID string) *BlockArchiveExporter {
+	return &BlockArchiveExporter{
+		ledger:       ledger,
+		store:        store,
+		genesisID:    genesisID,
+		bundleRounds: max(config.BlockArchiveBundleRounds, 1),
+		log:          log,
+	}
+}
+
+// Start uploading bundles in the background
+func (be *BlockArchiveExporter) Start() {
+	if be.store == nil {
+		return
+	}
+	var ctx context.Context
+	ctx, be.cancel = context.WithCancel(context.Background())
+	be.wg.Add(1)
+	go be.run(ctx)
+}
+
+// Stop uploading bundles, waiting for an upload in progress to be aborted
+func (be *BlockArchiveExporter) Stop() {
+	if be.cancel == nil {
+		return
+	}
+	be.cancel()
+	be.wg.Wait()
+	be.cancel = nil
+}
+
+func (be *BlockArchiveExporter) run(ctx context.Context) {
+	defer be.wg.Done()
+	var manifest BlockArchiveManifest
+	for {
+		wait, err := be.exportNextBundle(ctx, &manifest)
+		var retry <-chan time.Time
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return
+			}
+			be.log.Warnf("BlockArchiveExporter: %v", err)
+			retry = time.After(blockArchiveRetryInterval)
+		case wait == nil:
+			// a bundle was just uploaded, move on to the next one
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-wait:
+		case <-retry:
+		}
+	}
+}
+
+// exportNextBundle uploads the bundle following the last one listed in the manifest, and updates the manifest accordingly.
+// If the ledger does not have all the rounds of that bundle yet, it returns a channel that is closed once it does.
+func (be *BlockArchiveExporter) exportNextBundle(ctx context.Context, manifest *BlockArchiveManifest) (chan struct{}, error) {
+	if manifest.BundleRounds == 0 {
+		loaded, err := GetBlockArchiveManifest(ctx, be.store, be.genesisID)
+		switch {
+		case errors.Is(err, s3.ErrObjectNotFound):
+			loaded = BlockArchiveManifest{GenesisID: be.genesisID, BundleRounds: be.bundleRounds}
+		case err != nil:
+			return nil, err
+		}
+		*manifest = loaded
+	}
+
+	first, last := BlockArchiveBundleRange(manifest.LastRound+1, manifest.BundleRounds)
+	if be.ledger.Latest() < last {
+		return be.ledger.Wait(last), nil
+	}
+
+	entries := make([][]byte, 0, last-first+1)
+	for rnd := first; rnd <= last; rnd++ {
+		entry, err := RawBlockBytes(be.ledger, rnd)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read block %d: %w", rnd, err)
+		}
+		entries = append(entries, entry)
+	}
+	bundle, err := EncodeBlockArchiveBundle(entries)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode bundle %d-%d: %w", first, last, err)
+	}
+	err = be.store.PutObject(ctx, BlockArchiveBundleKey(be.genesisID, first, last), bundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to upload bundle %d-%d: %w", first, last, err)
+	}
+
+	updated := *manifest
+	updated.LastRound = last
+	err = be.store.PutObject(ctx, BlockArchiveManifestKey(be.genesisID), protocol.EncodeJSON(updated))
+	if err != nil {
+		return nil, fmt.Errorf("unable to update manifest after uploading bundle %d-%d: %w", first, last, err)
+	}
+	*manifest = updated
+	be.log.Infof("BlockArchiveExporter: uploaded rounds %d-%d (%d bytes)", first, last, len(bundle))
+	return nil, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/s3"
+)
+
+// memoryBlockArchiveStore is an in-memory BlockArchiveStore
+type memoryBlockArchiveStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (ms *memoryBlockArchiveStore) PutObject(ctx context.Context, key string, data []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.objects == nil {
+		ms.objects = make(map[string][]byte)
+	}
+	ms.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (ms *memoryBlockArchiveStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	data, ok := ms.objects[key]
+	if !ok {
+		return nil, s3.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+func TestBlockArchiveBundleRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		rnd         basics.Round
+		first, last basics.Round
+	}{
+		{rnd: 1, first: 1, last: 99},
+		{rnd: 99, first: 1, last: 99},
+		{rnd: 100, first: 100, last: 199},
+		{rnd: 250, first: 200, last: 299},
+	}
+	for _, test := range tests {
+		first, last := BlockArchiveBundleRange(test.rnd, 100)
+		require.Equal(t, test.first, first, "round %d", test.rnd)
+		require.Equal(t, test.last, last, "round %d", test.rnd)
+	}
+}
+
+func TestBlockArchiveBundleEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeLedger(t, "")
+	defer ledger.Close()
+	for i := 0; i < 3; i++ {
+		addBlock(t, ledger)
+	}
+
+	var entries [][]byte
+	for rnd := basics.Round(1); rnd <= 3; rnd++ {
+		entry, err := RawBlockBytes(ledger, rnd)
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	bundle, err := EncodeBlockArchiveBundle(entries)
+	require.NoError(t, err)
+
+	decoded, err := DecodeBlockArchiveBundle(bundle)
+	require.NoError(t, err)
+	require.Equal(t, entries, decoded)
+	for i, entry := range decoded {
+		var blockCert EncodedBlockCert
+		require.NoError(t, protocol.Decode(entry, &blockCert))
+		require.Equal(t, basics.Round(i+1), blockCert.Block.Round())
+	}
+
+	_, err = DecodeBlockArchiveBundle([]byte("not a bundle"))
+	require.Error(t, err)
+}
+
+func TestBlockArchiveExporter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeLedger(t, "")
+	defer ledger.Close()
+	for i := 0; i < 12; i++ {
+		addBlock(t, ledger)
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.BlockArchiveBundleRounds = 5
+	store := &memoryBlockArchiveStore{}
+	exporter := MakeBlockArchiveExporter(logging.TestingLog(t), cfg, ledger, store, "test-genesis")
+	exporter.Start()
+	defer func() { exporter.Stop() }()
+
+	manifestLastRound := func() basics.Round {
+		manifest, err := GetBlockArchiveManifest(context.Background(), store, "test-genesis")
+		if err != nil {
+			return 0
+		}
+		return manifest.LastRound
+	}
+
+	// rounds 1-4 and 5-9 are available, while 10-14 is not complete yet
+	require.Eventually(t, func() bool { return manifestLastRound() == 9 }, 10*time.Second, 10*time.Millisecond)
+	for _, bundle := range []struct{ first, last basics.Round }{{1, 4}, {5, 9}} {
+		data, err := store.GetObject(context.Background(), BlockArchiveBundleKey("test-genesis", bundle.first, bundle.last))
+		require.NoError(t, err)
+		entries, err := DecodeBlockArchiveBundle(data)
+		require.NoError(t, err)
+		require.Len(t, entries, int(bundle.last-bundle.first+1))
+		for i, entry := range entries {
+			expected, err := RawBlockBytes(ledger, bundle.first+basics.Round(i))
+			require.NoError(t, err)
+			require.Equal(t, expected, entry)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		addBlock(t, ledger)
+	}
+	require.Eventually(t, func() bool { return manifestLastRound() == 14 }, 10*time.Second, 10*time.Millisecond)
+
+	// a restarted exporter picks up after the last bundle listed in the manifest, keeping its bundle size
+	exporter.Stop()
+	cfg.BlockArchiveBundleRounds = 1000
+	exporter = MakeBlockArchiveExporter(logging.TestingLog(t), cfg, ledger, store, "test-genesis")
+	exporter.Start()
+	for i := 0; i < 5; i++ {
+		addBlock(t, ledger)
+	}
+	require.Eventually(t, func() bool { return manifestLastRound() == 19 }, 10*time.Second, 10*time.Millisecond)
+	_, err := store.GetObject(context.Background(), BlockArchiveBundleKey("test-genesis", 15, 19))
+	require.NoError(t, err)
+}
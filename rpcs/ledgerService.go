@@ -17,9 +17,12 @@
 package rpcs
 
 import (
+	"archive/tar"
+	"bufio"
 	"compress/gzip"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -27,13 +30,17 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/DataDog/zstd"
 	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
 
 	"github.com/DePINNetwork/depin-sdk/config"
 	"github.com/DePINNetwork/depin-sdk/data/basics"
 	"github.com/DePINNetwork/depin-sdk/ledger"
 	"github.com/DePINNetwork/depin-sdk/ledger/ledgercore"
 	"github.com/DePINNetwork/depin-sdk/logging"
+	"github.com/DePINNetwork/depin-sdk/util/metrics"
+	"github.com/DePINNetwork/go-deadlock"
 )
 
 const (
@@ -51,8 +58,31 @@ const (
 
 	// expectedWorstUploadSpeedBytesPerSecond defines the worst-case scenario upload speed we expect to get while uploading a catchpoint file
 	expectedWorstUploadSpeedBytesPerSecond = 20 * 1024
+
+	// LedgerEntriesStartParam is the query argument selecting the index of the first catchpoint file entry to serve.
+	// Along with LedgerEntriesCountParam, it allows a client to download a catchpoint file in parts.
+	LedgerEntriesStartParam = "start"
+	// LedgerEntriesCountParam is the query argument limiting the number of catchpoint file entries to serve.
+	// When missing, all the entries from LedgerEntriesStartParam onwards are served.
+	LedgerEntriesCountParam = "count"
+	// LedgerEntriesStartHeader is the HTTP header set on responses serving a part of a catchpoint file, holding the index
+	// of the first entry served. Responses lacking it hold the entire catchpoint file.
+	LedgerEntriesStartHeader = "X-Algorand-Ledger-Entries-Start"
+
+	// ledgerEntriesRateLimit is the number of catchpoint file entries per second each peer may request by asking for
+	// parts of catchpoint files. Entries skipped to reach the first entry requested count as well, unless the
+	// catchpoint file is indexed.
+	ledgerEntriesRateLimit = 1024
+	// ledgerEntriesRateBurst is the number of catchpoint file entries a peer may request at once.
+	ledgerEntriesRateBurst = 4 * ledgerEntriesRateLimit
+	// ledgerEntriesMaxPeers is the number of tracked peers above which peers with a full bucket are forgotten
+	ledgerEntriesMaxPeers = 1024
+	// ledgerEntriesRetryAfter is the Retry-After header value of rate limited requests for parts of catchpoint files
+	ledgerEntriesRetryAfter = "4"
 )
 
+var ledgerEntriesRateLimitedCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_rpcs_ledger_entries_rate_limited", Description: "Number of requests for parts of catchpoint files rejected by the per-peer rate limiter"})
+
 // LedgerForService defines the ledger interface required for the LedgerService
 type LedgerForService interface {
 	// GetCatchpointStream returns the ReadCloseSize for a request catchpoint round
@@ -73,6 +103,10 @@ type LedgerService struct {
 	net           httpGossipNode
 	enableService bool
 	stopping      sync.WaitGroup
+
+	// entriesLimitersMu guards entriesLimiters, which limit the rate of requests for parts of catchpoint files by peer
+	entriesLimitersMu deadlock.Mutex
+	entriesLimiters   map[string]*rate.Limiter
 }
 
 // MakeLedgerService creates a LedgerService around the provider Ledger and registers it with the HTTP router
@@ -82,6 +116,8 @@ func MakeLedgerService(config config.Local, ledger LedgerForService, net httpGos
 		genesisID:     genesisID,
 		net:           net,
 		enableService: config.EnableLedgerService,
+
+		entriesLimiters: make(map[string]*rate.Limiter),
 	}
 	// the underlying gorilla/mux doesn't support "unregister", so we're forced to implement it ourselves.
 	if service.enableService {
@@ -204,7 +240,33 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		}
 	}
 	defer cs.Close()
+	entriesStart, entriesCount, partial, err := parseLedgerEntriesRange(request)
+	if err != nil {
+		logging.Base().Debugf("LedgerService.ServeHTTP: bad entries range: %v", err)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("invalid catchpoint file entries range : %v", err)))
+		return
+	}
+	indexed, hasIndex := cs.(ledger.CatchpointEntriesStream)
+	if partial && request.Method != http.MethodHead {
+		// without an index, the entries before the requested ones have to be decompressed as well. Serving all the
+		// entries following start costs no more than serving the whole catchpoint file, which isn't limited.
+		cost := entriesCount
+		if !hasIndex {
+			cost += entriesStart
+		}
+		if !ls.allowEntries(ledgerRequestPeer(request), cost, time.Now()) {
+			ledgerEntriesRateLimitedCounter.Inc(nil)
+			logging.Base().Debugf("LedgerService.ServeHTTP: rate limited entries %d+%d of catchpoint round %d for %s", entriesStart, entriesCount, round, request.RemoteAddr)
+			response.Header().Set("Retry-After", ledgerEntriesRetryAfter)
+			response.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	}
 	response.Header().Set("Content-Type", LedgerResponseContentType)
+	if partial {
+		response.Header().Set(LedgerEntriesStartHeader, strconv.FormatUint(entriesStart, 10))
+	}
 	if request.Method == http.MethodHead {
 		response.WriteHeader(http.StatusOK)
 		return
@@ -222,10 +284,26 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Warnf("LedgerService.ServeHTTP unable to set connection timeout")
 	}
 
-	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
-	if requestedCompressedResponse {
-		response.Header().Set("Content-Encoding", "gzip")
-		written, err := io.Copy(response, cs)
+	catchpointReader := bufio.NewReader(cs)
+	acceptedEncodings := request.Header.Get("Accept-Encoding")
+	if partial {
+		var written uint64
+		if hasIndex {
+			written, err = serveIndexedLedgerEntries(response, indexed, ledger.CatchpointFileEncoding(catchpointReader), entriesStart, entriesCount, acceptedEncodings)
+		} else {
+			written, err = serveLedgerEntries(response, catchpointReader, entriesStart, entriesCount, acceptedEncodings)
+		}
+		if err != nil {
+			logging.Base().Infof("LedgerService.ServeHTTP : unable to write entries %d+%d of catchpoint file for round %d, written entries %d : %v", entriesStart, entriesCount, round, written, err)
+			return
+		}
+		elapsed := time.Since(start)
+		logging.Base().Infof("LedgerService.ServeHTTP: served %d entries of catchpoint round %d in %d sec", written, round, int(elapsed.Seconds()))
+		return
+	}
+	if fileEncoding := ledger.CatchpointFileEncoding(catchpointReader); fileEncoding != "" && strings.Contains(acceptedEncodings, fileEncoding) {
+		response.Header().Set("Content-Encoding", fileEncoding)
+		written, err := io.Copy(response, catchpointReader)
 		if err != nil {
 			logging.Base().Infof("LedgerService.ServeHTTP : unable to write compressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 		}
@@ -233,15 +311,17 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP: served catchpoint round %d in %d sec", round, int(elapsed.Seconds()))
 		return
 	}
-	decompressedGzip, err := gzip.NewReader(cs)
+	// the client doesn't accept the encoding of the catchpoint file, so the file is decompressed and
+	// compressed again using an encoding the client accepts, if any.
+	decompressed, err := ledger.MakeCatchpointFileReader(catchpointReader)
 	if err != nil {
 		logging.Base().Warnf("LedgerService.ServeHTTP : failed to decompress catchpoint %d %v", round, err)
 		response.WriteHeader(http.StatusInternalServerError)
 		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be decompressed due to internal error : %v", round, err)))
 		return
 	}
-	defer decompressedGzip.Close()
-	written, err := io.Copy(response, decompressedGzip)
+	defer decompressed.Close()
+	written, err := serveLedgerStream(response, decompressed, acceptedEncodings)
 	if err != nil {
 		logging.Base().Infof("LedgerService.ServeHTTP : unable to write decompressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 	} else {
@@ -249,3 +329,180 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP: served catchpoint round %d in %d sec", round, int(elapsed.Seconds()))
 	}
 }
+
+// ledgerRequestPeer identifies the peer making the request by the host part of its remote address.
+func ledgerRequestPeer(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
+// allowEntries takes cost entries from the bucket of the peer, returning false if the bucket doesn't hold enough of
+// them, in which case nothing is taken. A request costing more than the bucket holds is charged the whole bucket.
+func (ls *LedgerService) allowEntries(peer string, cost uint64, now time.Time) bool {
+	ls.entriesLimitersMu.Lock()
+	defer ls.entriesLimitersMu.Unlock()
+
+	limiter, ok := ls.entriesLimiters[peer]
+	if !ok {
+		if len(ls.entriesLimiters) >= ledgerEntriesMaxPeers {
+			// peers with a full bucket are indistinguishable from new peers.
+			for key, l := range ls.entriesLimiters {
+				if l.TokensAt(now) >= ledgerEntriesRateBurst {
+					delete(ls.entriesLimiters, key)
+				}
+			}
+		}
+		limiter = rate.NewLimiter(ledgerEntriesRateLimit, ledgerEntriesRateBurst)
+		ls.entriesLimiters[peer] = limiter
+	}
+	return limiter.AllowN(now, int(min(cost, ledgerEntriesRateBurst)))
+}
+
+// parseLedgerEntriesRange parses the optional catchpoint file entries range of the request.
+// A zero count stands for all the entries following start.
+func parseLedgerEntriesRange(request *http.Request) (start, count uint64, partial bool, err error) {
+	query := request.URL.Query()
+	startStr := query.Get(LedgerEntriesStartParam)
+	countStr := query.Get(LedgerEntriesCountParam)
+	if startStr == "" {
+		if countStr != "" {
+			return 0, 0, false, fmt.Errorf("'%s' specified without '%s'", LedgerEntriesCountParam, LedgerEntriesStartParam)
+		}
+		return 0, 0, false, nil
+	}
+	start, err = strconv.ParseUint(startStr, 10, 64)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("unable to parse '%s' : %v", LedgerEntriesStartParam, err)
+	}
+	if countStr != "" {
+		count, err = strconv.ParseUint(countStr, 10, 64)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("unable to parse '%s' : %v", LedgerEntriesCountParam, err)
+		}
+		if count == 0 {
+			return 0, 0, false, fmt.Errorf("'%s' must be positive", LedgerEntriesCountParam)
+		}
+	}
+	return start, count, true, nil
+}
+
+// ledgerResponseEncoder sets the content encoding of the response to the preferred one among the accepted encodings,
+// returning a writer that compresses the response body accordingly. The writer has to be closed once the body is written.
+func ledgerResponseEncoder(response http.ResponseWriter, acceptedEncodings string) (io.WriteCloser, error) {
+	switch {
+	case strings.Contains(acceptedEncodings, ledger.CatchpointFileEncodingZstd):
+		response.Header().Set("Content-Encoding", ledger.CatchpointFileEncodingZstd)
+		return zstd.NewWriterLevel(response, zstd.BestSpeed), nil
+	case strings.Contains(acceptedEncodings, ledger.CatchpointFileEncodingGzip):
+		response.Header().Set("Content-Encoding", ledger.CatchpointFileEncodingGzip)
+		return gzip.NewWriterLevel(response, gzip.BestSpeed)
+	default:
+		return nopWriteCloser{response}, nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// serveLedgerStream writes the uncompressed catchpoint file tar stream, compressed using one of the accepted encodings.
+// It returns the number of uncompressed bytes written.
+func serveLedgerStream(response http.ResponseWriter, tarStream io.Reader, acceptedEncodings string) (written int64, err error) {
+	encoder, err := ledgerResponseEncoder(response, acceptedEncodings)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		closeErr := encoder.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	return io.Copy(encoder, tarStream)
+}
+
+// serveIndexedLedgerEntries writes a tar stream holding count entries of the indexed catchpoint file, starting at the
+// entry with index start, or all of the entries following start if count is zero. Only the frames holding these
+// entries are read, and they are written as is if the client accepts the encoding of the catchpoint file. Otherwise,
+// they are decompressed and compressed again using one of the accepted encodings. It returns the number of entries
+// written.
+func serveIndexedLedgerEntries(response http.ResponseWriter, cs ledger.CatchpointEntriesStream, fileEncoding string, start, count uint64, acceptedEncodings string) (written uint64, err error) {
+	frames := cs.EntryFrames(start, count)
+	if fileEncoding != "" && strings.Contains(acceptedEncodings, fileEncoding) {
+		response.Header().Set("Content-Encoding", fileEncoding)
+		_, err = io.Copy(response, frames)
+		if err != nil {
+			return 0, err
+		}
+		written = cs.EntryCount() - min(start, cs.EntryCount())
+		if count != 0 {
+			written = min(written, count)
+		}
+		return written, nil
+	}
+
+	decompressed, err := ledger.NewCatchpointFileDecoder(frames, fileEncoding)
+	if err != nil {
+		return 0, err
+	}
+	defer decompressed.Close()
+	return writeLedgerEntries(response, decompressed, 0, 0, acceptedEncodings)
+}
+
+// serveLedgerEntries writes a tar stream holding count entries of the catchpoint file, starting at the entry with
+// index start, or all of the entries following start if count is zero. The tar stream is compressed using one of
+// the accepted encodings. It returns the number of entries written.
+func serveLedgerEntries(response http.ResponseWriter, cs *bufio.Reader, start, count uint64, acceptedEncodings string) (written uint64, err error) {
+	decompressed, err := ledger.MakeCatchpointFileReader(cs)
+	if err != nil {
+		return 0, err
+	}
+	defer decompressed.Close()
+	return writeLedgerEntries(response, decompressed, start, count, acceptedEncodings)
+}
+
+// writeLedgerEntries copies count entries of the decompressed tar stream, starting at the entry with index start, or
+// all of the entries following start if count is zero, to the response, compressed using one of the accepted
+// encodings. It returns the number of entries written.
+func writeLedgerEntries(response http.ResponseWriter, decompressed io.Reader, start, count uint64, acceptedEncodings string) (written uint64, err error) {
+	encoder, err := ledgerResponseEncoder(response, acceptedEncodings)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		closeErr := encoder.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	tarReader := tar.NewReader(decompressed)
+	tarWriter := tar.NewWriter(encoder)
+	for index := uint64(0); count == 0 || index < start+count; index++ {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return written, err
+		}
+		if index < start {
+			continue
+		}
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return written, err
+		}
+		_, err = io.Copy(tarWriter, tarReader)
+		if err != nil {
+			return written, err
+		}
+		written++
+	}
+	return written, tarWriter.Close()
+}
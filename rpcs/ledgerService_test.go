@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/DataDog/zstd"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -231,3 +232,281 @@ func TestLedgerServiceP2P(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+type entriesLedgerForService struct {
+	entries []string
+	// zstd makes the catchpoint file compressed with zstd rather than gzip
+	zstd bool
+	// indexed makes the catchpoint file compressed in a frame per entry, indexed like the catchpoint files the
+	// ledger writes
+	indexed bool
+}
+
+func (l *entriesLedgerForService) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	buf := bytes.NewBuffer(nil)
+	newCompressor := func() io.WriteCloser {
+		if l.zstd {
+			return zstd.NewWriter(buf)
+		}
+		return gzip.NewWriter(buf)
+	}
+	compressor := newCompressor()
+	var offsets []uint64
+	wtar := tar.NewWriter(&frameSwitcher{&compressor})
+	for _, entry := range l.entries {
+		offsets = append(offsets, uint64(buf.Len()))
+		err := wtar.WriteHeader(&tar.Header{Name: entry, Mode: 0600, Size: int64(len(entry))})
+		if err != nil {
+			return nil, err
+		}
+		_, err = wtar.Write([]byte(entry))
+		if err != nil {
+			return nil, err
+		}
+		if l.indexed {
+			wtar.Flush()
+			compressor.Close()
+			compressor = newCompressor()
+		}
+	}
+	offsets = append(offsets, uint64(buf.Len()))
+	wtar.Close()
+	compressor.Close()
+	if l.indexed {
+		return &indexedSizedStream{mockSizedStream{bytes.NewBuffer(bytes.Clone(buf.Bytes()))}, buf.Bytes(), offsets}, nil
+	}
+	return mockSizedStream{buf}, nil
+}
+
+// frameSwitcher writes to the compressor it points to, which is replaced along with each frame.
+type frameSwitcher struct {
+	compressor *io.WriteCloser
+}
+
+func (f *frameSwitcher) Write(p []byte) (int, error) {
+	return (*f.compressor).Write(p)
+}
+
+type indexedSizedStream struct {
+	mockSizedStream
+	data    []byte
+	offsets []uint64
+}
+
+func (s *indexedSizedStream) EntryCount() uint64 {
+	return uint64(len(s.offsets) - 1)
+}
+
+func (s *indexedSizedStream) EntryFrames(start, count uint64) io.Reader {
+	entries := s.EntryCount()
+	start = min(start, entries)
+	end := entries
+	if count != 0 && count < entries-start {
+		end = start + count
+	}
+	return io.MultiReader(bytes.NewReader(s.data[s.offsets[start]:s.offsets[end]]), bytes.NewReader(s.data[s.offsets[entries]:]))
+}
+
+func readTestTarEntries(t *testing.T, r io.Reader) (entries []string) {
+	tarReader := tar.NewReader(r)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return entries
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		require.Equal(t, header.Name, string(data))
+		entries = append(entries, header.Name)
+	}
+}
+
+// TestLedgerServiceEntries checks parts of a catchpoint file can be served, entry by entry.
+func TestLedgerServiceEntries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, indexed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("indexed=%v", indexed), func(t *testing.T) {
+			testLedgerServiceEntries(t, indexed)
+		})
+	}
+}
+
+func testLedgerServiceEntries(t *testing.T, indexed bool) {
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	l := entriesLedgerForService{entries: []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack", "balances.3.msgpack"}, indexed: indexed}
+	fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+	fnet.On("RegisterHTTPHandler", LedgerServiceLedgerPath, mock.Anything).Return()
+	ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+	ledgerService.Start()
+	defer ledgerService.Stop()
+
+	get := func(query string, acceptGzip bool) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/1?%s", genesisID, query), nil)
+		require.NoError(t, err)
+		if acceptGzip {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+		fnet.router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	testcases := []struct {
+		query    string
+		start    string
+		expected []string
+	}{
+		{"", "", l.entries},
+		{"start=0", "0", l.entries},
+		{"start=1&count=2", "1", l.entries[1:3]},
+		{"start=2", "2", l.entries[2:]},
+		{"start=3&count=16", "3", l.entries[3:]},
+		{"start=4&count=16", "4", nil},
+	}
+	for _, tc := range testcases {
+		rr := get(tc.query, false)
+		require.Equal(t, http.StatusOK, rr.Code, tc.query)
+		require.Equal(t, LedgerResponseContentType, rr.Header().Get("Content-Type"))
+		require.Equal(t, tc.start, rr.Header().Get(LedgerEntriesStartHeader), tc.query)
+		require.Equal(t, tc.expected, readTestTarEntries(t, rr.Body), tc.query)
+	}
+
+	// compressed partial response
+	rr := get("start=1&count=1", true)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+	gz, err := gzip.NewReader(rr.Body)
+	require.NoError(t, err)
+	require.Equal(t, l.entries[1:2], readTestTarEntries(t, gz))
+
+	// malformed ranges
+	for _, query := range []string{"count=1", "start=1&count=0", "start=-1", "start=1&count=x"} {
+		rr := get(query, false)
+		require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		require.Contains(t, rr.Body.String(), "invalid catchpoint file entries range")
+	}
+}
+
+// TestLedgerServiceEncodings checks catchpoint files are served using a content encoding accepted by the client.
+func TestLedgerServiceEncodings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	entries := []string{"content.msgpack", "stateProofVerificationContext.msgpack", "balances.1.msgpack"}
+
+	decode := func(t *testing.T, rr *httptest.ResponseRecorder) []string {
+		decoder, err := ledger.NewCatchpointFileDecoder(rr.Body, rr.Header().Get("Content-Encoding"))
+		require.NoError(t, err)
+		defer decoder.Close()
+		return readTestTarEntries(t, decoder)
+	}
+
+	testcases := []struct {
+		zstdFile         bool
+		indexed          bool
+		query            string
+		acceptEncoding   string
+		expectedEncoding string
+	}{
+		// the catchpoint file is served as is when its encoding is accepted
+		{true, false, "", "zstd, gzip", "zstd"},
+		{false, false, "", "zstd, gzip", "gzip"},
+		{true, true, "", "zstd, gzip", "zstd"},
+		// otherwise, it is compressed again using an accepted encoding, if any
+		{true, false, "", "gzip", "gzip"},
+		{true, false, "", "", ""},
+		{false, false, "", "zstd", "zstd"},
+		// partial responses are compressed using the preferred accepted encoding
+		{true, false, "start=1", "zstd, gzip", "zstd"},
+		{false, false, "start=1", "zstd, gzip", "zstd"},
+		{true, false, "start=1", "gzip", "gzip"},
+		{true, false, "start=1", "", ""},
+		// unless the catchpoint file is indexed, in which case the frames holding the entries are served as is
+		// when the encoding of the catchpoint file is accepted
+		{true, true, "start=1", "zstd, gzip", "zstd"},
+		{false, true, "start=1", "zstd, gzip", "gzip"},
+		{true, true, "start=1", "gzip", "gzip"},
+		{true, true, "start=1", "", ""},
+	}
+	for _, tc := range testcases {
+		name := fmt.Sprintf("zstd=%v/indexed=%v/query=%s/accept=%s", tc.zstdFile, tc.indexed, tc.query, tc.acceptEncoding)
+		t.Run(name, func(t *testing.T) {
+			l := entriesLedgerForService{entries: entries, zstd: tc.zstdFile, indexed: tc.indexed}
+			fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+			fnet.On("RegisterHTTPHandler", LedgerServiceLedgerPath, mock.Anything).Return()
+			ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+			ledgerService.Start()
+			defer ledgerService.Stop()
+
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/1?%s", genesisID, tc.query), nil)
+			require.NoError(t, err)
+			if tc.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+			}
+			fnet.router.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code)
+			require.Equal(t, tc.expectedEncoding, rr.Header().Get("Content-Encoding"))
+			expected := entries
+			if tc.query != "" {
+				expected = entries[1:]
+			}
+			require.Equal(t, expected, decode(t, rr))
+		})
+	}
+}
+
+// TestLedgerServiceEntriesRateLimit checks requests for parts of catchpoint files are rate limited per peer, counting
+// the entries decompressed to serve them.
+func TestLedgerServiceEntriesRateLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	entries := []string{"content.msgpack", "balances.1.msgpack"}
+
+	for _, indexed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("indexed=%v", indexed), func(t *testing.T) {
+			l := entriesLedgerForService{entries: entries, indexed: indexed}
+			fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+			fnet.On("RegisterHTTPHandler", LedgerServiceLedgerPath, mock.Anything).Return()
+			ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+			ledgerService.Start()
+			defer ledgerService.Stop()
+
+			get := func(query string, remoteAddr string) *httptest.ResponseRecorder {
+				rr := httptest.NewRecorder()
+				req := httptest.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/1?%s", genesisID, query), nil)
+				req.RemoteAddr = remoteAddr
+				fnet.router.ServeHTTP(rr, req)
+				return rr
+			}
+
+			// skipping a large number of entries takes the whole bucket of the peer, unless the catchpoint file
+			// is indexed, in which case only the entries served count.
+			far := fmt.Sprintf("start=%d&count=1", 10*ledgerEntriesRateBurst)
+			require.Equal(t, http.StatusOK, get(far, "10.0.0.1:4160").Code)
+			rr := get(far, "10.0.0.1:4161")
+			if indexed {
+				require.Equal(t, http.StatusOK, rr.Code)
+				return
+			}
+			require.Equal(t, http.StatusTooManyRequests, rr.Code)
+			require.Equal(t, ledgerEntriesRetryAfter, rr.Header().Get("Retry-After"))
+
+			// other peers and whole catchpoint files aren't limited
+			require.Equal(t, http.StatusOK, get(far, "10.0.0.2:4160").Code)
+			require.Equal(t, http.StatusOK, get("", "10.0.0.1:4160").Code)
+		})
+	}
+}
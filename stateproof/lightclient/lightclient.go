@@ -0,0 +1,326 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient implements a state proofs light client: starting from trusted voters, it follows the chain of
+// state proofs published by an algod node, verifying each one using the voters attested to by its predecessor, and
+// uses the verified state proofs to authenticate block headers and transactions served by that, untrusted, node.
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/DePINNetwork/go-deadlock"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/crypto/merklearray"
+	sp "github.com/DePINNetwork/depin-sdk/crypto/stateproof"
+	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/client"
+	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/v2/generated/model"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
+	"github.com/DePINNetwork/depin-sdk/data/stateproofmsg"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/rpcs"
+)
+
+// maxTrustedIntervals is the number of the most recent verified state proof messages the client retains. Block headers
+// and transactions can only be verified for the rounds attested to by the retained messages.
+const maxTrustedIntervals = 4096
+
+// Errors returned by the light client
+var (
+	// ErrStateProofNotAvailable is returned when the source does not have the state proof following the checkpoint yet.
+	ErrStateProofNotAvailable = errors.New("the next state proof is not available yet")
+	// ErrRoundNotAttested is returned when a round is not attested to by any of the verified state proofs retained by the client.
+	ErrRoundNotAttested = errors.New("round is not attested to by a verified state proof")
+	// ErrInvalidStateProof is returned when the state proof served by the source does not follow the checkpoint or does not verify.
+	ErrInvalidStateProof = errors.New("invalid state proof")
+	// ErrInvalidProof is returned when a block header or transaction proof served by the source does not verify.
+	ErrInvalidProof = errors.New("invalid proof")
+)
+
+// Source is the subset of the algod REST API the light client fetches state proofs, blocks and proofs from.
+// client.RestClient implements it. None of the data returned by the source is trusted.
+type Source interface {
+	StateProofs(round uint64) (model.StateProofResponse, error)
+	LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error)
+	TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error)
+	EncodedBlockCert(round uint64) (rpcs.EncodedBlockCert, error)
+}
+
+// Checkpoint is the trusted data the light client verifies the next state proof with.
+type Checkpoint struct {
+	// Round is the last round attested to by the state proof the checkpoint was taken from, or the round of the
+	// block header it was made from. The next state proof attests to the state proof interval that follows it.
+	Round basics.Round
+	// VotersCommitment is the vector commitment root of the voters signing the next state proof.
+	VotersCommitment crypto.GenericDigest
+	// LnProvenWeight is the natural log of the weight the next state proof needs to prove, with 16 bits of precision.
+	LnProvenWeight uint64
+}
+
+// MakeCheckpoint returns the checkpoint for verifying the state proof that follows the block header votersHdr,
+// typically a trusted block header such as the first one whose round is a multiple of the state proof interval.
+func MakeCheckpoint(votersHdr bookkeeping.BlockHeader) (Checkpoint, error) {
+	proto := config.Consensus[votersHdr.CurrentProtocol]
+	if proto.StateProofInterval == 0 {
+		return Checkpoint{}, fmt.Errorf("state proofs are not enabled in protocol %s", votersHdr.CurrentProtocol)
+	}
+	if uint64(votersHdr.Round)%proto.StateProofInterval != 0 {
+		return Checkpoint{}, fmt.Errorf("round %d is not a multiple of the state proof interval %d", votersHdr.Round, proto.StateProofInterval)
+	}
+
+	tracking := votersHdr.StateProofTracking[protocol.StateProofBasic]
+	provenWeight, overflowed := basics.Muldiv(tracking.StateProofOnlineTotalWeight.ToUint64(), uint64(proto.StateProofWeightThreshold), 1<<32)
+	if overflowed {
+		return Checkpoint{}, fmt.Errorf("overflow computing the proven weight of round %d", votersHdr.Round)
+	}
+	lnProvenWeight, err := sp.LnIntApproximation(provenWeight)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+
+	return Checkpoint{
+		Round:            votersHdr.Round,
+		VotersCommitment: tracking.StateProofVotersCommitment,
+		LnProvenWeight:   lnProvenWeight,
+	}, nil
+}
+
+// Client is a state proofs light client. It is safe for concurrent use.
+type Client struct {
+	source         Source
+	interval       uint64
+	strengthTarget uint64
+
+	mu         deadlock.Mutex
+	checkpoint Checkpoint
+	// messages holds the retained verified state proof messages, by their last attested round
+	messages map[basics.Round]stateproofmsg.Message
+	// headers is the trusted light block headers cache, bounded by headersCacheSize
+	headers          map[basics.Round]bookkeeping.LightBlockHeader
+	headersCacheSize int
+}
+
+// MakeClient creates a light client fetching from the given source, starting from a trusted checkpoint.
+// The state proofs are verified using the parameters of the consensus protocol version, and up to
+// headersCacheSize verified light block headers are cached.
+func MakeClient(source Source, checkpoint Checkpoint, version protocol.ConsensusVersion, headersCacheSize int) (*Client, error) {
+	proto, ok := config.Consensus[version]
+	if !ok {
+		return nil, protocol.Error(version)
+	}
+	if proto.StateProofInterval == 0 {
+		return nil, fmt.Errorf("state proofs are not enabled in protocol %s", version)
+	}
+	return &Client{
+		source:           source,
+		interval:         proto.StateProofInterval,
+		strengthTarget:   proto.StateProofStrengthTarget,
+		checkpoint:       checkpoint,
+		messages:         make(map[basics.Round]stateproofmsg.Message),
+		headers:          make(map[basics.Round]bookkeeping.LightBlockHeader),
+		headersCacheSize: headersCacheSize,
+	}, nil
+}
+
+// Checkpoint returns the checkpoint of the latest verified state proof.
+func (c *Client) Checkpoint() Checkpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.checkpoint
+}
+
+// Sync verifies the state proofs following the checkpoint until the latest state proof available from the source,
+// and returns the resulting checkpoint.
+func (c *Client) Sync(ctx context.Context) (Checkpoint, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return c.Checkpoint(), ctx.Err()
+		default:
+		}
+		checkpoint, err := c.VerifyNextStateProof()
+		if errors.Is(err, ErrStateProofNotAvailable) {
+			return checkpoint, nil
+		}
+		if err != nil {
+			return checkpoint, err
+		}
+	}
+}
+
+// VerifyNextStateProof fetches the state proof following the checkpoint from the source and verifies it using the
+// checkpoint's voters. Once verified, the state proof's message becomes the new checkpoint, which is returned.
+func (c *Client) VerifyNextStateProof() (Checkpoint, error) {
+	checkpoint := c.Checkpoint()
+	firstRound := checkpoint.Round + 1
+	lastRound := checkpoint.Round + basics.Round(c.interval)
+
+	resp, err := c.source.StateProofs(uint64(firstRound))
+	if err != nil {
+		var httpErr client.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return checkpoint, ErrStateProofNotAvailable
+		}
+		return checkpoint, err
+	}
+
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: resp.Message.BlockHeadersCommitment,
+		VotersCommitment:       resp.Message.VotersCommitment,
+		LnProvenWeight:         resp.Message.LnProvenWeight,
+		FirstAttestedRound:     resp.Message.FirstAttestedRound,
+		LastAttestedRound:      resp.Message.LastAttestedRound,
+	}
+	if msg.FirstAttestedRound != uint64(firstRound) || msg.LastAttestedRound != uint64(lastRound) {
+		return checkpoint, fmt.Errorf("%w: attests to rounds %d-%d rather than %d-%d", ErrInvalidStateProof,
+			msg.FirstAttestedRound, msg.LastAttestedRound, firstRound, lastRound)
+	}
+
+	var stateProof sp.StateProof
+	err = protocol.Decode(resp.StateProof, &stateProof)
+	if err != nil {
+		return checkpoint, fmt.Errorf("%w: %v", ErrInvalidStateProof, err)
+	}
+	verifier := sp.MkVerifierWithLnProvenWeight(checkpoint.VotersCommitment, checkpoint.LnProvenWeight, c.strengthTarget)
+	err = verifier.Verify(msg.LastAttestedRound, msg.Hash(), &stateProof)
+	if err != nil {
+		return checkpoint, fmt.Errorf("%w: attesting to rounds %d-%d: %v", ErrInvalidStateProof, firstRound, lastRound, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checkpoint.Round != checkpoint.Round {
+		// another goroutine verified this state proof concurrently
+		return c.checkpoint, nil
+	}
+	c.checkpoint = Checkpoint{
+		Round:            lastRound,
+		VotersCommitment: msg.VotersCommitment,
+		LnProvenWeight:   msg.LnProvenWeight,
+	}
+	c.messages[lastRound] = msg
+	delete(c.messages, lastRound.SubSaturate(basics.Round(c.interval*maxTrustedIntervals)))
+	return c.checkpoint, nil
+}
+
+// LightBlockHeader returns the light block header of the given round, after verifying it against the verified state
+// proof attesting to the round.
+func (c *Client) LightBlockHeader(round basics.Round) (bookkeeping.LightBlockHeader, error) {
+	c.mu.Lock()
+	hdr, cached := c.headers[round]
+	msg, attested := c.messages[round.RoundUpToMultipleOf(basics.Round(c.interval))]
+	c.mu.Unlock()
+	if cached {
+		return hdr, nil
+	}
+	if round == 0 || !attested {
+		return bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: %d", ErrRoundNotAttested, round)
+	}
+
+	blockCert, err := c.source.EncodedBlockCert(uint64(round))
+	if err != nil {
+		return bookkeeping.LightBlockHeader{}, err
+	}
+	hdr = blockCert.Block.ToLightBlockHeader()
+	if hdr.Round != round {
+		return bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: got the block of round %d rather than %d", ErrInvalidProof, hdr.Round, round)
+	}
+
+	resp, err := c.source.LightBlockHeaderProof(uint64(round))
+	if err != nil {
+		return bookkeeping.LightBlockHeader{}, err
+	}
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), resp.Proof)
+	if err != nil {
+		return bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	elems := map[uint64]crypto.Hashable{uint64(round) - msg.FirstAttestedRound: &hdr}
+	err = merklearray.VerifyVectorCommitment(msg.BlockHeadersCommitment, elems, proof.ToProof())
+	if err != nil {
+		return bookkeeping.LightBlockHeader{}, fmt.Errorf("%w: light block header of round %d: %v", ErrInvalidProof, round, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, cached := c.headers[round]; cached || c.headersCacheSize <= 0 {
+		return hdr, nil
+	}
+	if len(c.headers) >= c.headersCacheSize {
+		// make room by evicting the cached header of the lowest round
+		first := true
+		var oldest basics.Round
+		for rnd := range c.headers {
+			if first || rnd < oldest {
+				oldest = rnd
+				first = false
+			}
+		}
+		delete(c.headers, oldest)
+	}
+	c.headers[round] = hdr
+	return hdr, nil
+}
+
+// txnMerkleLeaf is a leaf of the SHA-256 vector commitment on the transactions of a block.
+type txnMerkleLeaf struct {
+	txid     crypto.Digest
+	stibHash crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l *txnMerkleLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 2*crypto.DigestSize)
+	copy(buf, l.txid[:])
+	copy(buf[crypto.DigestSize:], l.stibHash[:])
+	return protocol.TxnMerkleLeaf, buf
+}
+
+// VerifyTransaction verifies that the transaction txn was committed in the block of the given round, which must be
+// attested to by a verified state proof.
+func (c *Client) VerifyTransaction(txn transactions.Transaction, round basics.Round) error {
+	hdr, err := c.LightBlockHeader(round)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.source.TransactionProof(txn.ID().String(), uint64(round), crypto.Sha256)
+	if err != nil {
+		return err
+	}
+	if resp.Hashtype != model.TransactionProofResponseHashtypeSha256 || len(resp.Stibhash) != crypto.DigestSize {
+		return fmt.Errorf("%w: unexpected transaction proof of type %s", ErrInvalidProof, resp.Hashtype)
+	}
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), resp.Proof)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+
+	leaf := txnMerkleLeaf{txid: txn.IDSha256()}
+	copy(leaf.stibHash[:], resp.Stibhash)
+	elems := map[uint64]crypto.Hashable{resp.Idx: &leaf}
+	err = merklearray.VerifyVectorCommitment(hdr.Sha256TxnCommitment, elems, proof.ToProof())
+	if err != nil {
+		return fmt.Errorf("%w: transaction %s in round %d: %v", ErrInvalidProof, txn.ID(), round, err)
+	}
+	return nil
+}
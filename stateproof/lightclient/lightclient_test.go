@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/config"
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/crypto/merklearray"
+	"github.com/DePINNetwork/depin-sdk/crypto/merklesignature"
+	sp "github.com/DePINNetwork/depin-sdk/crypto/stateproof"
+	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/client"
+	"github.com/DePINNetwork/depin-sdk/daemon/algod/api/server/v2/generated/model"
+	"github.com/DePINNetwork/depin-sdk/data/basics"
+	"github.com/DePINNetwork/depin-sdk/data/bookkeeping"
+	"github.com/DePINNetwork/depin-sdk/data/transactions"
+	"github.com/DePINNetwork/depin-sdk/protocol"
+	"github.com/DePINNetwork/depin-sdk/rpcs"
+	"github.com/DePINNetwork/depin-sdk/stateproof"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+// testChain is an in-memory chain with state proofs, serving as the light client's source
+type testChain struct {
+	t        *testing.T
+	proto    config.ConsensusParams
+	blocks   map[basics.Round]bookkeeping.Block
+	proofs   map[basics.Round]model.StateProofResponse
+	latest   basics.Round
+	voters   []basics.Participant
+	partTree *merklearray.Tree
+	key      *merklesignature.Secrets
+	txns     map[basics.Round]transactions.Transaction
+}
+
+func makeTestChain(t *testing.T, intervals uint64) *testChain {
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	key, err := merklesignature.New(0, proto.StateProofInterval*(intervals+2), merklesignature.KeyLifetimeDefault)
+	require.NoError(t, err)
+
+	var voters []basics.Participant
+	for i := 0; i < 8; i++ {
+		voters = append(voters, basics.Participant{PK: *key.GetVerifier(), Weight: 1_000_000})
+	}
+	partTree, err := merklearray.BuildVectorCommitmentTree(basics.ParticipantsArray(voters), crypto.HashFactory{HashType: sp.HashType})
+	require.NoError(t, err)
+
+	c := &testChain{
+		t:        t,
+		proto:    proto,
+		blocks:   make(map[basics.Round]bookkeeping.Block),
+		proofs:   make(map[basics.Round]model.StateProofResponse),
+		voters:   voters,
+		partTree: partTree,
+		key:      key,
+		txns:     make(map[basics.Round]transactions.Transaction),
+	}
+	for i := uint64(0); i < (intervals+1)*proto.StateProofInterval; i++ {
+		c.addBlock()
+	}
+	for rnd := basics.Round(2 * proto.StateProofInterval); rnd <= c.latest; rnd += basics.Round(proto.StateProofInterval) {
+		c.addStateProof(rnd)
+	}
+	return c
+}
+
+func (c *testChain) addBlock() {
+	rnd := c.latest + 1
+	blk := bookkeeping.Block{
+		BlockHeader: bookkeeping.BlockHeader{
+			Round:       rnd,
+			GenesisID:   "lightclient-test",
+			GenesisHash: crypto.Digest{0x42},
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		},
+	}
+	blk.BlockHeader.Seed[0] = byte(rnd)
+	if uint64(rnd)%c.proto.StateProofInterval == 0 {
+		blk.StateProofTracking = map[protocol.StateProofType]bookkeeping.StateProofTrackingData{
+			protocol.StateProofBasic: {
+				StateProofVotersCommitment:  c.partTree.Root(),
+				StateProofOnlineTotalWeight: basics.MicroAlgos{Raw: uint64(len(c.voters)) * c.voters[0].Weight},
+			},
+		}
+	}
+	if rnd%7 == 0 {
+		txn := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      basics.Address{byte(rnd)},
+				Fee:         basics.MicroAlgos{Raw: c.proto.MinTxnFee},
+				FirstValid:  rnd,
+				LastValid:   rnd + 10,
+				GenesisHash: blk.BlockHeader.GenesisHash,
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: basics.Address{0x01},
+				Amount:   basics.MicroAlgos{Raw: uint64(rnd)},
+			},
+		}
+		for _, other := range []transactions.Transaction{{Type: protocol.PaymentTx, Header: transactions.Header{Sender: basics.Address{0x02}, FirstValid: rnd, GenesisHash: blk.BlockHeader.GenesisHash}}, txn} {
+			stib, err := blk.EncodeSignedTxn(transactions.SignedTxn{Txn: other}, transactions.ApplyData{})
+			require.NoError(c.t, err)
+			blk.Payset = append(blk.Payset, stib)
+		}
+		c.txns[rnd] = txn
+	}
+	var err error
+	blk.TxnCommitments, err = blk.PaysetCommit()
+	require.NoError(c.t, err)
+	c.blocks[rnd] = blk
+	c.latest = rnd
+}
+
+func (c *testChain) addStateProof(lastAttestedRound basics.Round) {
+	msg, err := stateproof.GenerateStateProofMessage(c, lastAttestedRound)
+	require.NoError(c.t, err)
+
+	votersHdr := c.blocks[lastAttestedRound-basics.Round(c.proto.StateProofInterval)].BlockHeader
+	totalWeight := votersHdr.StateProofTracking[protocol.StateProofBasic].StateProofOnlineTotalWeight.Raw
+	provenWeight, overflowed := basics.Muldiv(totalWeight, uint64(c.proto.StateProofWeightThreshold), 1<<32)
+	require.False(c.t, overflowed)
+
+	prover, err := sp.MakeProver(msg.Hash(), uint64(lastAttestedRound), provenWeight, c.voters, c.partTree, c.proto.StateProofStrengthTarget)
+	require.NoError(c.t, err)
+	msgHash := msg.Hash()
+	sig, err := c.key.GetSigner(uint64(lastAttestedRound)).SignBytes(msgHash[:])
+	require.NoError(c.t, err)
+	for i := range c.voters {
+		prover.Add(uint64(i), sig)
+	}
+	proof, err := prover.CreateProof()
+	require.NoError(c.t, err)
+
+	resp := model.StateProofResponse{StateProof: protocol.Encode(proof)}
+	resp.Message.BlockHeadersCommitment = msg.BlockHeadersCommitment
+	resp.Message.VotersCommitment = msg.VotersCommitment
+	resp.Message.LnProvenWeight = msg.LnProvenWeight
+	resp.Message.FirstAttestedRound = msg.FirstAttestedRound
+	resp.Message.LastAttestedRound = msg.LastAttestedRound
+	c.proofs[lastAttestedRound] = resp
+}
+
+func (c *testChain) BlockHdr(round basics.Round) (bookkeeping.BlockHeader, error) {
+	blk, ok := c.blocks[round]
+	if !ok {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("no block for round %d", round)
+	}
+	return blk.BlockHeader, nil
+}
+
+func (c *testChain) stateProofFor(round uint64) (model.StateProofResponse, error) {
+	for _, resp := range c.proofs {
+		if resp.Message.FirstAttestedRound <= round && round <= resp.Message.LastAttestedRound {
+			return resp, nil
+		}
+	}
+	return model.StateProofResponse{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+}
+
+func (c *testChain) StateProofs(round uint64) (model.StateProofResponse, error) {
+	return c.stateProofFor(round)
+}
+
+func (c *testChain) LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error) {
+	resp, err := c.stateProofFor(round)
+	if err != nil {
+		return model.LightBlockHeaderProofResponse{}, err
+	}
+	lightHeaders, err := stateproof.FetchLightHeaders(c, c.proto.StateProofInterval, basics.Round(resp.Message.LastAttestedRound))
+	if err != nil {
+		return model.LightBlockHeaderProofResponse{}, err
+	}
+	index := round - resp.Message.FirstAttestedRound
+	proof, err := stateproof.GenerateProofOfLightBlockHeaders(c.proto.StateProofInterval, lightHeaders, index)
+	if err != nil {
+		return model.LightBlockHeaderProofResponse{}, err
+	}
+	return model.LightBlockHeaderProofResponse{Index: index, Proof: proof.GetConcatenatedProof(), Treedepth: uint64(proof.TreeDepth)}, nil
+}
+
+func (c *testChain) TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error) {
+	blk := c.blocks[basics.Round(round)]
+	for idx := range blk.Payset {
+		stxn, _, err := blk.DecodeSignedTxn(blk.Payset[idx])
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		if stxn.Txn.ID().String() != txid {
+			continue
+		}
+		tree, err := blk.TxnMerkleTreeSHA256()
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		proof, err := tree.ProveSingleLeaf(uint64(idx))
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		stibHash := blk.Payset[idx].HashSHA256()
+		return model.TransactionProofResponse{
+			Proof:     proof.GetConcatenatedProof(),
+			Stibhash:  stibHash[:],
+			Idx:       uint64(idx),
+			Treedepth: uint64(proof.TreeDepth),
+			Hashtype:  model.TransactionProofResponseHashtypeSha256,
+		}, nil
+	}
+	return model.TransactionProofResponse{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+}
+
+func (c *testChain) EncodedBlockCert(round uint64) (rpcs.EncodedBlockCert, error) {
+	blk, ok := c.blocks[basics.Round(round)]
+	if !ok {
+		return rpcs.EncodedBlockCert{}, client.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	}
+	return rpcs.EncodedBlockCert{Block: blk}, nil
+}
+
+func TestLightClientSync(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const intervals = 3
+	chain := makeTestChain(t, intervals)
+	interval := basics.Round(chain.proto.StateProofInterval)
+
+	checkpoint, err := MakeCheckpoint(chain.blocks[interval].BlockHeader)
+	require.NoError(t, err)
+	lc, err := MakeClient(chain, checkpoint, protocol.ConsensusCurrentVersion, 16)
+	require.NoError(t, err)
+
+	// nothing is attested to before the client verifies any state proof
+	_, err = lc.LightBlockHeader(interval + 1)
+	require.ErrorIs(t, err, ErrRoundNotAttested)
+
+	checkpoint, err = lc.Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(intervals+1)*interval, checkpoint.Round)
+	require.Equal(t, checkpoint, lc.Checkpoint())
+
+	_, err = lc.VerifyNextStateProof()
+	require.ErrorIs(t, err, ErrStateProofNotAvailable)
+
+	for rnd := interval + 1; rnd <= checkpoint.Round; rnd += 13 {
+		hdr, err := lc.LightBlockHeader(rnd)
+		require.NoError(t, err)
+		expected := chain.blocks[rnd].BlockHeader
+		require.Equal(t, expected.ToLightBlockHeader(), hdr)
+	}
+	_, err = lc.LightBlockHeader(interval)
+	require.ErrorIs(t, err, ErrRoundNotAttested)
+
+	verified := 0
+	for rnd, txn := range chain.txns {
+		err = lc.VerifyTransaction(txn, rnd)
+		if rnd <= interval {
+			require.ErrorIs(t, err, ErrRoundNotAttested)
+			continue
+		}
+		require.NoError(t, err, "round %d", rnd)
+		verified++
+
+		// the transaction was not committed in any other round
+		err = lc.VerifyTransaction(txn, rnd+7)
+		require.Error(t, err)
+	}
+	require.NotZero(t, verified)
+
+	// the chain grows, and the client catches up with the new state proof
+	for i := basics.Round(0); i < interval; i++ {
+		chain.addBlock()
+	}
+	chain.addStateProof(chain.latest)
+	checkpoint, err = lc.Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, chain.latest, checkpoint.Round)
+}
+
+func TestLightClientInvalidData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	chain := makeTestChain(t, 1)
+	interval := basics.Round(chain.proto.StateProofInterval)
+	checkpoint, err := MakeCheckpoint(chain.blocks[interval].BlockHeader)
+	require.NoError(t, err)
+
+	// a state proof of different voters does not verify
+	badCheckpoint := checkpoint
+	badCheckpoint.VotersCommitment = crypto.GenericDigest(crypto.Hash([]byte("voters")).ToSlice())
+	lc, err := MakeClient(chain, badCheckpoint, protocol.ConsensusCurrentVersion, 16)
+	require.NoError(t, err)
+	_, err = lc.VerifyNextStateProof()
+	require.ErrorIs(t, err, ErrInvalidStateProof)
+	require.Equal(t, badCheckpoint, lc.Checkpoint())
+
+	// a state proof message altered by the source does not verify
+	lc, err = MakeClient(chain, checkpoint, protocol.ConsensusCurrentVersion, 16)
+	require.NoError(t, err)
+	resp := chain.proofs[2*interval]
+	resp.Message.VotersCommitment = badCheckpoint.VotersCommitment
+	chain.proofs[2*interval] = resp
+	_, err = lc.VerifyNextStateProof()
+	require.ErrorIs(t, err, ErrInvalidStateProof)
+	chain.addStateProof(2 * interval)
+
+	_, err = lc.Sync(context.Background())
+	require.NoError(t, err)
+
+	// a block altered by the source does not match the verified light block header commitment
+	rnd := (interval/7 + 1) * 7
+	require.Contains(t, chain.txns, rnd)
+	txn := chain.txns[rnd]
+	blk := chain.blocks[rnd]
+	blk.Payset = blk.Payset[1:]
+	blk.TxnCommitments, err = blk.PaysetCommit()
+	require.NoError(t, err)
+	chain.blocks[rnd] = blk
+	_, err = lc.LightBlockHeader(rnd)
+	require.ErrorIs(t, err, ErrInvalidProof)
+	require.ErrorIs(t, lc.VerifyTransaction(txn, rnd), ErrInvalidProof)
+}
+
+// TestLightClientHeadersCache checks the light block headers cache stays bounded whichever order the headers are
+// fetched in.
+func TestLightClientHeadersCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const cacheSize = 4
+	chain := makeTestChain(t, 2)
+	interval := basics.Round(chain.proto.StateProofInterval)
+
+	checkpoint, err := MakeCheckpoint(chain.blocks[interval].BlockHeader)
+	require.NoError(t, err)
+	lc, err := MakeClient(chain, checkpoint, protocol.ConsensusCurrentVersion, cacheSize)
+	require.NoError(t, err)
+	checkpoint, err = lc.Sync(context.Background())
+	require.NoError(t, err)
+
+	// descending, then ascending
+	for rnd := checkpoint.Round; rnd > interval; rnd-- {
+		_, err := lc.LightBlockHeader(rnd)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(lc.headers), cacheSize)
+	}
+	require.Len(t, lc.headers, cacheSize)
+	for rnd := interval + 1; rnd <= checkpoint.Round; rnd++ {
+		_, err := lc.LightBlockHeader(rnd)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(lc.headers), cacheSize)
+	}
+	// the headers of the highest rounds are kept
+	for rnd := checkpoint.Round - cacheSize + 1; rnd <= checkpoint.Round; rnd++ {
+		require.Contains(t, lc.headers, rnd)
+	}
+}
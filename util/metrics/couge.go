@@ -145,19 +145,30 @@ func (cg *couge) getUint64ValueForLabels(labels map[string]string) uint64 {
 
 // writeMetric writes the metric into the output stream
 func (cg *couge) writeMetric(buf *strings.Builder, metricType string, parentLabels string) {
+	cg.writeMetricFamily(buf, metricType, cg.name, cg.name, parentLabels)
+}
+
+// writeOpenCounter writes the counter into the output stream in OpenMetrics format,
+// where the metric family name has no _total suffix while the samples have it.
+func (cg *couge) writeOpenCounter(buf *strings.Builder, parentLabels string) {
+	familyName := strings.TrimSuffix(cg.name, "_total")
+	cg.writeMetricFamily(buf, "counter", familyName, familyName+"_total", parentLabels)
+}
+
+func (cg *couge) writeMetricFamily(buf *strings.Builder, metricType string, familyName string, sampleName string, parentLabels string) {
 	cg.Lock()
 	defer cg.Unlock()
 
 	buf.WriteString("# HELP ")
-	buf.WriteString(cg.name)
+	buf.WriteString(familyName)
 	buf.WriteString(" ")
 	buf.WriteString(cg.description)
 	buf.WriteString("\n# TYPE ")
-	buf.WriteString(cg.name)
+	buf.WriteString(familyName)
 	buf.WriteString(" " + metricType + "\n")
 	// if counter is zero, report 0 using parentLabels and no tags
 	if len(cg.values) == 0 {
-		buf.WriteString(cg.name)
+		buf.WriteString(sampleName)
 		if len(parentLabels) > 0 {
 			buf.WriteString("{" + parentLabels + "}")
 		}
@@ -167,7 +178,7 @@ func (cg *couge) writeMetric(buf *strings.Builder, metricType string, parentLabe
 	}
 	// otherwise iterate through values and write one line per label
 	for _, l := range cg.values {
-		buf.WriteString(cg.name)
+		buf.WriteString(sampleName)
 		if len(parentLabels) > 0 || len(l.formattedLabels) > 0 {
 			buf.WriteString("{")
 			if len(parentLabels) > 0 {
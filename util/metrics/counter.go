@@ -110,6 +110,11 @@ func (counter *Counter) WriteMetric(buf *strings.Builder, parentLabels string) {
 	counter.c.writeMetric(buf, "counter", parentLabels)
 }
 
+// WriteOpenMetric writes the metric into the output stream in OpenMetrics format
+func (counter *Counter) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	counter.c.writeOpenCounter(buf, parentLabels)
+}
+
 // AddMetric adds the metric into the map
 func (counter *Counter) AddMetric(values map[string]float64) {
 	counter.c.addMetric(values)
@@ -83,6 +83,12 @@ func (gauge *Gauge) WriteMetric(buf *strings.Builder, parentLabels string) {
 	gauge.g.writeMetric(buf, "gauge", parentLabels)
 }
 
+// WriteOpenMetric writes the metric into the output stream in OpenMetrics format,
+// which is the same as the Prometheus exposition format for gauges
+func (gauge *Gauge) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	gauge.g.writeMetric(buf, "gauge", parentLabels)
+}
+
 // AddMetric adds the metric into the map
 func (gauge *Gauge) AddMetric(values map[string]float64) {
 	gauge.g.addMetric(values)
@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+)
+
+// DefaultHistogramBuckets are bucket upper bounds suitable for latencies measured in seconds,
+// from 5ms to 10s.
+var DefaultHistogramBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// ExponentialBuckets returns count bucket upper bounds, the first being start and each next one
+// factor times the previous one.
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	if start <= 0 || factor <= 1 || count < 1 {
+		panic(fmt.Sprintf("invalid exponential buckets start=%v factor=%v count=%d", start, factor, count))
+	}
+	buckets := make([]float64, count)
+	for i := range buckets {
+		buckets[i] = start
+		start *= factor
+	}
+	return buckets
+}
+
+// Histogram counts observations in configurable buckets, for example latencies.
+// Each distinct set of labels has its own set of buckets, sum and count.
+type Histogram struct {
+	deadlock.Mutex
+	name        string
+	description string
+	// buckets are the sorted upper bounds of the buckets, the +Inf bucket is implicit
+	buckets []float64
+	values  []*histogramValues
+	// valuesIndices maps formatted labels to the index in values
+	valuesIndices map[string]int
+}
+
+type histogramValues struct {
+	labels          map[string]string
+	formattedLabels string
+	// counts holds the non-cumulative number of observations per bucket, the last one being +Inf
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// MakeHistogram creates a new histogram with the provided name, description and bucket upper bounds,
+// and registers it with the default registry. DefaultHistogramBuckets are used if buckets is empty.
+func MakeHistogram(metric MetricName, buckets []float64) *Histogram {
+	h := makeHistogram(metric, buckets)
+	h.Register(nil)
+	return h
+}
+
+// makeHistogram creates a new histogram but does not register it with the default registry.
+func makeHistogram(metric MetricName, buckets []float64) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultHistogramBuckets
+	}
+	buckets = slices.Clone(buckets)
+	sort.Float64s(buckets)
+	// the +Inf bucket is always present so drop it if given explicitly
+	if math.IsInf(buckets[len(buckets)-1], 1) {
+		buckets = buckets[:len(buckets)-1]
+	}
+	return &Histogram{
+		name:          metric.Name,
+		description:   metric.Description,
+		buckets:       slices.Compact(buckets),
+		valuesIndices: make(map[string]int),
+	}
+}
+
+// Register registers the histogram with the default/specific registry
+func (h *Histogram) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(h)
+	} else {
+		reg.Register(h)
+	}
+}
+
+// Deregister deregisters the histogram with the default/specific registry
+func (h *Histogram) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(h)
+	} else {
+		reg.Deregister(h)
+	}
+}
+
+// formatLabels formats the labels sorted by name so that the same labels always map to the same values
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(k + "=\"" + labels[k] + "\"")
+	}
+	return buf.String()
+}
+
+// Observe adds a single observation with the given labels, which may be nil
+func (h *Histogram) Observe(x float64, labels map[string]string) {
+	formattedLabels := formatLabels(labels)
+	bucket, _ := slices.BinarySearch(h.buckets, x)
+
+	h.Lock()
+	defer h.Unlock()
+	idx, has := h.valuesIndices[formattedLabels]
+	if !has {
+		h.values = append(h.values, &histogramValues{
+			labels:          labels,
+			formattedLabels: formattedLabels,
+			counts:          make([]uint64, len(h.buckets)+1),
+		})
+		idx = len(h.values) - 1
+		h.valuesIndices[formattedLabels] = idx
+	}
+	v := h.values[idx]
+	v.counts[bucket]++
+	v.sum += x
+	v.count++
+}
+
+// ObserveSince adds the number of seconds elapsed since t as an observation
+func (h *Histogram) ObserveSince(t time.Time, labels map[string]string) {
+	h.Observe(time.Since(t).Seconds(), labels)
+}
+
+// ObserveDuration adds d in seconds as an observation
+func (h *Histogram) ObserveDuration(d time.Duration, labels map[string]string) {
+	h.Observe(d.Seconds(), labels)
+}
+
+// GetCountForLabels returns the number of observations for the given labels
+func (h *Histogram) GetCountForLabels(labels map[string]string) uint64 {
+	h.Lock()
+	defer h.Unlock()
+	if idx, has := h.valuesIndices[formatLabels(labels)]; has {
+		return h.values[idx].count
+	}
+	return 0
+}
+
+// formatBucketBound formats a bucket upper bound, in OpenMetrics the canonical representation
+// of integral values has a trailing ".0"
+func formatBucketBound(bound float64, openMetrics bool) string {
+	s := strconv.FormatFloat(bound, 'g', -1, 64)
+	if openMetrics && !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s
+}
+
+func joinLabels(labels ...string) string {
+	var nonEmpty []string
+	for _, l := range labels {
+		if l != "" {
+			nonEmpty = append(nonEmpty, l)
+		}
+	}
+	return strings.Join(nonEmpty, ",")
+}
+
+func (h *Histogram) writeSeries(buf *strings.Builder, parentLabels string, v *histogramValues, openMetrics bool) {
+	labels := joinLabels(parentLabels, v.formattedLabels)
+	var cumulative uint64
+	for i, count := range v.counts {
+		cumulative += count
+		bound := "+Inf"
+		if i < len(h.buckets) {
+			bound = formatBucketBound(h.buckets[i], openMetrics)
+		}
+		buf.WriteString(h.name + "_bucket{" + joinLabels(labels, "le=\""+bound+"\"") + "} " + strconv.FormatUint(cumulative, 10) + "\n")
+	}
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	buf.WriteString(h.name + "_sum" + labels + " " + strconv.FormatFloat(v.sum, 'g', -1, 64) + "\n")
+	buf.WriteString(h.name + "_count" + labels + " " + strconv.FormatUint(v.count, 10) + "\n")
+}
+
+func (h *Histogram) write(buf *strings.Builder, parentLabels string, openMetrics bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	buf.WriteString("# HELP " + h.name + " " + h.description + "\n")
+	buf.WriteString("# TYPE " + h.name + " histogram\n")
+	if len(h.values) == 0 {
+		// report an empty histogram using parentLabels and no labels
+		h.writeSeries(buf, parentLabels, &histogramValues{counts: make([]uint64, len(h.buckets)+1)}, openMetrics)
+		return
+	}
+	for _, v := range h.values {
+		h.writeSeries(buf, parentLabels, v, openMetrics)
+	}
+}
+
+// WriteMetric writes the metric into the output stream in Prometheus exposition format
+func (h *Histogram) WriteMetric(buf *strings.Builder, parentLabels string) {
+	h.write(buf, parentLabels, false)
+}
+
+// WriteOpenMetric writes the metric into the output stream in OpenMetrics format
+func (h *Histogram) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	h.write(buf, parentLabels, true)
+}
+
+// AddMetric adds the number and the sum of the observations into the map
+func (h *Histogram) AddMetric(values map[string]float64) {
+	h.Lock()
+	defer h.Unlock()
+
+	for _, v := range h.values {
+		var suffix string
+		if len(v.formattedLabels) > 0 {
+			suffix = ":" + v.formattedLabels
+		}
+		values[sanitizeTelemetryName(h.name+"_count"+suffix)] = float64(v.count)
+		values[sanitizeTelemetryName(h.name+"_sum"+suffix)] = v.sum
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramWriteMetric(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := makeHistogram(MetricName{Name: "algod_test_seconds", Description: "test histogram"}, []float64{1, 0.5, 2, 1})
+
+	var buf strings.Builder
+	h.WriteMetric(&buf, "")
+	require.Equal(t, `# HELP algod_test_seconds test histogram
+# TYPE algod_test_seconds histogram
+algod_test_seconds_bucket{le="0.5"} 0
+algod_test_seconds_bucket{le="1"} 0
+algod_test_seconds_bucket{le="2"} 0
+algod_test_seconds_bucket{le="+Inf"} 0
+algod_test_seconds_sum 0
+algod_test_seconds_count 0
+`, buf.String())
+
+	h.Observe(0.25, nil)
+	h.Observe(1, nil)
+	h.ObserveDuration(1500*time.Millisecond, nil)
+	h.Observe(3, nil)
+	require.Equal(t, uint64(4), h.GetCountForLabels(nil))
+
+	buf.Reset()
+	h.WriteMetric(&buf, `host="a"`)
+	require.Equal(t, `# HELP algod_test_seconds test histogram
+# TYPE algod_test_seconds histogram
+algod_test_seconds_bucket{host="a",le="0.5"} 1
+algod_test_seconds_bucket{host="a",le="1"} 2
+algod_test_seconds_bucket{host="a",le="2"} 3
+algod_test_seconds_bucket{host="a",le="+Inf"} 4
+algod_test_seconds_sum{host="a"} 5.75
+algod_test_seconds_count{host="a"} 4
+`, buf.String())
+}
+
+func TestHistogramLabels(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := makeHistogram(MetricName{Name: "algod_test_seconds", Description: "test histogram"}, []float64{1})
+	h.Observe(0.5, map[string]string{"mode": "apply", "a": "b"})
+	h.Observe(2, map[string]string{"a": "b", "mode": "apply"})
+	h.Observe(2, map[string]string{"mode": "validate"})
+
+	require.Equal(t, uint64(2), h.GetCountForLabels(map[string]string{"mode": "apply", "a": "b"}))
+	require.Equal(t, uint64(1), h.GetCountForLabels(map[string]string{"mode": "validate"}))
+	require.Zero(t, h.GetCountForLabels(nil))
+
+	var buf strings.Builder
+	h.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), `algod_test_seconds_bucket{a="b",mode="apply",le="1"} 1`+"\n")
+	require.Contains(t, buf.String(), `algod_test_seconds_bucket{a="b",mode="apply",le="+Inf"} 2`+"\n")
+	require.Contains(t, buf.String(), `algod_test_seconds_count{mode="validate"} 1`+"\n")
+
+	values := make(map[string]float64)
+	h.AddMetric(values)
+	require.Len(t, values, 4)
+	require.Equal(t, float64(2), values[sanitizeTelemetryName(`algod_test_seconds_count:a="b",mode="apply"`)])
+	require.Equal(t, float64(2.5), values[sanitizeTelemetryName(`algod_test_seconds_sum:a="b",mode="apply"`)])
+}
+
+func TestHistogramOpenMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	reg := MakeRegistry()
+	h := makeHistogram(MetricName{Name: "algod_test_seconds", Description: "test histogram"}, []float64{0.5, 1})
+	h.Register(reg)
+	c := makeCounter(MetricName{Name: "algod_test_events_total", Description: "test counter"})
+	c.Register(reg)
+
+	h.Observe(0.75, nil)
+	c.Inc(nil)
+
+	var buf strings.Builder
+	reg.WriteOpenMetrics(&buf, "")
+	out := buf.String()
+	require.Contains(t, out, `algod_test_seconds_bucket{le="0.5"} 0`+"\n")
+	require.Contains(t, out, `algod_test_seconds_bucket{le="1.0"} 1`+"\n")
+	require.Contains(t, out, "# TYPE algod_test_events counter\n")
+	require.Contains(t, out, "algod_test_events_total 1\n")
+	require.True(t, strings.HasSuffix(out, "# EOF\n"))
+
+	// the Prometheus text format keeps the names and bounds as they are
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	out = buf.String()
+	require.Contains(t, out, `algod_test_seconds_bucket{le="1"} 1`+"\n")
+	require.Contains(t, out, "# TYPE algod_test_events_total counter\n")
+	require.NotContains(t, out, "# EOF")
+}
+
+func TestExponentialBuckets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, []float64{1, 2, 4, 8}, ExponentialBuckets(1, 2, 4))
+	require.Panics(t, func() { ExponentialBuckets(0, 2, 4) })
+	require.Panics(t, func() { ExponentialBuckets(1, 1, 4) })
+	require.Panics(t, func() { ExponentialBuckets(1, 2, 0) })
+}
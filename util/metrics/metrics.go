@@ -92,6 +92,25 @@ var (
 	// LedgerDBRound Last round written to ledger
 	LedgerDBRound = MetricName{Name: "algod_ledger_dbround", Description: "Last round written to the ledger DB"}
 
+	// CatchupBlocksFetchedTotal Total number of blocks fetched by the catchup service
+	CatchupBlocksFetchedTotal = MetricName{Name: "algod_catchup_blocks_fetched_total", Description: "Total number of blocks fetched by the catchup service"}
+	// CatchupBlocksWrittenTotal Total number of blocks written to the ledger by the catchup service
+	CatchupBlocksWrittenTotal = MetricName{Name: "algod_catchup_blocks_written_total", Description: "Total number of blocks written to the ledger by the catchup service"}
+	// CatchupTransactionsWrittenTotal Total number of transactions written to the ledger by the catchup service
+	CatchupTransactionsWrittenTotal = MetricName{Name: "algod_catchup_transactions_written_total", Description: "Total number of transactions written to the ledger by the catchup service"}
+	// CatchupBlocksPreverifiedTotal Total number of blocks whose signatures were verified ahead of their evaluation by the catchup service
+	CatchupBlocksPreverifiedTotal = MetricName{Name: "algod_catchup_blocks_preverified_total", Description: "Total number of blocks whose signatures were verified ahead of their evaluation by the catchup service"}
+	// CatchupBlocksPreverifySkippedTotal Total number of blocks whose signatures were only verified on evaluation, as too many blocks were being verified ahead
+	CatchupBlocksPreverifySkippedTotal = MetricName{Name: "algod_catchup_blocks_preverify_skipped_total", Description: "Total number of blocks whose signatures were only verified on evaluation by the catchup service, as too many blocks were being verified ahead"}
+	// CatchupPreverificationSeconds Time spent verifying the signatures of a block ahead of its evaluation by the catchup service
+	CatchupPreverificationSeconds = MetricName{Name: "algod_catchup_preverification_seconds", Description: "Time spent verifying the signatures of a block ahead of its evaluation by the catchup service"}
+	// CatchupBlockWriteSeconds Time spent validating and writing a block to the ledger by the catchup service
+	CatchupBlockWriteSeconds = MetricName{Name: "algod_catchup_block_write_seconds", Description: "Time spent validating and writing a block to the ledger by the catchup service"}
+	// CatchupBlocksPerSecond Number of blocks written to the ledger per second by the catchup service
+	CatchupBlocksPerSecond = MetricName{Name: "algod_catchup_blocks_per_second", Description: "Number of blocks written to the ledger per second by the catchup service, over the last throughput window"}
+	// CatchupTransactionsPerSecond Number of transactions written to the ledger per second by the catchup service
+	CatchupTransactionsPerSecond = MetricName{Name: "algod_catchup_transactions_per_second", Description: "Number of transactions written to the ledger per second by the catchup service, over the last throughput window"}
+
 	// AgreementMessagesHandled "Number of agreement messages handled"
 	AgreementMessagesHandled = MetricName{Name: "algod_agreement_handled", Description: "Number of agreement messages handled"}
 	// AgreementMessagesDropped "Number of agreement messages dropped"
@@ -0,0 +1,345 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package metricstest parses metrics expositions in tests.
+package metricstest
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Family is a metric family parsed from an OpenMetrics exposition
+type Family struct {
+	Name    string
+	Type    string
+	Help    string
+	Samples []Sample
+}
+
+// Sample is a single sample of a metric family
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+var (
+	metricNameRe = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRe  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// sampleSuffixes lists the sample name suffixes allowed for each metric type
+var sampleSuffixes = map[string][]string{
+	"counter":        {"_total", "_created"},
+	"gauge":          {""},
+	"histogram":      {"_bucket", "_count", "_sum", "_created"},
+	"gaugehistogram": {"_bucket", "_gcount", "_gsum"},
+	"summary":        {"", "_count", "_sum", "_created"},
+	"info":           {"_info"},
+	"stateset":       {""},
+	"unknown":        {""},
+}
+
+// ParseOpenMetrics parses an OpenMetrics text exposition, as specified by
+// https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md,
+// and returns its metric families. It rejects any exposition that is not valid OpenMetrics, among which
+// expositions in the Prometheus text format.
+func ParseOpenMetrics(exposition string) ([]Family, error) {
+	if !strings.HasSuffix(exposition, "# EOF\n") && !strings.HasSuffix(exposition, "# EOF") {
+		return nil, fmt.Errorf("exposition does not end with # EOF")
+	}
+	lines := strings.Split(strings.TrimSuffix(exposition, "\n"), "\n")
+	lines = lines[:len(lines)-1]
+
+	var families []Family
+	seen := make(map[string]bool)
+	var current *Family
+	samplesSeen := make(map[string]bool)
+	startFamily := func(name string) error {
+		if seen[name] {
+			return fmt.Errorf("metric family %s appears more than once", name)
+		}
+		if !metricNameRe.MatchString(name) {
+			return fmt.Errorf("invalid metric family name %q", name)
+		}
+		seen[name] = true
+		families = append(families, Family{Name: name, Type: "unknown"})
+		current = &families[len(families)-1]
+		return nil
+	}
+
+	for i, line := range lines {
+		lineNo := i + 1
+		if strings.HasPrefix(line, "#") {
+			fields := strings.SplitN(line, " ", 4)
+			if len(fields) < 3 || fields[0] != "#" {
+				return nil, fmt.Errorf("line %d: invalid metadata %q", lineNo, line)
+			}
+			name := fields[2]
+			if current == nil || current.Name != name {
+				if err := startFamily(name); err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNo, err)
+				}
+			} else if len(current.Samples) > 0 {
+				return nil, fmt.Errorf("line %d: metadata for %s after its samples", lineNo, name)
+			}
+			switch fields[1] {
+			case "TYPE":
+				if len(fields) != 4 {
+					return nil, fmt.Errorf("line %d: missing type for %s", lineNo, name)
+				}
+				if _, ok := sampleSuffixes[fields[3]]; !ok {
+					return nil, fmt.Errorf("line %d: invalid type %q for %s", lineNo, fields[3], name)
+				}
+				current.Type = fields[3]
+			case "HELP":
+				if len(fields) == 4 {
+					current.Help = fields[3]
+				}
+			case "UNIT":
+				if len(fields) == 4 && !strings.HasSuffix(name, "_"+fields[3]) {
+					return nil, fmt.Errorf("line %d: family %s does not end with its unit %s", lineNo, name, fields[3])
+				}
+			default:
+				return nil, fmt.Errorf("line %d: invalid metadata %q", lineNo, line)
+			}
+			continue
+		}
+
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if current == nil || !belongsTo(sample.Name, current) {
+			// a sample without metadata is a family of unknown type on its own
+			if err := startFamily(sample.Name); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+		}
+		key := sample.Name + "{" + formatLabels(sample.Labels) + "}"
+		if samplesSeen[key] {
+			return nil, fmt.Errorf("line %d: duplicate sample %s", lineNo, key)
+		}
+		samplesSeen[key] = true
+		if current.Type == "counter" && strings.HasSuffix(sample.Name, "_total") && (sample.Value < 0 || math.IsNaN(sample.Value)) {
+			return nil, fmt.Errorf("line %d: counter %s has invalid value %v", lineNo, sample.Name, sample.Value)
+		}
+		if strings.HasSuffix(sample.Name, "_bucket") {
+			le, ok := sample.Labels["le"]
+			if !ok {
+				return nil, fmt.Errorf("line %d: bucket %s has no le label", lineNo, sample.Name)
+			}
+			if _, err := parseValue(le); err != nil {
+				return nil, fmt.Errorf("line %d: bucket %s has invalid le %q", lineNo, sample.Name, le)
+			}
+			if le != "+Inf" && !strings.ContainsAny(le, ".e") {
+				return nil, fmt.Errorf("line %d: bucket %s le %q is not canonical", lineNo, sample.Name, le)
+			}
+		}
+		current.Samples = append(current.Samples, sample)
+	}
+
+	for _, f := range families {
+		if f.Type == "histogram" {
+			if err := checkHistogram(f); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return families, nil
+}
+
+// belongsTo returns true if the sample name is one of the sample names of the family
+func belongsTo(sampleName string, f *Family) bool {
+	for _, suffix := range sampleSuffixes[f.Type] {
+		if sampleName == f.Name+suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// checkHistogram checks every label set of the histogram has a +Inf bucket and cumulative buckets
+func checkHistogram(f Family) error {
+	type series struct {
+		last   float64
+		hasInf bool
+	}
+	buckets := make(map[string]*series)
+	for _, s := range f.Samples {
+		if s.Name != f.Name+"_bucket" {
+			continue
+		}
+		labels := make(map[string]string, len(s.Labels))
+		for k, v := range s.Labels {
+			if k != "le" {
+				labels[k] = v
+			}
+		}
+		key := formatLabels(labels)
+		b, ok := buckets[key]
+		if !ok {
+			b = &series{}
+			buckets[key] = b
+		}
+		if s.Value < b.last {
+			return fmt.Errorf("histogram %s{%s} buckets are not cumulative", f.Name, key)
+		}
+		b.last = s.Value
+		if s.Labels["le"] == "+Inf" {
+			b.hasInf = true
+		}
+	}
+	for key, b := range buckets {
+		if !b.hasInf {
+			return fmt.Errorf("histogram %s{%s} has no +Inf bucket", f.Name, key)
+		}
+	}
+	return nil
+}
+
+func parseSample(line string) (Sample, error) {
+	s := Sample{Labels: make(map[string]string)}
+	nameEnd := strings.IndexAny(line, "{ ")
+	if nameEnd <= 0 {
+		return s, fmt.Errorf("invalid sample %q", line)
+	}
+	s.Name = line[:nameEnd]
+	if !metricNameRe.MatchString(s.Name) {
+		return s, fmt.Errorf("invalid sample name %q", s.Name)
+	}
+	rest := line[nameEnd:]
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		rest, err = parseLabels(rest[1:], s.Labels)
+		if err != nil {
+			return s, fmt.Errorf("sample %s: %w", s.Name, err)
+		}
+	}
+	if !strings.HasPrefix(rest, " ") {
+		return s, fmt.Errorf("sample %s: missing value", s.Name)
+	}
+	fields := strings.Split(rest[1:], " ")
+	if len(fields) > 2 {
+		return s, fmt.Errorf("sample %s: unexpected %q after the value", s.Name, rest)
+	}
+	value, err := parseValue(fields[0])
+	if err != nil {
+		return s, fmt.Errorf("sample %s: %w", s.Name, err)
+	}
+	s.Value = value
+	if len(fields) == 2 {
+		if _, err := strconv.ParseFloat(fields[1], 64); err != nil {
+			return s, fmt.Errorf("sample %s: invalid timestamp %q", s.Name, fields[1])
+		}
+	}
+	return s, nil
+}
+
+// parseLabels parses the labels following the opening brace into labels, and returns what follows the closing brace
+func parseLabels(text string, labels map[string]string) (string, error) {
+	for {
+		if strings.HasPrefix(text, "}") {
+			return text[1:], nil
+		}
+		eq := strings.Index(text, `="`)
+		if eq <= 0 {
+			return "", fmt.Errorf("invalid labels %q", text)
+		}
+		name := text[:eq]
+		if !labelNameRe.MatchString(name) {
+			return "", fmt.Errorf("invalid label name %q", name)
+		}
+		if _, ok := labels[name]; ok {
+			return "", fmt.Errorf("duplicate label %s", name)
+		}
+		text = text[eq+2:]
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(text); i++ {
+			c := text[i]
+			if c == '\\' {
+				if i+1 == len(text) {
+					break
+				}
+				i++
+				switch text[i] {
+				case '\\':
+					value.WriteByte('\\')
+				case '"':
+					value.WriteByte('"')
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					return "", fmt.Errorf("label %s has invalid escape \\%c", name, text[i])
+				}
+				continue
+			}
+			if c == '"' {
+				text = text[i+1:]
+				closed = true
+				break
+			}
+			value.WriteByte(c)
+		}
+		if !closed {
+			return "", fmt.Errorf("label %s value is not terminated", name)
+		}
+		labels[name] = value.String()
+		if strings.HasPrefix(text, ",") {
+			text = text[1:]
+			if strings.HasPrefix(text, "}") {
+				return "", fmt.Errorf("trailing comma in labels")
+			}
+		} else if !strings.HasPrefix(text, "}") {
+			return "", fmt.Errorf("invalid labels after %s", name)
+		}
+	}
+}
+
+func parseValue(text string) (float64, error) {
+	switch text {
+	case "+Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+	if strings.ContainsAny(text, "xXpP_") || strings.EqualFold(text, "inf") || strings.EqualFold(text, "infinity") {
+		return 0, fmt.Errorf("invalid value %q", text)
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// formatLabels formats labels in a canonical order
+func formatLabels(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + "=" + strconv.Quote(labels[name])
+	}
+	return strings.Join(parts, ",")
+}
@@ -53,6 +53,16 @@ func writeUint64MetricValue(buf *strings.Builder, name string, labels string, va
 
 // WriteMetric writes the netdev metrics to the provided buffer.
 func (pg netDevGatherer) WriteMetric(buf *strings.Builder, parentLabels string) {
+	pg.writeMetric(buf, parentLabels, "")
+}
+
+// WriteOpenMetric writes the netdev metrics to the provided buffer in OpenMetrics format,
+// where the counter samples get the _total suffix.
+func (pg netDevGatherer) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	pg.writeMetric(buf, parentLabels, "_total")
+}
+
+func (pg netDevGatherer) writeMetric(buf *strings.Builder, parentLabels string, sampleSuffix string) {
 	nds, err := getNetDevStats()
 	if err != nil {
 		return
@@ -65,13 +75,13 @@ func (pg netDevGatherer) WriteMetric(buf *strings.Builder, parentLabels string)
 	writeUint64MetricCounterHeader(buf, "algod_netdev_received_bytes", "Bytes received")
 	for _, nd := range nds {
 		labels := fmt.Sprintf("iface=\"%s\"%s%s", nd.iface, sep, parentLabels)
-		writeUint64MetricValue(buf, "algod_netdev_received_bytes", labels, nd.bytesReceived)
+		writeUint64MetricValue(buf, "algod_netdev_received_bytes"+sampleSuffix, labels, nd.bytesReceived)
 	}
 
 	writeUint64MetricCounterHeader(buf, "algod_netdev_sent_bytes", "Bytes sent")
 	for _, nd := range nds {
 		labels := fmt.Sprintf("iface=\"%s\"%s%s", nd.iface, sep, parentLabels)
-		writeUint64MetricValue(buf, "algod_netdev_sent_bytes", labels, nd.bytesSent)
+		writeUint64MetricValue(buf, "algod_netdev_sent_bytes"+sampleSuffix, labels, nd.bytesSent)
 	}
 }
 
@@ -40,6 +40,14 @@ func (og *defaultOpencensusGatherer) WriteMetric(buf *strings.Builder, parentLab
 	}
 }
 
+// WriteOpenMetric return opencensus data converted to algorand format, in OpenMetrics format
+func (og *defaultOpencensusGatherer) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	metrics := collectOpenCensusMetrics(og.names)
+	for _, metric := range metrics {
+		metric.(OpenMetric).WriteOpenMetric(buf, parentLabels)
+	}
+}
+
 // AddMetric return opencensus data converted to algorand format
 func (og *defaultOpencensusGatherer) AddMetric(values map[string]float64) {
 	metrics := collectOpenCensusMetrics(og.names)
@@ -77,12 +85,21 @@ type statCounter struct {
 
 // WriteMetric outputs Prometheus metrics for all labels/values in statCounter
 func (st *statCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
+	st.counter().WriteMetric(buf, parentLabels)
+}
+
+// WriteOpenMetric outputs OpenMetrics metrics for all labels/values in statCounter
+func (st *statCounter) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	st.counter().WriteOpenMetric(buf, parentLabels)
+}
+
+func (st *statCounter) counter() *Counter {
 	name := sanitizePrometheusName(st.name)
 	counter := makeCounter(MetricName{name, st.description})
 	for i := 0; i < len(st.labels); i++ {
 		counter.AddUint64(uint64(st.values[i]), st.labels[i])
 	}
-	counter.WriteMetric(buf, parentLabels)
+	return counter
 }
 
 // AddMetric outputs all statCounter's labels/values into a map
@@ -104,12 +121,21 @@ type statDistribution struct {
 
 // WriteMetric outputs Prometheus metrics for all labels/values in statCounter
 func (st *statDistribution) WriteMetric(buf *strings.Builder, parentLabels string) {
+	st.gauge().WriteMetric(buf, parentLabels)
+}
+
+// WriteOpenMetric outputs OpenMetrics metrics for all labels/values in statDistribution
+func (st *statDistribution) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	st.gauge().WriteOpenMetric(buf, parentLabels)
+}
+
+func (st *statDistribution) gauge() *Gauge {
 	name := sanitizePrometheusName(st.name)
 	gauge := makeGauge(MetricName{name, st.description})
 	for i := 0; i < len(st.labels); i++ {
 		gauge.SetLabels(uint64(st.values[i]), st.labels[i])
 	}
-	gauge.WriteMetric(buf, parentLabels)
+	return gauge
 }
 
 // AddMetric outputs all statCounter's labels/values into a map
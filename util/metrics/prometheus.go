@@ -39,6 +39,15 @@ func (pg *defaultPrometheusGatherer) WriteMetric(buf *strings.Builder, parentLab
 	}
 }
 
+// WriteOpenMetric return prometheus converted to algorand format, in OpenMetrics format.
+// Supports only counter and gauge types and ignores go_ metrics.
+func (pg *defaultPrometheusGatherer) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	metrics := collectPrometheusMetrics(pg.names)
+	for _, metric := range metrics {
+		metric.(OpenMetric).WriteOpenMetric(buf, parentLabels)
+	}
+}
+
 // AddMetric return prometheus data converted to algorand format.
 // Supports only counter and gauge types and ignores go_ metrics.
 func (pg *defaultPrometheusGatherer) AddMetric(values map[string]float64) {
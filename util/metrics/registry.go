@@ -78,6 +78,21 @@ func (r *Registry) WriteMetrics(buf *strings.Builder, parentLabels string) {
 	}
 }
 
+// WriteOpenMetrics will write all the metrics that were registered to this registry in OpenMetrics format.
+// Metrics not implementing OpenMetric are written in Prometheus exposition format.
+func (r *Registry) WriteOpenMetrics(buf *strings.Builder, parentLabels string) {
+	r.metricsMu.Lock()
+	defer r.metricsMu.Unlock()
+	for _, m := range r.metrics {
+		if om, ok := m.(OpenMetric); ok {
+			om.WriteOpenMetric(buf, parentLabels)
+		} else {
+			m.WriteMetric(buf, parentLabels)
+		}
+	}
+	buf.WriteString("# EOF\n")
+}
+
 // AddMetrics will add all the metrics that were registered to this registry
 func (r *Registry) AddMetrics(values map[string]float64) {
 	r.metricsMu.Lock()
@@ -31,6 +31,12 @@ type Metric interface {
 	AddMetric(values map[string]float64)
 }
 
+// OpenMetric is implemented by metrics whose OpenMetrics format differs from the Prometheus exposition format
+type OpenMetric interface {
+	// WriteOpenMetric adds metrics in OpenMetrics format to buf, including parentLabels tags if provided.
+	WriteOpenMetric(buf *strings.Builder, parentLabels string)
+}
+
 // Registry represents a single set of metrics registry
 type Registry struct {
 	metrics   []Metric
@@ -17,11 +17,18 @@
 package metrics
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
-	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+	"github.com/DePINNetwork/depin-sdk/util/metrics/metricstest"
 )
 
 func TestWriteAdd(t *testing.T) {
@@ -60,3 +67,79 @@ func TestWriteAdd(t *testing.T) {
 	counter.Deregister(nil)
 	labelCounter.Deregister(nil)
 }
+
+func TestWriteOpenMetricsAllMetricTypes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	promCounter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_openmetrics_prom_dials_total", Help: "Dials"}, []string{"dir"})
+	promGauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_openmetrics_prom_streams", Help: "Streams"})
+	prometheus.DefaultRegisterer.MustRegister(promCounter, promGauge)
+	defer prometheus.DefaultRegisterer.Unregister(promCounter)
+	defer prometheus.DefaultRegisterer.Unregister(promGauge)
+	promCounter.WithLabelValues("in").Add(3)
+	promGauge.Set(2)
+
+	sent := stats.Int64("test_openmetrics_oc_sent_messages", "Messages sent", stats.UnitDimensionless)
+	received := stats.Int64("test_openmetrics_oc_received_bytes", "Bytes received", stats.UnitBytes)
+	sentView := &view.View{Measure: sent, Aggregation: view.Count()}
+	receivedView := &view.View{Measure: received, Aggregation: view.Distribution(1024, 2048)}
+	require.NoError(t, view.Register(sentView, receivedView))
+	defer view.Unregister(sentView, receivedView)
+	stats.Record(context.Background(), sent.M(1), received.M(100))
+	ocNames := []string{"test_openmetrics_oc_sent_messages", "test_openmetrics_oc_received_bytes"}
+	require.Eventually(t, func() bool {
+		// stats are written by a background goroutine
+		return len(collectOpenCensusMetrics(ocNames)) == 2
+	}, 10*time.Second, 20*time.Millisecond)
+
+	reg := MakeRegistry()
+	counter := makeCounter(MetricName{Name: "algod_test_events_total", Description: "events"})
+	counter.Register(reg)
+	counter.Inc(map[string]string{"kind": "a"})
+	gauge := makeGauge(MetricName{Name: "algod_test_level", Description: "level"})
+	gauge.Register(reg)
+	gauge.Set(7)
+	histogram := makeHistogram(MetricName{Name: "algod_test_seconds", Description: "durations"}, []float64{0.5, 1})
+	histogram.Register(reg)
+	histogram.Observe(0.75, nil)
+	tagCounter := NewTagCounter("algod_test_tag_{TAG}", "by tag", "AA")
+	tagCounter.Add("AA", 2)
+	reg.Register(tagCounter)
+	reg.Register(NewRuntimeMetrics())
+	reg.Register(&defaultPrometheusGatherer{names: []string{"test_openmetrics_prom_dials_total", "test_openmetrics_prom_streams"}})
+	reg.Register(&defaultOpencensusGatherer{names: ocNames})
+	reg.Register(netDevGatherer{})
+
+	var buf strings.Builder
+	reg.WriteOpenMetrics(&buf, `host="a"`)
+	families, err := metricstest.ParseOpenMetrics(buf.String())
+	require.NoError(t, err, buf.String())
+
+	types := make(map[string]string)
+	samples := make(map[string]bool)
+	for _, f := range families {
+		types[f.Name] = f.Type
+		for _, s := range f.Samples {
+			require.Equal(t, "a", s.Labels["host"], s.Name)
+			samples[s.Name] = true
+		}
+	}
+	require.Equal(t, "counter", types["algod_test_events"])
+	require.Equal(t, "gauge", types["algod_test_level"])
+	require.Equal(t, "histogram", types["algod_test_seconds"])
+	require.Equal(t, "counter", types["test_openmetrics_prom_dials"])
+	require.True(t, samples["test_openmetrics_prom_dials_total"])
+	require.Equal(t, "gauge", types["test_openmetrics_prom_streams"])
+	require.Equal(t, "counter", types["test_openmetrics_oc_sent_messages"])
+	require.True(t, samples["test_openmetrics_oc_sent_messages_total"])
+	require.Equal(t, "gauge", types["test_openmetrics_oc_received_bytes"])
+	require.Equal(t, "counter", types["algod_go_gc_cycles_total_gc_cycles"])
+	require.True(t, samples["algod_go_gc_cycles_total_gc_cycles_total"])
+	require.Equal(t, "gauge", types["algod_go_sched_goroutines_goroutines"])
+
+	// the Prometheus text format is not valid OpenMetrics
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	_, err = metricstest.ParseOpenMetrics(buf.String() + "# EOF\n")
+	require.Error(t, err)
+}
@@ -87,6 +87,16 @@ func NewRuntimeMetrics(enabledMetrics ...string) *RuntimeMetrics {
 
 // WriteMetric writes runtime metrics to the output stream in prometheus exposition format.
 func (rm *RuntimeMetrics) WriteMetric(buf *strings.Builder, parentLabels string) {
+	rm.writeMetric(buf, parentLabels, false)
+}
+
+// WriteOpenMetric writes runtime metrics to the output stream in OpenMetrics format,
+// where the samples of the cumulative metrics get the _total suffix.
+func (rm *RuntimeMetrics) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	rm.writeMetric(buf, parentLabels, true)
+}
+
+func (rm *RuntimeMetrics) writeMetric(buf *strings.Builder, parentLabels string, openMetrics bool) {
 	rm.Lock()
 	defer rm.Unlock()
 
@@ -95,13 +105,17 @@ func (rm *RuntimeMetrics) WriteMetric(buf *strings.Builder, parentLabels string)
 		name := "algod_go" + sanitizePrometheusName(s.Name)
 		desc := rm.descriptions[i]
 
+		sampleName := name
 		buf.WriteString("# HELP " + name + " " + desc.Description + "\n")
 		if desc.Cumulative {
 			buf.WriteString("# TYPE " + name + " counter\n")
+			if openMetrics {
+				sampleName += "_total"
+			}
 		} else {
 			buf.WriteString("# TYPE " + name + " gauge\n")
 		}
-		buf.WriteString(name)
+		buf.WriteString(sampleName)
 		if len(parentLabels) > 0 {
 			buf.WriteString("{" + parentLabels + "}")
 		}
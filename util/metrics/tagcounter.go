@@ -120,6 +120,17 @@ func (tc *TagCounter) Add(tag string, val uint64) {
 
 // WriteMetric is part of the Metric interface
 func (tc *TagCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
+	tc.writeMetric(buf, parentLabels, false)
+}
+
+// WriteOpenMetric is part of the OpenMetric interface
+func (tc *TagCounter) WriteOpenMetric(buf *strings.Builder, parentLabels string) {
+	tc.writeMetric(buf, parentLabels, true)
+}
+
+// writeMetric writes a counter per tag. In OpenMetrics format the samples get the _total suffix
+// that the metric family names cannot have.
+func (tc *TagCounter) writeMetric(buf *strings.Builder, parentLabels string, openMetrics bool) {
 	tagptr := tc.tagptr.Load()
 	if tagptr == nil {
 		// no values, nothing to say.
@@ -137,6 +148,11 @@ func (tc *TagCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
 		} else {
 			name = tc.Name + "_" + tag
 		}
+		sampleName := name
+		if openMetrics {
+			name = strings.TrimSuffix(name, "_total")
+			sampleName = name + "_total"
+		}
 		buf.WriteString("# HELP ")
 		buf.WriteString(name)
 		buf.WriteRune(' ')
@@ -144,7 +160,7 @@ func (tc *TagCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
 		buf.WriteString("\n# TYPE ")
 		buf.WriteString(name)
 		buf.WriteString(" counter\n")
-		buf.WriteString(name)
+		buf.WriteString(sampleName)
 		if len(parentLabels) > 0 {
 			buf.WriteRune('{')
 			buf.WriteString(parentLabels)
@@ -17,9 +17,12 @@
 package s3
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -45,6 +48,9 @@ const (
 	s3DefaultRegion        = "us-east-1"
 )
 
+// ErrObjectNotFound is returned by GetObject when the bucket has no object with the requested key
+var ErrObjectNotFound = errors.New("object not found")
+
 // Helper encapsulates the s3 session state for interactive with our default S3 bucket with appropriate credentials
 type Helper struct {
 	session *session.Session
@@ -79,12 +85,18 @@ func getS3Region() (region string) {
 
 // MakeS3SessionForUploadWithBucket upload to bucket
 func MakeS3SessionForUploadWithBucket(awsBucket string) (helper Helper, err error) {
-	return makeS3Session(awsBucket)
+	return makeS3Session(awsBucket, "")
 }
 
 // MakeS3SessionForDownloadWithBucket download from bucket
 func MakeS3SessionForDownloadWithBucket(awsBucket string) (helper Helper, err error) {
-	return makeS3Session(awsBucket)
+	return makeS3Session(awsBucket, "")
+}
+
+// MakeS3SessionWithEndpoint creates a session for the given bucket of an S3-compatible service, such as MinIO.
+// An empty endpoint selects AWS S3 itself.
+func MakeS3SessionWithEndpoint(awsBucket string, endpoint string) (helper Helper, err error) {
+	return makeS3Session(awsBucket, endpoint)
 }
 
 // UploadFileStream sends file as stream to s3
@@ -109,7 +121,7 @@ func validateS3Bucket(awsBucket string) (err error) {
 	return
 }
 
-func makeS3Session(bucket string) (helper Helper, err error) {
+func makeS3Session(bucket string, endpoint string) (helper Helper, err error) {
 	err = validateS3Bucket(bucket)
 	if err != nil {
 		return
@@ -120,6 +132,12 @@ func makeS3Session(bucket string) (helper Helper, err error) {
 		Region:                        aws.String(getS3Region()),
 	}
 
+	// S3-compatible services are usually not set up for virtual-hosted style bucket addressing
+	if endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+
 	// s3DefaultReleaseBucket should be public, use AnonymousCredentials
 	if bucket == s3DefaultReleaseBucket {
 		awsConfig.Credentials = credentials.AnonymousCredentials
@@ -145,6 +163,35 @@ func makeS3Session(bucket string) (helper Helper, err error) {
 	return
 }
 
+// PutObject stores data under the given key
+func (helper *Helper) PutObject(ctx context.Context, key string, data []byte) error {
+	svc := s3.New(helper.session)
+	_, err := svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(helper.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// GetObject returns the data stored under the given key, or ErrObjectNotFound if there is no such key
+func (helper *Helper) GetObject(ctx context.Context, key string) ([]byte, error) {
+	svc := s3.New(helper.session)
+	output, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(helper.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var reqErr awserr.RequestFailure
+		if errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+	return io.ReadAll(output.Body)
+}
+
 // GetLatestPackageVersion returns the latest version details for a given package name (eg node, install, tools)
 func (helper *Helper) GetLatestPackageVersion(channel string, packageName string) (maxVersion uint64, maxVersionName string, err error) {
 	return helper.GetPackageVersion(channel, packageName, 0)
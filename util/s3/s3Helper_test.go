@@ -17,9 +17,14 @@
 package s3
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
@@ -173,6 +178,63 @@ func TestMakeS3SessionForDownloadWithBucket(t *testing.T) {
 	}
 }
 
+// fakeS3Server is a minimal stand-in for an S3-compatible service, serving path-style object PUT and GET requests
+type fakeS3Server struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (fs *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fs.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := fs.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+		w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestObjectsWithEndpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "minioadmin")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "minioadmin")
+	server := &fakeS3Server{objects: make(map[string][]byte)}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	helper, err := MakeS3SessionWithEndpoint("test-bucket", httpServer.URL)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = helper.GetObject(ctx, "network/manifest.json")
+	require.ErrorIs(t, err, ErrObjectNotFound)
+
+	require.NoError(t, helper.PutObject(ctx, "network/manifest.json", []byte("{}")))
+	data, err := helper.GetObject(ctx, "network/manifest.json")
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), data)
+
+	// objects are addressed path-style, which S3-compatible services generally expect
+	server.mu.Lock()
+	require.Contains(t, server.objects, "/test-bucket/network/manifest.json")
+	server.mu.Unlock()
+}
+
 func TestGetVersionFromName(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
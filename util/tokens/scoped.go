@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/DePINNetwork/go-deadlock"
+)
+
+// AlgodScopedTokensFilename is the file in the algod data directory holding named, scoped API tokens
+const AlgodScopedTokensFilename = "algod.tokens.json"
+
+// Scope is a group of API routes a scoped token grants access to
+type Scope string
+
+const (
+	// ScopeRead grants access to the public read-only routes
+	ScopeRead Scope = "read"
+	// ScopeSubmit grants access to the transaction submission routes
+	ScopeSubmit Scope = "submit"
+	// ScopeSimulate grants access to the transaction simulation and dryrun routes
+	ScopeSimulate Scope = "simulate"
+	// ScopeParticipation grants access to the participation key management routes
+	ScopeParticipation Scope = "participation"
+	// ScopeDebug grants access to the debug settings and profiling routes
+	ScopeDebug Scope = "debug"
+	// ScopeAdmin grants access to the node administration routes such as shutdown and catchup,
+	// and implies every other scope
+	ScopeAdmin Scope = "admin"
+)
+
+// AllScopes lists every known scope
+var AllScopes = []Scope{ScopeRead, ScopeSubmit, ScopeSimulate, ScopeParticipation, ScopeDebug, ScopeAdmin}
+
+// scopedTokenReloadInterval is how often the scoped token store reloads its file in the background
+const scopedTokenReloadInterval = time.Second
+
+var (
+	// ErrUnknownToken is returned when the token is not in the store
+	ErrUnknownToken = errors.New("unknown API token")
+	// ErrTokenExpired is returned when the token expiry time has passed
+	ErrTokenExpired = errors.New("API token expired")
+	// ErrTokenRevoked is returned when the token has been revoked
+	ErrTokenRevoked = errors.New("API token revoked")
+	// ErrScopeNotAllowed is returned when the token does not have the scope required by the route
+	ErrScopeNotAllowed = errors.New("API token scope does not allow this route")
+	// ErrRateLimited is returned when the token exceeded its request rate limit
+	ErrRateLimited = errors.New("API token request rate limit exceeded")
+)
+
+// ScopedToken describes a named API token. The token secret itself is never stored, only its hash.
+type ScopedToken struct {
+	Name string `json:"name"`
+	// Hash is the hex encoded sha256 hash of the token secret
+	Hash   string  `json:"hash"`
+	Scopes []Scope `json:"scopes"`
+	// Created and Expires are unix timestamps in seconds, a zero Expires never expires
+	Created int64 `json:"created"`
+	Expires int64 `json:"expires,omitempty"`
+	// RateLimit is the number of requests per second allowed for the token, zero is unlimited
+	RateLimit uint64 `json:"rate-limit,omitempty"`
+	Revoked   bool   `json:"revoked,omitempty"`
+}
+
+// HasScope returns true if the token grants the scope
+func (t ScopedToken) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired returns true if the token has an expiry time that is not after now
+func (t ScopedToken) Expired(now time.Time) bool {
+	return t.Expires != 0 && now.Unix() >= t.Expires
+}
+
+type scopedTokensFile struct {
+	Tokens []ScopedToken `json:"tokens"`
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// ParseScopes parses a comma separated list of scope names
+func ParseScopes(scopes string) ([]Scope, error) {
+	var res []Scope
+	for _, name := range strings.Split(scopes, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, s := range AllScopes {
+			if string(s) == name {
+				res = append(res, s)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown scope %s", name)
+		}
+	}
+	if len(res) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	return res, nil
+}
+
+func readScopedTokens(filename string) ([]ScopedToken, error) {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f scopedTokensFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", filename, err)
+	}
+	return f.Tokens, nil
+}
+
+// writeScopedTokens replaces the token file atomically so a running node never reads a partial file
+func writeScopedTokens(filename string, tokens []ScopedToken) error {
+	data, err := json.MarshalIndent(scopedTokensFile{Tokens: tokens}, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmpFilename := filename + ".tmp"
+	if err := os.WriteFile(tmpFilename, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+// CreateScopedToken generates a new named token with the given scopes, expiry (zero for none) and
+// rate limit (zero for unlimited), records it in the data directory and returns the token secret.
+// A name may only be reused once the previous token with that name has been revoked.
+func CreateScopedToken(dataDir, name string, scopes []Scope, expires time.Time, rateLimit uint64) (string, error) {
+	if name == "" {
+		return "", errors.New("token name is required")
+	}
+	if len(scopes) == 0 {
+		return "", errors.New("at least one scope is required")
+	}
+	filename := tokenFilepath(dataDir, AlgodScopedTokensFilename)
+	existing, err := readScopedTokens(filename)
+	if err != nil {
+		return "", err
+	}
+
+	tokenBytes := make([]byte, (minimumAPITokenLength+1)/2)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", fmt.Errorf("error reading random bytes: %v", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	created := ScopedToken{
+		Name:      name,
+		Hash:      hashToken(token),
+		Scopes:    scopes,
+		Created:   time.Now().Unix(),
+		RateLimit: rateLimit,
+	}
+	if !expires.IsZero() {
+		created.Expires = expires.Unix()
+	}
+
+	tokens := make([]ScopedToken, 0, len(existing)+1)
+	for _, t := range existing {
+		if t.Name == name {
+			if !t.Revoked {
+				return "", fmt.Errorf("token %s already exists", name)
+			}
+			continue
+		}
+		tokens = append(tokens, t)
+	}
+	tokens = append(tokens, created)
+	return token, writeScopedTokens(filename, tokens)
+}
+
+// ListScopedTokens returns the named tokens recorded in the data directory
+func ListScopedTokens(dataDir string) ([]ScopedToken, error) {
+	return readScopedTokens(tokenFilepath(dataDir, AlgodScopedTokensFilename))
+}
+
+// RevokeScopedToken marks the named token as revoked. A running node stops accepting it
+// within a second of the token file being updated.
+func RevokeScopedToken(dataDir, name string) error {
+	filename := tokenFilepath(dataDir, AlgodScopedTokensFilename)
+	tokens, err := readScopedTokens(filename)
+	if err != nil {
+		return err
+	}
+	for i := range tokens {
+		if tokens[i].Name == name {
+			tokens[i].Revoked = true
+			return writeScopedTokens(filename, tokens)
+		}
+	}
+	return fmt.Errorf("token %s not found", name)
+}
+
+// ScopedTokenStore authorizes requests against the named tokens in the data directory.
+// It picks up changes made to the token file while the node is running, until it is closed.
+type ScopedTokenStore struct {
+	filename string
+
+	mu       deadlock.RWMutex
+	byHash   map[string]ScopedToken
+	limiters map[string]*rate.Limiter
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// MakeScopedTokenStore loads the scoped tokens from the data directory and starts reloading them
+// in the background. A missing token file results in an empty store.
+func MakeScopedTokenStore(dataDir string) (*ScopedTokenStore, error) {
+	s := &ScopedTokenStore{
+		filename: tokenFilepath(dataDir, AlgodScopedTokensFilename),
+		byHash:   make(map[string]ScopedToken),
+		limiters: make(map[string]*rate.Limiter),
+		closing:  make(chan struct{}),
+	}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.reloadLoop()
+	return s, nil
+}
+
+// Close stops reloading the token file. The store keeps authorizing against the last loaded tokens.
+func (s *ScopedTokenStore) Close() {
+	close(s.closing)
+	s.wg.Wait()
+}
+
+func (s *ScopedTokenStore) reloadLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(scopedTokenReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// keep serving the previously loaded tokens if the file cannot be read
+			_ = s.reload()
+		case <-s.closing:
+			return
+		}
+	}
+}
+
+// reload re-reads the token file. The file is small, so it is simply read again rather than
+// relying on modification times that may not change between two quick updates.
+func (s *ScopedTokenStore) reload() error {
+	tokens, err := readScopedTokens(s.filename)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	byHash := make(map[string]ScopedToken, len(tokens))
+	limiters := make(map[string]*rate.Limiter, len(tokens))
+	for _, t := range tokens {
+		byHash[t.Hash] = t
+		if t.RateLimit == 0 {
+			continue
+		}
+		// keep the state of existing limiters so that reloading does not reset them
+		if l, ok := s.limiters[t.Hash]; ok && l.Limit() == rate.Limit(t.RateLimit) {
+			limiters[t.Hash] = l
+		} else {
+			limiters[t.Hash] = rate.NewLimiter(rate.Limit(t.RateLimit), int(t.RateLimit))
+		}
+	}
+	s.byHash = byHash
+	s.limiters = limiters
+	return nil
+}
+
+// Authorize checks the token is known, not expired or revoked, grants the scope and is within its rate limit.
+// It returns the token name on success.
+func (s *ScopedTokenStore) Authorize(token string, scope Scope) (string, error) {
+	now := time.Now()
+	s.mu.RLock()
+	t, ok := s.byHash[hashToken(token)]
+	l, limited := s.limiters[t.Hash]
+	s.mu.RUnlock()
+
+	if !ok {
+		return "", ErrUnknownToken
+	}
+	if t.Revoked {
+		return t.Name, ErrTokenRevoked
+	}
+	if t.Expired(now) {
+		return t.Name, ErrTokenExpired
+	}
+	if !t.HasScope(scope) {
+		return t.Name, ErrScopeNotAllowed
+	}
+	if limited && !l.AllowN(now, 1) {
+		return t.Name, ErrRateLimited
+	}
+	return t.Name, nil
+}
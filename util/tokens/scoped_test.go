@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+func TestParseScopes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	scopes, err := ParseScopes("read, submit,simulate")
+	require.NoError(t, err)
+	require.Equal(t, []Scope{ScopeRead, ScopeSubmit, ScopeSimulate}, scopes)
+
+	_, err = ParseScopes("read,shutdown")
+	require.ErrorContains(t, err, "unknown scope shutdown")
+
+	_, err = ParseScopes("")
+	require.Error(t, err)
+}
+
+func TestScopedTokenStore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	store, err := MakeScopedTokenStore(dataDir)
+	require.NoError(t, err)
+	defer store.Close()
+	_, err = store.Authorize("unknown", ScopeRead)
+	require.ErrorIs(t, err, ErrUnknownToken)
+
+	adminToken, err := CreateScopedToken(dataDir, "admin", []Scope{ScopeAdmin}, time.Time{}, 0)
+	require.NoError(t, err)
+	partToken, err := CreateScopedToken(dataDir, "participation", []Scope{ScopeParticipation}, time.Now().Add(time.Hour), 0)
+	require.NoError(t, err)
+	_, err = CreateScopedToken(dataDir, "admin", []Scope{ScopeRead}, time.Time{}, 0)
+	require.ErrorContains(t, err, "already exists")
+
+	// secrets are not stored in the token file
+	data, err := os.ReadFile(tokenFilepath(dataDir, AlgodScopedTokensFilename))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), adminToken)
+	require.NotContains(t, string(data), partToken)
+
+	store, err = MakeScopedTokenStore(dataDir)
+	require.NoError(t, err)
+	defer store.Close()
+	name, err := store.Authorize(adminToken, ScopeDebug)
+	require.NoError(t, err)
+	require.Equal(t, "admin", name)
+	_, err = store.Authorize(partToken, ScopeParticipation)
+	require.NoError(t, err)
+	_, err = store.Authorize(partToken, ScopeSubmit)
+	require.ErrorIs(t, err, ErrScopeNotAllowed)
+
+	// revocation is picked up by the running store
+	require.NoError(t, RevokeScopedToken(dataDir, "participation"))
+	require.Error(t, RevokeScopedToken(dataDir, "missing"))
+	require.Eventually(t, func() bool {
+		_, err = store.Authorize(partToken, ScopeParticipation)
+		return errors.Is(err, ErrTokenRevoked)
+	}, 5*scopedTokenReloadInterval, 10*time.Millisecond)
+
+	// a revoked name can be reused
+	newPartToken, err := CreateScopedToken(dataDir, "participation", []Scope{ScopeParticipation}, time.Time{}, 0)
+	require.NoError(t, err)
+	list, err := ListScopedTokens(dataDir)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.NoError(t, store.reload())
+	_, err = store.Authorize(newPartToken, ScopeParticipation)
+	require.NoError(t, err)
+	_, err = store.Authorize(partToken, ScopeParticipation)
+	require.ErrorIs(t, err, ErrUnknownToken)
+}
+
+func TestScopedTokenExpired(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	require.False(t, ScopedToken{}.Expired(now))
+	require.False(t, ScopedToken{Expires: now.Add(time.Second).Unix()}.Expired(now))
+	require.True(t, ScopedToken{Expires: now.Unix()}.Expired(now))
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package tracing exports OpenTelemetry traces of the transaction lifecycle to an OTLP collector.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/DePINNetwork/depin-sdk/util/tracing"
+
+// ErrAlreadyStarted is returned by Start when tracing is already running
+var ErrAlreadyStarted = errors.New("tracing already started")
+
+// Config describes where traces are exported to and how many of them are sampled
+type Config struct {
+	// Endpoint is the URL of the OTLP/HTTP collector, e.g. http://localhost:4318
+	Endpoint string
+	// SamplePercent is the percentage of new traces that are sampled. Traces continuing a
+	// sampled remote parent, e.g. from a traceparent header, are always sampled.
+	SamplePercent uint64
+	// ServiceName is reported as the service.name resource attribute
+	ServiceName string
+	// Attributes are additional resource attributes, such as the node version
+	Attributes map[string]string
+}
+
+var (
+	mu       deadlock.Mutex
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer = noop.NewTracerProvider().Tracer(instrumentationName)
+	enabled  atomic.Bool
+)
+
+var propagator = propagation.TraceContext{}
+
+// Start starts exporting traces to the OTLP collector at cfg.Endpoint
+func Start(ctx context.Context, cfg Config) error {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return err
+	}
+	err = startWithExporter(exporter, cfg)
+	if err != nil {
+		exporter.Shutdown(ctx)
+	}
+	return err
+}
+
+func startWithExporter(exporter sdktrace.SpanExporter, cfg Config) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if provider != nil {
+		return ErrAlreadyStarted
+	}
+
+	attrs := []attribute.KeyValue{attribute.String("service.name", cfg.ServiceName)}
+	for k, v := range cfg.Attributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attrs...)),
+		sdktrace.WithSampler(makeSampler(cfg.SamplePercent)),
+	)
+	tracer = provider.Tracer(instrumentationName)
+	enabled.Store(true)
+	return nil
+}
+
+func makeSampler(percent uint64) sdktrace.Sampler {
+	var root sdktrace.Sampler
+	switch {
+	case percent == 0:
+		root = sdktrace.NeverSample()
+	case percent >= 100:
+		root = sdktrace.AlwaysSample()
+	default:
+		root = sdktrace.TraceIDRatioBased(float64(percent) / 100)
+	}
+	return sdktrace.ParentBased(root)
+}
+
+// Shutdown flushes the pending spans and stops exporting traces.
+// Transaction traces that have not ended yet are dropped.
+func Shutdown(ctx context.Context) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if provider == nil {
+		return nil
+	}
+	enabled.Store(false)
+	txnTraces.reset()
+	err := provider.Shutdown(ctx)
+	provider = nil
+	tracer = noop.NewTracerProvider().Tracer(instrumentationName)
+	return err
+}
+
+// Enabled returns true if traces are being exported. Callers use it to avoid
+// the cost of preparing spans, such as computing transaction IDs, when tracing is off.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Tracer returns the tracer spans are created with, which is a no-op tracer when tracing is not enabled
+func Tracer() trace.Tracer {
+	mu.Lock()
+	defer mu.Unlock()
+	return tracer
+}
+
+// ExtractHTTP returns a copy of ctx carrying the remote span context from the W3C traceparent
+// header, if there is one
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// endSpan ends span, marking it as failed if err is not nil
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+	"github.com/DePINNetwork/depin-sdk/test/partitiontest"
+)
+
+// collectorStandIn is a minimal OTLP/HTTP trace collector
+type collectorStandIn struct {
+	mu       sync.Mutex
+	service  string
+	spans    []*tracepb.Span
+	requests int
+}
+
+func (c *collectorStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/traces" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var req coltracepb.ExportTraceServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests++
+	for _, rs := range req.ResourceSpans {
+		for _, attr := range rs.Resource.Attributes {
+			if attr.Key == "service.name" {
+				c.service = attr.Value.GetStringValue()
+			}
+		}
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	resp, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	w.Write(resp)
+}
+
+func spanAttr(span *tracepb.Span, key string) string {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			if attr.Value.GetStringValue() != "" {
+				return attr.Value.GetStringValue()
+			}
+			return attr.Value.String()
+		}
+	}
+	return ""
+}
+
+func TestTxnTraceExport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	collector := &collectorStandIn{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	err := Start(context.Background(), Config{Endpoint: srv.URL, SamplePercent: 100, ServiceName: "algod-test"})
+	require.NoError(t, err)
+	require.True(t, Enabled())
+	require.ErrorIs(t, Start(context.Background(), Config{Endpoint: srv.URL}), ErrAlreadyStarted)
+
+	txid := crypto.Hash([]byte("txn"))
+	tr := StartTxnTrace(context.Background(), txid, "rest")
+	require.NotNil(t, tr)
+	require.Same(t, tr, StartTxnTrace(context.Background(), txid, "gossip"))
+	require.Same(t, tr, LookupTxnTrace(txid))
+	require.Equal(t, 1, TrackedTxnTraces())
+
+	start := time.Now()
+	tr.RecordSpan("verify", start, nil)
+	tr.RecordSpan("pool.Remember", start, nil)
+	RecordTxnSpans("pool.AssembleBlock", start, func(id crypto.Digest) bool { return id == txid }, attribute.Int64("round", 5))
+	EndCommittedTxnTraces(5, func(id crypto.Digest) bool { return id == txid })
+	require.Nil(t, LookupTxnTrace(txid))
+	require.Zero(t, TrackedTxnTraces())
+
+	require.NoError(t, Shutdown(context.Background()))
+	require.False(t, Enabled())
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	require.Equal(t, "algod-test", collector.service)
+	require.Len(t, collector.spans, 4)
+	byName := make(map[string]*tracepb.Span)
+	for _, span := range collector.spans {
+		byName[span.Name] = span
+	}
+	lifecycle := byName["txn.lifecycle"]
+	require.NotNil(t, lifecycle)
+	require.Equal(t, txid.String(), spanAttr(lifecycle, "txn.id"))
+	require.Equal(t, "rest", spanAttr(lifecycle, "txn.origin"))
+	require.Equal(t, OutcomeCommitted, spanAttr(lifecycle, "txn.outcome"))
+	require.Len(t, lifecycle.Events, 1)
+	for _, name := range []string{"verify", "pool.Remember", "pool.AssembleBlock"} {
+		span := byName[name]
+		require.NotNil(t, span, name)
+		require.Equal(t, lifecycle.TraceId, span.TraceId)
+		require.Equal(t, lifecycle.SpanId, span.ParentSpanId)
+	}
+}
+
+func TestTxnTraceSampling(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	require.NoError(t, startWithExporter(exporter, Config{SamplePercent: 0}))
+	defer Shutdown(context.Background())
+
+	// not sampled without a parent
+	require.Nil(t, StartTxnTrace(context.Background(), crypto.Hash([]byte("a")), "gossip"))
+	require.Zero(t, TrackedTxnTraces())
+
+	// a sampled remote parent is always followed
+	header := http.Header{}
+	header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	tr := StartTxnTrace(ExtractHTTP(context.Background(), header), crypto.Hash([]byte("b")), "rest")
+	require.NotNil(t, tr)
+	require.Equal(t, "0af7651916cd43dd8448eb211c80319c", tr.span.SpanContext().TraceID().String())
+
+	// while an unsampled one is not
+	header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00")
+	require.Nil(t, StartTxnTrace(ExtractHTTP(context.Background(), header), crypto.Hash([]byte("c")), "rest"))
+}
+
+func TestTxnTraceRejectAndEvict(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	require.NoError(t, startWithExporter(exporter, Config{SamplePercent: 100}))
+	defer Shutdown(context.Background())
+
+	max := txnTraces.max
+	txnTraces.max = 2
+	defer func() { txnTraces.max = max }()
+
+	rejected := StartTxnTrace(context.Background(), crypto.Hash([]byte("rejected")), "gossip")
+	require.NotNil(t, rejected)
+	rejected.Reject(errors.New("overspend"))
+	require.Zero(t, TrackedTxnTraces())
+
+	for _, name := range []string{"a", "b", "c"} {
+		require.NotNil(t, StartTxnTrace(context.Background(), crypto.Hash([]byte(name)), "gossip"))
+	}
+	require.Equal(t, 2, TrackedTxnTraces())
+	require.Nil(t, LookupTxnTrace(crypto.Hash([]byte("a"))))
+	require.NotNil(t, LookupTxnTrace(crypto.Hash([]byte("c"))))
+
+	// the in-memory exporter forgets the spans on shutdown so flush them instead
+	require.NoError(t, provider.ForceFlush(context.Background()))
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	outcomes := make(map[string]codes.Code)
+	for _, span := range spans {
+		for _, attr := range span.Attributes {
+			if attr.Key == "txn.outcome" {
+				outcomes[attr.Value.AsString()] = span.Status.Code
+			}
+		}
+	}
+	require.Equal(t, map[string]codes.Code{OutcomeRejected: codes.Error, OutcomeEvicted: codes.Unset}, outcomes)
+}
+
+func TestTxnTraceDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.False(t, Enabled())
+	txid := crypto.Hash([]byte("txn"))
+	require.Nil(t, StartTxnTrace(context.Background(), txid, "rest"))
+	require.Nil(t, LookupTxnTrace(txid))
+
+	// a nil trace records nothing
+	var tr *TxnTrace
+	_, span := tr.StartSpan("verify")
+	span.End()
+	tr.RecordSpan("verify", time.Now(), nil)
+	tr.AddEvent("event")
+	tr.Reject(errors.New("rejected"))
+	require.True(t, tr.StartTime().IsZero())
+}
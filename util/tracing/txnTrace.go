@@ -0,0 +1,232 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/DePINNetwork/go-deadlock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/DePINNetwork/depin-sdk/crypto"
+)
+
+// maxTxnTraces bounds the number of transaction groups traced at the same time.
+// When exceeded, the oldest traces are ended as evicted.
+const maxTxnTraces = 10000
+
+// Transaction lifecycle outcomes, reported as the txn.outcome attribute of the lifecycle span
+const (
+	OutcomeCommitted = "committed"
+	OutcomeRejected  = "rejected"
+	OutcomeEvicted   = "evicted"
+)
+
+// TxnTrace is the trace of a transaction group from the moment the node received it until it
+// was committed or rejected. The stages the group goes through, possibly on different goroutines,
+// are recorded as child spans of the lifecycle span. A nil *TxnTrace is valid and records nothing,
+// so that callers do not need to check whether the group is being traced.
+type TxnTrace struct {
+	ctx   context.Context
+	span  trace.Span
+	txid  crypto.Digest
+	start time.Time
+}
+
+type txnTraceStore struct {
+	deadlock.Mutex
+	max    int
+	traces map[crypto.Digest]*TxnTrace
+	// order holds the txids in the order they were added, possibly including ended ones
+	order []crypto.Digest
+}
+
+var txnTraces = makeTxnTraceStore(maxTxnTraces)
+
+func makeTxnTraceStore(max int) *txnTraceStore {
+	return &txnTraceStore{
+		max:    max,
+		traces: make(map[crypto.Digest]*TxnTrace),
+	}
+}
+
+func (s *txnTraceStore) reset() {
+	s.Lock()
+	defer s.Unlock()
+	s.traces = make(map[crypto.Digest]*TxnTrace)
+	s.order = nil
+}
+
+// StartTxnTrace starts tracing the lifecycle of the transaction group identified by txid, the ID of
+// its first transaction. ctx may carry a remote parent span, see ExtractHTTP. If the group is already
+// being traced, its existing trace is returned. It returns nil if tracing is disabled or the
+// trace was not sampled.
+func StartTxnTrace(ctx context.Context, txid crypto.Digest, origin string) *TxnTrace {
+	if !Enabled() {
+		return nil
+	}
+	return txnTraces.start(ctx, Tracer(), txid, origin)
+}
+
+func (s *txnTraceStore) start(ctx context.Context, tracer trace.Tracer, txid crypto.Digest, origin string) *TxnTrace {
+	s.Lock()
+	defer s.Unlock()
+	if t, has := s.traces[txid]; has {
+		t.span.AddEvent("received again", trace.WithAttributes(attribute.String("txn.origin", origin)))
+		return t
+	}
+
+	ctx, span := tracer.Start(ctx, "txn.lifecycle", trace.WithAttributes(
+		attribute.String("txn.id", txid.String()),
+		attribute.String("txn.origin", origin),
+	))
+	if !span.SpanContext().IsSampled() {
+		span.End()
+		return nil
+	}
+	t := &TxnTrace{ctx: ctx, span: span, txid: txid, start: time.Now()}
+	s.traces[txid] = t
+	s.order = append(s.order, txid)
+
+	for len(s.traces) > s.max {
+		oldest := s.order[0]
+		s.order = s.order[1:]
+		if evicted, has := s.traces[oldest]; has {
+			delete(s.traces, oldest)
+			evicted.end(OutcomeEvicted, nil)
+		}
+	}
+	// drop the ended traces from order once they dominate it
+	if len(s.order) > 2*s.max {
+		order := make([]crypto.Digest, 0, len(s.traces))
+		for _, id := range s.order {
+			if _, has := s.traces[id]; has {
+				order = append(order, id)
+			}
+		}
+		s.order = order
+	}
+	return t
+}
+
+// LookupTxnTrace returns the trace of the transaction group identified by txid, or nil if it is
+// not being traced
+func LookupTxnTrace(txid crypto.Digest) *TxnTrace {
+	if !Enabled() {
+		return nil
+	}
+	txnTraces.Lock()
+	defer txnTraces.Unlock()
+	return txnTraces.traces[txid]
+}
+
+// TrackedTxnTraces returns the number of transaction groups currently being traced
+func TrackedTxnTraces() int {
+	if !Enabled() {
+		return 0
+	}
+	txnTraces.Lock()
+	defer txnTraces.Unlock()
+	return len(txnTraces.traces)
+}
+
+// EndCommittedTxnTraces ends the traces of the transaction groups committed in round,
+// as reported by the committed function
+func EndCommittedTxnTraces(round uint64, committed func(txid crypto.Digest) bool) {
+	if !Enabled() {
+		return
+	}
+	txnTraces.Lock()
+	defer txnTraces.Unlock()
+	for txid, t := range txnTraces.traces {
+		if committed(txid) {
+			delete(txnTraces.traces, txid)
+			t.span.SetAttributes(attribute.Int64("txn.round", int64(round)))
+			t.end(OutcomeCommitted, nil)
+		}
+	}
+}
+
+// RecordTxnSpans records a child span that started at start and ends now on the traces of the
+// transaction groups selected by the include function, for stages that process many groups at once
+func RecordTxnSpans(name string, start time.Time, include func(txid crypto.Digest) bool, attrs ...attribute.KeyValue) {
+	if !Enabled() {
+		return
+	}
+	txnTraces.Lock()
+	defer txnTraces.Unlock()
+	for txid, t := range txnTraces.traces {
+		if include(txid) {
+			t.RecordSpan(name, start, nil, attrs...)
+		}
+	}
+}
+
+// StartTime returns when the lifecycle trace was started
+func (t *TxnTrace) StartTime() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.start
+}
+
+// StartSpan starts a child span of the lifecycle span. The caller must end the returned span.
+func (t *TxnTrace) StartSpan(name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if t == nil {
+		return context.Background(), noop.Span{}
+	}
+	return Tracer().Start(t.ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordSpan records a child span of the lifecycle span that started at start and ends now.
+// The span is marked as failed if err is not nil.
+func (t *TxnTrace) RecordSpan(name string, start time.Time, err error, attrs ...attribute.KeyValue) {
+	if t == nil {
+		return
+	}
+	_, span := Tracer().Start(t.ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	endSpan(span, err)
+}
+
+// AddEvent adds an event to the lifecycle span
+func (t *TxnTrace) AddEvent(name string, attrs ...attribute.KeyValue) {
+	if t == nil {
+		return
+	}
+	t.span.AddEvent(name, trace.WithAttributes(attrs...))
+}
+
+// Reject ends the lifecycle trace of a transaction group that will not be committed because of err
+func (t *TxnTrace) Reject(err error) {
+	if t == nil {
+		return
+	}
+	txnTraces.Lock()
+	if txnTraces.traces[t.txid] == t {
+		delete(txnTraces.traces, t.txid)
+	}
+	txnTraces.Unlock()
+	t.end(OutcomeRejected, err)
+}
+
+func (t *TxnTrace) end(outcome string, err error) {
+	t.span.SetAttributes(attribute.String("txn.outcome", outcome))
+	endSpan(t.span, err)
+}